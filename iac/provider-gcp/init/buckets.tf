@@ -255,6 +255,34 @@ resource "google_storage_bucket_iam_member" "volumes_token_minter" {
   member = "serviceAccount:${google_service_account.volumes_token_minter.email}"
 }
 
+# Per-team volume buckets are created by the API with the first volume of a team,
+# access is granted on the project for the buckets with the team bucket prefix
+resource "google_project_iam_member" "volumes_team_buckets_token_minter" {
+  count = var.volumes_team_bucket_prefix != "" ? 1 : 0
+
+  project = var.gcp_project_id
+  role    = "roles/storage.objectAdmin"
+  member  = "serviceAccount:${google_service_account.volumes_token_minter.email}"
+
+  condition {
+    title      = "Team volume buckets"
+    expression = "resource.name.startsWith(\"projects/_/buckets/${var.volumes_team_bucket_prefix}\")"
+  }
+}
+
+resource "google_project_iam_member" "volumes_team_buckets_infra" {
+  count = var.volumes_team_bucket_prefix != "" ? 1 : 0
+
+  project = var.gcp_project_id
+  role    = "roles/storage.admin"
+  member  = "serviceAccount:${google_service_account.infra_instances_service_account.email}"
+
+  condition {
+    title      = "Team volume buckets"
+    expression = "resource.name.startsWith(\"projects/_/buckets/${var.volumes_team_bucket_prefix}\")"
+  }
+}
+
 # GCS bucket for JuiceFS binary versions
 resource "google_storage_bucket" "juicefs_versions" {
   location = var.gcp_region
@@ -26,3 +26,9 @@ variable "template_bucket_name" {
   description = "The name of the FC template bucket"
   default     = ""
 }
+
+variable "volumes_team_bucket_prefix" {
+  type        = string
+  description = "Prefix of the per-team volume buckets created by the API, empty when volumes share one bucket"
+  default     = ""
+}
@@ -53,6 +53,8 @@ module "init" {
 
   template_bucket_location = var.template_bucket_location
   template_bucket_name     = var.template_bucket_name
+
+  volumes_team_bucket_prefix = var.volumes_team_bucket_prefix
 }
 
 module "cluster" {
@@ -254,6 +256,7 @@ module "nomad" {
   volumes_enabled                  = var.volumes_enabled
   volumes_redis_url_secret_version = module.init.volumes_redis_url_secret_version
   volumes_bucket                   = module.init.volumes_bucket_name
+  volumes_team_bucket_prefix       = var.volumes_team_bucket_prefix
   volumes_token_minter_sa          = module.init.volumes_token_minter_email
 
   launch_darkly_api_key_secret_name = module.init.launch_darkly_api_key_secret_version.secret
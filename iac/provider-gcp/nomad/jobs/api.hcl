@@ -106,6 +106,11 @@ job "api" {
 %{ if volumes_redis_url != "" }
         VOLUMES_REDIS_URL             = "${volumes_redis_url}"
         VOLUMES_BUCKET                = "${volumes_bucket}"
+%{ if volumes_team_bucket_prefix != "" }
+        VOLUMES_TEAM_BUCKET_PREFIX    = "${volumes_team_bucket_prefix}"
+        GCP_PROJECT_ID                = "${gcp_project_id}"
+        GCP_REGION                    = "${gcp_region}"
+%{ endif }
 %{ endif }
 
         # This is here just because it is required in some part of our code which is transitively imported
@@ -111,8 +111,11 @@ resource "nomad_job" "api" {
     local_cluster_token    = var.edge_api_secret
 
     # Volumes (JuiceFS)
-    volumes_redis_url = var.volumes_enabled ? trimspace(data.google_secret_manager_secret_version.volumes_redis_url[0].secret_data) : ""
-    volumes_bucket    = var.volumes_bucket
+    volumes_redis_url          = var.volumes_enabled ? trimspace(data.google_secret_manager_secret_version.volumes_redis_url[0].secret_data) : ""
+    volumes_bucket             = var.volumes_bucket
+    volumes_team_bucket_prefix = var.volumes_team_bucket_prefix
+    gcp_project_id             = var.gcp_project_id
+    gcp_region                 = var.gcp_region
   })
 }
 
@@ -267,6 +267,11 @@ variable "volumes_bucket" {
   default = ""
 }
 
+variable "volumes_team_bucket_prefix" {
+  type    = string
+  default = ""
+}
+
 variable "volumes_token_minter_sa" {
   description = "Service account email for volume token minting (impersonation)"
   type        = string
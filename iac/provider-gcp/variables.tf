@@ -561,3 +561,9 @@ variable "volumes_enabled" {
   description = "Enable volume primitive (JuiceFS-backed persistent storage). Creates dedicated Redis cluster and GCS bucket."
   default     = false
 }
+
+variable "volumes_team_bucket_prefix" {
+  type        = string
+  description = "Store the data of new volumes in a GCS bucket per team named with this prefix and the team ID, instead of the shared volumes bucket. Empty disables it."
+  default     = ""
+}
@@ -23,6 +23,21 @@ type ServerInterface interface {
 	// Kill all sandboxes for a team
 	// (POST /admin/teams/{teamID}/sandboxes/kill)
 	PostAdminTeamsTeamIDSandboxesKill(c *gin.Context, teamID openapi_types.UUID)
+	// Delete old volumes of a team by name prefix
+	// (POST /admin/teams/{teamID}/volumes/cleanup)
+	PostAdminTeamsTeamIDVolumesCleanup(c *gin.Context, teamID openapi_types.UUID)
+	// Update envd in a template
+	// (POST /admin/templates/{templateID}/envd-update)
+	PostAdminTemplatesTemplateIDEnvdUpdate(c *gin.Context, templateID TemplateID)
+	// List orphaned volume storage
+	// (GET /admin/volumes/orphans)
+	GetAdminVolumesOrphans(c *gin.Context)
+	// Report the usage of the volumes Redis databases
+	// (GET /admin/volumes/redis-databases)
+	GetAdminVolumesRedisDatabases(c *gin.Context)
+	// Migrate the metadata of a volume to another engine
+	// (POST /admin/volumes/{volumeID}/metadata-engine)
+	PostAdminVolumesVolumeIDMetadataEngine(c *gin.Context, volumeID string)
 
 	// (GET /api-keys)
 	GetApiKeys(c *gin.Context)
@@ -35,9 +50,18 @@ type ServerInterface interface {
 
 	// (PATCH /api-keys/{apiKeyID})
 	PatchApiKeysApiKeyID(c *gin.Context, apiKeyID ApiKeyID)
+	// List audit logs
+	// (GET /audit-logs)
+	GetAuditLogs(c *gin.Context, params GetAuditLogsParams)
+	// Get capabilities
+	// (GET /capabilities)
+	GetCapabilities(c *gin.Context)
 
 	// (GET /health)
 	GetHealth(c *gin.Context)
+	// Get rate limits
+	// (GET /limits)
+	GetLimits(c *gin.Context)
 
 	// (GET /nodes)
 	GetNodes(c *gin.Context)
@@ -47,6 +71,21 @@ type ServerInterface interface {
 
 	// (POST /nodes/{nodeID})
 	PostNodesNodeID(c *gin.Context, nodeID NodeID)
+	// Get OpenAPI document
+	// (GET /openapi.json)
+	GetOpenAPIDocument(c *gin.Context)
+	// Get volume operation
+	// (GET /operations/{operationID})
+	GetOperationsOperationID(c *gin.Context, operationID OperationID)
+
+	// (GET /s3-credentials)
+	GetS3Credentials(c *gin.Context)
+
+	// (POST /s3-credentials)
+	PostS3Credentials(c *gin.Context)
+
+	// (DELETE /s3-credentials/{accessKeyID})
+	DeleteS3CredentialsAccessKeyID(c *gin.Context, accessKeyID AccessKeyID)
 
 	// (GET /sandboxes)
 	GetSandboxes(c *gin.Context, params GetSandboxesParams)
@@ -69,6 +108,9 @@ type ServerInterface interface {
 	// (GET /sandboxes/{sandboxID}/logs)
 	GetSandboxesSandboxIDLogs(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDLogsParams)
 
+	// (GET /sandboxes/{sandboxID}/logs/summary)
+	GetSandboxesSandboxIDLogsSummary(c *gin.Context, sandboxID SandboxID)
+
 	// (GET /sandboxes/{sandboxID}/metrics)
 	GetSandboxesSandboxIDMetrics(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDMetricsParams)
 
@@ -84,8 +126,29 @@ type ServerInterface interface {
 	// (POST /sandboxes/{sandboxID}/timeout)
 	PostSandboxesSandboxIDTimeout(c *gin.Context, sandboxID SandboxID)
 
+	// (POST /sandboxes/{sandboxID}/volumes)
+	PostSandboxesSandboxIDVolumes(c *gin.Context, sandboxID SandboxID)
+
+	// (DELETE /sandboxes/{sandboxID}/volumes/{volumeID})
+	DeleteSandboxesSandboxIDVolumesVolumeID(c *gin.Context, sandboxID SandboxID, volumeID VolumeIdOrName)
+
+	// (GET /secrets)
+	GetSecrets(c *gin.Context)
+
+	// (POST /secrets)
+	PostSecrets(c *gin.Context)
+
+	// (DELETE /secrets/{secretName})
+	DeleteSecretsSecretName(c *gin.Context, secretName SecretName)
+	// Get platform status
+	// (GET /status)
+	GetStatus(c *gin.Context)
+
 	// (GET /teams)
 	GetTeams(c *gin.Context)
+	// Get team storage usage
+	// (GET /teams/storage-usage)
+	GetTeamsStorageUsage(c *gin.Context)
 
 	// (GET /teams/{teamID}/metrics)
 	GetTeamsTeamIDMetrics(c *gin.Context, teamID TeamID, params GetTeamsTeamIDMetricsParams)
@@ -93,6 +156,9 @@ type ServerInterface interface {
 	// (GET /teams/{teamID}/metrics/max)
 	GetTeamsTeamIDMetricsMax(c *gin.Context, teamID TeamID, params GetTeamsTeamIDMetricsMaxParams)
 
+	// (GET /teams/{teamID}/metrics/resources)
+	GetTeamsTeamIDMetricsResources(c *gin.Context, teamID TeamID, params GetTeamsTeamIDMetricsResourcesParams)
+
 	// (GET /templates)
 	GetTemplates(c *gin.Context, params GetTemplatesParams)
 
@@ -142,25 +208,106 @@ type ServerInterface interface {
 	GetVolumes(c *gin.Context, params GetVolumesParams)
 	// Create volume (idempotent)
 	// (POST /volumes)
-	PostVolumes(c *gin.Context)
+	PostVolumes(c *gin.Context, params PostVolumesParams)
 	// Delete volume
 	// (DELETE /volumes/{volumeID})
-	DeleteVolumesIdOrName(c *gin.Context, volumeID VolumeIdOrName)
+	DeleteVolumesIdOrName(c *gin.Context, volumeID VolumeIdOrName, params DeleteVolumesIdOrNameParams)
 	// Get volume
 	// (GET /volumes/{volumeID})
 	GetVolumesIdOrName(c *gin.Context, volumeID VolumeIdOrName)
+	// Update volume
+	// (PATCH /volumes/{volumeID})
+	PatchVolumesIdOrName(c *gin.Context, volumeID VolumeIdOrName)
+	// List volume attachments
+	// (GET /volumes/{volumeID}/attachments)
+	GetVolumesIdOrNameAttachments(c *gin.Context, volumeID VolumeIdOrName)
 	// Delete file or directory
 	// (DELETE /volumes/{volumeID}/files)
 	DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, params DeleteVolumesVolumeIDFilesParams)
 	// List files in volume
 	// (GET /volumes/{volumeID}/files)
 	GetVolumesVolumeIDFiles(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesParams)
+	// Download directory archive
+	// (GET /volumes/{volumeID}/files/archive)
+	GetVolumesVolumeIDFilesArchive(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesArchiveParams)
+	// Set file attributes
+	// (PATCH /volumes/{volumeID}/files/attributes)
+	PatchVolumesVolumeIDFilesAttributes(c *gin.Context, volumeID string)
+	// Copy files
+	// (POST /volumes/{volumeID}/files/copy)
+	PostVolumesVolumeIDFilesCopy(c *gin.Context, volumeID string)
 	// Download file content
 	// (GET /volumes/{volumeID}/files/download)
 	GetVolumesVolumeIDFilesDownload(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesDownloadParams)
+	// Get file headers
+	// (HEAD /volumes/{volumeID}/files/download)
+	HeadVolumesVolumeIDFilesDownload(c *gin.Context, volumeID string, params HeadVolumesVolumeIDFilesDownloadParams)
+	// Search file contents in volume
+	// (GET /volumes/{volumeID}/files/grep)
+	GetVolumesVolumeIDFilesGrep(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesGrepParams)
+	// Create directory
+	// (POST /volumes/{volumeID}/files/mkdir)
+	PostVolumesVolumeIDFilesMkdir(c *gin.Context, volumeID string)
+	// Create a signed download URL
+	// (POST /volumes/{volumeID}/files/presign)
+	PostVolumesVolumeIDFilesPresign(c *gin.Context, volumeID string)
+	// Search files in volume
+	// (GET /volumes/{volumeID}/files/search)
+	GetVolumesVolumeIDFilesSearch(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesSearchParams)
+	// Get file metadata
+	// (GET /volumes/{volumeID}/files/stat)
+	GetVolumesVolumeIDFilesStat(c *gin.Context, volumeID string, params GetVolumesVolumeIDFilesStatParams)
+	// Create symlink
+	// (POST /volumes/{volumeID}/files/symlink)
+	PostVolumesVolumeIDFilesSymlink(c *gin.Context, volumeID string)
+	// Compare a local directory with a volume directory
+	// (POST /volumes/{volumeID}/files/sync)
+	PostVolumesVolumeIDFilesSync(c *gin.Context, volumeID string)
 	// Upload file content
 	// (PUT /volumes/{volumeID}/files/upload)
 	PutVolumesVolumeIDFilesUpload(c *gin.Context, volumeID string, params PutVolumesVolumeIDFilesUploadParams)
+	// Check volume consistency
+	// (POST /volumes/{volumeID}/fsck)
+	PostVolumesVolumeIDFsck(c *gin.Context, volumeID VolumeIdOrName, params PostVolumesVolumeIDFsckParams)
+	// Get volume metrics
+	// (GET /volumes/{volumeID}/metrics)
+	GetVolumesVolumeIDMetrics(c *gin.Context, volumeID string, params GetVolumesVolumeIDMetricsParams)
+	// List volume operations
+	// (GET /volumes/{volumeID}/operations)
+	GetVolumesIdOrNameOperations(c *gin.Context, volumeID VolumeIdOrName, params GetVolumesIdOrNameOperationsParams)
+	// List volume runs
+	// (GET /volumes/{volumeID}/runs)
+	GetVolumesIdOrNameRuns(c *gin.Context, volumeID VolumeIdOrName, params GetVolumesIdOrNameRunsParams)
+	// Restore volume
+	// (POST /volumes/{volumeID}/undelete)
+	PostVolumesIdOrNameUndelete(c *gin.Context, volumeID VolumeIdOrName)
+	// Start multipart upload
+	// (POST /volumes/{volumeID}/uploads)
+	PostVolumesVolumeIDUploads(c *gin.Context, volumeID string)
+	// Abort multipart upload
+	// (DELETE /volumes/{volumeID}/uploads/{uploadID})
+	DeleteVolumesVolumeIDUploadsUploadID(c *gin.Context, volumeID string, uploadID UploadID)
+	// Get multipart upload
+	// (GET /volumes/{volumeID}/uploads/{uploadID})
+	GetVolumesVolumeIDUploadsUploadID(c *gin.Context, volumeID string, uploadID UploadID)
+	// Complete multipart upload
+	// (POST /volumes/{volumeID}/uploads/{uploadID}/complete)
+	PostVolumesVolumeIDUploadsUploadIDComplete(c *gin.Context, volumeID string, uploadID UploadID)
+	// Upload part
+	// (PUT /volumes/{volumeID}/uploads/{uploadID}/parts/{partNumber})
+	PutVolumesVolumeIDUploadsUploadIDPartsPartNumber(c *gin.Context, volumeID string, uploadID UploadID, partNumber int32)
+	// Get volume usage
+	// (GET /volumes/{volumeID}/usage)
+	GetVolumesVolumeIDUsage(c *gin.Context, volumeID string)
+
+	// (GET /webhooks)
+	GetWebhooks(c *gin.Context)
+
+	// (POST /webhooks)
+	PostWebhooks(c *gin.Context)
+
+	// (DELETE /webhooks/{webhookID})
+	DeleteWebhooksWebhookID(c *gin.Context, webhookID WebhookID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -239,6 +386,114 @@ func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDSandboxesKill(c *gin.Cont
 	siw.Handler.PostAdminTeamsTeamIDSandboxesKill(c, teamID)
 }
 
+// PostAdminTeamsTeamIDVolumesCleanup operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDVolumesCleanup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminTeamsTeamIDVolumesCleanup(c, teamID)
+}
+
+// PostAdminTemplatesTemplateIDEnvdUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTemplatesTemplateIDEnvdUpdate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminTemplatesTemplateIDEnvdUpdate(c, templateID)
+}
+
+// GetAdminVolumesOrphans operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminVolumesOrphans(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminVolumesOrphans(c)
+}
+
+// GetAdminVolumesRedisDatabases operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminVolumesRedisDatabases(c *gin.Context) {
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminVolumesRedisDatabases(c)
+}
+
+// PostAdminVolumesVolumeIDMetadataEngine operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminVolumesVolumeIDMetadataEngine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminVolumesVolumeIDMetadataEngine(c, volumeID)
+}
+
 // GetApiKeys operation middleware
 func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
 
@@ -329,6 +584,105 @@ func (siw *ServerInterfaceWrapper) PatchApiKeysApiKeyID(c *gin.Context) {
 	siw.Handler.PatchApiKeysApiKeyID(c, apiKeyID)
 }
 
+// GetAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLogs(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "volumeID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "volumeID", c.Request.URL.Query(), &params.VolumeID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actorID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorID", c.Request.URL.Query(), &params.ActorID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actorID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuditLogs(c, params)
+}
+
+// GetCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetCapabilities(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCapabilities(c)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 
@@ -342,6 +696,25 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 	siw.Handler.GetHealth(c)
 }
 
+// GetLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetLimits(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLimits(c)
+}
+
 // GetNodes operation middleware
 func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
 
@@ -420,28 +793,39 @@ func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
 	siw.Handler.PostNodesNodeID(c, nodeID)
 }
 
-// GetSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
-
-	var err error
+// GetOpenAPIDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPIDocument(c *gin.Context) {
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	c.Set(Supabase1TokenAuthScopes, []string{})
+	siw.Handler.GetOpenAPIDocument(c)
+}
 
-	c.Set(Supabase2TeamAuthScopes, []string{})
+// GetOperationsOperationID operation middleware
+func (siw *ServerInterfaceWrapper) GetOperationsOperationID(c *gin.Context) {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesParams
+	var err error
 
-	// ------------- Optional query parameter "metadata" -------------
+	// ------------- Path parameter "operationID" -------------
+	var operationID OperationID
 
-	err = runtime.BindQueryParameter("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata)
+	err = runtime.BindStyledParameterWithOptions("simple", "operationID", c.Param("operationID"), &operationID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operationID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -449,11 +833,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxes(c, params)
+	siw.Handler.GetOperationsOperationID(c, operationID)
 }
 
-// PostSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+// GetS3Credentials operation middleware
+func (siw *ServerInterfaceWrapper) GetS3Credentials(c *gin.Context) {
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
@@ -468,13 +852,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxes(c)
+	siw.Handler.GetS3Credentials(c)
 }
 
-// GetSandboxesMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
-
-	var err error
+// PostS3Credentials operation middleware
+func (siw *ServerInterfaceWrapper) PostS3Credentials(c *gin.Context) {
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
@@ -482,24 +864,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesMetricsParams
-
-	// ------------- Required query parameter "sandbox_ids" -------------
-
-	if paramValue := c.Query("sandbox_ids"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument sandbox_ids is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", false, true, "sandbox_ids", c.Request.URL.Query(), &params.SandboxIds)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandbox_ids: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -507,20 +871,140 @@ func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesMetrics(c, params)
+	siw.Handler.PostS3Credentials(c)
 }
 
-// DeleteSandboxesSandboxID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+// DeleteS3CredentialsAccessKeyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteS3CredentialsAccessKeyID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "accessKeyID" -------------
+	var accessKeyID AccessKeyID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "accessKeyID", c.Param("accessKeyID"), &accessKeyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteS3CredentialsAccessKeyID(c, accessKeyID)
+}
+
+// GetSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesParams
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxes(c, params)
+}
+
+// PostSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxes(c)
+}
+
+// GetSandboxesMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesMetricsParams
+
+	// ------------- Required query parameter "sandbox_ids" -------------
+
+	if paramValue := c.Query("sandbox_ids"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument sandbox_ids is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "sandbox_ids", c.Request.URL.Query(), &params.SandboxIds)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandbox_ids: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesMetrics(c, params)
+}
+
+// DeleteSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -665,6 +1149,36 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
 }
 
+// GetSandboxesSandboxIDLogsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogsSummary(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDLogsSummary(c, sandboxID)
+}
+
 // GetSandboxesSandboxIDMetrics operation middleware
 func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
 
@@ -834,13 +1348,26 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context)
 	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
 }
 
-// GetTeams operation middleware
-func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+// PostSandboxesSandboxIDVolumes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDVolumes(c *gin.Context) {
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -848,47 +1375,37 @@ func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeams(c)
+	siw.Handler.PostSandboxesSandboxIDVolumes(c, sandboxID)
 }
 
-// GetTeamsTeamIDMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
+// DeleteSandboxesSandboxIDVolumesVolumeID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxIDVolumesVolumeID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID TeamID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
-
-	c.Set(Supabase1TokenAuthScopes, []string{})
-
-	c.Set(Supabase2TeamAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamsTeamIDMetricsParams
-
-	// ------------- Optional query parameter "start" -------------
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
 
-	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "end" -------------
+	c.Set(ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -897,22 +1414,11 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamsTeamIDMetrics(c, teamID, params)
+	siw.Handler.DeleteSandboxesSandboxIDVolumesVolumeID(c, sandboxID, volumeID)
 }
 
-// GetTeamsTeamIDMetricsMax operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "teamID" -------------
-	var teamID TeamID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetSecrets operation middleware
+func (siw *ServerInterfaceWrapper) GetSecrets(c *gin.Context) {
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
@@ -920,39 +1426,24 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamsTeamIDMetricsMaxParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "end" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.GetSecrets(c)
+}
 
-	// ------------- Required query parameter "metric" -------------
+// PostSecrets operation middleware
+func (siw *ServerInterfaceWrapper) PostSecrets(c *gin.Context) {
 
-	if paramValue := c.Query("metric"); paramValue != "" {
+	c.Set(ApiKeyAuthScopes, []string{})
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument metric is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	c.Set(Supabase1TokenAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "metric", c.Request.URL.Query(), &params.Metric)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(Supabase2TeamAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -961,17 +1452,264 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamsTeamIDMetricsMax(c, teamID, params)
+	siw.Handler.PostSecrets(c)
 }
 
-// GetTemplates operation middleware
-func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+// DeleteSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSecretsSecretName(c *gin.Context) {
 
 	var err error
 
-	c.Set(ApiKeyAuthScopes, []string{})
-
-	c.Set(AccessTokenAuthScopes, []string{})
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSecretsSecretName(c, secretName)
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatus(c)
+}
+
+// GetTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeams(c)
+}
+
+// GetTeamsStorageUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsStorageUsage(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsStorageUsage(c)
+}
+
+// GetTeamsTeamIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDMetricsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDMetrics(c, teamID, params)
+}
+
+// GetTeamsTeamIDMetricsMax operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDMetricsMaxParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "metric" -------------
+
+	if paramValue := c.Query("metric"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument metric is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "metric", c.Request.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDMetricsMax(c, teamID, params)
+}
+
+// GetTeamsTeamIDMetricsResources operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsResources(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDMetricsResourcesParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDMetricsResources(c, teamID, params)
+}
+
+// GetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(AccessTokenAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -1579,6 +2317,30 @@ func (siw *ServerInterfaceWrapper) GetVolumes(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "namePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namePrefix", c.Request.URL.Query(), &params.NamePrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namePrefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1592,20 +2354,33 @@ func (siw *ServerInterfaceWrapper) GetVolumes(c *gin.Context) {
 // PostVolumes operation middleware
 func (siw *ServerInterfaceWrapper) PostVolumes(c *gin.Context) {
 
+	var err error
+
 	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumesParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
 			return
 		}
 	}
 
-	siw.Handler.PostVolumes(c)
+	siw.Handler.PostVolumes(c, params)
 }
 
 // DeleteVolumesIdOrName operation middleware
@@ -1628,6 +2403,17 @@ func (siw *ServerInterfaceWrapper) DeleteVolumesIdOrName(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVolumesIdOrNameParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", c.Request.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter force: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1635,7 +2421,7 @@ func (siw *ServerInterfaceWrapper) DeleteVolumesIdOrName(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteVolumesIdOrName(c, volumeID)
+	siw.Handler.DeleteVolumesIdOrName(c, volumeID, params)
 }
 
 // GetVolumesIdOrName operation middleware
@@ -1668,6 +2454,66 @@ func (siw *ServerInterfaceWrapper) GetVolumesIdOrName(c *gin.Context) {
 	siw.Handler.GetVolumesIdOrName(c, volumeID)
 }
 
+// PatchVolumesIdOrName operation middleware
+func (siw *ServerInterfaceWrapper) PatchVolumesIdOrName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchVolumesIdOrName(c, volumeID)
+}
+
+// GetVolumesIdOrNameAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesIdOrNameAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesIdOrNameAttachments(c, volumeID)
+}
+
 // DeleteVolumesVolumeIDFiles operation middleware
 func (siw *ServerInterfaceWrapper) DeleteVolumesVolumeIDFiles(c *gin.Context) {
 
@@ -1688,6 +2534,8 @@ func (siw *ServerInterfaceWrapper) DeleteVolumesVolumeIDFiles(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params DeleteVolumesVolumeIDFilesParams
 
@@ -1744,6 +2592,8 @@ func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFiles(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetVolumesVolumeIDFilesParams
 
@@ -1781,6 +2631,124 @@ func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFiles(c *gin.Context) {
 	siw.Handler.GetVolumesVolumeIDFiles(c, volumeID, params)
 }
 
+// GetVolumesVolumeIDFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesArchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesVolumeIDFilesArchiveParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := c.Query("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument path is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", c.Request.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter path: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDFilesArchive(c, volumeID, params)
+}
+
+// PatchVolumesVolumeIDFilesAttributes operation middleware
+func (siw *ServerInterfaceWrapper) PatchVolumesVolumeIDFilesAttributes(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchVolumesVolumeIDFilesAttributes(c, volumeID)
+}
+
+// PostVolumesVolumeIDFilesCopy operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFilesCopy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFilesCopy(c, volumeID)
+}
+
 // GetVolumesVolumeIDFilesDownload operation middleware
 func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesDownload(c *gin.Context) {
 
@@ -1801,6 +2769,8 @@ func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesDownload(c *gin.Contex
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetVolumesVolumeIDFilesDownloadParams
 
@@ -1829,8 +2799,8 @@ func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesDownload(c *gin.Contex
 	siw.Handler.GetVolumesVolumeIDFilesDownload(c, volumeID, params)
 }
 
-// PutVolumesVolumeIDFilesUpload operation middleware
-func (siw *ServerInterfaceWrapper) PutVolumesVolumeIDFilesUpload(c *gin.Context) {
+// HeadVolumesVolumeIDFilesDownload operation middleware
+func (siw *ServerInterfaceWrapper) HeadVolumesVolumeIDFilesDownload(c *gin.Context) {
 
 	var err error
 
@@ -1849,8 +2819,10 @@ func (siw *ServerInterfaceWrapper) PutVolumesVolumeIDFilesUpload(c *gin.Context)
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutVolumesVolumeIDFilesUploadParams
+	var params HeadVolumesVolumeIDFilesDownloadParams
 
 	// ------------- Required query parameter "path" -------------
 
@@ -1874,7 +2846,1013 @@ func (siw *ServerInterfaceWrapper) PutVolumesVolumeIDFilesUpload(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PutVolumesVolumeIDFilesUpload(c, volumeID, params)
+	siw.Handler.HeadVolumesVolumeIDFilesDownload(c, volumeID, params)
+}
+
+// GetVolumesVolumeIDFilesGrep operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesGrep(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesVolumeIDFilesGrepParams
+
+	// ------------- Required query parameter "pattern" -------------
+
+	if paramValue := c.Query("pattern"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pattern is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pattern", c.Request.URL.Query(), &params.Pattern)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pattern: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", c.Request.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter path: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "glob" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "glob", c.Request.URL.Query(), &params.Glob)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter glob: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ignoreCase" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ignoreCase", c.Request.URL.Query(), &params.IgnoreCase)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ignoreCase: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxMatches" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxMatches", c.Request.URL.Query(), &params.MaxMatches)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxMatches: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDFilesGrep(c, volumeID, params)
+}
+
+// PostVolumesVolumeIDFilesMkdir operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFilesMkdir(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFilesMkdir(c, volumeID)
+}
+
+// PostVolumesVolumeIDFilesPresign operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFilesPresign(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFilesPresign(c, volumeID)
+}
+
+// GetVolumesVolumeIDFilesSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesSearch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesVolumeIDFilesSearchParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", c.Request.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter path: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "glob" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "glob", c.Request.URL.Query(), &params.Glob)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter glob: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minSize", c.Request.URL.Query(), &params.MinSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxSize", c.Request.URL.Query(), &params.MaxSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "modifiedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "modifiedAfter", c.Request.URL.Query(), &params.ModifiedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter modifiedAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "modifiedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "modifiedBefore", c.Request.URL.Query(), &params.ModifiedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter modifiedBefore: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDFilesSearch(c, volumeID, params)
+}
+
+// GetVolumesVolumeIDFilesStat operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDFilesStat(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesVolumeIDFilesStatParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := c.Query("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument path is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", c.Request.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter path: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "checksum" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "checksum", c.Request.URL.Query(), &params.Checksum)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checksum: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDFilesStat(c, volumeID, params)
+}
+
+// PostVolumesVolumeIDFilesSymlink operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFilesSymlink(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFilesSymlink(c, volumeID)
+}
+
+// PostVolumesVolumeIDFilesSync operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFilesSync(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFilesSync(c, volumeID)
+}
+
+// PutVolumesVolumeIDFilesUpload operation middleware
+func (siw *ServerInterfaceWrapper) PutVolumesVolumeIDFilesUpload(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	c.Set(VolumeCredentialsAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutVolumesVolumeIDFilesUploadParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := c.Query("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument path is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", c.Request.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter path: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "extract" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "extract", c.Request.URL.Query(), &params.Extract)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter extract: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "contentType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "contentType", c.Request.URL.Query(), &params.ContentType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contentType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "uid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uid", c.Request.URL.Query(), &params.Uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "gid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gid", c.Request.URL.Query(), &params.Gid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Content-MD5" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Content-MD5")]; found {
+		var ContentMD5 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Content-MD5, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Content-MD5", valueList[0], &ContentMD5, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Content-MD5: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.ContentMD5 = &ContentMD5
+
+	}
+
+	// ------------- Optional header parameter "x-checksum-sha256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-checksum-sha256")]; found {
+		var XChecksumSha256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for x-checksum-sha256, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-checksum-sha256", valueList[0], &XChecksumSha256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter x-checksum-sha256: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XChecksumSha256 = &XChecksumSha256
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutVolumesVolumeIDFilesUpload(c, volumeID, params)
+}
+
+// PostVolumesVolumeIDFsck operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDFsck(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumesVolumeIDFsckParams
+
+	// ------------- Optional query parameter "repair" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repair", c.Request.URL.Query(), &params.Repair)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter repair: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDFsck(c, volumeID, params)
+}
+
+// GetVolumesVolumeIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDMetrics(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesVolumeIDMetricsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDMetrics(c, volumeID, params)
+}
+
+// GetVolumesIdOrNameOperations operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesIdOrNameOperations(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesIdOrNameOperationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesIdOrNameOperations(c, volumeID, params)
+}
+
+// GetVolumesIdOrNameRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesIdOrNameRuns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumesIdOrNameRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesIdOrNameRuns(c, volumeID, params)
+}
+
+// PostVolumesIdOrNameUndelete operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesIdOrNameUndelete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeIdOrName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesIdOrNameUndelete(c, volumeID)
+}
+
+// PostVolumesVolumeIDUploads operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDUploads(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDUploads(c, volumeID)
+}
+
+// DeleteVolumesVolumeIDUploadsUploadID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVolumesVolumeIDUploadsUploadID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", c.Param("uploadID"), &uploadID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uploadID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteVolumesVolumeIDUploadsUploadID(c, volumeID, uploadID)
+}
+
+// GetVolumesVolumeIDUploadsUploadID operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDUploadsUploadID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", c.Param("uploadID"), &uploadID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uploadID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDUploadsUploadID(c, volumeID, uploadID)
+}
+
+// PostVolumesVolumeIDUploadsUploadIDComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumesVolumeIDUploadsUploadIDComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", c.Param("uploadID"), &uploadID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uploadID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumesVolumeIDUploadsUploadIDComplete(c, volumeID, uploadID)
+}
+
+// PutVolumesVolumeIDUploadsUploadIDPartsPartNumber operation middleware
+func (siw *ServerInterfaceWrapper) PutVolumesVolumeIDUploadsUploadIDPartsPartNumber(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", c.Param("uploadID"), &uploadID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uploadID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "partNumber" -------------
+	var partNumber int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "partNumber", c.Param("partNumber"), &partNumber, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter partNumber: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutVolumesVolumeIDUploadsUploadIDPartsPartNumber(c, volumeID, uploadID, partNumber)
+}
+
+// GetVolumesVolumeIDUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeIDUsage(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeIDUsage(c, volumeID)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooks(c)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooks(c)
+}
+
+// DeleteWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhooksWebhookID(c, webhookID)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -1907,14 +3885,27 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/access-tokens", wrapper.PostAccessTokens)
 	router.DELETE(options.BaseURL+"/access-tokens/:accessTokenID", wrapper.DeleteAccessTokensAccessTokenID)
 	router.POST(options.BaseURL+"/admin/teams/:teamID/sandboxes/kill", wrapper.PostAdminTeamsTeamIDSandboxesKill)
+	router.POST(options.BaseURL+"/admin/teams/:teamID/volumes/cleanup", wrapper.PostAdminTeamsTeamIDVolumesCleanup)
+	router.POST(options.BaseURL+"/admin/templates/:templateID/envd-update", wrapper.PostAdminTemplatesTemplateIDEnvdUpdate)
+	router.GET(options.BaseURL+"/admin/volumes/orphans", wrapper.GetAdminVolumesOrphans)
+	router.GET(options.BaseURL+"/admin/volumes/redis-databases", wrapper.GetAdminVolumesRedisDatabases)
+	router.POST(options.BaseURL+"/admin/volumes/:volumeID/metadata-engine", wrapper.PostAdminVolumesVolumeIDMetadataEngine)
 	router.GET(options.BaseURL+"/api-keys", wrapper.GetApiKeys)
 	router.POST(options.BaseURL+"/api-keys", wrapper.PostApiKeys)
 	router.DELETE(options.BaseURL+"/api-keys/:apiKeyID", wrapper.DeleteApiKeysApiKeyID)
 	router.PATCH(options.BaseURL+"/api-keys/:apiKeyID", wrapper.PatchApiKeysApiKeyID)
+	router.GET(options.BaseURL+"/audit-logs", wrapper.GetAuditLogs)
+	router.GET(options.BaseURL+"/capabilities", wrapper.GetCapabilities)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/limits", wrapper.GetLimits)
 	router.GET(options.BaseURL+"/nodes", wrapper.GetNodes)
 	router.GET(options.BaseURL+"/nodes/:nodeID", wrapper.GetNodesNodeID)
 	router.POST(options.BaseURL+"/nodes/:nodeID", wrapper.PostNodesNodeID)
+	router.GET(options.BaseURL+"/openapi.json", wrapper.GetOpenAPIDocument)
+	router.GET(options.BaseURL+"/operations/:operationID", wrapper.GetOperationsOperationID)
+	router.GET(options.BaseURL+"/s3-credentials", wrapper.GetS3Credentials)
+	router.POST(options.BaseURL+"/s3-credentials", wrapper.PostS3Credentials)
+	router.DELETE(options.BaseURL+"/s3-credentials/:accessKeyID", wrapper.DeleteS3CredentialsAccessKeyID)
 	router.GET(options.BaseURL+"/sandboxes", wrapper.GetSandboxes)
 	router.POST(options.BaseURL+"/sandboxes", wrapper.PostSandboxes)
 	router.GET(options.BaseURL+"/sandboxes/metrics", wrapper.GetSandboxesMetrics)
@@ -1922,14 +3913,23 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID", wrapper.GetSandboxesSandboxID)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/connect", wrapper.PostSandboxesSandboxIDConnect)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs", wrapper.GetSandboxesSandboxIDLogs)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs/summary", wrapper.GetSandboxesSandboxIDLogsSummary)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/metrics", wrapper.GetSandboxesSandboxIDMetrics)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/pause", wrapper.PostSandboxesSandboxIDPause)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/refreshes", wrapper.PostSandboxesSandboxIDRefreshes)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/resume", wrapper.PostSandboxesSandboxIDResume)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/timeout", wrapper.PostSandboxesSandboxIDTimeout)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/volumes", wrapper.PostSandboxesSandboxIDVolumes)
+	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID/volumes/:volumeID", wrapper.DeleteSandboxesSandboxIDVolumesVolumeID)
+	router.GET(options.BaseURL+"/secrets", wrapper.GetSecrets)
+	router.POST(options.BaseURL+"/secrets", wrapper.PostSecrets)
+	router.DELETE(options.BaseURL+"/secrets/:secretName", wrapper.DeleteSecretsSecretName)
+	router.GET(options.BaseURL+"/status", wrapper.GetStatus)
 	router.GET(options.BaseURL+"/teams", wrapper.GetTeams)
+	router.GET(options.BaseURL+"/teams/storage-usage", wrapper.GetTeamsStorageUsage)
 	router.GET(options.BaseURL+"/teams/:teamID/metrics", wrapper.GetTeamsTeamIDMetrics)
 	router.GET(options.BaseURL+"/teams/:teamID/metrics/max", wrapper.GetTeamsTeamIDMetricsMax)
+	router.GET(options.BaseURL+"/teams/:teamID/metrics/resources", wrapper.GetTeamsTeamIDMetricsResources)
 	router.GET(options.BaseURL+"/templates", wrapper.GetTemplates)
 	router.POST(options.BaseURL+"/templates", wrapper.PostTemplates)
 	router.DELETE(options.BaseURL+"/templates/:templateID", wrapper.DeleteTemplatesTemplateID)
@@ -1949,8 +3949,35 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/volumes", wrapper.PostVolumes)
 	router.DELETE(options.BaseURL+"/volumes/:volumeID", wrapper.DeleteVolumesIdOrName)
 	router.GET(options.BaseURL+"/volumes/:volumeID", wrapper.GetVolumesIdOrName)
+	router.PATCH(options.BaseURL+"/volumes/:volumeID", wrapper.PatchVolumesIdOrName)
+	router.GET(options.BaseURL+"/volumes/:volumeID/attachments", wrapper.GetVolumesIdOrNameAttachments)
 	router.DELETE(options.BaseURL+"/volumes/:volumeID/files", wrapper.DeleteVolumesVolumeIDFiles)
 	router.GET(options.BaseURL+"/volumes/:volumeID/files", wrapper.GetVolumesVolumeIDFiles)
+	router.GET(options.BaseURL+"/volumes/:volumeID/files/archive", wrapper.GetVolumesVolumeIDFilesArchive)
+	router.PATCH(options.BaseURL+"/volumes/:volumeID/files/attributes", wrapper.PatchVolumesVolumeIDFilesAttributes)
+	router.POST(options.BaseURL+"/volumes/:volumeID/files/copy", wrapper.PostVolumesVolumeIDFilesCopy)
 	router.GET(options.BaseURL+"/volumes/:volumeID/files/download", wrapper.GetVolumesVolumeIDFilesDownload)
+	router.HEAD(options.BaseURL+"/volumes/:volumeID/files/download", wrapper.HeadVolumesVolumeIDFilesDownload)
+	router.GET(options.BaseURL+"/volumes/:volumeID/files/grep", wrapper.GetVolumesVolumeIDFilesGrep)
+	router.POST(options.BaseURL+"/volumes/:volumeID/files/mkdir", wrapper.PostVolumesVolumeIDFilesMkdir)
+	router.POST(options.BaseURL+"/volumes/:volumeID/files/presign", wrapper.PostVolumesVolumeIDFilesPresign)
+	router.GET(options.BaseURL+"/volumes/:volumeID/files/search", wrapper.GetVolumesVolumeIDFilesSearch)
+	router.GET(options.BaseURL+"/volumes/:volumeID/files/stat", wrapper.GetVolumesVolumeIDFilesStat)
+	router.POST(options.BaseURL+"/volumes/:volumeID/files/symlink", wrapper.PostVolumesVolumeIDFilesSymlink)
+	router.POST(options.BaseURL+"/volumes/:volumeID/files/sync", wrapper.PostVolumesVolumeIDFilesSync)
 	router.PUT(options.BaseURL+"/volumes/:volumeID/files/upload", wrapper.PutVolumesVolumeIDFilesUpload)
+	router.POST(options.BaseURL+"/volumes/:volumeID/fsck", wrapper.PostVolumesVolumeIDFsck)
+	router.GET(options.BaseURL+"/volumes/:volumeID/metrics", wrapper.GetVolumesVolumeIDMetrics)
+	router.GET(options.BaseURL+"/volumes/:volumeID/operations", wrapper.GetVolumesIdOrNameOperations)
+	router.GET(options.BaseURL+"/volumes/:volumeID/runs", wrapper.GetVolumesIdOrNameRuns)
+	router.POST(options.BaseURL+"/volumes/:volumeID/undelete", wrapper.PostVolumesIdOrNameUndelete)
+	router.POST(options.BaseURL+"/volumes/:volumeID/uploads", wrapper.PostVolumesVolumeIDUploads)
+	router.DELETE(options.BaseURL+"/volumes/:volumeID/uploads/:uploadID", wrapper.DeleteVolumesVolumeIDUploadsUploadID)
+	router.GET(options.BaseURL+"/volumes/:volumeID/uploads/:uploadID", wrapper.GetVolumesVolumeIDUploadsUploadID)
+	router.POST(options.BaseURL+"/volumes/:volumeID/uploads/:uploadID/complete", wrapper.PostVolumesVolumeIDUploadsUploadIDComplete)
+	router.PUT(options.BaseURL+"/volumes/:volumeID/uploads/:uploadID/parts/:partNumber", wrapper.PutVolumesVolumeIDUploadsUploadIDPartsPartNumber)
+	router.GET(options.BaseURL+"/volumes/:volumeID/usage", wrapper.GetVolumesVolumeIDUsage)
+	router.GET(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	router.DELETE(options.BaseURL+"/webhooks/:webhookID", wrapper.DeleteWebhooksWebhookID)
 }
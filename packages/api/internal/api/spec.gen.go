@@ -18,155 +18,414 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a2/cuJLoXyF090Ny0XY7j3NwJ8B+cJxkjnfsjOG2MwvM8Q1oid3NtSRqSKrtnsD/",
-	"fcEiKVES9Wq3H0mMA5yJW3zWi8WqYtW3IGRJxlKSShG8+xZkmOOESMLhLxyGRIgzdkXSww/qB5oG74IM",
-	"y2UwCVKckOBdrc0k4OSvnHISBe8kz8kkEOGSJFh1lutMdRCS03QR3N5OApzR38i6fWj7edyolzmNo9ZB",
-	"7ddxY6YsIq1Dmo/jRszwgqZYUpYe0YRK1SgiIuQ0U78F74JjfEOTPEFpnlwSjtgcUUkSgSRDnMicpygj",
-	"HGV4QYKJXtVfOeHrclkxjOuuIiJznMcyePdqb28SzBlPsAzeBTSVb14HkyDRM5rPCU3NXxO7fJpKsiC8",
-	"tv7P5EYC/pt7OMi5YFwtWUjMJZJLgmIqJJpzlrQsOy2G6wagwGl0yW5asVJ+H4cYSXDSOqj5OHbEJIux",
-	"JB2jFg3GjbxicZ6Qw+h3/hlGqsP/C3xHhx/QixWLv97c3LxEjCOYduJbiRlw3DpuVWORsVQQEBtv9/bU",
-	"f0KWSpICZeMsi2kI1DL9H8GAUsrx/oOTefAu+D/TUhZN9Vcx/cg543qO6tbe4wipJRIhg9tJ8Hbv1f3P",
-	"uZ/LJUmlGRUR3U5N/ub+J//E+CWNIpLqGd/e/4yfmURzlqeRnvGX+5/xgKXzmIaA0X88BBXNCF8RbjF5",
-	"a6kcyHj/j9kpWVAh+RqORs4ywiXVNI6vxT6cfOqEipqct//HDOkG6DeyVhw4Zxx9PDhFuEJEwaTOThM1",
-	"tpqYpf5h9Td0vSScgERVo3KzUkQFilmIJYlahp6RkBNZLN4/h27k7mD48vUP9VHP1hlRh1ix0MZAJFWn",
-	"zZ9qjcHFxCPtSon0p/46qaPBu0EXoOW47PJ/iCa0/Sih6UyfFr/ROD4lAg7JOsrnmMYkOmB56jmtPxen",
-	"tDl3iEByiSXSvdQReEXjOGiepZNAfRg1sMhhc/M8jtdI9w68h7QLMXeWSWUzF7eT4L1Si47Y4mPqJfeY",
-	"rEjcx2VHbHEE7W4nQUKEUKpJYz9HbIHMR2R520NEQpKs2XkmSYZoClQPihzKOAMS5UQdoABnUDLYAhHY",
-	"io9AaUKExIlngjP7SQG8PlChMEVYkh01StBLpsVUJUgmBpoF2GcSy1ycEmxkWg30Ginmr0KF+/Ni4oEs",
-	"0S3r4BAwA+J6ikkAmmQfOqskUTB2gDnH604cHxv8XlO5bM4/QWHOOUllvEacZIxLmi4QS2MtZEAWmx4j",
-	"KcNhuF7M2MUrLBycnLdw38HJOQoZJwKWBlvRXBj49OcOjXmizraUhNIImiaeFamwXPppkuVS0b0gIUsj",
-	"AeozrMZAEqnOCM8l4eh6ScOlu1QkliyPI0RuMspJ58L3eqWIXaVPkB5wgiXROuepUc0a20y7FFX1Eb3I",
-	"U/pXTuB6o/TtCRJxvkB61S8DdfWQknDV7f//iXf+vlD/t7fzy87F/zX/uviPXvTDMto3Ee2X19rmHkLT",
-	"RvYIEH03RlKNgqCTPumGCJJJQD1qxWGkjsw51SeCQrI7hzt0nlOvBpBgcdXH+eUsx1hc0XTxgUhMY6H6",
-	"+/Gnrh8tK2qKX/9d8WxJkD7RDHh7BqohFHZrLja2B+x14qDrokTwGcHJ/smh0YA2w+/+ySG6IuvxqDUT",
-	"vIe5cRz/Pg/e/dmNE7Xec6E48mISpHkc48uY6LvZYFox6x1CJlc+zfAUX6MVjnPSHLAxQIyFPBfEs64j",
-	"LIzAkksqCiBeY4FyAZLbC8Tqnh+Fslu366NF3dCQoCHMKiV+oOLqmEhOQ9GkwYisaOhZzwf43V7hG0CY",
-	"05iItZAkOfOq4Z+K70j1RS/I7mJ3gsiNfDtBN3Px0isz1OF4wqjvhDxW31CmPlowRRT27GF8ieP3a2k3",
-	"WOEr9Q2JDIdEHXSX0MqlU5rKf771qs+KaFpGVQS4yaB1XaHc/8QipgFqdyGVvVpUz+jf5Pi9B6NUXCFB",
-	"/yZ1HUOt+Zi+H3tiT4KP6eoLNibcKKJqHhyf1MjLXcLHdEU5SxOlSqwwp4rPfCpPk+w/pqvoC+HCe1s1",
-	"HyxdkHQVIZ6nqdL3jBbfOvYk0Jf2pnBmkYeuoTGCbx5wNUHUqrvqWfs43EzkKpGKsw7TOWuuOGGREjne",
-	"8wSEoW5grEpG3A07SPwySy0FMY4iykkoGV+3iguw/jX753GM1CfQ4GmKtFnQq43Tv9sWACRt2Q69KBR8",
-	"4JuXNTS18LbflgA3EmRMANZsoIZV7Gn33G9DMEAxFlBo69MI1WaOqJCnxsbpsQyoHYFtd8i9qiAUz5Uq",
-	"bbelnxQGd6NQKliq9tYH0L1ZvUbv/jhLDhO8IK61K6Jq7kTNqJkwwVmmxtW2r7a9uTazSbAIs7aGvx6c",
-	"OA15MXNLa5ISjuOih6JcjYH1Z2NCV7u6nQQsJQOUKXeZt5Putu5Ke9vW16kEgztAg3QE4eo42Q9Ddcb8",
-	"l/CJ0Zlug0wj9F+z3z8D9n89OHkAe5zC4lB7nGc7PpKrw6kBlgwLcc145GMD/QVJptTF4pDiJTVtHQLF",
-	"2BeewXNBuF8Mn5svw5fqB2oxw6SEiw+qrcpt80TC4opEX5Qqf8LJnN544Ay/g0auhLjugVbVE10LIsbb",
-	"LgHOPLN87p1H/37HebLuTYBdiFroiMaQ9ihpjAuXnSOSLnynpP69e4ltGqVZcHWGiQcvPhgqoaIOJBK1",
-	"GpNwTLFHz9tXPxcrNl5H7wU1piSV2mEZkYwT7VEwV6++e6bu7R03ywtLW5cgLSxyt+pEd3Xnrl6Oln2r",
-	"uLf1Bo+ul6Sif6JrGsceC1mn8kWqum+nA8ppCtpnwvi6f0PHth30kTjCstfXZWji2Davu8r7kNehkYMT",
-	"n4yBKhbIdBoMVSEVTQ7b5AzaNlzsfVu0rbUdVRtMqais3NzU+0W067p3Qw4KDnLB5jCAQwQVErd0awFR",
-	"JTNgfetm8fpWwLcAR412kMRsIZyjLCKX+QJ8/3MWTIJrzOGgg0uP73Q7YgvxAVRq7w2v+OT4S4zjy1id",
-	"L4kJVwFgFso649eYq18ucXgF/2zMPgludlT7nRWG40+ojpX1fCpGqfz8vhjSbGDGcu6zpejfRy5dYZxx",
-	"DMd3ptAiwIc1fPl61jNnmPLXE2fA20lwjMMlTVuulWGW7/NwSSUJZc6J33mBnRZ2o6m+vPqE8yec0Hjt",
-	"H2oO3wYMcswiH3WqMRL1aegQ/piWcpjUMc35x6rf2osNOuuszTdpwFUj4uaM4ERb6zzSj+AEJfDROL0c",
-	"v1/TzeM4H7uP1oY70swxxiPp+DvPU5+S1DmJ0slUN22wfWEdUIKmIUEkY+Fy4E0eFB2/1d/EnVVNyya2",
-	"h0R2OcZgtKArkiI1MF9hx5+uw+Q6HbBVONglAXrDrMNY1ogaOT44QSFL53SRc30jb5rKWszVpbZ+7OgA",
-	"dfep+rKJNfDV6//ng/1nct3pz7qrT8frW9PzdmioMbv+CnhMifyqJ/BprDG7LkAgWbGSJUG28y76Qyke",
-	"gkjVYI5jQSaISnRJlnhF7LmeEKS0kYyEdL6m6QJFJF3/nkOfvV3433TPUllK5DXjVwbLu+WWLxmLCQYl",
-	"DueSneBckIpfXk/fDBxjCVY3yzheo0x1qqob2nULuolxsPpmJKVdt0fZhGZKadSE3aljhtkd1UsDrIE9",
-	"P+vWBwBZUPhI6D2+ZvA7wnGMjMciZEmSp9ZQCoK2oa064BqnFFoK7rwXVZz0Npj2Hz6xrcgqpiuvUd9I",
-	"0d3xln0b8NkV6qmYREocLq1zZ8Xir/gyfPX6zctddKq3KYxNF9w3J1gud73332qbVu8PlktEU0Gjcptm",
-	"7qnCNXhgpopcygVEiM6R3Y7SvjPOVjQi0S46zoU0AcOAY2eMCYJh1H+TVE4n6sI91aOI6e4Ybd3Ipy43",
-	"8PYcgqVENM6LmiYX50ISPoxOTWOv4sQSbyj5AfxuB2A8XBIhOVh2W53Tn6zlqCcczdyUIOxmqMdOd5np",
-	"KDYyZhZR9Bk20zC/eJsimlTV704p6jTV0tS6dbt6KXKwHuDKK4PxNpeUJThq3YkB44gYQ+unY6kLogrM",
-	"W1xroribQ2RX/5ymIZrZyWty0T+LtjQfpkLiNPTKeGs3p6ZNaQLsxbwJPxuAPh28B8JyoBu0m//qksO+",
-	"LYGYguamJ47wKJZdw3dJjk3Wq7J7C/LKvRUypsocVrRpg7NHwOFwSSIIKPRw+xEVIJx0K223EIhGNdor",
-	"nGst9v3Si/YsT5/l6YPIU9JBzX2idJCvuGrm95D6sxgcIAa1nHNlUL8g9Em8Qor6ZJ8TyVV/RhNZm4po",
-	"mIPUNRjo8uDkvItvi3aoCEkeeBwXPbVZoSVSah9inKozaQP12HAs18Xji/Eq3zOWwdXjlYwwy08ID4lX",
-	"tVAAV4PnEIWe6XY6SGLI2BEVV8IXeSf1Ww6DSx2tru5ZqsM0KQPhhnK3GwDoja9X8D/rjZpLNYFtgizd",
-	"67w9gu6zM7b10W4cR1ch9hbKrKC2uUCPB8UBkMWd5clZIb/qrwXgPUJV+pXefhypK13EMU21JT/Usfv6",
-	"jzxdEhzL5Xqgzb9cyKkZufzlQzlH+eOBO1v583k5b2V7B0ucLrZ3q+wNDR5/KNTIwAygdnFKRJ50+bGr",
-	"NrbuQ3xLVrbHtfEoYH13bv2IJZh6jvz3WBCkPzrvFQtrLsfzOQ0RFcaqSy/jQZHeJF3VH2jUAOI+vACx",
-	"BbI6XUVVG+J2vfrbcrM/nDN7EhgcdEITfi7towqUBl/pophjRTHKOLtZ7/ZjcAMfet0Jblik7cL5HP/y",
-	"CEz5AOE2T5Drn2N5nmN5No7lMXs/Ygt/NI/2wVdDChBOIxTTlDQuk/CjPyqIpp3PrR/pSTQsuAqHlgfo",
-	"ZEVSaZ80DaAmNVLRBd5FEWN7bHsR02ZVLD32d33T/khALkHnvkE3AKkB34WyP1raMhUscKV3am9OQkZa",
-	"qRYyIpxr+lQy+SuwjfM3SSNvuFm5FNH/Er56o+M5hOvoiLemABx0Ia+ToedSHpulNSjg7nM2p6th1cTy",
-	"OXBw0HfsnCnDXn3ZHr2nRWUSbwDUsRsyNFRctVuKPjdtRMOedYVZfi5IdBK2vOXvsgjNY4ZlM6BIS3Qw",
-	"MrQZYCJ4wdf6zLDd/KI6+h/JwqPAVoNLp0Gnc6kdZqLOQf2rPO4xDHU87Popw+BGBKc5yoVD1CUuHFQ7",
-	"dOQSqyMbqjE3/lis3325J6wzA1qQCB0cfjhFlzELrwRiHB2eIBxFHCzoWtlecFDB9SViF+2bfmUrHF/j",
-	"tUASXxGksE4iomDIVoTrgd3Wu6PcfrDIk/wypuGZXkDFhuOjrJkOB0O0IgHR+emRcKKAy4uQTlMCAq76",
-	"WsgfImZCzNrhGpGUjgbrKKAkWFyZZBz/YsKzFAuCJRMSnt8YJRquaJekvEhBIJYBkInOFN6zoqE4GSo8",
-	"zdPBN/Uzq9br7+05FHwXmD98d5fyFjD0uhmVKXkGHOCnefqx6KL7D1ydkCzLxtyq2q+A5zp1SmGHLK5L",
-	"mxtyy+2VPr6uK1qBOSAcyayruU/DqFiInctX9VZmPXtOJoVOgvvoYrGWzQJ+b8GEVWnL7FqFxZiYF+Ji",
-	"mcuIXaddimwJtQ4fBC7ZKq88O9R+Y3j2ZzJj2AV2TDmzd+7mdKSp6bXO1TEDEX9QuWzNXFHxjbdposOs",
-	"HkrXvG0hDqP9nhGceKQKpI71mIhMshFrsJeqty/3jvhgjw0P+8olKbtba4M5Z2pDOodBfzhh22rKlKT9",
-	"1hDfCA07h0liah6VGmC5u7aQfc6Q0+oG++kT3Bjq8SZZ2tJjmZClJsPZrD3g5mxJnKCCsosTgVNj9wEX",
-	"STcO7tQrUL2JGbV1EFKX6TvCoAvm82Wo7zLkoQMPjizlgRRomhAT4yqqJRNRP9tt5sKvKg2THqZ3j+jw",
-	"8ZJem16/8Ur5NWXS5tUiPr/W8GsCBF32WmMAL1Wzu5JqqrMcxldOcvY+aCoB66Q6Ne9ZFSvrN2Bd/rvL",
-	"Mq9mn8S0AHdScW7qqes5FUufSgV6Yy8mWz8aN39gv6nPTKF2luHrdDSwgCjudopu4K/LwKjQpwuaZVKB",
-	"dHt1lQd7gWM/uFx79DRHSRQKKpvyYR0uHea3jXxsPmrMs2gDmtdo1F039HC418KyqMMAn5xBpsuu7jZc",
-	"BqtTagU/FaFZ5YZJIayrosgV8CBvmlJ+hICEpkNU1XuVZVosbyLIHl7uzGlKxXLcrmyfwdvaRMCIuxxV",
-	"g1mw3NTd+a9kOY9NpsZPHp5scMInGpPzLGbYwxMZJ8Ib6evK3zmNQfbiGAI4kelkX/RC+LdX5ObcoxWe",
-	"89gJjoGxS3twDusE61YvnOzaGxv2J3XYgP2bN9OhGcPfFxlCkCj8p1tLD156SgcsYJSyygfZZZu51e/K",
-	"aNs6NYcdZQVf+d2+lTUesYVoz084ChPbJwWfF7uxg9aE4XcO5dsk5I6FV4RDRsmmZ7b45pgV2qff5DQA",
-	"AXaQRF6bdbRG4ZKEVxDThlMwsZMbEuaSWFlXqFplwHOrsACThXcuuFdvaZYtWzAd/LQR0pfXT4OUNsH/",
-	"lqGlt90KqDfPgOoGFDCCj57mrEgr1ZX9w9VSrpcstopYqVDAQMBjPE8RJwvMo5iIAtbtysvcJm/1AEH9",
-	"bHNPYoEwusSiKbTamXbuSwzbmdm20cGM4hq1WryFd1jnjycuhSRZb7kX+85Ute2az84y6Ci3+JhJknlP",
-	"8oav1acr9Ty4aizNeiHhb+2GvMbUvICy77Hak9TZJRyRBQ7Xz5bTu1hOn+2ez3bPZ7vns93zjnZPV4ky",
-	"iqa9n7YqnPcqoe9fcj4cszysHaKgGx9uZ711+qqHvS3Y10yEwHttFPt8kSdKVpXvbdXsY0gBqiD8CwtP",
-	"KjP1qwUZNCvCmp2Zmjry+CuAGmorun93Wvv2VfuyzLs4PQeZ0GqQeSg6v3WW9AeVyzJ90EPLjo4sLyZZ",
-	"kccSNErd1s4gXyqjB1GtHlMvedYxnraO0RD/7QpEv9KgDw8tYDZIvUiuddJny26j8y9qD1O7pdxfD+kE",
-	"6zoPhd/H1BoaWA1JaWCN7qPey9R9SrpiEczmC3/WuUBHRW0WQek6meZGUqS3tGdrUbhPNCYDkwbpo9mt",
-	"STWEJdUgCg3d1ecMnmpToBeAp4F5rjt41gfjDZh1Ulbpb4v/NxN0hf/XSMop/N+oVehuqkluNoMvleuZ",
-	"OtU0fJ2H+vu55qlLgjnhn+wOteD+alNKw4kIAhualQteSpkVZborA1K14yXBETTX1Bf89w403Dmrpqo2",
-	"Mc5qHPhX3xgnhzu/uYKl7D/LM3yJBXk1ZC22cftybIvXIA6HjlY54uxgChXUeJUllerMDY4Zz21iTSUu",
-	"ncxm74K93Ve7e1A1KyMpzmjwLnizu7e7Z4qiASKnGk87gCctI73PiHRZVYRRSq7r6cKVDILw78NISVMm",
-	"pEMeItCUSIR8z6L11mr+15Ke154RGBMQNycBbOz13qutze4pIwwr6MhYYcveutXdFXLe6mX5ZiuWP1WN",
-	"bifBP/b2+tuqRi7bghnNR9Z/XtwqfscLyGNVJYQLNUKVOKbfcLndww+3mkhiIr1lTNXvCKfdtKKbudSy",
-	"704BhMpxQiThotUaWDaZVhYIVsEaBbztSSui93M3JL3Vs/S1ffsoCFXCc6pUaDH9pt1rt9MiwH16ReO4",
-	"XQb8RuNYuO8E3SKi8MyQQn51EF4eoQASXk19BhMXsd5q3CaqPa8KdIpI9adRkYzoLF68VAXAxGHmvqDt",
-	"JqnsbU1YwMbNbtVeT4nIY+kTGDOH7JB+m1bC+mnSYf3c1jQo8iTBUPBRbdhDM7i4x1lqVeNYKs3ozhVZ",
-	"AyIWpO19rRoUXmiZa4JoUN2vRGp1QB9Cd0DvwNt+ceNputa6cW0LEXk29chHhFeFqQkaiy51BRugPrj7",
-	"80sKB2n3ojm4mHoUxaG+AI+wq7yte2J6wziicFl6+k2rswP1h25aMeqDppZ9M+54pcF2HKYvVJDzvesL",
-	"o7kby9BjQdEWnz50najOW8bW9sVDw3o1SELs9RCKuWL/JISiOF5ns209wv8Fn3Xwju/g1t+DIYA2rgyd",
-	"wq6A7zjoApKnKYvIAK1DN/Ms+rP5sB1dY1gQBNScgUrUm2scekMPdqj4dUafJggLm37T+eFvWzHzK5E6",
-	"Tbape+lHzGebZX6cxDHJ6W8nY9Iswy3lr5zAm11zTanksH8SNxOnqMdgeilSan9H15E6abWqqZBrGwkn",
-	"d4fJHt5UUrdBUvd0hDWSh9+aM6xXtzG4tRAANwEM8T2cXMPFSiWHSbest9U93FoTDfHiPlvvNGMUOflA",
-	"NBQ5dOY0tkGj5TUZSqyhf0N9+P/El+G/87291//EWfafGWfRv4OXu+gjDpegXuA00vU1BUpyIdElQeen",
-	"R4ikIYtItNsikIo8ta482rb8GXmc1Uqi3O1cayIPiHFvCDHuPeB56Pgs/rxQB83GSlg1e07PZdzmJYLc",
-	"ujVvdVPguUR+T/fyAu0PeymvTNuUiJ40Yx5p+JMQVUV8Tp3CTe1i1C2oouPshgnT47KoTpdMPWBJgncE",
-	"UY0UauJqhSZ0+AHi5xekspJgEpCbLIaCjSamyycizSBfaSQ67cvtIUcJvjnUH1/t7dWE2STIwaNrGgCd",
-	"36vC503xdTeRqpP8JGWdm5+UFb4VWe06LVvaHu6kaPOZtAo0zZxMeeNUzDLH3kCzVk3QWe/D09f67uvw",
-	"bL1plgfn5RrBna1dht0TArcuETa5BYqybt1PQxatPD81RaTa3aenADtREE+kU7ftosNqdloqdHGjCKqc",
-	"2zytXJdS2kVnZ0eqCURHkhsJeUh3uxW2gghN6ak70+L2lT+zslEK4N5jKIA2/4TNqHk7eSxV1FDEg6mi",
-	"Pyjf2uwJreLeKYkghsn6I91yYx6beB+fKq1LegpJCCSXWDpPDgohTVOU0DimJoVfm1ky50JnvG3aJG0g",
-	"ZGdJs8Zyj/GNau2EdXYts2VZMdVlOctVleXalCLdLLumZzWf+1bsmzKinIQmj+kwdlWY/lD08oDik7bs",
-	"XK51NQ2kloJe6EoaiHGkS2m8hEMgZbKMo5kY+OiAGwW/NiuOWwBklJCpFlF5CC0DGGMTHUMz37PAUgKr",
-	"787tyqyyLu0AsdV6376D5CoSiWqpVb7swryosK34kq9wPHEKK06gqU5VXyYobRNhtv7MHSSYl7Mgwasr",
-	"gAZsjaTRZhsbt+SLh4hoqqXq3tQU6zLyAxgKflC+z2yhVP/1Auqo1tK/D7kT6PqrD21e0Deciu4KTowQ",
-	"p85t5z4x/3bvlyFtf/nOqISTOSdiSUTXRRSaVNhS3ySVikmlMNnNdfXbgWR0Wsz7OJfL6lOoKNcL9kSW",
-	"mS81MVyUiCjU0yuSSYSh/m8pvV01880/+/XMxmuaYX7YmhjVkH0go8sToGBhn7cV5NudM12Xmd5A9umO",
-	"T9AcUiuc/XT9Ye1GiGepPYLmnaLkfpk9I9KtbF4vSb6Lzvzld9GNFV2Ol5eWJSEM8e6iAxzHYGFYUqGU",
-	"tCWLUJLHkmYxMY+m2Yrwa06leT99dnY0QQSHusIDyoXuTpAt0ODUCBSl1q9aZYyq7wwlBIvcZGG1W7Oy",
-	"e6hR86wo3fP4506luHz9QbfaXHmUlPhw4WXSh7UeTM2iyoOqzjdrMahVXmzlfBKGNIsS02b0n01rh1dV",
-	"w56seC/kZ+bDQ0bbwEvTOwbZ6A09nDO3/vS4C42VADD1m4Oq8gHcEIuKG8TgpKHwY1E/cNvUnmKesz0b",
-	"U34sY4pTNOlOlhRZFli6ZzPKmyFt3zwZgdzL4NME33QyOdCQcV74GN6mi9JRTJYih4mBY3zzLAmevCSY",
-	"tJS+lkwxIadkRSpUAkG3Jp6sJcSWQ3qd9tAxmx21rIL1VTTLYOka6185FMJ62FcCx/jGlV3PsmrbskoH",
-	"3Q7SHW1Tr8gpP9bEjI8yi0fzbYw4OMv2xUPrrCZI+c56q4XXIwYibqzNlquvBnp3W8pq77A7or1daroP",
-	"C5e3OsQgO9frra/BJJZuMXeVZXVwGJJMWrfEk4ty3QYpVQSSUqBsqrihz7VbSEq3KIjqrJKCbqROVHQd",
-	"7nqqZNDbxqPtJygDuo8OJ5NlB5rcY2RLOJr0ts7wgqawhs/kRppsSmO6HUGo0L3qQJ5MpSMVIUuAVC7B",
-	"x2YSJn6PQfC1s6czJ0D7IaO63YtAuL/Dqpo6d+PEAI3ko63JAZ7+S4oHVmBOiT6OcTpQffk+COv71YJ+",
-	"AM1mqkXx9JtJin47xves68K45V4GEaM+Q96XWdjv8Xy1ud49B+Rrv3TSyF7iouT5j4vr/vjvWob7tjDw",
-	"PiRvFBS+IaKfA8i/4wBy717IisRjBj2CDh7QzljOQzII+3POkjbLMIwyapd64ns2VbZXzdxMW3dY/mm6",
-	"s/3Scqiuvw35WZZcHSpB25L09EnQma2Y+jgy9DCNyE1ZesMI1IJCWtkIbnz16hE+HmcL8ft8LkiL0Nob",
-	"HfTxo4jVjaXfg4kaqPa8kYh5litarkBW/um3JRbL7jxfODWVFlBM0ytr0MJcl11QqMU0dTgTr4n+NlRr",
-	"+1QUEbqjpPFkKl7qYdudgT1FiwZ5X17dD407Jdxb7oguXkx1dWZ/BJo3WPoBHn/cH3+sXtvIxB2epz1O",
-	"QRtzp1qiFzQN4xyC+IVkWUai6ZIKyTgNcfzSR/1fXpsoytM87c2zYp4ywlSXa8RSghhHCeM2XRiQ56Ck",
-	"KvYg3+w50mmeOkXbaxWVhFxDZQR1DH1PxueRABgSQnRUS4QD5PSzJWgp2WmIg70zMVHBLT9knre2p8vl",
-	"Qj1MP4rlycYcP5NGU/rhuP05Kd7jyIRK0M32oye+vH6M+Ikvr5+678BA4odKoNejzG3kcxjrYXDo7Sn4",
-	"GO6Z3AEio4j9abk4tkFYb9pE2IYC682jCKw3jyWwmkWrn2VXncSgZuAApdk0ROxaqRimTCvO5ZKkksJx",
-	"CpGju16d2kwyVjo1NLINdb8HubaZgp0jrmyrAiy6TCBM8d87auGm1qAn/4PdnqllNmccpeRGogwvSKfq",
-	"f/s9KXVlaSkAVgkpS8f2l4HViEwxTQWtjHChNO1UIiEZxwuyi2zWPnJDBRj8bfHNua5Vm2AZLtUtjkYk",
-	"yZjq/NL/crUk9XtJoAd70nOMD1DayhIsmTfJ+mMNeMV9xIXatlPptS/nc4l2WwT3R70CldxiiN4WvXUB",
-	"7+Md5wSYfrPlY4cFAevWuwh+UPIo4ywkJBK18vc4lHRFUMLyVIrdlpBhwzWH0e/8M94gV4NZuu0+LGTY",
-	"FDWO7AY2VBG/myfNJZUYJBbVl31CtdU1s7Jgg8SmShM4/IBerFj89ebm5iVS5xFOSJcecI9ofgg596UC",
-	"gJ+AXEqsjxAi2tc3SJSAT49xpANrGF+XWROMlOkWG1/MnJ+M86zTaGuw59Ksv96oU0273ZPXa1+FsvOS",
-	"mecILaZbM/EdpjGwLCHIFTUIuiLxumXSooXfzW/MvGbmS8ZiglOvJ/JtG2p/IlHaIOExUhVUXF2wHqdR",
-	"MQZVf0uEkSIPCDChaSdTlBL2KXPEh4JGM8MbMRWymzM89BlMffXwv5srZdfRo7CmaKIrrE21AcCZ9NQ/",
-	"OJs5LELTTQ+jacSuUwhtaLN2zCQnOCmDTNQlsfcsamG7D3ayp8V+QDjAeS4gt3IqjeMJFkoidwRAvMob",
-	"RXDZJU2xFqV1W4aXHexEP8GpY2irQqnj+UFH7YBlOR/IDZK5vID0fVOgDENyq8rRJVBKiL9uQe7lGBN3",
-	"9MSOK6JkrDa53SPbDLEV3ZFjHi61oEZk7/FVMcv80PyqATKAW2EVfGUpP+dx8C5YSpmJd9Mpzuhuwni+",
-	"S1ng2PS/lckeylwH32q1rao/2hmdnyBXhfs3eD92wMpcbWgrAN9e3P5vAAAA//+F8iU6ewwBAA==",
+	"H4sIAAAAAAAC/+y9e3PcNrI4+lVQc39VG29RDz+Sc5Kq3x/ya+Ozlq0r2cmpWvsmEImZwYoDcAFQ0iTl",
+	"734L3QAIkiCHo5cf0TlVG2tI4tHobvS7/5zlclVJwYTRs5/+nFVU0RUzTMFfNM+Z1v9k61fP7Z9czH6a",
+	"VdQsZ9lM0BWb/dR6I5sp9p+aK1bMfjKqZtlM50u2ovZTs67s69ooLhazT58y9+U7ecbEhtH9O1uOX/HR",
+	"hfvH2416WvOyGBzUP91uTCELNjike7jdiLJiihouHWQLpnPFK/vD7KfZL7KsV4yEdwgMn5g6HmW7+Su6",
+	"4AI+fc1X3PTXcEgv+apeEVGvTpkick64YStNjCSKmVoJUjFFKrpgfmn/qZlaN2srYdx4FQWb07o0s58e",
+	"7u9ns7lUK2pmP824MI8fzbLZCmd0j1dcuL8yv3wuDFsw1Vn/G3ZpAP/6e3hWKy2VXbI2VBliloyUXBsy",
+	"V3I1sGwRhhsHoKaiOJWXg1jRPN/uYDTLFTNvYJD0wM0L241sGF0NLtc93HbEVVVSw0ZGDS9sN3JdlZIW",
+	"Kdo4rEvDK3ua+M4gbYQhtpv5HGjvVfFW+TNI0uar5+S7c1n+dnl5+YBIRQSeR2IdbsBt13HBTpdSng2C",
+	"tnk+Nm4gsrrmxSzrzfPJfqwrKTSD6+TJ/r79Ty6FYQK4Aq2qkudAaXv/1hKorBn//yg2n/00+3/2mjtq",
+	"D5/qvRdKSYVztEH4lBbELplpM/uUzZ7sP7z9OQ9qs2TCuFEJw/fs5I9vf/KXUp3yomACZ3xy+zO+kYbM",
+	"ZS0KnPHH25/xmRTzkudwot/fBRadMHXOlD/JTx7rAY0Pfj05ZguujVrbPytlL0rDEcfphT4IMlHRp/CD",
+	"X08IvkD+ydaW0udSkRfPjgltIVGfnDI7tp1YivSw+IxcLJlicBvZUZVbKeGalDKnhhUDQ58A6w+LT8+B",
+	"L8U7mL58/KE76rt1xawAEBbaG4gJe1P/y65x9jFLcLOGQ/0Ln2bdY0huMAZoM648/TdDRDsoVlwcs4Lr",
+	"59TQU6rZe20lkv6Zlx6yvd39zBdLpg0p3Ahkwc+ZIEYSSpB3Z+GZJlT5F2SNcgR5OEsIM12RJZvltKI5",
+	"N4lTexNErGYeOQf8wAVoAnts1kH2CRd5WRes2CWHXGsuFharRP8jUkimxd8MsSC4IIrRwr7MjSa5FHO+",
+	"qFGC3J22i7liCQx5HtZtnxfkdE0Kpo2Sa1b45WQNYAW7CIucc6XNtLlLqROC6oE/2gh6gnGzZIrUmhVE",
+	"SAXLyvzi2Fw68mu+uGCKEcXgA6nIkpWwixUz1L5EVnyBcNKEC1IpuVBM62nrtoOOwQwmPV17kEwZtENS",
+	"DXq72dxBOZh99KRygkLpP3lZHjMNsniXUuaUl6x4JmthxjDVibdME7OkhuBX9mzPeFkmoWAfbDWwroEP",
+	"zOuyXBP8ejMk4lmy1mYCEN45ofSFOC/eVwU1CX4RKZHthb4qmDB8znGxFofgVVLbgSxh2Z+82JtisUyc",
+	"F78wpZN3hHtgh7bvReNXtbGYZ+TGCdpC+abVD4/U5dqxKN9o0fF2AoRRRn5WMirqqg9cK8EeKTbnl/0V",
+	"vhVlIARysZSagWiNCpwmF9wsYd0VfA/suGAlQ9RfcfGaiYVZxlpjAxlZFky9W1Lxs6yV3jB3rhgwFWpI",
+	"yai2yiPXZEXFmizt54QuZGf6vkY7rsPG4I1g0ltoGq5DBOzWs5HQ/Eab9Se4/TRm4IfqsAIcOTmwPuNV",
+	"tcXIZ6wy5JTltNbAudcAemoMzZc4GSWqFsJSoOMgwMbpuTsgS1WVkoblbdln6DxaUOysd4Cv4Okcuhvj",
+	"0F8Y/RPyl8oLseCCbRKA28O6b7rL7QzZWdNbVS2paEiuy+vyM5Y4hafwe0xt3LKgFM+pBqgZp2yoFq5S",
+	"qeDf4WpVDHQDe9xe4kJM8pILu+Ta9KftgMBtIywmCQPd3z6+jv8GE9emAxkCbJCfZ1Qpup7B+iLRVI/J",
+	"ACl5D+EW4LQRPmH1E2SS9ko7oAwg6e0AgFoX3BzkJnmDvQ02S8VyqQpWEI5SKbWfkVIuIn0Bd7OLvHbm",
+	"jS+7gXG4v5HO4+fu7zkv2S4aevxfhbwQrb9xrJ5Wks0ud+wyds6pssxX2/VEW3O81q+s9+S5X2PvyYFf",
+	"beKb/pOXvGTv/Q46vz9v9tJ94nYVHYdU75La2zPF4NanJRxDY1S+oPY+KxigWazEVfy3M1C+as3UlpCT",
+	"6uDoFapuzU/vYRy/1tdy8UKkNfOAVKP0F+EfeCmMVCk9/tVzT1UHR6/IGVtbzuN+sTtDIgIItAAzyzaZ",
+	"zdykHt5TFuvetnogChYHCY77jq+YX2FyOQU1bMfwVVLw48UUgY8B6CdsEcyNvQEt8vmh5ryM1qlTg3gr",
+	"eGJpJ/6axsGQxgkVBUHy3jSyrFXOXlWJPR8RWhSKaQ0DO0sjya0Y6Qz/vdG81XfYF9M/lfHrCIBKvbTR",
+	"4EuMAJYknlpRepgkSnbOyk1I9louXsN7n7LZimlvAmlv5LVcEPeQeMtcCq6GJWB6YljlGblTSJQEA5Ni",
+	"VisAEcw+LOUioFhvbIu52tBVlUZ9eOQhHQ80Bf+76kqYqgFJ5qAZwH5iqKn1MaM6JaaVeCic6Zbz6l8f",
+	"swRkGb7ZBYeGGYjCKbJpAkYbJRJixeAZH+KDRuBqzZ+RvFaKCVOuiWKVVKCwSlGiiRAsqe6LLTEjEvw3",
+	"noxfvD2FZ0fvB1SAZ0fvSS4V07A02Aryi231rGz2jFb0lJe8EfjiU/ZGl0lSeGuo7sb8SClD5TMpBMuN",
+	"43n9VVh0lfXAlWAtjVwQzXIpCo1GRwsRd5rEfkzo3DBFLpY8X8bgInop67Ig7LLiio0Cb3+jUuRXmdwh",
+	"cDWUZI6dc6cvayfvlOdMG+fMJfaNcEnDYKyAiyYjFYXdFlwxy025s8YGRV0TwVgxAQNhFcN7wKMe3INX",
+	"J48abTJmD3NaatblEMdsDoqr14kjWZ/UwvDSaVl+RMI1yUtGVbybUymt5o8M4PoaZDZbWdJ7W+EVO22M",
+	"+ItP3hc5cGXah+S7WvD/1AyCBQyjq4zosl4QxMIHMxAzDFP2s//vX3Tnj4/2f/Z3ftz5+Hf3r4//J8mM",
+	"+B8MIheerk1KszrhfzDyn1qi3hSBmwtyaj/ZJYirVkhQsl4sg6QIzOzCUU3OWEG4AUxTzCKKNbm/FxDd",
+	"YB/NiZCGaGa6BvQfnmxvARrByuKgibTpI+UGoTLcrBiuQ4wdBSnn5iXMeI4pguaK6rNN6NfMckj1GRcL",
+	"q0rxcgQJrfd+YEW9FZh0+Mg7K+OC9TnwmNGBUhKgiwvwX8BeuyKgO+CTx42qplPK0ZjPEhd2hv5Kt+08",
+	"Gi6x66k444ZC/pwcehr2KEYLa2LtT/frkoG7pjMyiib2s4iAk/xQb3KMOqcobcCk+UJ4e71TEZzBBZyo",
+	"fCGoqRUj3iT/JCPcKhHW4HLKcFWnND9LMqf6dOCmc3fXusOUuhtHbmMHzFGYzAg91UyYIA1dLGXZB8kW",
+	"Cs2EKcHOqhioZWXZsk8F7wFdod8Rl7eRJmjL46t77t4kYbxjdOWsCldmfN4EsDXPcxM8XTs38tv57Kd/",
+	"jTMru14weXz6mM1EXZb0tGQYmzOZibr1TuGfZymEP6YX5JyWNesP2BugpNq8T/ooX1PtxEvwfnggWsuR",
+	"dzOmgNje82dh+YPbTTFpfNHxZsexBzHxVwy8ujoqusit7VGRnTNhrBFBp2M0AlnCi+DZ4edMNdqom3mq",
+	"Hup2+sJPm1JFp2FzM/FGbEaeMMjBY7bt9seZHuDNu8Re4f+7cyhVvdOw9CWjBa6N+jEg+MaOuWSXhIlc",
+	"FqwgPx8ePNs5+fng0fc/+I24sZrzxLEyO5I0YL2yr53KYr2b2l2tykQEyrt3Ryfk/fHr+PCoYqSSGnnx",
+	"NDS2g7ewJECzi87PuT47ZJbX65SCc87zVJAH/O4jHXtbs0qaXmvDVmk79MvwnNhvyXdsd7GbEXZpnmTk",
+	"cq4fJGVDq3AcSZ4yEYAyQir70B9PwfVZWsAztBzQFN7ZZ0RXNG+Ugxaielk+HdgxMKrlp1cZtGssafaf",
+	"+YPpgTpeSGuv/qitMnT4NCWO6DNiNamukcWu+ZA/3dZckM1eiPNfqMtMKApu56HlUQe94iW8EOdcSbFi",
+	"wpBzqri9NlI2nz76v5gYSIFG7/Mi+Ie5GB87m72olmzFFC1RVkppBkpe0AvqwwWCgtBZeAY/6FxRky8d",
+	"OpyytXRsQklp5hq5lBuI68arbfnYzoXihjmzOLikUUjlRoNZRDdhX+HstIuW2QVnaMvzDOiUFE0PTrUs",
+	"a8PQAmMkgXdjIZUawoXmBWvN5sh4byXMntvog1tR2DNSJ7TuayrdDUBSmjfGnvYvelkkcAJeJvBsUlTY",
+	"oBEXZ00rUHqze8zyWRjC2bW7W3YrjM2w9pMDYxQ/rQ3Tg5avReqyf3shmCILJesKQ/H7x+HzOp48+vHJ",
+	"jz/816Mfn2xiI6skhI+YWnENdH3KIRyKyNwybyENSFKZi4qEKBBmal5k9r8LXgABacPzs/Usm7FLuqpK",
+	"O+f+f/3X99OdX20aaXnBVLBLrq0wMOfCXirrVcnFmaXIubThl+nIXsXyWml+zjYbEp8tqVgw7zBzBwaS",
+	"jFPQvB/ilNloT9qsihgpk7pzPXyq4CW9oUOdaoPt4iImJ/SRkcWumXTwkIcFhpFF8IJgzxxAWaQ2lwiE",
+	"4iWbQnjWo9Tbq1+qG2Zo189ktR6xOgcb+WYDeobm5Cvby7N4ul82WhIkyWW1BpOBvBAYy4pXxApNBLvk",
+	"OWK1Dn4x8N46Fp+UlOU5U3D3TbGuV6W9WSEOxtI+XozUOC2hgVzSdgRLSXCbMRr3m94omLvRWxDdgAFD",
+	"GB8d5BjW57LirIiPfTqKTxkY35s05DR3z5C0MCiqW0nB2yGjNSUF7eHFTbOQoaMcLB5urgnaWBg680lb",
+	"HmjtU4FdDiFDfIcnsjPzJRdsRzFaWJGZYGQkqLQuABMX0QnSgPvQ0yc8Ojh6FcX+CGl+w7yhbFZQsSi5",
+	"WPzmrrFZBo8DDcyyGdetP+1jtqoM3rFcG7tJkOl+Q08KBqiD3+U3I+VvJVXgl8+XLD/T9eq3FdcrK0La",
+	"scU5LXnxG1X5kp/HcGrQxMLpH4pVh/BN34PvnGMdoxYXzKW1ZhhXbHkGNeThNMRJY3WXXaRDsy9N2s0A",
+	"m7bLsEsGCd86fTFfVTByqhg9s+574/y83z98FHB9grMzQ1C4FQxhnIXkMPsBFD5h9jhYMcYn4EVQXrZg",
+	"PCcYYLt5XJT7cRWgXZ0yC7dTLqiCCC/AKQjuEg2WA8/wGckT1gTnsUVMaBsRE3Yyo2oxkIBkzx93RLSR",
+	"Fgr+6oJV2D3BHnJQyGATPofFSn1OC2SKBbgk7rmu3uP2l3WOtXMa8bqHsOaVmMsk6Z29syeRQnj4HZlV",
+	"w1sStp+Cz3naoAqGaXzBZXQ6o+k0S2rafvyyd8sP2boGYuPqskTVwBIwFyPumfT99jKgqr/KyHchPAcO",
+	"5sE09E3n8UE8ERjfMg93FAmFNLGC4i8Dx8ZiDu8+25zk5yAX3+9DCPSaa7OB7WxFh4CQCRIUw4UCjkI1",
+	"AecatwC37/sCB+ObxTUO7e/wrOBqy5CYpKbZlj59ZPK11EkYhKxcBmFfY8hQca5zjIPoroOWls2vSbjs",
+	"N7CdUa3vSDFr3R+EFIYw6VfteJsf9/e7uzpxgVJ2rdaqzjUBUcKe6mys8MR///CkVXrih/2Bu4EpTstA",
+	"wqMQBs3IX0OQp2JBXUJo3sICHYGAeZBozHO5mSB4cojw10YqVNnC5/hZ5iO+6BnT6KmzQJMKLa9e3Qp3",
+	"YFIDGon0tY/ICCe70vkOqvR4wIOR0f487S2pyYVUZxiOPo3nR8dWjIcg2DnAraDdgRm6YAUquZGE52HP",
+	"Qw4VkSJvlum2k1Y6J/L/aew+6VWy/i5WwEqMJD4tokEHCCO0iBUCRin5x4t3PhgiC1LoaBhJBwGcCyoc",
+	"pNtpB/pDGALWk7651SkHCcdZ5Kw7+flgJ3LUOYkJiAiVnnCHIpG5babNH+7DgYQKfIheLNS4ABvstPCb",
+	"o1YpfIkSqZqHUEhHW7cXE3ogDf+KhtZExvSNSGFXM8VavwDZ/+HJk7bBFX+4F/ZmJ9vT+WcQ665qH54S",
+	"7NgWDxtW0aAC/MFmuAiki0HegVsYlB6uYE+kom1StOjiIEWo6diuNt6nSZHODxcEumxEGIvNt0nzwkZK",
+	"j6bMMFWBnzMvJBTd4DS/OKkIdYvf/SDCPnC6EDkmy3NW4K0See+UlC17EwYRURG9Ca/glB+Edys2Lm7v",
+	"dHRPmM6IltHi3SqsOADkJM1y9wPGWl76bPAn+z/+MNFQ4oA4jGYiH0jVufY9NZ359GzT3bPUa5FHRuC1",
+	"Y8da5XsrysXuQl5HKx11uk70/QRyD2AbA/l4NP4Emn7exN0774AoPIJ3sm9jPWtJIfTbyuAubGBFBZ8z",
+	"bZKUP2BDfwkzhsSmnJbxyTQ8WxQEzKddorfT44lODd1qoypIv5ev8MOH+/b/+urxpojVcMWh72Nl+RPE",
+	"Xfk0ZMEw0mxaunbLMD5+9MP6glE0EfroacFOoWMyuf6pZ+0ojJCoHA5loKRSA+ZaeMfjiKnTT+cF1pDO",
+	"RVeNT0KKdlj0FEUBE40T7gQ3H4LMX0GtGew14NSEgs/nDG6nIGFz0SxaqoKpLYDS1SF8NjSebwyyJJ4o",
+	"uXq1ogsWV/wquN3eigtqMIJjRavKTo71vwazdaO6YdlskVdDL/7j2VH0ogozD7zNBFO0DF98CnE56zeu",
+	"UKILr5SCTQgwjpf5KRt/N17pxne767TBIfEAPRLUTJ3znB3kYJz+n6Sv6gTfIe4l8j8nb9+ANvaPZ0d3",
+	"UJPMnuLUmmSJ7aRQrgunhFFP6wupitTNjU8so6x1E3inGmy6cQiEsZPivWYqrSG9d0+mLzUN1DBD1sAl",
+	"BdXBgO8eeG2kNit+seHtR+OVR6C0FvAm+wU5b4cFoq1XqqHA+Giek3qenAd/v+Y8G8qnwH3EPXR0b0ji",
+	"AN0bFxIAvAzc06rh9/ElDkpwvlJRPEOWOJcUDC1TsTZ/Vgymw9KSU50q9cap3ly5KpvlJWfC+ApYlWLO",
+	"9YbpCJui1fHr5LhVHfKVxxhpyGv+lM2KVgDu2FdRqC4UChuuEdGL+bzgZZnI8R1PJ2gH0I4W4YxetXTB",
+	"VlKtN2/o0L8Xpaxu+sbhhM9WnXVLLW86vJGwXvD1s22gSjVxH02GqjauoNyETZ7Au1eu2YYqdDBCxysf",
+	"tBKMVXWLS1YHCorBFhFAhAQtFPd46wHRrxAXilUkK1RAhQb060OZiVIudHSVFey0XkBwyFzOstkFVXDR",
+	"QcRs6nZ7LRcaVZh04Jx/FFWdcGXMXN78KXPlztsmNKkuqLK/2DQT+Oe0Mjmt9bwMo7R+fhqGdBs4GYhQ",
+	"w9+3XLo9cakoXN+VPRYNpofpy8dZ30XDNL8eRQN+ynyUUjpCIK/qA5UvuWG5qRVLl4Cg0Rt+owJNginm",
+	"/JKueLlODzWHZxMGOZQFK9NjWGtkOXWIdP3wZhgRpaulx+pGbocNRuvszJf14IoHcWlT1zDlJ8H9GF2R",
+	"FTx0umZUPaVfqCIq4TJ+tfbSiN0c29R1iarGvBcpIWl0EsIFsZ/Bjsh3voSG5iJnhFUSkxWmRAtaAWYo",
+	"dgs7JbTSLYN7yS/HGRKwBq4dWJ3TqFAqxqONlrFpw8EvCY43r0YybnqVsw+fHbVr/ybybQZSOBtp/TCS",
+	"ATrDw5OrpBQ9fPTfKdi/YRejxQ+uWwAgWYgB592Qk99OaR+zP9q3ohLMHePTLjnGBWjiY0d3037joczy",
+	"Y5e63cvrBoMw133rvq9tXfIz1hQWDMlHztTjJhxf4JS0840L9OuZmHUuzZKpC65ZuquBPbth7aKUF78B",
+	"DQpmfkPkSBeWvgjoa2TAoiUj/uNd8qsVGjUz9gU8eQLxebb0qG6MdlaSrFjO52trWCuYWL+t4Zv9Xfj/",
+	"vX3PIQQz4MtACk2jAa2NPKK1nmD4PqiNXFHDc2oLWVT2o7aoiAGl9hdf3ic1IwqUicS4UbWh8zoqHT5B",
+	"cIPCAa9ZxSGvNr1t+d/1VAwH9IlfvsG3n8EJzT4FQepnuaFjCObK2b4h9DR/+OhxaB1iMcENgmnIcpVw",
+	"yAXB3x05OmCl2CUHnn6DqRgvGhibN9WM+Ty22IOlvpt+CClxGIMKSX2wFJ/Y3FkX11GwAzft9I94keCz",
+	"K6RprPAWgJAQqYmu1Tk/bzBSMZ8ar3fJMyqsJJvL1SkXni+duxJNyH2PpUu9Pm+KFB0zzLzQGTmtDbjC",
+	"oy8HuBdmS+v0XYLsx7Ie95o9My4geCsU5nZb2HVdGNA5qpmx9M+Sqa7uaF05PRYUzk6aKm7DZkCeQTqr",
+	"pY6mELLdXikXC1Zk/kAin0Eoh+zVgSZBBx/FK2OigNin3a28GprlSRn+BH4HRu68ublcrWrhAzlglT2V",
+	"PeI722nG/hofL5Ae11rzHam+z5IRZ5KUFjMTsowTJXe3z5FGFITbBVD1JeVlAN4YKz8JTawasw+GaHGj",
+	"AwNod4FoqiI4us4IF9owWkBIPeWlr6bQMh3YhhDAF+a4OEvpVX1acr30cYhOcoFhd13OCdQe2CUHVVWC",
+	"oVLGKwnlvF8VGYk4psXM5PWCXEe2mMl5qxynP+b0Lbkxw+fVc7jWsfJonzn3hJ5Dny08IvscXruoWmeu",
+	"wcIHGJoZx0aEZOzAXPfsBddsBC4ADxY4UyXPOXYRqbVB3oNEGY2RERhmz2V5Z/bA9nAUvbcJFIERbwGM",
+	"5psw1ttzpkq6tgDR6XgIPZadbu+tBy4R1PksHW8O11dThLZJnrII6K9lmiupdfqSegFee+dvbpEfzMFY",
+	"EYfGhGtcChf2W+shFWA6C8avjhS7oCoRg/KPUp4SV2xP94N/3HJXDWo50IGtQu/9ffeUg38AECpre9Wr",
+	"uizhxjIyCnHILSg7TnYUNrn5WyRniFZLFg9fQEWM9cGJLJxQdSqkZWkXlDflsFwQtQtntfJQyKhqSStu",
+	"pYDh7JyptYF3alHAsuyHjpVVCMggK9mf+wtMnJoFUsTdxg+xCct4/GjoSI8nK5mH3ZINUEHCZpDYdbp/",
+	"IuC1vRjstaCXVKFEsILOemUUaQVng0prvPPQTxEwmlpQ7ZxKCVERFmSVlGUkkrqJ/HlHVS1O11E4vBuc",
+	"GkJBEwHR729mSPiLGYIFeV8knKxU9z+dAGp6xtrEDGFsUdhaBPuosUi87GyA8izUfc0Q5Cnf7ZlVlZE9",
+	"VQvLjNn5A3sCa2LBaMXILbd6MmRF6MUo0iG7QeCslh9oZvQetzEYgpkHTfEQaroXZyx8dMvYAa1LEUsu",
+	"xDrkGeFaYlVleydB0U4UToLIAoiCNgMUA7XpG1gC2nkCwOSXduijS8DoqkgJnItuj4Z9TLGSDLtgnNFp",
+	"rN7dzVU+i81cdsaTUHhr2xlRH8oIdWk2KUVnMLp6wLD6S2xM9RNMLMCYjjVuzKVuw4OF3L6EOmsrLny8",
+	"XiKG7JaqiPUKiAG0XAJAx3tT1towNU0tcy+nMy1WyfbDz+B3P4BU+ZJpoyCaa7BI40sfLbKht5in/Dm8",
+	"P63UF35ygi3J2Daz6PDNtJmmVdQbcj6t2i63UatZ9OonsJ75enBjX1l08KXjWp2xt4+zEHJFi8GdODBu",
+	"0TDOF/hyErjolGKqh2sx6eCPh2IAm+d0L5ITP3nHDJCeBaPLXgltqMiTJg0fK8fdO03Yz8aTd4X7Jxwf",
+	"tj0A5juxfto4/XX5re+HzotZatNZxDzCsjvn3aBjn/Ta5D5weM3eAo9pE4dnbRhklmBwoAhCKwadqiGB",
+	"Ega+hbEKmvCig3vTtbd7fnrPT++En7IRbN7ESidJM+3QvqSt+J4NbmSDyOdiHrSZEaY4XuCiKd4XlYDt",
+	"EJ8sGGm+Heil/Ozo/RjdhvdIaOYy8ToOX2IowUDFyANUMlszYVDatnVc47DOVMUpEfYUdnIFISOv6iOm",
+	"cibMAMDt4DX076nwPbqYOraNwEv1F4TLLQShYGFVUJPtB3urpoLuVOqOKwcnOxNZ+L/bWG5XIIJd5bDw",
+	"q/fDpXffRGP7uOwrF+BtIfsAZraOtr/ARNRkBCB/dp4mTwL/6jl/TK073K+J8KfF2g6lKBcYvZdjxyH8",
+	"oxZLRkuzXE+M82sWcuxGbn553szR/Pgsnq35+X0zb2t7WMbyxrTKzUXFt74UOmjgBrC7OCqpsRM+8wMk",
+	"hS185JdauW/aXRZDQ8uI7/9mF1PUJUISol+nHVlvWVjYrffzL2HG3iMflRyvoPfSa7kYgEODue1Dhejc",
+	"Y2pS7uElVd2QuXYbYR+GxNQ58y3KnGe8pNqQ78mKixrKA4M1ep8Y2S7kVsj6NC7H5iPtsllJDRP5+ujH",
+	"7w8TBPfj92bpGXHU9FAx+4NfLCnqqC38ipcld47hzHcvqaTylaGD6yCG8JRqZEOlgrE8oF8aImnjAQ4o",
+	"TjjWTQtlAOPIw36hhDEa6WO/oxR7cmPSQDhdOMoQghCtMXWqeM261hNeGpwGtGk07/eDyOsC8tMGxCCi",
+	"he1mEW6nMprag6cKSfv1TC5wNUR1KTn76gDIZtDPfiRXIsY3qI25qurpaRJp7prFAImXsBm2JybNXwy4",
+	"WdtMGNrAilCJpZlz94P4PSKR3zFGiQi7obJcZ+T3gi0ULVjxO+q6diSuibYOMkvfFVWmy80yO2htRTn/",
+	"kX1zJXXvTaxa4O+HNq36iWfZDAfb8lZAKL1tjdl+9ryZofORm+9TNrOIDjXcU03ElTYnyfoBhy4+WvR5",
+	"AcXiJIQaKJ00rXD6ZoeEsqcOhRJd3QNwyzWNohMl01ZOqJnCwUIMqvVQrawjUPHF0hAhL7wnG4tSmqWS",
+	"xpSsmLYxP8ERU4fA/lLphtpQcIWOQLNiyvHPafOGZR7D3VauJ0EhDsb1nfHwun7y6McWN3+4f212nubI",
+	"fYBlESLGx5raZIqrHDNdr8YSE9uBt+MWmhsKvf288WoW9N96Z7ppDbBaY0MjIsu5PEFcuRfWbbbFu+9D",
+	"N6kPXbs50leXlVxIy+b6C3tKNSP40AKnE2BlFJ3PeQ4dZwAa/LSchLA2obOTj5MkeUwpBwuMxRb7WTv6",
+	"92aTkm8qS/jucnGzmTuDUWjCz01kswWlOy+xCHOcc0oqJS/Xu5tP8AopwN0cXkciQ76z+/T9z0CUd1At",
+	"4Auk+vtSBPelCK5cisDt/bVcpIsRYApxOyMaojNdN4VJ3Saka+owUuSvk1Q9Ku+Wfl1XM7U0U7meEG04",
+	"DJQ2DCFhE7HJjhRHs805c2EUQ10BhwIkGtVs0AhqIewefoFAbkDXbCEApAP888Gaw77YkycqWOA57tRb",
+	"jLQpUIXUpmBKIX5anvwbkE30NxNFslpGs5SEllcidiS7D50YVUO1ASzY0WeAk2ybXTRM2DRLuUhM//om",
+	"5txYlw7mzmI4tI9PTw1VC+jFfW0/KvA0sUQ0cBgokZL1DMYbZohGnmYlvy5ll76Uy2hIiC/50gVpTBx+",
+	"x7jHDmhP6tWKJouu2rf1RJCAZWwA0Ftiiw4CYhdFof/q1AX1kHZbSxjOlnk4RGA7jKScab1Y/Rcb5ZfW",
+	"JMmKIodxDY6pF+hwGMabfgDGNNNmXtXWsHOUm3QllrFwi3kpqUn5Da2M8S59yvAzBFeMNP8dpkb7YdoQ",
+	"Ba16B6MZRqMlRpc6EoMxOmh6lYcboi6Gh/xr1pXZotpLJO5GSN2cRXTUER7FyBrxhnYBg3SBjLe1GY4U",
+	"9K6GZ6+eH5PTUuZnOiOvjggtCoVp7FI5LdcFHS0UaIeo3+6SAzdA8wEtL+haQycTYo+fFcwCU54zhTPE",
+	"b++S525wB7+4pIYVAq16HUprYKLV8zcn5D81S/BdMDoaMB8KfcFcPhz0dTDMoouvb64wNsEZYOGnxu3i",
+	"trtd1iZ8fGQzq/N3CJuWnT+F/SdYR4Tw9h7eH7/WUemvxnyAy0U5o1UiNJ0i5gA5fPYFE/w6R+9PzuUF",
+	"skuaG0gP0uQ71yhiN5erB1hwuCxyqgpNvvv7bushpCYq1yPNosbCDorZjzYVhvwstQmt/tFY/e71CTl5",
+	"88puQtbm1PY5JO+wGJLA2ms689vzO/DlFdxxF7vkWfN2SMCnZCm1EdQnxmHjmCVVBVZ9b+AD2dN2If7T",
+	"kzevHsAsmJzptnO6bj7QdqzWskJ6GsAf3NjyQkRHANbyUADe5iAeFOguiI7NU4Eb08iYiHBks2QrQjW5",
+	"YGW5HWLbup+uars9iYTO4NDYAg7qpjrzARinTlljQoLiESHrOoRe9GWSnsrouN1xLSbbKN95gwY+3845",
+	"9WvKatPYP6Ya2orjSY2+m929CJ/g9xNX57oNTl7ZiPHrveD/qZuRm3Dtq0fjNduLQlxGjFPh5ABxQkON",
+	"jZJsKxAlMju17VEhQqVxFY0i3Iv4FJNBW+mT8Mo8dvCfNd5fX+Uym+llbWw7pzEVvoHaSCApbciqbtWL",
+	"xuB/qNdcoxThFzgy5ZQQnOYcBucamQE9hgdQo2GsGzWGNXNBaMhjaCbuVJK+drWO1XCdjnY2dVhYXA7B",
+	"ZC55eLjcRqvaRrp10k2U2VA3UGUga/65TZWBiyUvoxJ0V6wXsJ0H/IYz3UfS2u84VX07J3wTknHeJp9t",
+	"elw3JDDCE5n+lZtllHHSJsRWdtKQuWKas0bxfPapu9xmfKsG2fTv/hpoxV3OfQdXMKM+xDwY+3UCzFw/",
+	"94QzFsphP/dOEkdpnSEjBN4c7Da0Gvv7VCdOaoSeewaGy3zslQNWvGsP2aHaBVPDejy8fWjPZAHFTfB0",
+	"7ZTYCa007Hpto4PZp49dL+vk3MGm4sLGRIRpkUZcBxhYCdLdwVcLL7KS9SbaGey8MAkBpxacAIg47IFV",
+	"dQNvbqhEcS5FXivVJDQk05CWLAqhbD6JrqUOuU+wNsaZyOmEh1Taui/4VzHlovQmWSHvLWabLGYJPEic",
+	"kcc8Lw0NYaB/rp39KxZoO5FoVvRsFXm+hp27IzpvafieNkWtm4SM5Dw3YgrvbuQWbOOn62tNMdFYfs2N",
+	"TLKeX3Mn2xfPAMNmyFYyS8YVUQHlXTh3hNITcHADuwAS9MD0I98ym3CsoVNrom9b39qwPlZBaarcg2WO",
+	"thd7pldoAtSiKHoOVmnqVJ5NzbghO6gdS2EFG2zg1l4OFsy/WoSFEyMayLbShfx5YDXA9z5spBuus+Jm",
+	"IIXYfYk5JF3WDmSYQS3eFXdFlYU0RDMzsQvfcO4y8hlottmqsMhFswQf+/wdLORBRhSbKwaFWSumuCww",
+	"4WDaWnCwjXwiEW99BTKso5zoeOKU2hgE897BsZULOu20QbY/+wXWOm16nCbQu683SPMp8RbX5hFwsMLY",
+	"VI7gColtzxK+hBJmXY/ANNg3E29Upm6rDBrM1quF1tdWXABz2rXAhgKgWSoEerpfBUoNbaRXuPBak4Am",
+	"aT820y5vmGea/QA4vCvLM69L17nJqk9YhH4s1BvePZnkEPAAfxp9csWg7g3014TftqC3rSfnxs0RV28l",
+	"d9Xwanu0JxW9EFsDy355TcvFFUK7oUZ5vsn+5pbpa5rnmBFsG7Q0TvPTdXzTJSzLFipXpcMuXEbiYq4U",
+	"jn0FmW30GPHTKwbDxn40z1UmhW+7wxwS82IC62Jq63xaTLNNDVlg1m1WFDN44DepjOfJDBJenXKj3Sov",
+	"Q7Z8FUZ293xnzgW0GthmV/6bydu6CoPR17mqJpNgs6nr019DcgkndoeeEjTZowTbl/196CPepolKMZ2s",
+	"iBPzX6vRQIJTCWWLiPvI6zhQ9CzJcpPy3ntVRnlUMHYTBIUZ/9OkPr/23obT7QuvQP59b0AnpN45j/71",
+	"sWu+fRp6YRIdQu2nyubw8bSg+gkL2EpYVZMCWSIqacJYrkVoN3VrTrvKAl2lMwRaa7Sh48dMV1Jolsyx",
+	"+JyokEp46O3ABXXdQtbnVbIzbVyoslSfMAuHZ5ErZ3j6q9wGwMCerZLt7ixry5csP4P0R9fKhl2yvDas",
+	"sef4aLNQCWKQWYCbKDkXGlJvZpYb9hpH5zOESL88+jJQ6Srnf8PQwm0PAurxPaDGAQWEkMKnuQwNlMci",
+	"m2IpBUN4UBBrBAoYCGhM1YIotqCqKJkOsB4WXmyC7KtVMoERfrZj1pphb61TqvtMa5how9jHbMG1y+Ac",
+	"O5qXvQ/cKLFRayC88hrr/PbYpTas2nRjh+rK9t2x+fwsk65yfx4nhlXJmzxhUe/LShvKjPaW5sM24W+M",
+	"27R9mPBfvgrpcDt2vwQXMdR0EU2GcoZuXO3min0DaqM4hbKMLiYOhyBSMFcJjPpQROzBDfTa7YBZSmmT",
+	"TerKGoFijxg2np23+zL2m1ZFbfliqwpdkSXVREhrK/VtjAg9p7zEHj5xnCCH5TtFLfNlMJ/s/5jhkFRA",
+	"A9zwvh2ZmwZkTdQklpVLhL3eWdBq1H4uauBk/ZO7s40Fka4aiaoloWIdxfgMYw80GrJY4TqANbCLkCJG",
+	"Rimag+oOFuJRMzw9G33sD8t+hzUAm/PE4xnq1PRmo2sX34OsEorIWik255eECUugONPfW93cdgDrd/7+",
+	"IOp+aIeCooTKlUfzuNf0lZTa+YcjLEeICcKgAR48VWwlfevjdtfG1lZHw0ph2x9HeNhrtqD5+t71ch3X",
+	"y73j5N5xcu84uXecXNNxEmthTlP1Bq5fHn8ODn37nPPuiOVuDZkBb1JnC4pGQl9gVVqR8S3N+/1D1EYj",
+	"54Fa1CuI3AiVTO3s26ACxE39THVCxLW/tsOrfMGCaKa+kr29DcEOdSPGAzNaFGh41d1jt0/jM31fFQ3V",
+	"9mm16KppUxTStm53p9SyfQduv+hUbt+nCFC/JEeeXMyq25UFpWLXvwbHa8vzerry6xTbpu8yhFwDLpHv",
+	"4D/PucrwB7whHkCAYdEz3KAGmlwPpNu7X3BlEFNFodF7gUUazJKtXK9bE40DgcU+DZAZIkVrhDxnFai4",
+	"qEL4r1CLiJUI3VIf+iqBTS9r2rXd9aUz0lULn6d8EFsZemBvqfnvRia/GV7wOcXiexH384u4N86jR+Sa",
+	"njQzLA9vloFRFsL78gr9mdkFGkc8E9i6STPO/IvrVD54X5fMznikpMEKJSlL+7zWDIt9lMwlFTsmXwvj",
+	"mtxXYQRLCXnJqGJFAtdTdmYMDjmiKrFCsPDrepUQypg1WWG1lJOfD3Yeff8D8W97FK7QcD9Y4tE+R/rq",
+	"j38kNY/rxMNYXAQhMGuaulJDHk6z1Ohkw4uTKHzfTzM5d6cbldJsyU2XNUD8OAj94RCD651ACKhBkLm0",
+	"B+QP7NIo6nuA9e8Pl7fOx5t9Rq/5AVmRpSYhVBCq8iU/n9gQCET9sbnhBey+v16VXJzd+BLStQiOXAmC",
+	"FnCT3qZRdGt9HiWqAN/u5ZWEnblt949we1Q1S4+kKcxsRIfJyRChZI03Ql9JcCmZYQdzw9TIBL7uYahC",
+	"UTn7ueeplg8WTBsl16zwreixEb02UrGi4Z5iu7VtYNixeNJUyMAm+Li34gqMO5uxaslWTNHyZLisj3s0",
+	"cARYnUkTnStq8iWmeWbxy1yHJfYqWnNNmiI3fRMmlqJ8IRauqvKEmibtb3xllGtVd0lf5/gu3OhDZPp6",
+	"LLvMkuF/atlU2vTguoHksmlxc7iDKGDO8gcb2TmxD2rISgudPyYsDSaxm5+U/NaZwqe7TZtqRKBN8ZRr",
+	"SLIj1bD8qY4Uw0oXVYnKbCQ4RI86BvMgB5kw1lBaJUN1EzRvOQlm0vvCPmAu28atfATe5NaQfiAK5XN4",
+	"wTZlnboPph5qs9ChGrujxzutL48b1RfqSfmi0yriTTUS2LbFTxoqWa9sETLshvVML5zWYEF8ZBFAh/Hy",
+	"Ga3oKS95E0/eiuLiJQst7PTmIPOeTS3c2LQAMc/6+A0gjpL1Yuk1s+SBreglitYDzMt3ufPsi6IYJpWX",
+	"EBv5jIumjJUPWHH2NRp6CFJXKcv+iV/ufhCvqVowFXV8g2ZJrd5rDx/vkjexWC69Qc5PBStsZTZbbRRN",
+	"ec7INqWKAb1sFD09peuf3YpOb22atrWirgTcyB3SPwYfIOE26O2pHieaCp62iquKoNOBo//Awjxcz7tX",
+	"kJM7aNwD5Qh5AJt/ZnWyAcUuUeXa/swKKKkrRRF04BCc0uoh5uO73O0TJJlZR5jKZiC/AFUXXD8/BTtJ",
+	"fsZMMu5rsHeDKzTUtMLUdWnGa0b2qrJYU7r7HmHQbKOi2lnToJuw3dEZHyhk2DklP1QI7vd72HQ8z9U6",
+	"WXAUBpze6LV/4gnTL5R842IxzSrbmGE3irXYzyXiGqkzkWfDPDiBXuQCHGHgcx0yJiVqPEAuuAPeMOxf",
+	"6vzsGKqX9Nf0koMq53jPXOdnTSfkzPkkcmZVOrc2WF0n3UfJMyZepk0I9rLTbY8iMOMVxy5doFz6UsnU",
+	"YKDVw/39rdwMJaNng3UjYpMTvhhPumXxGBzgLUB43FgTTSEkXniKzZliIgdLynolVYi8hGhaSnJl3a8r",
+	"7kLsJt42TuJ9pXWd2v8rkUuhuTZM5Bxqd9UiyDj+Y7eQgoqF7b1NuJAFlqu+UNIeUxCZLNFbysr1LjnA",
+	"hodBf/WjgVTsJ03XXXTnvyUkAwShChCi02ltAjYBaWqsUJK5Sj1+QaXUE/VExSqKlDYmRoXNWoHafwLC",
+	"ygCmOXV/M3V3YJO1KKyLg23sjxY/zBAOexaE4Lef6f+UiHm9jbfSCNfasFUDgrYcbetl6IzkS6mZaLAj",
+	"0phQJ9slOJuFXslzapzPJwzr5BG8PbtRnXC7kjPGKgjv5YIcwy/2BBw0faRlVco1FPMxkizpeSPh4Bc5",
+	"1PqvFSvafaYDLGCq5O0dAJqsiwbldRxKgBhd1aZlSdtQDW0+bHVImWZjA3ELWBN5m/X0rEV+YMZKMAqJ",
+	"SB0UBNlWLrm3fqH4au01mhlfkd8TjDXSBaF/zcxWqieg+hFTJyC2JfoI2ueoyoS7OpgufSHB0aJuhaxP",
+	"YzvzYGnBjUW7bqqA4G1WBBstu3QCBeriwmsdi9y0GeBCm3Zwacy65sltURwxLsXUUGAS9dL7+tgwhpuJ",
+	"ivmfmufs5clA/InLxGjiS3QTYRLHkfyEF5GVh/75lHz3w5Odhz88/u8nWcv34e4qx1QUI86Cz8WDjFjB",
+	"WTGtrZ70nYDMkfKPJ1ZK+EOb4oGLpHnOFfmOdotIJ4NwupaWuNK0PfDeleGyK7h50ArbId/t7zzcf/Rk",
+	"f7+9m+6EGdm3f9lYCHtpZCGAxiKbHeBBRk7r+ZwpP+7jRztP9n/8Act377la2/CGXYDVlzw6YsgSxp22",
+	"B368v/8gGFXYKc3PyHdG1WABwUA5xwvxhVDSzL65UFZca49nv32w2zpNO3p8Ovbk5vwydi40sERiMU5a",
+	"yakzhLggJPiAm7/pJsXGfu7SNBxeOXORDW5SvCjS13yI04LLCTcAxxqiqtJhS8k67INFR9Ei0QQRudhK",
+	"polm6ryjZ/WtqRjZ5Smsd8p2HxgSZqwUjyk1eMg4MaTTNOdvX/c9by6EQ1f3PpxOrVhcEv2U2RWit2c3",
+	"VQ71V8YXS5PafkmNtZ3ZWqUX8FKHLwRAQHFD1fwNMZaghX2/v0uet0hgP90FH01Hs58e7u/v70dd8R8O",
+	"VPEMQfbJMp4hsavL48MKuSCH/Gl7cZT8p6bK9Gy/HrwOmaHce85YQZa0nNt3uRlv7f/w0X8nLVMDiBkM",
+	"VImYOb0W+VJJIWtN/i1PQ5MFS5KNMLa9kzco5U7bAMPDNp1TIO8wMf66M3ywBvWGGKs7k1hnsBdkbkxQ",
+	"JimwjpyVW6zdWiWm2W8iS8enbBbWMuKHatY73pelUhJaNSWMiHJVOTe4Q8toTOH7520iqggb9wdcl2o6",
+	"jri3SdNB5Aa7IneIoOmOvK62/daXQ5zikmxTwA17JX2DlNY8qrailmh57Vd0bTWgUgrrbAAb40YHUIyH",
+	"WezHhM+aFswBx7Z3WnZOY7jTTNA8w6JikzDGdcyyqPVMbPYLvCGQ8LBK3D7j3oL+yUWRXo/NMnXhd/GR",
+	"2xseyMmFntTK3+whCmWhrKaCdV6zD6JrznQBW/BNYxHr+ADbBgBcx8yxoOHNOqN2KgO3F8VAG2n3jFXO",
+	"vtqNC0G5qXdT+AdT3bz+/UYNDnM34WkQEWXFPeeQnExOW7i0L+i36NNmRbyGm3dqD+/w23d3T2nR5UiW",
+	"2BDi2AUZHCwh9mv9N4UHtA41OkTRiKEZamIgpXg9Zk1y65Nx0WSVYhXtGQj9RLNsFsaK+aTjVr8FPgL/",
+	"sB8M85Kh+nFTpESvRdLQOWO6gHhZccX04PDU6jJeBPQTWV7FdU4ViHns0kCDPasQs3Om1pAwz89ZAf7t",
+	"yUup0l5z8AA3Q2pJ5lTZkyt8V1L7oXOq27oKGpNz0Fqm6so0Cz9dE+1uElDdnPsAZt6dmn0SBXMnfFID",
+	"tMi04QLvo9gysjm+dYQeWHuUCEHxB8TQHAVVwAl6Cr0MkmiI34zIzP7wRwXmSbKWL3e4TS3CsLyWKOUD",
+	"bL1HGnGoLUg1KD7Mdt6nffG+6D32xG4xH/DKQI0Mi0H5srax0a6khDVAMLUDl10uK870g56lZkXPEBjO",
+	"0Abmq4KD7aG5tu2PoQPF6Zr8XtS/J8wFzbhpRcVPSsuFVNwsV50rob388o8nVlAQ7EHqhKPJji1C92es",
+	"AV/QqFvwc+54A270KUZzPmwMR+Ab8NVp/OjTrPIFK+pqYBWNz7C3kmiBYSVCeiiA9XPpgoSnLMLfsxtd",
+	"NnHA/+T4/GmOoGnjlXJhOyEMmfybpAb09vE/LICo7qIg2dmhlb0XhdmxL/0+1b/aOpEEl7SY0Pb1+sVo",
+	"e8/kZQ28W1dUaUaWcvLGI9wbcj8EgzdB5uD9yc5LH6G9NYg7mTbqsOyjf6a4XRr8GwCCWwwoCTg/oDp4",
+	"SwECgJ8OYzNyyuZSsXiN27S6GOHWVwvUbaFZ/9zbAGgfTtv10iGtFvOZtcg/wZdS3L7XlGEwgzxoqNDv",
+	"oN0NwjVk0NGN666FJhPD/dA4/90Pdnu7/uLr/OxfTkZiaZbXipv1iRVDEHEOIP/ynQ0SOKhR7DhlVDH1",
+	"0h89Zmj+ZuwrFtLw7ewn91pzpktjoGTaQbHiojUgt0DBnuE+BPun2f/uwIs779y4bhTXANGOA//aNMbR",
+	"q51/snXq+5O6oqdUs4dT1uJfHl6Of+MR5ClOHa2Vy9oMFsLQgDZoqf2ADXSp5nnzKQLXniF3dZMNNyUD",
+	"W4GqiY8uxzDbc19bZ7a/+3B33xk0Ba347KfZY9u834k9gAF7eMA7cMDwS5XsLI5Bc4QSwS5c2i7xSNHY",
+	"qQpMCjQRXiH9gtn5qSzWrpmgcWHytHIsSYq9f7uyxigmbxKi37CLaJZuc1JXo0i5lD3Y2KP9hzc2+zMn",
+	"HnZX0LHqR3AKjsimPkoJ2PBk/+HQbGH5e/alT9ns+/39ze/al2J6hzpPKXr410db2MnQhYb6ny1E+GhH",
+	"aCPH3p+02e6r559CdmwyBtX+Drl8Y7iCr8XYchBPgfI4XTHDlB4sV9W8stdaIJSt6mDAk4TrJT4kn1l1",
+	"nUN6sv9kyrtPPsuBWq67Zxhd6b0/sYDsp70QD7Fn3YnDPOCfvCw1tGnsN/TUFcvtNV/4DP4EU4CrwU79",
+	"DiYOHSTtuP2jTvQqBYwAruvUNsdzQx/dNgPIImLe1Heqjyr7N8YsYONut3avGF+dYhgnEdo5324D6y8T",
+	"D7sXPuKgrlcrqtYOaRI4Qz2eBGy144xhqa98mVvLaF0NoykyFd3KSIjbzQ1UXTTLUOYRA++oIRfMWwVZ",
+	"4WVk+x6Ws4JYUZ/rMOhR2CW/JMrP9Nrog9ZoDfu75JBRDDmMCraUbG5s0ARuhWljv9e7kwjNzf/MAe5L",
+	"oLSblwdg006ychudJBPs3+IKJhK6v3QihEX63Z9Cv/t3J0RsonV368uyiAkPST3U/UUa20D5GOYD1O+r",
+	"j3zas7XydtCrOUz9J0jS1BVM65aSBcsYN9YRDFSEbwGxQyyTYlVJc6ZtK88mKDt2fJAlKytLiIFrOIl7",
+	"oMIzUxgpFH71kcfa2yWAC7nwYyjZjWW0dYZ1EwLfhBojSwYiuNudy7Fw9vVxfuBg+i5A1JbXxBooWwta",
+	"zbGkpKxHN0tTfsXRehMk9Q7s2EWAe8ubcYtX55P9H6e8++Ptkh7CBbEWnMVxaaJBQvN3qlTVkqL6t2Am",
+	"XXZLxyH1SMQufNzbYE/jsl/tSPmlLIuQtRP1PgXCKyTGXnFtMrjoIEcDvWp4+eI81jbPbVgmXvCQS7i0",
+	"ozYlEVzIIW6HlBzaU9qb1T33FsEmQtLSXMVUcKdDosqaFW6QqKRb61LvEdo/mIkuAP3WQfRu7hs/2wBZ",
+	"hK04PuYMk1/OxYFl3QZWOQWBIdFiJyDhICJjkJfFxwuysrXMN+AtCICueKn0zzLMV3WR7f4DiNO1r88V",
+	"wyehtEl4x6KSYrVmWcvyK1o5S8PL2Yh18NbzAIXbRr7WdOgBG2LM3v/VB3FzaF+lzIMYRczUTU7B5j+9",
+	"KfzTnk9p2mEh5yot9xyGAvE+JHkgycpFziJSh3dw+MyirfP82pj6GlsmcxOKVTRfcGFkEEfwcxR0EmUC",
+	"gnLj+HNZtFL+PHPuhjTVmunkFO75qtYG4ktOWUe58kpVFO614gsXKDYsJDky+sWB/7BbE2RUccKvyKvn",
+	"5LtzWf52eXn5IK1ERY6OYTXq7tUmv9tDD6i7VqB8LnWahaRwooO+t8lBvj6REM+RtTMjY8eUkaGLBvMY",
+	"nmROFd85Y+tx8RBsPKDouTqMOnlZgRPk2jfTxCKvoaRkv5fPuEaumKmVFUX6m/rMFvukK6pj9/XHZdPJ",
+	"Jnhz4v2lWWN0aLfiyIlP6rP4cboLSFjEHIC+SDfOdkgRk/Ten+iWnOjOGccV581BbDlw427vw/EfTnPf",
+	"tA7na3ffbE3d1OSJYEFnDNhwXEf24xs+rZtnD73ywNOFkhFEcbkRfxFEAYqvC252fDPc4Wu8lcrSdpxI",
+	"AZpArPA6C6ZgF0xbM6TSZpe4Rr2uklYuVcEKF4Q0mOqFgdPQNExe2DZxlKwklF4oqWEqrfnaLb3G/sDb",
+	"YW1FFy6YFisxfcq2+OQNuzTO5Z/1y+KUfpvMQcFBkPpCg6AQ/Kdmat1oBOHhRKHdbvwgdzL6FosIiYSp",
+	"RURqybAassVkntakgjTS4a1L1Zl0kwtpyiIaxDN2BQ36uejx1Fqg/kF6JaMthrZZTuRFHFkJJCBsv5KP",
+	"dyFXe7IbanjdD4OxH9j22h4as8yFTcFc/7tjKcrFXiWi9z3duRANa0MT7NKQCo2Dw7j66cs0KEWRbf/6",
+	"aJFnaxbfMZxSD9+Wdcn+6Hh/3ikBmeT+/2DI/OeMmlo5/u5S5B1FW4eCxcOMlNwFn6+6tQGFD/N3wkCS",
+	"c7dqUt6iRaE1TwIz4+fdTW6HEbd6yvZo8jbImmM2S3fKS0ZLsxw835/hcSjn1zsTfD6bIkq5ZgnoYQsS",
+	"1JYAgzUjfm3ESbBjtHHR3i7BA5tLoetVFSdTG0ZXGTGSaGYT0dbtAp9mqaQxtnQCedf5nmtiFMX6jkzB",
+	"PFxoQ0XOkrj8GrdwF5z32HbpdALLRq57HMFsE6C+Uu5n0SNCjTRZQEG6zaYrfC1xvm/cg5s53mmdN+2c",
+	"s08fr2W2wg19Zj9JypwIC9v70/7HmR0Gad++QyDmeehg3sAoWysAOHlCgO/n1OZlrc2g+OqebinA3ma0",
+	"oYUIloCdji92nwJw7usJMeyi1qCtc0nFAkL9Qv4vbDVl6bwJlLolO4hdFeYw44bcDTrBQObO1kMASqnA",
+	"EF+D+WM6W3FJDrserEmmYoHxtmLC3uqFzKEhJhI61/aqz5qrEnMwyfvj100lZZRoyQtIUg7o80FwTVZU",
+	"nfmC4b9f7qykqncqplbcGFb8nhHDSiiQehEVBcibFBCCBVFxch4q9XwQcSE2H70S1bywGwob4Uazch5S",
+	"IZ2RLJ4G09B7rNSB5Lkb6Lq3XbpaXauFnM+o6nOo7vFsjz8t+aA/nEMWhIDe+zMqs/JpoySqIW0aopFc",
+	"1RWn9dC4glO3NklGuPC5hy5mT/f7uewOHI1b6dtWOZjtmFO0x9mnj7fuww1LTR3wLx3gfKGM56YF1UT9",
+	"HM/G8JG31OrHOxHFbrbWnjxuMZKW/hNFp3Mog6mY8Sk5Qz7ak8dRatqd6DXtGa8s9Y4D46vScfqYscGV",
+	"29m3j1k8eUwW1LALuu73wiV7+jFeIC3VWPOF8BfZwa8n5IQvBFiEiOtTTp40l2SjcQScsgEwELfcRbYM",
+	"qxbbA1uEuyteNFx1pZbNfUc1eUo1z1uvuUvwV3b6/OAXwkRRSS5ME4qK8UfN7+2N75II16DRmOLQ6gPi",
+	"hnyNaMufXV0caz3mBssCawibIpRcSHUGVq6Q3obh4/bFptuIS7J2GbpJibNPbLfiYe9QWEp0vHGnem/O",
+	"HhW3cdanPn+rVtsp3N7nWE52yY8xfwztiQ1YGurQIXnZF1cuF2tuY6mkYmTFRW1SeQQ4XetID5qVXjFR",
+	"cxs/f2ejPtP9W5UdBpAl5AJutGd10svStq2T6OFobKOPDSRg/sDYZ6hrGxxdYR4sAkM+zGrN1P+lp/mH",
+	"en//0Q+0qv5vpWTxYfZgl7ywrcrtXWF59jkta6YxmPOUgcLlWqbuDhhdfDTbbGPA5N2Z7F5DroED6HVt",
+	"d/3D+9aZYrPTCVFr7uWmzFGU7JK4YiMkv63r1R/73UavtabtGzo8mOL2on2Lz22Fy95JCOztIGCL1e6t",
+	"oEnHBpbrXoL7FEPrpzHeQzf4Bv77TK5WdEcz+5I9xtLOKefhiF89Bxl0wVorwdplpSzY7CesF58Oe8BB",
+	"fuOFHg1JH+6rtKKXr/AhlCRuMT5frMe9ADRxqyaIANtfuVl6+F6P/brWU36svxAvbpPCn6FI56hsijn/",
+	"USXVpCzpRz2JCn9uJ0WG1UyNFe0wRV9h4cu3gt/WRTto62wu2dM14UXvDGMedksHeOMc4SpeMY/DfyW0",
+	"GKT5vVwKwXIznIV2DLDTTf4VgFzvklfzbqPyilotArq+X1h+gW3f6xXEZLx7bV8Bk4qvDrs7LtwFJHzm",
+	"1nhdXLx5QdGtbCthcf9zCIu0xEIE7h60SPqZxFaHEXcotn6TdDsa9m3ZvYc5vDiJ118p7jqisSxZuAOS",
+	"NX1braYZ08JVCtBLaHd6GhmeuSArXpbc9TAbCtOolQZ5OBGj4atbjjXS+JQNNUVucrfHljmwrNL1AW5W",
+	"FRoqgiB9jdYfdsWpKbEi5jbR5vakn4evhsOdsXq3MMQuhXynTWE9T1IRbQqm1AO4BKAavK8Vljn4YFEx",
+	"C78hiw8L9Taz7ZiMjVMO396J3gGEcRUZA4nvnmF5hrUX/KcbfPINCUaQDJH3FVMxXkJUMztn5XQ2d+LW",
+	"8WVLt/FKr4x+xMP8Hg1d9YVR0098da6CJWcCWg2afa5xgYaGnnh5hsqMqQag4It1V2YGr14seb70ueJu",
+	"bUljkcGWDNe4SFPDMlG0Bp20NSaKq21suyXfSVaNQw1EjOvFQHQx8l5e3pbuQTcd1nKPqC/FNmTiSqum",
+	"8N2dW7lQ0W6pUKF/Z6N0fwP1MO4aSxSbK6aXTI/ZQ+CVFlmiQQPqlhmNHZiNhN7nE9HoOMz7eWwcnd5i",
+	"9VBTy+e17w3ZYsMeDo2WBP3MqIVAxL1jbefxD5vVnX5k6aTw6A4bRcjeke3vC8BgS/tt9K0Uy6nxFqle",
+	"VhF8cQXehx9+gVY5XFjx5btwh21h91x7C5y3DFfWIzbsE6dWuhcbQTru+xwOxpqusUEUufSsKwpMsNy9",
+	"mz7wjGIqAAT6r5hZyoKs6tLwqsQvNPTHdj297afv3r3OCLNBMzBgrfFzFqqyNbIx1Y3Ub9+CIEh7wawY",
+	"hR7S8dY8755qW3+H330R9050jh26cZvjon8eMbxcTYDBiwlPdbQBdPoiipva+FV+vJH7STPTWqkf/V5q",
+	"jyrEj9VIrIVp6p+5/pXdQuw+Zl6xQETc2O6y/oUlhfDpldSGSMFC0HDTtt7EmreK0np8VHIr7jpYQRMt",
+	"XV33y6kE6goYfoHXrFsiLvAAIDXtrh3QcHog6vZvvVWt9/GUdx/f37gxXUZlTceCR16WtV6CgloLONqY",
+	"IuIqn5NpNyO6qXToBnLKrx+vadJgK0Pbz+wNXNI1NNvUWLZ0KVcstOCDuja0ad5LlJQGakc3iwxtZJur",
+	"xchqOLp6iJx/iftVXt1cuOFldzrFW/WGrtgWxoaGFN2JJRpH35Pj5yBHSMGZkEIG5b3c292kMQzPTpq1",
+	"3fB3VcwT57uebTTe6dcZnOfWPiFMOtorNKp2vS2Qn9pTdamrUHkfU1MHTFDRQd9aAVB/unerf3dnTtQM",
+	"RAi6jprffvBnwK+Ig+z9if+wF8MWhULxo11y3IunPWOsivAQqv9B8XzXsAN40OA9iYs6CUva/l5sPt2i",
+	"yqhDhL9K6lEHE0If8VFfPBah6tbSIs0Gmub5XobDZLWCKX4eCw7LqF5Vk9ypWM6E8cUZmFJSaSjzZFhZ",
+	"NvNxrWvm9H737yg17m+ayAtBclm4evIwDpQScuWhtikAdeJ7h9+ah//IbcvNlLrwQnWTAbB/xRWewm5C",
+	"k/ZElSd7rBMLlCdlmXfuwV2mjL2DrPSP1y5OfpeH220YPHbCrUotnaPac91ddmrfOH9D2Q3fR7+pgpLq",
+	"7efZBPzhP4Iou93BU3c9+rF/yS1SMYga8VyDAkfY7N026blpwjX9zQwltnZ6PU6Ju4kzrvyRD54xNkK8",
+	"atQNLus+5OYbC7mxSHET8TaA53cSbDPdzvFFSJA9pt8l8L0VvdzI+32J2RTBe6Mvplx6jJzGBg7p5T0n",
+	"+OI5QZYoRaB4ju1xjeLsvF2IGBVKTH4dqB2goLf+cJ4rE3Yx/7LcxvkLf4uTeX26LBzGb4oaFnn37qTE",
+	"4yG9jHnXPa+6E16lmJa1yieU0A5vBnkVRPVWlYxWYwWry7qS7hMY13FYyF+Pfd0ua5rCHL9QQcYjxY0J",
+	"NB6J77nFJm7hGitPsT74V5N03jzsUHUKLUMn9qFru1/J2Hfc/7yFcvw+r2/58PD6jBryle0hzerbjpzx",
+	"6MtO37aRojcxNt2G08aP/9S22nb9AKb5bh7d+BpeswXN10MhlE0zcF9W8Av14dwEKrUYUqt7/kSvzQBK",
+	"4RuJHvI33Dl+IMLAfwTHeBNN3r5AHjB+dQAWa1eub/CY4mvkhs7o6p2xtu3B9fFWba+4I1sSCFiW3lYi",
+	"8ghoQ/m40e5AvkoXb+fuGe0hOHzJ2M9uhSHc3mWFe9rqttqfwJCGmwl++XECdyzAHDO8jqmYKL58HYj1",
+	"9UpB34Bks4eseO9P+K8TdaYiJFQdARYPX09FRrxDnuKEt3y/um2lLshHae6Eh23D1F04zbd71ptL2/iv",
+	"HVSGKtxsOuQr1bu54kHf18b5imvjJPfiCo5MHvQ1fJAA7Qna5Kacvg1+GoAtWva22iVOfMuOjdZ9amc9",
+	"djNdUVqPSP7LjNZLc8upsv5N8M8pcX1tcA71Y9vEQUOc3Ofhoa9EwS494YTskIAhg2QUel1EAmuSxuVC",
+	"v53PNRtgWvtbJxJ+K2z1ytzvzljNK4vSV2Ix93wF+Qo0Xdn7c0n1cryJVtMguOTizBu0qIK2LcQeLeUi",
+	"oky6ZvhsqtT20r77M9XL63IaQGWb/tVg8hKHHQ4d6LTcpTqEQvstbPa+PLwdHLdweQ+QH9IR43O5WDIF",
+	"EdruR8B5d0rfQEGh26OP80c+625H1WKDU9C9adMYNfmu6RGnjawqVuwtuTZS8ZyWD1LY/8sjlyl4bGfa",
+	"UELeVWmEqU7XkLgsFXZ9QRmA6an14v1FfrUSV8e18IHsXf9fNtNmXdofXAfur8b4vCUApvjnX3dq/AM6",
+	"/dVqzzfkNMXBPtpzIVDLN9nuZqgqa7PQBNFvRfLsyhR/Ypyk9M1R+31voM/DE1pBNzcfPfHLo88RP/HL",
+	"oy/dd+Ag8ZX6uq4kzF3J57CthyHCty/Bx3DL6A4Q2QrZvywXx00g1uMhFnZFhvX4szCsx5+LYbkFePOw",
+	"X8g974pQrKmGNS40hzzKC9EkV9oAVyYMh+sUIkeTCZRXrTfVk8iuLvslpV6/pwFFNwsvVK4UKwSVcSkg",
+	"/Rvq+ZQgtFlDiHCCv/WpTG+qdkUlGSG6hYI8uv+LpdSM2CUhn9SNObtSbM4vB1QO+58j/8IWSsdbVTTx",
+	"xtEhQPtBC17DVyyz/IxpQ+ZcWSVoTbwJOr0YaQdNm6xh+lkWUnYo/AU/frzFSOfNB7iNgn8eiGjJaAEU",
+	"9Ofsf3csmu8gnicqUHtiIMa+AXZUwS4NqTDNdvjMPn2r6kKTfAyAbaC6dTN1vHDxdYBsxZTm2kDlCcxn",
+	"3iW+1VWonuPe53Okt5UNkLP2AV6wVSXtxw+w2oR/UTeKneKLpSHU9mkPBIo0A9ZAKO5gjQeVYhX0FHd5",
+	"j7Za2ULJWhQZqaRLMnLjY/Uxbv4W19yQyvY1t3s+DQU4XH9y3yIUmmVAm3ScnpI55WXoY07ognLhku+0",
+	"W5ErkpiWTYbuiE5oWI1bcgU/5LwBQLQpCwSEGhRfq6QyUJ2D0aL1CR9iJoVaW/tbkps4du4o5lTKklHh",
+	"+cYt9AMDgCN4tg9KvJEleGbVZ04vOmgdUDXG55vuDDa8nDcNQTo8zRC1hynCZniVDRnhWh/d8FrxDJ8j",
+	"UiXWfYwoKuebcTuLQjekIoVa37rJ98kNwuOFUlINieH9ehzI/qBO4ldVa6+5Zdxl4bCyRRZDZS62q4QZ",
+	"0jIcgybPvZBaKZkzVlgILqgqSqYBqWhubA19qMGodz+I9mXTE3XR97pQNGf2huOyQIkss3Wh7ZuYIslN",
+	"1CoCiqDtfhC+XCbcVkW0LsPyIEcLGaplRbUwo5e4JnnJKA45kHTiZgp1KbdVNbplLbM+mLVRMq4pQ8D2",
+	"z1crVnBqWLlu1URsQWzglpnLbnzVtEtmUzLML259HuBXNH58k1UwG8p0hIOHOSAADgYoeBTAzqVWO3n1",
+	"nHx3LsvfLi8vH1gByp7xmDZ8Y6j68bPc/L+0APDNlrlr1yoaxZUNKTJLRjQz9jZHLhzuc4z7YDZxy7JC",
+	"zQywxZLNDalFvqRikSztbae7FVy6eRkWYfCFyrDvXWLOeVDJv4Swla+QoTpMHyGStHSzh6WwV3bBm6sQ",
+	"N67qdg18V4SlXEel3pG4GFUlZ9qEByC/TOHNB9HCPjeb3sKs1Cx7UoWHAYA2YPwLcPfIGERo69QnYzHG",
+	"7k2R1O2bVkRoqsSHeqZOiB+Xcn2l95cuWnDUYoIvt8STWZYKWzxv6scPhy5utO0eUWuYkk6iHxB83cTX",
+	"mMbBsoGgsqih+Tkr1wOThjduQeJ+fvvVfr+OCyH7cxZMHlBahJZ6VPzu0cI2kjhQLdAdmPz8GJxB6xUK",
+	"bRlAKXNmnyHqajj/l0xazwOyV47IrPdpnMQSiD7bSwQYZ3fmjLtNdcWemsWJsYQg+w4Azln7/tpNT6fT",
+	"a0RrXFxByINP96jKl5YFD4l5J0ZhhV7i3kRdqeHzRjGWeesukUjX83K9S164Vt5gU6Ir6y9hJQVbl3Nh",
+	"VBTaejkzaxhzMj84cIv/otlCfDi3c/c6MBCXBDRo28KHKQ5kqNpd/BF5ZA1Vs6z5+Q9eXd8zK3PDzI4G",
+	"hGqzkJC9dMoFtmzvzvQpG9izn+uecUy/6OWFgOyQhohpIKRt2Ycxip/WPh4qbXF5BiYTpHimVlxrawQ9",
+	"5aZpEGCjWBSylp4AkpGSn1kvzEoW8EG+lBdi94MAHuBSXSDBS8l6gV5YW/4fYkJ8dAz0eQKz90oWjOz/",
+	"8OQJNJiCHhY5FX+DsG7bvNEw8UG4eBohxQ58WWumQvXHRuMN5vH135RdIZqGiK1X0wjAqPQ2kPog7D6d",
+	"15c5JnnKSnnRYqy0GZEYKTOi1yub5OPf5WiW0me8qtKW+Ngi1eabzal9VtZ5S9Ytu8dmi5/JvtVdxLAA",
+	"1Lzlz/ve5nVlu8EJQ6EoorftuVouq/VIfKes1kmjgVGM9bUb+47pdbILrGSFblaMMXGYB+YzWXH0jzsH",
+	"bOPNqqh2rWQbhpeX3MZ/jIVytFiA3cQm4ndVC86/Vh5g97gV9T+8hemH6f6ZO2w86Xuav7pL3xJkyNTd",
+	"jtQLJwxtUoBCnrM9sY51MAr+ci9YJHetx6xKhCIKdHOzb8FTOYeCdOzSMGHlod0P4sRf8PZen8uylBes",
+	"yAj1N7+LCzVULZghhWTaii0QyUbaLIej62puA2pSksGAPuUlwy9MobJruyVd6jNqMC8jjLpXX66gvsQk",
+	"OWCktJG5fZL2MaCuX1nhRHtKPDNoxY24GaBdGUSCwa+a/4FhjStZ8DnPmzjpRovp38Y/M1rcE94I4SXm",
+	"B/7WCbN2V+fOayYWZjnwIRwRF+R0jULgSKGsRDt4P8U7ePTnwN3tWbmvFZE1DL7L/ke5/3i8/uw11Wbn",
+	"EDCNJRDaPu4j4meLJ//WOM4/vE7hMXBrKWOhWDUsYTBrfnHuXng/bWOFuD8r+Jce13znhJILpjF0HUO/",
+	"FVvUJVWEXVaKgbnlg+CCHL94RPRaGHq5S9B4YiUNxSjoGUDokLUR2Rp8QKAXR3Y/iKdwxUVuHvxXacUS",
+	"ux4qyMN9csifxvYJJAwNW8V+2oTODVPk4f7+/j4O8UG4/ax6JZNcWP4Wssw/LMi/LHZ63DsVt68Co/O1",
+	"IeycqTWc5zCjNUyJ0YWs6KVnjA/3Hz2B8k/hh2wbA7Z0BX6MdEd3Y86tTgKSTd3SfTqIEqF8Ygb6DQAI",
+	"GYEiDr//fXchfx9Y2aKUp9slQx3aieJpSE412+FCW1ZtxjzafCGkYs+o3tKlPaFmWCBupHXso1QrMbCS",
+	"Fb08RIBdtWhYXDXs4S20SNmkPVv6HdOeD1sAuRegO1Yw4LOxhHwtL+HqrOBqc8azIGxVmXXkyeuZwsH6",
+	"Lxbe9deKEFAhSwSulbjHeXMXOtXWPlRKqukWr0PYw7dq74bdfUZj11AtvuYucUd7b+e6HSHV0eB42M4o",
+	"kVeKab4Qw2Tu9WZK9FIqs1NC62/7DSugIpKRjQrtDORgKvMpRLg4m5ihJcqL4X1NCin+hrbtridvl4B8",
+	"gCKBU6uoboRhefpvlod0F7ceqtG3RxXLCJjem+pNK2qY4rTkf4CF3Ug7lrHBMQs/2EBI6hBzOXKw+1bZ",
+	"i9vfZ/SlhRWMlBZuMPGe2dxQnhz19BQI+/3x6+15i9MeNqrAXa23XZQgahWIXvNG5S3LqKMsFn7wuXQw",
+	"DtfkgpZnTcapG9FXaeuovJijbEMHatPVf51g7d5rirY3+vMWauqJV6u+zAimoPhh0MEtqX+HkW7njzZS",
+	"/Vx5WXzuRhkugHEFbW946lGts5SLG1Y7e0YgQ0pGfaJFbM/MCLu0dUeZbsvQogiIPKQacnHC/2A32zkg",
+	"vfaVvOGl08vbXHrgKs7QardgjW5zXz7SWVWTS3PfHNiX0wssqGE7bogr4WVY1ymbS8WmLukpvH2lNf1F",
+	"QpCDLQGQ996WMGRLuJYNQRtqBgWA2CXnr2S0grcM3kVsmQxeceesczHk4FjpmBemhxTbEk5fYgbP7UcR",
+	"P5OrqnaJsSc/H+w8+v6HxpWZgZcAz+diKd2BDKwF62XUq+vm9dwsE4CTHfLDe5y7p/0ruMWiSsfb8gQk",
+	"4Qm1FD2xtxOHIGjOxcNwiIbxBGAlV7AeTtfhXfjNN6vDu/19gUZCt7J7rf2mtHYdUHlrghT5CDXKlb1Y",
+	"3TVNBZ8zrIVHSSlzWkb3cwiJg3ET4e0txR4MgpbIRf5BQB1HjJnQrv4SRsHDUN5zHQfQYjCOYiTHBfp6",
+	"mFz5myxzVXHCLbay87RYyfumZYYrIolLjy2NPgcKdnf0/h2+soeLBQ2GXRpFc5OFXKcPwshmpV3PCGbl",
+	"ZhGkYjWoY/1ogAfNg6yI8zcf+fdBhPOwgMBxC5c6oSxgyc4O/ppMFRjkiSL/hhmiyD+jRROnH0+M1E0z",
+	"l3uueI0AYWALQ2yK9ghse8bpzsiyznpiFHG7jiNWbmQ66RnVRDBWgPXxXTfMOIo+Izz4R1zHa2QnTJ1j",
+	"JJo34roqfAUzLDeu6SBykRCR5scFq6ZP0zplC459DNxTv5JaQDUzzVySlfvdBs/tfhDA6gJnNO1EB+gj",
+	"lZHFH7zasfihmIbGHVRZJe8PXnmumxHNSlzv6bo1ioVD9kHYVXKblFXR/Mx7dlqZpdaiYzeUETsNU+e+",
+	"ll/zhjaqzk2tMLqzyVdLxh4d1UmuiVfJl2bUZVY9hrV3YjozYhoxOqKNJRP+1IZNrtdXPN/DecEaQi6f",
+	"v2iHj3BgOW6914y/GQ7vBNsvX9EF26vEIvO0BbCKydBT2mBHv4hCtjMUH3VSKFv0L4jMDS2JkAZOOoNM",
+	"R1yeK2a1S97Yf9SV83B0jnl32JrYXii7pKuqtI/2f4iDaEeivCDJs9ZMWaRvgRXTM6+/ypoXG6zDPsTp",
+	"yaMfn/z4w389+vHJtiZj3IatVlrd2j4Wd7CPp1SzH574Nkbk8Pn3pOALJ9HH7PW745fPyMP//uHJgyyi",
+	"UiwF+m9kyLz9hc9NAfeJ3yJGzzZ79BHWh8+/344CfmaX9mo4ba/f26ySe7jRhV/ueAvXjl7SR9//MLsR",
+	"AdbegNtmlWQ3lp/SHulyx1B1vSGusJs7NUjgJb2xMom3SbTyD168o4u+kPf/1tKi1JJd9pDSI4xHy3DR",
+	"IdvwdQb7V+6XH8G/jT7w5OHju6lc7CidXWLB3TioHIwFYLNwZJnFOjY8xVLHPl+jVwT5azHQOovGxjyp",
+	"IcVG52ebGiRRYt8iQSpGRPdSdezJ6URxcJFLgdX5c8402ikKKhal/ZgLWTCdgQjOjf4gPPztpzDiaSlt",
+	"TW4fTioVEZKUUtgUBMXmTDGRs8LJa+jBpSRXVC/Jihc7ttADC9GpFeUqc1YUv2Su3QMXjQpUK5qhW8to",
+	"mVzQjuNX1n0NrFs+vMTrcxZo2ONTipzZrfj2kUvaKgOIpfBY3DeggT3A1Wgy51AyWk819NhzvvH6zMcA",
+	"PFhh96whLXWwKJz97Lqeo5uuF//WwzB5TbQp4L64c9IEAyjusLhBh60sLStmFM83dL23ZKotq0CihUDS",
+	"qjYdFiTPmXJdbagO5OjtCk2BFzCTNIU3ncOpGZVrYvfICjdi/PEueSUMU+e01MFHTf1jUutOj4wlPQfK",
+	"Z8JM81cfOnB8WWaG94JfAmS1oasqROwBVfhD4A4uGZS3YLkUhc58OyHtDWPYZ8gdevv8ZoPdm5S5yeig",
+	"gc0wUWy3lZJuuRMmimvs4w5L2yISzq7cG7Udign4fB9+ky5zHgC0BcsMPGRCIWdqvURLJYWsdXOf6a6n",
+	"zv4bAvsUy6EYxtTizW+btdyAtPGVhKZtQUqRkLGZmt4OnM830I7sK69WLWM0n0yoVvjfTKLYI6Yjy3Dh",
+	"hQqm7TVkY2uDX4OhvkCksA+vTLvH9T3Vpqn2uJ5Er4eJk7un1c9Nq6rejkpr0dSSH8qRAwds41Pu9W9y",
+	"wdtWUW81cWKi0KPBap6Q3otQy/1rbFTjINTu73GvJzvs9PizfZS1i5jaZLHD17BMCKZges9hRZXRu+TI",
+	"/scnUwY7NReEijWmN/l2jor7uh7e6+nTt4M7tPG4WHiCgWxSQOZ7t5lvMfQIAz28++GzBGMi3N67uKJU",
+	"Cx44tpYp6z7y6CrJE0Bzq7o0vGqo7wpkvfcn/mND88GDUwlW+e6Mrh2DzqlCnVuxnEH6NlL9tP4mjirf",
+	"u5V8dsvThvvOQ2w2rWeIQ3p6Ku/ttz1ERsSahMjZuHVWG2qcAy6Jpa4xgNENjmpJ5lRNsYl+Qxi6/xm4",
+	"vWF/EYvazXLkPS/cDAtfB1qzlW3l3We+UZBbFKIHBSSdMObrVmA5KB+v+TB4FRa00tuIVZ48nvllf8Vk",
+	"8tkCQu6FouuEY1u0u2kqBGra+9P+5w1QyqfBeOwo2cOHfsGNZL/1qSCoI8HyfPP8qqQ5eAV3J4QCd4gN",
+	"SPkorO3robl+BKrU3LRCxFUoQI3xTKA4APwMeZhedhVDYnjh4zXqJhWpm6LBXbOy890ljiA2WTRKMSj7",
+	"u8sAmN2Hh/0lw8NSEWAVusWn81ZNF2w0zKKUC24zaSBHYrnW8IeHAnzedRs2fol8WYszUrCiDmcL4/jk",
+	"DxdEY7g2PNeTpH6NNvDPbSu6XfkdNjnc+RsP7S/lEK/duacR+4KdLqU8m+BWAxr2r2dx9XeuiGa5Ykan",
+	"0PBXP8NduJssRNyE1wu3aO12W4T5rEjgzzksHtq8jxcOiHeL4VsOedg5izxy8BpVjNjhsHyA/dnWkqOa",
+	"/M/J2zeZr4QWUpsDVBFFdslLyksbGcpsZcRQ09RZym2sLLvAaCK4UwQplITeXUnVrYVcN2+FfsMuWhh1",
+	"twZoPJ6it4LOVR2d3V3oXV8acsdcbO9P968NFuDQ1DpG/MxjOy0Vo8WanDLnk7SIygqyojbzkZclOfUk",
+	"MGQT9nj5q1/O1n7IsJGJhtkWGhS339n5i0MDmEade/DWqpz9NFsaU+mf9vZoxXdXUtW7XM6iAf704oxh",
+	"q6qkBupahR9DwEj8o78+o5+oXVn8N1wqOxCC0H6x4jtnbN2exN2c0U/RtRPNUVih+eOn/38A++UE2+Bv",
+	"AgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,99 @@
+// Package audit records who did what on the volumes of a team, for the audit log of the team.
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// Audited actions
+const (
+	ActionVolumeCreate = "volume.create"
+	ActionVolumeDelete = "volume.delete"
+	ActionVolumeAttach = "volume.attach"
+	ActionVolumeDetach = "volume.detach"
+	ActionFileUpload   = "file.upload"
+	ActionFileDownload = "file.download"
+	ActionFileDelete   = "file.delete"
+)
+
+// Actions lists the audited actions
+var Actions = []string{
+	ActionVolumeCreate,
+	ActionVolumeDelete,
+	ActionVolumeAttach,
+	ActionVolumeDetach,
+	ActionFileUpload,
+	ActionFileDownload,
+	ActionFileDelete,
+}
+
+// Actor types, the actor ID is the ID of the API key or of the user
+const (
+	ActorAPIKey = "api_key"
+	ActorUser   = "user"
+)
+
+// Entry is an operation of an actor of the team.
+type Entry struct {
+	TeamID    uuid.UUID
+	Action    string
+	ActorType string
+	ActorID   uuid.UUID
+	VolumeID  string
+	// Path is the file of the file actions
+	Path string
+	// SandboxID is the sandbox of the attach and detach actions
+	SandboxID string
+	SourceIP  string
+}
+
+// Recorder stores the audit log entries in PostgreSQL.
+type Recorder struct {
+	db *sqlcdb.Client
+}
+
+func NewRecorder(db *sqlcdb.Client) *Recorder {
+	return &Recorder{db: db}
+}
+
+// Record stores the entry in the background, so the audited request doesn't wait for it.
+// A failure is logged, it doesn't fail the operation that already happened.
+func (r *Recorder) Record(ctx context.Context, entry Entry) {
+	params := queries.CreateAuditLogParams{
+		TeamID:    entry.TeamID,
+		Action:    entry.Action,
+		ActorType: entry.ActorType,
+		VolumeID:  optional(entry.VolumeID),
+		Path:      optional(entry.Path),
+		SandboxID: optional(entry.SandboxID),
+		SourceIp:  optional(entry.SourceIP),
+	}
+	if entry.ActorID != uuid.Nil {
+		params.ActorID = &entry.ActorID
+	}
+
+	go func() {
+		if err := r.db.CreateAuditLog(context.WithoutCancel(ctx), params); err != nil {
+			logger.L().Error(ctx, "Failed to record audit log entry",
+				zap.Error(err),
+				zap.String("action", entry.Action),
+				zap.String("volume_id", entry.VolumeID),
+				logger.WithTeamID(entry.TeamID.String()))
+		}
+	}()
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// BasicAuthMiddleware authenticates the team by an API key sent as the password of Basic credentials,
+// the username is ignored. It serves the routes outside of the OpenAPI spec used by clients
+// that can't send the X-API-Key header, like WebDAV clients. The X-API-Key header is accepted too.
+// A password that isn't an API key is the secret access key of the S3 credentials with the username
+// as access key ID, the credentials restricted to a volume set their scope in the context.
+func BasicAuthMiddleware(
+	realm string,
+	teamValidationFunction func(context.Context, string) (*types.Team, *api.APIError),
+	s3CredentialsFunction func(context.Context, string) (*types.S3Credentials, *api.APIError),
+) gin.HandlerFunc {
+	challenge := fmt.Sprintf("Basic realm=%q", realm)
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		apiKey := c.GetHeader("X-API-Key")
+		username, password := "", ""
+		if apiKey == "" {
+			username, password, _ = c.Request.BasicAuth()
+			apiKey = password
+		}
+
+		if apiKey == "" {
+			c.Header("WWW-Authenticate", challenge)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, api.Error{
+				Code:    http.StatusUnauthorized,
+				Message: "Missing API key, send it as the password of Basic credentials",
+			})
+
+			return
+		}
+
+		var credentials *types.S3Credentials
+		var apiErr *api.APIError
+		if password != "" && !strings.HasPrefix(password, apiKeyPrefix) {
+			credentials, apiErr = authenticateS3Credentials(ctx, s3CredentialsFunction, username, password)
+		} else {
+			var team *types.Team
+			team, apiErr = teamValidationFunction(ctx, apiKey)
+			credentials = &types.S3Credentials{Team: team}
+		}
+
+		if apiErr != nil {
+			logger.L().Info(ctx, "validation error", zap.Error(apiErr.Err))
+
+			if apiErr.Code == http.StatusUnauthorized {
+				c.Header("WWW-Authenticate", challenge)
+			}
+			c.AbortWithStatusJSON(apiErr.Code, api.Error{
+				Code:    int32(apiErr.Code),
+				Message: apiErr.ClientMsg,
+			})
+
+			return
+		}
+
+		setS3Credentials(c, credentials)
+		c.Next()
+	}
+}
@@ -140,6 +140,7 @@ func CreateAuthenticationFunc(
 	userValidationFunction func(context.Context, string) (uuid.UUID, *api.APIError),
 	supabaseTokenValidationFunction func(context.Context, string) (uuid.UUID, *api.APIError),
 	supabaseTeamValidationFunction func(context.Context, string) (*types.Team, *api.APIError),
+	s3CredentialsFunction func(context.Context, string) (*types.S3Credentials, *api.APIError),
 ) openapi3filter.AuthenticationFunc {
 	authenticators := []authenticator{
 		&commonAuthenticator[*types.Team]{
@@ -197,6 +198,9 @@ func CreateAuthenticationFunc(
 			contextKey:         "",
 			errorMessage:       "Invalid Access token.",
 		},
+		&volumeCredentialsAuthenticator{
+			credentialsFunction: s3CredentialsFunction,
+		},
 	}
 
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
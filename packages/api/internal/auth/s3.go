@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// S3Error is the error document of the S3 protocol.
+type S3Error struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource,omitempty"`
+}
+
+// S3ChunkVerifierContextKey holds the *S3ChunkVerifier of a streaming upload with signed chunks.
+const S3ChunkVerifierContextKey = "s3ChunkVerifier"
+
+// S3AuthMiddleware authenticates the team by the S3 credentials that signed the request with AWS Signature
+// Version 4, in the Authorization header or in the query of a presigned URL. The secret access key of the
+// credentials is looked up by their access key ID. Content sent with its SHA-256 as the payload hash is verified
+// while it's read, the chunk signatures of streaming uploads by the S3ChunkVerifier set in the context.
+func S3AuthMiddleware(credentialsFunction func(context.Context, string) (*types.S3Credentials, *api.APIError)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		signature, err := parseS3Signature(c.Request)
+		if err != nil {
+			abortS3AuthError(c, err)
+
+			return
+		}
+
+		if signature == nil {
+			abortS3(c, http.StatusForbidden, "AccessDenied", "Missing credentials, sign the request with AWS Signature Version 4 and the S3 credentials of the team")
+
+			return
+		}
+
+		if err := signature.checkTime(time.Now()); err != nil {
+			abortS3AuthError(c, err)
+
+			return
+		}
+
+		credentials, apiErr := credentialsFunction(ctx, signature.accessKeyID)
+		if apiErr != nil {
+			logger.L().Info(ctx, "validation error", zap.Error(apiErr.Err))
+
+			if apiErr.Code == http.StatusUnauthorized {
+				abortS3(c, http.StatusForbidden, "InvalidAccessKeyId", apiErr.ClientMsg)
+
+				return
+			}
+			abortS3(c, apiErr.Code, "AccessDenied", apiErr.ClientMsg)
+
+			return
+		}
+
+		if err := signature.verify(c.Request, credentials.SecretAccessKey); err != nil {
+			abortS3AuthError(c, err)
+
+			return
+		}
+
+		switch {
+		case isHexSHA256(signature.payloadHash) && c.Request.Body != nil:
+			c.Request.Body = newS3PayloadVerifier(c.Request.Body, signature.payloadHash)
+		case signature.payloadHash == S3StreamingSignedPayload || signature.payloadHash == S3StreamingSignedPayloadTrailer:
+			c.Set(S3ChunkVerifierContextKey, newS3ChunkVerifier(signature, credentials.SecretAccessKey))
+		}
+
+		setS3Credentials(c, credentials)
+		c.Next()
+	}
+}
+
+func abortS3AuthError(c *gin.Context, err error) {
+	var authErr *s3AuthError
+	if errors.As(err, &authErr) {
+		abortS3(c, authErr.status, authErr.code, authErr.message)
+
+		return
+	}
+
+	abortS3(c, http.StatusForbidden, "AccessDenied", err.Error())
+}
+
+func abortS3(c *gin.Context, status int, code, message string) {
+	if c.Request.Method == http.MethodHead {
+		c.AbortWithStatus(status)
+
+		return
+	}
+
+	c.Abort()
+	c.XML(status, S3Error{Code: code, Message: message, Resource: c.Request.URL.Path})
+}
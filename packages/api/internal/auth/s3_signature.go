@@ -0,0 +1,487 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4 of the S3 protocol, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+const (
+	s3SignatureAlgorithm      = "AWS4-HMAC-SHA256"
+	s3ChunkSignatureAlgorithm = "AWS4-HMAC-SHA256-PAYLOAD"
+	s3SignatureService        = "s3"
+	s3SignatureTerminator     = "aws4_request"
+	s3SignatureTimeFormat     = "20060102T150405Z"
+	s3SignatureDateFormat     = "20060102"
+
+	// s3MaxClockSkew is how far the time of a signed request may be from the time of the API, like in S3
+	s3MaxClockSkew = 15 * time.Minute
+	// s3MaxPresignExpires bounds the validity of presigned URLs, like in S3
+	s3MaxPresignExpires = 7 * 24 * time.Hour
+
+	// The payload hashes of the requests that don't hash the whole content
+	S3UnsignedPayload                 = "UNSIGNED-PAYLOAD"
+	S3StreamingSignedPayload          = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	S3StreamingSignedPayloadTrailer   = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	S3StreamingUnsignedPayloadTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+)
+
+// emptySHA256 is the hex encoded SHA-256 of empty content.
+var emptySHA256 = hex.EncodeToString(sha256.New().Sum(nil))
+
+var (
+	// ErrS3SignatureMismatch is returned for a request or a chunk whose signature isn't the one computed
+	// with the secret access key.
+	ErrS3SignatureMismatch = errors.New("the request signature we calculated does not match the signature you provided, check your key and signing method")
+
+	// ErrS3ContentSHA256Mismatch is returned for content that doesn't match the payload hash of the request.
+	ErrS3ContentSHA256Mismatch = errors.New("the provided 'x-amz-content-sha256' header does not match what was computed")
+)
+
+// s3AuthError is an authentication error of the S3 protocol.
+type s3AuthError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *s3AuthError) Error() string {
+	return e.message
+}
+
+func s3MalformedError(presigned bool, format string, args ...any) *s3AuthError {
+	code := "AuthorizationHeaderMalformed"
+	if presigned {
+		code = "AuthorizationQueryParametersError"
+	}
+
+	return &s3AuthError{status: http.StatusBadRequest, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// s3Signature is the AWS Signature Version 4 of a request, sent in the Authorization header
+// or in the query of a presigned URL.
+type s3Signature struct {
+	accessKeyID string
+	// amzDate is the time of the request in the format of the string to sign
+	amzDate string
+	time    time.Time
+	// date and region are the scope of the signing key
+	date          string
+	region        string
+	signedHeaders []string
+	signature     string
+	payloadHash   string
+
+	presigned bool
+	expires   time.Duration
+}
+
+// scope is the credential scope of the signature.
+func (s *s3Signature) scope() string {
+	return strings.Join([]string{s.date, s.region, s3SignatureService, s3SignatureTerminator}, "/")
+}
+
+// parseS3Signature reads the signature of a request, nil when the request isn't signed.
+func parseS3Signature(r *http.Request) (*s3Signature, error) {
+	query := r.URL.Query()
+	if query.Has("X-Amz-Credential") {
+		return parseS3PresignedSignature(query)
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return nil, nil
+	}
+
+	algorithm, params, _ := strings.Cut(authorization, " ")
+	if algorithm != s3SignatureAlgorithm {
+		return nil, &s3AuthError{
+			status:  http.StatusBadRequest,
+			code:    "InvalidArgument",
+			message: fmt.Sprintf("unsupported authorization type, only %s is supported", s3SignatureAlgorithm),
+		}
+	}
+
+	fields := make(map[string]string)
+	for _, param := range strings.Split(params, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		fields[name] = value
+	}
+
+	s := &s3Signature{
+		signature:   fields["Signature"],
+		payloadHash: r.Header.Get("X-Amz-Content-Sha256"),
+	}
+	if err := s.parseCredential(fields["Credential"]); err != nil {
+		return nil, err
+	}
+	if err := s.parseSignedHeaders(fields["SignedHeaders"]); err != nil {
+		return nil, err
+	}
+	if s.signature == "" {
+		return nil, s3MalformedError(false, "the authorization header is missing the signature")
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	if amzDate == "" {
+		return nil, &s3AuthError{status: http.StatusForbidden, code: "AccessDenied", message: "the request is missing the x-amz-date header"}
+	}
+	if err := s.parseTime(amzDate); err != nil {
+		return nil, err
+	}
+
+	if s.payloadHash == "" {
+		return nil, &s3AuthError{status: http.StatusBadRequest, code: "InvalidRequest", message: "missing required header for this request: x-amz-content-sha256"}
+	}
+	if !validS3PayloadHash(s.payloadHash) {
+		return nil, &s3AuthError{status: http.StatusBadRequest, code: "InvalidArgument", message: "x-amz-content-sha256 must be UNSIGNED-PAYLOAD, a streaming payload or a valid sha256 value"}
+	}
+
+	// The headers of the S3 protocol are all signed
+	for name := range r.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") && !slices.Contains(s.signedHeaders, name) {
+			return nil, &s3AuthError{status: http.StatusForbidden, code: "AccessDenied", message: fmt.Sprintf("there were headers present in the request which were not signed: %s", name)}
+		}
+	}
+
+	return s, nil
+}
+
+// parseS3PresignedSignature reads the signature of a presigned URL.
+func parseS3PresignedSignature(query url.Values) (*s3Signature, error) {
+	if algorithm := query.Get("X-Amz-Algorithm"); algorithm != s3SignatureAlgorithm {
+		return nil, s3MalformedError(true, "X-Amz-Algorithm only supports %s", s3SignatureAlgorithm)
+	}
+
+	s := &s3Signature{
+		signature:   query.Get("X-Amz-Signature"),
+		payloadHash: S3UnsignedPayload,
+		presigned:   true,
+	}
+	if err := s.parseCredential(query.Get("X-Amz-Credential")); err != nil {
+		return nil, err
+	}
+	if err := s.parseSignedHeaders(query.Get("X-Amz-SignedHeaders")); err != nil {
+		return nil, err
+	}
+	if s.signature == "" {
+		return nil, s3MalformedError(true, "the query is missing X-Amz-Signature")
+	}
+	if err := s.parseTime(query.Get("X-Amz-Date")); err != nil {
+		return nil, err
+	}
+
+	expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil || expires <= 0 {
+		return nil, s3MalformedError(true, "X-Amz-Expires should be a number of seconds greater than 0")
+	}
+	s.expires = time.Duration(expires) * time.Second
+	if s.expires > s3MaxPresignExpires {
+		return nil, s3MalformedError(true, "X-Amz-Expires must be less than a week (in seconds) that is 604800")
+	}
+
+	// Presigned URLs can sign their content like the requests
+	if payloadHash := query.Get("X-Amz-Content-Sha256"); payloadHash != "" {
+		if payloadHash != S3UnsignedPayload && !isHexSHA256(payloadHash) {
+			return nil, s3MalformedError(true, "X-Amz-Content-Sha256 must be UNSIGNED-PAYLOAD or a valid sha256 value")
+		}
+		s.payloadHash = payloadHash
+	}
+
+	return s, nil
+}
+
+// parseCredential reads the access key ID and the scope of Credential=<access key ID>/<date>/<region>/s3/aws4_request.
+func (s *s3Signature) parseCredential(credential string) error {
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[0] == "" || parts[2] == "" {
+		return s3MalformedError(s.presigned, "the credential is malformed, expecting \"<YOUR-AKID>/YYYYMMDD/REGION/SERVICE/aws4_request\"")
+	}
+	if parts[3] != s3SignatureService {
+		return s3MalformedError(s.presigned, "the credential is scoped to the service '%s' instead of '%s'", parts[3], s3SignatureService)
+	}
+	if parts[4] != s3SignatureTerminator {
+		return s3MalformedError(s.presigned, "the credential should be terminated by '%s'", s3SignatureTerminator)
+	}
+
+	s.accessKeyID, s.date, s.region = parts[0], parts[1], parts[2]
+
+	return nil
+}
+
+// parseSignedHeaders reads the semicolon separated names of the signed headers, the host is always signed.
+func (s *s3Signature) parseSignedHeaders(signedHeaders string) error {
+	if signedHeaders == "" {
+		return s3MalformedError(s.presigned, "the signed headers are missing")
+	}
+
+	s.signedHeaders = strings.Split(signedHeaders, ";")
+	if !slices.Contains(s.signedHeaders, "host") {
+		return &s3AuthError{status: http.StatusForbidden, code: "AccessDenied", message: "the host header must be signed"}
+	}
+
+	return nil
+}
+
+// parseTime reads the time of the request, the date of the credential scope must be its date.
+func (s *s3Signature) parseTime(amzDate string) error {
+	t, err := time.Parse(s3SignatureTimeFormat, amzDate)
+	if err != nil {
+		return s3MalformedError(s.presigned, "X-Amz-Date must be in the ISO8601 Long Format \"yyyyMMdd'T'HHmmss'Z'\"")
+	}
+	if t.Format(s3SignatureDateFormat) != s.date {
+		return s3MalformedError(s.presigned, "the date of the credential '%s' isn't the date of the request '%s'", s.date, amzDate)
+	}
+
+	s.amzDate, s.time = amzDate, t
+
+	return nil
+}
+
+// checkTime returns an error when the request was signed too far from now, or when the presigned URL expired.
+func (s *s3Signature) checkTime(now time.Time) error {
+	if s.time.After(now.Add(s3MaxClockSkew)) || (!s.presigned && s.time.Before(now.Add(-s3MaxClockSkew))) {
+		return &s3AuthError{status: http.StatusForbidden, code: "RequestTimeTooSkewed", message: "the difference between the request time and the current time is too large"}
+	}
+	if s.presigned && now.After(s.time.Add(s.expires)) {
+		return &s3AuthError{status: http.StatusForbidden, code: "AccessDenied", message: "request has expired"}
+	}
+
+	return nil
+}
+
+// verify checks the signature of the request with the secret access key.
+func (s *s3Signature) verify(r *http.Request, secretAccessKey string) error {
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		s3CanonicalURI(r),
+		s3CanonicalQuery(r.URL.RawQuery, s.presigned),
+		s.canonicalHeaders(r),
+		strings.Join(s.signedHeaders, ";"),
+		s.payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		s3SignatureAlgorithm,
+		s.amzDate,
+		s.scope(),
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	expected := hex.EncodeToString(hmacSHA256(s.signingKey(secretAccessKey), stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(s.signature)) {
+		return &s3AuthError{status: http.StatusForbidden, code: "SignatureDoesNotMatch", message: ErrS3SignatureMismatch.Error()}
+	}
+
+	return nil
+}
+
+// signingKey derives the key of the credential scope from the secret access key.
+func (s *s3Signature) signingKey(secretAccessKey string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), s.date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3SignatureService)
+
+	return hmacSHA256(key, s3SignatureTerminator)
+}
+
+// canonicalHeaders returns the signed headers, one name:value per line, the values trimmed and
+// the values of a repeated header separated by commas.
+func (s *s3Signature) canonicalHeaders(r *http.Request) string {
+	var b strings.Builder
+	for _, name := range s.signedHeaders {
+		var values []string
+		switch {
+		case name == "host":
+			// The server moves the host out of the headers
+			values = []string{r.Host}
+		case name == "content-length" && r.Header.Get("Content-Length") == "":
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		default:
+			values = slices.Clone(r.Header.Values(name))
+		}
+
+		for i, value := range values {
+			values[i] = strings.Join(strings.Fields(value), " ")
+		}
+
+		b.WriteString(name)
+		b.WriteByte(':')
+		b.WriteString(strings.Join(values, ","))
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// s3CanonicalURI returns the path of the request as sent by the client. S3 signs the path encoded once,
+// so the clients sign the path they send without encoding it again.
+func s3CanonicalURI(r *http.Request) string {
+	path := r.URL.EscapedPath()
+	if r.RequestURI != "" && !strings.Contains(r.RequestURI, "://") {
+		path, _, _ = strings.Cut(r.RequestURI, "?")
+	}
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+// s3CanonicalQuery returns the parameters of the query sorted by name and encoded like in the string to sign,
+// without the signature of a presigned URL.
+func s3CanonicalQuery(rawQuery string, presigned bool) string {
+	type param struct{ name, value string }
+
+	var params []param
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+
+		name, value, _ := strings.Cut(pair, "=")
+		name, _ = url.QueryUnescape(name)
+		value, _ = url.QueryUnescape(value)
+		if presigned && name == "X-Amz-Signature" {
+			continue
+		}
+
+		params = append(params, param{name: s3URIEncode(name), value: s3URIEncode(value)})
+	}
+
+	slices.SortFunc(params, func(a, b param) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.value, b.value)
+	})
+
+	pairs := make([]string, len(params))
+	for i, p := range params {
+		pairs[i] = p.name + "=" + p.value
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode percent-encodes every byte but the unreserved characters of RFC 3986.
+func s3URIEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+// validS3PayloadHash returns whether the payload hash of a request signed in the Authorization header is supported.
+func validS3PayloadHash(payloadHash string) bool {
+	switch payloadHash {
+	case S3UnsignedPayload, S3StreamingSignedPayload, S3StreamingSignedPayloadTrailer, S3StreamingUnsignedPayloadTrailer:
+		return true
+	}
+
+	return isHexSHA256(payloadHash)
+}
+
+func isHexSHA256(s string) bool {
+	digest, err := hex.DecodeString(s)
+
+	return err == nil && len(digest) == sha256.Size
+}
+
+func hexSHA256(data []byte) string {
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// S3ChunkVerifier verifies the chunk signatures of a streaming upload. Each chunk is signed with
+// the signature of the previous one, the first with the signature of the request.
+type S3ChunkVerifier struct {
+	key      []byte
+	amzDate  string
+	scope    string
+	previous string
+}
+
+func newS3ChunkVerifier(s *s3Signature, secretAccessKey string) *S3ChunkVerifier {
+	return &S3ChunkVerifier{
+		key:      s.signingKey(secretAccessKey),
+		amzDate:  s.amzDate,
+		scope:    s.scope(),
+		previous: s.signature,
+	}
+}
+
+// Verify checks the signature of the next chunk from the SHA-256 of its data.
+func (v *S3ChunkVerifier) Verify(chunkSHA256 []byte, signature string) error {
+	stringToSign := strings.Join([]string{
+		s3ChunkSignatureAlgorithm,
+		v.amzDate,
+		v.scope,
+		v.previous,
+		emptySHA256,
+		hex.EncodeToString(chunkSHA256),
+	}, "\n")
+
+	expected := hex.EncodeToString(hmacSHA256(v.key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrS3SignatureMismatch
+	}
+	v.previous = expected
+
+	return nil
+}
+
+// s3PayloadVerifier fails the read of the end of content that doesn't match the payload hash of the request.
+type s3PayloadVerifier struct {
+	io.ReadCloser
+
+	hash     hash.Hash
+	expected []byte
+}
+
+func newS3PayloadVerifier(body io.ReadCloser, payloadHash string) *s3PayloadVerifier {
+	expected, _ := hex.DecodeString(payloadHash)
+
+	return &s3PayloadVerifier{ReadCloser: body, hash: sha256.New(), expected: expected}
+}
+
+func (v *s3PayloadVerifier) Read(p []byte) (int, error) {
+	n, err := v.ReadCloser.Read(p)
+	v.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && !bytes.Equal(v.hash.Sum(nil), v.expected) {
+		return n, ErrS3ContentSHA256Mismatch
+	}
+
+	return n, err
+}
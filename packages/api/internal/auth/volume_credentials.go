@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	middleware "github.com/oapi-codegen/gin-middleware"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// VolumeScopeContextKey holds the *types.VolumeScope of the credentials of the request, when they're
+// restricted to a volume.
+const VolumeScopeContextKey = "volume_scope"
+
+// apiKeyPrefix starts the API keys of the teams.
+const apiKeyPrefix = "moru_"
+
+// authenticateS3Credentials checks the secret access key sent with the access key ID, for the clients sending
+// the S3 credentials as Basic credentials instead of signing the requests.
+func authenticateS3Credentials(
+	ctx context.Context,
+	credentialsFunction func(context.Context, string) (*types.S3Credentials, *api.APIError),
+	accessKeyID, secretAccessKey string,
+) (*types.S3Credentials, *api.APIError) {
+	credentials, apiErr := credentialsFunction(ctx, accessKeyID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	if subtle.ConstantTimeCompare([]byte(secretAccessKey), []byte(credentials.SecretAccessKey)) != 1 {
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("invalid secret access key for %s", accessKeyID),
+			ClientMsg: "The secret access key doesn't match the access key ID",
+			Code:      http.StatusUnauthorized,
+		}
+	}
+
+	return credentials, nil
+}
+
+// setS3Credentials sets the team of the credentials in the context, with their scope when they're restricted to a volume.
+func setS3Credentials(c *gin.Context, credentials *types.S3Credentials) {
+	c.Set(TeamContextKey, credentials.Team)
+	if credentials.Scope != nil {
+		c.Set(VolumeScopeContextKey, credentials.Scope)
+	}
+}
+
+// volumeCredentialsAuthenticator authenticates the S3 credentials sent as Basic credentials to the endpoints
+// of the files of the volumes, the access key ID as the username and the secret access key as the password.
+type volumeCredentialsAuthenticator struct {
+	credentialsFunction func(context.Context, string) (*types.S3Credentials, *api.APIError)
+}
+
+func (a *volumeCredentialsAuthenticator) Authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	accessKeyID, secretAccessKey, ok := input.RequestValidationInput.Request.BasicAuth()
+	if !ok {
+		return fmt.Errorf("invalid volume credentials: %w", ErrNoAuthHeader)
+	}
+
+	credentials, apiErr := authenticateS3Credentials(ctx, a.credentialsFunction, accessKeyID, secretAccessKey)
+	if apiErr != nil {
+		logger.L().Info(ctx, "validation error", zap.Error(apiErr.Err))
+
+		var forbiddenError *db.TeamForbiddenError
+		if errors.As(apiErr.Err, &forbiddenError) {
+			return fmt.Errorf("forbidden: %w", apiErr.Err)
+		}
+
+		var blockedError *db.TeamBlockedError
+		if errors.As(apiErr.Err, &blockedError) {
+			return fmt.Errorf("blocked: %w", apiErr.Err)
+		}
+
+		return fmt.Errorf("invalid volume credentials\n%s (%w)", apiErr.ClientMsg, apiErr.Err)
+	}
+
+	setS3Credentials(middleware.GetGinContext(ctx), credentials)
+
+	return nil
+}
+
+func (a *volumeCredentialsAuthenticator) SecuritySchemeName() string {
+	return "VolumeCredentialsAuth"
+}
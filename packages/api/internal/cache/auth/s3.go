@@ -0,0 +1,40 @@
+package autchcache
+
+import (
+	"context"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/cache"
+)
+
+type S3CredentialsCallback = func(ctx context.Context, accessKeyID string) (*types.S3Credentials, error)
+
+// S3CredentialsCache caches the S3 credentials by their access key ID, with their team.
+type S3CredentialsCache struct {
+	cache *cache.Cache[string, *types.S3Credentials]
+}
+
+func NewS3CredentialsCache() *S3CredentialsCache {
+	config := cache.Config[string, *types.S3Credentials]{
+		TTL:             authInfoExpiration,
+		RefreshInterval: refreshInterval,
+		RefreshTimeout:  refreshTimeout,
+	}
+
+	return &S3CredentialsCache{
+		cache: cache.NewCache[string, *types.S3Credentials](config),
+	}
+}
+
+func (c *S3CredentialsCache) GetOrSet(ctx context.Context, accessKeyID string, dataCallback S3CredentialsCallback) (*types.S3Credentials, error) {
+	return c.cache.GetOrSet(ctx, accessKeyID, dataCallback)
+}
+
+// Delete forgets the credentials, deleted credentials stop authenticating right away on this instance.
+func (c *S3CredentialsCache) Delete(accessKeyID string) {
+	c.cache.Delete(accessKeyID)
+}
+
+func (c *S3CredentialsCache) Close(ctx context.Context) error {
+	return c.cache.Close(ctx)
+}
@@ -30,6 +30,11 @@ type Config struct {
 
 	SandboxAccessTokenHashSeed string `env:"SANDBOX_ACCESS_TOKEN_HASH_SEED"`
 
+	// ExpiringEnvdAccessTokens hands out envd access tokens that expire after 12 to 24 hours instead of the
+	// static token of the sandbox, for sandboxes whose envd supports them. Clients renew the token by
+	// getting or connecting to the sandbox again.
+	ExpiringEnvdAccessTokens bool `env:"EXPIRING_ENVD_ACCESS_TOKENS"`
+
 	// SupabaseJWTSecrets is a list of secrets used to verify the Supabase JWT.
 	// More secrets are possible in the case of JWT secret rotation where we need to accept
 	// tokens signed with the old secret for some time.
@@ -37,15 +42,64 @@ type Config struct {
 
 	DefaultKernelVersion string `env:"DEFAULT_KERNEL_VERSION"`
 
+	// TemplatesEnvdUpdateBatchSize is how many templates built with an older envd are updated to the envd of the
+	// template builders at once, the updates are started periodically. Templates are only updated on request when it's 0.
+	TemplatesEnvdUpdateBatchSize int `env:"TEMPLATES_ENVD_UPDATE_BATCH_SIZE"`
+
+	// VolumesFormatMigrationBatchSize is how many volumes with metadata in an older format version are migrated
+	// to the current format at once, the migrations are run periodically. Volumes are not migrated when it's 0.
+	VolumesFormatMigrationBatchSize int `env:"VOLUMES_FORMAT_MIGRATION_BATCH_SIZE"`
+
 	// VolumesBucket is the GCS bucket for volume data storage.
+	// The volume data is sent to a GCS emulator or another GCS compatible endpoint when STORAGE_EMULATOR_HOST is set.
+	// With VOLUMES_STORAGE_PROVIDER=s3 it's an S3 bucket, on an S3 compatible storage like MinIO when
+	// VOLUMES_S3_ENDPOINT is set.
 	VolumesBucket string `env:"VOLUMES_BUCKET"`
 
-	// VolumesRedisURL is the Redis URL for JuiceFS volume metadata.
+	// VolumesTeamBucketPrefix stores the data of new volumes in a bucket per team instead of VolumesBucket when set.
+	// Team buckets are named with the prefix and the team ID, and created with the first volume of the team
+	// in GCP_PROJECT_ID and GCP_REGION. Volumes created before keep their data in VolumesBucket.
+	VolumesTeamBucketPrefix string `env:"VOLUMES_TEAM_BUCKET_PREFIX"`
+
+	// VolumesDeleteGraceDays is how many days deleted volumes can be restored before their data is destroyed.
+	// Volumes are destroyed immediately when it's 0.
+	VolumesDeleteGraceDays int `env:"VOLUMES_DELETE_GRACE_DAYS" envDefault:"7"`
+
+	// VolumesOrphanGraceHours is how many hours bucket prefixes and databases of the volumes Redis without a volume
+	// are kept before they're deleted. Orphans are only listed by the admin API, never deleted, when it's 0.
+	VolumesOrphanGraceHours int `env:"VOLUMES_ORPHAN_GRACE_HOURS" envDefault:"24"`
+
+	// VolumesMaxUploadBytes is the largest file or archive a single upload request can write to a volume,
+	// larger files are uploaded in parts. The request bodies are validated in memory, so it also raises the
+	// size limit of all the requests when it's above their 256 MiB. Only that limit applies when it's 0.
+	VolumesMaxUploadBytes int64 `env:"VOLUMES_MAX_UPLOAD_BYTES" envDefault:"268435456"`
+
+	// VolumesMaxSizeBytes is the size limit of the volumes without a lower size limit of their own.
+	// Volumes are only limited by their own size limit and the team storage limit when it's 0.
+	VolumesMaxSizeBytes int64 `env:"VOLUMES_MAX_SIZE_BYTES"`
+
+	// VolumesClientPoolSize is the maximum number of volumes the API keeps open for the file operations,
+	// the least recently used volume is closed to open another one.
+	VolumesClientPoolSize int `env:"VOLUMES_CLIENT_POOL_SIZE" envDefault:"32"`
+
+	// VolumesClientBufferMB is the write buffer of each open volume in MiB.
+	VolumesClientBufferMB int `env:"VOLUMES_CLIENT_BUFFER_MB" envDefault:"300"`
+
+	// VolumesClientMemoryLimitMB caps the write buffers of all the open volumes in MiB, fewer volumes are kept
+	// open than VolumesClientPoolSize when they don't fit. The open volumes are only limited by their number when it's 0.
+	VolumesClientMemoryLimitMB int `env:"VOLUMES_CLIENT_MEMORY_LIMIT_MB" envDefault:"4096"`
+
+	// VolumesRedisURL is the URL of the volumes Redis keeping the JuiceFS metadata of the volumes created
+	// with Redis metadata, each in a database of its own. Volumes can only be created with SQLite metadata without it.
 	VolumesRedisURL string `env:"VOLUMES_REDIS_URL"`
 
 	// VolumesEncryptionKey is the base64-encoded 256-bit key for encrypting volume passwords.
 	// Generate with: openssl rand -base64 32
 	VolumesEncryptionKey string `env:"VOLUMES_ENCRYPTION_KEY"`
+
+	// SecretsEncryptionKey is the base64-encoded 256-bit key for encrypting team secrets.
+	// Team secrets are disabled when it's not set. Generate with: openssl rand -base64 32
+	SecretsEncryptionKey string `env:"SECRETS_ENCRYPTION_KEY"`
 }
 
 func Parse() (Config, error) {
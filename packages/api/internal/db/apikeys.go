@@ -51,6 +51,7 @@ func GetTeamAuth(ctx context.Context, db *sqlcdb.Client, apiKey string) (*types.
 	}
 
 	team := types.NewTeam(&result.Team, &result.TeamLimit)
+	team.APIKeyID = result.ApiKeyID
 
 	return team, nil
 }
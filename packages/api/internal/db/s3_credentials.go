@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/crypto"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+)
+
+// GetTeamS3CredentialsAuth returns the S3 credentials with the access key ID and their team,
+// the secret access key decrypted with the encryptor.
+func GetTeamS3CredentialsAuth(ctx context.Context, db *sqlcdb.Client, encryptor *crypto.Encryptor, accessKeyID string) (*types.S3Credentials, error) {
+	result, err := db.GetTeamWithTierByS3AccessKeyWithUpdateLastUsed(ctx, accessKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team from S3 access key: %w", err)
+	}
+
+	err = validateTeamUsage(result.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := encryptor.Decrypt(result.SecretEncrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt S3 secret access key: %w", err)
+	}
+
+	credentials := &types.S3Credentials{
+		Team:            types.NewTeam(&result.Team, &result.TeamLimit),
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: string(secret),
+	}
+	if result.VolumeID != nil {
+		credentials.Scope = &types.VolumeScope{
+			VolumeID: *result.VolumeID,
+			Subpath:  result.Subpath,
+			ReadOnly: result.ReadOnly,
+		}
+	}
+
+	return credentials, nil
+}
@@ -8,4 +8,12 @@ type TeamLimits struct {
 	MaxVcpu  int64
 	MaxRamMb int64
 	DiskMb   int64
+
+	// MaxStorageBytes caps the total size of the team volumes, 0 means unlimited
+	MaxStorageBytes int64
+
+	// VolumeBandwidthBytesPerSecond and VolumeRequestsPerSecond limit the requests of each sandbox
+	// volume to the bucket, 0 uses the default of the orchestrator
+	VolumeBandwidthBytesPerSecond int64
+	VolumeRequestsPerSecond       int64
 }
@@ -0,0 +1,21 @@
+package types
+
+// S3Credentials are the S3 credentials of a team, the secret access key signs the requests to the S3 gateway.
+type S3Credentials struct {
+	Team *Team
+
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// Scope restricts the credentials to a volume, nil when they reach all the volumes of the team.
+	Scope *VolumeScope
+}
+
+// VolumeScope restricts credentials to a volume, like the mount of a volume attached with a subpath.
+type VolumeScope struct {
+	VolumeID string
+	// Subpath is the directory of the volume the credentials reach, empty for the whole volume.
+	Subpath string
+	// ReadOnly refuses the writes of the volume.
+	ReadOnly bool
+}
@@ -1,13 +1,19 @@
 package types
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
 )
 
 type Team struct {
 	*queries.Team
 
 	Limits *TeamLimits
+
+	// APIKeyID is the API key the team was authenticated with, uuid.Nil for the other authentication methods
+	APIKeyID uuid.UUID
 }
 
 func newTeamLimits(
@@ -20,6 +26,10 @@ func newTeamLimits(
 		MaxVcpu:            int64(teamLimits.MaxVcpu),
 		MaxRamMb:           int64(teamLimits.MaxRamMb),
 		DiskMb:             int64(teamLimits.DiskMb),
+		MaxStorageBytes:    utils.DerefOrDefault(teamLimits.MaxStorageBytes, 0),
+
+		VolumeBandwidthBytesPerSecond: utils.DerefOrDefault(teamLimits.VolumeBandwidthBytesPerSecond, 0),
+		VolumeRequestsPerSecond:       utils.DerefOrDefault(teamLimits.VolumeRequestsPerSecond, 0),
 	}
 }
 
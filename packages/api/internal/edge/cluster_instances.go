@@ -29,6 +29,7 @@ type ClusterInstance struct {
 
 	roles       []infogrpc.ServiceInfoRole
 	machineInfo machineinfo.MachineInfo
+	envdVersion string
 
 	status infogrpc.ServiceInfoStatus
 	mutex  sync.RWMutex
@@ -68,6 +69,7 @@ func (c *Cluster) syncInstance(ctx context.Context, instance *ClusterInstance) {
 	instance.status = info.GetServiceStatus()
 	instance.roles = info.GetServiceRoles()
 	instance.machineInfo = machineinfo.FromGRPCInfo(info.GetMachineInfo())
+	instance.envdVersion = info.GetEnvdVersion()
 }
 
 func (n *ClusterInstance) GetStatus() infogrpc.ServiceInfoStatus {
@@ -84,6 +86,14 @@ func (n *ClusterInstance) GetMachineInfo() machineinfo.MachineInfo {
 	return n.machineInfo
 }
 
+// GetEnvdVersion returns the version of envd the builder puts into templates, empty when unknown.
+func (n *ClusterInstance) GetEnvdVersion() string {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	return n.envdVersion
+}
+
 func (n *ClusterInstance) hasRole(r infogrpc.ServiceInfoRole) bool {
 	n.mutex.RLock()
 	defer n.mutex.RUnlock()
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+func (a *APIStore) PostAdminTeamsTeamIDVolumesCleanup(c *gin.Context, teamID uuid.UUID) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "admin-cleanup-team-volumes")
+	defer span.End()
+
+	body, err := utils.ParseBody[api.AdminVolumeCleanup](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		return
+	}
+
+	if body.NamePrefix == "" || body.OlderThanHours < 1 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "namePrefix must not be empty and olderThanHours must be at least 1")
+
+		return
+	}
+
+	createdBefore := time.Now().Add(-time.Duration(body.OlderThanHours) * time.Hour)
+	volumes, err := a.sqlcDB.GetTeamVolumesCreatedBefore(ctx, queries.GetTeamVolumesCreatedBeforeParams{
+		TeamID:        teamID,
+		NamePrefix:    body.NamePrefix,
+		CreatedBefore: createdBefore,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list volumes")
+
+		return
+	}
+
+	logger.L().Info(ctx, "Admin cleaning up team volumes",
+		logger.WithTeamID(teamID.String()),
+		zap.String("name_prefix", body.NamePrefix),
+		zap.Time("created_before", createdBefore),
+		zap.Int("count", len(volumes)),
+	)
+
+	var result api.AdminVolumeCleanupResult
+	for _, volume := range volumes {
+		// Protected volumes are only deleted once the team clears the protection
+		if volume.DeletionProtection {
+			result.SkippedCount++
+
+			continue
+		}
+
+		isAttached, err := a.sqlcDB.IsVolumeAttached(ctx, &volume.ID)
+		if err != nil {
+			logger.L().Error(ctx, "Failed to check if volume is attached", zap.Error(err), zap.String("volume_id", volume.ID))
+			result.FailedCount++
+
+			continue
+		}
+		if isAttached {
+			result.SkippedCount++
+
+			continue
+		}
+
+		// Deleted right away as with a forced delete
+		deleting, err := a.sqlcDB.UpdateVolumeStatus(ctx, queries.UpdateVolumeStatusParams{
+			ID:     volume.ID,
+			Status: "deleting",
+		})
+		if err == nil {
+			err = a.destroyVolume(ctx, deleting)
+		}
+		if err != nil {
+			logger.L().Error(ctx, "Failed to delete volume", zap.Error(err), zap.String("volume_id", volume.ID))
+			result.FailedCount++
+
+			continue
+		}
+
+		result.DeletedCount++
+	}
+
+	logger.L().Info(ctx, "Completed cleaning up team volumes",
+		logger.WithTeamID(teamID.String()),
+		zap.Int("deleted", result.DeletedCount),
+		zap.Int("skipped", result.SkippedCount),
+		zap.Int("failed", result.FailedCount),
+	)
+
+	c.JSON(http.StatusOK, result)
+}
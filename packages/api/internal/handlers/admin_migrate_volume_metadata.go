@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// PostAdminVolumesVolumeIDMetadataEngine moves the metadata of a volume to another metadata engine,
+// e.g. a volume created with Redis metadata to the Litestream replicated SQLite metadata.
+func (a *APIStore) PostAdminVolumesVolumeIDMetadataEngine(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "admin-migrate-volume-metadata")
+	defer span.End()
+
+	body, err := utils.ParseBody[api.AdminVolumeMetadataMigration](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		return
+	}
+
+	target, apiErr := a.volumeMetaEngine(&body.MetadataEngine)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	volume, err := a.sqlcDB.GetVolume(ctx, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+
+		return
+	}
+
+	if volume.Status != "available" {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s", volume.Status))
+
+		return
+	}
+
+	if storedMetaEngine(volume) == target {
+		c.JSON(http.StatusOK, volumeToAPI(volume))
+
+		return
+	}
+
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+
+		return
+	}
+
+	writeCtx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	ctx = trace.ContextWithSpan(writeCtx, span)
+
+	migrated, err := a.migrateVolumeMetaEngine(ctx, volume, target)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to migrate volume metadata", zap.Error(err), zap.String("volume_id", volume.ID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when migrating volume metadata: %s", err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, volumeToAPI(migrated))
+}
+
+// migrateVolumeMetaEngine copies the metadata of the volume to the target engine and switches the volume to it,
+// the caller holds the write lease of the volume. The old metadata is deleted afterwards, best effort.
+func (a *APIStore) migrateVolumeMetaEngine(ctx context.Context, volume queries.Volume, target volumestorage.MetaEngine) (queries.Volume, error) {
+	from := juicefsVolume(volume)
+	to := juicefs.Volume{ID: volume.ID, Bucket: from.Bucket, MetaEngine: target}
+
+	var redisDB *int32
+	if target == volumestorage.MetaEngineRedis {
+		db, err := a.sqlcDB.AllocateRedisDB(ctx)
+		if err != nil {
+			return queries.Volume{}, fmt.Errorf("allocate redis database: %w", err)
+		}
+		redisDB = &db
+		to.RedisDB = db
+	}
+
+	// Volumes that were never mounted have no metadata yet, the first mount formats it in the new engine
+	err := juicefs.MigrateMeta(ctx, from, to, a.juicefsPool.Config())
+	if err != nil && !errors.Is(err, juicefs.ErrVolumeNotInitialized) {
+		// The database can hold part of the metadata, it's only reused once emptied
+		if redisDB != nil {
+			if err := juicefs.DeleteRedisMetaDB(ctx, a.juicefsPool.Config(), *redisDB); err != nil {
+				logger.L().Warn(ctx, "Failed to delete the metadata of a failed migration", zap.Error(err), zap.Int32("redis_db", *redisDB))
+			} else {
+				a.freeRedisDB(ctx, *redisDB)
+			}
+		}
+
+		return queries.Volume{}, err
+	}
+
+	migrated, err := a.sqlcDB.UpdateVolumeMetadataEngine(ctx, queries.UpdateVolumeMetadataEngineParams{
+		ID:             volume.ID,
+		MetadataEngine: string(target),
+		RedisDb:        redisDB,
+	})
+	if err != nil {
+		return queries.Volume{}, fmt.Errorf("update volume metadata engine: %w", err)
+	}
+
+	// Clients opened with the old metadata must not be used anymore
+	a.juicefsPool.InvalidateVolume(volume.ID)
+
+	logger.L().Info(ctx, "Volume moved to another metadata engine",
+		zap.String("volume_id", volume.ID),
+		zap.String("from", string(from.MetaEngine)),
+		zap.String("to", string(target)))
+
+	deleteCfg := juicefs.FormatConfig{
+		VolumeID:   volume.ID,
+		MetaEngine: from.MetaEngine,
+		RedisDB:    from.RedisDB,
+		PoolConfig: juicefs.Config{
+			GCSBucket: cmp.Or(from.Bucket, a.volumesBucket),
+			RedisURL:  a.config.VolumesRedisURL,
+		},
+	}
+	if err := juicefs.DeleteMeta(ctx, deleteCfg); err != nil {
+		logger.L().Warn(ctx, "Failed to delete the old volume metadata",
+			zap.Error(err),
+			zap.String("volume_id", volume.ID))
+	} else if from.MetaEngine == volumestorage.MetaEngineRedis {
+		a.freeRedisDB(ctx, from.RedisDB)
+	}
+
+	return migrated, nil
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	auditLogsDefaultLimit = int32(100)
+	auditLogsMaxLimit     = int32(100)
+)
+
+// recordAudit records an operation of the caller on a volume of the team, with the credential and address
+// of the request. The entry sets the team, the action and what it applies to.
+func (a *APIStore) recordAudit(c *gin.Context, entry audit.Entry) {
+	if a.auditRecorder == nil {
+		return
+	}
+
+	entry.ActorType, entry.ActorID = auditActor(c)
+	entry.SourceIP = c.ClientIP()
+
+	a.auditRecorder.Record(c.Request.Context(), entry)
+}
+
+// auditActor returns the credential of the request. A user also selects the team, so it takes precedence.
+func auditActor(c *gin.Context) (string, uuid.UUID) {
+	if userID, ok := c.Value(auth.UserIDContextKey).(uuid.UUID); ok {
+		return audit.ActorUser, userID
+	}
+
+	if team, ok := c.Value(auth.TeamContextKey).(*types.Team); ok {
+		return audit.ActorAPIKey, team.APIKeyID
+	}
+
+	return audit.ActorAPIKey, uuid.Nil
+}
+
+func auditLogToAPI(entry queries.AuditLog) api.AuditLogEntry {
+	return api.AuditLogEntry{
+		Id:        entry.ID,
+		Action:    api.AuditAction(entry.Action),
+		ActorType: api.AuditActorType(entry.ActorType),
+		ActorId:   entry.ActorID,
+		VolumeId:  entry.VolumeID,
+		Path:      entry.Path,
+		SandboxId: entry.SandboxID,
+		SourceIp:  entry.SourceIp,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// GetAuditLogs lists the audit log of the team, newest first.
+func (a *APIStore) GetAuditLogs(c *gin.Context, params api.GetAuditLogsParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	pagination, err := utils.NewPagination[queries.AuditLog](
+		utils.PaginationParams{
+			Limit:     params.Limit,
+			NextToken: params.NextToken,
+		},
+		utils.PaginationConfig{
+			DefaultLimit: auditLogsDefaultLimit,
+			MaxLimit:     auditLogsMaxLimit,
+			DefaultID:    uuid.Max.String(),
+		},
+	)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+		return
+	}
+
+	var action *string
+	if params.Action != nil {
+		action = (*string)(params.Action)
+	}
+
+	entries, err := a.sqlcDB.ListAuditLogs(ctx, queries.ListAuditLogsParams{
+		TeamID:     team.ID,
+		Action:     action,
+		VolumeID:   params.VolumeID,
+		ActorID:    params.ActorID,
+		Since:      params.Since,
+		Until:      params.Until,
+		CursorTime: pagination.CursorTime(),
+		CursorID:   pagination.CursorID(),
+		QueryLimit: pagination.QueryLimit(),
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list audit logs", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list audit logs")
+		return
+	}
+
+	entries = pagination.ProcessResultsWithHeader(c, entries, func(entry queries.AuditLog) (time.Time, string) {
+		return entry.CreatedAt, entry.ID.String()
+	})
+
+	result := make([]api.AuditLogEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = auditLogToAPI(entry)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+)
+
+func TestAuditActor(t *testing.T) {
+	apiKeyID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("api key", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Set(auth.TeamContextKey, &types.Team{APIKeyID: apiKeyID})
+
+		actorType, actorID := auditActor(c)
+		assert.Equal(t, audit.ActorAPIKey, actorType)
+		assert.Equal(t, apiKeyID, actorID)
+	})
+
+	t.Run("user takes precedence", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Set(auth.TeamContextKey, &types.Team{})
+		c.Set(auth.UserIDContextKey, userID)
+
+		actorType, actorID := auditActor(c)
+		assert.Equal(t, audit.ActorUser, actorType)
+		assert.Equal(t, userID, actorID)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+		actorType, actorID := auditActor(c)
+		assert.Equal(t, audit.ActorAPIKey, actorType)
+		assert.Equal(t, uuid.Nil, actorID)
+	})
+}
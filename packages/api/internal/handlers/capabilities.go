@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+// GetCapabilities returns the features and limits of the platform, so clients can split large uploads up front.
+func (a *APIStore) GetCapabilities(c *gin.Context) {
+	volumes := api.VolumeCapabilities{
+		FileOperations: a.juicefsPool != nil,
+		MaxUploadParts: maxUploadParts,
+	}
+	if a.config.VolumesMaxUploadBytes > 0 {
+		volumes.MaxUploadBytes = sharedUtils.ToPtr(a.config.VolumesMaxUploadBytes)
+	}
+	if a.config.VolumesMaxSizeBytes > 0 {
+		volumes.MaxVolumeSizeBytes = sharedUtils.ToPtr(a.config.VolumesMaxSizeBytes)
+	}
+
+	c.JSON(http.StatusOK, api.Capabilities{Volumes: volumes})
+}
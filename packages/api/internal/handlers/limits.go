@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	customMiddleware "github.com/moru-ai/sandbox-infra/packages/api/internal/middleware"
+)
+
+// RateLimits returns the rate limits the rate limiting middlewares of the endpoints are created with.
+func (a *APIStore) RateLimits() *customMiddleware.RateLimits {
+	return a.rateLimits
+}
+
+// GetLimits returns the rate limits of the API with the consumption of the team on this API instance.
+func (a *APIStore) GetLimits(c *gin.Context) {
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	usage := a.rateLimits.Usage(customMiddleware.TeamKey(teamID.String()))
+
+	limits := make([]api.RateLimit, 0, len(usage))
+	for _, u := range usage {
+		limits = append(limits, api.RateLimit{
+			Name:              u.Config.Name,
+			RequestsPerMinute: int32(u.Config.RequestsPerMinute),
+			BurstSize:         int32(u.Config.Burst()),
+			Remaining:         int32(u.Remaining),
+			ThrottledRecently: u.ThrottledRecently,
+		})
+	}
+
+	c.JSON(http.StatusOK, limits)
+}
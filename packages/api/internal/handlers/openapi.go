@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	middleware "github.com/oapi-codegen/gin-middleware"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+)
+
+const (
+	// permittedExtension marks whether the caller's credentials are accepted by an operation.
+	permittedExtension = "x-moru-permitted"
+
+	// authenticatedExtension lists the security schemes the caller's credentials satisfied.
+	authenticatedExtension = "x-moru-authenticated"
+)
+
+// operationMethods are the keys of a path item that hold operations.
+var operationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// openAPIDocument is the embedded spec encoded as JSON, loaded once on first use.
+var openAPIDocument = sync.OnceValues(func() ([]byte, error) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load spec: %w", err)
+	}
+
+	return json.Marshal(swagger)
+})
+
+// SetAuthenticationFunc sets the credential check used for the capability hints of the OpenAPI document.
+// It's the same function the request validator uses, so the hints match what the API enforces.
+func (a *APIStore) SetAuthenticationFunc(authenticate openapi3filter.AuthenticationFunc) {
+	a.authenticate = authenticate
+}
+
+// GetOpenAPIDocument serves the OpenAPI document with the server URL of the request
+// and the operations marked by whether the caller's credentials are accepted.
+func (a *APIStore) GetOpenAPIDocument(c *gin.Context) {
+	encoded, err := openAPIDocument()
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to load OpenAPI document")
+		return
+	}
+
+	// Decode a fresh copy, the document is modified for every caller
+	var doc map[string]any
+	if err := json.Unmarshal(encoded, &doc); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to load OpenAPI document")
+		return
+	}
+
+	doc["servers"] = []map[string]any{{"url": requestBaseURL(c.Request)}}
+
+	schemes := a.authenticatedSchemes(c, doc)
+	names := make([]string, 0, len(schemes))
+	for name, ok := range schemes {
+		if ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	doc[authenticatedExtension] = names
+
+	markPermittedOperations(doc, schemes)
+
+	c.JSON(http.StatusOK, doc)
+}
+
+// authenticatedSchemes checks the request credentials against every security scheme of the document.
+func (a *APIStore) authenticatedSchemes(c *gin.Context, doc map[string]any) map[string]bool {
+	components, _ := doc["components"].(map[string]any)
+	securitySchemes, _ := components["securitySchemes"].(map[string]any)
+
+	result := make(map[string]bool, len(securitySchemes))
+	if a.authenticate == nil {
+		return result
+	}
+
+	// Check in alphabetical order like the request validator, the Supabase team
+	// authenticator relies on the user resolved by the Supabase token authenticator
+	names := make([]string, 0, len(securitySchemes))
+	for name := range securitySchemes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	// The authenticators store the resolved team or user on the gin context
+	ctx := context.WithValue(c.Request.Context(), middleware.GinContextKey, c)
+
+	for _, name := range names {
+		err := a.authenticate(ctx, &openapi3filter.AuthenticationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{Request: c.Request},
+			SecuritySchemeName:     name,
+		})
+		result[name] = err == nil
+	}
+
+	return result
+}
+
+// markPermittedOperations sets the permitted extension on every operation of the document.
+func markPermittedOperations(doc map[string]any, schemes map[string]bool) {
+	defaultSecurity, _ := doc["security"].([]any)
+
+	paths, _ := doc["paths"].(map[string]any)
+	for _, item := range paths {
+		pathItem, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, method := range operationMethods {
+			operation, ok := pathItem[method].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			security := defaultSecurity
+			if operationSecurity, ok := operation["security"].([]any); ok {
+				security = operationSecurity
+			}
+
+			operation[permittedExtension] = securitySatisfied(security, schemes)
+		}
+	}
+}
+
+// securitySatisfied reports whether any of the security requirements is fully met.
+// Operations without requirements are public.
+func securitySatisfied(security []any, schemes map[string]bool) bool {
+	if len(security) == 0 {
+		return true
+	}
+
+	for _, item := range security {
+		requirement, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		satisfied := true
+		for name := range requirement {
+			if !schemes[name] {
+				satisfied = false
+				break
+			}
+		}
+
+		if satisfied {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestBaseURL returns the URL the caller reached the API on, honoring the load balancer headers.
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+
+	return scheme + "://" + host
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkPermittedOperations(t *testing.T) {
+	doc := map[string]any{
+		"paths": map[string]any{
+			"/health": map[string]any{
+				"get": map[string]any{},
+			},
+			"/sandboxes": map[string]any{
+				"get": map[string]any{
+					"security": []any{
+						map[string]any{"ApiKeyAuth": []any{}},
+						map[string]any{"Supabase1TokenAuth": []any{}, "Supabase2TeamAuth": []any{}},
+					},
+				},
+			},
+			"/teams": map[string]any{
+				"get": map[string]any{
+					"security": []any{
+						map[string]any{"AccessTokenAuth": []any{}},
+					},
+				},
+			},
+			"/nodes": map[string]any{
+				"get": map[string]any{
+					"security": []any{
+						map[string]any{"AdminTokenAuth": []any{}},
+					},
+				},
+			},
+		},
+	}
+
+	markPermittedOperations(doc, map[string]bool{
+		"ApiKeyAuth":         false,
+		"Supabase1TokenAuth": true,
+		"Supabase2TeamAuth":  true,
+		"AccessTokenAuth":    false,
+		"AdminTokenAuth":     false,
+	})
+
+	permitted := func(path string) any {
+		return doc["paths"].(map[string]any)[path].(map[string]any)["get"].(map[string]any)[permittedExtension]
+	}
+
+	assert.Equal(t, true, permitted("/health"))
+	assert.Equal(t, true, permitted("/sandboxes"))
+	assert.Equal(t, false, permitted("/teams"))
+	assert.Equal(t, false, permitted("/nodes"))
+}
+
+func TestSecuritySatisfiedRequiresAllSchemes(t *testing.T) {
+	security := []any{
+		map[string]any{"Supabase1TokenAuth": []any{}, "Supabase2TeamAuth": []any{}},
+	}
+
+	assert.False(t, securitySatisfied(security, map[string]bool{"Supabase1TokenAuth": true}))
+	assert.True(t, securitySatisfied(security, map[string]bool{"Supabase1TokenAuth": true, "Supabase2TeamAuth": true}))
+}
+
+func TestRequestBaseURL(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://api.internal:3000/openapi.json", nil)
+	assert.Equal(t, "http://api.internal:3000", requestBaseURL(req))
+
+	req.Header.Set("X-Forwarded-Proto", "https")
+	req.Header.Set("X-Forwarded-Host", "api.moru.io")
+	assert.Equal(t, "https://api.moru.io", requestBaseURL(req))
+}
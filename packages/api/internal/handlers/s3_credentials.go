@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// maxTeamS3Credentials bounds the S3 credentials of a team.
+	maxTeamS3Credentials = 10
+
+	// s3AccessKeyIDPrefix starts the access key IDs, 20 characters long like the ones of AWS.
+	s3AccessKeyIDPrefix = "MORU"
+)
+
+// newS3Credentials generates an access key ID and a secret access key of 40 characters like the ones of AWS.
+func newS3Credentials() (accessKeyID, secretAccessKey string, err error) {
+	id := make([]byte, 10)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", fmt.Errorf("generate access key id: %w", err)
+	}
+
+	secret := make([]byte, 30)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("generate secret access key: %w", err)
+	}
+
+	return s3AccessKeyIDPrefix + base32.StdEncoding.EncodeToString(id), base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// s3CredentialsScopeToAPI returns the volume, the subpath and the read-only flag of the credentials restricted to a volume.
+func s3CredentialsScopeToAPI(volumeID *string, subpath string, readOnly bool) (*string, *string, *bool) {
+	if volumeID == nil {
+		return nil, nil, nil
+	}
+
+	var subpathPtr *string
+	if subpath != "" {
+		subpathPtr = &subpath
+	}
+
+	return volumeID, subpathPtr, &readOnly
+}
+
+func s3CredentialsToAPI(credentials queries.TeamS3Credential) api.S3Credentials {
+	volumeID, subpath, readOnly := s3CredentialsScopeToAPI(credentials.VolumeID, credentials.Subpath, credentials.ReadOnly)
+
+	return api.S3Credentials{
+		AccessKeyId: credentials.AccessKeyID,
+		CreatedAt:   credentials.CreatedAt,
+		LastUsed:    credentials.LastUsed,
+		VolumeId:    volumeID,
+		Subpath:     subpath,
+		ReadOnly:    readOnly,
+	}
+}
+
+// GetS3Credentials lists the S3 credentials of the team, secret access keys are never returned.
+func (a *APIStore) GetS3Credentials(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	credentials, err := a.sqlcDB.ListTeamS3Credentials(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list team S3 credentials", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list S3 credentials")
+		return
+	}
+
+	result := make([]api.S3Credentials, len(credentials))
+	for i, credential := range credentials {
+		result[i] = s3CredentialsToAPI(credential)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PostS3Credentials creates S3 credentials for the team, the secret access key is only returned here.
+// The credentials can be restricted to a volume, a subpath of it and reads, like a volume attachment.
+func (a *APIStore) PostS3Credentials(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// The body is optional, the credentials reach all the volumes of the team without it
+	var req api.NewS3Credentials
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+			return
+		}
+	}
+
+	if req.VolumeId == nil && (req.Subpath != nil || req.ReadOnly != nil) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "subpath and readOnly require volumeId")
+		return
+	}
+
+	subpath := ""
+	if req.Subpath != nil {
+		if errMsg := ValidateVolumeSubpath(*req.Subpath); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+			return
+		}
+		subpath = *req.Subpath
+	}
+
+	if req.VolumeId != nil {
+		if _, err := a.resolveVolumeByID(ctx, team.ID, *req.VolumeId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+				return
+			}
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+			return
+		}
+	}
+
+	// The secret access keys are stored encrypted
+	if a.secretsEncryptor == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "S3 credentials are not available")
+		return
+	}
+
+	credentials, err := a.sqlcDB.ListTeamS3Credentials(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list team S3 credentials", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create S3 credentials")
+		return
+	}
+
+	if len(credentials) >= maxTeamS3Credentials {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("a team can have at most %d S3 credentials", maxTeamS3Credentials))
+		return
+	}
+
+	accessKeyID, secretAccessKey, err := newS3Credentials()
+	if err != nil {
+		logger.L().Error(ctx, "Failed to generate S3 credentials", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create S3 credentials")
+		return
+	}
+
+	encrypted, err := a.secretsEncryptor.Encrypt([]byte(secretAccessKey))
+	if err != nil {
+		logger.L().Error(ctx, "Failed to encrypt S3 secret access key", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create S3 credentials")
+		return
+	}
+
+	created, err := a.sqlcDB.CreateTeamS3Credential(ctx, queries.CreateTeamS3CredentialParams{
+		AccessKeyID:     accessKeyID,
+		TeamID:          team.ID,
+		SecretEncrypted: encrypted,
+		VolumeID:        req.VolumeId,
+		Subpath:         subpath,
+		ReadOnly:        req.ReadOnly != nil && *req.ReadOnly,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to store team S3 credentials", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create S3 credentials")
+		return
+	}
+
+	logger.L().Info(ctx, "Team S3 credentials created",
+		zap.String("access_key_id", created.AccessKeyID),
+		logger.WithTeamID(team.ID.String()),
+	)
+
+	volumeID, createdSubpath, readOnly := s3CredentialsScopeToAPI(created.VolumeID, created.Subpath, created.ReadOnly)
+	c.JSON(http.StatusCreated, api.CreatedS3Credentials{
+		AccessKeyId:     created.AccessKeyID,
+		SecretAccessKey: secretAccessKey,
+		CreatedAt:       created.CreatedAt,
+		VolumeId:        volumeID,
+		Subpath:         createdSubpath,
+		ReadOnly:        readOnly,
+	})
+}
+
+// DeleteS3CredentialsAccessKeyID deletes S3 credentials of the team.
+func (a *APIStore) DeleteS3CredentialsAccessKeyID(c *gin.Context, accessKeyID api.AccessKeyID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	deleted, err := a.sqlcDB.DeleteTeamS3Credential(ctx, queries.DeleteTeamS3CredentialParams{
+		TeamID:      team.ID,
+		AccessKeyID: accessKeyID,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to delete team S3 credentials", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to delete S3 credentials")
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "S3 credentials not found")
+		return
+	}
+
+	// The other instances forget the credentials when their cache entry is refreshed
+	a.s3CredentialsCache.Delete(accessKeyID)
+
+	c.Status(http.StatusNoContent)
+}
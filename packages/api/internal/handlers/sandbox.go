@@ -28,6 +28,7 @@ func (a *APIStore) startSandbox(
 	sandboxID string,
 	timeout time.Duration,
 	envVars map[string]string,
+	secrets map[string]string,
 	metadata map[string]string,
 	alias string,
 	team *typesteam.Team,
@@ -57,6 +58,7 @@ func (a *APIStore) startSandbox(
 		build,
 		metadata,
 		envVars,
+		secrets,
 		startTime,
 		endTime,
 		timeout,
@@ -125,5 +127,8 @@ func (a *APIStore) startSandbox(
 		TeamID:     team.ID.String(),
 	}).Info(ctx, "Sandbox created", zap.String("end_time", endTime.Format("2006-01-02 15:04:05 -07:00")))
 
-	return sandbox.ToAPISandbox(), nil
+	apiSandbox := sandbox.ToAPISandbox()
+	apiSandbox.EnvdAccessToken = a.clientEnvdAccessToken(sandbox.EnvdAccessToken, sandbox.EnvdVersion)
+
+	return apiSandbox, nil
 }
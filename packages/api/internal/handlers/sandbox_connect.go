@@ -83,7 +83,10 @@ func (a *APIStore) PostSandboxesSandboxIDConnect(c *gin.Context, sandboxID api.S
 				return
 			}
 
-			c.JSON(http.StatusOK, sandboxData.ToAPISandbox())
+			apiSandbox := sandboxData.ToAPISandbox()
+			apiSandbox.EnvdAccessToken = a.clientEnvdAccessToken(sandboxData.EnvdAccessToken, sandboxData.EnvdVersion)
+
+			c.JSON(http.StatusOK, apiSandbox)
 
 			return
 		default:
@@ -149,6 +152,7 @@ func (a *APIStore) PostSandboxesSandboxIDConnect(c *gin.Context, sandboxID api.S
 		snap.SandboxID,
 		timeout,
 		nil,
+		nil, // secrets - kept in the memory of the paused sandbox
 		snap.Metadata,
 		alias,
 		teamInfo,
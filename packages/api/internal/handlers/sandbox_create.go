@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"golang.org/x/net/idna"
 
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
 	typesteam "github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/middleware/otel/metrics"
@@ -24,11 +26,13 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
 	"github.com/moru-ai/sandbox-infra/packages/db/types"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/keys"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger/sandbox"
 	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
 )
 
 const (
@@ -36,6 +40,10 @@ const (
 	metricTemplateAlias         = metrics.MetricPrefix + "template.alias"
 	minEnvdVersionForSecureFlag = "0.2.0" // Minimum version of envd that supports secure flag
 
+	minEnvdVersionForExpiringAccessTokens = "0.4.7" // Minimum version of envd that supports expiring access tokens
+
+	minEnvdVersionForVolumeSubpath = "0.4.19" // Minimum version of envd that mounts a subpath of a volume
+
 	// Network validation error messages
 	ErrMsgDomainsRequireBlockAll = "When specifying allowed domains in allow out, you must include 'ALL_TRAFFIC' in deny out to block all other traffic."
 )
@@ -131,6 +139,18 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		envVars = *body.EnvVars
 	}
 
+	var secrets map[string]string
+	if body.Secrets != nil {
+		var secretsErr *api.APIError
+		secrets, secretsErr = a.resolveSandboxSecrets(ctx, teamInfo.Team.ID, *body.Secrets)
+		if secretsErr != nil {
+			telemetry.ReportError(ctx, "error when resolving sandbox secrets", secretsErr.Err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, secretsErr.Code, secretsErr.ClientMsg)
+
+			return
+		}
+	}
+
 	var mcp api.Mcp
 	if body.Mcp != nil {
 		mcp = *body.Mcp
@@ -198,6 +218,50 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	// Validate and lookup volume if provided
 	var volumeConfig *types.VolumeConfig
+	volumeReadOnly := sharedUtils.DerefOrDefault(body.VolumeReadOnly, false)
+	volumeReadOnlyRoot := sharedUtils.DerefOrDefault(body.VolumeReadOnlyRoot, false)
+	if body.PersistHome != nil && (body.VolumeId != nil || body.VolumeMountPath != nil || body.VolumeOverlayPaths != nil || body.VolumeSubpath != nil || volumeReadOnly) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "persistHome can't be combined with volumeId, volumeMountPath, volumeOverlayPaths, volumeSubpath or volumeReadOnly")
+		return
+	}
+
+	if body.CreateEphemeralVolume != nil && (body.VolumeId != nil || body.PersistHome != nil || body.VolumeMountPath != nil || body.VolumeOverlayPaths != nil || body.VolumeSubpath != nil || body.VolumePrewarm != nil || volumeReadOnly || volumeReadOnlyRoot) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "createEphemeralVolume can't be combined with volumeId, persistHome, volumeMountPath, volumeOverlayPaths, volumeSubpath, volumePrewarm, volumeReadOnly or volumeReadOnlyRoot")
+		return
+	}
+
+	if body.CreateEphemeralVolume != nil {
+		if errMsg := ValidateEphemeralVolume(*body.CreateEphemeralVolume); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+			return
+		}
+	}
+
+	if body.VolumeId == nil && body.PersistHome == nil && body.CreateEphemeralVolume == nil && (body.VolumeOverlayPaths != nil || volumeReadOnlyRoot || volumeReadOnly || body.VolumeMountResources != nil || body.VolumeMountOptions != nil || body.VolumePrewarm != nil || body.VolumeSubpath != nil) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "volumeOverlayPaths, volumeReadOnlyRoot, volumeReadOnly, volumeMountResources, volumeMountOptions, volumePrewarm and volumeSubpath require volumeId")
+		return
+	}
+
+	if errMsg := ValidateMountResources(body.VolumeMountResources, build.RamMb); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if errMsg := ValidateVolumeMountOptions(body.VolumeMountOptions, false); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if errMsg := ValidateVolumePrewarm(sharedUtils.DerefOrDefault(body.VolumePrewarm, nil)); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if volumeReadOnly && (body.VolumeOverlayPaths != nil || volumeReadOnlyRoot) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "volumeReadOnly can't be combined with volumeOverlayPaths or volumeReadOnlyRoot")
+		return
+	}
+
 	if body.VolumeId != nil {
 		// volumeMountPath is required if volumeId is provided
 		if body.VolumeMountPath == nil || *body.VolumeMountPath == "" {
@@ -211,6 +275,25 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 			return
 		}
 
+		overlayPaths := sharedUtils.DerefOrDefault(body.VolumeOverlayPaths, nil)
+		if errMsg := ValidateOverlayPaths(overlayPaths, *body.VolumeMountPath); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+			return
+		}
+
+		if subpath := body.VolumeSubpath; subpath != nil {
+			if errMsg := ValidateVolumeSubpath(*subpath); errMsg != "" {
+				a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+				return
+			}
+
+			// The orchestrator refuses it too, but only once it failed to place the sandbox on every node
+			if ok, err := sharedUtils.IsGTEVersion(sharedUtils.DerefOrDefault(build.EnvdVersion, ""), minEnvdVersionForVolumeSubpath); err != nil || !ok {
+				a.sendAPIStoreError(c, http.StatusBadRequest, "The template doesn't support mounting a subpath of a volume, rebuild the template to use it.")
+				return
+			}
+		}
+
 		// Lookup volume and verify ownership
 		volume, err := a.sqlcDB.GetVolume(ctx, *body.VolumeId)
 		if err != nil {
@@ -234,10 +317,108 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 			return
 		}
 
-		volumeConfig = &types.VolumeConfig{
-			VolumeID:  volume.ID,
-			MountPath: *body.VolumeMountPath,
-			RedisDB:   0, // Deprecated - SQLite metadata now stored in GCS
+		volumeConfig = volumeAttachConfig(volume)
+		volumeConfig.MountPath = *body.VolumeMountPath
+		volumeConfig.ReadOnlyRoot = volumeReadOnlyRoot
+		volumeConfig.OverlayPaths = overlayPaths
+		volumeConfig.ReadOnly = volumeReadOnly
+		volumeConfig.Subpath = sharedUtils.DerefOrDefault(body.VolumeSubpath, "")
+	}
+
+	if body.PersistHome != nil {
+		volume, apiErr := a.resolvePersistHomeVolume(ctx, teamInfo, *body.PersistHome)
+		if apiErr != nil {
+			telemetry.ReportError(ctx, "failed to resolve persistHome volume", apiErr.Err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+			return
+		}
+
+		if volume.Status != "available" {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s, must be available", volume.Status))
+			return
+		}
+
+		volumeConfig = volumeAttachConfig(volume)
+		volumeConfig.MountPath = persistHomeMountPath
+		volumeConfig.ReadOnlyRoot = volumeReadOnlyRoot
+		volumeConfig.PersistHome = true
+	}
+
+	if body.CreateEphemeralVolume != nil {
+		volume, apiErr := a.createEphemeralVolume(ctx, teamInfo, sandboxID, *body.CreateEphemeralVolume)
+		if apiErr != nil {
+			telemetry.ReportError(ctx, "failed to create ephemeral volume", apiErr.Err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+			return
+		}
+
+		volumeConfig = volumeAttachConfig(volume)
+		volumeConfig.MountPath = body.CreateEphemeralVolume.MountPath
+	}
+
+	// The template attaches its default volume to the sandboxes that don't request one
+	defaultVolume := false
+	if volumeConfig == nil {
+		volumeConfig, err = a.templateDefaultVolume(ctx, teamInfo.Team.ID, env.TemplateID)
+		if err != nil {
+			telemetry.ReportError(ctx, "failed to get template default volume", err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the default volume of the template")
+			return
+		}
+		defaultVolume = volumeConfig != nil
+	}
+
+	if resources := body.VolumeMountResources; resources != nil && volumeConfig != nil {
+		volumeConfig.MountMemoryMB = int64(sharedUtils.DerefOrDefault(resources.MemoryMB, 0))
+		volumeConfig.MountCPUWeight = int64(sharedUtils.DerefOrDefault(resources.CpuWeight, 0))
+	}
+
+	if volumeConfig != nil {
+		if err := a.applyTemplateVolumeDefaults(ctx, env.TemplateID, volumeConfig); err != nil {
+			if body.CreateEphemeralVolume != nil {
+				a.deleteEphemeralVolumesLater(context.WithoutCancel(ctx), sandboxID, 0)
+			}
+			telemetry.ReportError(ctx, "failed to get template volume defaults", err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the volume defaults of the template")
+			return
+		}
+	}
+
+	if options := body.VolumeMountOptions; options != nil && volumeConfig != nil {
+		volumeConfig.MountOptions = volumeoptions.Merge(volumeConfig.MountOptions, *options)
+	}
+
+	if prewarm := body.VolumePrewarm; prewarm != nil && volumeConfig != nil {
+		volumeConfig.Prewarm = *prewarm
+	}
+
+	if allowMountFailure := body.VolumeAllowMountFailure; allowMountFailure != nil && volumeConfig != nil {
+		volumeConfig.AllowMountFailure = *allowMountFailure
+	}
+
+	// One sandbox reading a large dataset must not saturate the network of the node for the others
+	if volumeConfig != nil {
+		volumeConfig.BandwidthBytesPerSecond = teamInfo.Limits.VolumeBandwidthBytesPerSecond
+		volumeConfig.RequestsPerSecond = teamInfo.Limits.VolumeRequestsPerSecond
+	}
+
+	volumeLocked := false
+	if volumeConfig != nil {
+		volumeLocked, err = a.lockVolumeAttachment(ctx, teamInfo.Team.ID, sandboxID, volumeConfig)
+		if err != nil {
+			if body.CreateEphemeralVolume != nil {
+				a.deleteEphemeralVolumesLater(context.WithoutCancel(ctx), sandboxID, 0)
+			}
+			// A read-write default volume is attached to one sandbox of the template at a time, the
+			// sandbox isn't started without the volume it expects
+			if defaultVolume && errors.Is(err, errVolumeAttachedReadWrite) {
+				a.sendAPIStoreError(c, http.StatusConflict, "The default volume of the template is attached read-write to another sandbox, make it read-only in the template or detach it first")
+
+				return
+			}
+			a.sendVolumeAttachmentError(c, sandboxID, err)
+
+			return
 		}
 	}
 
@@ -246,6 +427,7 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		sandboxID,
 		timeout,
 		envVars,
+		secrets,
 		metadata,
 		alias,
 		teamInfo,
@@ -262,6 +444,14 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		volumeConfig,
 	)
 	if createErr != nil {
+		if volumeLocked {
+			a.unlockVolumeAttachment(ctx, sandboxID, volumeConfig.VolumeID)
+		}
+		// The sandbox may have mounted the volume before it failed
+		if body.CreateEphemeralVolume != nil {
+			a.deleteEphemeralVolumesLater(context.WithoutCancel(ctx), sandboxID, ephemeralVolumeDeleteDelay)
+		}
+
 		logger.L().Error(ctx, "Failed to create sandbox", zap.Error(createErr.Err))
 		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
 
@@ -274,6 +464,10 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		a.juicefsPool.InvalidateVolume(volumeConfig.VolumeID)
 	}
 
+	if volumeConfig != nil {
+		a.recordAudit(c, audit.Entry{TeamID: teamInfo.Team.ID, Action: audit.ActionVolumeAttach, VolumeID: volumeConfig.VolumeID, SandboxID: sandboxID})
+	}
+
 	c.JSON(http.StatusCreated, &sbx)
 }
 
@@ -315,6 +509,21 @@ func (a *APIStore) getEnvdAccessToken(envdVersion *string, sandboxID string) (st
 	return key, nil
 }
 
+// clientEnvdAccessToken returns the envd access token handed out to clients for the static token of a sandbox,
+// an expiring token signed with it when enabled and supported by the envd of the sandbox.
+func (a *APIStore) clientEnvdAccessToken(accessToken *string, envdVersion string) *string {
+	if accessToken == nil || !a.config.ExpiringEnvdAccessTokens {
+		return accessToken
+	}
+
+	ok, err := sharedUtils.IsGTEVersion(envdVersion, minEnvdVersionForExpiringAccessTokens)
+	if err != nil || !ok {
+		return accessToken
+	}
+
+	return sharedUtils.ToPtr(keys.NewEnvdAccessToken(*accessToken, time.Now()))
+}
+
 func setTemplateNameMetric(c *gin.Context, aliases []string) {
 	for _, alias := range aliases {
 		if _, exists := mostUsedTemplates[alias]; exists {
@@ -383,12 +592,12 @@ func validateNetworkConfig(network *api.SandboxNetworkConfig) *api.APIError {
 	}
 
 	denyOut := sharedUtils.DerefOrDefault(network.DenyOut, nil)
-	for _, cidr := range denyOut {
-		if !sandbox_network.IsIPOrCIDR(cidr) {
+	for _, entry := range denyOut {
+		if !sandbox_network.IsIPOrCIDR(entry) && !sandbox_network.IsDomainPattern(entry) {
 			return &api.APIError{
 				Code:      http.StatusBadRequest,
-				Err:       fmt.Errorf("invalid denied CIDR %s", cidr),
-				ClientMsg: fmt.Sprintf("invalid denied CIDR %s", cidr),
+				Err:       fmt.Errorf("invalid denied address %s", entry),
+				ClientMsg: fmt.Sprintf("invalid denied address %s, expected a CIDR, an IP address or a domain", entry),
 			}
 		}
 	}
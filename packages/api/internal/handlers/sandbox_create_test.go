@@ -33,14 +33,21 @@ func TestValidateNetworkConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid deny_out with domains",
+			network: &api.SandboxNetworkConfig{
+				DenyOut: &[]string{"uploads.example.com", "*.storage.example.com"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid deny_out entry",
 			network: &api.SandboxNetworkConfig{
-				DenyOut: &[]string{"not-a-cidr"},
+				DenyOut: &[]string{"10.0.0.0/33"},
 			},
 			wantErr:    true,
 			wantCode:   http.StatusBadRequest,
-			wantErrMsg: "invalid denied CIDR not-a-cidr",
+			wantErrMsg: "invalid denied address 10.0.0.0/33, expected a CIDR, an IP address or a domain",
 		},
 		// Domain validation tests
 		{
@@ -76,7 +76,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			EndAt:           sbx.EndTime,
 			State:           state,
 			EnvdVersion:     sbx.EnvdVersion,
-			EnvdAccessToken: sbx.EnvdAccessToken,
+			EnvdAccessToken: a.clientEnvdAccessToken(sbx.EnvdAccessToken, sbx.EnvdVersion),
 			Domain:          sbxDomain,
 		}
 
@@ -142,7 +142,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 		EndAt:           lastSnapshot.Snapshot.CreatedAt.Time, // Snapshot is created when sandbox is paused
 		State:           api.SandboxStatePaused,
 		EnvdVersion:     envdVersion,
-		EnvdAccessToken: sbxAccessToken,
+		EnvdAccessToken: a.clientEnvdAccessToken(sbxAccessToken, envdVersion),
 		Domain:          nil,
 	}
 
@@ -130,6 +130,9 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 		return
 	default:
 		killedOrRemoved = true
+
+		// The paused sandbox is gone without a killed event, its scratch volumes go with it
+		a.deleteEphemeralVolumesLater(ctx, sandboxID, 0)
 	}
 
 	if killedOrRemoved {
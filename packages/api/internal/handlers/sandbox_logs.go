@@ -117,3 +117,75 @@ func (a *APIStore) getClusterSandboxLogs(ctx context.Context, sandboxID string,
 
 	return &api.SandboxLogs{Logs: l, LogEntries: le}, nil
 }
+
+func (a *APIStore) GetSandboxesSandboxIDLogsSummary(c *gin.Context, sandboxID string) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	team := c.Value(auth.TeamContextKey).(*types.Team)
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	summary, err := a.getClusterSandboxLogsSummary(ctx, sandboxID, team.ID.String(), utils.WithClusterFallback(team.ClusterID))
+	if err != nil {
+		a.sendAPIStoreError(c, int(err.Code), err.Message)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func (a *APIStore) getClusterSandboxLogsSummary(ctx context.Context, sandboxID string, teamID string, clusterID uuid.UUID) (*api.SandboxLogsSummary, *api.Error) {
+	cluster, ok := a.clustersPool.GetClusterById(clusterID)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "error getting cluster by ID", fmt.Errorf("cluster with ID '%s' not found", clusterID))
+
+		return nil, &api.Error{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("Error getting cluster '%s'", clusterID),
+		}
+	}
+
+	edgeParams := &apiedge.V1SandboxLogsSummaryParams{TeamID: teamID}
+
+	// Count only the logs of the run, not the system logs of the template build (same as the logs endpoint)
+	sandboxRun, err := a.sqlcDB.GetSandboxRun(ctx, sandboxID)
+	if err == nil {
+		start := sandboxRun.CreatedAt.UnixMilli()
+		edgeParams.Start = &start
+	}
+
+	res, err := cluster.GetHttpClient().V1SandboxLogsSummaryWithResponse(ctx, sandboxID, edgeParams)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when returning logs summary for sandbox", err)
+
+		return nil, &api.Error{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("Error returning logs summary for sandbox '%s'", sandboxID),
+		}
+	}
+
+	if res.JSON200 == nil {
+		telemetry.ReportCriticalError(ctx, "error when returning logs summary for sandbox", fmt.Errorf("unexpected response for sandbox '%s': %s", sandboxID, string(res.Body)))
+
+		return nil, &api.Error{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("Error returning logs summary for sandbox '%s'", sandboxID),
+		}
+	}
+
+	counts := make([]api.SandboxLogsCount, 0, len(res.JSON200.Counts))
+	for _, count := range res.JSON200.Counts {
+		counts = append(counts, api.SandboxLogsCount{
+			EventType: api.SandboxLogEventType(count.EventType),
+			Level:     api.LogLevel(count.Level),
+			Count:     count.Count,
+		})
+	}
+
+	return &api.SandboxLogsSummary{Total: res.JSON200.Total, Counts: counts}, nil
+}
@@ -148,6 +148,7 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		snap.SandboxID,
 		timeout,
 		nil,
+		nil, // secrets - kept in the memory of the paused sandbox
 		snap.Metadata,
 		alias,
 		teamInfo,
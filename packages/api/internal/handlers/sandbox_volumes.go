@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
+)
+
+const (
+	// minEnvdVersionForVolumeAttach is the first envd version able to mount a volume in a running sandbox.
+	minEnvdVersionForVolumeAttach = "0.4.8"
+	// minEnvdVersionForVolumeDetach is the first envd version able to unmount a volume from a running sandbox.
+	minEnvdVersionForVolumeDetach = "0.4.9"
+)
+
+// PostSandboxesSandboxIDVolumes mounts a volume in a running sandbox without restarting it.
+func (a *APIStore) PostSandboxesSandboxIDVolumes(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	body, err := utils.ParseBody[api.SandboxVolumeAttach](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		return
+	}
+
+	if errMsg := ValidateMountPath(body.MountPath); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+		return
+	}
+
+	if subpath := body.Subpath; subpath != nil {
+		if errMsg := ValidateVolumeSubpath(*subpath); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+			return
+		}
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(ctx, sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	if sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return
+	}
+
+	if ok, err := sharedUtils.IsGTEVersion(sbx.EnvdVersion, minEnvdVersionForVolumeAttach); err != nil || !ok {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The template of the sandbox doesn't support attaching volumes to running sandboxes, rebuild the template to use it.")
+
+		return
+	}
+
+	if errMsg := ValidateMountResources(body.MountResources, sbx.RamMB); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+		return
+	}
+
+	if errMsg := ValidateVolumeMountOptions(body.MountOptions, false); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+		return
+	}
+
+	volume, err := a.resolveVolumeByID(ctx, teamID, body.VolumeId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+
+		return
+	}
+
+	if volume.Status != "available" {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s, must be available", volume.Status))
+
+		return
+	}
+
+	volumeConfig := volumeAttachConfig(volume)
+	volumeConfig.MountPath = body.MountPath
+	volumeConfig.ReadOnly = sharedUtils.DerefOrDefault(body.ReadOnly, false)
+	volumeConfig.Subpath = sharedUtils.DerefOrDefault(body.Subpath, "")
+	if resources := body.MountResources; resources != nil {
+		volumeConfig.MountMemoryMB = int64(sharedUtils.DerefOrDefault(resources.MemoryMB, 0))
+		volumeConfig.MountCPUWeight = int64(sharedUtils.DerefOrDefault(resources.CpuWeight, 0))
+	}
+	if err := a.applyTemplateVolumeDefaults(ctx, sbx.TemplateID, volumeConfig); err != nil {
+		telemetry.ReportError(ctx, "failed to get template volume defaults", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the volume defaults of the template")
+
+		return
+	}
+	if options := body.MountOptions; options != nil {
+		volumeConfig.MountOptions = volumeoptions.Merge(volumeConfig.MountOptions, *options)
+	}
+
+	volumeLocked, err := a.lockVolumeAttachment(ctx, teamID, sbx.SandboxID, volumeConfig)
+	if err != nil {
+		a.sendVolumeAttachmentError(c, sandboxID, err)
+
+		return
+	}
+
+	err = a.orchestrator.AttachVolume(ctx, sbx.SandboxID, volumeConfig, sbx.ClusterID, sbx.NodeID)
+	if err != nil && volumeLocked {
+		a.unlockVolumeAttachment(ctx, sbx.SandboxID, volume.ID)
+	}
+
+	switch {
+	case err == nil:
+	case errors.Is(err, orchestrator.ErrSandboxNotFound):
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	case errors.Is(err, orchestrator.ErrVolumeAttachRejected):
+		a.sendAPIStoreError(c, http.StatusConflict, err.Error())
+
+		return
+	default:
+		telemetry.ReportError(ctx, "error attaching volume", err, telemetry.WithSandboxID(sandboxID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error attaching volume")
+
+		return
+	}
+
+	// The volume counts as attached while the sandbox runs, it's protected from deletion
+	a.updateSandboxRunVolume(ctx, sbx.SandboxID, &volume.ID, &body.MountPath)
+
+	// The API sees fresh metadata after the sandbox mounted the volume
+	if a.juicefsPool != nil {
+		a.juicefsPool.InvalidateVolume(volume.ID)
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionVolumeAttach, VolumeID: volume.ID, SandboxID: sbx.SandboxID})
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteSandboxesSandboxIDVolumesVolumeID flushes and unmounts the volume from a running sandbox without restarting it.
+func (a *APIStore) DeleteSandboxesSandboxIDVolumesVolumeID(c *gin.Context, sandboxID api.SandboxID, volumeID api.VolumeIdOrName) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := a.orchestrator.GetSandbox(ctx, sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	if sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return
+	}
+
+	if ok, err := sharedUtils.IsGTEVersion(sbx.EnvdVersion, minEnvdVersionForVolumeDetach); err != nil || !ok {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The template of the sandbox doesn't support detaching volumes from running sandboxes, rebuild the template to use it.")
+
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, teamID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+
+		return
+	}
+
+	err = a.orchestrator.DetachVolume(ctx, sbx.SandboxID, volume.ID, sbx.ClusterID, sbx.NodeID)
+	switch {
+	case err == nil:
+	case errors.Is(err, orchestrator.ErrSandboxNotFound):
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	case errors.Is(err, orchestrator.ErrVolumeDetachRejected):
+		a.sendAPIStoreError(c, http.StatusConflict, err.Error())
+
+		return
+	default:
+		telemetry.ReportError(ctx, "error detaching volume", err, telemetry.WithSandboxID(sandboxID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error detaching volume")
+
+		return
+	}
+
+	a.updateSandboxRunVolume(ctx, sbx.SandboxID, nil, nil)
+
+	// Another sandbox can attach the volume read-write right away, without waiting for the detach event
+	a.unlockVolumeAttachment(ctx, sbx.SandboxID, volume.ID)
+
+	// The data the sandbox flushed is visible through the API
+	if a.juicefsPool != nil {
+		a.juicefsPool.InvalidateVolume(volume.ID)
+	}
+
+	// The other API instances drop the metadata they loaded while the sandbox was writing
+	if a.volumeLeases != nil {
+		if _, err := a.volumeLeases.Bump(ctx, volume.ID); err != nil {
+			telemetry.ReportError(ctx, "error bumping the volume metadata generation", err, telemetry.WithSandboxID(sandboxID))
+		}
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionVolumeDetach, VolumeID: volume.ID, SandboxID: sbx.SandboxID})
+
+	c.Status(http.StatusNoContent)
+}
+
+// updateSandboxRunVolume records the volume attached to the running sandbox. The attachment already
+// happened, so a failure is only reported.
+func (a *APIStore) updateSandboxRunVolume(ctx context.Context, sandboxID string, volumeID, mountPath *string) {
+	err := a.sqlcDB.UpdateSandboxRunVolume(ctx, queries.UpdateSandboxRunVolumeParams{
+		VolumeID:        volumeID,
+		VolumeMountPath: mountPath,
+		SandboxID:       sandboxID,
+	})
+	if err != nil {
+		telemetry.ReportError(ctx, "error updating the volume of the sandbox run", err, telemetry.WithSandboxID(sandboxID))
+	}
+}
+
+// volumeAttachConfig returns the config of the volume for the orchestrator, where its data and metadata live.
+func volumeAttachConfig(volume queries.Volume) *types.VolumeConfig {
+	return &types.VolumeConfig{
+		VolumeID:       volume.ID,
+		GCSBucket:      volumeBucket(volume),
+		MetadataEngine: string(storedMetaEngine(volume)),
+		RedisDB:        int(sharedUtils.DerefOrDefault(volume.RedisDb, 0)),
+		MountOptions:   volume.MountOptions,
+	}
+}
+
+// templateDefaultVolume returns the volume the template attaches to the sandboxes of the team that
+// don't request one, nil when the template has no default volume or the team no such volume available.
+// A volume name ending with * attaches the most recently created volume whose name starts with it.
+func (a *APIStore) templateDefaultVolume(ctx context.Context, teamID uuid.UUID, templateID string) (*types.VolumeConfig, error) {
+	defaults, err := a.sqlcDB.GetTemplateDefaultVolume(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get template default volume: %w", err)
+	}
+
+	name := sharedUtils.DerefOrDefault(defaults.VolumeName, "")
+	var volume queries.Volume
+	if prefix, ok := strings.CutSuffix(name, "*"); ok {
+		volumes, err := a.sqlcDB.ListVolumes(ctx, queries.ListVolumesParams{
+			TeamID:     teamID,
+			Status:     []string{string(api.Available)},
+			NamePrefix: prefix,
+			CursorTime: time.Now(),
+			QueryLimit: 1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list volumes with prefix %q: %w", prefix, err)
+		}
+		if len(volumes) == 0 {
+			return nil, nil
+		}
+
+		volume = volumes[0]
+	} else {
+		volume, err = a.sqlcDB.GetVolumeByName(ctx, queries.GetVolumeByNameParams{TeamID: teamID, Name: name})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get volume %q: %w", name, err)
+		}
+		if volume.Status != string(api.Available) {
+			return nil, nil
+		}
+	}
+
+	volumeConfig := volumeAttachConfig(volume)
+	volumeConfig.MountPath = sharedUtils.DerefOrDefault(defaults.MountPath, "")
+	volumeConfig.ReadOnly = defaults.ReadOnly
+
+	return volumeConfig, nil
+}
+
+// applyTemplateVolumeDefaults applies the options of the volume over the default mount options of
+// the volumes of the template.
+func (a *APIStore) applyTemplateVolumeDefaults(ctx context.Context, templateID string, volumeConfig *types.VolumeConfig) error {
+	defaults, err := a.sqlcDB.GetTemplateVolumeMountOptions(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("get template volume mount options: %w", err)
+	}
+
+	volumeConfig.MountOptions = volumeoptions.Merge(defaults, volumeConfig.MountOptions)
+
+	return nil
+}
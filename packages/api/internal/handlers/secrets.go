@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// secretNamePattern validates secret names, they are used as environment variable names in the sandbox.
+var secretNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,127}$`)
+
+const (
+	// reservedSecretPrefix is used by the variables envd sets in the sandbox.
+	reservedSecretPrefix = "MORU_"
+
+	// maxSecretValueSize bounds a secret value, secrets are held in memory by envd.
+	maxSecretValueSize = 64 << 10
+
+	// maxSandboxSecrets bounds the number of secrets injected into a single sandbox.
+	maxSandboxSecrets = 100
+)
+
+// validateSecretName returns an error message if the name can't be used as a secret name.
+func validateSecretName(name string) string {
+	if !secretNamePattern.MatchString(name) {
+		return fmt.Sprintf("invalid secret name '%s', it must be a valid environment variable name (letters, digits and underscores, max 128 chars)", name)
+	}
+
+	if strings.HasPrefix(strings.ToUpper(name), reservedSecretPrefix) {
+		return fmt.Sprintf("invalid secret name '%s', the %s prefix is reserved", name, reservedSecretPrefix)
+	}
+
+	return ""
+}
+
+func secretToAPI(secret queries.TeamSecret) api.TeamSecret {
+	return api.TeamSecret{
+		Name:      secret.Name,
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.UpdatedAt,
+	}
+}
+
+// GetSecrets lists the secrets of the team, values are never returned.
+func (a *APIStore) GetSecrets(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	secrets, err := a.sqlcDB.ListTeamSecrets(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list team secrets", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list secrets")
+		return
+	}
+
+	result := make([]api.TeamSecret, len(secrets))
+	for i, secret := range secrets {
+		result[i] = secretToAPI(secret)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PostSecrets stores a team secret encrypted, replacing the value of an existing secret with the same name.
+func (a *APIStore) PostSecrets(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	if a.secretsEncryptor == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Secrets are not available")
+		return
+	}
+
+	var req api.NewTeamSecret
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if errMsg := validateSecretName(req.Name); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if len(req.Value) > maxSecretValueSize {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("secret value must be at most %d bytes", maxSecretValueSize))
+		return
+	}
+
+	encrypted, err := a.secretsEncryptor.Encrypt([]byte(req.Value))
+	if err != nil {
+		logger.L().Error(ctx, "Failed to encrypt team secret", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to store secret")
+		return
+	}
+
+	secret, err := a.sqlcDB.UpsertTeamSecret(ctx, queries.UpsertTeamSecretParams{
+		TeamID:         team.ID,
+		Name:           req.Name,
+		ValueEncrypted: encrypted,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to store team secret", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to store secret")
+		return
+	}
+
+	logger.L().Info(ctx, "Team secret stored",
+		zap.String("secret_name", req.Name),
+		logger.WithTeamID(team.ID.String()),
+	)
+
+	c.JSON(http.StatusCreated, secretToAPI(secret))
+}
+
+// DeleteSecretsSecretName deletes a team secret. Running sandboxes keep the value they were started with.
+func (a *APIStore) DeleteSecretsSecretName(c *gin.Context, secretName api.SecretName) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	deleted, err := a.sqlcDB.DeleteTeamSecret(ctx, queries.DeleteTeamSecretParams{
+		TeamID: team.ID,
+		Name:   secretName,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to delete team secret", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to delete secret")
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Secret not found")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// resolveSandboxSecrets returns the decrypted values of the named team secrets.
+// Every name must refer to an existing secret of the team.
+func (a *APIStore) resolveSandboxSecrets(ctx context.Context, teamID uuid.UUID, names []string) (map[string]string, *api.APIError) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	if a.secretsEncryptor == nil {
+		return nil, &api.APIError{
+			Code:      http.StatusServiceUnavailable,
+			ClientMsg: "Secrets are not available",
+			Err:       fmt.Errorf("secrets requested without an encryption key configured"),
+		}
+	}
+
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+	if len(names) > maxSandboxSecrets {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("a sandbox can have at most %d secrets", maxSandboxSecrets),
+			Err:       fmt.Errorf("too many secrets requested: %d", len(names)),
+		}
+	}
+
+	rows, err := a.sqlcDB.GetTeamSecretsByNames(ctx, queries.GetTeamSecretsByNamesParams{
+		TeamID: teamID,
+		Names:  names,
+	})
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get secrets",
+			Err:       fmt.Errorf("failed to get team secrets: %w", err),
+		}
+	}
+
+	secrets := make(map[string]string, len(rows))
+	for _, row := range rows {
+		value, err := a.secretsEncryptor.Decrypt(row.ValueEncrypted)
+		if err != nil {
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Failed to get secrets",
+				Err:       fmt.Errorf("failed to decrypt team secret %s: %w", row.Name, err),
+			}
+		}
+
+		secrets[row.Name] = string(value)
+	}
+
+	for _, name := range names {
+		if _, ok := secrets[name]; !ok {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Secret '%s' not found", name),
+				Err:       fmt.Errorf("secret %s not found", name),
+			}
+		}
+	}
+
+	return secrets, nil
+}
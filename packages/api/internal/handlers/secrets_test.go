@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSecretName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		isValid bool
+	}{
+		{name: "uppercase", input: "API_TOKEN", isValid: true},
+		{name: "lowercase", input: "api_token", isValid: true},
+		{name: "leading underscore", input: "_TOKEN", isValid: true},
+		{name: "with digits", input: "TOKEN_2", isValid: true},
+		{name: "max length (128 chars)", input: "A" + strings.Repeat("1", 127), isValid: true},
+
+		{name: "empty", input: "", isValid: false},
+		{name: "starts with digit", input: "2TOKEN", isValid: false},
+		{name: "contains hyphen", input: "API-TOKEN", isValid: false},
+		{name: "contains equals", input: "API=TOKEN", isValid: false},
+		{name: "too long (129 chars)", input: "A" + strings.Repeat("1", 128), isValid: false},
+		{name: "reserved prefix", input: "MORU_SANDBOX", isValid: false},
+		{name: "reserved prefix lowercase", input: "moru_token", isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := validateSecretName(tt.input)
+			assert.Equal(t, tt.isValid, errMsg == "", "validateSecretName(%q) = %q", tt.input, errMsg)
+		})
+	}
+}
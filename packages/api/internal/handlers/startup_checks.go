@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/cfg"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/crypto"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+)
+
+// startupCheckTimeout bounds the startup checks, so an unreachable dependency fails the start
+// instead of hanging it.
+const startupCheckTimeout = 30 * time.Second
+
+// checkVolumesConfig verifies at startup that the volume and secrets configuration works, so a
+// misconfiguration fails the start with an actionable message instead of failing the first requests.
+func checkVolumesConfig(ctx context.Context, config cfg.Config, db *sqlcdb.Client, secretsEncryptor *crypto.Encryptor) error {
+	ctx, cancel := context.WithTimeout(ctx, startupCheckTimeout)
+	defer cancel()
+
+	var errs []error
+
+	if config.VolumesBucket != "" {
+		errs = append(errs, checkVolumesBucket(ctx, config.VolumesBucket))
+
+		// Volume file operations restore and replicate the volume metadata with them
+		for _, binary := range []string{juicefs.LitestreamBinary, juicefs.SQLite3Binary} {
+			if _, err := exec.LookPath(binary); err != nil {
+				errs = append(errs, fmt.Errorf("%s is missing, it's needed for volume file operations when VOLUMES_BUCKET is set: %w", binary, err))
+			}
+		}
+	}
+
+	if secretsEncryptor != nil {
+		errs = append(errs, checkSecretsEncryptionKey(ctx, db, secretsEncryptor))
+	}
+
+	return errors.Join(errs...)
+}
+
+func checkVolumesBucket(ctx context.Context, bucket string) error {
+	if err := juicefs.CheckBucket(ctx, bucket); err != nil {
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			return fmt.Errorf("VOLUMES_BUCKET %q does not exist, create it or fix the bucket name", bucket)
+		}
+
+		return fmt.Errorf("VOLUMES_BUCKET %q is not reachable, check the credentials of the API have access to it: %w", bucket, err)
+	}
+
+	if err := juicefs.CheckBucketWritable(ctx, bucket, ".startup-check/"+id.Generate()); err != nil {
+		return fmt.Errorf("VOLUMES_BUCKET %q is not writable, grant the API the Storage Object Admin role on it: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// checkSecretsEncryptionKey decrypts a stored team secret, which fails when the key was changed.
+func checkSecretsEncryptionKey(ctx context.Context, db *sqlcdb.Client, secretsEncryptor *crypto.Encryptor) error {
+	canary, err := db.GetTeamSecretCanary(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing stored yet, any key works
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get a team secret to verify SECRETS_ENCRYPTION_KEY: %w", err)
+	}
+
+	if _, err := secretsEncryptor.Decrypt(canary); err != nil {
+		return fmt.Errorf("SECRETS_ENCRYPTION_KEY doesn't decrypt the stored team secrets, it must be the key the secrets were stored with: %w", err)
+	}
+
+	return nil
+}
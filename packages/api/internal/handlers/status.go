@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	platformstatus "github.com/moru-ai/sandbox-infra/packages/api/internal/platform-status"
+	apiutils "github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+// PlatformStatus returns the tracker of the recent requests the platform status is derived from.
+func (a *APIStore) PlatformStatus() *platformstatus.Tracker {
+	return a.platformStatus
+}
+
+// GetStatus returns the health of the platform components from the health checks and the recent server errors
+// on this API instance.
+func (a *APIStore) GetStatus(c *gin.Context) {
+	team := a.GetTeamInfo(c).Team
+	clusterID := apiutils.WithClusterFallback(team.ClusterID)
+
+	// Health checks failing regardless of the recent requests
+	unhealthy := map[api.PlatformComponent]string{}
+	if !a.Healthy {
+		unhealthy[api.PlatformComponentAPI] = "The API is not ready to serve requests"
+	}
+
+	ready := 0
+	for _, node := range a.orchestrator.GetClusterNodes(clusterID) {
+		if node.Status() == api.NodeStatusReady {
+			ready++
+		}
+	}
+	if ready == 0 {
+		unhealthy[api.PlatformComponentSandboxScheduling] = "No nodes are available to run sandboxes"
+	}
+
+	if _, ok := a.clustersPool.GetClusterById(clusterID); !ok {
+		unhealthy[api.PlatformComponentLogs] = "The log service is not reachable"
+	}
+
+	components := []api.PlatformComponent{api.PlatformComponentAPI}
+	// Volumes can't be used without the volumes bucket
+	if a.juicefsPool != nil {
+		components = append(components, api.PlatformComponentVolumes)
+	}
+	components = append(components, api.PlatformComponentSandboxScheduling, api.PlatformComponentLogs)
+
+	status := api.PlatformStatus{
+		Status:     api.PlatformStatusOperational,
+		Components: make([]api.PlatformComponentStatus, 0, len(components)),
+		UpdatedAt:  time.Now(),
+	}
+	for _, name := range components {
+		usage := a.platformStatus.Usage(name)
+
+		state, message := platformstatus.Status(name, usage)
+		if reason, ok := unhealthy[name]; ok {
+			state, message = api.PlatformStatusOutage, reason
+		}
+
+		component := api.PlatformComponentStatus{
+			Name:      name,
+			Status:    state,
+			Requests:  usage.Requests,
+			ErrorRate: usage.ErrorRate(),
+		}
+		if name == api.PlatformComponentSandboxScheduling {
+			component.LatencyP95Ms = utils.ToPtr(usage.LatencyP95.Milliseconds())
+		}
+		if message != "" {
+			component.Message = &message
+		}
+
+		status.Components = append(status.Components, component)
+		status.Status = platformstatus.Worst(status.Status, state)
+	}
+
+	c.JSON(http.StatusOK, status)
+}
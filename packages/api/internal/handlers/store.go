@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -17,18 +19,26 @@ import (
 
 	analyticscollector "github.com/moru-ai/sandbox-infra/packages/api/internal/analytics_collector"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
 	authcache "github.com/moru-ai/sandbox-infra/packages/api/internal/cache/auth"
 	templatecache "github.com/moru-ai/sandbox-infra/packages/api/internal/cache/templates"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/cfg"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/crypto"
 	dbapi "github.com/moru-ai/sandbox-infra/packages/api/internal/db"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/edge"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	customMiddleware "github.com/moru-ai/sandbox-infra/packages/api/internal/middleware"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/orchestrator"
+	platformstatus "github.com/moru-ai/sandbox-infra/packages/api/internal/platform-status"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/sandbox"
 	sandboxruns "github.com/moru-ai/sandbox-infra/packages/api/internal/sandbox-runs"
 	template_manager "github.com/moru-ai/sandbox-infra/packages/api/internal/template-manager"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	volumeattachments "github.com/moru-ai/sandbox-infra/packages/api/internal/volume-attachments"
+	volumeleases "github.com/moru-ai/sandbox-infra/packages/api/internal/volume-leases"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/webhooks"
 	clickhouse "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg"
 	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
@@ -57,14 +67,23 @@ type APIStore struct {
 	templateCache        *templatecache.TemplateCache
 	templateBuildsCache  *templatecache.TemplatesBuildCache
 	authCache            *authcache.TeamAuthCache
+	s3CredentialsCache   *authcache.S3CredentialsCache
 	templateSpawnCounter *utils.TemplateSpawnCounter
 	clickhouseStore      clickhouse.Clickhouse
 	accessTokenGenerator *sandbox.AccessTokenGenerator
 	featureFlags         *featureflags.Client
 	clustersPool         *edge.Pool
-	juicefsPool          *juicefs.Pool // For volume file operations (disabled until SQLite client implemented)
-	volumesBucket        string        // GCS bucket for volume data/metadata (used by FormatVolume/DestroyVolume)
+	juicefsPool          *juicefs.Pool        // For volume file operations (disabled until SQLite client implemented)
+	volumeLeases         *volumeleases.Leases // Write leases of the volumes, nil without Redis
+	volumesBucket        string               // GCS bucket for volume data/metadata (used by FormatVolume/DestroyVolume)
 	volEventsDelivery    events.Delivery[events.VolumeEvent]
+	secretsEncryptor     *crypto.Encryptor                 // For team secrets, nil when SECRETS_ENCRYPTION_KEY is not configured
+	authenticate         openapi3filter.AuthenticationFunc // Checks credentials for the capability hints of the OpenAPI document
+	jobs                 *jobs.Queue                       // Durable background jobs shared by the API instances
+	rateLimits           *customMiddleware.RateLimits      // Rate limiters of the endpoints, queried for the consumption of the teams
+	platformStatus       *platformstatus.Tracker           // Recent requests of the platform components, queried for the platform status
+	webdavLocks          sync.Map                          // WebDAV lock systems of the volumes by ID, the locks are held by each API instance
+	auditRecorder        *audit.Recorder                   // Records the operations on the volumes for the audit log of the teams
 }
 
 func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config) *APIStore {
@@ -137,6 +156,7 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 	}
 
 	authCache := authcache.NewTeamAuthCache()
+	s3CredentialsCache := authcache.NewS3CredentialsCache()
 	templateCache := templatecache.NewTemplateCache(sqlcDB)
 	templateSpawnCounter := utils.NewTemplateSpawnCounter(ctx, time.Minute, sqlcDB)
 
@@ -156,28 +176,72 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		logger.L().Info(ctx, "Volume events delivery initialized for Redis Streams")
 	}
 
+	// The write leases of the volumes serialize the API writes across the API instances
+	var volumeLeases *volumeleases.Leases
+	var volumeGenerations juicefs.Generations
+	if redisClient != nil {
+		volumeLeases = volumeleases.New(redisClient)
+		volumeGenerations = volumeLeases
+	}
+
 	// JuiceFS pool for volume file operations (list, download, upload, delete)
 	// Uses litestream restore to get SQLite metadata from GCS for each volume
 	var juicefsPool *juicefs.Pool
 	if config.VolumesBucket != "" {
+		// The cached clients reload the metadata the sandboxes synced since it was loaded
+		var metaReplicas juicefs.MetaReplicas
+		if gcsReplicas, err := juicefs.NewGCSMetaReplicas(ctx); err != nil {
+			logger.L().Error(ctx, "Failed to create the volume metadata replicas client, cached clients are not checked for freshness", zap.Error(err))
+		} else {
+			metaReplicas = gcsReplicas
+		}
+
 		juicefsPool = juicefs.NewPool(juicefs.Config{
-			GCSBucket: config.VolumesBucket,
-		})
+			GCSBucket:    config.VolumesBucket,
+			BufferSize:   uint64(config.VolumesClientBufferMB) << 20,
+			MaxClients:   config.VolumesClientPoolSize,
+			MemoryLimit:  uint64(config.VolumesClientMemoryLimitMB) << 20,
+			MetaReplicas: metaReplicas,
+			RedisURL:     config.VolumesRedisURL,
+		}, volumeGenerations, tel.MeterProvider)
 		logger.L().Info(ctx, "Volume file operations enabled",
-			zap.String("bucket", config.VolumesBucket))
+			zap.String("bucket", config.VolumesBucket),
+			zap.Int("max_clients", juicefsPool.MaxClients()))
+
+		if config.VolumesTeamBucketPrefix != "" {
+			if err := juicefs.ValidateBucketName(juicefs.TeamBucketName(config.VolumesTeamBucketPrefix, uuid.Nil)); err != nil {
+				logger.L().Fatal(ctx, "Invalid VOLUMES_TEAM_BUCKET_PREFIX", zap.Error(err))
+			}
+			logger.L().Info(ctx, "Volume data isolated in a bucket per team",
+				zap.String("bucket_prefix", config.VolumesTeamBucketPrefix))
+		}
 	} else {
 		logger.L().Info(ctx, "Volume file operations disabled (no VOLUMES_BUCKET configured)")
 	}
 
-	// Start sandbox runs consumer (writes sandbox events to PostgreSQL)
-	// Pass juicefsPool so it can invalidate cache when sandbox with volume terminates
-	if redisClient != nil {
-		var consumerOpts []sandboxruns.ConsumerOption
-		if juicefsPool != nil {
-			consumerOpts = append(consumerOpts, sandboxruns.WithVolumeInvalidator(juicefsPool.InvalidateVolume))
+	var secretsEncryptor *crypto.Encryptor
+	if config.SecretsEncryptionKey != "" {
+		secretsEncryptor, err = crypto.NewEncryptor(config.SecretsEncryptionKey)
+		if err != nil {
+			logger.L().Fatal(ctx, "Initializing secrets encryptor", zap.Error(err))
 		}
-		sandboxRunsConsumer := sandboxruns.NewConsumer(redisClient, sqlcDB, consumerOpts...)
-		go sandboxRunsConsumer.Run(ctx)
+	} else {
+		logger.L().Info(ctx, "Team secrets disabled (no SECRETS_ENCRYPTION_KEY configured)")
+	}
+
+	if err := checkVolumesConfig(ctx, config, sqlcDB, secretsEncryptor); err != nil {
+		logger.L().Fatal(ctx, "Startup checks of the volumes configuration failed", zap.Error(err))
+	}
+
+	// Background jobs shared by the API instances, the handlers of the jobs are registered below
+	jobQueue, err := jobs.NewQueue(sqlcDB, tel.MeterProvider)
+	if err != nil {
+		logger.L().Fatal(ctx, "Initializing background jobs queue", zap.Error(err))
+	}
+
+	rateLimits, err := customMiddleware.NewRateLimits(tel.MeterProvider)
+	if err != nil {
+		logger.L().Fatal(ctx, "Initializing rate limits", zap.Error(err))
 	}
 
 	a := &APIStore{
@@ -191,6 +255,7 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		templateCache:        templateCache,
 		templateBuildsCache:  templateBuildsCache,
 		authCache:            authCache,
+		s3CredentialsCache:   s3CredentialsCache,
 		templateSpawnCounter: templateSpawnCounter,
 		clickhouseStore:      clickhouseStore,
 		accessTokenGenerator: accessTokenGenerator,
@@ -198,10 +263,111 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		featureFlags:         featureFlags,
 		redisClient:          redisClient,
 		juicefsPool:          juicefsPool,
+		volumeLeases:         volumeLeases,
 		volumesBucket:        config.VolumesBucket,
 		volEventsDelivery:    volEventsDelivery,
+		secretsEncryptor:     secretsEncryptor,
+		jobs:                 jobQueue,
+		rateLimits:           rateLimits,
+		platformStatus:       platformstatus.NewTracker(),
+		auditRecorder:        audit.NewRecorder(sqlcDB),
+	}
+
+	// Delete the scratch volumes of the killed sandboxes
+	jobQueue.Register(deleteEphemeralVolumesJob, a.deleteEphemeralVolumes, jobs.KindConfig{
+		Concurrency: 4,
+		RetryDelay:  time.Minute,
+	})
+
+	// Prepare the volumes created by the API until they're available
+	jobQueue.Register(prepareVolumeJob, a.runVolumePreparation, jobs.KindConfig{
+		Concurrency: 4,
+	})
+
+	// Keep the size and file count reported for volumes up to date
+	if juicefsPool != nil {
+		jobQueue.Register(syncVolumeStatsJob, a.syncVolumeStats, jobs.KindConfig{
+			MaxAttempts: 1,
+			Timeout:     volumeStatsInterval,
+		})
+		jobQueue.Every(syncVolumeStatsJob, volumeStatsInterval)
+
+		// Check the consistency of volumes, waiting for the sandboxes attaching them to detach
+		jobQueue.Register(fsckVolumeJob, a.runVolumeFsck, jobs.KindConfig{
+			MaxAttempts: 10,
+			Timeout:     fsckVolumeTimeout,
+			RetryDelay:  time.Minute,
+		})
+
+		// Delete the files materialized for signed downloads once their URL expired
+		jobQueue.Register(deleteStagedDownloadJob, a.deleteStagedDownload, jobs.KindConfig{
+			Concurrency: 4,
+		})
+
+		// Upgrade the metadata of the volumes formatted by older releases
+		if config.VolumesFormatMigrationBatchSize > 0 {
+			jobQueue.Register(migrateVolumesFormatJob, a.migrateVolumesFormat, jobs.KindConfig{
+				MaxAttempts: 1,
+				Timeout:     volumesFormatMigrationInterval,
+			})
+			jobQueue.Every(migrateVolumesFormatJob, volumesFormatMigrationInterval)
+		}
+	}
+
+	// Destroy the volumes whose deletion grace period ended
+	if config.VolumesDeleteGraceDays > 0 {
+		jobQueue.Register(reapDeletedVolumesJob, a.reapDeletedVolumes, jobs.KindConfig{
+			MaxAttempts: 1,
+			Timeout:     volumeReaperInterval,
+		})
+		jobQueue.Every(reapDeletedVolumesJob, volumeReaperInterval)
+	}
+
+	// Delete the storage left by failed creates and partial deletes of volumes
+	if config.VolumesOrphanGraceHours > 0 {
+		jobQueue.Register(reapVolumeOrphansJob, a.reapVolumeOrphans, jobs.KindConfig{
+			MaxAttempts: 1,
+			Timeout:     volumeOrphansInterval,
+		})
+		jobQueue.Every(reapVolumeOrphansJob, volumeOrphansInterval)
+
+		jobQueue.Register(deleteVolumeOrphanJob, a.deleteVolumeOrphan, jobs.KindConfig{})
+	}
+
+	// Update envd and the volume mount helpers in the templates built with an older envd
+	if config.TemplatesEnvdUpdateBatchSize > 0 {
+		jobQueue.Register(updateTemplatesEnvdJob, a.updateTemplatesEnvd, jobs.KindConfig{
+			MaxAttempts: 1,
+			Timeout:     templatesEnvdUpdateInterval,
+		})
+		jobQueue.Every(updateTemplatesEnvdJob, templatesEnvdUpdateInterval)
 	}
 
+	// Start sandbox runs consumer (writes sandbox events to PostgreSQL)
+	// Pass juicefsPool so it can invalidate cache when sandbox with volume terminates,
+	// and the store so it deletes the scratch volumes of the killed sandboxes
+	if redisClient != nil {
+		var consumerOpts []sandboxruns.ConsumerOption
+		if juicefsPool != nil {
+			consumerOpts = append(consumerOpts, sandboxruns.WithVolumeInvalidator(juicefsPool.InvalidateVolume))
+		}
+		consumerOpts = append(consumerOpts, sandboxruns.WithEphemeralVolumeCleaner(a.cleanupEphemeralVolumes))
+		sandboxRunsConsumer := sandboxruns.NewConsumer(redisClient, sqlcDB, consumerOpts...)
+		go sandboxRunsConsumer.Run(ctx)
+
+		// Start volume attachments consumer (writes volume attach and detach events and the history of the mounts to PostgreSQL)
+		volumeAttachmentsConsumer := volumeattachments.NewConsumer(redisClient, sqlcDB)
+		go volumeAttachmentsConsumer.Run(ctx)
+
+		// Start webhooks consumer (posts the volume events to the team webhooks), the webhook secrets are encrypted
+		if secretsEncryptor != nil {
+			webhooksConsumer := webhooks.NewConsumer(redisClient, sqlcDB, secretsEncryptor)
+			go webhooksConsumer.Run(ctx)
+		}
+	}
+
+	go jobQueue.Run(ctx)
+
 	// Wait till there's at least one, otherwise we can't create sandboxes yet
 	go func() {
 		ticker := time.NewTicker(5 * time.Millisecond)
@@ -253,6 +419,12 @@ func (a *APIStore) Close(ctx context.Context) error {
 		}
 	}
 
+	if a.s3CredentialsCache != nil {
+		if err := a.s3CredentialsCache.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("closing S3 credentials cache: %w", err))
+		}
+	}
+
 	if err := a.sqlcDB.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("closing sqlc database client: %w", err))
 	}
@@ -336,6 +508,50 @@ func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (*types
 	return team, nil
 }
 
+// GetS3CredentialsFromAccessKey returns the S3 credentials with the access key ID, with their team
+// and their secret access key.
+func (a *APIStore) GetS3CredentialsFromAccessKey(ctx context.Context, accessKeyID string) (*types.S3Credentials, *api.APIError) {
+	// The secret access keys are stored encrypted
+	if a.secretsEncryptor == nil {
+		return nil, &api.APIError{
+			Err:       errors.New("secrets encryption key is not configured"),
+			ClientMsg: "S3 credentials are not available",
+			Code:      http.StatusServiceUnavailable,
+		}
+	}
+
+	credentials, err := a.s3CredentialsCache.GetOrSet(ctx, accessKeyID, func(ctx context.Context, key string) (*types.S3Credentials, error) {
+		return dbapi.GetTeamS3CredentialsAuth(ctx, a.sqlcDB, a.secretsEncryptor, key)
+	})
+	if err != nil {
+		var usageErr *dbapi.TeamForbiddenError
+		if errors.As(err, &usageErr) {
+			return nil, &api.APIError{
+				Err:       err,
+				ClientMsg: err.Error(),
+				Code:      http.StatusForbidden,
+			}
+		}
+
+		var blockedErr *dbapi.TeamBlockedError
+		if errors.As(err, &blockedErr) {
+			return nil, &api.APIError{
+				Err:       err,
+				ClientMsg: err.Error(),
+				Code:      http.StatusForbidden,
+			}
+		}
+
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("failed to get the team from db for an S3 access key: %w", err),
+			ClientMsg: "The AWS access key ID you provided does not exist in our records",
+			Code:      http.StatusUnauthorized,
+		}
+	}
+
+	return credentials, nil
+}
+
 func (a *APIStore) GetUserFromAccessToken(ctx context.Context, accessToken string) (uuid.UUID, *api.APIError) {
 	hashedToken, err := keys.VerifyKey(keys.AccessTokenPrefix, accessToken)
 	if err != nil {
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	clickhouseUtils "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/utils"
+	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetTeamsTeamIDMetricsResources(c *gin.Context, teamID string, params api.GetTeamsTeamIDMetricsResourcesParams) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "team-resource-metrics")
+	defer span.End()
+
+	team := c.Value(auth.TeamContextKey).(*types.Team)
+
+	if teamID != team.ID.String() {
+		telemetry.ReportError(ctx, "team ids mismatch", fmt.Errorf("you (%s) are not authorized to access this team's (%s) metrics", team.ID, teamID), telemetry.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You (%s) are not authorized to access this team's (%s) metrics", team.ID, teamID))
+
+		return
+	}
+
+	metricsReadFlag := a.featureFlags.BoolFlag(ctx, featureflags.MetricsReadFlagName)
+
+	if !metricsReadFlag {
+		logger.L().Debug(ctx, "sandbox metrics read feature flag is disabled")
+
+		c.JSON(http.StatusOK, []api.TeamResourceMetric{})
+
+		return
+	}
+
+	// Default time range is the last 7 days, the sandbox samples are kept for 7 days
+	start, end := time.Now().Add(-defaultTimeRange), time.Now()
+	if params.Start != nil {
+		start = time.Unix(*params.Start, 0)
+	}
+
+	if params.End != nil {
+		end = time.Unix(*params.End, 0)
+	}
+
+	start, end, err := clickhouseUtils.ValidateRange(start, end)
+	if err != nil {
+		telemetry.ReportError(ctx, "error validating dates", err, telemetry.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	step := clickhouseUtils.CalculateStep(start, end)
+
+	metrics, err := a.clickhouseStore.QueryTeamResourceMetrics(ctx, teamID, start, end, step)
+	if err != nil {
+		telemetry.ReportError(ctx, "error fetching team resource metrics", err, telemetry.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("error querying team resource metrics: %s", err))
+
+		return
+	}
+
+	apiMetrics := make([]api.TeamResourceMetric, len(metrics))
+	for i, m := range metrics {
+		apiMetrics[i] = api.TeamResourceMetric{
+			TimestampUnix: m.Timestamp.Unix(),
+			SandboxCount:  int32(m.SandboxCount),
+			CpuCount:      int32(m.CPUCount),
+			CpuUsed:       float32(m.CPUUsed),
+			MemUsed:       int64(m.MemUsed),
+			MemTotal:      int64(m.MemTotal),
+			DiskUsed:      int64(m.DiskUsed),
+			DiskTotal:     int64(m.DiskTotal),
+		}
+	}
+
+	c.JSON(http.StatusOK, apiMetrics)
+}
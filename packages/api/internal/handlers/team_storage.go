@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+// GetTeamsStorageUsage returns the storage used by the volumes of the team and the team storage limit.
+func (a *APIStore) GetTeamsStorageUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	usage, err := a.sqlcDB.GetTeamStorageUsage(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to get team storage usage", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get storage usage")
+		return
+	}
+
+	result := api.TeamStorageUsage{
+		UsedBytes:   usage.UsedBytes,
+		VolumeCount: usage.VolumeCount,
+	}
+	if team.Limits.MaxStorageBytes > 0 {
+		result.LimitBytes = &team.Limits.MaxStorageBytes
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// checkTeamStorageAvailable returns an error if the team volumes use up the team storage limit,
+// so no new volume can be created.
+func (a *APIStore) checkTeamStorageAvailable(ctx context.Context, team *types.Team) *api.APIError {
+	limit := team.Limits.MaxStorageBytes
+	if limit <= 0 {
+		return nil
+	}
+
+	usage, err := a.sqlcDB.GetTeamStorageUsage(ctx, team.ID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get storage usage",
+			Err:       fmt.Errorf("failed to get team storage usage: %w", err),
+		}
+	}
+
+	if usage.UsedBytes >= limit {
+		return &api.APIError{
+			Code:      http.StatusPaymentRequired,
+			ClientMsg: fmt.Sprintf("Team storage limit of %d bytes reached, free up space in existing volumes or upgrade your plan", limit),
+			Err:       fmt.Errorf("team storage limit reached: %d of %d bytes used", usage.UsedBytes, limit),
+		}
+	}
+
+	return nil
+}
+
+// volumeWriteLimit returns the size limit of writes to the volume, the volume size limit or the
+// space the team has left, whichever is lower, with the message to send when a write exceeds it.
+// A limit of 0 means unlimited.
+func (a *APIStore) volumeWriteLimit(ctx context.Context, team *types.Team, volume queries.Volume) (int64, string, *api.APIError) {
+	limit := a.volumeSizeLimit(volume)
+	msg := "Upload exceeds the volume size limit"
+
+	teamLimit := team.Limits.MaxStorageBytes
+	if teamLimit <= 0 {
+		return limit, msg, nil
+	}
+
+	usage, err := a.sqlcDB.GetTeamStorageUsage(ctx, team.ID)
+	if err != nil {
+		return 0, "", &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get storage usage",
+			Err:       fmt.Errorf("failed to get team storage usage: %w", err),
+		}
+	}
+
+	// The quota checks the current size of the volume, only the other volumes count from the recorded usage
+	teamLeft := teamLimit - (usage.UsedBytes - sharedUtils.DerefOrDefault(volume.TotalSizeBytes, 0))
+	if limit == 0 || teamLeft < limit {
+		limit = teamLeft
+		msg = fmt.Sprintf("Upload exceeds the team storage limit of %d bytes", teamLimit)
+	}
+
+	if limit <= 0 {
+		return 0, "", &api.APIError{
+			Code:      http.StatusRequestEntityTooLarge,
+			ClientMsg: msg,
+			Err:       fmt.Errorf("team storage limit reached: %d of %d bytes used", usage.UsedBytes, teamLimit),
+		}
+	}
+
+	return limit, msg, nil
+}
+
+// volumeSizeLimit returns the size limit of the volume, its own limit or the maximum volume size
+// of the platform, whichever is lower. A limit of 0 means unlimited.
+func (a *APIStore) volumeSizeLimit(volume queries.Volume) int64 {
+	limit := sharedUtils.DerefOrDefault(volume.SizeLimitBytes, 0)
+
+	maxSize := a.config.VolumesMaxSizeBytes
+	if maxSize > 0 && (limit == 0 || maxSize < limit) {
+		return maxSize
+	}
+
+	return limit
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	apiutils "github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/dberrors"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/consts"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/templates"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+const (
+	// updateTemplatesEnvdJob is the background job starting the builds that update envd in the templates built with an older version.
+	updateTemplatesEnvdJob = "templates.update-envd"
+
+	// templatesEnvdUpdateInterval is how often templates built with an older envd are looked for.
+	templatesEnvdUpdateInterval = 10 * time.Minute
+)
+
+var (
+	errTemplateEnvdUpToDate         = errors.New("the template already has the envd version of the template builder")
+	errTemplateEnvdUpdateNotAllowed = errors.New("only templates built with the v2 template build can be updated, rebuild the template instead")
+)
+
+// PostAdminTemplatesTemplateIDEnvdUpdate starts a build updating envd and the volume mount helpers in the template.
+func (a *APIStore) PostAdminTemplatesTemplateIDEnvdUpdate(c *gin.Context, templateID api.TemplateID) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "admin-update-template-envd")
+	defer span.End()
+
+	template, err := a.sqlcDB.GetTemplateWithBuild(ctx, templateID)
+	if err != nil {
+		if dberrors.IsNotFoundError(err) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Template '%s' with a finished build not found", templateID))
+
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error when getting template", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+
+		return
+	}
+
+	// The update is built from the latest build, it would be superseded by the build in progress
+	inProgress, err := a.sqlcDB.GetConcurrentTemplateBuilds(ctx, queries.GetConcurrentTemplateBuildsParams{
+		TemplateID:     template.Env.ID,
+		CurrentBuildID: uuid.Nil,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting running builds", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting running builds")
+
+		return
+	}
+	if len(inProgress) > 0 {
+		a.sendAPIStoreError(c, http.StatusConflict, "The template has a build in progress")
+
+		return
+	}
+
+	buildID, envdVersion, err := a.startTemplateEnvdUpdate(ctx, template.Env, template.EnvBuild)
+	switch {
+	case errors.Is(err, errTemplateEnvdUpToDate), errors.Is(err, errTemplateEnvdUpdateNotAllowed):
+		a.sendAPIStoreError(c, http.StatusConflict, err.Error())
+
+		return
+	case err != nil:
+		telemetry.ReportCriticalError(ctx, "error when starting template envd update", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when starting the envd update: %s", err))
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, api.AdminTemplateEnvdUpdate{
+		TemplateID:  template.Env.ID,
+		BuildID:     buildID.String(),
+		EnvdVersion: envdVersion,
+	})
+}
+
+// updateTemplatesEnvd starts the envd update of a batch of templates built with another envd version than
+// the template builders, the longest outdated first.
+func (a *APIStore) updateTemplatesEnvd(ctx context.Context, _ jobs.Job) error {
+	builder, err := a.templateManager.GetAvailableBuildClient(ctx, consts.LocalClusterID)
+	if err != nil {
+		return fmt.Errorf("failed to get a template builder: %w", err)
+	}
+
+	envdVersion := builder.GetEnvdVersion()
+	if envdVersion == "" {
+		return errors.New("the template builder didn't report its envd version")
+	}
+
+	batchSize := a.config.TemplatesEnvdUpdateBatchSize
+
+	// Some of the candidates can be skipped, they are built with a newer envd or can't be updated
+	candidates, err := a.sqlcDB.GetEnvdUpdateCandidates(ctx, queries.GetEnvdUpdateCandidatesParams{
+		EnvdVersion: envdVersion,
+		QueryLimit:  int32(batchSize * 4),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the templates to update: %w", err)
+	}
+
+	started := 0
+	for _, candidate := range candidates {
+		if started >= batchSize {
+			break
+		}
+
+		buildID, version, err := a.startTemplateEnvdUpdate(ctx, candidate.Env, candidate.EnvBuild)
+		switch {
+		case errors.Is(err, errTemplateEnvdUpToDate), errors.Is(err, errTemplateEnvdUpdateNotAllowed):
+			continue
+		case err != nil:
+			logger.L().Error(ctx, "Failed to start the envd update of the template", zap.Error(err), logger.WithTemplateID(candidate.Env.ID))
+
+			continue
+		}
+
+		logger.L().Info(ctx, "Started the envd update of the template",
+			logger.WithTemplateID(candidate.Env.ID),
+			logger.WithBuildID(buildID.String()),
+			zap.String("envd_version", version),
+		)
+		started++
+	}
+
+	return nil
+}
+
+// startTemplateEnvdUpdate starts a build of the template from its latest build without any steps,
+// so only envd and the volume mount helpers are replaced with the versions of the template builder.
+// The build keeps the resources, start and ready commands of the latest build.
+func (a *APIStore) startTemplateEnvdUpdate(ctx context.Context, env queries.Env, build queries.EnvBuild) (uuid.UUID, string, error) {
+	// v1 builds can't be based on another build, snapshots have no build version
+	if build.Version == nil {
+		return uuid.Nil, "", errTemplateEnvdUpdateNotAllowed
+	}
+	ok, err := utils.IsGTEVersion(*build.Version, templates.TemplateV2BetaVersion)
+	if err != nil || !ok {
+		return uuid.Nil, "", errTemplateEnvdUpdateNotAllowed
+	}
+
+	clusterID := apiutils.WithClusterFallback(env.ClusterID)
+	builder, err := a.templateManager.GetAvailableBuildClient(ctx, clusterID)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to get a template builder: %w", err)
+	}
+
+	envdVersion := builder.GetEnvdVersion()
+	if envdVersion == "" {
+		return uuid.Nil, "", errors.New("the template builder didn't report its envd version")
+	}
+
+	// Builds without a known envd version are always updated
+	if build.EnvdVersion != nil {
+		upToDate, err := utils.IsGTEVersion(*build.EnvdVersion, envdVersion)
+		if err == nil && upToDate {
+			return uuid.Nil, "", errTemplateEnvdUpToDate
+		}
+	}
+
+	dockerfile, err := json.Marshal(dockerfileStore{
+		FromTemplate: &env.ID,
+	})
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to encode the build source: %w", err)
+	}
+
+	buildID := uuid.New()
+	err = a.sqlcDB.CreateTemplateBuild(ctx, queries.CreateTemplateBuildParams{
+		BuildID:            buildID,
+		TemplateID:         env.ID,
+		RamMb:              build.RamMb,
+		Vcpu:               build.Vcpu,
+		KernelVersion:      build.KernelVersion,
+		FirecrackerVersion: build.FirecrackerVersion,
+		FreeDiskSizeMb:     build.FreeDiskSizeMb,
+		StartCmd:           build.StartCmd,
+		ReadyCmd:           build.ReadyCmd,
+		Dockerfile:         utils.ToPtr(string(dockerfile)),
+		Version:            build.Version,
+	})
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to create the build: %w", err)
+	}
+
+	machineInfo := builder.GetMachineInfo()
+	err = a.sqlcDB.UpdateTemplateBuild(ctx, queries.UpdateTemplateBuildParams{
+		StartCmd:        build.StartCmd,
+		ReadyCmd:        build.ReadyCmd,
+		Dockerfile:      utils.ToPtr(string(dockerfile)),
+		ClusterNodeID:   utils.ToPtr(builder.NodeID),
+		CpuArchitecture: utils.ToPtr(machineInfo.CPUArchitecture),
+		CpuFamily:       utils.ToPtr(machineInfo.CPUFamily),
+		CpuModel:        utils.ToPtr(machineInfo.CPUModel),
+		CpuModelName:    utils.ToPtr(machineInfo.CPUModelName),
+		CpuFlags:        machineInfo.CPUFlags,
+		BuildUuid:       buildID,
+	})
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to update the build: %w", err)
+	}
+
+	err = a.templateManager.CreateTemplate(
+		ctx,
+		env.TeamID,
+		env.ID,
+		buildID,
+		build.KernelVersion,
+		build.FirecrackerVersion,
+		build.StartCmd,
+		build.Vcpu,
+		build.FreeDiskSizeMb,
+		build.RamMb,
+		build.ReadyCmd,
+		nil,
+		&env.ID,
+		nil,
+		nil,
+		nil,
+		clusterID,
+		builder.NodeID,
+		*build.Version,
+	)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to start the build: %w", err)
+	}
+
+	return buildID, envdVersion, nil
+}
@@ -93,6 +93,22 @@ func (a *APIStore) GetTemplatesTemplateID(c *gin.Context, templateID api.Templat
 		return b.CreatedAt, b.ID.String()
 	})
 
+	volumeMountOptions, err := a.sqlcDB.GetTemplateVolumeMountOptions(ctx, template.ID)
+	if err != nil && !dberrors.IsNotFoundError(err) {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		telemetry.ReportCriticalError(ctx, "error when getting template volume mount options", err)
+
+		return
+	}
+
+	defaultVolume, err := a.sqlcDB.GetTemplateDefaultVolume(ctx, template.ID)
+	if err != nil && !dberrors.IsNotFoundError(err) {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		telemetry.ReportCriticalError(ctx, "error when getting template default volume", err)
+
+		return
+	}
+
 	res := api.TemplateWithBuilds{
 		TemplateID:    template.ID,
 		Public:        template.Public,
@@ -103,6 +119,17 @@ func (a *APIStore) GetTemplatesTemplateID(c *gin.Context, templateID api.Templat
 		SpawnCount:    template.SpawnCount,
 		Builds:        make([]api.TemplateBuild, 0, len(builds)),
 	}
+	if len(volumeMountOptions) > 0 {
+		options := api.TemplateVolumeMountOptions(volumeMountOptions)
+		res.VolumeMountOptions = &options
+	}
+	if defaultVolume.VolumeName != nil {
+		res.DefaultVolume = &api.TemplateDefaultVolume{
+			VolumeName: sharedUtils.DerefOrDefault(defaultVolume.VolumeName, ""),
+			MountPath:  defaultVolume.MountPath,
+			ReadOnly:   &defaultVolume.ReadOnly,
+		}
+	}
 
 	for _, item := range builds {
 		res.Builds = append(res.Builds, api.TemplateBuild{
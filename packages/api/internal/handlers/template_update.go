@@ -73,6 +73,25 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 		return
 	}
 
+	var volumeMountOptions *api.VolumeMountOptions
+	if body.VolumeMountOptions != nil {
+		options := api.VolumeMountOptions(*body.VolumeMountOptions)
+		if errMsg := ValidateVolumeMountOptions(&options, false); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+			return
+		}
+		volumeMountOptions = &options
+	}
+
+	if volume := body.DefaultVolume; volume != nil {
+		if errMsg := ValidateTemplateDefaultVolume(*volume); errMsg != "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+
+			return
+		}
+	}
+
 	// Update template
 	if body.Public != nil {
 		_, err := a.sqlcDB.UpdateTemplate(ctx, queries.UpdateTemplateParams{
@@ -95,6 +114,40 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 		}
 	}
 
+	if volumeMountOptions != nil {
+		var mountOptions map[string]string
+		if len(*volumeMountOptions) > 0 {
+			mountOptions = *volumeMountOptions
+		}
+
+		err := a.sqlcDB.SetTemplateVolumeMountOptions(ctx, queries.SetTemplateVolumeMountOptionsParams{
+			TemplateID:   template.ID,
+			MountOptions: mountOptions,
+		})
+		if err != nil {
+			telemetry.ReportError(ctx, "error when updating template volume mount options", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error updating template")
+
+			return
+		}
+	}
+
+	if volume := body.DefaultVolume; volume != nil {
+		params := queries.SetTemplateDefaultVolumeParams{TemplateID: template.ID}
+		if volume.VolumeName != "" {
+			params.VolumeName = &volume.VolumeName
+			params.MountPath = volume.MountPath
+			params.ReadOnly = sharedUtils.DerefOrDefault(volume.ReadOnly, false)
+		}
+
+		if err := a.sqlcDB.SetTemplateDefaultVolume(ctx, params); err != nil {
+			telemetry.ReportError(ctx, "error when updating template default volume", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error updating template")
+
+			return
+		}
+	}
+
 	a.templateCache.Invalidate(template.ID)
 
 	telemetry.ReportEvent(ctx, "updated template")
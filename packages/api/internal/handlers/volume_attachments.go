@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/sandbox"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+// GetVolumesIdOrNameAttachments lists the sandboxes the volume is currently mounted in, the earliest mounted first.
+func (a *APIStore) GetVolumesIdOrNameAttachments(c *gin.Context, volumeID api.VolumeIdOrName) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	attachments, err := a.sqlcDB.ListVolumeAttachments(ctx, volume.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list volume attachments")
+		return
+	}
+
+	result := make([]api.VolumeAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = api.VolumeAttachment{
+			SandboxID: attachment.SandboxID,
+			MountPath: attachment.MountPath,
+			MountedAt: attachment.MountedAt,
+			ReadOnly:  attachment.ReadOnly,
+		}
+		if attachment.Subpath != "" {
+			result[i].Subpath = &attachment.Subpath
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// uniqueViolation is the PostgreSQL error code of a second read-write attachment of the volume.
+const uniqueViolation = "23505"
+
+var (
+	// errVolumeAttachedReadWrite is returned when the volume is already attached read-write to another sandbox.
+	errVolumeAttachedReadWrite = errors.New("volume is attached read-write to another sandbox")
+	// errVolumeWriteInProgress is returned when the volume is being modified through the API.
+	errVolumeWriteInProgress = errors.New("volume is being modified through the API")
+)
+
+// sendVolumeAttachmentError reports a failure to record the attachment of the volume.
+func (a *APIStore) sendVolumeAttachmentError(c *gin.Context, sandboxID string, err error) {
+	switch {
+	case errors.Is(err, errVolumeAttachedReadWrite):
+		a.sendAPIStoreError(c, http.StatusConflict, "Volume is attached read-write to another sandbox, attach it read-only or detach it first")
+	case errors.Is(err, errVolumeWriteInProgress):
+		a.sendAPIStoreError(c, http.StatusConflict, "Volume is being modified through the API, retry later")
+	default:
+		telemetry.ReportError(c.Request.Context(), "error locking volume attachment", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error attaching volume")
+	}
+}
+
+// lockVolumeAttachment records the attachment of the volume before it's mounted in the sandbox and reports
+// whether it was recorded by this call. Only one sandbox can have the volume attached read-write, concurrent
+// writers would corrupt the SQLite metadata of the volume. Read-only attachments are never rejected.
+func (a *APIStore) lockVolumeAttachment(ctx context.Context, teamID uuid.UUID, sandboxID string, volume *types.VolumeConfig) (bool, error) {
+	locked, err := a.createVolumeAttachment(ctx, queries.CreateVolumeAttachmentParams{
+		VolumeID:  volume.VolumeID,
+		SandboxID: sandboxID,
+		TeamID:    teamID,
+		MountPath: volume.MountPath,
+		ReadOnly:  volume.ReadOnly,
+		Subpath:   volume.Subpath,
+		MountedAt: time.Now(),
+	})
+	if err != nil || !locked || volume.ReadOnly || a.volumeLeases == nil {
+		return locked, err
+	}
+
+	// The API writes check the attachments after taking the write lease of the volume,
+	// so either the write sees the attachment or the lease is seen here
+	writing, err := a.volumeLeases.IsHeld(ctx, volume.VolumeID)
+	if err == nil && !writing {
+		return true, nil
+	}
+
+	a.unlockVolumeAttachment(ctx, sandboxID, volume.VolumeID)
+	if err != nil {
+		return false, err
+	}
+
+	return false, errVolumeWriteInProgress
+}
+
+func (a *APIStore) createVolumeAttachment(ctx context.Context, params queries.CreateVolumeAttachmentParams) (bool, error) {
+	created, err := a.sqlcDB.CreateVolumeAttachment(ctx, params)
+	if !isUniqueViolation(err) {
+		return created > 0, err
+	}
+
+	// The writer can be gone without its detach being recorded, e.g. when its node was lost
+	released, err := a.releaseStaleVolumeWriters(ctx, params.VolumeID, params.SandboxID)
+	if err != nil {
+		return false, err
+	}
+	if !released {
+		return false, errVolumeAttachedReadWrite
+	}
+
+	created, err = a.sqlcDB.CreateVolumeAttachment(ctx, params)
+	if isUniqueViolation(err) {
+		return false, errVolumeAttachedReadWrite
+	}
+
+	return created > 0, err
+}
+
+// unlockVolumeAttachment removes the attachment of the volume when it wasn't mounted in the sandbox
+// or was unmounted from it.
+func (a *APIStore) unlockVolumeAttachment(ctx context.Context, sandboxID, volumeID string) {
+	err := a.sqlcDB.DeleteVolumeAttachment(ctx, queries.DeleteVolumeAttachmentParams{
+		VolumeID:   volumeID,
+		SandboxID:  sandboxID,
+		DetachedAt: time.Now(),
+	})
+	if err != nil {
+		telemetry.ReportError(ctx, "error removing the volume attachment", err, telemetry.WithSandboxID(sandboxID))
+	}
+}
+
+// releaseStaleVolumeWriters removes the read-write attachments of the volume to sandboxes that are no longer running.
+func (a *APIStore) releaseStaleVolumeWriters(ctx context.Context, volumeID, sandboxID string) (bool, error) {
+	attachments, err := a.sqlcDB.ListVolumeAttachments(ctx, volumeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list volume attachments: %w", err)
+	}
+
+	released := false
+	for _, attachment := range attachments {
+		if attachment.ReadOnly || attachment.SandboxID == sandboxID {
+			continue
+		}
+
+		_, err := a.orchestrator.GetSandbox(ctx, attachment.SandboxID)
+		var notFoundErr *sandbox.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			continue
+		}
+
+		logger.L().Info(ctx, "Releasing the volume attachment of a sandbox that is no longer running",
+			logger.WithSandboxID(attachment.SandboxID),
+			zap.String("volume_id", volumeID))
+		a.unlockVolumeAttachment(ctx, attachment.SandboxID, volumeID)
+		released = true
+	}
+
+	return released, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
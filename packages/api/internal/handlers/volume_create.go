@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/consts"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+// prepareVolumeJob is the background job preparing a new volume until it's available.
+const prepareVolumeJob = "volumes.prepare"
+
+// errVolumeNotCreating is returned when the volume being prepared was deleted or failed meanwhile.
+var errVolumeNotCreating = errors.New("volume is no longer creating")
+
+type volumePreparation struct {
+	VolumeID string `json:"volumeId"`
+}
+
+// enqueueVolumePreparation prepares the creating volume in the background. The volume is failed when
+// the preparation can't be enqueued, creating it again retries.
+func (a *APIStore) enqueueVolumePreparation(ctx context.Context, volume queries.Volume) error {
+	err := a.jobs.Enqueue(ctx, prepareVolumeJob, volumePreparation{VolumeID: volume.ID})
+	if err != nil {
+		a.failVolumeCreation(context.WithoutCancel(ctx), volume)
+
+		return fmt.Errorf("enqueue volume preparation: %w", err)
+	}
+
+	return nil
+}
+
+// runVolumePreparation prepares the volume of the job. Failed runs are retried, the volume is failed
+// once the last attempt failed.
+func (a *APIStore) runVolumePreparation(ctx context.Context, job jobs.Job) error {
+	var payload volumePreparation
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+
+	volume, err := a.sqlcDB.GetVolume(ctx, payload.VolumeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	// Retried creations enqueue another preparation, only one of them finds the volume creating
+	if volume.Status != "creating" {
+		return nil
+	}
+
+	_, err = a.prepareVolume(ctx, volume, job.Attempt >= job.MaxAttempts)
+	if errors.Is(err, errVolumeNotCreating) {
+		return nil
+	}
+
+	return err
+}
+
+// prepareVolume creates the team bucket and the bucket paths of the creating volume, then makes it
+// available and emits the volume.created event. The volume is failed when the preparation fails and fail is set.
+func (a *APIStore) prepareVolume(ctx context.Context, volume queries.Volume, fail bool) (queries.Volume, error) {
+	if err := a.formatVolume(ctx, volume); err != nil {
+		if fail {
+			a.failVolumeCreation(context.WithoutCancel(ctx), volume)
+		}
+
+		return queries.Volume{}, err
+	}
+
+	prepared, err := a.sqlcDB.FinishVolumeCreation(ctx, queries.FinishVolumeCreationParams{
+		ID:     volume.ID,
+		Status: "available",
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return queries.Volume{}, errVolumeNotCreating
+	}
+	if err != nil {
+		return queries.Volume{}, fmt.Errorf("update volume status: %w", err)
+	}
+
+	// Emit volume.created event
+	if a.volEventsDelivery != nil {
+		event := events.NewVolumeEvent(events.VolumeCreatedEvent, prepared.ID).
+			WithVolumeName(prepared.Name)
+		event.SandboxTeamID = prepared.TeamID
+
+		go func() {
+			if err := a.volEventsDelivery.Publish(context.WithoutCancel(ctx), events.DeliveryKey(prepared.TeamID), event); err != nil {
+				logger.L().Error(ctx, "Failed to publish volume.created event", zap.Error(err), zap.String("volume_id", prepared.ID))
+			}
+		}()
+	}
+	logger.L().Info(ctx, "Volume created",
+		zap.String("volume_id", prepared.ID),
+		zap.String("volume_name", prepared.Name),
+		zap.String("team_id", prepared.TeamID.String()),
+		zap.String("metadata_engine", prepared.MetadataEngine),
+	)
+
+	return prepared, nil
+}
+
+// formatVolume creates the team bucket of the volume and its bucket paths. JuiceFS metadata is
+// formatted by envd during the first mount.
+func (a *APIStore) formatVolume(ctx context.Context, volume queries.Volume) error {
+	bucket := volumeBucket(volume)
+	if bucket != "" {
+		if err := juicefs.EnsureBucket(ctx, bucket, consts.GCPProject, consts.GCPRegion); err != nil {
+			return fmt.Errorf("ensure team bucket: %w", err)
+		}
+	}
+
+	// Deployments without a volumes bucket keep the volumes elsewhere
+	bucket = cmp.Or(bucket, a.volumesBucket)
+	if bucket == "" {
+		return nil
+	}
+
+	return juicefs.FormatVolume(ctx, juicefs.FormatConfig{
+		VolumeID:   volume.ID,
+		MetaEngine: storedMetaEngine(volume),
+		RedisDB:    sharedUtils.DerefOrDefault(volume.RedisDb, 0),
+		PoolConfig: juicefs.Config{GCSBucket: bucket},
+	})
+}
+
+// failVolumeCreation fails the creating volume, unless it was deleted meanwhile.
+func (a *APIStore) failVolumeCreation(ctx context.Context, volume queries.Volume) {
+	_, err := a.sqlcDB.FinishVolumeCreation(ctx, queries.FinishVolumeCreationParams{
+		ID:     volume.ID,
+		Status: "failed",
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		logger.L().Error(ctx, "Failed to mark volume creation as failed", zap.Error(err), zap.String("volume_id", volume.ID))
+
+		return
+	}
+
+	logger.L().Warn(ctx, "Volume creation failed", zap.String("volume_id", volume.ID))
+}
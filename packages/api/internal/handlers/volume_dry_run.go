@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// dryRunCreateVolume runs the checks of PostVolumes without creating anything and reports their results.
+func (a *APIStore) dryRunCreateVolume(ctx context.Context, team *types.Team, req api.CreateVolumeRequest) api.VolumeCreateDryRun {
+	report := api.VolumeCreateDryRun{Name: req.Name}
+	add := func(check api.VolumeCreateCheckCheck, status api.VolumeCreateCheckStatus, msg string) {
+		report.Checks = append(report.Checks, api.VolumeCreateCheck{Check: check, Status: status, Message: msg})
+	}
+
+	// An existing volume is returned instead of creating one, so the capacity checks don't apply
+	nameValid := volumeNamePattern.MatchString(req.Name)
+	pendingDelete := false
+	var lookupErr error
+	if nameValid {
+		existing, err := a.sqlcDB.GetVolumeByName(ctx, queries.GetVolumeByNameParams{
+			TeamID: team.ID,
+			Name:   req.Name,
+		})
+		if err == nil {
+			volume := volumeToAPI(existing)
+			report.ExistingVolume = &volume
+			pendingDelete = existing.Status == "pending_delete"
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			lookupErr = err
+		}
+	}
+
+	switch {
+	case !nameValid:
+		add(api.Name, api.VolumeCreateCheckStatusFailed, "name must be lowercase alphanumeric with hyphens (1-63 chars)")
+	case pendingDelete:
+		add(api.Name, api.VolumeCreateCheckStatusFailed, "A volume with this name is pending deletion, restore it or delete it permanently first")
+	default:
+		add(api.Name, api.VolumeCreateCheckStatusPassed, "Name is valid")
+	}
+
+	if req.SizeLimitBytes != nil && *req.SizeLimitBytes <= 0 {
+		add(api.SizeLimit, api.VolumeCreateCheckStatusFailed, "sizeLimitBytes must be positive")
+	} else {
+		add(api.SizeLimit, api.VolumeCreateCheckStatusPassed, "Size limit is valid")
+	}
+
+	if errMsg := ValidateVolumeMountOptions(req.MountOptions, true); errMsg != "" {
+		add(api.MountOptions, api.VolumeCreateCheckStatusFailed, errMsg)
+	} else {
+		add(api.MountOptions, api.VolumeCreateCheckStatusPassed, "Mount options are valid")
+	}
+
+	switch {
+	case lookupErr != nil:
+		add(api.Quota, api.VolumeCreateCheckStatusFailed, "Failed to check existing volume")
+	case pendingDelete:
+		add(api.Quota, api.VolumeCreateCheckStatusSkipped, "A volume with this name is pending deletion")
+	case report.ExistingVolume != nil:
+		add(api.Quota, api.VolumeCreateCheckStatusSkipped, "A volume with this name exists and is returned instead")
+	case team.Limits.MaxStorageBytes <= 0:
+		add(api.Quota, api.VolumeCreateCheckStatusPassed, "The team has no storage limit")
+	default:
+		if apiErr := a.checkTeamStorageAvailable(ctx, team); apiErr != nil {
+			add(api.Quota, api.VolumeCreateCheckStatusFailed, apiErr.ClientMsg)
+		} else {
+			add(api.Quota, api.VolumeCreateCheckStatusPassed, fmt.Sprintf("The team is below its storage limit of %d bytes", team.Limits.MaxStorageBytes))
+		}
+	}
+
+	// Only the volumes with Redis metadata take a database of the volumes Redis
+	if metaEngine, apiErr := a.volumeMetaEngine(req.MetadataEngine); apiErr != nil {
+		add(api.RedisDb, api.VolumeCreateCheckStatusFailed, apiErr.ClientMsg)
+	} else if metaEngine == volumestorage.MetaEngineRedis {
+		add(api.RedisDb, api.VolumeCreateCheckStatusPassed, "A database of the volumes Redis is allocated for the volume metadata")
+	} else {
+		add(api.RedisDb, api.VolumeCreateCheckStatusSkipped, "SQLite metadata is replicated to the bucket, it doesn't use a Redis database")
+	}
+
+	var err error
+	bucket := a.volumesBucket
+	bucketExists := true
+	if bucket != "" && a.config.VolumesTeamBucketPrefix != "" {
+		bucket = juicefs.TeamBucketName(a.config.VolumesTeamBucketPrefix, team.ID)
+		bucketExists, err = juicefs.BucketExists(ctx, bucket)
+	}
+
+	switch {
+	case bucket == "":
+		add(api.Bucket, api.VolumeCreateCheckStatusSkipped, "No volumes bucket is configured")
+	case err != nil:
+		add(api.Bucket, api.VolumeCreateCheckStatusFailed, "Volumes bucket is not reachable: "+err.Error())
+	case !bucketExists:
+		add(api.Bucket, api.VolumeCreateCheckStatusPassed, fmt.Sprintf("Team bucket %s is created with the first volume", bucket))
+	default:
+		if err := juicefs.CheckBucket(ctx, bucket); err != nil {
+			add(api.Bucket, api.VolumeCreateCheckStatusFailed, "Volumes bucket is not reachable: "+err.Error())
+		} else {
+			add(api.Bucket, api.VolumeCreateCheckStatusPassed, "Volumes bucket is reachable")
+		}
+	}
+
+	report.Ok = true
+	for _, check := range report.Checks {
+		if check.Status == api.VolumeCreateCheckStatusFailed {
+			report.Ok = false
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+const (
+	// deleteEphemeralVolumesJob is the background job deleting the scratch volumes of a killed sandbox.
+	deleteEphemeralVolumesJob = "volumes.delete-ephemeral"
+
+	// ephemeralVolumeNamePrefix names the scratch volumes after their sandbox.
+	ephemeralVolumeNamePrefix = "ephemeral-"
+
+	// ephemeralVolumeDeleteDelay leaves the killed sandbox the time to unmount its scratch volume
+	// before the volume is destroyed.
+	ephemeralVolumeDeleteDelay = time.Minute
+)
+
+// ephemeralVolumesDeletion is the payload of the job deleting the scratch volumes of a sandbox.
+type ephemeralVolumesDeletion struct {
+	SandboxID string `json:"sandboxId"`
+}
+
+// createEphemeralVolume creates the scratch volume of the sandbox, prepared in the request.
+// The volume is deleted when the sandbox is killed.
+func (a *APIStore) createEphemeralVolume(ctx context.Context, team *types.Team, sandboxID string, req api.EphemeralVolume) (queries.Volume, *api.APIError) {
+	if apiErr := a.checkTeamStorageAvailable(ctx, team); apiErr != nil {
+		return queries.Volume{}, apiErr
+	}
+
+	volume, err := a.insertVolume(ctx, team.ID, ephemeralVolumeNamePrefix+sandboxID, req.SizeLimitBytes, false, volumestorage.MetaEngineSQLite, nil, &sandboxID)
+	if err != nil {
+		return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to create ephemeral volume", Err: err}
+	}
+
+	volume, err = a.prepareVolume(ctx, volume, true)
+	if err != nil {
+		// The sandbox won't be killed, the failed volume is deleted right away
+		a.deleteEphemeralVolumesLater(context.WithoutCancel(ctx), sandboxID, 0)
+
+		return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to create ephemeral volume", Err: err}
+	}
+
+	return volume, nil
+}
+
+// deleteEphemeralVolumesLater deletes the scratch volumes of the sandbox in the background after the delay.
+// Failing to enqueue the deletion is only logged, the volumes stay with the team until deleted.
+func (a *APIStore) deleteEphemeralVolumesLater(ctx context.Context, sandboxID string, delay time.Duration) {
+	if err := a.enqueueEphemeralVolumesDeletion(ctx, sandboxID, delay); err != nil {
+		logger.L().Error(ctx, "Failed to enqueue the deletion of the ephemeral volumes", zap.Error(err), logger.WithSandboxID(sandboxID))
+	}
+}
+
+// enqueueEphemeralVolumesDeletion deletes the scratch volumes of the sandbox in the background after
+// the delay. Nothing is enqueued when the sandbox has no scratch volume.
+func (a *APIStore) enqueueEphemeralVolumesDeletion(ctx context.Context, sandboxID string, delay time.Duration) error {
+	volumes, err := a.sqlcDB.GetSandboxEphemeralVolumes(ctx, &sandboxID)
+	if err != nil {
+		return fmt.Errorf("failed to get ephemeral volumes: %w", err)
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	return a.jobs.Enqueue(ctx, deleteEphemeralVolumesJob, ephemeralVolumesDeletion{SandboxID: sandboxID},
+		jobs.WithUniqueKey(sandboxID),
+		jobs.WithRunAfter(time.Now().Add(delay)),
+	)
+}
+
+// cleanupEphemeralVolumes deletes the scratch volumes of the killed sandbox, once it had the time to unmount them.
+func (a *APIStore) cleanupEphemeralVolumes(ctx context.Context, sandboxID string) error {
+	return a.enqueueEphemeralVolumesDeletion(ctx, sandboxID, ephemeralVolumeDeleteDelay)
+}
+
+// deleteEphemeralVolumes destroys the scratch volumes of the sandbox of the job, without a grace period.
+func (a *APIStore) deleteEphemeralVolumes(ctx context.Context, job jobs.Job) error {
+	var payload ephemeralVolumesDeletion
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+
+	volumes, err := a.sqlcDB.GetSandboxEphemeralVolumes(ctx, &payload.SandboxID)
+	if err != nil {
+		return fmt.Errorf("failed to get ephemeral volumes: %w", err)
+	}
+
+	var errs []error
+	for _, volume := range volumes {
+		if volume.Status != "deleting" {
+			deleting, err := a.sqlcDB.UpdateVolumeStatus(ctx, queries.UpdateVolumeStatusParams{
+				ID:     volume.ID,
+				Status: "deleting",
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to update status of volume %s: %w", volume.ID, err))
+
+				continue
+			}
+			volume = deleting
+		}
+
+		if err := a.destroyVolume(ctx, volume); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+)
+
+// PatchVolumesVolumeIDFilesAttributes changes the permission bits and the owner of a path in a volume.
+func (a *APIStore) PatchVolumesVolumeIDFilesAttributes(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.FileAttributesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	attrs, err := fileAttributes(req.Mode, req.Uid, req.Gid)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if attrs.IsZero() {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "At least one of mode, uid and gid must be set")
+		return
+	}
+
+	// Normalize path
+	path := filepath.Clean(req.Path)
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	recursive := req.Recursive != nil && *req.Recursive
+
+	result, err := client.SetAttributes(ctx, path, attrs, recursive)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrNotFound) {
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to set attributes: "+err.Error())
+		return
+	}
+
+	stat, err := client.Stat(ctx, path, false)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to stat path: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, api.FileAttributesResponse{
+		Entries: result.Entries,
+		File:    fileStatToAPI(stat),
+	})
+}
+
+// fileAttributes returns the attributes to set from the octal permission bits and the owner IDs of a request.
+func fileAttributes(mode *string, uid, gid *int64) (juicefs.Attributes, error) {
+	var attrs juicefs.Attributes
+
+	if mode != nil {
+		bits, err := strconv.ParseUint(*mode, 8, 16)
+		if err != nil || bits > 0o7777 {
+			return juicefs.Attributes{}, fmt.Errorf("invalid mode %q, expected octal permission bits like 0644", *mode)
+		}
+		attrs.Mode = ptr(uint16(bits))
+	}
+
+	var err error
+	if attrs.UID, err = ownerID("uid", uid); err != nil {
+		return juicefs.Attributes{}, err
+	}
+	if attrs.GID, err = ownerID("gid", gid); err != nil {
+		return juicefs.Attributes{}, err
+	}
+
+	return attrs, nil
+}
+
+// ownerID returns the user or group ID of an owner, nil when it's not set.
+func ownerID(name string, value *int64) (*uint32, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	// The largest ID is reserved for leaving the owner unchanged
+	if *value < 0 || *value >= math.MaxUint32 {
+		return nil, fmt.Errorf("invalid %s %d", name, *value)
+	}
+
+	return ptr(uint32(*value)), nil
+}
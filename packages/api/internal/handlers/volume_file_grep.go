@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+)
+
+const (
+	// defaultGrepMaxMatches is the number of matching lines returned when the request sets no maximum
+	defaultGrepMaxMatches = 100
+	// grepMaxFiles is how many files a single search reads at most
+	grepMaxFiles = 10000
+	// grepMaxFileSize skips larger files, they are rarely source code and would dominate the search
+	grepMaxFileSize = 10 << 20 // 10 MiB
+)
+
+// GetVolumesVolumeIDFilesGrep searches the file contents of a volume for a regular expression.
+func (a *APIStore) GetVolumesVolumeIDFilesGrep(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDFilesGrepParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// Get path parameter
+	searchPath := "/"
+	if params.Path != nil {
+		searchPath = *params.Path
+	}
+
+	// Validate path
+	if !strings.HasPrefix(searchPath, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	searchPath = filepath.Clean(searchPath)
+
+	query, err := grepQuery(params)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	result, err := client.Grep(ctx, searchPath, query)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrNotFound) {
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to search files: "+err.Error())
+		return
+	}
+
+	matches := make([]api.FileGrepMatch, 0, len(result.Matches))
+	for _, match := range result.Matches {
+		matches = append(matches, api.FileGrepMatch{
+			Path: match.Path,
+			Line: int64(match.Line),
+			Text: match.Text,
+		})
+	}
+
+	c.JSON(http.StatusOK, api.FileGrepResponse{
+		Matches:       matches,
+		FilesSearched: result.FilesSearched,
+		FilesSkipped:  result.FilesSkipped,
+		Truncated:     result.Truncated,
+	})
+}
+
+// grepQuery validates the pattern and the filters of a content search.
+func grepQuery(params api.GetVolumesVolumeIDFilesGrepParams) (juicefs.GrepQuery, error) {
+	query := juicefs.GrepQuery{
+		MaxMatches:  defaultGrepMaxMatches,
+		MaxFiles:    grepMaxFiles,
+		MaxFileSize: grepMaxFileSize,
+	}
+
+	expr := params.Pattern
+	if params.IgnoreCase != nil && *params.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return query, fmt.Errorf("invalid pattern: %w", err)
+	}
+	query.Pattern = pattern
+
+	if params.Glob != nil {
+		if _, err := path.Match(*params.Glob, ""); err != nil {
+			return query, fmt.Errorf("invalid glob pattern %q", *params.Glob)
+		}
+		query.Glob = *params.Glob
+	}
+
+	if params.MaxMatches != nil {
+		query.MaxMatches = int(*params.MaxMatches)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+)
+
+// PostVolumesVolumeIDFilesSymlink creates a symlink in a volume.
+func (a *APIStore) PostVolumesVolumeIDFilesSymlink(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.FileSymlinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path, the target is stored as given
+	linkPath := filepath.Clean(req.Path)
+	if linkPath == "/" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path can't be the volume root")
+		return
+	}
+
+	if req.Target == "" || len(req.Target) > juicefs.MaxSymlinkTarget || strings.ContainsRune(req.Target, 0) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Target must be a path of at most 4096 bytes")
+		return
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	overwrite := req.Overwrite != nil && *req.Overwrite
+
+	info, err := client.Symlink(ctx, linkPath, req.Target, overwrite)
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrExists):
+			a.sendFileError(c, http.StatusConflict, err, "Path already exists")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusConflict, err, "A parent of the path is not a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create symlink: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.FileInfo{
+		Name:       info.Name,
+		Path:       info.Path,
+		Type:       api.FileInfoTypeSymlink,
+		ModifiedAt: ptr(info.ModifiedAt),
+		LinkTarget: ptr(info.LinkTarget),
+	})
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+)
+
+// GetVolumesVolumeIDFilesSearch searches a directory tree of a volume.
+func (a *APIStore) GetVolumesVolumeIDFilesSearch(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDFilesSearchParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// Get path parameter
+	dirPath := "/"
+	if params.Path != nil {
+		dirPath = *params.Path
+	}
+
+	// Validate path
+	if !strings.HasPrefix(dirPath, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	dirPath = filepath.Clean(dirPath)
+
+	query, err := searchQuery(params)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Parse pagination parameters
+	limit := defaultFileListLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = min(int(*params.Limit), maxFileListLimit)
+	}
+
+	offset := 0
+	if params.NextToken != nil && *params.NextToken != "" {
+		decodedOffset, err := decodeNextToken(*params.NextToken)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+			return
+		}
+		offset = decodedOffset
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	result, err := client.Search(ctx, dirPath, query, limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusBadRequest, err, "Path is not a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to search files: "+err.Error())
+		}
+		return
+	}
+
+	apiFiles := make([]api.FileInfo, 0, len(result.Files))
+	for _, f := range result.Files {
+		apiFile := api.FileInfo{
+			Name:       f.Name,
+			Path:       f.Path,
+			Type:       api.FileInfoType(f.Type),
+			ModifiedAt: ptr(f.ModifiedAt),
+		}
+		if f.Type == "file" {
+			apiFile.Size = ptr(f.Size)
+		}
+		if f.LinkTarget != "" {
+			apiFile.LinkTarget = ptr(f.LinkTarget)
+		}
+		apiFiles = append(apiFiles, apiFile)
+	}
+
+	response := api.FileListResponse{
+		Files: apiFiles,
+	}
+
+	// Generate next token if there are more matches
+	if result.HasMore {
+		nextToken := encodeNextToken(offset + limit)
+		response.NextToken = &nextToken
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// searchQuery validates the filters of a search.
+func searchQuery(params api.GetVolumesVolumeIDFilesSearchParams) (juicefs.SearchQuery, error) {
+	query := juicefs.SearchQuery{
+		MinSize:        params.MinSize,
+		MaxSize:        params.MaxSize,
+		ModifiedAfter:  params.ModifiedAfter,
+		ModifiedBefore: params.ModifiedBefore,
+	}
+
+	if params.Name != nil {
+		query.Name = *params.Name
+	}
+
+	if params.Glob != nil {
+		if _, err := path.Match(*params.Glob, ""); err != nil {
+			return query, fmt.Errorf("invalid glob pattern %q", *params.Glob)
+		}
+		query.Glob = *params.Glob
+	}
+
+	if query.MinSize != nil && query.MaxSize != nil && *query.MinSize > *query.MaxSize {
+		return query, errors.New("minSize must not be greater than maxSize")
+	}
+
+	if query.ModifiedAfter != nil && query.ModifiedBefore != nil && !query.ModifiedAfter.Before(*query.ModifiedBefore) {
+		return query, errors.New("modifiedAfter must be before modifiedBefore")
+	}
+
+	return query, nil
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+)
+
+// syncMaxFiles bounds the manifest of a sync, larger directories are synced in parts
+const syncMaxFiles = 100000
+
+// PostVolumesVolumeIDFilesSync compares the manifest of a local directory with a directory of a volume.
+func (a *APIStore) PostVolumesVolumeIDFilesSync(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.FileSyncRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	dirPath := filepath.Clean(req.Path)
+
+	manifest, err := syncManifest(req.Files)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deleteExtra := req.Delete != nil && *req.Delete
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	// Only deleting writes to the volume
+	if deleteExtra {
+		writeCtx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+		if !ok {
+			return
+		}
+		defer finishWrite()
+
+		ctx = writeCtx
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	diff, err := client.DiffSync(ctx, dirPath, manifest, deleteExtra)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrNotDirectory) {
+			a.sendFileError(c, http.StatusConflict, err, "Path is not a directory")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to compare files: "+err.Error())
+		return
+	}
+
+	if deleteExtra && len(diff.Extra) > 0 {
+		// The directory is audited once, the events tell the deleted files
+		a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileDelete, VolumeID: volume.ID, Path: dirPath})
+
+		deleted := make([]string, len(diff.Extra))
+		for i, filePath := range diff.Extra {
+			deleted[i] = path.Join(dirPath, filePath)
+		}
+		a.publishFileEvents(ctx, volume, events.VolumeFileDeletedEvent, map[string]any{"sync": true}, deleted...)
+	}
+
+	c.JSON(http.StatusOK, api.FileSyncResponse{
+		Upload:    diff.Upload,
+		Extra:     diff.Extra,
+		Unchanged: diff.Unchanged,
+	})
+}
+
+// syncManifest validates the files of a sync manifest. Paths must be clean, relative and listed once.
+func syncManifest(files []api.FileSyncEntry) ([]juicefs.SyncEntry, error) {
+	if len(files) > syncMaxFiles {
+		return nil, fmt.Errorf("the manifest lists more than %d files, sync the directory in parts", syncMaxFiles)
+	}
+
+	manifest := make([]juicefs.SyncEntry, 0, len(files))
+	seen := make(map[string]bool, len(files))
+	for _, file := range files {
+		if file.Path == "" || file.Path == "." || path.IsAbs(file.Path) || path.Clean(file.Path) != file.Path ||
+			file.Path == ".." || strings.HasPrefix(file.Path, "../") || strings.ContainsRune(file.Path, 0) {
+			return nil, fmt.Errorf("invalid manifest path %q, paths must be clean and relative to the directory", file.Path)
+		}
+
+		if seen[file.Path] {
+			return nil, fmt.Errorf("the manifest lists %q more than once", file.Path)
+		}
+		seen[file.Path] = true
+
+		if file.Size < 0 {
+			return nil, fmt.Errorf("invalid size of %q", file.Path)
+		}
+
+		if checksum, err := hex.DecodeString(file.Checksum); err != nil || len(checksum) != 32 {
+			return nil, fmt.Errorf("invalid checksum of %q, expected a hex encoded SHA-256", file.Path)
+		}
+
+		manifest = append(manifest, juicefs.SyncEntry{
+			Path:     file.Path,
+			Size:     file.Size,
+			Checksum: file.Checksum,
+		})
+	}
+
+	return manifest, nil
+}
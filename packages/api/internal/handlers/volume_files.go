@@ -2,11 +2,16 @@ package handlers
 
 import (
 	"context"
+	"crypto/md5" //nolint:gosec // Content-MD5 is an integrity check, not a security measure
+	"crypto/sha256"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	iofs "io/fs"
+	"mime"
 	"net/http"
 	"path/filepath"
 	"strconv"
@@ -15,10 +20,17 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	volumeleases "github.com/moru-ai/sandbox-infra/packages/api/internal/volume-leases"
 	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
 )
 
 const (
@@ -28,6 +40,13 @@ const (
 	maxFileListLimit = 1000
 )
 
+var (
+	// errVolumeBeingModified is returned when another request holds the write lease of the volume.
+	errVolumeBeingModified = errors.New("volume is being modified by another request")
+	// errVolumeAttached is returned when a sandbox has the volume attached.
+	errVolumeAttached = errors.New("volume is attached to a sandbox")
+)
+
 // GetVolumesVolumeIDFiles lists files in a volume.
 func (a *APIStore) GetVolumesVolumeIDFiles(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDFilesParams) {
 	ctx := c.Request.Context()
@@ -91,25 +110,28 @@ func (a *APIStore) GetVolumesVolumeIDFiles(c *gin.Context, volumeID string, para
 
 	// Get JuiceFS client for this volume
 	// Note: redisDB parameter is deprecated, passing 0 (code won't reach here due to nil check above)
-	client, err := a.juicefsPool.Get(ctx, volume.ID, 0)
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
 	if err != nil {
-		// Handle fresh volumes that haven't been mounted yet
-		if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
-			a.sendAPIStoreError(c, http.StatusPreconditionFailed, "Volume not initialized - mount to a sandbox first")
-			return
-		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to connect to volume: "+err.Error())
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, path); err != nil {
+		a.sendVolumeScopeError(c, err)
 		return
 	}
 
 	// List directory with pagination
 	result, err := client.ListDir(ctx, path, limit, offset)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			a.sendAPIStoreError(c, http.StatusNotFound, "Path not found")
-			return
+		switch {
+		case errors.Is(err, juicefs.ErrNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusBadRequest, err, "Path must be a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list files: "+err.Error())
 		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list files: "+err.Error())
 		return
 	}
 
@@ -125,6 +147,9 @@ func (a *APIStore) GetVolumesVolumeIDFiles(c *gin.Context, volumeID string, para
 		if f.Type == "file" {
 			apiFile.Size = ptr(f.Size)
 		}
+		if f.LinkTarget != "" {
+			apiFile.LinkTarget = ptr(f.LinkTarget)
+		}
 		apiFiles = append(apiFiles, apiFile)
 	}
 
@@ -180,41 +205,185 @@ func (a *APIStore) GetVolumesVolumeIDFilesDownload(c *gin.Context, volumeID stri
 
 	// Get JuiceFS client for this volume
 	// Note: redisDB parameter is deprecated, passing 0 (code won't reach here due to nil check above)
-	client, err := a.juicefsPool.Get(ctx, volume.ID, 0)
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
 	if err != nil {
-		// Handle fresh volumes that haven't been mounted yet
-		if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
-			a.sendAPIStoreError(c, http.StatusPreconditionFailed, "Volume not initialized - mount to a sandbox first")
-			return
-		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to connect to volume: "+err.Error())
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, path); err != nil {
+		a.sendVolumeScopeError(c, err)
 		return
 	}
 
 	// Download file
 	reader, size, err := client.Download(ctx, path)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			a.sendAPIStoreError(c, http.StatusNotFound, "File not found")
-			return
+		switch {
+		case errors.Is(err, juicefs.ErrDanglingSymlink):
+			a.sendFileError(c, http.StatusNotFound, err, err.Error())
+		case errors.Is(err, juicefs.ErrNotFound), errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusNotFound, err, "File not found")
+		case errors.Is(err, juicefs.ErrIsDirectory):
+			a.sendFileError(c, http.StatusBadRequest, err, "Path is a directory, download it as an archive")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to download file: "+err.Error())
 		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to download file: "+err.Error())
 		return
 	}
 	defer reader.Close()
 
-	// Set response headers
-	c.Header("Content-Type", "application/octet-stream")
+	contentType, err := client.ContentType(ctx, path)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the content type: "+err.Error())
+		return
+	}
+
+	// Set response headers, browsers must not guess another type than the stored one
+	c.Header("Content-Type", contentType)
+	c.Header("X-Content-Type-Options", "nosniff")
 	c.Header("Content-Length", strconv.FormatInt(size, 10))
 	c.Header("Content-Disposition", "attachment; filename=\""+filepath.Base(path)+"\"")
 
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileDownload, VolumeID: volume.ID, Path: path})
+
 	// Stream content
 	c.Status(http.StatusOK)
 	_, _ = io.Copy(c.Writer, reader)
 }
 
-// PutVolumesVolumeIDFilesUpload streams file content to a volume.
-func (a *APIStore) PutVolumesVolumeIDFilesUpload(c *gin.Context, volumeID string, params api.PutVolumesVolumeIDFilesUploadParams) {
+// HeadVolumesVolumeIDFilesDownload returns the headers of a file download without the content.
+func (a *APIStore) HeadVolumesVolumeIDFilesDownload(c *gin.Context, volumeID string, params api.HeadVolumesVolumeIDFilesDownloadParams) {
+	stat, ok := a.statVolumeFile(c, volumeID, params.Path, false)
+	if !ok {
+		return
+	}
+
+	if stat.Type == "directory" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path is a directory")
+		return
+	}
+
+	c.Header("Content-Type", stat.ContentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Length", strconv.FormatInt(stat.Size, 10))
+	c.Header("Content-Disposition", "attachment; filename=\""+filepath.Base(stat.Path)+"\"")
+	c.Header("Last-Modified", stat.ModifiedAt.UTC().Format(http.TimeFormat))
+	c.Status(http.StatusOK)
+}
+
+// GetVolumesVolumeIDFilesStat returns the metadata of a single path in a volume.
+func (a *APIStore) GetVolumesVolumeIDFilesStat(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDFilesStatParams) {
+	checksum := params.Checksum != nil && *params.Checksum
+
+	stat, ok := a.statVolumeFile(c, volumeID, params.Path, checksum)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, fileStatToAPI(stat))
+}
+
+// fileStatToAPI converts the metadata of a volume entry to its API representation.
+func fileStatToAPI(stat *juicefs.FileStat) api.FileStat {
+	result := api.FileStat{
+		Name:       stat.Name,
+		Path:       stat.Path,
+		Type:       api.FileStatType(stat.Type),
+		Size:       stat.Size,
+		ModifiedAt: stat.ModifiedAt,
+		Mode:       fmt.Sprintf("%04o", fileModeBits(stat.Mode)),
+		Uid:        int64(stat.UID),
+		Gid:        int64(stat.GID),
+	}
+	if stat.LinkTarget != "" {
+		result.LinkTarget = &stat.LinkTarget
+	}
+	if stat.Checksum != "" {
+		result.Checksum = &stat.Checksum
+	}
+	if stat.ContentType != "" {
+		result.ContentType = &stat.ContentType
+	}
+
+	return result
+}
+
+// statVolumeFile resolves the volume and returns the metadata of the path, sending the error response on failure.
+func (a *APIStore) statVolumeFile(c *gin.Context, volumeID, filePath string, checksum bool) (*juicefs.FileStat, bool) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return nil, false
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return nil, false
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return nil, false
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return nil, false
+	}
+
+	// Validate path
+	if !strings.HasPrefix(filePath, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return nil, false
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return nil, false
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, filePath); err != nil {
+		a.sendVolumeScopeError(c, err)
+		return nil, false
+	}
+
+	stat, err := client.Stat(ctx, filepath.Clean(filePath), checksum)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrNotFound) {
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+			return nil, false
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to stat path: "+err.Error())
+		return nil, false
+	}
+
+	return stat, true
+}
+
+// fileModeBits converts the permission bits of a file mode to their Unix representation.
+func fileModeBits(mode iofs.FileMode) uint32 {
+	bits := uint32(mode.Perm())
+	if mode&iofs.ModeSetuid != 0 {
+		bits |= 0o4000
+	}
+	if mode&iofs.ModeSetgid != 0 {
+		bits |= 0o2000
+	}
+	if mode&iofs.ModeSticky != 0 {
+		bits |= 0o1000
+	}
+
+	return bits
+}
+
+// GetVolumesVolumeIDFilesArchive streams an archive of a directory tree from a volume.
+func (a *APIStore) GetVolumesVolumeIDFilesArchive(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDFilesArchiveParams) {
 	ctx := c.Request.Context()
 
 	team, apiErr := a.GetTeam(ctx, c, nil)
@@ -240,36 +409,162 @@ func (a *APIStore) PutVolumesVolumeIDFilesUpload(c *gin.Context, volumeID string
 		return
 	}
 
-	// Check if volume is attached to a running sandbox (write conflict)
-	isAttached, err := a.sqlcDB.IsVolumeAttached(ctx, &volume.ID)
+	// Validate path
+	if !strings.HasPrefix(params.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	path := filepath.Clean(params.Path)
+
+	format := juicefs.ArchiveTarGz
+	if params.Format != nil {
+		format = juicefs.ArchiveFormat(*params.Format)
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to check volume status")
+		a.sendVolumeClientError(c, err)
 		return
 	}
-	if isAttached {
-		a.sendAPIStoreError(c, http.StatusConflict, "Cannot modify volume while attached to sandbox")
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, path); err != nil {
+		a.sendVolumeScopeError(c, err)
 		return
 	}
 
+	// Check the directory before the response starts, errors can't be reported once the archive streams
+	if _, err := client.ListDir(ctx, path, 1, 0); err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "Path not found")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusBadRequest, err, "Path must be a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to read directory: "+err.Error())
+		}
+		return
+	}
+
+	name := filepath.Base(path)
+	if path == "/" {
+		name = volume.Name
+	}
+
+	// Set response headers, the size is unknown until the archive is complete
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", "attachment; filename=\""+name+"."+string(format)+"\"")
+
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileDownload, VolumeID: volume.ID, Path: path})
+
+	// Stream content
+	c.Status(http.StatusOK)
+	if err := client.WriteArchive(ctx, path, format, c.Writer); err != nil {
+		// The status is already sent, abort so the client sees a truncated response
+		logger.L().Warn(ctx, "Failed to stream volume archive",
+			zap.Error(err),
+			zap.String("volume_id", volume.ID),
+			zap.String("path", path))
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
+// PutVolumesVolumeIDFilesUpload streams file content to a volume.
+func (a *APIStore) PutVolumesVolumeIDFilesUpload(c *gin.Context, volumeID string, params api.PutVolumesVolumeIDFilesUploadParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
 	// Validate path
 	if !strings.HasPrefix(params.Path, "/") {
 		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
 		return
 	}
 
+	expected, err := parseUploadChecksums(params)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	extract := params.Extract != nil && *params.Extract
+
+	// The entries of an archive could be written through the symlinks of the volume, out of the subpath
+	if scope := requestVolumeScope(c); extract && scope != nil && scope.Subpath != "" {
+		a.sendAPIStoreError(c, http.StatusForbidden, "Archives can't be extracted with credentials restricted to a subpath")
+		return
+	}
+
+	if extract && !expected.IsZero() {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Checksum headers are not supported when extracting")
+		return
+	}
+
+	contentType, err := uploadContentType(params.ContentType)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	attrs, err := fileAttributes(params.Mode, params.Uid, params.Gid)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if extract && !attrs.IsZero() {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Mode and owner are not supported when extracting")
+		return
+	}
+
 	// Normalize path
 	path := filepath.Clean(params.Path)
 
 	// Get JuiceFS client for this volume
 	// Note: redisDB parameter is deprecated, passing 0 (code won't reach here due to nil check above)
-	client, err := a.juicefsPool.Get(ctx, volume.ID, 0)
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
 	if err != nil {
-		// Handle fresh volumes that haven't been mounted yet
-		if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
-			a.sendAPIStoreError(c, http.StatusPreconditionFailed, "Volume not initialized - mount to a sandbox first")
-			return
-		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to connect to volume: "+err.Error())
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, path); err != nil {
+		a.sendVolumeScopeError(c, err)
+		return
+	}
+
+	// Reject uploads declaring a larger body before reading it, chunked uploads are limited while streaming
+	maxUpload := a.config.VolumesMaxUploadBytes
+	if maxUpload > 0 && c.Request.ContentLength > maxUpload {
+		a.sendFileError(c, http.StatusRequestEntityTooLarge, juicefs.ErrUploadTooLarge, uploadTooLargeMsg(maxUpload))
 		return
 	}
 
@@ -280,21 +575,157 @@ func (a *APIStore) PutVolumesVolumeIDFilesUpload(c *gin.Context, volumeID string
 		body = strings.NewReader("")
 	}
 
+	if extract {
+		if maxUpload > 0 && c.Request.Body != nil {
+			body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUpload)
+		}
+		a.extractArchive(c, client, volume, path, body)
+		return
+	}
+
+	sizeLimit, limitMsg, apiErr := a.volumeWriteLimit(ctx, team, volume)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
 	// Upload file
-	written, err := client.Upload(ctx, path, body)
+	written, checksums, err := client.Upload(ctx, path, body, juicefs.UploadOptions{
+		Checksums:   expected,
+		SizeLimit:   sizeLimit,
+		MaxSize:     maxUpload,
+		ContentType: contentType,
+		Attributes:  attrs,
+	})
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to upload file: "+err.Error())
+		switch {
+		case errors.Is(err, juicefs.ErrChecksumMismatch):
+			a.sendFileError(c, http.StatusBadRequest, err, err.Error())
+		case errors.Is(err, juicefs.ErrQuotaExceeded):
+			a.sendFileError(c, http.StatusRequestEntityTooLarge, err, limitMsg)
+		case errors.Is(err, juicefs.ErrUploadTooLarge):
+			a.sendFileError(c, http.StatusRequestEntityTooLarge, err, uploadTooLargeMsg(maxUpload))
+		case errors.Is(err, juicefs.ErrIsDirectory):
+			a.sendFileError(c, http.StatusConflict, err, "Path is a directory")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusConflict, err, "A parent of the path is not a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to upload file: "+err.Error())
+		}
 		return
 	}
 
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileUpload, VolumeID: volume.ID, Path: path})
+	a.publishFileEvents(ctx, volume, events.VolumeFileUploadedEvent, map[string]any{
+		"size":     written,
+		"checksum": checksums.SHA256,
+	}, path)
+
+	c.Header("ETag", `"`+checksums.SHA256+`"`)
 	c.JSON(http.StatusCreated, api.UploadResponse{
-		Path: path,
-		Size: written,
+		Path:     path,
+		Size:     written,
+		Checksum: &checksums.SHA256,
 	})
 }
 
-// DeleteVolumesVolumeIDFiles deletes a file or directory from a volume.
-func (a *APIStore) DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, params api.DeleteVolumesVolumeIDFilesParams) {
+// uploadTooLargeMsg is the message of an upload exceeding the maximum size of a single upload request,
+// larger files are uploaded in parts.
+func uploadTooLargeMsg(maxUpload int64) string {
+	return fmt.Sprintf("Upload exceeds the maximum size of %d bytes per request", maxUpload)
+}
+
+// uploadContentType returns the content type the client set for the uploaded file,
+// empty when it set none, so the type is detected from the path and the content.
+func uploadContentType(param *string) (string, error) {
+	if param == nil {
+		return "", nil
+	}
+
+	if _, _, err := mime.ParseMediaType(*param); err != nil {
+		return "", fmt.Errorf("invalid content type %q", *param)
+	}
+
+	return *param, nil
+}
+
+// parseUploadChecksums returns the hex encoded checksums sent in the upload headers.
+// Content-MD5 is base64 encoded (RFC 1864), x-checksum-sha256 can be hex or base64 encoded.
+func parseUploadChecksums(params api.PutVolumesVolumeIDFilesUploadParams) (juicefs.Checksums, error) {
+	var checksums juicefs.Checksums
+
+	if params.ContentMD5 != nil {
+		digest, err := base64.StdEncoding.DecodeString(*params.ContentMD5)
+		if err != nil || len(digest) != md5.Size {
+			return juicefs.Checksums{}, fmt.Errorf("invalid Content-MD5 header, expected a base64 encoded MD5 digest")
+		}
+		checksums.MD5 = hex.EncodeToString(digest)
+	}
+
+	if params.XChecksumSha256 != nil {
+		value := *params.XChecksumSha256
+		digest, err := hex.DecodeString(value)
+		if err != nil || len(digest) != sha256.Size {
+			digest, err = base64.StdEncoding.DecodeString(value)
+		}
+		if err != nil || len(digest) != sha256.Size {
+			return juicefs.Checksums{}, fmt.Errorf("invalid x-checksum-sha256 header, expected a hex or base64 encoded SHA-256 digest")
+		}
+		checksums.SHA256 = hex.EncodeToString(digest)
+	}
+
+	return checksums, nil
+}
+
+// extractArchive unpacks the uploaded archive into the directory at dirPath.
+func (a *APIStore) extractArchive(c *gin.Context, client *juicefs.Client, volume queries.Volume, dirPath string, body io.Reader) {
+	ctx := c.Request.Context()
+
+	format, ok := juicefs.ArchiveFormatFromContentType(c.ContentType())
+	if !ok {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Extracting requires a tar, gzip or zip archive content type")
+		return
+	}
+
+	result, err := client.Extract(ctx, dirPath, format, body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			a.sendFileError(c, http.StatusRequestEntityTooLarge, juicefs.ErrUploadTooLarge, uploadTooLargeMsg(tooLarge.Limit))
+			return
+		}
+		if errors.Is(err, juicefs.ErrInvalidArchive) {
+			a.sendFileError(c, http.StatusBadRequest, err, err.Error())
+			return
+		}
+
+		logger.L().Warn(ctx, "Failed to extract archive",
+			zap.Error(err),
+			zap.String("volume_id", volume.ID),
+			zap.String("path", dirPath))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to extract archive: "+err.Error())
+		return
+	}
+
+	// The extracted files aren't listed, a single entry and event tell the directory changed
+	a.recordAudit(c, audit.Entry{TeamID: volume.TeamID, Action: audit.ActionFileUpload, VolumeID: volume.ID, Path: dirPath})
+	a.publishFileEvents(ctx, volume, events.VolumeFileUploadedEvent, map[string]any{
+		"size":        result.Size,
+		"extracted":   true,
+		"files":       result.Files,
+		"directories": result.Directories,
+	}, dirPath)
+
+	c.JSON(http.StatusCreated, api.UploadResponse{
+		Path:        dirPath,
+		Size:        result.Size,
+		Files:       &result.Files,
+		Directories: &result.Directories,
+	})
+}
+
+// PostVolumesVolumeIDFilesCopy copies a file or directory tree within a volume or to another volume of the team.
+func (a *APIStore) PostVolumesVolumeIDFilesCopy(c *gin.Context, volumeID string) {
 	ctx := c.Request.Context()
 
 	team, apiErr := a.GetTeam(ctx, c, nil)
@@ -303,6 +734,131 @@ func (a *APIStore) DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, p
 		return
 	}
 
+	var req api.FileCopyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate paths
+	if !strings.HasPrefix(req.Source, "/") || !strings.HasPrefix(req.Destination, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Paths must be absolute")
+		return
+	}
+
+	// Normalize paths
+	srcPath := filepath.Clean(req.Source)
+	dstPath := filepath.Clean(req.Destination)
+	if dstPath == "/" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Destination can't be the volume root")
+		return
+	}
+
+	// Verify ownership of both volumes
+	srcVolume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	dstVolume := srcVolume
+	if req.DestinationVolumeId != nil && *req.DestinationVolumeId != srcVolume.ID {
+		dstVolume, err = a.resolveVolumeByID(ctx, team.ID, *req.DestinationVolumeId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				a.sendAPIStoreError(c, http.StatusNotFound, "Destination volume not found")
+				return
+			}
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get destination volume")
+			return
+		}
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	// Only the destination is modified, the source can stay attached
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, dstVolume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	// Get JuiceFS clients for both volumes, the pool returns the same client for a single volume
+	srcClient, err := a.juicefsPool.Get(ctx, juicefsVolume(srcVolume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	dstClient, err := a.juicefsPool.Get(ctx, juicefsVolume(dstVolume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	overwrite := req.Overwrite != nil && *req.Overwrite
+
+	result, err := juicefs.Copy(ctx, srcClient, srcPath, dstClient, dstPath, overwrite)
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrSourceNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "Source path not found")
+		case errors.Is(err, juicefs.ErrCopyIntoSource):
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Destination can't be inside the source")
+		case errors.Is(err, juicefs.ErrDestinationExists):
+			a.sendFileError(c, http.StatusConflict, err, "Copy conflicts with existing content: "+err.Error())
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to copy: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.FileCopyResponse{
+		VolumeId:    dstVolume.ID,
+		Path:        dstPath,
+		Files:       result.Files,
+		Directories: result.Directories,
+		Size:        result.Size,
+	})
+}
+
+// PostVolumesVolumeIDFilesMkdir creates a directory in a volume.
+func (a *APIStore) PostVolumesVolumeIDFilesMkdir(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.FileMkdirRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	dirPath := filepath.Clean(req.Path)
+	if dirPath == "/" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path can't be the volume root")
+		return
+	}
+
 	// Verify volume ownership
 	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
 	if err != nil {
@@ -320,17 +876,271 @@ func (a *APIStore) DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, p
 		return
 	}
 
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, dirPath); err != nil {
+		a.sendVolumeScopeError(c, err)
+		return
+	}
+
+	recursive := req.Recursive != nil && *req.Recursive
+
+	info, err := client.Mkdir(ctx, dirPath, recursive)
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "Parent directory not found")
+		case errors.Is(err, juicefs.ErrExists):
+			a.sendFileError(c, http.StatusConflict, err, "Path already exists")
+		case errors.Is(err, juicefs.ErrNotDirectory):
+			a.sendFileError(c, http.StatusConflict, err, "A parent of the path is not a directory")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create directory: "+err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.FileInfo{
+		Name:       info.Name,
+		Path:       info.Path,
+		Type:       api.FileInfoTypeDirectory,
+		ModifiedAt: ptr(info.ModifiedAt),
+	})
+}
+
+// sendVolumeClientError reports a failure to get a JuiceFS client for a volume.
+func (a *APIStore) sendVolumeClientError(c *gin.Context, err error) {
+	// Handle fresh volumes that haven't been mounted yet
+	if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
+		a.sendAPIStoreError(c, http.StatusPreconditionFailed, "Volume not initialized - mount to a sandbox first")
+		return
+	}
+	if errors.Is(err, juicefs.ErrPoolFull) {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Too many volumes are open, retry later")
+		return
+	}
+	// The volume was written by a newer release, this one could corrupt it
+	if errors.Is(err, volumeformat.ErrUnsupported) {
+		a.sendAPIStoreError(c, http.StatusConflict, err.Error())
+		return
+	}
+	a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to connect to volume: "+err.Error())
+}
+
+// fileErrorReasons are the machine-readable reasons of the typed errors of the juicefs package.
+var fileErrorReasons = []struct {
+	err    error
+	reason api.FileErrorReason
+}{
+	{juicefs.ErrNotFound, api.NotFound},
+	{juicefs.ErrSourceNotFound, api.NotFound},
+	{juicefs.ErrDanglingSymlink, api.DanglingSymlink},
+	{juicefs.ErrNotDirectory, api.NotDirectory},
+	{juicefs.ErrIsDirectory, api.IsDirectory},
+	{juicefs.ErrNotEmpty, api.NotEmpty},
+	{juicefs.ErrExists, api.Exists},
+	{juicefs.ErrDestinationExists, api.Exists},
+	{juicefs.ErrQuotaExceeded, api.QuotaExceeded},
+	{juicefs.ErrUploadTooLarge, api.UploadTooLarge},
+	{juicefs.ErrChecksumMismatch, api.ChecksumMismatch},
+	{juicefs.ErrInvalidArchive, api.InvalidArchive},
+}
+
+// fileErrorReason returns the machine-readable reason of a typed error of a file operation.
+func fileErrorReason(err error) (api.FileErrorReason, bool) {
+	for _, r := range fileErrorReasons {
+		if errors.Is(err, r.err) {
+			return r.reason, true
+		}
+	}
+
+	return "", false
+}
+
+// sendFileError sends the error of a file operation like sendAPIStoreError, with the reason of the typed error
+// it wraps, so clients can tell the causes of the same status apart.
+func (a *APIStore) sendFileError(c *gin.Context, code int, err error, message string) {
+	apiErr := api.Error{
+		Code:    int32(code),
+		Message: message,
+	}
+	if reason, ok := fileErrorReason(err); ok {
+		apiErr.Reason = &reason
+	}
+
+	c.Error(errors.New(message))
+	c.JSON(code, apiErr)
+}
+
+// publishFileEvents emits a file event of the volume for each path, with the same event data. The events
+// are published in the background like the volume lifecycle events, a failure doesn't fail the request.
+func (a *APIStore) publishFileEvents(ctx context.Context, volume queries.Volume, eventType string, data map[string]any, filePaths ...string) {
+	if a.volEventsDelivery == nil || len(filePaths) == 0 {
+		return
+	}
+
+	fileEvents := make([]events.VolumeEvent, len(filePaths))
+	for i, filePath := range filePaths {
+		fileEvents[i] = events.NewVolumeEvent(eventType, volume.ID).
+			WithVolumeName(volume.Name).
+			WithFilePath(filePath).
+			WithEventData(data)
+		fileEvents[i].SandboxTeamID = volume.TeamID
+	}
+
+	go func() {
+		for _, event := range fileEvents {
+			if err := a.volEventsDelivery.Publish(context.WithoutCancel(ctx), events.DeliveryKey(volume.TeamID), event); err != nil {
+				logger.L().Error(ctx, "Failed to publish "+eventType+" event", zap.Error(err),
+					zap.String("volume_id", volume.ID),
+					zap.String("path", event.FilePath))
+			}
+		}
+	}()
+}
+
+// beginVolumeWrite starts a write of the volume with startVolumeWrite, the error is sent to the client
+// when the write can't start or the credentials of the request can't write the volume. The request context is
+// replaced by the context of the write.
+func (a *APIStore) beginVolumeWrite(c *gin.Context, volume queries.Volume) (context.Context, func(), bool) {
+	if err := checkVolumeWriteScope(requestVolumeScope(c), volume.ID); err != nil {
+		a.sendVolumeScopeError(c, err)
+		return nil, nil, false
+	}
+
+	ctx, finish, err := a.startVolumeWrite(c.Request.Context(), volume)
+	switch {
+	case errors.Is(err, errVolumeBeingModified):
+		a.sendAPIStoreError(c, http.StatusConflict, "Volume is being modified by another request, retry later")
+		return nil, nil, false
+	case errors.Is(err, errVolumeAttached):
+		a.sendAPIStoreError(c, http.StatusConflict, "Cannot modify volume while attached to sandbox")
+		return nil, nil, false
+	case err != nil:
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to check volume status")
+		return nil, nil, false
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+
+	return ctx, finish, true
+}
+
+// startVolumeWrite takes the write lease of the volume and checks that no sandbox has it attached, the metadata
+// the API uploads would race with the metadata replicated by the sandbox. The volume must be modified with the
+// returned context, its metadata isn't uploaded once the lease is lost. The returned function finishes the write,
+// it must be called once the volume was modified.
+func (a *APIStore) startVolumeWrite(ctx context.Context, volume queries.Volume) (context.Context, func(), error) {
+	var lease *volumeleases.Lease
+	if a.volumeLeases != nil {
+		var err error
+		lease, err = a.volumeLeases.Acquire(ctx, volume.ID)
+		if err != nil {
+			if errors.Is(err, volumeleases.ErrLeaseHeld) {
+				return nil, nil, errVolumeBeingModified
+			}
+			return nil, nil, fmt.Errorf("failed to lock volume: %w", err)
+		}
+
+		ctx = juicefs.WithWriteCheck(ctx, lease.Err)
+	}
+
+	release := func() {
+		if lease != nil {
+			lease.Release(context.WithoutCancel(ctx))
+		}
+	}
+
+	// Sandboxes attaching the volume read-write check the lease after recording their attachment,
+	// so either the attachment is seen here or the lease is seen by the sandbox
+	attachments, err := a.sqlcDB.ListVolumeAttachments(ctx, volume.ID)
+	if err != nil {
+		release()
+		return nil, nil, fmt.Errorf("failed to list volume attachments: %w", err)
+	}
+
 	// Check if volume is attached to a running sandbox (write conflict)
 	isAttached, err := a.sqlcDB.IsVolumeAttached(ctx, &volume.ID)
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to check volume status")
+		release()
+		return nil, nil, fmt.Errorf("failed to check volume attachment: %w", err)
+	}
+	if isAttached || len(attachments) > 0 {
+		release()
+		return nil, nil, errVolumeAttached
+	}
+
+	finish := func() {
+		if lease == nil {
+			return
+		}
+
+		// The metadata of the instance may have missed the writes made after the lease was lost
+		if lease.Err() != nil {
+			a.juicefsPool.InvalidateVolume(volume.ID)
+			release()
+
+			return
+		}
+
+		// The other API instances drop the metadata they loaded before the write
+		ctx := context.WithoutCancel(ctx)
+		generation, err := a.volumeLeases.Bump(ctx, volume.ID)
+		if err != nil {
+			telemetry.ReportError(ctx, "error bumping the volume metadata generation", err)
+		} else {
+			a.juicefsPool.Advance(volume.ID, generation)
+		}
+
+		release()
+	}
+
+	return ctx, finish, nil
+}
+
+// DeleteVolumesVolumeIDFiles deletes a file or directory from a volume.
+func (a *APIStore) DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, params api.DeleteVolumesVolumeIDFilesParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
 		return
 	}
-	if isAttached {
-		a.sendAPIStoreError(c, http.StatusConflict, "Cannot modify volume while attached to sandbox")
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
 		return
 	}
 
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
 	// Validate path
 	if !strings.HasPrefix(params.Path, "/") {
 		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
@@ -348,29 +1158,31 @@ func (a *APIStore) DeleteVolumesVolumeIDFiles(c *gin.Context, volumeID string, p
 
 	// Get JuiceFS client for this volume
 	// Note: redisDB parameter is deprecated, passing 0 (code won't reach here due to nil check above)
-	client, err := a.juicefsPool.Get(ctx, volume.ID, 0)
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
 	if err != nil {
-		// Handle fresh volumes that haven't been mounted yet
-		if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
-			a.sendAPIStoreError(c, http.StatusPreconditionFailed, "Volume not initialized - mount to a sandbox first")
-			return
-		}
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to connect to volume: "+err.Error())
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, path); err != nil {
+		a.sendVolumeScopeError(c, err)
 		return
 	}
 
 	// Delete file/directory
 	err = client.Delete(ctx, path, recursive)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			// Already deleted or doesn't exist - that's fine
-			c.Status(http.StatusNoContent)
+		if errors.Is(err, juicefs.ErrNotEmpty) {
+			a.sendFileError(c, http.StatusConflict, err, "Directory is not empty, delete it recursively")
 			return
 		}
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to delete: "+err.Error())
 		return
 	}
 
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileDelete, VolumeID: volume.ID, Path: path})
+	a.publishFileEvents(ctx, volume, events.VolumeFileDeletedEvent, map[string]any{"recursive": recursive}, path)
+
 	c.Status(http.StatusNoContent)
 }
 
@@ -389,6 +1201,10 @@ func (a *APIStore) resolveVolumeByID(ctx context.Context, teamID uuid.UUID, volu
 	if vol.TeamID != teamID {
 		return queries.Volume{}, sql.ErrNoRows // Hide existence from other teams
 	}
+	// Volumes pending deletion can only be restored or deleted
+	if vol.Status == "pending_delete" {
+		return queries.Volume{}, sql.ErrNoRows
+	}
 	return vol, nil
 }
 
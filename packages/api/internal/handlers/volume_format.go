@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+)
+
+const (
+	// migrateVolumesFormatJob is the background job upgrading the metadata of the volumes to the current format version.
+	migrateVolumesFormatJob = "volumes.migrate-format"
+
+	// volumesFormatMigrationInterval is how often volumes with metadata in an older format version are looked for.
+	volumesFormatMigrationInterval = 10 * time.Minute
+)
+
+// migrateVolumesFormat upgrades the metadata of a batch of volumes formatted by older releases, the oldest first.
+// Volumes attached to a sandbox or modified through the API are skipped and migrated by a later run.
+func (a *APIStore) migrateVolumesFormat(ctx context.Context, _ jobs.Job) error {
+	volumes, err := a.sqlcDB.GetVolumesToMigrate(ctx, queries.GetVolumesToMigrateParams{
+		FormatVersion: volumeformat.Current,
+		QueryLimit:    int32(a.config.VolumesFormatMigrationBatchSize),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the volumes to migrate: %w", err)
+	}
+
+	for _, volume := range volumes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		version, err := a.migrateVolumeFormat(ctx, volume)
+		switch {
+		case errors.Is(err, errVolumeBeingModified), errors.Is(err, errVolumeAttached):
+			continue
+		case err != nil:
+			logger.L().Warn(ctx, "Failed to migrate volume format", zap.Error(err), zap.String("volume_id", volume.ID))
+
+			continue
+		}
+
+		err = a.sqlcDB.UpdateVolumeFormatVersion(ctx, queries.UpdateVolumeFormatVersionParams{
+			ID:            volume.ID,
+			FormatVersion: int32(version),
+		})
+		if err != nil {
+			logger.L().Warn(ctx, "Failed to update volume format version", zap.Error(err), zap.String("volume_id", volume.ID))
+		}
+	}
+
+	return nil
+}
+
+// migrateVolumeFormat upgrades the metadata of the volume under its write lease and returns the resulting format version.
+func (a *APIStore) migrateVolumeFormat(ctx context.Context, volume queries.Volume) (int, error) {
+	ctx, finishWrite, err := a.startVolumeWrite(ctx, volume)
+	if err != nil {
+		return 0, err
+	}
+	defer finishWrite()
+
+	client, release, err := a.juicefsPool.Open(ctx, juicefsVolume(volume))
+	if err != nil {
+		// Volumes that were never mounted are formatted with the current version on the first mount
+		if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
+			return volumeformat.Current, nil
+		}
+
+		return 0, fmt.Errorf("failed to open volume: %w", err)
+	}
+
+	defer release()
+
+	return client.MigrateFormat(ctx)
+}
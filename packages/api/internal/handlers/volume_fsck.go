@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// fsckVolumeJob is the background job checking the consistency of a volume.
+	fsckVolumeJob = "volumes.fsck"
+
+	// fsckVolumeTimeout bounds a consistency check, it lists all the slices and chunk objects of the volume.
+	fsckVolumeTimeout = time.Hour
+)
+
+type volumeFsck struct {
+	OperationID string `json:"operationId"`
+	VolumeID    string `json:"volumeId"`
+	Repair      bool   `json:"repair"`
+}
+
+// PostVolumesVolumeIDFsck starts a consistency check of the volume, it runs in the background once no
+// sandbox has the volume attached.
+func (a *APIStore) PostVolumesVolumeIDFsck(c *gin.Context, volumeID api.VolumeIdOrName, params api.PostVolumesVolumeIDFsckParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	if volume.Status != "available" {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s", volume.Status))
+		return
+	}
+
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	_, err = a.sqlcDB.GetActiveVolumeOperation(ctx, queries.GetActiveVolumeOperationParams{
+		VolumeID: volume.ID,
+		Type:     string(api.Fsck),
+	})
+	if err == nil {
+		a.sendAPIStoreError(c, http.StatusConflict, "A fsck operation is already running on the volume")
+		return
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume operations")
+		return
+	}
+
+	// The operation is the only way to get the findings, the check isn't started without it
+	op := a.createVolumeOperation(ctx, volume, api.Fsck, api.VolumeOperationStatePending)
+	if op == nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to start fsck")
+		return
+	}
+
+	payload := volumeFsck{
+		OperationID: op.ID,
+		VolumeID:    volume.ID,
+		Repair:      params.Repair != nil && *params.Repair,
+	}
+	if err := a.jobs.Enqueue(ctx, fsckVolumeJob, payload, jobs.WithUniqueKey(op.ID)); err != nil {
+		logger.L().Error(ctx, "Failed to enqueue volume fsck", zap.Error(err), zap.String("volume_id", volume.ID))
+		a.finishVolumeOperation(context.WithoutCancel(ctx), op, api.VolumeOperationStateFailed, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to start fsck")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, volumeOperationToAPI(*op))
+}
+
+// runVolumeFsck checks the volume of the job and records the findings with its operation. The check is
+// retried while a sandbox has the volume attached, the operation fails once the job runs out of attempts.
+func (a *APIStore) runVolumeFsck(ctx context.Context, job jobs.Job) error {
+	var payload volumeFsck
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+
+	op, err := a.sqlcDB.GetVolumeOperation(ctx, payload.OperationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get volume operation: %w", err)
+	}
+	if op.State != string(api.VolumeOperationStatePending) && op.State != string(api.VolumeOperationStateRunning) {
+		return nil
+	}
+
+	volume, err := a.sqlcDB.GetVolume(ctx, payload.VolumeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		a.finishVolumeOperation(ctx, &op, api.VolumeOperationStateFailed, errors.New("the volume was deleted"))
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	report, err := a.fsckVolume(ctx, volume, payload.Repair)
+	if err != nil {
+		// Sandboxes detach eventually, the check waits for them by retrying
+		if job.Attempt < job.MaxAttempts {
+			return err
+		}
+
+		a.finishVolumeOperation(ctx, &op, api.VolumeOperationStateFailed, err)
+
+		return jobs.Permanent(err)
+	}
+
+	err = a.sqlcDB.SetVolumeOperationFsckReport(ctx, queries.SetVolumeOperationFsckReportParams{
+		ID:         op.ID,
+		FsckReport: report,
+	})
+	if err != nil {
+		a.finishVolumeOperation(ctx, &op, api.VolumeOperationStateFailed, fmt.Errorf("record findings: %w", err))
+
+		return jobs.Permanent(err)
+	}
+
+	a.finishVolumeOperation(ctx, &op, api.VolumeOperationStateSucceeded, nil)
+
+	return nil
+}
+
+// fsckVolume checks the volume under its write lease, writes would be reported as inconsistencies.
+// Volumes that were never mounted hold no files and are consistent.
+func (a *APIStore) fsckVolume(ctx context.Context, volume queries.Volume, repair bool) (*types.VolumeFsckReport, error) {
+	ctx, finishWrite, err := a.startVolumeWrite(ctx, volume)
+	if err != nil {
+		return nil, err
+	}
+	defer finishWrite()
+
+	a.startVolumeOperation(ctx, volume, api.Fsck)
+
+	client, release, err := a.juicefsPool.Open(ctx, juicefsVolume(volume))
+	if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
+		return &types.VolumeFsckReport{Repaired: repair}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open volume: %w", err)
+	}
+	defer release()
+
+	report, err := client.Fsck(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeFsckReport{
+		MetadataIssues: report.MetadataIssues,
+		MissingObjects: report.MissingObjects,
+		BrokenFiles:    report.BrokenFiles,
+		LeakedObjects:  report.LeakedObjects,
+		LeakedBytes:    report.LeakedBytes,
+		Repaired:       report.Repaired,
+	}, nil
+}
+
+// volumeFsckReportToAPI converts the findings of a fsck operation to API response.
+func volumeFsckReportToAPI(report *types.VolumeFsckReport) *api.VolumeFsckReport {
+	if report == nil {
+		return nil
+	}
+
+	result := &api.VolumeFsckReport{
+		MissingObjects: report.MissingObjects,
+		BrokenFiles:    report.BrokenFiles,
+		LeakedObjects:  report.LeakedObjects,
+		LeakedBytes:    report.LeakedBytes,
+		Repaired:       report.Repaired,
+	}
+	if result.BrokenFiles == nil {
+		result.BrokenFiles = []string{}
+	}
+	if report.MetadataIssues != "" {
+		result.MetadataIssues = &report.MetadataIssues
+	}
+
+	return result
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	clickhouse "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg"
+	clickhouseUtils "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/utils"
+	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// GetVolumesVolumeIDMetrics returns the usage and throughput of the volume over time, reported by the
+// sandboxes the volume is mounted in.
+func (a *APIStore) GetVolumesVolumeIDMetrics(c *gin.Context, volumeID string, params api.GetVolumesVolumeIDMetricsParams) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "volume-metrics")
+	defer span.End()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	ctx = featureflags.SetContext(
+		ctx,
+		ldcontext.NewBuilder(team.ID.String()).
+			Kind(featureflags.TeamKind).
+			Build(),
+	)
+
+	// Same switch as for the sandbox metrics, the volume metrics are read from ClickHouse too
+	if !a.featureFlags.BoolFlag(ctx, featureflags.MetricsReadFlagName) {
+		logger.L().Debug(ctx, "volume metrics read feature flag is disabled")
+
+		c.JSON(http.StatusOK, []api.VolumeMetric{})
+
+		return
+	}
+
+	start, end, err := getVolumeStartEndTime(ctx, a.clickhouseStore, team.ID.String(), volume.ID, params)
+	if err != nil {
+		logger.L().Error(ctx, "error getting volume metrics time range", zap.Error(err), zap.String("volume_id", volume.ID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "error getting metrics time range")
+
+		return
+	}
+
+	start, end, err = clickhouseUtils.ValidateRange(start, end)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("error validating time range: %s", err))
+
+		return
+	}
+
+	step := clickhouseUtils.CalculateStep(start, end)
+
+	metrics, err := a.clickhouseStore.QueryVolumeMetrics(ctx, volume.ID, team.ID.String(), start, end, step)
+	if err != nil {
+		logger.L().Error(ctx, "error querying volume metrics", zap.Error(err), zap.String("volume_id", volume.ID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "error querying volume metrics")
+
+		return
+	}
+
+	apiMetrics := make([]api.VolumeMetric, len(metrics))
+	for i, m := range metrics {
+		apiMetrics[i] = api.VolumeMetric{
+			Timestamp:           m.Timestamp,
+			TimestampUnix:       m.Timestamp.Unix(),
+			UsedBytes:           int64(m.Used),
+			FileCount:           int64(m.Files),
+			ReadBytesPerSecond:  m.ReadBps,
+			WriteBytesPerSecond: m.WriteBps,
+		}
+
+		if m.LastSync > 0 {
+			lastSync := time.Unix(int64(m.LastSync), 0).UTC()
+			apiMetrics[i].LastSyncAt = &lastSync
+		}
+	}
+
+	c.JSON(http.StatusOK, apiMetrics)
+}
+
+func getVolumeStartEndTime(ctx context.Context, clickhouseStore clickhouse.Clickhouse, teamID, volumeID string, params api.GetVolumesVolumeIDMetricsParams) (time.Time, time.Time, error) {
+	var start, end time.Time
+	if params.Start != nil {
+		start = time.Unix(*params.Start, 0)
+	}
+
+	if params.End != nil {
+		end = time.Unix(*params.End, 0)
+	}
+
+	if start.IsZero() || end.IsZero() {
+		volumeStart, volumeEnd, err := clickhouseStore.QueryVolumeTimeRange(ctx, volumeID, teamID)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("error querying volume time range: %w", err)
+		}
+
+		if start.IsZero() {
+			start = volumeStart
+		}
+
+		if end.IsZero() {
+			end = volumeEnd
+		}
+	}
+
+	return start, end, nil
+}
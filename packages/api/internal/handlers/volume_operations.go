@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	operationIDPrefix = "op-"
+
+	volumeOperationsDefaultLimit = 100
+	volumeOperationsMaxLimit     = 100
+)
+
+// GetVolumesIdOrNameOperations lists the operations of a volume, the most recent first.
+func (a *APIStore) GetVolumesIdOrNameOperations(c *gin.Context, volumeID api.VolumeIdOrName, params api.GetVolumesIdOrNameOperationsParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	pagination, err := utils.NewPagination[queries.VolumeOperation](
+		utils.PaginationParams{
+			Limit:     params.Limit,
+			NextToken: params.NextToken,
+		},
+		utils.PaginationConfig{
+			DefaultLimit: volumeOperationsDefaultLimit,
+			MaxLimit:     volumeOperationsMaxLimit,
+		},
+	)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+		return
+	}
+
+	operations, err := a.sqlcDB.ListVolumeOperations(ctx, queries.ListVolumeOperationsParams{
+		VolumeID:   volume.ID,
+		CursorTime: pagination.CursorTime(),
+		CursorID:   pagination.CursorID(),
+		QueryLimit: pagination.QueryLimit(),
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list volume operations")
+		return
+	}
+
+	operations = pagination.ProcessResultsWithHeader(c, operations, func(op queries.VolumeOperation) (time.Time, string) {
+		return op.CreatedAt, op.ID
+	})
+
+	result := make([]api.VolumeOperation, len(operations))
+	for i, op := range operations {
+		result[i] = volumeOperationToAPI(op)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetOperationsOperationID gets a volume operation, also once its volume is deleted.
+func (a *APIStore) GetOperationsOperationID(c *gin.Context, operationID api.OperationID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	if !strings.HasPrefix(operationID, operationIDPrefix) {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Operation not found")
+		return
+	}
+
+	op, err := a.sqlcDB.GetVolumeOperation(ctx, operationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Operation not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get operation")
+		return
+	}
+
+	// Hide existence from other teams
+	if op.TeamID != team.ID {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Operation not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, volumeOperationToAPI(op))
+}
+
+// createVolumeOperation records an operation on the volume. Operations only give visibility into
+// the jobs, so failing to record one is logged and doesn't fail the job, nil is returned then.
+func (a *APIStore) createVolumeOperation(ctx context.Context, volume queries.Volume, opType api.VolumeOperationType, state api.VolumeOperationState) *queries.VolumeOperation {
+	op, err := a.sqlcDB.CreateVolumeOperation(ctx, queries.CreateVolumeOperationParams{
+		ID:       operationIDPrefix + id.Generate(),
+		VolumeID: volume.ID,
+		TeamID:   volume.TeamID,
+		Type:     string(opType),
+		State:    string(state),
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to record volume operation", zap.Error(err),
+			zap.String("volume_id", volume.ID),
+			zap.String("operation_type", string(opType)))
+
+		return nil
+	}
+
+	return &op
+}
+
+// startVolumeOperation moves the unfinished operation of the type on the volume to running,
+// or records a running one if there is none, e.g. when a volume is deleted without a grace period.
+func (a *APIStore) startVolumeOperation(ctx context.Context, volume queries.Volume, opType api.VolumeOperationType) *queries.VolumeOperation {
+	op, err := a.sqlcDB.GetActiveVolumeOperation(ctx, queries.GetActiveVolumeOperationParams{
+		VolumeID: volume.ID,
+		Type:     string(opType),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return a.createVolumeOperation(ctx, volume, opType, api.VolumeOperationStateRunning)
+	}
+	if err != nil {
+		logger.L().Error(ctx, "Failed to get volume operation", zap.Error(err), zap.String("volume_id", volume.ID))
+
+		return nil
+	}
+
+	if op.State == string(api.VolumeOperationStateRunning) {
+		return &op
+	}
+
+	started, err := a.sqlcDB.StartVolumeOperation(ctx, op.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to start volume operation", zap.Error(err), zap.String("operation_id", op.ID))
+
+		return nil
+	}
+
+	return &started
+}
+
+// cancelVolumeOperation cancels the unfinished operation of the type on the volume, if any.
+func (a *APIStore) cancelVolumeOperation(ctx context.Context, volume queries.Volume, opType api.VolumeOperationType) {
+	op, err := a.sqlcDB.GetActiveVolumeOperation(ctx, queries.GetActiveVolumeOperationParams{
+		VolumeID: volume.ID,
+		Type:     string(opType),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		logger.L().Error(ctx, "Failed to get volume operation", zap.Error(err), zap.String("volume_id", volume.ID))
+
+		return
+	}
+
+	a.finishVolumeOperation(ctx, &op, api.VolumeOperationStateCanceled, nil)
+}
+
+// setVolumeOperationProgress updates the completion in percent of a running operation.
+func (a *APIStore) setVolumeOperationProgress(ctx context.Context, op *queries.VolumeOperation, progress int32) {
+	if op == nil {
+		return
+	}
+
+	err := a.sqlcDB.UpdateVolumeOperationProgress(ctx, queries.UpdateVolumeOperationProgressParams{
+		ID:       op.ID,
+		Progress: progress,
+	})
+	if err != nil {
+		logger.L().Warn(ctx, "Failed to update volume operation progress", zap.Error(err), zap.String("operation_id", op.ID))
+	}
+}
+
+// finishVolumeOperation moves the operation to its final state, with the error of a failed operation.
+func (a *APIStore) finishVolumeOperation(ctx context.Context, op *queries.VolumeOperation, state api.VolumeOperationState, opErr error) {
+	if op == nil {
+		return
+	}
+
+	var errMsg *string
+	if opErr != nil {
+		msg := opErr.Error()
+		errMsg = &msg
+	}
+
+	_, err := a.sqlcDB.FinishVolumeOperation(ctx, queries.FinishVolumeOperationParams{
+		ID:    op.ID,
+		State: string(state),
+		Error: errMsg,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.L().Error(ctx, "Failed to finish volume operation", zap.Error(err), zap.String("operation_id", op.ID))
+	}
+}
+
+// volumeOperationToAPI converts a database volume operation to API response.
+func volumeOperationToAPI(op queries.VolumeOperation) api.VolumeOperation {
+	return api.VolumeOperation{
+		OperationID: op.ID,
+		VolumeID:    op.VolumeID,
+		Type:        api.VolumeOperationType(op.Type),
+		State:       api.VolumeOperationState(op.State),
+		Progress:    op.Progress,
+		Error:       op.Error,
+		CreatedAt:   op.CreatedAt,
+		UpdatedAt:   op.UpdatedAt,
+		StartedAt:   op.StartedAt,
+		FinishedAt:  op.FinishedAt,
+		Fsck:        volumeFsckReportToAPI(op.FsckReport),
+	}
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+const (
+	// reapVolumeOrphansJob is the background job looking for the storage of volumes that don't exist.
+	reapVolumeOrphansJob = "volumes.reap-orphans"
+
+	// deleteVolumeOrphanJob is the background job deleting an orphan that stayed orphaned for the grace period.
+	deleteVolumeOrphanJob = "volumes.delete-orphan"
+
+	// volumeOrphansInterval is how often the storage of volumes that don't exist is looked for.
+	volumeOrphansInterval = time.Hour
+)
+
+// volumeOrphan is storage left by a volume that doesn't exist, a prefix of a bucket or a database
+// of the volumes Redis.
+type volumeOrphan struct {
+	Bucket  string `json:"bucket,omitempty"`
+	Prefix  string `json:"prefix,omitempty"`
+	RedisDB int32  `json:"redisDb,omitempty"`
+}
+
+// key identifies the orphan, its deletion is only enqueued once.
+func (o volumeOrphan) key() string {
+	if o.Prefix != "" {
+		return o.Bucket + "/" + o.Prefix
+	}
+
+	return "redis/" + strconv.Itoa(int(o.RedisDB))
+}
+
+// GetAdminVolumesOrphans lists the storage of volumes that don't exist without deleting it.
+func (a *APIStore) GetAdminVolumesOrphans(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "admin-list-volume-orphans")
+	defer span.End()
+
+	orphans, err := a.findVolumeOrphans(ctx)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to find volume orphans", zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when finding volume orphans: %s", err))
+
+		return
+	}
+
+	result := api.AdminVolumeOrphans{
+		Prefixes:       []api.AdminVolumeOrphanPrefix{},
+		RedisDatabases: []int32{},
+	}
+	for _, orphan := range orphans {
+		if orphan.Prefix != "" {
+			result.Prefixes = append(result.Prefixes, api.AdminVolumeOrphanPrefix{Bucket: orphan.Bucket, Prefix: orphan.Prefix})
+		} else {
+			result.RedisDatabases = append(result.RedisDatabases, orphan.RedisDB)
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// reapVolumeOrphans enqueues the deletion of the orphans found, after the grace period. Orphans are found
+// while volumes are created and migrated, e.g. the database a migration loads the metadata into, the
+// deletion checks the orphan is still orphaned.
+func (a *APIStore) reapVolumeOrphans(ctx context.Context, _ jobs.Job) error {
+	orphans, err := a.findVolumeOrphans(ctx)
+	if err != nil {
+		return err
+	}
+
+	runAfter := time.Now().Add(time.Duration(a.config.VolumesOrphanGraceHours) * time.Hour)
+	for _, orphan := range orphans {
+		if err := a.jobs.Enqueue(ctx, deleteVolumeOrphanJob, orphan, jobs.WithUniqueKey(orphan.key()), jobs.WithRunAfter(runAfter)); err != nil {
+			logger.L().Warn(ctx, "Failed to enqueue the deletion of a volume orphan", zap.Error(err), zap.String("orphan", orphan.key()))
+		}
+	}
+
+	logger.L().Info(ctx, "Looked for volume orphans", zap.Int("count", len(orphans)))
+
+	return nil
+}
+
+// deleteVolumeOrphan deletes an orphan whose grace period ended, unless a volume uses it by now.
+func (a *APIStore) deleteVolumeOrphan(ctx context.Context, job jobs.Job) error {
+	var orphan volumeOrphan
+	if err := json.Unmarshal(job.Payload, &orphan); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+
+	if orphan.Prefix != "" {
+		// Never delete anything but the prefixes of volumes, whatever the payload says
+		if !strings.HasPrefix(orphan.Prefix, volumeIDPrefix) {
+			return jobs.Permanent(fmt.Errorf("prefix %q isn't a volume prefix", orphan.Prefix))
+		}
+
+		orphaned, err := a.orphanPrefixes(ctx, orphan.Bucket, []string{orphan.Prefix})
+		if err != nil {
+			return err
+		}
+		if len(orphaned) == 0 {
+			return nil
+		}
+
+		return juicefs.DeletePrefix(ctx, orphan.Bucket, orphan.Prefix)
+	}
+
+	if orphan.RedisDB <= 0 {
+		return jobs.Permanent(fmt.Errorf("redis database %d isn't a volume database", orphan.RedisDB))
+	}
+
+	used, err := a.sqlcDB.GetVolumeRedisDBs(ctx, []int32{orphan.RedisDB})
+	if err != nil {
+		return fmt.Errorf("failed to get the volumes redis databases: %w", err)
+	}
+	if len(used) > 0 {
+		return nil
+	}
+
+	if err := juicefs.DeleteRedisMetaDB(ctx, juicefs.Config{RedisURL: a.config.VolumesRedisURL}, orphan.RedisDB); err != nil {
+		return err
+	}
+	a.freeRedisDB(ctx, orphan.RedisDB)
+
+	return nil
+}
+
+// findVolumeOrphans returns the prefixes of the volume buckets and the databases of the volumes Redis
+// without a volume. Only the buckets of existing volumes are looked at, besides the shared volumes bucket.
+func (a *APIStore) findVolumeOrphans(ctx context.Context) ([]volumeOrphan, error) {
+	var orphans []volumeOrphan
+
+	if a.volumesBucket != "" {
+		buckets, err := a.sqlcDB.GetVolumeBuckets(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the volume buckets: %w", err)
+		}
+
+		for _, bucket := range append([]string{a.volumesBucket}, buckets...) {
+			prefixes, err := juicefs.ListPrefixes(ctx, bucket)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the prefixes of bucket %s: %w", bucket, err)
+			}
+
+			// The buckets hold objects of the API too, like the staged downloads
+			prefixes = slices.DeleteFunc(prefixes, func(prefix string) bool {
+				return !strings.HasPrefix(prefix, volumeIDPrefix)
+			})
+
+			orphaned, err := a.orphanPrefixes(ctx, bucket, prefixes)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, prefix := range orphaned {
+				orphans = append(orphans, volumeOrphan{Bucket: bucket, Prefix: prefix})
+			}
+		}
+	}
+
+	if a.config.VolumesRedisURL != "" {
+		dbs, err := juicefs.ListRedisMetaDBs(ctx, juicefs.Config{RedisURL: a.config.VolumesRedisURL})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the volumes redis databases: %w", err)
+		}
+
+		used, err := a.sqlcDB.GetVolumeRedisDBs(ctx, dbs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the volumes redis databases: %w", err)
+		}
+
+		for _, db := range dbs {
+			if !slices.Contains(used, db) {
+				orphans = append(orphans, volumeOrphan{RedisDB: db})
+			}
+		}
+	}
+
+	return orphans, nil
+}
+
+// orphanPrefixes returns the prefixes of the bucket whose volume doesn't exist or keeps its data in another bucket.
+func (a *APIStore) orphanPrefixes(ctx context.Context, bucket string, prefixes []string) ([]string, error) {
+	if len(prefixes) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		ids[i] = juicefs.PrefixVolumeID(prefix)
+	}
+
+	volumes, err := a.sqlcDB.GetVolumeBucketsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the volumes of the prefixes: %w", err)
+	}
+
+	volumeBuckets := make(map[string]string, len(volumes))
+	for _, volume := range volumes {
+		volumeBuckets[volume.ID] = cmp.Or(sharedUtils.DerefOrDefault(volume.GcsBucket, ""), a.volumesBucket)
+	}
+
+	var orphaned []string
+	for i, prefix := range prefixes {
+		if volumeBuckets[ids[i]] != bucket {
+			orphaned = append(orphaned, prefix)
+		}
+	}
+
+	return orphaned, nil
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// deleteStagedDownloadJob is the background job deleting a materialized file once its signed URL expired.
+	deleteStagedDownloadJob = "volumes.delete-staged-download"
+
+	// defaultPresignExpiry is how long a signed download URL is valid for when not requested otherwise.
+	defaultPresignExpiry = 15 * time.Minute
+
+	// stagedDownloadGracePeriod keeps a materialized file after its URL expired, so a download
+	// started just before the expiry can finish.
+	stagedDownloadGracePeriod = time.Hour
+)
+
+// stagedDownload is the payload of the job deleting a materialized file.
+type stagedDownload struct {
+	Bucket string `json:"bucket"`
+	Object string `json:"object"`
+}
+
+// PostVolumesVolumeIDFilesPresign returns a signed URL to download a file from the bucket directly.
+func (a *APIStore) PostVolumesVolumeIDFilesPresign(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.FilePresignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	path := filepath.Clean(req.Path)
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	expiry := defaultPresignExpiry
+	if req.ExpiresIn != nil {
+		expiry = time.Duration(*req.ExpiresIn) * time.Second
+	}
+	expiresAt := time.Now().Add(expiry)
+
+	materialized := false
+	object, size, err := client.ContiguousObject(ctx, path)
+	if errors.Is(err, juicefs.ErrNotContiguous) && req.Materialize != nil && *req.Materialize {
+		object = juicefs.StagedDownloadsPrefix + volume.ID + "/" + id.Generate() + "/" + filepath.Base(path)
+		size, err = client.Materialize(ctx, path, object)
+		materialized = err == nil
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrNotFound):
+			a.sendFileError(c, http.StatusNotFound, err, "File not found")
+		case errors.Is(err, juicefs.ErrNotContiguous):
+			a.sendAPIStoreError(c, http.StatusConflict, "File isn't stored as a single object, set materialize to stage a copy of it first")
+		default:
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to prepare the download: "+err.Error())
+		}
+		return
+	}
+
+	if materialized {
+		// The staged copy is only needed while the URL works
+		payload := stagedDownload{Bucket: client.Bucket(), Object: object}
+		if err := a.jobs.Enqueue(ctx, deleteStagedDownloadJob, payload, jobs.WithRunAfter(expiresAt.Add(stagedDownloadGracePeriod))); err != nil {
+			logger.L().Error(ctx, "Failed to schedule the deletion of a staged download",
+				zap.Error(err),
+				zap.String("volume_id", volume.ID),
+				zap.String("object", object))
+		}
+	}
+
+	contentType, err := client.ContentType(ctx, path)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the content type: "+err.Error())
+		return
+	}
+
+	url, err := juicefs.SignedDownloadURL(ctx, client.Bucket(), object, path, contentType, expiresAt)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to sign the download URL: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, api.FilePresignResponse{
+		Url:          url,
+		ExpiresAt:    expiresAt,
+		Size:         size,
+		Materialized: materialized,
+	})
+}
+
+// deleteStagedDownload deletes a materialized file whose signed URL expired.
+func (a *APIStore) deleteStagedDownload(ctx context.Context, job jobs.Job) error {
+	var payload stagedDownload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+
+	// Never delete anything but staged downloads, whatever the payload says
+	if !strings.HasPrefix(payload.Object, juicefs.StagedDownloadsPrefix) {
+		return jobs.Permanent(fmt.Errorf("object %q isn't a staged download", payload.Object))
+	}
+
+	return juicefs.DeleteObject(ctx, payload.Bucket, payload.Object)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// GetAdminVolumesRedisDatabases reports how the databases of the volumes Redis are used.
+func (a *APIStore) GetAdminVolumesRedisDatabases(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := tracer.Start(ctx, "admin-volumes-redis-databases")
+	defer span.End()
+
+	if a.config.VolumesRedisURL == "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "No volumes Redis is configured")
+
+		return
+	}
+
+	usage, err := a.sqlcDB.GetRedisDBUsage(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting the redis databases usage: %s", err))
+
+		return
+	}
+
+	result := api.AdminRedisDatabaseUsage{
+		Allocated: usage.Allocated,
+		Used:      usage.Used,
+		Free:      usage.Free,
+		Lost:      max(usage.Allocated-usage.Used-usage.Free, 0),
+	}
+
+	capacity, err := juicefs.RedisDBCapacity(ctx, juicefs.Config{RedisURL: a.config.VolumesRedisURL})
+	if err != nil {
+		logger.L().Warn(ctx, "Failed to get the number of databases of the volumes Redis", zap.Error(err))
+	} else {
+		result.Capacity = &capacity
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// freeRedisDB gives the database of the volumes Redis back for reuse, its metadata must be deleted.
+// A database that isn't freed is only lost to new volumes.
+func (a *APIStore) freeRedisDB(ctx context.Context, db int32) {
+	if err := a.sqlcDB.FreeRedisDB(ctx, db); err != nil {
+		logger.L().Warn(ctx, "Failed to free redis database", zap.Error(err), zap.Int32("redis_db", db))
+	}
+}
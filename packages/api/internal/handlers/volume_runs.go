@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+)
+
+const (
+	volumeRunsDefaultLimit = 100
+	volumeRunsMaxLimit     = 100
+)
+
+// GetVolumesIdOrNameRuns lists the mounts of a volume in sandboxes, the detached ones included, the most recent first.
+func (a *APIStore) GetVolumesIdOrNameRuns(c *gin.Context, volumeID api.VolumeIdOrName, params api.GetVolumesIdOrNameRunsParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	pagination, err := utils.NewPagination[queries.VolumeRun](
+		utils.PaginationParams{
+			Limit:     params.Limit,
+			NextToken: params.NextToken,
+		},
+		utils.PaginationConfig{
+			DefaultLimit: volumeRunsDefaultLimit,
+			MaxLimit:     volumeRunsMaxLimit,
+		},
+	)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+		return
+	}
+
+	runs, err := a.sqlcDB.ListVolumeRuns(ctx, queries.ListVolumeRunsParams{
+		VolumeID:   volume.ID,
+		CursorTime: pagination.CursorTime(),
+		CursorID:   pagination.CursorID(),
+		QueryLimit: pagination.QueryLimit(),
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list volume runs")
+		return
+	}
+
+	runs = pagination.ProcessResultsWithHeader(c, runs, func(run queries.VolumeRun) (time.Time, string) {
+		return run.MountedAt, run.SandboxID
+	})
+
+	result := make([]api.VolumeRun, len(runs))
+	for i, run := range runs {
+		result[i] = volumeRunToAPI(run)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// volumeRunToAPI converts a mount of a volume recorded in the database to the API response.
+func volumeRunToAPI(run queries.VolumeRun) api.VolumeRun {
+	result := api.VolumeRun{
+		SandboxID:  run.SandboxID,
+		MountPath:  run.MountPath,
+		ReadOnly:   run.ReadOnly,
+		MountedAt:  run.MountedAt,
+		DetachedAt: run.DetachedAt,
+	}
+	if run.Subpath != "" {
+		result.Subpath = &run.Subpath
+	}
+
+	return result
+}
@@ -0,0 +1,528 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+)
+
+const (
+	// s3Namespace is the XML namespace of the S3 documents
+	s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+	// s3TimeFormat is the format of the times in S3 documents
+	s3TimeFormat = "2006-01-02T15:04:05.000Z"
+
+	// s3MaxKeys is the default and the maximum number of keys of a listing, like in S3
+	s3MaxKeys = 1000
+	// s3MaxBuckets limits the volumes listed as buckets
+	s3MaxBuckets = 1000
+)
+
+// S3Methods are the methods of the S3 operations, the S3 routes are registered for each of them.
+var S3Methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPut,
+	http.MethodPost,
+	http.MethodDelete,
+}
+
+// VolumeS3 serves the volumes of the team over a subset of the S3 protocol at /s3, so S3 SDKs and tools
+// can read and write volume contents. Buckets are the volumes by ID and the key of an object is the path
+// of its file without the leading slash. Only path-style requests are served.
+// The team is authenticated by S3AuthMiddleware.
+func (a *APIStore) VolumeS3(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendS3Error(c, apiErr.Code, "AccessDenied", apiErr.ClientMsg)
+		return
+	}
+
+	bucket := c.Param("bucket")
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	query := c.Request.URL.Query()
+
+	switch {
+	case bucket == "" && c.Request.Method == http.MethodGet:
+		a.s3ListBuckets(c, team.ID)
+	case bucket == "":
+		a.sendS3Error(c, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not allowed on the service")
+	case key == "":
+		a.s3BucketOperation(c, team.ID, bucket, query)
+	default:
+		a.s3ObjectOperation(c, team, bucket, key, query)
+	}
+}
+
+// s3BucketOperation dispatches the operations on a bucket.
+func (a *APIStore) s3BucketOperation(c *gin.Context, teamID uuid.UUID, bucket string, query url.Values) {
+	switch c.Request.Method {
+	case http.MethodHead:
+		if _, ok := a.s3Volume(c, teamID, bucket); ok {
+			c.Status(http.StatusOK)
+		}
+	case http.MethodGet:
+		switch {
+		case query.Has("location"):
+			if _, ok := a.s3Volume(c, teamID, bucket); ok {
+				c.XML(http.StatusOK, s3LocationConstraint{Xmlns: s3Namespace})
+			}
+		case isS3Listing(query):
+			a.s3ListObjects(c, teamID, bucket, query)
+		default:
+			a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "The bucket operation is not supported")
+		}
+	case http.MethodPost:
+		if !query.Has("delete") {
+			a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "The bucket operation is not supported")
+			return
+		}
+		a.s3DeleteObjects(c, teamID, bucket)
+	default:
+		// Buckets are created and deleted as volumes through the API
+		a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "Buckets are managed as volumes through the API")
+	}
+}
+
+// s3ListingParams are the query parameters of the listings, other parameters select other bucket operations.
+// x-id names the operation in the requests of some SDKs.
+var s3ListingParams = map[string]bool{
+	"list-type":          true,
+	"prefix":             true,
+	"delimiter":          true,
+	"max-keys":           true,
+	"continuation-token": true,
+	"start-after":        true,
+	"marker":             true,
+	"encoding-type":      true,
+	"fetch-owner":        true,
+	"x-id":               true,
+}
+
+// isS3Listing reports whether a GET request of a bucket lists its objects.
+func isS3Listing(query url.Values) bool {
+	for name := range query {
+		if !s3ListingParams[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// s3ObjectOperation dispatches the operations on an object.
+func (a *APIStore) s3ObjectOperation(c *gin.Context, team *types.Team, bucket, key string, query url.Values) {
+	filePath, ok := s3ObjectPath(key)
+	if !ok {
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", "Object keys must be paths without empty, . or .. segments")
+		return
+	}
+
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead:
+		if query.Has("uploadId") {
+			a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "Listing the parts of an upload is not supported")
+			return
+		}
+		a.s3GetObject(c, team.ID, bucket, filePath)
+	case http.MethodPut:
+		switch {
+		case query.Has("uploadId"):
+			a.s3UploadPart(c, team, bucket, filePath, query)
+		case c.GetHeader("X-Amz-Copy-Source") != "":
+			a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "Copying objects is not supported")
+		default:
+			a.s3PutObject(c, team, bucket, key, filePath)
+		}
+	case http.MethodPost:
+		switch {
+		case query.Has("uploads"):
+			a.s3CreateMultipartUpload(c, team.ID, bucket, key, filePath)
+		case query.Has("uploadId"):
+			a.s3CompleteMultipartUpload(c, team.ID, bucket, key, filePath, query.Get("uploadId"))
+		default:
+			a.sendS3Error(c, http.StatusNotImplemented, "NotImplemented", "The object operation is not supported")
+		}
+	case http.MethodDelete:
+		if query.Has("uploadId") {
+			a.s3AbortMultipartUpload(c, team.ID, bucket, filePath, query.Get("uploadId"))
+			return
+		}
+		a.s3DeleteObject(c, team.ID, bucket, key, filePath)
+	}
+}
+
+// s3ObjectPath returns the path of the file of an object key. Keys are clean relative paths,
+// a key ending with a slash is the folder marker S3 tools create for directories.
+func s3ObjectPath(key string) (string, bool) {
+	filePath := "/" + strings.TrimSuffix(key, "/")
+	if filePath == "/" || path.Clean(filePath) != filePath || strings.ContainsRune(key, 0) {
+		return "", false
+	}
+
+	// Staged uploads aren't contents of the volume
+	if filePath == juicefs.UploadsDir || strings.HasPrefix(filePath, juicefs.UploadsDir+"/") {
+		return "", false
+	}
+
+	return filePath, true
+}
+
+// s3Volume resolves the volume of a bucket, the credentials of the request must reach it. Sends the error
+// response and returns false otherwise.
+func (a *APIStore) s3Volume(c *gin.Context, teamID uuid.UUID, bucket string) (queries.Volume, bool) {
+	if scope := requestVolumeScope(c); scope != nil && scope.VolumeID != bucket {
+		a.sendS3Error(c, http.StatusForbidden, "AccessDenied", errOutOfVolumeScope.Error())
+		return queries.Volume{}, false
+	}
+
+	volume, err := a.resolveVolumeByID(c.Request.Context(), teamID, bucket)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendS3Error(c, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+			return queries.Volume{}, false
+		}
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to get volume")
+		return queries.Volume{}, false
+	}
+
+	return volume, true
+}
+
+// s3VolumeClient resolves the volume of a bucket and its client, the credentials of the request must reach the
+// paths of the volume. Sends the error response and returns false otherwise.
+func (a *APIStore) s3VolumeClient(c *gin.Context, teamID uuid.UUID, bucket string, paths ...string) (queries.Volume, *juicefs.Client, bool) {
+	volume, ok := a.s3Volume(c, teamID, bucket)
+	if !ok {
+		return queries.Volume{}, nil, false
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendS3Error(c, http.StatusServiceUnavailable, "ServiceUnavailable", "Volume file operations not available")
+		return queries.Volume{}, nil, false
+	}
+
+	client, err := a.juicefsPool.Get(c.Request.Context(), juicefsVolume(volume))
+	if err != nil {
+		switch {
+		case errors.Is(err, juicefs.ErrVolumeNotInitialized):
+			a.sendS3Error(c, http.StatusPreconditionFailed, "PreconditionFailed", "Volume not initialized - mount to a sandbox first")
+		case errors.Is(err, juicefs.ErrPoolFull):
+			a.sendS3Error(c, http.StatusServiceUnavailable, "SlowDown", "Too many volumes are open, retry later")
+		case errors.Is(err, volumeformat.ErrUnsupported):
+			a.sendS3Error(c, http.StatusConflict, "InvalidBucketState", err.Error())
+		default:
+			a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to connect to volume: "+err.Error())
+		}
+		return queries.Volume{}, nil, false
+	}
+
+	if err := checkVolumeScope(c.Request.Context(), requestVolumeScope(c), client, volume.ID, paths...); err != nil {
+		a.sendS3ScopeError(c, err)
+		return queries.Volume{}, nil, false
+	}
+
+	return volume, client, true
+}
+
+// sendS3ScopeError sends the error of checkVolumeScope or checkVolumeWriteScope.
+func (a *APIStore) sendS3ScopeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, errOutOfVolumeScope), errors.Is(err, errReadOnlyVolumeScope):
+		a.sendS3Error(c, http.StatusForbidden, "AccessDenied", err.Error())
+	case errors.Is(err, juicefs.ErrSymlinkLoop):
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", err.Error())
+	default:
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to resolve the path: "+err.Error())
+	}
+}
+
+// s3BeginWrite starts a write of the volume with startVolumeWrite, the request context is replaced by the context
+// of the write. Sends the error response and returns false when the write can't start.
+func (a *APIStore) s3BeginWrite(c *gin.Context, volume queries.Volume) (context.Context, func(), bool) {
+	if err := checkVolumeWriteScope(requestVolumeScope(c), volume.ID); err != nil {
+		a.sendS3ScopeError(c, err)
+		return nil, nil, false
+	}
+
+	ctx, finish, err := a.startVolumeWrite(c.Request.Context(), volume)
+	switch {
+	case errors.Is(err, errVolumeBeingModified):
+		a.sendS3Error(c, http.StatusConflict, "OperationAborted", "Volume is being modified by another request, retry later")
+		return nil, nil, false
+	case errors.Is(err, errVolumeAttached):
+		a.sendS3Error(c, http.StatusConflict, "InvalidBucketState", "Cannot modify volume while attached to sandbox")
+		return nil, nil, false
+	case err != nil:
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to check volume status")
+		return nil, nil, false
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+
+	return ctx, finish, true
+}
+
+// sendS3Error sends an S3 error document, responses to HEAD requests have no body.
+func (a *APIStore) sendS3Error(c *gin.Context, status int, code, message string) {
+	c.Error(errors.New(message))
+
+	if c.Request.Method == http.MethodHead {
+		c.Status(status)
+		return
+	}
+
+	c.XML(status, auth.S3Error{Code: code, Message: message, Resource: c.Request.URL.Path})
+}
+
+type s3LocationConstraint struct {
+	XMLName xml.Name `xml:"LocationConstraint"`
+	Xmlns   string   `xml:"xmlns,attr"`
+}
+
+type s3Bucket struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type s3ListAllMyBucketsResult struct {
+	XMLName xml.Name   `xml:"ListAllMyBucketsResult"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	Owner   s3Owner    `xml:"Owner"`
+	Buckets []s3Bucket `xml:"Buckets>Bucket"`
+}
+
+type s3Owner struct {
+	ID string `xml:"ID"`
+}
+
+// s3ListBuckets lists the available volumes of the team as buckets.
+func (a *APIStore) s3ListBuckets(c *gin.Context, teamID uuid.UUID) {
+	volumes, err := a.sqlcDB.ListVolumes(c.Request.Context(), queries.ListVolumesParams{
+		TeamID:     teamID,
+		Status:     []string{string(api.Available)},
+		Ascending:  true,
+		QueryLimit: s3MaxBuckets,
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to list volumes")
+		return
+	}
+
+	result := s3ListAllMyBucketsResult{
+		Xmlns:   s3Namespace,
+		Owner:   s3Owner{ID: teamID.String()},
+		Buckets: make([]s3Bucket, 0, len(volumes)),
+	}
+	scope := requestVolumeScope(c)
+	for _, volume := range volumes {
+		// Credentials restricted to a volume only list its bucket
+		if scope != nil && scope.VolumeID != volume.ID {
+			continue
+		}
+
+		result.Buckets = append(result.Buckets, s3Bucket{
+			Name:         volume.ID,
+			CreationDate: volume.CreatedAt.UTC().Format(s3TimeFormat),
+		})
+	}
+
+	c.XML(http.StatusOK, result)
+}
+
+type s3Contents struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type s3CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// s3ListBucketResult is the result of ListObjectsV2 and of the original ListObjects, which pages with markers.
+type s3ListBucketResult struct {
+	XMLName               xml.Name         `xml:"ListBucketResult"`
+	Xmlns                 string           `xml:"xmlns,attr"`
+	Name                  string           `xml:"Name"`
+	Prefix                string           `xml:"Prefix"`
+	Delimiter             string           `xml:"Delimiter,omitempty"`
+	Marker                *string          `xml:"Marker,omitempty"`
+	NextMarker            string           `xml:"NextMarker,omitempty"`
+	StartAfter            string           `xml:"StartAfter,omitempty"`
+	ContinuationToken     string           `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+	KeyCount              *int             `xml:"KeyCount,omitempty"`
+	MaxKeys               int              `xml:"MaxKeys"`
+	EncodingType          string           `xml:"EncodingType,omitempty"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	Contents              []s3Contents     `xml:"Contents"`
+	CommonPrefixes        []s3CommonPrefix `xml:"CommonPrefixes"`
+}
+
+// s3ListParams are the parameters of a listing.
+type s3ListParams struct {
+	v2           bool
+	query        juicefs.ObjectQuery
+	delimiter    string
+	startAfter   string
+	token        string
+	marker       string
+	encodingType string
+}
+
+// parseS3ListParams validates the parameters of ListObjectsV2 and ListObjects.
+func parseS3ListParams(query url.Values) (s3ListParams, error) {
+	params := s3ListParams{
+		v2:           query.Get("list-type") == "2",
+		delimiter:    query.Get("delimiter"),
+		startAfter:   query.Get("start-after"),
+		token:        query.Get("continuation-token"),
+		marker:       query.Get("marker"),
+		encodingType: query.Get("encoding-type"),
+	}
+	params.query.Prefix = query.Get("prefix")
+	params.query.MaxKeys = s3MaxKeys
+
+	switch params.delimiter {
+	case "":
+	case "/":
+		params.query.Delimited = true
+	default:
+		return params, errors.New("only the / delimiter is supported")
+	}
+
+	if params.encodingType != "" && params.encodingType != "url" {
+		return params, fmt.Errorf("invalid encoding type %q", params.encodingType)
+	}
+
+	if value := query.Get("max-keys"); value != "" {
+		maxKeys, err := strconv.Atoi(value)
+		if err != nil || maxKeys < 0 {
+			return params, fmt.Errorf("invalid max-keys %q", value)
+		}
+		params.query.MaxKeys = min(maxKeys, s3MaxKeys)
+	}
+
+	switch {
+	case !params.v2:
+		params.query.StartAfter = params.marker
+	case params.token != "":
+		// The token is the last key of the previous page
+		startAfter, err := base64.RawURLEncoding.DecodeString(params.token)
+		if err != nil {
+			return params, errors.New("the continuation token provided is incorrect")
+		}
+		params.query.StartAfter = string(startAfter)
+	default:
+		params.query.StartAfter = params.startAfter
+	}
+
+	return params, nil
+}
+
+// s3ListObjects lists the keys of a bucket, with ListObjectsV2 or the original ListObjects.
+func (a *APIStore) s3ListObjects(c *gin.Context, teamID uuid.UUID, bucket string, query url.Values) {
+	params, err := parseS3ListParams(query)
+	if err != nil {
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+
+	// Credentials restricted to a subpath only list the keys under it
+	if scope := requestVolumeScope(c); scope != nil && scope.Subpath != "" && !strings.HasPrefix(params.query.Prefix, strings.TrimPrefix(scope.Subpath, "/")+"/") {
+		a.sendS3Error(c, http.StatusForbidden, "AccessDenied", fmt.Sprintf("%s: list the keys with the prefix %s/", errOutOfVolumeScope, strings.TrimPrefix(scope.Subpath, "/")))
+		return
+	}
+
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket, "/"+params.query.Prefix)
+	if !ok {
+		return
+	}
+
+	listing := &juicefs.ObjectListing{}
+	if params.query.MaxKeys > 0 {
+		listing, err = client.ListObjects(c.Request.Context(), params.query)
+		if err != nil {
+			a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to list objects: "+err.Error())
+			return
+		}
+	}
+
+	// Keys are URL encoded when requested, XML can't represent every key
+	encode := func(s string) string { return s }
+	if params.encodingType == "url" {
+		encode = url.QueryEscape
+	}
+
+	result := s3ListBucketResult{
+		Xmlns:        s3Namespace,
+		Name:         volume.ID,
+		Prefix:       encode(params.query.Prefix),
+		Delimiter:    encode(params.delimiter),
+		MaxKeys:      params.query.MaxKeys,
+		EncodingType: params.encodingType,
+		IsTruncated:  listing.IsTruncated,
+	}
+
+	if params.v2 {
+		keyCount := len(listing.Objects) + len(listing.CommonPrefixes)
+		result.KeyCount = &keyCount
+		result.StartAfter = encode(params.startAfter)
+		result.ContinuationToken = params.token
+		if listing.IsTruncated {
+			result.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(listing.NextKey))
+		}
+	} else {
+		marker := encode(params.marker)
+		result.Marker = &marker
+		if listing.IsTruncated {
+			result.NextMarker = encode(listing.NextKey)
+		}
+	}
+
+	for _, object := range listing.Objects {
+		result.Contents = append(result.Contents, s3Contents{
+			Key:          encode(object.Key),
+			LastModified: object.ModifiedAt.UTC().Format(s3TimeFormat),
+			ETag:         s3ObjectETag(object.ModifiedAt, object.Size),
+			Size:         object.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+	for _, prefix := range listing.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, s3CommonPrefix{Prefix: encode(prefix)})
+	}
+
+	c.XML(http.StatusOK, result)
+}
+
+// s3ObjectETag returns the entity tag of a stored object. The content isn't hashed to list or serve objects,
+// the tag is derived from the modification time and the size. The tag has the form of the tag of an object
+// uploaded in parts, clients don't take it for the MD5 of the content then.
+func s3ObjectETag(modTime time.Time, size int64) string {
+	return fmt.Sprintf(`"%x-%d"`, modTime.UnixNano(), size)
+}
@@ -0,0 +1,767 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5" //nolint:gosec // MD5 is used for Content-MD5 integrity checks, not for security
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+)
+
+const (
+	// s3EmptyETag is the entity tag of empty content, the MD5 of no bytes
+	s3EmptyETag = `"d41d8cd98f00b204e9800998ecf8427e"`
+	// s3MaxDeleteObjects is the number of keys a single request deletes at most, like in S3
+	s3MaxDeleteObjects = 1000
+	// s3MaxXMLBody limits the XML documents sent to the S3 operations
+	s3MaxXMLBody = 2 << 20 // 2 MiB
+	// s3StreamingPrefix starts the payload hash of uploads sent in the aws-chunked encoding
+	s3StreamingPrefix = "STREAMING-"
+)
+
+// s3GetObject serves the content of an object, or only its metadata for HEAD requests.
+// Ranges and conditional requests are served by http.ServeContent.
+func (a *APIStore) s3GetObject(c *gin.Context, teamID uuid.UUID, bucket, filePath string) {
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	reader, object, err := client.OpenObject(c.Request.Context(), filePath)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrNotFound) {
+			a.sendS3Error(c, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+			return
+		}
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to open object: "+err.Error())
+		return
+	}
+	defer reader.Close()
+
+	c.Header("ETag", s3ObjectETag(object.ModifiedAt, object.Size))
+	c.Header("Content-Type", object.ContentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if c.Request.Method == http.MethodGet {
+		a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionFileDownload, VolumeID: volume.ID, Path: filePath})
+	}
+
+	http.ServeContent(c.Writer, c.Request, "", object.ModifiedAt, reader)
+}
+
+// s3PutObject stores the content of an object. The entity tag of the response is the MD5 of the content,
+// as SDKs verify it. A key ending with a slash creates the directory, like the folders of S3 tools.
+func (a *APIStore) s3PutObject(c *gin.Context, team *types.Team, bucket, key, filePath string) {
+	ctx := c.Request.Context()
+
+	volume, client, ok := a.s3VolumeClient(c, team.ID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	ctx, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	body, length := s3RequestBody(c)
+
+	if strings.HasSuffix(key, "/") {
+		if length > 0 {
+			a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", "Folder objects can't have content")
+			return
+		}
+
+		if _, err := client.Mkdir(ctx, filePath, true); err != nil {
+			if errors.Is(err, juicefs.ErrExists) || errors.Is(err, juicefs.ErrNotDirectory) {
+				a.sendS3Error(c, http.StatusConflict, "InvalidRequest", err.Error())
+				return
+			}
+			a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to create directory: "+err.Error())
+			return
+		}
+
+		c.Header("ETag", s3EmptyETag)
+		c.Status(http.StatusOK)
+		return
+	}
+
+	expected, err := s3UploadChecksums(c.Request)
+	if err != nil {
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidDigest", err.Error())
+		return
+	}
+
+	upload, ok := a.s3WriteOptions(c, team, volume, length)
+	if !ok {
+		return
+	}
+	upload.Checksums = expected
+	upload.ContentType = c.ContentType()
+
+	written, checksums, err := client.Upload(ctx, filePath, body, upload.UploadOptions)
+	if err != nil {
+		a.sendS3WriteError(c, err, upload)
+		return
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileUpload, VolumeID: volume.ID, Path: filePath})
+	a.publishFileEvents(ctx, volume, events.VolumeFileUploadedEvent, map[string]any{
+		"size":     written,
+		"checksum": checksums.SHA256,
+	}, filePath)
+
+	c.Header("ETag", `"`+checksums.MD5+`"`)
+	c.Status(http.StatusOK)
+}
+
+// s3WriteOptions returns the size limits of content written to the volume. Sends the error response
+// and returns false when the declared length already exceeds them.
+func (a *APIStore) s3WriteOptions(c *gin.Context, team *types.Team, volume queries.Volume, length int64) (s3Write, bool) {
+	upload := s3Write{}
+	upload.MaxSize = a.config.VolumesMaxUploadBytes
+
+	// Reject uploads declaring a larger body before reading it
+	if upload.MaxSize > 0 && length > upload.MaxSize {
+		a.sendS3Error(c, http.StatusBadRequest, "EntityTooLarge", uploadTooLargeMsg(upload.MaxSize))
+		return upload, false
+	}
+
+	var apiErr *api.APIError
+	upload.SizeLimit, upload.limitMsg, apiErr = a.volumeWriteLimit(c.Request.Context(), team, volume)
+	if apiErr != nil {
+		a.sendS3Error(c, apiErr.Code, "EntityTooLarge", apiErr.ClientMsg)
+		return upload, false
+	}
+
+	return upload, true
+}
+
+// s3Write holds the options of content written to a volume with the message of its size limit.
+type s3Write struct {
+	juicefs.UploadOptions
+
+	limitMsg string
+}
+
+// sendS3WriteError sends the error of content written to a volume.
+func (a *APIStore) sendS3WriteError(c *gin.Context, err error, upload s3Write) {
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.Is(err, juicefs.ErrChecksumMismatch):
+		a.sendS3Error(c, http.StatusBadRequest, "BadDigest", err.Error())
+	case errors.Is(err, juicefs.ErrQuotaExceeded):
+		a.sendS3Error(c, http.StatusBadRequest, "EntityTooLarge", upload.limitMsg)
+	case errors.Is(err, juicefs.ErrUploadTooLarge):
+		a.sendS3Error(c, http.StatusBadRequest, "EntityTooLarge", uploadTooLargeMsg(upload.MaxSize))
+	case errors.As(err, &tooLarge):
+		a.sendS3Error(c, http.StatusBadRequest, "EntityTooLarge", uploadTooLargeMsg(tooLarge.Limit))
+	case errors.Is(err, errMalformedChunk):
+		a.sendS3Error(c, http.StatusBadRequest, "IncompleteBody", err.Error())
+	case errors.Is(err, auth.ErrS3SignatureMismatch):
+		a.sendS3Error(c, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+	case errors.Is(err, auth.ErrS3ContentSHA256Mismatch):
+		a.sendS3Error(c, http.StatusBadRequest, "XAmzContentSHA256Mismatch", err.Error())
+	default:
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to write object: "+err.Error())
+	}
+}
+
+// s3DeleteObject deletes an object, deleting a missing object succeeds like in S3.
+// A key ending with a slash deletes the directory when it's empty.
+func (a *APIStore) s3DeleteObject(c *gin.Context, teamID uuid.UUID, bucket, key, filePath string) {
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	_, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	if err := client.DeleteObject(c.Request.Context(), filePath, strings.HasSuffix(key, "/")); err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to delete object: "+err.Error())
+		return
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionFileDelete, VolumeID: volume.ID, Path: filePath})
+	a.publishFileEvents(c.Request.Context(), volume, events.VolumeFileDeletedEvent, nil, filePath)
+
+	c.Status(http.StatusNoContent)
+}
+
+type s3Delete struct {
+	XMLName xml.Name `xml:"Delete"`
+	Quiet   bool     `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type s3Deleted struct {
+	Key string `xml:"Key"`
+}
+
+type s3DeleteError struct {
+	Key     string `xml:"Key"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type s3DeleteResult struct {
+	XMLName xml.Name        `xml:"DeleteResult"`
+	Xmlns   string          `xml:"xmlns,attr"`
+	Deleted []s3Deleted     `xml:"Deleted"`
+	Errors  []s3DeleteError `xml:"Error"`
+}
+
+// s3DeleteObjects deletes the objects listed in the request, the result reports each key.
+func (a *APIStore) s3DeleteObjects(c *gin.Context, teamID uuid.UUID, bucket string) {
+	ctx := c.Request.Context()
+
+	var req s3Delete
+	err := readS3XML(c.Request, &req)
+	if errors.Is(err, auth.ErrS3ContentSHA256Mismatch) {
+		a.sendS3Error(c, http.StatusBadRequest, "XAmzContentSHA256Mismatch", err.Error())
+		return
+	}
+	if err != nil || len(req.Objects) > s3MaxDeleteObjects {
+		a.sendS3Error(c, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		return
+	}
+
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket)
+	if !ok {
+		return
+	}
+
+	ctx, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	result := s3DeleteResult{Xmlns: s3Namespace}
+	deleted := make([]string, 0, len(req.Objects))
+	for _, object := range req.Objects {
+		filePath, ok := s3ObjectPath(object.Key)
+		if !ok {
+			result.Errors = append(result.Errors, s3DeleteError{Key: object.Key, Code: "InvalidArgument", Message: "Invalid object key"})
+			continue
+		}
+
+		if err := checkVolumeScope(ctx, requestVolumeScope(c), client, volume.ID, filePath); err != nil {
+			result.Errors = append(result.Errors, s3DeleteError{Key: object.Key, Code: "AccessDenied", Message: err.Error()})
+			continue
+		}
+
+		if err := client.DeleteObject(ctx, filePath, strings.HasSuffix(object.Key, "/")); err != nil {
+			result.Errors = append(result.Errors, s3DeleteError{Key: object.Key, Code: "InternalError", Message: err.Error()})
+			continue
+		}
+		deleted = append(deleted, filePath)
+		a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionFileDelete, VolumeID: volume.ID, Path: filePath})
+
+		if !req.Quiet {
+			result.Deleted = append(result.Deleted, s3Deleted{Key: object.Key})
+		}
+	}
+
+	a.publishFileEvents(ctx, volume, events.VolumeFileDeletedEvent, nil, deleted...)
+
+	c.XML(http.StatusOK, result)
+}
+
+type s3InitiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+// s3CreateMultipartUpload starts an upload in parts, the parts are staged like the parts of the uploads API.
+func (a *APIStore) s3CreateMultipartUpload(c *gin.Context, teamID uuid.UUID, bucket, key, filePath string) {
+	if strings.HasSuffix(key, "/") {
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", "Folder objects can't have content")
+		return
+	}
+
+	volume, ok := a.s3Volume(c, teamID, bucket)
+	if !ok {
+		return
+	}
+
+	// The path is checked with its symlinks once the parts are written
+	if err := checkVolumeWriteScope(requestVolumeScope(c), volume.ID); err != nil {
+		a.sendS3ScopeError(c, err)
+		return
+	}
+
+	upload, err := a.sqlcDB.CreateVolumeUpload(c.Request.Context(), queries.CreateVolumeUploadParams{
+		ID:        uploadIDPrefix + id.Generate(),
+		VolumeID:  volume.ID,
+		TeamID:    teamID,
+		Path:      filePath,
+		ExpiresAt: time.Now().Add(uploadExpiration),
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to create upload")
+		return
+	}
+
+	c.XML(http.StatusOK, s3InitiateMultipartUploadResult{
+		Xmlns:    s3Namespace,
+		Bucket:   volume.ID,
+		Key:      key,
+		UploadID: upload.ID,
+	})
+}
+
+// s3UploadPart stores a part of an upload, the entity tag of the part is the SHA-256 of its content.
+func (a *APIStore) s3UploadPart(c *gin.Context, team *types.Team, bucket, filePath string, query url.Values) {
+	ctx := c.Request.Context()
+
+	partNumber, err := strconv.ParseInt(query.Get("partNumber"), 10, 32)
+	if err != nil || partNumber < 1 || partNumber > maxUploadParts {
+		a.sendS3Error(c, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Part number must be an integer between 1 and %d", maxUploadParts))
+		return
+	}
+
+	volume, client, ok := a.s3VolumeClient(c, team.ID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	upload, ok := a.s3Upload(c, volume, filePath, query.Get("uploadId"))
+	if !ok {
+		return
+	}
+
+	ctx, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	body, length := s3RequestBody(c)
+
+	options, ok := a.s3WriteOptions(c, team, volume, length)
+	if !ok {
+		return
+	}
+
+	size, checksum, err := client.WritePart(ctx, upload.ID, int32(partNumber), body, options.SizeLimit, options.MaxSize)
+	if err != nil {
+		a.sendS3WriteError(c, err, options)
+		return
+	}
+
+	_, err = a.sqlcDB.UpsertVolumeUploadPart(ctx, queries.UpsertVolumeUploadPartParams{
+		UploadID:   upload.ID,
+		PartNumber: int32(partNumber),
+		Size:       size,
+		Checksum:   checksum,
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to record upload part")
+		return
+	}
+
+	// Every received part keeps the upload resumable for longer
+	err = a.sqlcDB.TouchVolumeUpload(ctx, queries.TouchVolumeUploadParams{
+		ID:        upload.ID,
+		ExpiresAt: time.Now().Add(uploadExpiration),
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to update upload")
+		return
+	}
+
+	c.Header("ETag", `"`+checksum+`"`)
+	c.Status(http.StatusOK)
+}
+
+type s3CompleteMultipartUpload struct {
+	XMLName xml.Name `xml:"CompleteMultipartUpload"`
+	Parts   []struct {
+		PartNumber int32  `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type s3CompleteMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// s3CompleteMultipartUpload assembles the parts listed in the request into the object,
+// the staged parts that aren't listed are discarded.
+func (a *APIStore) s3CompleteMultipartUpload(c *gin.Context, teamID uuid.UUID, bucket, key, filePath, uploadID string) {
+	ctx := c.Request.Context()
+
+	var req s3CompleteMultipartUpload
+	err := readS3XML(c.Request, &req)
+	if errors.Is(err, auth.ErrS3ContentSHA256Mismatch) {
+		a.sendS3Error(c, http.StatusBadRequest, "XAmzContentSHA256Mismatch", err.Error())
+		return
+	}
+	if err != nil || len(req.Parts) == 0 {
+		a.sendS3Error(c, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		return
+	}
+
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	upload, ok := a.s3Upload(c, volume, filePath, uploadID)
+	if !ok {
+		return
+	}
+
+	staged, err := a.sqlcDB.ListVolumeUploadParts(ctx, upload.ID)
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to list upload parts")
+		return
+	}
+
+	checksums := make(map[int32]queries.VolumeUploadPart, len(staged))
+	for _, part := range staged {
+		checksums[part.PartNumber] = part
+	}
+
+	parts := make([]juicefs.UploadPart, 0, len(req.Parts))
+	for i, part := range req.Parts {
+		if i > 0 && part.PartNumber <= req.Parts[i-1].PartNumber {
+			a.sendS3Error(c, http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order")
+			return
+		}
+
+		stagedPart, ok := checksums[part.PartNumber]
+		if !ok || !strings.EqualFold(strings.Trim(part.ETag, `"`), stagedPart.Checksum) {
+			a.sendS3Error(c, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("Part %d was not uploaded or its entity tag doesn't match", part.PartNumber))
+			return
+		}
+
+		parts = append(parts, juicefs.UploadPart{Number: part.PartNumber, Size: stagedPart.Size})
+	}
+
+	ctx, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	if _, err := client.CompleteUpload(ctx, upload.ID, parts, upload.Path); err != nil {
+		if errors.Is(err, juicefs.ErrPartMissing) {
+			a.sendS3Error(c, http.StatusBadRequest, "InvalidPart", "Upload part is incomplete, upload it again: "+err.Error())
+			return
+		}
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to complete upload: "+err.Error())
+		return
+	}
+
+	_, err = a.sqlcDB.UpdateVolumeUploadStatus(ctx, queries.UpdateVolumeUploadStatusParams{
+		ID:     upload.ID,
+		Status: uploadStatusCompleted,
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to update upload")
+		return
+	}
+
+	object, err := client.StatObject(ctx, upload.Path)
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to get object: "+err.Error())
+		return
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: teamID, Action: audit.ActionFileUpload, VolumeID: volume.ID, Path: upload.Path})
+	a.publishFileEvents(ctx, volume, events.VolumeFileUploadedEvent, map[string]any{
+		"size":      object.Size,
+		"upload_id": upload.ID,
+	}, upload.Path)
+
+	c.XML(http.StatusOK, s3CompleteMultipartUploadResult{
+		Xmlns:    s3Namespace,
+		Location: c.Request.URL.Path,
+		Bucket:   volume.ID,
+		Key:      key,
+		ETag:     s3ObjectETag(object.ModifiedAt, object.Size),
+	})
+}
+
+// s3AbortMultipartUpload aborts an upload and discards its parts.
+func (a *APIStore) s3AbortMultipartUpload(c *gin.Context, teamID uuid.UUID, bucket, filePath, uploadID string) {
+	ctx := c.Request.Context()
+
+	volume, client, ok := a.s3VolumeClient(c, teamID, bucket, filePath)
+	if !ok {
+		return
+	}
+
+	upload, ok := a.s3Upload(c, volume, filePath, uploadID)
+	if !ok {
+		return
+	}
+
+	ctx, finishWrite, ok := a.s3BeginWrite(c, volume)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	if err := client.AbortUpload(ctx, upload.ID); err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to discard upload parts: "+err.Error())
+		return
+	}
+
+	_, err := a.sqlcDB.UpdateVolumeUploadStatus(ctx, queries.UpdateVolumeUploadStatusParams{
+		ID:     upload.ID,
+		Status: uploadStatusAborted,
+	})
+	if err != nil {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to update upload")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// s3Upload resolves an upload in progress of an object. Sends the error response and returns false otherwise.
+func (a *APIStore) s3Upload(c *gin.Context, volume queries.Volume, filePath, uploadID string) (queries.VolumeUpload, bool) {
+	if !strings.HasPrefix(uploadID, uploadIDPrefix) {
+		a.sendS3Error(c, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist")
+		return queries.VolumeUpload{}, false
+	}
+
+	upload, err := a.sqlcDB.GetVolumeUpload(c.Request.Context(), uploadID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		a.sendS3Error(c, http.StatusInternalServerError, "InternalError", "Failed to get upload")
+		return queries.VolumeUpload{}, false
+	}
+
+	// Hide existence from other teams, volumes and objects
+	if err != nil || upload.TeamID != volume.TeamID || upload.VolumeID != volume.ID || upload.Path != filePath ||
+		upload.Status != uploadStatusUploading || time.Now().After(upload.ExpiresAt) {
+		a.sendS3Error(c, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist")
+		return queries.VolumeUpload{}, false
+	}
+
+	return upload, true
+}
+
+// readS3XML decodes the XML document of a request body. The body is read to the end, so its payload hash is verified.
+func readS3XML(r *http.Request, v any) error {
+	if r.Body == nil {
+		return io.ErrUnexpectedEOF
+	}
+
+	body := io.LimitReader(r.Body, s3MaxXMLBody)
+	if err := xml.NewDecoder(body).Decode(v); err != nil {
+		return err
+	}
+
+	_, err := io.Copy(io.Discard, body)
+
+	return err
+}
+
+// s3UploadChecksums returns the hex encoded checksums of the content sent in the headers of an upload.
+// The SHA-256 is the payload hash of signed requests, or the additional checksum of the S3 protocol.
+func s3UploadChecksums(r *http.Request) (juicefs.Checksums, error) {
+	var checksums juicefs.Checksums
+
+	if value := r.Header.Get("Content-MD5"); value != "" {
+		digest, err := base64.StdEncoding.DecodeString(value)
+		if err != nil || len(digest) != md5.Size {
+			return juicefs.Checksums{}, errors.New("the Content-MD5 you specified was invalid")
+		}
+		checksums.MD5 = hex.EncodeToString(digest)
+	}
+
+	// Unsigned and streaming payloads have no hash of the whole content
+	if digest, err := hex.DecodeString(r.Header.Get("X-Amz-Content-Sha256")); err == nil && len(digest) == sha256.Size {
+		checksums.SHA256 = hex.EncodeToString(digest)
+	}
+
+	if value := r.Header.Get("X-Amz-Checksum-Sha256"); value != "" {
+		digest, err := base64.StdEncoding.DecodeString(value)
+		if err != nil || len(digest) != sha256.Size {
+			return juicefs.Checksums{}, errors.New("the x-amz-checksum-sha256 you specified was invalid")
+		}
+		checksums.SHA256 = hex.EncodeToString(digest)
+	}
+
+	return checksums, nil
+}
+
+// s3RequestBody returns the content of an upload request and its declared length, -1 when unknown.
+// Streaming uploads of the SDKs send the content in the aws-chunked encoding and declare its length
+// in x-amz-decoded-content-length, their chunk signatures are verified when S3AuthMiddleware set a verifier.
+func s3RequestBody(c *gin.Context) (io.Reader, int64) {
+	r := c.Request
+	if r.Body == nil {
+		return strings.NewReader(""), 0
+	}
+
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), s3StreamingPrefix) {
+		return r.Body, r.ContentLength
+	}
+
+	length, err := strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64)
+	if err != nil {
+		length = -1
+	}
+
+	body := newAWSChunkedReader(r.Body)
+	if verifier, ok := c.Value(auth.S3ChunkVerifierContextKey).(*auth.S3ChunkVerifier); ok {
+		body.verifier = verifier
+	}
+
+	return body, length
+}
+
+// errMalformedChunk is returned for content that isn't valid aws-chunked encoding.
+var errMalformedChunk = errors.New("malformed aws-chunked content")
+
+// awsChunkedReader decodes content in the aws-chunked encoding. Each chunk is its hex encoded size, optionally
+// followed by the chunk signature, and its data, ended by a chunk of size 0 and the trailing checksums.
+// With a verifier, every chunk must be signed and a chunk with an invalid signature fails the read of its
+// last byte. Trailers are ignored, so are their signatures.
+type awsChunkedReader struct {
+	r        *bufio.Reader
+	verifier *auth.S3ChunkVerifier
+	// remaining is the number of bytes left in the current chunk
+	remaining int64
+	done      bool
+	// signature and hash are the signature and the SHA-256 of the data of the current chunk
+	signature string
+	hash      hash.Hash
+}
+
+func newAWSChunkedReader(r io.Reader) *awsChunkedReader {
+	return &awsChunkedReader{r: bufio.NewReader(r), hash: sha256.New()}
+}
+
+func (r *awsChunkedReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, io.EOF
+	}
+
+	if r.remaining == 0 {
+		size, err := r.readChunkHeader()
+		if err != nil {
+			return 0, err
+		}
+		if size == 0 {
+			// The final chunk is signed too
+			if err := r.verifyChunk(); err != nil {
+				return 0, err
+			}
+			r.done = true
+
+			return 0, io.EOF
+		}
+		r.remaining = size
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+
+	n, err := r.r.Read(p)
+	r.remaining -= int64(n)
+	r.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		return n, fmt.Errorf("%w: content ends within a chunk", errMalformedChunk)
+	}
+	if err != nil {
+		return n, err
+	}
+
+	if r.remaining == 0 {
+		// The data of a chunk ends with a line break
+		var crlf [2]byte
+		if _, err := io.ReadFull(r.r, crlf[:]); err != nil || !bytes.Equal(crlf[:], []byte("\r\n")) {
+			return n, fmt.Errorf("%w: chunk data doesn't end with a line break", errMalformedChunk)
+		}
+
+		if err := r.verifyChunk(); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// verifyChunk checks the signature of the chunk that was read, when the reader has a verifier.
+func (r *awsChunkedReader) verifyChunk() error {
+	defer r.hash.Reset()
+
+	if r.verifier == nil {
+		return nil
+	}
+
+	return r.verifier.Verify(r.hash.Sum(nil), r.signature)
+}
+
+// readChunkHeader reads the header line of a chunk and returns the size of its data.
+func (r *awsChunkedReader) readChunkHeader() (int64, error) {
+	line, err := r.r.ReadSlice('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, bufio.ErrBufferFull) {
+			return 0, fmt.Errorf("%w: invalid chunk header", errMalformedChunk)
+		}
+
+		return 0, err
+	}
+
+	header := strings.TrimSuffix(strings.TrimSuffix(string(line), "\n"), "\r")
+	sizeHex, extension, _ := strings.Cut(header, ";")
+
+	r.signature = strings.TrimPrefix(extension, "chunk-signature=")
+	if r.verifier != nil && (r.signature == "" || r.signature == extension) {
+		return 0, fmt.Errorf("%w: the chunk isn't signed", errMalformedChunk)
+	}
+
+	size, err := strconv.ParseInt(sizeHex, 16, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("%w: invalid chunk size %q", errMalformedChunk, sizeHex)
+	}
+
+	return size, nil
+}
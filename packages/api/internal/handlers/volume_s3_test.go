@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+)
+
+func TestS3ObjectPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key  string
+		path string
+		ok   bool
+	}{
+		{key: "file.txt", path: "/file.txt", ok: true},
+		{key: "dir/file.txt", path: "/dir/file.txt", ok: true},
+		{key: "dir/", path: "/dir", ok: true},
+		{key: "/file.txt", ok: false},
+		{key: "dir//file.txt", ok: false},
+		{key: "dir/./file.txt", ok: false},
+		{key: "../file.txt", ok: false},
+		{key: "dir//", ok: false},
+		{key: "/", ok: false},
+		{key: ".moru-uploads/upl-1/00001", ok: false},
+		{key: "file\x00.txt", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			t.Parallel()
+
+			path, ok := s3ObjectPath(tt.key)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+}
+
+func TestParseS3ListParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("v2", func(t *testing.T) {
+		t.Parallel()
+
+		params, err := parseS3ListParams(url.Values{
+			"list-type":   {"2"},
+			"prefix":      {"logs/"},
+			"delimiter":   {"/"},
+			"max-keys":    {"5000"},
+			"start-after": {"logs/a"},
+		})
+		require.NoError(t, err)
+		assert.True(t, params.v2)
+		assert.Equal(t, "logs/", params.query.Prefix)
+		assert.True(t, params.query.Delimited)
+		assert.Equal(t, s3MaxKeys, params.query.MaxKeys)
+		assert.Equal(t, "logs/a", params.query.StartAfter)
+	})
+
+	t.Run("continuation token takes precedence", func(t *testing.T) {
+		t.Parallel()
+
+		params, err := parseS3ListParams(url.Values{
+			"list-type":          {"2"},
+			"continuation-token": {"bG9ncy9i"},
+			"start-after":        {"logs/a"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "logs/b", params.query.StartAfter)
+	})
+
+	t.Run("v1 marker", func(t *testing.T) {
+		t.Parallel()
+
+		params, err := parseS3ListParams(url.Values{"marker": {"logs/a"}, "max-keys": {"10"}})
+		require.NoError(t, err)
+		assert.False(t, params.v2)
+		assert.Equal(t, "logs/a", params.query.StartAfter)
+		assert.Equal(t, 10, params.query.MaxKeys)
+	})
+
+	for name, query := range map[string]url.Values{
+		"delimiter":          {"delimiter": {","}},
+		"encoding type":      {"encoding-type": {"base64"}},
+		"max keys":           {"max-keys": {"-1"}},
+		"continuation token": {"list-type": {"2"}, "continuation-token": {"%%%"}},
+	} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseS3ListParams(query)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIsS3Listing(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isS3Listing(url.Values{}))
+	assert.True(t, isS3Listing(url.Values{"list-type": {"2"}, "prefix": {"a"}, "x-id": {"ListObjectsV2"}}))
+	assert.False(t, isS3Listing(url.Values{"versioning": {""}}))
+	assert.False(t, isS3Listing(url.Values{"uploads": {""}, "prefix": {"a"}}))
+}
+
+func TestAWSChunkedReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signed chunks", func(t *testing.T) {
+		t.Parallel()
+
+		body := "5;chunk-signature=abc\r\nhello\r\n6;chunk-signature=def\r\n world\r\n0;chunk-signature=ghi\r\n\r\n"
+
+		content, err := io.ReadAll(newAWSChunkedReader(strings.NewReader(body)))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(content))
+	})
+
+	t.Run("unsigned chunks with trailer", func(t *testing.T) {
+		t.Parallel()
+
+		body := "b\r\nhello world\r\n0\r\nx-amz-checksum-crc32:AAAAAA==\r\n\r\n"
+
+		content, err := io.ReadAll(newAWSChunkedReader(strings.NewReader(body)))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(content))
+	})
+
+	t.Run("small reads", func(t *testing.T) {
+		t.Parallel()
+
+		body := "a\r\n0123456789\r\n0\r\n\r\n"
+
+		content, err := io.ReadAll(io.LimitReader(newAWSChunkedReader(strings.NewReader(body)), 100))
+		require.NoError(t, err)
+		assert.Equal(t, "0123456789", string(content))
+
+		r := newAWSChunkedReader(strings.NewReader(body))
+		buf := make([]byte, 3)
+		var read []byte
+		for {
+			n, err := r.Read(buf)
+			read = append(read, buf[:n]...)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "0123456789", string(read))
+	})
+
+	for name, body := range map[string]string{
+		"truncated data":   "a\r\n01234",
+		"missing final":    "5\r\nhello\r\n",
+		"invalid size":     "zz\r\nhello\r\n0\r\n\r\n",
+		"missing newline":  "5\r\nhelloX\r\n0\r\n\r\n",
+		"unterminated hdr": "5",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := io.ReadAll(newAWSChunkedReader(strings.NewReader(body)))
+			assert.ErrorIs(t, err, errMalformedChunk)
+		})
+	}
+}
+
+// s3StreamingUpload signs an upload of the chunks in the aws-chunked encoding like the S3 clients of the SDK.
+func s3StreamingUpload(t *testing.T, credentials aws.Credentials, chunks []string) (*http.Request, []byte) {
+	t.Helper()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	var length int
+	for _, chunk := range chunks {
+		length += len(chunk)
+	}
+
+	r := httptest.NewRequest(http.MethodPut, "https://api.moru.io/s3/data/file.txt", nil)
+	r.Header.Set("Content-Encoding", "aws-chunked")
+	r.Header.Set("X-Amz-Content-Sha256", auth.S3StreamingSignedPayload)
+	r.Header.Set("X-Amz-Decoded-Content-Length", strconv.Itoa(length))
+
+	signer := v4.NewSigner(func(o *v4.SignerOptions) {
+		o.DisableURIPathEscaping = true
+	})
+	require.NoError(t, signer.SignHTTP(ctx, credentials, r, auth.S3StreamingSignedPayload, "s3", "us-east-1", now))
+	r.RequestURI = r.URL.RequestURI()
+
+	_, seed, ok := strings.Cut(r.Header.Get("Authorization"), "Signature=")
+	require.True(t, ok)
+	seedSignature, err := hex.DecodeString(seed)
+	require.NoError(t, err)
+
+	chunkSigner := v4.NewStreamSigner(credentials, "s3", "us-east-1", seedSignature)
+
+	var body bytes.Buffer
+	for _, chunk := range append(chunks, "") {
+		signature, err := chunkSigner.GetSignature(ctx, nil, []byte(chunk), now)
+		require.NoError(t, err)
+		fmt.Fprintf(&body, "%x;chunk-signature=%x\r\n%s\r\n", len(chunk), signature, chunk)
+	}
+
+	return r, body.Bytes()
+}
+
+func TestS3ChunkSignatures(t *testing.T) {
+	t.Parallel()
+
+	credentials := aws.Credentials{AccessKeyID: "MORUTESTACCESSKEY000", SecretAccessKey: "test-secret-access-key"}
+
+	router := gin.New()
+	router.Use(auth.S3AuthMiddleware(func(context.Context, string) (*types.S3Credentials, *api.APIError) {
+		return &types.S3Credentials{Team: &types.Team{}, AccessKeyID: credentials.AccessKeyID, SecretAccessKey: credentials.SecretAccessKey}, nil
+	}))
+	router.PUT("/s3/*path", func(c *gin.Context) {
+		body, _ := s3RequestBody(c)
+
+		content, err := io.ReadAll(body)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+
+			return
+		}
+		c.String(http.StatusOK, string(content))
+	})
+
+	upload := func(r *http.Request, body []byte) *httptest.ResponseRecorder {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		return w
+	}
+
+	t.Run("signed chunks", func(t *testing.T) {
+		t.Parallel()
+
+		r, body := s3StreamingUpload(t, credentials, []string{"hello", " world"})
+
+		w := upload(r, body)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "hello world", w.Body.String())
+	})
+
+	t.Run("tampered chunk", func(t *testing.T) {
+		t.Parallel()
+
+		r, body := s3StreamingUpload(t, credentials, []string{"hello", " world"})
+
+		w := upload(r, bytes.Replace(body, []byte("hello"), []byte("jello"), 1))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), auth.ErrS3SignatureMismatch.Error())
+	})
+
+	t.Run("unsigned chunks", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := s3StreamingUpload(t, credentials, []string{"hello world"})
+
+		w := upload(r, []byte("b\r\nhello world\r\n0\r\n\r\n"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), errMalformedChunk.Error())
+	})
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+)
+
+var (
+	// errOutOfVolumeScope is returned when the credentials of the request don't reach a volume or a path of it.
+	errOutOfVolumeScope = errors.New("the credentials are restricted to another part of the volumes")
+	// errReadOnlyVolumeScope is returned when read-only credentials write a volume.
+	errReadOnlyVolumeScope = errors.New("the credentials can only read the volume")
+)
+
+// requestVolumeScope returns the scope of the credentials of the request, nil when they reach all the volumes of the team.
+func requestVolumeScope(c *gin.Context) *types.VolumeScope {
+	scope, _ := c.Value(auth.VolumeScopeContextKey).(*types.VolumeScope)
+
+	return scope
+}
+
+// checkVolumeWriteScope returns errOutOfVolumeScope when the scope doesn't reach the volume, and
+// errReadOnlyVolumeScope when it only reads it.
+func checkVolumeWriteScope(scope *types.VolumeScope, volumeID string) error {
+	switch {
+	case scope == nil:
+		return nil
+	case scope.VolumeID != volumeID:
+		return errOutOfVolumeScope
+	case scope.ReadOnly:
+		return errReadOnlyVolumeScope
+	}
+
+	return nil
+}
+
+// checkVolumeScope returns errOutOfVolumeScope when the scope doesn't reach the volume or the paths of the volume
+// are outside of its subpath. The paths are checked once their symlinks are followed too, like the operations
+// follow them.
+func checkVolumeScope(ctx context.Context, scope *types.VolumeScope, client *juicefs.Client, volumeID string, paths ...string) error {
+	if scope == nil {
+		return nil
+	}
+
+	if scope.VolumeID != volumeID {
+		return errOutOfVolumeScope
+	}
+
+	if scope.Subpath == "" {
+		return nil
+	}
+
+	for _, filePath := range paths {
+		// The operations could resolve .. after following a symlink, out of the subpath
+		if slices.Contains(strings.Split(filePath, "/"), "..") || !isSameOrNestedPath(path.Clean("/"+filePath), scope.Subpath) {
+			return fmt.Errorf("%w: %s is outside of %s", errOutOfVolumeScope, filePath, scope.Subpath)
+		}
+
+		resolved, err := client.ResolvePath(ctx, filePath)
+		if err != nil {
+			return err
+		}
+		if !isSameOrNestedPath(resolved, scope.Subpath) {
+			return fmt.Errorf("%w: %s links out of %s", errOutOfVolumeScope, filePath, scope.Subpath)
+		}
+	}
+
+	return nil
+}
+
+// sendVolumeScopeError sends the error of checkVolumeScope or checkVolumeWriteScope.
+func (a *APIStore) sendVolumeScopeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, errOutOfVolumeScope), errors.Is(err, errReadOnlyVolumeScope):
+		a.sendAPIStoreError(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, juicefs.ErrSymlinkLoop):
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+	default:
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to resolve the path: "+err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// syncVolumeStatsJob is the background job refreshing the size and file count of the volumes.
+	syncVolumeStatsJob = "volumes.sync-stats"
+
+	// volumeStatsInterval is how often the size and file count of the volumes are refreshed.
+	volumeStatsInterval = 10 * time.Minute
+)
+
+// syncVolumeStats refreshes the size and file count of the available volumes
+// from the JuiceFS directory statistics.
+func (a *APIStore) syncVolumeStats(ctx context.Context, _ jobs.Job) error {
+	volumes, err := a.sqlcDB.GetVolumesByStatus(ctx, "available")
+	if err != nil {
+		return fmt.Errorf("failed to list volumes for stats: %w", err)
+	}
+
+	for _, volume := range volumes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		size, files, err := a.volumeStats(ctx, volume)
+		if err != nil {
+			// Volumes that were never mounted have no files yet
+			if errors.Is(err, juicefs.ErrVolumeNotInitialized) {
+				continue
+			}
+			logger.L().Warn(ctx, "Failed to get volume stats", zap.Error(err), zap.String("volume_id", volume.ID))
+			continue
+		}
+
+		// Skip unchanged volumes, the update bumps updated_at
+		if volume.TotalSizeBytes != nil && *volume.TotalSizeBytes == size &&
+			volume.TotalFileCount != nil && *volume.TotalFileCount == files {
+			continue
+		}
+
+		_, err = a.sqlcDB.UpdateVolumeStats(ctx, queries.UpdateVolumeStatsParams{
+			ID:             volume.ID,
+			TotalSizeBytes: &size,
+			TotalFileCount: &files,
+		})
+		if err != nil {
+			logger.L().Warn(ctx, "Failed to update volume stats", zap.Error(err), zap.String("volume_id", volume.ID))
+		}
+	}
+
+	return nil
+}
+
+// volumeStats returns the size and file count of the volume, the client is released afterwards
+// unless requests use it.
+func (a *APIStore) volumeStats(ctx context.Context, volume queries.Volume) (int64, int64, error) {
+	client, release, err := a.juicefsPool.Open(ctx, juicefsVolume(volume))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer release()
+
+	return client.Stats(ctx)
+}
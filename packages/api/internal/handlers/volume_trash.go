@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/jobs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+const (
+	// reapDeletedVolumesJob is the background job destroying the volumes whose deletion grace period ended.
+	reapDeletedVolumesJob = "volumes.reap-deleted"
+
+	// volumeReaperInterval is how often volumes whose deletion grace period ended are destroyed.
+	volumeReaperInterval = time.Hour
+)
+
+// PostVolumesIdOrNameUndelete restores a volume pending deletion.
+func (a *APIStore) PostVolumesIdOrNameUndelete(c *gin.Context, volumeID api.VolumeIdOrName) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	if volume.Status != "pending_delete" {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s, only volumes pending deletion can be restored", volume.Status))
+		return
+	}
+	if volume.DeleteAfter != nil && time.Now().After(*volume.DeleteAfter) {
+		a.sendAPIStoreError(c, http.StatusConflict, "The deletion grace period of the volume has ended")
+		return
+	}
+
+	restored, err := a.sqlcDB.RestoreVolume(ctx, volume.ID)
+	if err != nil {
+		// The reaper started destroying the volume in the meantime
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusConflict, "The deletion grace period of the volume has ended")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to restore volume")
+		return
+	}
+
+	a.cancelVolumeOperation(ctx, volume, api.Delete)
+
+	logger.L().Info(ctx, "Volume restored",
+		zap.String("volume_id", volume.ID),
+		zap.String("volume_name", volume.Name),
+		zap.String("team_id", team.ID.String()),
+	)
+
+	c.JSON(http.StatusOK, volumeToAPI(restored))
+}
+
+// markVolumePendingDelete moves the volume to the trash, its data is destroyed by the reaper
+// once the grace period ends.
+func (a *APIStore) markVolumePendingDelete(ctx context.Context, volume queries.Volume) *api.APIError {
+	// Deleting again keeps the original grace period
+	if volume.Status == "pending_delete" {
+		return nil
+	}
+
+	deleteAfter := time.Now().AddDate(0, 0, a.config.VolumesDeleteGraceDays)
+	_, err := a.sqlcDB.MarkVolumePendingDelete(ctx, queries.MarkVolumePendingDeleteParams{
+		ID:          volume.ID,
+		DeleteAfter: &deleteAfter,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Volume is %s, must be available", volume.Status),
+			Err:       fmt.Errorf("volume %s is %s", volume.ID, volume.Status),
+		}
+	}
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to update volume status",
+			Err:       fmt.Errorf("failed to mark volume pending deletion: %w", err),
+		}
+	}
+
+	a.createVolumeOperation(ctx, volume, api.Delete, api.VolumeOperationStatePending)
+
+	logger.L().Info(ctx, "Volume pending deletion",
+		zap.String("volume_id", volume.ID),
+		zap.String("volume_name", volume.Name),
+		zap.String("team_id", volume.TeamID.String()),
+		zap.Time("delete_after", deleteAfter),
+	)
+
+	return nil
+}
+
+// destroyVolume destroys the data of a volume marked as deleting and deletes its record,
+// tracking it in the delete operation of the volume.
+func (a *APIStore) destroyVolume(ctx context.Context, volume queries.Volume) error {
+	op := a.startVolumeOperation(ctx, volume, api.Delete)
+
+	// Emit volume.deleted event
+	if a.volEventsDelivery != nil {
+		event := events.NewVolumeEvent(events.VolumeDeletedEvent, volume.ID).
+			WithVolumeName(volume.Name)
+		event.SandboxTeamID = volume.TeamID
+
+		go func() {
+			if err := a.volEventsDelivery.Publish(context.WithoutCancel(ctx), events.DeliveryKey(volume.TeamID), event); err != nil {
+				logger.L().Error(ctx, "Failed to publish volume.deleted event", zap.Error(err), zap.String("volume_id", volume.ID))
+			}
+		}()
+	}
+	logger.L().Info(ctx, "Volume deletion started",
+		zap.String("volume_id", volume.ID),
+		zap.String("volume_name", volume.Name),
+		zap.String("team_id", volume.TeamID.String()),
+	)
+
+	// Destroy JuiceFS volume (data in the bucket, metadata in the bucket or the volumes Redis)
+	metaDeleted := false
+	if a.volumesBucket != "" {
+		destroyCfg := juicefs.FormatConfig{
+			VolumeID:   volume.ID,
+			MetaEngine: storedMetaEngine(volume),
+			RedisDB:    sharedUtils.DerefOrDefault(volume.RedisDb, 0),
+			PoolConfig: juicefs.Config{
+				GCSBucket: cmp.Or(volumeBucket(volume), a.volumesBucket),
+				RedisURL:  a.config.VolumesRedisURL,
+			},
+		}
+		// Best effort - don't fail if destroy fails
+		if err := juicefs.DestroyVolume(ctx, destroyCfg, true); err != nil {
+			logger.L().Warn(ctx, "Failed to destroy volume data",
+				zap.Error(err),
+				zap.String("volume_id", volume.ID))
+		} else {
+			metaDeleted = true
+		}
+	}
+	a.setVolumeOperationProgress(ctx, op, 50)
+
+	// Delete the record
+	if err := a.sqlcDB.DeleteVolume(ctx, volume.ID); err != nil {
+		err = fmt.Errorf("failed to delete volume record: %w", err)
+		a.finishVolumeOperation(ctx, op, api.VolumeOperationStateFailed, err)
+
+		return err
+	}
+
+	// The database is only reused once it's empty, otherwise the orphan reaper deletes and frees it
+	if volume.RedisDb != nil && metaDeleted {
+		a.freeRedisDB(ctx, *volume.RedisDb)
+	}
+	a.finishVolumeOperation(ctx, op, api.VolumeOperationStateSucceeded, nil)
+
+	return nil
+}
+
+// reapDeletedVolumes destroys the volumes whose deletion grace period ended.
+func (a *APIStore) reapDeletedVolumes(ctx context.Context, _ jobs.Job) error {
+	now := time.Now()
+	volumes, err := a.sqlcDB.GetExpiredPendingDeleteVolumes(ctx, &now)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes pending deletion: %w", err)
+	}
+
+	for _, volume := range volumes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// Only the instance claiming the volume destroys it
+		claimed, err := a.sqlcDB.ClaimPendingDeleteVolume(ctx, volume.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			logger.L().Warn(ctx, "Failed to claim volume pending deletion", zap.Error(err), zap.String("volume_id", volume.ID))
+			continue
+		}
+
+		if err := a.destroyVolume(ctx, claimed); err != nil {
+			logger.L().Error(ctx, "Failed to destroy volume pending deletion", zap.Error(err), zap.String("volume_id", volume.ID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,451 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+)
+
+const (
+	uploadIDPrefix = "upl-"
+
+	// uploadExpiration is how long an upload stays resumable after its last received part
+	uploadExpiration = 7 * 24 * time.Hour
+
+	// maxUploadParts limits the number of parts of a single upload
+	maxUploadParts = 10000
+
+	uploadStatusUploading = "uploading"
+	uploadStatusCompleted = "completed"
+	uploadStatusAborted   = "aborted"
+)
+
+// PostVolumesVolumeIDUploads starts a multipart upload into a volume.
+func (a *APIStore) PostVolumesVolumeIDUploads(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var req api.CreateUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate path
+	if !strings.HasPrefix(req.Path, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Path must be absolute")
+		return
+	}
+
+	// Normalize path
+	path := filepath.Clean(req.Path)
+	if path == "/" || path == juicefs.UploadsDir || strings.HasPrefix(path, juicefs.UploadsDir+"/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid upload path")
+		return
+	}
+
+	ctx, volume, finishWrite, ok := a.getWritableVolume(c, team.ID, volumeID)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	upload, err := a.sqlcDB.CreateVolumeUpload(ctx, queries.CreateVolumeUploadParams{
+		ID:        uploadIDPrefix + id.Generate(),
+		VolumeID:  volume.ID,
+		TeamID:    team.ID,
+		Path:      path,
+		ExpiresAt: time.Now().Add(uploadExpiration),
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create upload")
+		return
+	}
+
+	c.JSON(http.StatusCreated, uploadToAPI(upload, nil))
+}
+
+// GetVolumesVolumeIDUploadsUploadID returns the state of a multipart upload, used to resume it.
+func (a *APIStore) GetVolumesVolumeIDUploadsUploadID(c *gin.Context, volumeID string, uploadID api.UploadID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	upload, ok := a.getUpload(c, team.ID, volumeID, uploadID)
+	if !ok {
+		return
+	}
+
+	parts, err := a.sqlcDB.ListVolumeUploadParts(ctx, upload.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list upload parts")
+		return
+	}
+
+	c.JSON(http.StatusOK, uploadToAPI(upload, parts))
+}
+
+// PutVolumesVolumeIDUploadsUploadIDPartsPartNumber stores a part of a multipart upload.
+func (a *APIStore) PutVolumesVolumeIDUploadsUploadIDPartsPartNumber(c *gin.Context, volumeID string, uploadID api.UploadID, partNumber int32) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	if partNumber < 1 || partNumber > maxUploadParts {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Part number must be between 1 and %d", maxUploadParts))
+		return
+	}
+
+	upload, ok := a.getUpload(c, team.ID, volumeID, uploadID)
+	if !ok {
+		return
+	}
+
+	if !a.checkUploadInProgress(c, upload) {
+		return
+	}
+
+	ctx, volume, finishWrite, ok := a.getWritableVolume(c, team.ID, upload.VolumeID)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	maxUpload := a.config.VolumesMaxUploadBytes
+	if maxUpload > 0 && c.Request.ContentLength > maxUpload {
+		a.sendAPIStoreError(c, http.StatusRequestEntityTooLarge, uploadTooLargeMsg(maxUpload))
+		return
+	}
+
+	// Handle empty parts (Content-Length: 0)
+	var body io.Reader = c.Request.Body
+	if body == nil {
+		body = strings.NewReader("")
+	}
+
+	sizeLimit, limitMsg, apiErr := a.volumeWriteLimit(ctx, team, volume)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	size, checksum, err := client.WritePart(ctx, upload.ID, partNumber, body, sizeLimit, maxUpload)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrQuotaExceeded) {
+			a.sendFileError(c, http.StatusRequestEntityTooLarge, err, limitMsg)
+			return
+		}
+		if errors.Is(err, juicefs.ErrUploadTooLarge) {
+			a.sendFileError(c, http.StatusRequestEntityTooLarge, err, uploadTooLargeMsg(maxUpload))
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to upload part: "+err.Error())
+		return
+	}
+
+	part, err := a.sqlcDB.UpsertVolumeUploadPart(ctx, queries.UpsertVolumeUploadPartParams{
+		UploadID:   upload.ID,
+		PartNumber: partNumber,
+		Size:       size,
+		Checksum:   checksum,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to record upload part")
+		return
+	}
+
+	// Every received part keeps the upload resumable for longer
+	err = a.sqlcDB.TouchVolumeUpload(ctx, queries.TouchVolumeUploadParams{
+		ID:        upload.ID,
+		ExpiresAt: time.Now().Add(uploadExpiration),
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to update upload")
+		return
+	}
+
+	c.JSON(http.StatusOK, partToAPI(part))
+}
+
+// PostVolumesVolumeIDUploadsUploadIDComplete assembles the parts of a multipart upload into the destination file.
+func (a *APIStore) PostVolumesVolumeIDUploadsUploadIDComplete(c *gin.Context, volumeID string, uploadID api.UploadID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	upload, ok := a.getUpload(c, team.ID, volumeID, uploadID)
+	if !ok {
+		return
+	}
+
+	if !a.checkUploadInProgress(c, upload) {
+		return
+	}
+
+	ctx, volume, finishWrite, ok := a.getWritableVolume(c, team.ID, upload.VolumeID)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	parts, err := a.sqlcDB.ListVolumeUploadParts(ctx, upload.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list upload parts")
+		return
+	}
+
+	uploadParts, errMsg := orderedUploadParts(parts)
+	if errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	size, err := client.CompleteUpload(ctx, upload.ID, uploadParts, upload.Path)
+	if err != nil {
+		if errors.Is(err, juicefs.ErrPartMissing) {
+			a.sendAPIStoreError(c, http.StatusConflict, "Upload part is incomplete, upload it again: "+err.Error())
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to complete upload: "+err.Error())
+		return
+	}
+
+	_, err = a.sqlcDB.UpdateVolumeUploadStatus(ctx, queries.UpdateVolumeUploadStatusParams{
+		ID:     upload.ID,
+		Status: uploadStatusCompleted,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to update upload")
+		return
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionFileUpload, VolumeID: volume.ID, Path: upload.Path})
+	a.publishFileEvents(ctx, volume, events.VolumeFileUploadedEvent, map[string]any{
+		"size":      size,
+		"upload_id": upload.ID,
+	}, upload.Path)
+
+	c.JSON(http.StatusCreated, api.UploadResponse{
+		Path: upload.Path,
+		Size: size,
+	})
+}
+
+// DeleteVolumesVolumeIDUploadsUploadID aborts a multipart upload and discards its parts.
+func (a *APIStore) DeleteVolumesVolumeIDUploadsUploadID(c *gin.Context, volumeID string, uploadID api.UploadID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	upload, ok := a.getUpload(c, team.ID, volumeID, uploadID)
+	if !ok {
+		return
+	}
+
+	switch upload.Status {
+	case uploadStatusAborted:
+		// Already aborted - that's fine
+		c.Status(http.StatusNoContent)
+		return
+	case uploadStatusCompleted:
+		a.sendAPIStoreError(c, http.StatusConflict, "Upload already completed")
+		return
+	}
+
+	ctx, volume, finishWrite, ok := a.getWritableVolume(c, team.ID, upload.VolumeID)
+	if !ok {
+		return
+	}
+	defer finishWrite()
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	if err := client.AbortUpload(ctx, upload.ID); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to discard upload parts: "+err.Error())
+		return
+	}
+
+	_, err = a.sqlcDB.UpdateVolumeUploadStatus(ctx, queries.UpdateVolumeUploadStatusParams{
+		ID:     upload.ID,
+		Status: uploadStatusAborted,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to update upload")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getWritableVolume resolves a team volume that can be modified through the API and begins the write with the
+// returned context, the returned function finishes it. Sends the error response and returns false otherwise.
+func (a *APIStore) getWritableVolume(c *gin.Context, teamID uuid.UUID, volumeID string) (context.Context, queries.Volume, func(), bool) {
+	ctx := c.Request.Context()
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, teamID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return nil, queries.Volume{}, nil, false
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return nil, queries.Volume{}, nil, false
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return nil, queries.Volume{}, nil, false
+	}
+
+	ctx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+	if !ok {
+		return nil, queries.Volume{}, nil, false
+	}
+
+	return ctx, volume, finishWrite, true
+}
+
+// getUpload resolves an upload of a team volume. Sends the error response and returns false otherwise.
+func (a *APIStore) getUpload(c *gin.Context, teamID uuid.UUID, volumeID, uploadID string) (queries.VolumeUpload, bool) {
+	ctx := c.Request.Context()
+
+	if !strings.HasPrefix(uploadID, uploadIDPrefix) {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Upload not found")
+		return queries.VolumeUpload{}, false
+	}
+
+	upload, err := a.sqlcDB.GetVolumeUpload(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Upload not found")
+			return queries.VolumeUpload{}, false
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get upload")
+		return queries.VolumeUpload{}, false
+	}
+
+	// Hide existence from other teams and volumes
+	if upload.TeamID != teamID || upload.VolumeID != volumeID {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Upload not found")
+		return queries.VolumeUpload{}, false
+	}
+
+	return upload, true
+}
+
+// checkUploadInProgress verifies the upload still accepts parts. Sends the error response and returns false otherwise.
+func (a *APIStore) checkUploadInProgress(c *gin.Context, upload queries.VolumeUpload) bool {
+	switch {
+	case upload.Status != uploadStatusUploading:
+		a.sendAPIStoreError(c, http.StatusConflict, "Upload already "+upload.Status)
+		return false
+	case time.Now().After(upload.ExpiresAt):
+		a.sendAPIStoreError(c, http.StatusNotFound, "Upload expired")
+		return false
+	}
+
+	return true
+}
+
+// orderedUploadParts converts the received parts to the order of the final file.
+// Parts must be numbered from 1 without gaps. Returns an error message for invalid parts.
+func orderedUploadParts(parts []queries.VolumeUploadPart) ([]juicefs.UploadPart, string) {
+	if len(parts) == 0 {
+		return nil, "Upload has no parts"
+	}
+
+	result := make([]juicefs.UploadPart, 0, len(parts))
+	for i, part := range parts {
+		if part.PartNumber != int32(i+1) {
+			return nil, fmt.Sprintf("Upload part %d is missing", i+1)
+		}
+
+		result = append(result, juicefs.UploadPart{
+			Number: part.PartNumber,
+			Size:   part.Size,
+		})
+	}
+
+	return result, ""
+}
+
+// uploadToAPI converts a database upload and its parts to API response.
+func uploadToAPI(upload queries.VolumeUpload, parts []queries.VolumeUploadPart) api.VolumeUpload {
+	apiParts := make([]api.UploadPart, 0, len(parts))
+	for _, part := range parts {
+		apiParts = append(apiParts, partToAPI(part))
+	}
+
+	return api.VolumeUpload{
+		UploadID:  upload.ID,
+		VolumeID:  upload.VolumeID,
+		Path:      upload.Path,
+		Status:    api.VolumeUploadStatus(upload.Status),
+		Parts:     apiParts,
+		CreatedAt: upload.CreatedAt,
+		ExpiresAt: upload.ExpiresAt,
+	}
+}
+
+// partToAPI converts a database upload part to API response.
+func partToAPI(part queries.VolumeUploadPart) api.UploadPart {
+	return api.UploadPart{
+		PartNumber: part.PartNumber,
+		Size:       part.Size,
+		Checksum:   part.Checksum,
+	}
+}
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
 )
 
 // allowedMountPrefixes defines safe mount path prefixes.
@@ -14,6 +18,9 @@ var allowedMountPrefixes = []string{
 	"/volumes/",
 }
 
+// persistHomeMountPath is where the volume persisting the home directory of the default user is mounted.
+const persistHomeMountPath = "/mnt/home"
+
 // ValidateMountPath validates that a mount path is safe and allowed.
 // Returns an error message if invalid, or empty string if valid.
 func ValidateMountPath(path string) string {
@@ -59,3 +66,201 @@ func ValidateMountPath(path string) string {
 
 	return ""
 }
+
+// reservedOverlayPrefixes are guest paths that can't be backed by a volume.
+// They hold kernel interfaces or the scratch space envd uses while mounting the volume.
+var reservedOverlayPrefixes = []string{
+	"/proc",
+	"/sys",
+	"/dev",
+	"/run",
+	"/tmp",
+	"/boot",
+}
+
+// maxOverlayPaths limits the number of volume-backed overlay paths per sandbox.
+const maxOverlayPaths = 8
+
+// ValidateOverlayPaths validates the sandbox paths that should be persisted on the volume.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateOverlayPaths(paths []string, mountPath string) string {
+	if len(paths) > maxOverlayPaths {
+		return fmt.Sprintf("At most %d overlay paths are allowed", maxOverlayPaths)
+	}
+
+	for i, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return "Overlay path must be absolute"
+		}
+
+		if filepath.Clean(path) != path || strings.Contains(path, "..") {
+			return "Overlay path must be canonical (no '..' or '//')"
+		}
+
+		if path == "/" {
+			return "Overlay path cannot be the root directory"
+		}
+
+		for _, prefix := range reservedOverlayPrefixes {
+			if isSameOrNestedPath(path, prefix) {
+				return fmt.Sprintf("Overlay path %s is reserved", prefix)
+			}
+		}
+
+		if isSameOrNestedPath(path, mountPath) || isSameOrNestedPath(mountPath, path) {
+			return "Overlay path cannot overlap the volume mount path"
+		}
+
+		for _, other := range paths[:i] {
+			if isSameOrNestedPath(path, other) || isSameOrNestedPath(other, path) {
+				return "Overlay paths cannot overlap each other"
+			}
+		}
+	}
+
+	return ""
+}
+
+// ValidateVolumeSubpath validates the directory of the volume mounted instead of the whole volume.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateVolumeSubpath(subpath string) string {
+	if !strings.HasPrefix(subpath, "/") {
+		return "Subpath must be an absolute path of the volume"
+	}
+
+	if filepath.Clean(subpath) != subpath || strings.Contains(subpath, "..") {
+		return "Subpath must be canonical (no '..' or '//')"
+	}
+
+	if subpath == "/" {
+		return "Subpath cannot be the root of the volume, omit it to mount the whole volume"
+	}
+
+	// The JuiceFS internal files and the overlays of the sandboxes are in hidden directories at the root
+	if strings.HasPrefix(subpath, "/.") {
+		return "Subpath cannot be in a hidden directory at the root of the volume"
+	}
+
+	return ""
+}
+
+// ValidateTemplateDefaultVolume validates the volume attached to the sandboxes of a template. An empty
+// volume name removes the default volume. Returns an error message if invalid, or empty string if valid.
+func ValidateTemplateDefaultVolume(volume api.TemplateDefaultVolume) string {
+	if volume.VolumeName == "" {
+		return ""
+	}
+
+	if prefix, ok := strings.CutSuffix(volume.VolumeName, "*"); ok {
+		if !volumeNamePrefixPattern.MatchString(prefix) {
+			return "Volume name prefix must be lowercase alphanumeric with hyphens, start with a letter and be at most 63 chars"
+		}
+	} else if !volumeNamePattern.MatchString(volume.VolumeName) {
+		return "Volume name must be lowercase alphanumeric with hyphens, 1-63 chars, or a name prefix ending with *"
+	}
+
+	if volume.MountPath == nil || *volume.MountPath == "" {
+		return "mountPath is required with volumeName"
+	}
+
+	return ValidateMountPath(*volume.MountPath)
+}
+
+// ValidateEphemeralVolume validates the scratch volume created for a sandbox.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateEphemeralVolume(volume api.EphemeralVolume) string {
+	if volume.MountPath == "" {
+		return "mountPath is required with createEphemeralVolume"
+	}
+
+	if volume.SizeLimitBytes != nil && *volume.SizeLimitBytes <= 0 {
+		return "sizeLimitBytes must be positive"
+	}
+
+	return ValidateMountPath(volume.MountPath)
+}
+
+// Bounds for the resources of the processes serving the volume inside the sandbox.
+const (
+	minMountMemoryMB  = volumeoptions.MinMountMemoryMB
+	minMountCPUWeight = 1
+	maxMountCPUWeight = 10000
+)
+
+// ValidateMountResources validates the resource limits for the volume processes against the template memory.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateMountResources(resources *api.VolumeMountResources, templateRAMMB int64) string {
+	if resources == nil {
+		return ""
+	}
+
+	if resources.MemoryMB != nil {
+		memoryMB := int64(*resources.MemoryMB)
+		if memoryMB < minMountMemoryMB {
+			return fmt.Sprintf("Volume mount memory must be at least %d MiB", minMountMemoryMB)
+		}
+
+		// The rest of the memory must stay available to the user processes
+		if memoryMB > templateRAMMB/2 {
+			return fmt.Sprintf("Volume mount memory can't exceed half of the sandbox memory (%d MiB)", templateRAMMB/2)
+		}
+	}
+
+	if resources.CpuWeight != nil && (*resources.CpuWeight < minMountCPUWeight || *resources.CpuWeight > maxMountCPUWeight) {
+		return fmt.Sprintf("Volume mount CPU weight must be between %d and %d", minMountCPUWeight, maxMountCPUWeight)
+	}
+
+	return ""
+}
+
+// ValidateVolumeMountOptions validates the JuiceFS options against the allowlist. The format options are
+// only accepted when the volume is created, they are fixed once it's formatted.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateVolumeMountOptions(options *api.VolumeMountOptions, create bool) string {
+	if options == nil {
+		return ""
+	}
+
+	validate := volumeoptions.ValidateMount
+	if create {
+		validate = volumeoptions.Validate
+	}
+
+	if err := validate(*options); err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+// maxPrewarmPatterns limits the number of glob patterns of the files prewarmed per sandbox.
+const maxPrewarmPatterns = 32
+
+// ValidateVolumePrewarm validates the glob patterns of the files pulled into the volume cache on mount.
+// Returns an error message if invalid, or empty string if valid.
+func ValidateVolumePrewarm(patterns []string) string {
+	if len(patterns) > maxPrewarmPatterns {
+		return fmt.Sprintf("At most %d prewarm patterns are allowed", maxPrewarmPatterns)
+	}
+
+	for _, pattern := range patterns {
+		if pattern == "" || strings.HasPrefix(pattern, "/") {
+			return "Prewarm pattern must be relative to the volume mount path"
+		}
+
+		if filepath.Clean(pattern) != pattern || pattern == ".." || strings.HasPrefix(pattern, "../") {
+			return "Prewarm pattern must be canonical (no '..' or '//')"
+		}
+
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Sprintf("Prewarm pattern %s is invalid", pattern)
+		}
+	}
+
+	return ""
+}
+
+// isSameOrNestedPath reports whether path equals parent or is located inside it.
+func isSameOrNestedPath(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
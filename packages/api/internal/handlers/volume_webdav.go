@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// WebDAVRealm is the realm of the Basic credentials WebDAV clients authenticate with.
+const WebDAVRealm = "Moru volumes"
+
+// WebDAVMethods are the methods WebDAV clients send, the WebDAV routes are registered for each of them.
+var WebDAVMethods = []string{
+	http.MethodOptions,
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPut,
+	http.MethodDelete,
+	"PROPFIND",
+	"PROPPATCH",
+	"MKCOL",
+	"COPY",
+	"MOVE",
+	"LOCK",
+	"UNLOCK",
+}
+
+// webdavWriteMethods modify the volume, they take its write lease like the writes of the files API.
+// LOCK creates an empty file when the locked one doesn't exist.
+var webdavWriteMethods = map[string]bool{
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+	"MKCOL":           true,
+	"COPY":            true,
+	"MOVE":            true,
+	"LOCK":            true,
+}
+
+// webdavAuditActions are the audited actions of the WebDAV methods transferring files.
+var webdavAuditActions = map[string]string{
+	http.MethodGet:    audit.ActionFileDownload,
+	http.MethodPut:    audit.ActionFileUpload,
+	http.MethodDelete: audit.ActionFileDelete,
+}
+
+// VolumeWebDAV serves a volume over WebDAV at /volumes/{volumeID}/webdav, so it can be mounted by
+// file managers and used by WebDAV clients like rclone. The team is authenticated by BasicAuthMiddleware,
+// credentials restricted to a subpath of the volume only reach the entries under it.
+func (a *APIStore) VolumeWebDAV(c *gin.Context) {
+	ctx := c.Request.Context()
+	volumeID := c.Param("volumeID")
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// Verify volume ownership
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	var upload juicefs.UploadOptions
+	var limitMsg string
+	if webdavWriteMethods[c.Request.Method] {
+		writeCtx, finishWrite, ok := a.beginVolumeWrite(c, volume)
+		if !ok {
+			return
+		}
+		defer finishWrite()
+
+		ctx = writeCtx
+
+		// Reject uploads declaring a larger body before reading it, like the upload endpoint
+		upload.MaxSize = a.config.VolumesMaxUploadBytes
+		if upload.MaxSize > 0 && c.Request.ContentLength > upload.MaxSize {
+			a.sendAPIStoreError(c, http.StatusRequestEntityTooLarge, uploadTooLargeMsg(upload.MaxSize))
+			return
+		}
+
+		upload.SizeLimit, limitMsg, apiErr = a.volumeWriteLimit(ctx, team, volume)
+		if apiErr != nil {
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+			return
+		}
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	prefix := "/volumes/" + volumeID + "/webdav"
+
+	scope := requestVolumeScope(c)
+	if err := checkVolumeScope(ctx, scope, client, volume.ID, davRequestPaths(c.Request, prefix)...); err != nil {
+		a.sendVolumeScopeError(c, err)
+		return
+	}
+
+	fs := juicefs.NewDAVFileSystem(client, upload)
+	if c.Request.Body != nil {
+		c.Request.Body = &davRequestBody{ReadCloser: c.Request.Body, fs: fs}
+	}
+
+	var fileSystem webdav.FileSystem = fs
+	if scope != nil {
+		fileSystem = &scopedDAVFileSystem{FileSystem: fs, scope: scope, client: client, volumeID: volume.ID}
+	}
+
+	handler := &webdav.Handler{
+		Prefix:     prefix,
+		FileSystem: fileSystem,
+		LockSystem: a.webdavLockSystem(volume.ID),
+		Logger: func(r *http.Request, err error) {
+			if err != nil {
+				logger.L().Debug(ctx, "WebDAV request failed",
+					zap.String("volume_id", volume.ID),
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.Error(err))
+
+				return
+			}
+
+			if action, ok := webdavAuditActions[r.Method]; ok {
+				a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: action, VolumeID: volume.ID, Path: strings.TrimPrefix(r.URL.Path, prefix)})
+			}
+		},
+	}
+
+	handler.ServeHTTP(&davResponseWriter{ResponseWriter: c.Writer, fs: fs, limitMsg: limitMsg, maxUpload: upload.MaxSize}, c.Request)
+}
+
+// webdavLockSystem returns the WebDAV locks of a volume. The locks are kept in memory by each API instance,
+// they only keep the clients of the same instance from conflicting writes.
+func (a *APIStore) webdavLockSystem(volumeID string) webdav.LockSystem {
+	ls, _ := a.webdavLocks.LoadOrStore(volumeID, webdav.NewMemLS())
+
+	return ls.(webdav.LockSystem)
+}
+
+// davRequestPaths returns the paths of the volume a WebDAV request reaches, the destination of a copy or a move too.
+func davRequestPaths(r *http.Request, prefix string) []string {
+	paths := []string{strings.TrimPrefix(r.URL.Path, prefix)}
+	if destination, err := url.Parse(r.Header.Get("Destination")); err == nil && destination.Path != "" {
+		paths = append(paths, strings.TrimPrefix(destination.Path, prefix))
+	}
+
+	return paths
+}
+
+// scopedDAVFileSystem refuses the entries out of the subpath of the credentials of the request, like the ones
+// a listing reaches through a symlink.
+type scopedDAVFileSystem struct {
+	webdav.FileSystem
+
+	scope    *types.VolumeScope
+	client   *juicefs.Client
+	volumeID string
+}
+
+func (f *scopedDAVFileSystem) check(ctx context.Context, names ...string) error {
+	err := checkVolumeScope(ctx, f.scope, f.client, f.volumeID, names...)
+	if errors.Is(err, errOutOfVolumeScope) {
+		return fmt.Errorf("%w: %w", os.ErrPermission, err)
+	}
+
+	return err
+}
+
+func (f *scopedDAVFileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	if err := f.check(ctx, name); err != nil {
+		return err
+	}
+
+	return f.FileSystem.Mkdir(ctx, name, perm)
+}
+
+func (f *scopedDAVFileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if err := f.check(ctx, name); err != nil {
+		return nil, err
+	}
+
+	return f.FileSystem.OpenFile(ctx, name, flag, perm)
+}
+
+func (f *scopedDAVFileSystem) RemoveAll(ctx context.Context, name string) error {
+	if err := f.check(ctx, name); err != nil {
+		return err
+	}
+
+	return f.FileSystem.RemoveAll(ctx, name)
+}
+
+func (f *scopedDAVFileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	if err := f.check(ctx, oldName, newName); err != nil {
+		return err
+	}
+
+	return f.FileSystem.Rename(ctx, oldName, newName)
+}
+
+func (f *scopedDAVFileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	if err := f.check(ctx, name); err != nil {
+		return nil, err
+	}
+
+	return f.FileSystem.Stat(ctx, name)
+}
+
+// davRequestBody aborts the files written from a request body that couldn't be read completely,
+// so an interrupted upload doesn't replace the file with partial content.
+type davRequestBody struct {
+	io.ReadCloser
+
+	fs *juicefs.DAVFileSystem
+}
+
+func (b *davRequestBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		b.fs.Abort(err)
+	}
+
+	return n, err
+}
+
+// davResponseWriter responds with 413 instead of the 405 of the WebDAV handler when a file exceeded
+// the upload or volume size limit, like the upload endpoint.
+type davResponseWriter struct {
+	gin.ResponseWriter
+
+	fs        *juicefs.DAVFileSystem
+	limitMsg  string
+	maxUpload int64
+	replaced  bool
+}
+
+func (w *davResponseWriter) WriteHeader(code int) {
+	if code == http.StatusMethodNotAllowed {
+		if msg, ok := w.tooLargeMsg(); ok {
+			w.replaced = true
+			w.ResponseWriter.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.ResponseWriter.WriteHeader(http.StatusRequestEntityTooLarge)
+			_, _ = io.WriteString(w.ResponseWriter, msg)
+
+			return
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *davResponseWriter) Write(p []byte) (int, error) {
+	// The status text of the replaced status is dropped
+	if w.replaced {
+		return len(p), nil
+	}
+
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *davResponseWriter) tooLargeMsg() (string, bool) {
+	err := w.fs.WriteErr()
+
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, juicefs.ErrQuotaExceeded):
+		return w.limitMsg, true
+	case errors.Is(err, juicefs.ErrUploadTooLarge):
+		return uploadTooLargeMsg(w.maxUpload), true
+	case errors.As(err, &maxBytesErr):
+		return uploadTooLargeMsg(maxBytesErr.Limit), true
+	}
+
+	return "", false
+}
@@ -4,20 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/audit"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
 	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
 	"github.com/moru-ai/sandbox-infra/packages/db/queries"
-	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 // volumeNamePattern validates volume names (slug format).
@@ -27,10 +33,18 @@ import (
 // - Min 1 char, max 63 chars
 var volumeNamePattern = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
 
-const volumeIDPrefix = "vol-"
+// volumeNamePrefixPattern matches the prefixes of the volume names.
+var volumeNamePrefixPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+
+const (
+	volumeIDPrefix = "vol-"
+
+	volumesDefaultLimit = int32(100)
+	volumesMaxLimit     = int32(100)
+)
 
 // PostVolumes creates a new volume (idempotent by name).
-func (a *APIStore) PostVolumes(c *gin.Context) {
+func (a *APIStore) PostVolumes(c *gin.Context, params api.PostVolumesParams) {
 	ctx := c.Request.Context()
 
 	team, apiErr := a.GetTeam(ctx, c, nil)
@@ -45,6 +59,11 @@ func (a *APIStore) PostVolumes(c *gin.Context) {
 		return
 	}
 
+	if params.DryRun != nil && *params.DryRun {
+		c.JSON(http.StatusAccepted, a.dryRunCreateVolume(ctx, team, req))
+		return
+	}
+
 	// Validate name
 	if req.Name == "" {
 		a.sendAPIStoreError(c, http.StatusBadRequest, "name is required")
@@ -61,6 +80,27 @@ func (a *APIStore) PostVolumes(c *gin.Context) {
 		Name:   req.Name,
 	})
 	if err == nil {
+		if existing.Status == "pending_delete" {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume %s is pending deletion, restore it or delete it permanently first", req.Name))
+			return
+		}
+
+		// Creating a failed volume again prepares it once more
+		if existing.Status == "failed" {
+			retried, err := a.sqlcDB.RetryVolumeCreation(ctx, existing.ID)
+			if err == nil {
+				err = a.enqueueVolumePreparation(ctx, retried)
+			}
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				logger.L().Error(ctx, "Failed to retry volume creation", zap.Error(err), zap.String("volume_id", existing.ID))
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create volume")
+				return
+			}
+			if err == nil {
+				existing = retried
+			}
+		}
+
 		// Volume exists, return it (200 OK for idempotent)
 		c.JSON(http.StatusOK, volumeToAPI(existing))
 		return
@@ -70,53 +110,213 @@ func (a *APIStore) PostVolumes(c *gin.Context) {
 		return
 	}
 
+	if req.SizeLimitBytes != nil && *req.SizeLimitBytes <= 0 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "sizeLimitBytes must be positive")
+		return
+	}
+
+	if errMsg := ValidateVolumeMountOptions(req.MountOptions, true); errMsg != "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	metaEngine, apiErr := a.volumeMetaEngine(req.MetadataEngine)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	if apiErr := a.checkTeamStorageAvailable(ctx, team); apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// The volume is prepared in the background, clients poll it until it's available
+	deletionProtection := req.DeletionProtection != nil && *req.DeletionProtection
+	var mountOptions map[string]string
+	if req.MountOptions != nil {
+		mountOptions = *req.MountOptions
+	}
+	volume, err := a.insertVolume(ctx, team.ID, req.Name, req.SizeLimitBytes, deletionProtection, metaEngine, mountOptions, nil)
+	if err == nil {
+		err = a.enqueueVolumePreparation(ctx, volume)
+	}
+	if err != nil {
+		logger.L().Error(ctx, "Failed to create volume", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create volume")
+		return
+	}
+
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionVolumeCreate, VolumeID: volume.ID})
+
+	c.JSON(http.StatusCreated, volumeToAPI(volume))
+}
+
+// createVolume creates an available volume for the team, prepared in the request, and emits the volume.created event.
+// The volume is failed when it can't be prepared.
+func (a *APIStore) createVolume(ctx context.Context, teamID uuid.UUID, name string, sizeLimit *int64, deletionProtection bool, metaEngine volumestorage.MetaEngine) (queries.Volume, error) {
+	volume, err := a.insertVolume(ctx, teamID, name, sizeLimit, deletionProtection, metaEngine, nil, nil)
+	if err != nil {
+		return queries.Volume{}, err
+	}
+
+	return a.prepareVolume(ctx, volume, true)
+}
+
+// insertVolume records a new volume of the team in the creating status, it's available once prepared.
+// The name and the mount options must be validated and the name not used by another volume of the team,
+// a nil sizeLimit means unlimited. Volumes with Redis metadata get a database of the volumes Redis of their own.
+// The volume is deleted when the sandbox is killed if ephemeralSandboxID is set.
+func (a *APIStore) insertVolume(ctx context.Context, teamID uuid.UUID, name string, sizeLimit *int64, deletionProtection bool, metaEngine volumestorage.MetaEngine, mountOptions map[string]string, ephemeralSandboxID *string) (queries.Volume, error) {
 	// Generate volume ID
 	volumeID := volumeIDPrefix + id.Generate()
 
+	// Volumes in the shared bucket don't record it, so they follow VOLUMES_BUCKET.
+	// The team bucket is created when the volume is prepared.
+	var bucket *string
+	if a.config.VolumesTeamBucketPrefix != "" {
+		teamBucket := juicefs.TeamBucketName(a.config.VolumesTeamBucketPrefix, teamID)
+		bucket = &teamBucket
+	}
+
+	var redisDB *int32
+	if metaEngine == volumestorage.MetaEngineRedis {
+		db, err := a.sqlcDB.AllocateRedisDB(ctx)
+		if err != nil {
+			return queries.Volume{}, fmt.Errorf("allocate redis database: %w", err)
+		}
+		redisDB = &db
+	}
+
 	// Create volume record with status 'creating'
 	volume, err := a.sqlcDB.CreateVolume(ctx, queries.CreateVolumeParams{
-		ID:     volumeID,
-		TeamID: team.ID,
-		Name:   req.Name,
-		Status: "creating",
+		ID:                 volumeID,
+		TeamID:             teamID,
+		Name:               name,
+		Status:             "creating",
+		SizeLimitBytes:     sizeLimit,
+		GcsBucket:          bucket,
+		DeletionProtection: deletionProtection,
+		MetadataEngine:     string(metaEngine),
+		RedisDb:            redisDB,
+		MountOptions:       mountOptions,
+		EphemeralSandboxID: ephemeralSandboxID,
 	})
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create volume")
-		return
+		// No metadata was written to the database yet
+		if redisDB != nil {
+			a.freeRedisDB(context.WithoutCancel(ctx), *redisDB)
+		}
+
+		return queries.Volume{}, fmt.Errorf("create volume: %w", err)
 	}
 
-	// Note: GCS bucket paths are created by envd during first mount.
-	// No GCS operations needed here - just update status to available.
+	return volume, nil
+}
 
-	// Update status to available
-	volume, err = a.sqlcDB.UpdateVolumeStatus(ctx, queries.UpdateVolumeStatusParams{
-		ID:     volumeID,
-		Status: "available",
-	})
+// volumeMetaEngine returns the metadata engine requested for a new volume, SQLite by default.
+// Redis metadata needs the volumes Redis of the deployment.
+func (a *APIStore) volumeMetaEngine(requested *api.VolumeMetadataEngine) (volumestorage.MetaEngine, *api.APIError) {
+	if requested == nil {
+		return volumestorage.MetaEngineSQLite, nil
+	}
+
+	metaEngine, err := volumestorage.ParseMetaEngine(string(*requested))
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to update volume status")
-		return
+		return "", &api.APIError{Code: http.StatusBadRequest, ClientMsg: "metadataEngine must be sqlite or redis", Err: err}
+	}
+
+	if metaEngine == volumestorage.MetaEngineRedis && a.config.VolumesRedisURL == "" {
+		return "", &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Redis metadata is not available, no volumes Redis is configured",
+			Err:       juicefs.ErrRedisNotConfigured,
+		}
+	}
+
+	return metaEngine, nil
+}
+
+// volumeBucket returns the bucket holding the data of the volume, empty for the shared volumes bucket.
+func volumeBucket(volume queries.Volume) string {
+	return sharedUtils.DerefOrDefault(volume.GcsBucket, "")
+}
+
+// storedMetaEngine returns the metadata engine the volume was created with.
+func storedMetaEngine(volume queries.Volume) volumestorage.MetaEngine {
+	if volume.MetadataEngine == string(volumestorage.MetaEngineRedis) {
+		return volumestorage.MetaEngineRedis
 	}
 
-	// Emit volume.created event
-	if a.volEventsDelivery != nil {
-		event := events.NewVolumeEvent(events.VolumeCreatedEvent, volumeID).
-			WithVolumeName(req.Name)
-		event.SandboxTeamID = team.ID
+	return volumestorage.MetaEngineSQLite
+}
 
-		go func() {
-			if err := a.volEventsDelivery.Publish(context.WithoutCancel(ctx), events.DeliveryKey(team.ID), event); err != nil {
-				logger.L().Error(ctx, "Failed to publish volume.created event", zap.Error(err), zap.String("volume_id", volumeID))
+// juicefsVolume returns the volume the file operations open, with its bucket and metadata engine.
+func juicefsVolume(volume queries.Volume) juicefs.Volume {
+	return juicefs.Volume{
+		ID:         volume.ID,
+		Bucket:     volumeBucket(volume),
+		MetaEngine: storedMetaEngine(volume),
+		RedisDB:    sharedUtils.DerefOrDefault(volume.RedisDb, 0),
+	}
+}
+
+// resolvePersistHomeVolume returns the volume to persist the sandbox home directory on.
+// A volume ID must refer to an existing volume, a volume name that isn't used yet creates the volume.
+func (a *APIStore) resolvePersistHomeVolume(ctx context.Context, team *types.Team, idOrName string) (queries.Volume, *api.APIError) {
+	if strings.HasPrefix(idOrName, volumeIDPrefix) {
+		volume, err := a.resolveVolumeByID(ctx, team.ID, idOrName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return queries.Volume{}, &api.APIError{Code: http.StatusNotFound, ClientMsg: "Volume not found", Err: err}
 			}
-		}()
+			return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to get volume", Err: err}
+		}
+
+		return volume, nil
 	}
-	logger.L().Info(ctx, "Volume created",
-		zap.String("volume_id", volumeID),
-		zap.String("volume_name", req.Name),
-		zap.String("team_id", team.ID.String()),
-	)
 
-	c.JSON(http.StatusCreated, volumeToAPI(volume))
+	if !volumeNamePattern.MatchString(idOrName) {
+		return queries.Volume{}, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "persistHome must be a volume ID or a volume name (lowercase alphanumeric with hyphens, 1-63 chars)",
+			Err:       fmt.Errorf("invalid persistHome volume %q", idOrName),
+		}
+	}
+
+	volume, err := a.sqlcDB.GetVolumeByName(ctx, queries.GetVolumeByNameParams{
+		TeamID: team.ID,
+		Name:   idOrName,
+	})
+	if err == nil && volume.Status == "failed" {
+		// The sandbox waits for the volume, it's prepared once more in the request
+		volume, err = a.sqlcDB.RetryVolumeCreation(ctx, volume.ID)
+		if err == nil {
+			volume, err = a.prepareVolume(ctx, volume, true)
+		}
+		if err != nil {
+			return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to create volume", Err: err}
+		}
+
+		return volume, nil
+	}
+	if err == nil {
+		return volume, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to get volume", Err: err}
+	}
+
+	if apiErr := a.checkTeamStorageAvailable(ctx, team); apiErr != nil {
+		return queries.Volume{}, apiErr
+	}
+
+	volume, err = a.createVolume(ctx, team.ID, idOrName, nil, false, volumestorage.MetaEngineSQLite)
+	if err != nil {
+		return queries.Volume{}, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Failed to create volume", Err: err}
+	}
+
+	return volume, nil
 }
 
 // GetVolumes lists all volumes for the authenticated team.
@@ -129,21 +329,51 @@ func (a *APIStore) GetVolumes(c *gin.Context, params api.GetVolumesParams) {
 		return
 	}
 
-	limit := int32(100)
-	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 100 {
-		limit = *params.Limit
+	ascending := params.Order != nil && *params.Order == api.Asc
+
+	pagination, err := utils.NewPagination[queries.Volume](
+		utils.PaginationParams{
+			Limit:     params.Limit,
+			NextToken: params.NextToken,
+		},
+		utils.PaginationConfig{
+			DefaultLimit: volumesDefaultLimit,
+			MaxLimit:     volumesMaxLimit,
+			Ascending:    ascending,
+		},
+	)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+		return
+	}
+
+	// Volumes pending deletion are only listed when filtered for
+	statusFilter := []string{string(api.Creating), string(api.Available), string(api.Deleting)}
+	if params.Status != nil && len(*params.Status) > 0 {
+		statusFilter = nil
+		for _, status := range *params.Status {
+			statusFilter = append(statusFilter, string(status))
+		}
 	}
 
 	volumes, err := a.sqlcDB.ListVolumes(ctx, queries.ListVolumesParams{
 		TeamID:     team.ID,
-		Status:     nil, // All statuses
-		QueryLimit: limit,
+		Status:     statusFilter,
+		NamePrefix: sharedUtils.DerefOrDefault(params.NamePrefix, ""),
+		Ascending:  ascending,
+		CursorTime: pagination.CursorTime(),
+		CursorID:   pagination.CursorID(),
+		QueryLimit: pagination.QueryLimit(),
 	})
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list volumes")
 		return
 	}
 
+	volumes = pagination.ProcessResultsWithHeader(c, volumes, func(v queries.Volume) (time.Time, string) {
+		return v.CreatedAt, v.ID
+	})
+
 	result := make([]api.Volume, len(volumes))
 	for i, v := range volumes {
 		result[i] = volumeToAPI(v)
@@ -175,8 +405,60 @@ func (a *APIStore) GetVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOrNam
 	c.JSON(http.StatusOK, volumeToAPI(volume))
 }
 
-// DeleteVolumesIdOrName deletes a volume by ID or name.
-func (a *APIStore) DeleteVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOrName) {
+// GetVolumesVolumeIDUsage returns the storage usage of a volume, with deduplication and compression statistics.
+func (a *APIStore) GetVolumesVolumeIDUsage(c *gin.Context, volumeID string) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolumeByID(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	// Check if JuiceFS pool is configured
+	if a.juicefsPool == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Volume file operations not available")
+		return
+	}
+
+	client, err := a.juicefsPool.Get(ctx, juicefsVolume(volume))
+	if err != nil {
+		a.sendVolumeClientError(c, err)
+		return
+	}
+
+	usage, err := client.Usage(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to compute usage: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, api.VolumeUsage{
+		VolumeID:         volume.ID,
+		LogicalBytes:     usage.LogicalBytes,
+		ReferencedBytes:  usage.ReferencedBytes,
+		UniqueBytes:      usage.UniqueBytes,
+		StoredBytes:      usage.StoredBytes,
+		FileCount:        usage.Files,
+		DirectoryCount:   usage.Directories,
+		Compression:      usage.Compression,
+		DedupRatio:       usage.DedupRatio(),
+		CompressionRatio: usage.CompressionRatio(),
+	})
+}
+
+// PatchVolumesIdOrName updates the settings of a volume by ID or name.
+func (a *APIStore) PatchVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOrName) {
 	ctx := c.Request.Context()
 
 	team, apiErr := a.GetTeam(ctx, c, nil)
@@ -185,6 +467,12 @@ func (a *APIStore) DeleteVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOr
 		return
 	}
 
+	var req api.UpdateVolumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
 	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -195,26 +483,75 @@ func (a *APIStore) DeleteVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOr
 		return
 	}
 
-	// Emit volume.deleted event
-	if a.volEventsDelivery != nil {
-		event := events.NewVolumeEvent(events.VolumeDeletedEvent, volume.ID).
-			WithVolumeName(volume.Name)
-		event.SandboxTeamID = team.ID
+	if req.DeletionProtection != nil && *req.DeletionProtection != volume.DeletionProtection {
+		// A volume pending deletion would still be destroyed once its grace period ends
+		if *req.DeletionProtection && volume.Status != "available" {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume is %s, must be available", volume.Status))
+			return
+		}
 
-		go func() {
-			if err := a.volEventsDelivery.Publish(context.WithoutCancel(ctx), events.DeliveryKey(team.ID), event); err != nil {
-				logger.L().Error(ctx, "Failed to publish volume.deleted event", zap.Error(err), zap.String("volume_id", volume.ID))
-			}
-		}()
+		volume, err = a.sqlcDB.UpdateVolumeDeletionProtection(ctx, queries.UpdateVolumeDeletionProtectionParams{
+			ID:                 volume.ID,
+			DeletionProtection: *req.DeletionProtection,
+		})
+		if err != nil {
+			logger.L().Error(ctx, "Failed to update volume deletion protection", zap.Error(err), zap.String("volume_id", volume.ID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to update volume")
+			return
+		}
+
+		logger.L().Info(ctx, "Volume deletion protection updated",
+			zap.String("volume_id", volume.ID),
+			zap.Bool("deletion_protection", volume.DeletionProtection),
+			zap.String("team_id", team.ID.String()),
+		)
+	}
+
+	c.JSON(http.StatusOK, volumeToAPI(volume))
+}
+
+// DeleteVolumesIdOrName deletes a volume by ID or name. The volume is pending deletion
+// for the grace period first, unless forced.
+func (a *APIStore) DeleteVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOrName, params api.DeleteVolumesIdOrNameParams) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	volume, err := a.resolveVolume(ctx, team.ID, volumeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, "Volume not found")
+			return
+		}
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get volume")
+		return
+	}
+
+	if volume.DeletionProtection {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume %s has deletion protection, clear it before deleting the volume", volume.Name))
+		return
+	}
+
+	// Failed volumes hold no files, they aren't kept for the grace period
+	force := params.Force != nil && *params.Force
+	if !force && a.config.VolumesDeleteGraceDays > 0 && volume.Status != "failed" {
+		if apiErr := a.markVolumePendingDelete(ctx, volume); apiErr != nil {
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+			return
+		}
+
+		a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionVolumeDelete, VolumeID: volume.ID})
+
+		c.Status(http.StatusNoContent)
+		return
 	}
-	logger.L().Info(ctx, "Volume deletion started",
-		zap.String("volume_id", volume.ID),
-		zap.String("volume_name", volume.Name),
-		zap.String("team_id", team.ID.String()),
-	)
 
 	// Mark as deleting
-	_, err = a.sqlcDB.UpdateVolumeStatus(ctx, queries.UpdateVolumeStatusParams{
+	volume, err = a.sqlcDB.UpdateVolumeStatus(ctx, queries.UpdateVolumeStatusParams{
 		ID:     volume.ID,
 		Status: "deleting",
 	})
@@ -223,28 +560,14 @@ func (a *APIStore) DeleteVolumesIdOrName(c *gin.Context, volumeID api.VolumeIdOr
 		return
 	}
 
-	// Destroy JuiceFS volume (data + metadata in GCS)
-	if a.volumesBucket != "" {
-		destroyCfg := juicefs.FormatConfig{
-			VolumeID: volume.ID,
-			PoolConfig: juicefs.Config{
-				GCSBucket: a.volumesBucket,
-			},
-		}
-		// Best effort - don't fail if destroy fails
-		if err := juicefs.DestroyVolume(ctx, destroyCfg, true); err != nil {
-			logger.L().Warn(ctx, "Failed to destroy volume data",
-				zap.Error(err),
-				zap.String("volume_id", volume.ID))
-		}
-	}
-
-	// Delete the record
-	if err := a.sqlcDB.DeleteVolume(ctx, volume.ID); err != nil {
+	if err := a.destroyVolume(ctx, volume); err != nil {
+		logger.L().Error(ctx, "Failed to delete volume", zap.Error(err), zap.String("volume_id", volume.ID))
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to delete volume")
 		return
 	}
 
+	a.recordAudit(c, audit.Entry{TeamID: team.ID, Action: audit.ActionVolumeDelete, VolumeID: volume.ID})
+
 	c.Status(http.StatusNoContent)
 }
 
@@ -273,10 +596,12 @@ func (a *APIStore) resolveVolume(ctx context.Context, teamID uuid.UUID, idOrName
 // volumeToAPI converts a database volume to API response.
 func volumeToAPI(v queries.Volume) api.Volume {
 	vol := api.Volume{
-		VolumeID:  v.ID,
-		Name:      v.Name,
-		CreatedAt: v.CreatedAt,
-		UpdatedAt: v.UpdatedAt,
+		VolumeID:           v.ID,
+		Name:               v.Name,
+		DeletionProtection: v.DeletionProtection,
+		MetadataEngine:     api.VolumeMetadataEngine(storedMetaEngine(v)),
+		CreatedAt:          v.CreatedAt,
+		UpdatedAt:          v.UpdatedAt,
 	}
 	if v.TotalSizeBytes != nil {
 		vol.TotalSizeBytes = v.TotalSizeBytes
@@ -284,5 +609,20 @@ func volumeToAPI(v queries.Volume) api.Volume {
 	if v.TotalFileCount != nil {
 		vol.TotalFileCount = v.TotalFileCount
 	}
+	if v.SizeLimitBytes != nil {
+		vol.SizeLimitBytes = v.SizeLimitBytes
+	}
+	if v.DeleteAfter != nil {
+		vol.DeleteAfter = v.DeleteAfter
+	}
+	if len(v.MountOptions) > 0 {
+		mountOptions := api.VolumeMountOptions(v.MountOptions)
+		vol.MountOptions = &mountOptions
+	}
+	if v.EphemeralSandboxID != nil {
+		vol.EphemeralSandboxID = v.EphemeralSandboxID
+	}
+	status := api.VolumeStatus(v.Status)
+	vol.Status = &status
 	return vol
 }
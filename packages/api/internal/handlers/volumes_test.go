@@ -1,9 +1,21 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/cfg"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/juicefs"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
 )
 
 func TestVolumeNameValidation(t *testing.T) {
@@ -43,3 +55,479 @@ func TestVolumeNameValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateOverlayPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		isValid bool
+	}{
+		{name: "no paths", paths: nil, isValid: true},
+		{name: "home", paths: []string{"/home"}, isValid: true},
+		{name: "multiple disjoint", paths: []string{"/home", "/var/lib/app", "/opt/cache"}, isValid: true},
+		{name: "relative", paths: []string{"home"}, isValid: false},
+		{name: "root", paths: []string{"/"}, isValid: false},
+		{name: "trailing slash", paths: []string{"/home/"}, isValid: false},
+		{name: "traversal", paths: []string{"/home/../etc"}, isValid: false},
+		{name: "tmp reserved", paths: []string{"/tmp"}, isValid: false},
+		{name: "nested in proc", paths: []string{"/proc/self"}, isValid: false},
+		{name: "prefix of reserved name is allowed", paths: []string{"/devices"}, isValid: true},
+		{name: "overlaps mount path", paths: []string{"/workspace"}, isValid: false},
+		{name: "inside mount path", paths: []string{"/workspace/data/home"}, isValid: false},
+		{name: "nested overlays", paths: []string{"/var", "/var/lib"}, isValid: false},
+		{name: "duplicate", paths: []string{"/home", "/home"}, isValid: false},
+		{name: "too many", paths: []string{"/a", "/b", "/c", "/d", "/e", "/f", "/g", "/h", "/i"}, isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateOverlayPaths(tt.paths, "/workspace/data")
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateOverlayPaths(%v) = %q", tt.paths, errMsg)
+		})
+	}
+}
+
+func TestPersistHomeMountPath(t *testing.T) {
+	assert.Empty(t, ValidateMountPath(persistHomeMountPath))
+
+	// The home directories envd persists must not overlap the volume mount
+	for _, home := range []string{"/home/user", "/root"} {
+		assert.Empty(t, ValidateOverlayPaths([]string{home}, persistHomeMountPath), home)
+	}
+}
+
+func TestValidateMountResources(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name      string
+		resources *api.VolumeMountResources
+		isValid   bool
+	}{
+		{name: "not set", resources: nil, isValid: true},
+		{name: "empty", resources: &api.VolumeMountResources{}, isValid: true},
+		{name: "memory within limit", resources: &api.VolumeMountResources{MemoryMB: int32Ptr(256)}, isValid: true},
+		{name: "memory half of sandbox", resources: &api.VolumeMountResources{MemoryMB: int32Ptr(512)}, isValid: true},
+		{name: "memory at minimum", resources: &api.VolumeMountResources{MemoryMB: int32Ptr(128)}, isValid: true},
+		{name: "memory too low", resources: &api.VolumeMountResources{MemoryMB: int32Ptr(64)}, isValid: false},
+		{name: "memory above half of sandbox", resources: &api.VolumeMountResources{MemoryMB: int32Ptr(513)}, isValid: false},
+		{name: "cpu weight", resources: &api.VolumeMountResources{CpuWeight: int32Ptr(200)}, isValid: true},
+		{name: "cpu weight zero", resources: &api.VolumeMountResources{CpuWeight: int32Ptr(0)}, isValid: false},
+		{name: "cpu weight too high", resources: &api.VolumeMountResources{CpuWeight: int32Ptr(10001)}, isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateMountResources(tt.resources, 1024)
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateMountResources(%+v) = %q", tt.resources, errMsg)
+		})
+	}
+}
+
+func TestValidateVolumeMountOptions(t *testing.T) {
+	options := func(values map[string]string) *api.VolumeMountOptions {
+		o := api.VolumeMountOptions(values)
+		return &o
+	}
+
+	assert.Empty(t, ValidateVolumeMountOptions(nil, false))
+	assert.Empty(t, ValidateVolumeMountOptions(options(map[string]string{"cacheSizeMB": "2048"}), false))
+	assert.Empty(t, ValidateVolumeMountOptions(options(map[string]string{"compression": "zstd", "blockSizeKB": "1024"}), true))
+	assert.NotEmpty(t, ValidateVolumeMountOptions(options(map[string]string{"compression": "zstd"}), false))
+	assert.NotEmpty(t, ValidateVolumeMountOptions(options(map[string]string{"cache-dir": "/"}), true))
+	assert.NotEmpty(t, ValidateVolumeMountOptions(options(map[string]string{"bufferSizeMB": "8"}), false))
+}
+
+func TestValidateVolumeSubpath(t *testing.T) {
+	tests := []struct {
+		name    string
+		subpath string
+		isValid bool
+	}{
+		{name: "directory", subpath: "/datasets", isValid: true},
+		{name: "nested directory", subpath: "/datasets/imagenet", isValid: true},
+		{name: "hidden nested directory", subpath: "/datasets/.cache", isValid: true},
+		{name: "empty", subpath: "", isValid: false},
+		{name: "relative", subpath: "datasets/imagenet", isValid: false},
+		{name: "root", subpath: "/", isValid: false},
+		{name: "traversal", subpath: "/datasets/../etc", isValid: false},
+		{name: "trailing slash", subpath: "/datasets/", isValid: false},
+		{name: "overlays", subpath: "/.moru-overlays/home", isValid: false},
+		{name: "trash", subpath: "/.trash", isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateVolumeSubpath(tt.subpath)
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateVolumeSubpath(%q) = %q", tt.subpath, errMsg)
+		})
+	}
+}
+
+func TestVolumeScope(t *testing.T) {
+	ctx := t.Context()
+
+	assert.NoError(t, checkVolumeScope(ctx, nil, nil, "vol_a", "/secret"))
+	assert.NoError(t, checkVolumeWriteScope(nil, "vol_a"))
+
+	volume := &types.VolumeScope{VolumeID: "vol_a"}
+	assert.NoError(t, checkVolumeScope(ctx, volume, nil, "vol_a", "/secret"))
+	assert.ErrorIs(t, checkVolumeScope(ctx, volume, nil, "vol_b", "/"), errOutOfVolumeScope)
+	assert.NoError(t, checkVolumeWriteScope(volume, "vol_a"))
+	assert.ErrorIs(t, checkVolumeWriteScope(volume, "vol_b"), errOutOfVolumeScope)
+
+	readOnly := &types.VolumeScope{VolumeID: "vol_a", ReadOnly: true}
+	assert.ErrorIs(t, checkVolumeWriteScope(readOnly, "vol_a"), errReadOnlyVolumeScope)
+
+	// The paths out of the subpath are refused before their symlinks are resolved
+	subpath := &types.VolumeScope{VolumeID: "vol_a", Subpath: "/datasets"}
+	for _, filePath := range []string{"/", "/datasets-other/file", "/secret", "/datasets/../secret", "/datasets/imagenet/../../secret"} {
+		assert.ErrorIs(t, checkVolumeScope(ctx, subpath, nil, "vol_a", filePath), errOutOfVolumeScope, filePath)
+	}
+}
+
+func TestDAVRequestPaths(t *testing.T) {
+	prefix := "/volumes/vol_a/webdav"
+
+	r := httptest.NewRequest("PROPFIND", prefix+"/datasets/a%20b", nil)
+	assert.Equal(t, []string{"/datasets/a b"}, davRequestPaths(r, prefix))
+
+	r = httptest.NewRequest("MOVE", prefix+"/datasets/a", nil)
+	r.Header.Set("Destination", "https://api.moru.io"+prefix+"/secret/a")
+	assert.Equal(t, []string{"/datasets/a", "/secret/a"}, davRequestPaths(r, prefix))
+}
+
+func TestValidateTemplateDefaultVolume(t *testing.T) {
+	mountPath := "/workspace/models"
+	reservedPath := "/etc"
+
+	tests := []struct {
+		name    string
+		volume  api.TemplateDefaultVolume
+		isValid bool
+	}{
+		{name: "name", volume: api.TemplateDefaultVolume{VolumeName: "model-cache", MountPath: &mountPath}, isValid: true},
+		{name: "name prefix", volume: api.TemplateDefaultVolume{VolumeName: "model-cache-*", MountPath: &mountPath}, isValid: true},
+		{name: "removed", volume: api.TemplateDefaultVolume{VolumeName: ""}, isValid: true},
+		{name: "invalid name", volume: api.TemplateDefaultVolume{VolumeName: "Model_Cache", MountPath: &mountPath}, isValid: false},
+		{name: "wildcard in the middle", volume: api.TemplateDefaultVolume{VolumeName: "model-*-cache", MountPath: &mountPath}, isValid: false},
+		{name: "wildcard only", volume: api.TemplateDefaultVolume{VolumeName: "*", MountPath: &mountPath}, isValid: false},
+		{name: "missing mount path", volume: api.TemplateDefaultVolume{VolumeName: "model-cache"}, isValid: false},
+		{name: "invalid mount path", volume: api.TemplateDefaultVolume{VolumeName: "model-cache", MountPath: &reservedPath}, isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateTemplateDefaultVolume(tt.volume)
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateTemplateDefaultVolume(%+v) = %q", tt.volume, errMsg)
+		})
+	}
+}
+
+func TestValidateEphemeralVolume(t *testing.T) {
+	size := int64(500 << 30)
+	zero := int64(0)
+
+	tests := []struct {
+		name    string
+		volume  api.EphemeralVolume
+		isValid bool
+	}{
+		{name: "mount path", volume: api.EphemeralVolume{MountPath: "/mnt/scratch"}, isValid: true},
+		{name: "size limit", volume: api.EphemeralVolume{MountPath: "/mnt/scratch", SizeLimitBytes: &size}, isValid: true},
+		{name: "missing mount path", volume: api.EphemeralVolume{}, isValid: false},
+		{name: "reserved mount path", volume: api.EphemeralVolume{MountPath: "/etc"}, isValid: false},
+		{name: "relative mount path", volume: api.EphemeralVolume{MountPath: "scratch"}, isValid: false},
+		{name: "zero size limit", volume: api.EphemeralVolume{MountPath: "/mnt/scratch", SizeLimitBytes: &zero}, isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateEphemeralVolume(tt.volume)
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateEphemeralVolume(%+v) = %q", tt.volume, errMsg)
+		})
+	}
+}
+
+func TestValidateVolumePrewarm(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		isValid  bool
+	}{
+		{name: "no patterns", patterns: nil, isValid: true},
+		{name: "directory", patterns: []string{"data"}, isValid: true},
+		{name: "globs", patterns: []string{"models/*.bin", "cache/[a-f]*"}, isValid: true},
+		{name: "whole volume", patterns: []string{"."}, isValid: true},
+		{name: "empty", patterns: []string{""}, isValid: false},
+		{name: "absolute", patterns: []string{"/workspace/data/models"}, isValid: false},
+		{name: "traversal", patterns: []string{"../etc"}, isValid: false},
+		{name: "not canonical", patterns: []string{"models//*.bin"}, isValid: false},
+		{name: "bad pattern", patterns: []string{"models/[a"}, isValid: false},
+		{name: "too many", patterns: make([]string, maxPrewarmPatterns+1), isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errMsg := ValidateVolumePrewarm(tt.patterns)
+			assert.Equal(t, tt.isValid, errMsg == "", "ValidateVolumePrewarm(%v) = %q", tt.patterns, errMsg)
+		})
+	}
+}
+
+func TestOrderedUploadParts(t *testing.T) {
+	part := func(number int32, size int64) queries.VolumeUploadPart {
+		return queries.VolumeUploadPart{UploadID: "upl-1", PartNumber: number, Size: size}
+	}
+
+	t.Run("contiguous parts", func(t *testing.T) {
+		parts, errMsg := orderedUploadParts([]queries.VolumeUploadPart{part(1, 100), part(2, 100), part(3, 10)})
+		assert.Empty(t, errMsg)
+		assert.Equal(t, []juicefs.UploadPart{{Number: 1, Size: 100}, {Number: 2, Size: 100}, {Number: 3, Size: 10}}, parts)
+	})
+
+	t.Run("no parts", func(t *testing.T) {
+		_, errMsg := orderedUploadParts(nil)
+		assert.NotEmpty(t, errMsg)
+	})
+
+	t.Run("missing first part", func(t *testing.T) {
+		_, errMsg := orderedUploadParts([]queries.VolumeUploadPart{part(2, 100)})
+		assert.Equal(t, "Upload part 1 is missing", errMsg)
+	})
+
+	t.Run("gap between parts", func(t *testing.T) {
+		_, errMsg := orderedUploadParts([]queries.VolumeUploadPart{part(1, 100), part(3, 100)})
+		assert.Equal(t, "Upload part 2 is missing", errMsg)
+	})
+}
+
+func TestVolumeSizeLimit(t *testing.T) {
+	limit := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		maxSize  int64
+		volume   *int64
+		expected int64
+	}{
+		{name: "unlimited", maxSize: 0, volume: nil, expected: 0},
+		{name: "volume limit only", maxSize: 0, volume: limit(100), expected: 100},
+		{name: "maximum only", maxSize: 200, volume: nil, expected: 200},
+		{name: "lower volume limit", maxSize: 200, volume: limit(100), expected: 100},
+		{name: "higher volume limit", maxSize: 200, volume: limit(300), expected: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &APIStore{config: cfg.Config{VolumesMaxSizeBytes: tt.maxSize}}
+			assert.Equal(t, tt.expected, a.volumeSizeLimit(queries.Volume{SizeLimitBytes: tt.volume}))
+		})
+	}
+}
+
+func TestFileModeBits(t *testing.T) {
+	assert.Equal(t, uint32(0o644), fileModeBits(0o644))
+	assert.Equal(t, uint32(0o755), fileModeBits(iofs.ModeDir|0o755))
+	assert.Equal(t, uint32(0o4755), fileModeBits(iofs.ModeSetuid|0o755))
+	assert.Equal(t, uint32(0o1777), fileModeBits(iofs.ModeDir|iofs.ModeSticky|0o777))
+	assert.Equal(t, "0644", fmt.Sprintf("%04o", fileModeBits(0o644)))
+}
+
+func TestUploadContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    *string
+		expected string
+		wantErr  bool
+	}{
+		{name: "not set", param: nil, expected: ""},
+		{name: "image", param: ptr("image/png"), expected: "image/png"},
+		{name: "with parameters", param: ptr("text/markdown; charset=utf-8"), expected: "text/markdown; charset=utf-8"},
+		{name: "invalid", param: ptr("text/"), wantErr: true},
+		{name: "empty", param: ptr(""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, err := uploadContentType(tt.param)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, contentType)
+		})
+	}
+}
+
+func TestFileAttributes(t *testing.T) {
+	attrs, err := fileAttributes(ptr("0775"), ptr(int64(1000)), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, juicefs.Attributes{Mode: ptr(uint16(0o775)), UID: ptr(uint32(1000))}, attrs)
+
+	attrs, err = fileAttributes(ptr("4755"), nil, ptr(int64(0)))
+	assert.NoError(t, err)
+	assert.Equal(t, juicefs.Attributes{Mode: ptr(uint16(0o4755)), GID: ptr(uint32(0))}, attrs)
+
+	attrs, err = fileAttributes(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, attrs.IsZero())
+
+	for name, tt := range map[string]struct {
+		mode     *string
+		uid, gid *int64
+	}{
+		"mode not octal":     {mode: ptr("0789")},
+		"mode too large":     {mode: ptr("17777")},
+		"mode empty":         {mode: ptr("")},
+		"negative uid":       {uid: ptr(int64(-1))},
+		"reserved gid":       {gid: ptr(int64(4294967295))},
+		"gid beyond 32 bits": {gid: ptr(int64(1 << 32))},
+	} {
+		_, err := fileAttributes(tt.mode, tt.uid, tt.gid)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := after.Add(time.Hour)
+
+	query, err := searchQuery(api.GetVolumesVolumeIDFilesSearchParams{
+		Name:           ptr("app"),
+		Glob:           ptr("*.log"),
+		MinSize:        ptr(int64(1)),
+		MaxSize:        ptr(int64(1)),
+		ModifiedAfter:  &after,
+		ModifiedBefore: &before,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, juicefs.SearchQuery{
+		Name:           "app",
+		Glob:           "*.log",
+		MinSize:        ptr(int64(1)),
+		MaxSize:        ptr(int64(1)),
+		ModifiedAfter:  &after,
+		ModifiedBefore: &before,
+	}, query)
+
+	for name, params := range map[string]api.GetVolumesVolumeIDFilesSearchParams{
+		"invalid glob":        {Glob: ptr("[a-")},
+		"empty size range":    {MinSize: ptr(int64(2)), MaxSize: ptr(int64(1))},
+		"empty time range":    {ModifiedAfter: &before, ModifiedBefore: &after},
+		"same time as bounds": {ModifiedAfter: &after, ModifiedBefore: &after},
+	} {
+		_, err := searchQuery(params)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestGrepQuery(t *testing.T) {
+	query, err := grepQuery(api.GetVolumesVolumeIDFilesGrepParams{Pattern: "TODO", IgnoreCase: ptr(true), Glob: ptr("*.go")})
+	assert.NoError(t, err)
+	assert.True(t, query.Pattern.MatchString("// todo: remove"))
+	assert.Equal(t, "*.go", query.Glob)
+	assert.Equal(t, defaultGrepMaxMatches, query.MaxMatches)
+	assert.Equal(t, grepMaxFiles, query.MaxFiles)
+
+	query, err = grepQuery(api.GetVolumesVolumeIDFilesGrepParams{Pattern: "TODO", MaxMatches: ptr(int32(5))})
+	assert.NoError(t, err)
+	assert.False(t, query.Pattern.MatchString("// todo: remove"))
+	assert.Equal(t, 5, query.MaxMatches)
+
+	for name, params := range map[string]api.GetVolumesVolumeIDFilesGrepParams{
+		"invalid pattern": {Pattern: "func ("},
+		"invalid glob":    {Pattern: "func", Glob: ptr("[a-")},
+	} {
+		_, err := grepQuery(params)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestFileErrorReason(t *testing.T) {
+	for err, expected := range map[error]api.FileErrorReason{
+		fmt.Errorf("%w: /a", juicefs.ErrNotFound):                            api.NotFound,
+		juicefs.ErrSourceNotFound:                                            api.NotFound,
+		fmt.Errorf("%w: /a", juicefs.ErrNotEmpty):                            api.NotEmpty,
+		fmt.Errorf("%w: /a", juicefs.ErrIsDirectory):                         api.IsDirectory,
+		fmt.Errorf("%w: a parent of /a/b", juicefs.ErrNotDirectory):          api.NotDirectory,
+		fmt.Errorf("%w: /a", juicefs.ErrDestinationExists):                   api.Exists,
+		fmt.Errorf("%w: %w", juicefs.ErrInvalidArchive, io.ErrUnexpectedEOF): api.InvalidArchive,
+	} {
+		reason, ok := fileErrorReason(err)
+		assert.True(t, ok, err.Error())
+		assert.Equal(t, expected, reason, err.Error())
+	}
+
+	_, ok := fileErrorReason(errors.New("open file: input/output error"))
+	assert.False(t, ok)
+}
+
+func TestSyncManifest(t *testing.T) {
+	checksum := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	manifest, err := syncManifest([]api.FileSyncEntry{
+		{Path: "main.go", Size: 5, Checksum: checksum},
+		{Path: "src/app/..dots", Size: 0, Checksum: checksum},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []juicefs.SyncEntry{
+		{Path: "main.go", Size: 5, Checksum: checksum},
+		{Path: "src/app/..dots", Size: 0, Checksum: checksum},
+	}, manifest)
+
+	for name, files := range map[string][]api.FileSyncEntry{
+		"empty path":       {{Path: "", Checksum: checksum}},
+		"absolute path":    {{Path: "/main.go", Checksum: checksum}},
+		"unclean path":     {{Path: "src//main.go", Checksum: checksum}},
+		"trailing slash":   {{Path: "src/", Checksum: checksum}},
+		"parent path":      {{Path: "../main.go", Checksum: checksum}},
+		"current dir":      {{Path: ".", Checksum: checksum}},
+		"duplicate path":   {{Path: "main.go", Checksum: checksum}, {Path: "main.go", Checksum: checksum}},
+		"negative size":    {{Path: "main.go", Size: -1, Checksum: checksum}},
+		"invalid checksum": {{Path: "main.go", Checksum: "xyz"}},
+		"md5 checksum":     {{Path: "main.go", Checksum: "5d41402abc4b2a76b9719d911017c592"}},
+	} {
+		_, err := syncManifest(files)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestParseUploadChecksums(t *testing.T) {
+	sha256Hex := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	sha256Base64 := "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="
+	md5Base64 := "XUFAKrxLKna5cZ2REBfFkg=="
+
+	tests := []struct {
+		name     string
+		md5      *string
+		sha256   *string
+		expected juicefs.Checksums
+		wantErr  bool
+	}{
+		{name: "no headers"},
+		{name: "content md5", md5: &md5Base64, expected: juicefs.Checksums{MD5: "5d41402abc4b2a76b9719d911017c592"}},
+		{name: "hex sha256", sha256: &sha256Hex, expected: juicefs.Checksums{SHA256: sha256Hex}},
+		{name: "base64 sha256", sha256: &sha256Base64, expected: juicefs.Checksums{SHA256: sha256Hex}},
+		{name: "invalid content md5", md5: &sha256Hex, wantErr: true},
+		{name: "content md5 of wrong size", md5: &sha256Base64, wantErr: true},
+		{name: "sha256 of wrong size", sha256: &md5Base64, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checksums, err := parseUploadChecksums(api.PutVolumesVolumeIDFilesUploadParams{
+				ContentMD5:      tt.md5,
+				XChecksumSha256: tt.sha256,
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, checksums)
+		})
+	}
+}
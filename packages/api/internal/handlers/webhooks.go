@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/webhooks"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// maxTeamWebhooks bounds the webhooks of a team, every event is posted to each of them.
+	maxTeamWebhooks = 10
+
+	// maxWebhookURLLength bounds the length of a webhook URL.
+	maxWebhookURLLength = 2048
+)
+
+// webhookEventTypes validates the event types of a new webhook and returns them sorted without duplicates.
+func webhookEventTypes(eventTypes []api.WebhookEventType) ([]string, error) {
+	if len(eventTypes) == 0 {
+		return nil, fmt.Errorf("at least one event type is required")
+	}
+
+	types := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhooks.EventTypes, string(eventType)) {
+			return nil, fmt.Errorf("invalid event type '%s'", eventType)
+		}
+		types = append(types, string(eventType))
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(types))), nil
+}
+
+// validateWebhookURL returns an error if the events can't be posted to the URL. Only HTTPS is allowed,
+// the deliveries are signed but not encrypted otherwise.
+func validateWebhookURL(rawURL string) error {
+	if len(rawURL) > maxWebhookURLLength {
+		return fmt.Errorf("webhook url must be at most %d characters", maxWebhookURLLength)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return fmt.Errorf("invalid webhook url '%s', it must be an https url without credentials", rawURL)
+	}
+
+	return nil
+}
+
+func webhookToAPI(hook queries.TeamWebhook) api.TeamWebhook {
+	eventTypes := make([]api.WebhookEventType, len(hook.EventTypes))
+	for i, eventType := range hook.EventTypes {
+		eventTypes[i] = api.WebhookEventType(eventType)
+	}
+
+	return api.TeamWebhook{
+		Id:         hook.ID,
+		Url:        hook.Url,
+		EventTypes: eventTypes,
+		CreatedAt:  hook.CreatedAt,
+	}
+}
+
+// GetWebhooks lists the webhooks of the team, secrets are never returned.
+func (a *APIStore) GetWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	hooks, err := a.sqlcDB.ListTeamWebhooks(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list team webhooks", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to list webhooks")
+		return
+	}
+
+	result := make([]api.TeamWebhook, len(hooks))
+	for i, hook := range hooks {
+		result[i] = webhookToAPI(hook)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PostWebhooks creates a team webhook with a new signing secret, the secret is only returned here.
+func (a *APIStore) PostWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	// The secrets are stored encrypted, the deliveries need an events stream
+	if a.secretsEncryptor == nil || a.volEventsDelivery == nil {
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, "Webhooks are not available")
+		return
+	}
+
+	var req api.NewTeamWebhook
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := validateWebhookURL(req.Url); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventTypes, err := webhookEventTypes(req.EventTypes)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	hooks, err := a.sqlcDB.ListTeamWebhooks(ctx, team.ID)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to list team webhooks", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	if len(hooks) >= maxTeamWebhooks {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("a team can have at most %d webhooks", maxTeamWebhooks))
+		return
+	}
+
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		logger.L().Error(ctx, "Failed to generate webhook secret", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	encrypted, err := a.secretsEncryptor.Encrypt([]byte(secret))
+	if err != nil {
+		logger.L().Error(ctx, "Failed to encrypt webhook secret", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	hook, err := a.sqlcDB.CreateTeamWebhook(ctx, queries.CreateTeamWebhookParams{
+		TeamID:          team.ID,
+		Url:             req.Url,
+		EventTypes:      eventTypes,
+		SecretEncrypted: encrypted,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to store team webhook", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	logger.L().Info(ctx, "Team webhook created",
+		zap.String("webhook_id", hook.ID.String()),
+		zap.Strings("event_types", eventTypes),
+		logger.WithTeamID(team.ID.String()),
+	)
+
+	created := webhookToAPI(hook)
+	c.JSON(http.StatusCreated, api.CreatedTeamWebhook{
+		Id:         created.Id,
+		Url:        created.Url,
+		EventTypes: created.EventTypes,
+		Secret:     secret,
+		CreatedAt:  created.CreatedAt,
+	})
+}
+
+// DeleteWebhooksWebhookID deletes a team webhook.
+func (a *APIStore) DeleteWebhooksWebhookID(c *gin.Context, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	deleted, err := a.sqlcDB.DeleteTeamWebhook(ctx, queries.DeleteTeamWebhookParams{
+		TeamID: team.ID,
+		ID:     webhookID,
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Failed to delete team webhook", zap.Error(err), logger.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to delete webhook")
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Webhook not found")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultConcurrency = 1
+	defaultMaxAttempts = 5
+	defaultTimeout     = 10 * time.Minute
+	defaultRetryDelay  = 10 * time.Second
+	maxRetryDelay      = time.Hour
+)
+
+// Job is a claimed background job passed to its handler.
+type Job struct {
+	ID   uuid.UUID
+	Kind string
+	// Payload is the JSON encoded payload the job was enqueued with.
+	Payload []byte
+	// Attempt counts the runs of the job, starting at 1.
+	Attempt     int
+	MaxAttempts int
+}
+
+// Handler runs a job. Returning an error retries the job with a backoff until it runs out of
+// attempts, unless the error is wrapped with Permanent.
+type Handler func(ctx context.Context, job Job) error
+
+// KindConfig configures how the jobs of a kind run.
+type KindConfig struct {
+	// Concurrency limits the jobs of the kind running at once on an API instance, 1 if not set.
+	Concurrency int
+	// MaxAttempts is the default number of runs of a failing job before it's failed, 5 if not set.
+	MaxAttempts int
+	// Timeout cancels the context of a job running longer, 10 minutes if not set.
+	Timeout time.Duration
+	// RetryDelay is the delay before the first retry of a failed job, doubled for each further
+	// retry up to an hour. 10 seconds if not set.
+	RetryDelay time.Duration
+}
+
+func (c KindConfig) withDefaults() KindConfig {
+	if c.Concurrency <= 0 {
+		c.Concurrency = defaultConcurrency
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.RetryDelay <= 0 {
+		c.RetryDelay = defaultRetryDelay
+	}
+
+	return c
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks the error of a job that can't succeed when retried, the job fails right away.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether the error was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+
+	return errors.As(err, &permanent)
+}
+
+// retryDelay returns how long to wait before running a job again after its attempt failed.
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+
+	return min(delay, maxRetryDelay)
+}
+
+// periodicKey returns the unique key of a periodic job for the interval containing now,
+// so the API instances enqueue the job once per interval.
+func periodicKey(now time.Time, interval time.Duration) string {
+	return now.UTC().Truncate(interval).Format(time.RFC3339)
+}
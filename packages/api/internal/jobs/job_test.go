@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryDelay(10*time.Second, 1))
+	assert.Equal(t, 20*time.Second, retryDelay(10*time.Second, 2))
+	assert.Equal(t, 80*time.Second, retryDelay(10*time.Second, 4))
+	assert.Equal(t, maxRetryDelay, retryDelay(10*time.Second, 20))
+	assert.Equal(t, maxRetryDelay, retryDelay(2*time.Hour, 1))
+}
+
+func TestPeriodicKey(t *testing.T) {
+	start := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, periodicKey(start, time.Hour), periodicKey(start.Add(59*time.Minute), time.Hour))
+	assert.NotEqual(t, periodicKey(start, time.Hour), periodicKey(start.Add(time.Hour), time.Hour))
+	assert.Equal(t, "2026-10-16T14:10:00Z", periodicKey(start.Add(13*time.Minute), 10*time.Minute))
+}
+
+func TestPermanent(t *testing.T) {
+	err := errors.New("invalid payload")
+
+	assert.Nil(t, Permanent(nil))
+	assert.False(t, IsPermanent(err))
+	assert.True(t, IsPermanent(Permanent(err)))
+	assert.True(t, IsPermanent(fmt.Errorf("import: %w", Permanent(err))))
+	assert.ErrorIs(t, Permanent(err), err)
+	assert.Equal(t, err.Error(), Permanent(err).Error())
+}
+
+func TestKindConfigDefaults(t *testing.T) {
+	config := KindConfig{}.withDefaults()
+	assert.Equal(t, defaultConcurrency, config.Concurrency)
+	assert.Equal(t, defaultMaxAttempts, config.MaxAttempts)
+	assert.Equal(t, defaultTimeout, config.Timeout)
+	assert.Equal(t, defaultRetryDelay, config.RetryDelay)
+
+	config = KindConfig{Concurrency: 4, MaxAttempts: 1}.withDefaults()
+	assert.Equal(t, 4, config.Concurrency)
+	assert.Equal(t, 1, config.MaxAttempts)
+}
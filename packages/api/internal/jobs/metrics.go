@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	resultSucceeded = "succeeded"
+	resultRetried   = "retried"
+	resultFailed    = "failed"
+)
+
+type metrics struct {
+	finished metric.Int64Counter
+	running  metric.Int64UpDownCounter
+	duration metric.Int64Histogram
+}
+
+func newMetrics(meterProvider metric.MeterProvider) (*metrics, error) {
+	meter := meterProvider.Meter("api.jobs")
+
+	finished, err := telemetry.GetCounter(meter, telemetry.ApiJobsFinishedCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jobs finished counter: %w", err)
+	}
+
+	running, err := telemetry.GetUpDownCounter(meter, telemetry.ApiJobsRunningMeterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jobs running counter: %w", err)
+	}
+
+	duration, err := telemetry.GetHistogram(meter, telemetry.ApiJobsDurationHistogramName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jobs duration histogram: %w", err)
+	}
+
+	return &metrics{
+		finished: finished,
+		running:  running,
+		duration: duration,
+	}, nil
+}
+
+func (m *metrics) started(ctx context.Context, kind string) {
+	m.running.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", kind)))
+}
+
+func (m *metrics) done(ctx context.Context, kind, result string, duration time.Duration) {
+	kindAttr := attribute.String("kind", kind)
+
+	m.running.Add(ctx, -1, metric.WithAttributes(kindAttr))
+	m.finished.Add(ctx, 1, metric.WithAttributes(kindAttr, attribute.String("result", result)))
+	m.duration.Record(ctx, duration.Milliseconds(), metric.WithAttributes(kindAttr))
+}
@@ -0,0 +1,401 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// pollInterval is how often each kind checks for due jobs
+	pollInterval = 5 * time.Second
+
+	// leaseDuration is how long a claimed job is reserved for its instance, the lease is
+	// renewed while the job runs, so jobs of stopped instances are claimed again after it
+	leaseDuration = time.Minute
+
+	// periodicCheckInterval bounds how often periodic jobs are enqueued, deduplicated per interval
+	periodicCheckInterval = time.Minute
+
+	// finishedRetention is how long finished jobs are kept, also deduplicating their unique keys
+	finishedRetention = 7 * 24 * time.Hour
+	cleanupInterval   = time.Hour
+)
+
+type kind struct {
+	name    string
+	handler Handler
+	config  KindConfig
+	slots   chan struct{}
+}
+
+type periodicJob struct {
+	kind     string
+	interval time.Duration
+}
+
+// Queue runs durable background jobs stored in Postgres. Every API instance runs the queue,
+// a job runs on the instance holding its lease.
+type Queue struct {
+	db      *sqlcdb.Client
+	owner   string
+	metrics *metrics
+
+	kinds    map[string]*kind
+	periodic []periodicJob
+	wg       sync.WaitGroup
+}
+
+// EnqueueOption configures an enqueued job.
+type EnqueueOption func(*queries.EnqueueBackgroundJobParams)
+
+// WithUniqueKey enqueues the job only if no job of the kind with the same key was enqueued.
+func WithUniqueKey(key string) EnqueueOption {
+	return func(p *queries.EnqueueBackgroundJobParams) {
+		p.UniqueKey = &key
+	}
+}
+
+// WithRunAfter delays the job until the given time.
+func WithRunAfter(runAfter time.Time) EnqueueOption {
+	return func(p *queries.EnqueueBackgroundJobParams) {
+		p.RunAfter = runAfter
+	}
+}
+
+// WithMaxAttempts overrides the number of runs of the failing job before it's failed.
+func WithMaxAttempts(maxAttempts int) EnqueueOption {
+	return func(p *queries.EnqueueBackgroundJobParams) {
+		p.MaxAttempts = int32(maxAttempts)
+	}
+}
+
+func NewQueue(db *sqlcdb.Client, meterProvider metric.MeterProvider) (*Queue, error) {
+	m, err := newMetrics(meterProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+
+	return &Queue{
+		db:      db,
+		owner:   hostname + "-" + id.Generate(),
+		metrics: m,
+		kinds:   make(map[string]*kind),
+	}, nil
+}
+
+// Register sets the handler of the jobs of a kind, it must be called before Run.
+func (q *Queue) Register(name string, handler Handler, config KindConfig) {
+	config = config.withDefaults()
+
+	q.kinds[name] = &kind{
+		name:    name,
+		handler: handler,
+		config:  config,
+		slots:   make(chan struct{}, config.Concurrency),
+	}
+}
+
+// Every enqueues a job of the kind once per interval, across all API instances.
+// It must be called before Run.
+func (q *Queue) Every(name string, interval time.Duration) {
+	q.periodic = append(q.periodic, periodicJob{kind: name, interval: interval})
+}
+
+// Enqueue adds a job of the kind with the payload encoded as JSON.
+func (q *Queue) Enqueue(ctx context.Context, name string, payload any, opts ...EnqueueOption) error {
+	data := []byte("{}")
+	if payload != nil {
+		var err error
+		data, err = json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode job payload: %w", err)
+		}
+	}
+
+	maxAttempts := defaultMaxAttempts
+	if k, ok := q.kinds[name]; ok {
+		maxAttempts = k.config.MaxAttempts
+	}
+
+	params := queries.EnqueueBackgroundJobParams{
+		Kind:        name,
+		Payload:     data,
+		MaxAttempts: int32(maxAttempts),
+		RunAfter:    time.Now(),
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
+	if _, err := q.db.EnqueueBackgroundJob(ctx, params); err != nil {
+		return fmt.Errorf("failed to enqueue %s job: %w", name, err)
+	}
+
+	return nil
+}
+
+// Run claims and runs the due jobs of the registered kinds until the context is canceled,
+// then waits for the running jobs to return.
+func (q *Queue) Run(ctx context.Context) {
+	logger.L().Info(ctx, "Starting background jobs queue", zap.String("owner", q.owner), zap.Int("kinds", len(q.kinds)))
+
+	for _, k := range q.kinds {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			q.poll(ctx, k)
+		}()
+	}
+
+	for _, p := range q.periodic {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			q.schedule(ctx, p)
+		}()
+	}
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		q.cleanup(ctx)
+	}()
+
+	<-ctx.Done()
+	q.wg.Wait()
+
+	logger.L().Info(ctx, "Background jobs queue stopped")
+}
+
+func (q *Queue) poll(ctx context.Context, k *kind) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.claim(ctx, k)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (q *Queue) claim(ctx context.Context, k *kind) {
+	free := cap(k.slots) - len(k.slots)
+	if free <= 0 {
+		return
+	}
+
+	leaseExpiresAt := time.Now().Add(leaseDuration)
+	claimed, err := q.db.ClaimBackgroundJobs(ctx, queries.ClaimBackgroundJobsParams{
+		LeaseOwner:     &q.owner,
+		LeaseExpiresAt: &leaseExpiresAt,
+		Kind:           k.name,
+		MaxJobs:        int32(free),
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.L().Warn(ctx, "Failed to claim background jobs", zap.Error(err), zap.String("kind", k.name))
+		}
+
+		return
+	}
+
+	for _, job := range claimed {
+		k.slots <- struct{}{}
+		q.wg.Add(1)
+		go func() {
+			defer func() {
+				<-k.slots
+				q.wg.Done()
+			}()
+			q.run(ctx, k, job)
+		}()
+	}
+}
+
+func (q *Queue) run(ctx context.Context, k *kind, record queries.BackgroundJob) {
+	job := Job{
+		ID:          record.ID,
+		Kind:        record.Kind,
+		Payload:     record.Payload,
+		Attempt:     int(record.Attempts),
+		MaxAttempts: int(record.MaxAttempts),
+	}
+	// The finished state is recorded also when the queue is stopping
+	finishCtx := context.WithoutCancel(ctx)
+
+	// Claimed again after its instance stopped during the last attempt
+	if job.Attempt > job.MaxAttempts {
+		q.fail(finishCtx, job, fmt.Errorf("lease expired on the last of %d attempts", job.MaxAttempts))
+
+		return
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, k.config.Timeout)
+	defer cancel()
+	go q.keepLease(jobCtx, cancel, job)
+
+	q.metrics.started(finishCtx, k.name)
+	start := time.Now()
+	err := call(jobCtx, k.handler, job)
+	duration := time.Since(start)
+
+	switch {
+	case err == nil:
+		if err := q.db.CompleteBackgroundJob(finishCtx, queries.CompleteBackgroundJobParams{
+			ID:         job.ID,
+			LeaseOwner: &q.owner,
+		}); err != nil {
+			logger.L().Error(ctx, "Failed to complete background job", zap.Error(err), zap.String("job_id", job.ID.String()))
+		}
+		q.metrics.done(finishCtx, k.name, resultSucceeded, duration)
+	case ctx.Err() != nil:
+		// Stopping, the job is picked up again right away by another instance
+		q.retry(finishCtx, job, time.Now(), err)
+		q.metrics.done(finishCtx, k.name, resultRetried, duration)
+	case IsPermanent(err) || job.Attempt >= job.MaxAttempts:
+		q.fail(finishCtx, job, err)
+		q.metrics.done(finishCtx, k.name, resultFailed, duration)
+	default:
+		q.retry(finishCtx, job, time.Now().Add(retryDelay(k.config.RetryDelay, job.Attempt)), err)
+		q.metrics.done(finishCtx, k.name, resultRetried, duration)
+	}
+}
+
+// call runs the handler, a panicking job fails its attempt instead of the API.
+func call(ctx context.Context, handler Handler, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return handler(ctx, job)
+}
+
+func (q *Queue) retry(ctx context.Context, job Job, runAfter time.Time, jobErr error) {
+	logger.L().Warn(ctx, "Background job failed, retrying",
+		zap.Error(jobErr),
+		zap.String("job_id", job.ID.String()),
+		zap.String("kind", job.Kind),
+		zap.Int("attempt", job.Attempt),
+		zap.Time("run_after", runAfter))
+
+	msg := jobErr.Error()
+	if err := q.db.RetryBackgroundJob(ctx, queries.RetryBackgroundJobParams{
+		ID:         job.ID,
+		LeaseOwner: &q.owner,
+		RunAfter:   runAfter,
+		LastError:  &msg,
+	}); err != nil {
+		logger.L().Error(ctx, "Failed to retry background job", zap.Error(err), zap.String("job_id", job.ID.String()))
+	}
+}
+
+func (q *Queue) fail(ctx context.Context, job Job, jobErr error) {
+	logger.L().Error(ctx, "Background job failed",
+		zap.Error(jobErr),
+		zap.String("job_id", job.ID.String()),
+		zap.String("kind", job.Kind),
+		zap.Int("attempt", job.Attempt))
+
+	msg := jobErr.Error()
+	if err := q.db.FailBackgroundJob(ctx, queries.FailBackgroundJobParams{
+		ID:         job.ID,
+		LeaseOwner: &q.owner,
+		LastError:  &msg,
+	}); err != nil {
+		logger.L().Error(ctx, "Failed to fail background job", zap.Error(err), zap.String("job_id", job.ID.String()))
+	}
+}
+
+// keepLease renews the lease of the running job, canceling it when the lease was lost
+// to another instance.
+func (q *Queue) keepLease(ctx context.Context, cancel context.CancelFunc, job Job) {
+	ticker := time.NewTicker(leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			leaseExpiresAt := time.Now().Add(leaseDuration)
+			renewed, err := q.db.ExtendBackgroundJobLease(ctx, queries.ExtendBackgroundJobLeaseParams{
+				ID:             job.ID,
+				LeaseOwner:     &q.owner,
+				LeaseExpiresAt: &leaseExpiresAt,
+			})
+			if err != nil {
+				logger.L().Warn(ctx, "Failed to renew background job lease", zap.Error(err), zap.String("job_id", job.ID.String()))
+
+				continue
+			}
+
+			if renewed == 0 {
+				logger.L().Warn(ctx, "Background job lease lost, canceling the job", zap.String("job_id", job.ID.String()), zap.String("kind", job.Kind))
+				cancel()
+
+				return
+			}
+		}
+	}
+}
+
+func (q *Queue) schedule(ctx context.Context, p periodicJob) {
+	ticker := time.NewTicker(min(p.interval, periodicCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		if err := q.Enqueue(ctx, p.kind, nil, WithUniqueKey(periodicKey(time.Now(), p.interval))); err != nil && ctx.Err() == nil {
+			logger.L().Warn(ctx, "Failed to enqueue periodic background job", zap.Error(err), zap.String("kind", p.kind))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (q *Queue) cleanup(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			finishedBefore := time.Now().Add(-finishedRetention)
+			deleted, err := q.db.DeleteFinishedBackgroundJobs(ctx, &finishedBefore)
+			if err != nil {
+				logger.L().Warn(ctx, "Failed to delete finished background jobs", zap.Error(err))
+
+				continue
+			}
+
+			if deleted > 0 {
+				logger.L().Debug(ctx, "Deleted finished background jobs", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package juicefs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"path"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/vfs"
+)
+
+// ArchiveFormat is the container format of a directory archive.
+type ArchiveFormat string
+
+const (
+	ArchiveTar   ArchiveFormat = "tar"
+	ArchiveTarGz ArchiveFormat = "tar.gz"
+	ArchiveZip   ArchiveFormat = "zip"
+)
+
+// ContentType returns the MIME type of the archive format.
+func (f ArchiveFormat) ContentType() string {
+	switch f {
+	case ArchiveTarGz:
+		return "application/gzip"
+	case ArchiveZip:
+		return "application/zip"
+	default:
+		return "application/x-tar"
+	}
+}
+
+// archiveWriter adds entries to an archive in a specific format.
+type archiveWriter interface {
+	addDir(name string, mode uint16, modTime time.Time) error
+	addFile(name string, mode uint16, modTime time.Time, size int64, content io.Reader) error
+	addSymlink(name, target string, modTime time.Time) error
+	Close() error
+}
+
+// WriteArchive streams an archive of the directory tree at dirPath to w.
+// Entries are named relative to the parent of dirPath, so the archive unpacks into a single directory.
+// Files are read one at a time, the archive is never held in memory.
+func (c *Client) WriteArchive(ctx context.Context, dirPath string, format ArchiveFormat, w io.Writer) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	if errno != 0 {
+		if errno == syscall.ENOENT {
+			return fmt.Errorf("%w: %s", ErrNotFound, dirPath)
+		}
+		return fmt.Errorf("stat: %s", errno)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%w: %s", ErrNotDirectory, dirPath)
+	}
+
+	var archive archiveWriter
+	switch format {
+	case ArchiveTar:
+		archive = newTarArchive(w, nil)
+	case ArchiveTarGz:
+		gz := gzip.NewWriter(w)
+		archive = newTarArchive(gz, gz)
+	case ArchiveZip:
+		archive = newZipArchive(w)
+	default:
+		return fmt.Errorf("unsupported archive format: %s", format)
+	}
+
+	// The volume root has no name, its content is archived at the top level
+	prefix := path.Base(dirPath)
+	if dirPath == "/" {
+		prefix = ""
+	}
+
+	if prefix != "" {
+		if err := archive.addDir(prefix, uint16(info.Mode().Perm()), info.ModTime()); err != nil {
+			return err
+		}
+	}
+
+	if err := c.archiveDir(ctx, mctx, archive, dirPath, prefix); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// archiveDir adds the entries of a directory to the archive, recursing into subdirectories.
+func (c *Client) archiveDir(ctx context.Context, mctx meta.Context, archive archiveWriter, dirPath, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	f, errno := c.jfs.Open(mctx, dirPath, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", dirPath, errno)
+	}
+	entries, errno := f.ReaddirPlus(mctx, 0)
+	f.Close(mctx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", dirPath, errno)
+	}
+
+	// Sort entries by name so archives of the same tree are identical
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Name) < string(entries[j].Name)
+	})
+
+	for _, entry := range entries {
+		entryPath := path.Join(dirPath, string(entry.Name))
+		entryName := path.Join(name, string(entry.Name))
+		modTime := time.Unix(entry.Attr.Mtime, int64(entry.Attr.Mtimensec))
+
+		switch entry.Attr.Typ {
+		case meta.TypeDirectory:
+			if err := archive.addDir(entryName, entry.Attr.Mode, modTime); err != nil {
+				return err
+			}
+			if err := c.archiveDir(ctx, mctx, archive, entryPath, entryName); err != nil {
+				return err
+			}
+		case meta.TypeSymlink:
+			target, errno := c.jfs.Readlink(mctx, entryPath)
+			if errno != 0 {
+				return fmt.Errorf("read link %s: %s", entryPath, errno)
+			}
+			if err := archive.addSymlink(entryName, string(target), modTime); err != nil {
+				return err
+			}
+		case meta.TypeFile:
+			if err := c.archiveFile(mctx, archive, entryPath, entryName, entry.Attr, modTime); err != nil {
+				return err
+			}
+		default:
+			// Devices, sockets and pipes have no content to archive
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) archiveFile(mctx meta.Context, archive archiveWriter, filePath, name string, attr *meta.Attr, modTime time.Time) error {
+	f, errno := c.jfs.Open(mctx, filePath, vfs.MODE_MASK_R)
+	if errno != 0 {
+		return fmt.Errorf("open file %s: %s", filePath, errno)
+	}
+
+	reader := &jfsReader{
+		file: f,
+		ctx:  mctx,
+		size: int64(attr.Length),
+	}
+	defer reader.Close()
+
+	if err := archive.addFile(name, attr.Mode, modTime, int64(attr.Length), reader); err != nil {
+		return fmt.Errorf("archive %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+// tarArchive writes a tar stream, optionally through a compressor closed with the archive.
+type tarArchive struct {
+	tw         *tar.Writer
+	compressor io.Closer
+}
+
+func newTarArchive(w io.Writer, compressor io.Closer) *tarArchive {
+	return &tarArchive{tw: tar.NewWriter(w), compressor: compressor}
+}
+
+func (a *tarArchive) addDir(name string, mode uint16, modTime time.Time) error {
+	return a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     name + "/",
+		Mode:     int64(mode),
+		ModTime:  modTime,
+		Format:   tar.FormatPAX,
+	})
+}
+
+func (a *tarArchive) addFile(name string, mode uint16, modTime time.Time, size int64, content io.Reader) error {
+	err := a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     int64(mode),
+		Size:     size,
+		ModTime:  modTime,
+		Format:   tar.FormatPAX,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The header announced the size, the content must match it exactly
+	_, err = io.CopyN(a.tw, content, size)
+
+	return err
+}
+
+func (a *tarArchive) addSymlink(name, target string, modTime time.Time) error {
+	return a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeSymlink,
+		Name:     name,
+		Linkname: target,
+		Mode:     0o777,
+		ModTime:  modTime,
+		Format:   tar.FormatPAX,
+	})
+}
+
+func (a *tarArchive) Close() error {
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+
+	if a.compressor != nil {
+		return a.compressor.Close()
+	}
+
+	return nil
+}
+
+// zipArchive writes a zip stream.
+type zipArchive struct {
+	zw *zip.Writer
+}
+
+func newZipArchive(w io.Writer) *zipArchive {
+	return &zipArchive{zw: zip.NewWriter(w)}
+}
+
+func (a *zipArchive) addDir(name string, mode uint16, modTime time.Time) error {
+	header := &zip.FileHeader{Name: name + "/", Modified: modTime}
+	header.SetMode(iofs.ModeDir | iofs.FileMode(mode&0o777))
+
+	_, err := a.zw.CreateHeader(header)
+
+	return err
+}
+
+func (a *zipArchive) addFile(name string, mode uint16, modTime time.Time, _ int64, content io.Reader) error {
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modTime}
+	header.SetMode(iofs.FileMode(mode & 0o777))
+
+	w, err := a.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, content)
+
+	return err
+}
+
+func (a *zipArchive) addSymlink(name, target string, modTime time.Time) error {
+	// Zip stores the link target as the content of a symlink entry
+	header := &zip.FileHeader{Name: name, Method: zip.Store, Modified: modTime}
+	header.SetMode(iofs.ModeSymlink | 0o777)
+
+	w, err := a.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, target)
+
+	return err
+}
+
+func (a *zipArchive) Close() error {
+	return a.zw.Close()
+}
@@ -0,0 +1,90 @@
+package juicefs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	iofs "io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestEntries(t *testing.T, archive archiveWriter) {
+	t.Helper()
+
+	modTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.NoError(t, archive.addDir("data", 0o755, modTime))
+	require.NoError(t, archive.addFile("data/a.txt", 0o644, modTime, 5, strings.NewReader("hello")))
+	require.NoError(t, archive.addSymlink("data/link", "a.txt", modTime))
+	require.NoError(t, archive.Close())
+}
+
+func TestTarArchive(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	writeTestEntries(t, newTarArchive(gz, gz))
+
+	gr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	header, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "data/", header.Name)
+	assert.Equal(t, byte(tar.TypeDir), header.Typeflag)
+
+	header, err = tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "data/a.txt", header.Name)
+	content, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	header, err = tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "data/link", header.Name)
+	assert.Equal(t, "a.txt", header.Linkname)
+
+	_, err = tr.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestZipArchive(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writeTestEntries(t, newZipArchive(&buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 3)
+
+	assert.Equal(t, "data/", zr.File[0].Name)
+	assert.True(t, zr.File[0].Mode().IsDir())
+
+	f, err := zr.File[1].Open()
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+	assert.Equal(t, iofs.FileMode(0o644), zr.File[1].Mode().Perm())
+
+	assert.Equal(t, iofs.ModeSymlink, zr.File[2].Mode()&iofs.ModeSymlink)
+}
+
+func TestArchiveFormatContentType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "application/x-tar", ArchiveTar.ContentType())
+	assert.Equal(t, "application/gzip", ArchiveTarGz.ContentType())
+	assert.Equal(t, "application/zip", ArchiveZip.ContentType())
+}
@@ -0,0 +1,101 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/api/googleapi"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// bucketNamePattern matches the GCS bucket names without dots.
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`)
+
+// TeamBucketName returns the name of the bucket holding the volume data of a team,
+// when volumes are isolated in a bucket per team.
+func TeamBucketName(prefix string, teamID uuid.UUID) string {
+	return prefix + strings.ReplaceAll(teamID.String(), "-", "")
+}
+
+// ValidateBucketName returns an error if name isn't a valid GCS bucket name.
+func ValidateBucketName(name string) error {
+	if !bucketNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid bucket name %q: must be 3-63 lowercase letters, numbers, hyphens or underscores", name)
+	}
+
+	return nil
+}
+
+// BucketExists reports whether the bucket exists.
+func BucketExists(ctx context.Context, name string) (bool, error) {
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return false, fmt.Errorf("create GCS client: %w", err)
+	}
+	defer gcsClient.Close()
+
+	_, err = gcsClient.Bucket(name).Attrs(ctx)
+	if errors.Is(err, storage.ErrBucketNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get bucket attributes: %w", err)
+	}
+
+	return true, nil
+}
+
+// EnsureBucket creates the bucket in the project if it doesn't exist yet.
+func EnsureBucket(ctx context.Context, name, projectID, location string) error {
+	exists, err := BucketExists(ctx, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("create GCS client: %w", err)
+	}
+	defer gcsClient.Close()
+
+	// Same settings as the shared volumes bucket, JuiceFS manages its own data
+	err = gcsClient.Bucket(name).Create(ctx, projectID, &storage.BucketAttrs{
+		Location:                 location,
+		StorageClass:             "STANDARD",
+		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true},
+		PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+		SoftDeletePolicy:         &storage.SoftDeletePolicy{RetentionDuration: 0},
+		Lifecycle: storage.Lifecycle{
+			Rules: []storage.LifecycleRule{{
+				Action:    storage.LifecycleAction{Type: storage.AbortIncompleteMPUAction},
+				Condition: storage.LifecycleCondition{AgeInDays: 7},
+			}},
+		},
+	})
+	if err != nil {
+		// Another request created the bucket in the meantime
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			return nil
+		}
+
+		return fmt.Errorf("create bucket: %w", err)
+	}
+
+	logger.L().Info(ctx, "Volume bucket created",
+		zap.String("bucket", name),
+		zap.String("location", location))
+
+	return nil
+}
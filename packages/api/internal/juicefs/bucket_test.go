@@ -0,0 +1,49 @@
+package juicefs
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamBucketName(t *testing.T) {
+	t.Parallel()
+
+	teamID := uuid.MustParse("0b3e6a3c-5f2d-4e1a-9c7b-8d6f5e4a3b2c")
+
+	name := TeamBucketName("moru-volumes-", teamID)
+	assert.Equal(t, "moru-volumes-0b3e6a3c5f2d4e1a9c7b8d6f5e4a3b2c", name)
+	assert.NoError(t, ValidateBucketName(name))
+
+	// The team ID takes 32 characters of the 63 a bucket name can have
+	assert.NoError(t, ValidateBucketName(TeamBucketName("a234567890123456789012345678901", teamID)))
+	assert.Error(t, ValidateBucketName(TeamBucketName("a2345678901234567890123456789012", teamID)))
+}
+
+func TestValidateBucketName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		bucket  string
+		isValid bool
+	}{
+		{name: "simple", bucket: "moru-volumes", isValid: true},
+		{name: "underscore", bucket: "moru_volumes", isValid: true},
+		{name: "too short", bucket: "ab", isValid: false},
+		{name: "uppercase", bucket: "Moru-volumes", isValid: false},
+		{name: "starts with hyphen", bucket: "-volumes", isValid: false},
+		{name: "ends with hyphen", bucket: "volumes-", isValid: false},
+		{name: "dots", bucket: "moru.volumes", isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateBucketName(tt.bucket)
+			assert.Equal(t, tt.isValid, err == nil, "ValidateBucketName(%q) = %v", tt.bucket, err)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package juicefs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// bucketStore is the object storage of a volumes bucket, for the objects the API manages
+// outside of JuiceFS: the prefix markers, the health checks and deleting volumes.
+type bucketStore interface {
+	// put writes an empty object.
+	put(ctx context.Context, key string) error
+	// delete deletes the object.
+	delete(ctx context.Context, key string) error
+	// deletePrefix deletes all objects under the prefix, the failures are logged and skipped.
+	// Returns the number of objects deleted.
+	deletePrefix(ctx context.Context, prefix string) (int, error)
+	// listPrefixes lists the top level prefixes of the bucket, with their trailing slash.
+	listPrefixes(ctx context.Context) ([]string, error)
+	// probe lists at most one object of the bucket.
+	probe(ctx context.Context) error
+	close() error
+}
+
+// openBucketStore opens the bucket on the storage provider configured for volumes.
+func openBucketStore(ctx context.Context, bucket string) (bucketStore, error) {
+	provider, err := volumestorage.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == volumestorage.S3 {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(os.Getenv(volumestorage.S3RegionEnv)))
+		if err != nil {
+			return nil, fmt.Errorf("load AWS config: %w", err)
+		}
+
+		client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+			// S3 compatible storage like MinIO addresses buckets by their path
+			if endpoint := os.Getenv(volumestorage.S3EndpointEnv); endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+				o.UsePathStyle = true
+			}
+		})
+
+		return &s3BucketStore{client: client, bucket: bucket}, nil
+	}
+
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create GCS client: %w", err)
+	}
+
+	return &gcsBucketStore{client: gcsClient, bucket: gcsClient.Bucket(bucket)}, nil
+}
+
+// litestreamEnv returns the environment of the Litestream commands, Litestream reads the region
+// of S3 replicas from the AWS environment.
+func litestreamEnv() []string {
+	env := os.Environ()
+	if provider, err := volumestorage.FromEnv(); err == nil && provider == volumestorage.S3 {
+		env = append(env, "AWS_REGION="+os.Getenv(volumestorage.S3RegionEnv))
+	}
+
+	return env
+}
+
+type gcsBucketStore struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+}
+
+func (b *gcsBucketStore) put(ctx context.Context, key string) error {
+	writer := b.bucket.Object(key).NewWriter(ctx)
+	if _, err := writer.Write([]byte{}); err != nil {
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+func (b *gcsBucketStore) delete(ctx context.Context, key string) error {
+	return b.bucket.Object(key).Delete(ctx)
+}
+
+func (b *gcsBucketStore) deletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+
+	it := b.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return deleted, fmt.Errorf("list objects: %w", err)
+		}
+
+		if err := b.bucket.Object(attrs.Name).Delete(ctx); err != nil {
+			// Log but continue - best effort deletion
+			logger.L().Debug(ctx, "Failed to delete object",
+				zap.String("object", attrs.Name),
+				zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (b *gcsBucketStore) listPrefixes(ctx context.Context) ([]string, error) {
+	var prefixes []string
+
+	it := b.bucket.Objects(ctx, &storage.Query{Delimiter: "/"})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list prefixes: %w", err)
+		}
+
+		// Objects in the root of the bucket are listed too, without a prefix
+		if attrs.Prefix != "" {
+			prefixes = append(prefixes, attrs.Prefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (b *gcsBucketStore) probe(ctx context.Context) error {
+	// Listing only needs the object permissions volumes already use, unlike reading the bucket attributes
+	it := b.bucket.Objects(ctx, &storage.Query{})
+	it.PageInfo().MaxSize = 1
+	if _, err := it.Next(); err != nil && !errors.Is(err, iterator.Done) {
+		return err
+	}
+
+	return nil
+}
+
+func (b *gcsBucketStore) close() error {
+	return b.client.Close()
+}
+
+type s3BucketStore struct {
+	client *s3.Client
+	bucket string
+}
+
+func (b *s3BucketStore) put(ctx context.Context, key string) error {
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(nil),
+	})
+
+	return err
+}
+
+func (b *s3BucketStore) delete(ctx context.Context, key string) error {
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
+
+func (b *s3BucketStore) deletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+
+	pages := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return deleted, fmt.Errorf("list objects: %w", err)
+		}
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]s3types.ObjectIdentifier, len(page.Contents))
+		for i, obj := range page.Contents {
+			objects[i] = s3types.ObjectIdentifier{Key: obj.Key}
+		}
+
+		// A page is at most 1000 objects, the limit of a batch delete
+		out, err := b.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(b.bucket),
+			Delete: &s3types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("delete objects: %w", err)
+		}
+
+		for _, failed := range out.Errors {
+			// Log but continue - best effort deletion
+			logger.L().Debug(ctx, "Failed to delete object",
+				zap.String("object", aws.ToString(failed.Key)),
+				zap.String("error", aws.ToString(failed.Message)))
+		}
+		deleted += len(objects) - len(out.Errors)
+	}
+
+	return deleted, nil
+}
+
+func (b *s3BucketStore) listPrefixes(ctx context.Context) ([]string, error) {
+	var prefixes []string
+
+	pages := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(b.bucket),
+		Delimiter: aws.String("/"),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list prefixes: %w", err)
+		}
+
+		for _, prefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.ToString(prefix.Prefix))
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (b *s3BucketStore) probe(ctx context.Context) error {
+	_, err := b.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:  aws.String(b.bucket),
+		MaxKeys: aws.Int32(1),
+	})
+
+	return err
+}
+
+func (b *s3BucketStore) close() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package juicefs
+
+import (
+	"crypto/md5" //nolint:gosec // MD5 is used for Content-MD5 integrity checks, not for security
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when uploaded content doesn't match the checksum sent by the client.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Checksums holds the hex encoded digests of file content. Empty digests are unknown.
+type Checksums struct {
+	MD5    string
+	SHA256 string
+}
+
+// IsZero reports whether no digest is set.
+func (c Checksums) IsZero() bool {
+	return c == Checksums{}
+}
+
+// Verify returns ErrChecksumMismatch if a digest set in expected differs from the computed one.
+func (c Checksums) Verify(expected Checksums) error {
+	if expected.MD5 != "" && !strings.EqualFold(expected.MD5, c.MD5) {
+		return fmt.Errorf("%w: content MD5 is %s, expected %s", ErrChecksumMismatch, c.MD5, strings.ToLower(expected.MD5))
+	}
+
+	if expected.SHA256 != "" && !strings.EqualFold(expected.SHA256, c.SHA256) {
+		return fmt.Errorf("%w: content SHA-256 is %s, expected %s", ErrChecksumMismatch, c.SHA256, strings.ToLower(expected.SHA256))
+	}
+
+	return nil
+}
+
+// checksumReader computes the digests of the content read through it, so uploads are hashed
+// while streaming instead of reading the file a second time.
+type checksumReader struct {
+	r      io.Reader
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{
+		r:      r,
+		md5:    md5.New(), //nolint:gosec // see the import
+		sha256: sha256.New(),
+	}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.md5.Write(p[:n])
+		r.sha256.Write(p[:n])
+	}
+
+	return n, err
+}
+
+// Checksums returns the digests of the content read so far.
+func (r *checksumReader) Checksums() Checksums {
+	return Checksums{
+		MD5:    hex.EncodeToString(r.md5.Sum(nil)),
+		SHA256: hex.EncodeToString(r.sha256.Sum(nil)),
+	}
+}
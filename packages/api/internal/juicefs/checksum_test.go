@@ -0,0 +1,33 @@
+package juicefs
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumReader(t *testing.T) {
+	t.Parallel()
+
+	r := newChecksumReader(strings.NewReader("hello"))
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	checksums := r.Checksums()
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", checksums.MD5)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", checksums.SHA256)
+
+	require.NoError(t, checksums.Verify(Checksums{}))
+	require.NoError(t, checksums.Verify(Checksums{MD5: "5D41402ABC4B2A76B9719D911017C592"}))
+	require.NoError(t, checksums.Verify(checksums))
+
+	err = checksums.Verify(Checksums{SHA256: strings.Repeat("0", 64)})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	err = checksums.Verify(Checksums{MD5: checksums.MD5, SHA256: strings.Repeat("0", 64)})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+}
@@ -13,9 +13,11 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/juicedata/juicefs/pkg/chunk"
 	"github.com/juicedata/juicefs/pkg/fs"
 	"github.com/juicedata/juicefs/pkg/meta"
@@ -24,37 +26,115 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 // Config holds configuration for JuiceFS connections.
 type Config struct {
 	// GCSBucket is the GCS bucket name for data and metadata storage
 	GCSBucket string
+
+	// BufferSize is the write buffer of each client in bytes, DefaultBufferSize when 0
+	BufferSize uint64
+
+	// MaxClients is the maximum number of clients cached by the pool, DefaultMaxClients when 0
+	MaxClients int
+
+	// MemoryLimit caps the write buffers of all the clients cached by the pool in bytes, unlimited when 0
+	MemoryLimit uint64
+
+	// MetaReplicas tracks the generation of the metadata replicas, the clients aren't checked for freshness without it
+	MetaReplicas MetaReplicas
+
+	// RedisURL is the URL of the volumes Redis, the volumes with Redis metadata can't be opened without it
+	RedisURL string
+}
+
+// Volume identifies the volume a client opens and where its metadata lives.
+type Volume struct {
+	ID string
+
+	// Bucket holds the volume data, empty for the bucket of the configuration
+	Bucket string
+
+	// MetaEngine is the metadata engine the volume was created with
+	MetaEngine volumestorage.MetaEngine
+
+	// RedisDB is the database of the volumes Redis with the metadata, for the volumes with Redis metadata
+	RedisDB int32
+}
+
+const (
+	// DefaultBufferSize is the write buffer of a client when the configuration doesn't set one.
+	DefaultBufferSize uint64 = 300 << 20
+
+	// DefaultMaxClients is the maximum number of clients cached by the pool when the configuration doesn't set one.
+	DefaultMaxClients = 32
+)
+
+// bufferSize returns the write buffer of each client.
+func (c Config) bufferSize() uint64 {
+	if c.BufferSize == 0 {
+		return DefaultBufferSize
+	}
+
+	return c.BufferSize
+}
+
+// maxClients returns how many clients fit in the pool, at least one.
+func (c Config) maxClients() int {
+	maxClients := c.MaxClients
+	if maxClients <= 0 {
+		maxClients = DefaultMaxClients
+	}
+
+	if c.MemoryLimit > 0 {
+		byMemory := c.MemoryLimit / c.bufferSize()
+		if byMemory < uint64(maxClients) {
+			maxClients = int(byMemory)
+		}
+	}
+
+	return max(maxClients, 1)
 }
 
 // FileInfo represents metadata about a file or directory.
 type FileInfo struct {
 	Name       string
 	Path       string
-	Type       string // "file" or "directory"
+	Type       string // "file", "directory" or "symlink"
 	Size       int64
 	ModifiedAt time.Time
+	// LinkTarget is the target of a symlink
+	LinkTarget string
 }
 
 // Client provides file operations for a single volume.
 type Client struct {
-	volumeID string
-	config   Config
+	volumeID   string
+	metaEngine volumestorage.MetaEngine
+	config     Config
 
 	jfs     *fs.FileSystem
 	metaCli meta.Meta
 	store   chunk.ChunkStore
 	blob    object.ObjectStorage
+	format  *meta.Format
 
-	// Local SQLite file path (for cleanup and sync)
+	// Bucket storage without the volume prefix, used to copy chunks between volumes
+	storage object.ObjectStorage
+
+	// Local SQLite file path (for cleanup and sync), empty for the volumes with Redis metadata
 	sqlitePath string
 	tmpDir     string
 
+	// Format version of the volume metadata
+	formatVersion int
+
+	// Generation of the metadata replica the client is at, updated by its own syncs
+	replicaGeneration atomic.Int64
+
 	mu     sync.RWMutex
 	closed bool
 }
@@ -63,38 +143,34 @@ type Client struct {
 var ErrVolumeNotInitialized = fmt.Errorf("volume not initialized - mount to a sandbox first")
 
 // NewClient creates a new JuiceFS client for a volume.
-// Uses litestream restore to reconstruct SQLite metadata from LTX files in GCS.
-func NewClient(volumeID string, _ int32, config Config) (*Client, error) {
+// SQLite metadata is restored with litestream from the LTX files in the bucket, Redis metadata is used
+// in place.
+func NewClient(volume Volume, config Config) (*Client, error) {
 	ctx := context.Background()
+	volumeID := volume.ID
 
-	// Restore metadata from litestream
-	restoreResult, err := restoreMetaDB(ctx, volumeID, config.GCSBucket)
-	if err != nil {
-		return nil, fmt.Errorf("restore metadata: %w", err)
+	if volume.Bucket != "" {
+		config.GCSBucket = volume.Bucket
 	}
 
-	// Fresh volumes must be mounted to a sandbox first to initialize JuiceFS metadata
-	if restoreResult.IsFreshVolume {
-		cleanupVolumeDir(volumeID)
-		return nil, ErrVolumeNotInitialized
+	var (
+		cm  clientMeta
+		err error
+	)
+	if volume.MetaEngine == volumestorage.MetaEngineRedis {
+		cm, err = openRedisMeta(ctx, volume, config)
+	} else {
+		cm, err = openSQLiteMeta(ctx, volumeID, config)
 	}
+	if err != nil {
+		return nil, err
+	}
+	tmpDir := cm.tmpDir
 
-	sqlitePath := restoreResult.MetaDBPath
-	tmpDir := filepath.Dir(sqlitePath)
-
-	// Keep WAL mode - JuiceFS works fine with WAL mode (sandbox proves this)
-	// Litestream requires WAL mode to track incremental changes
-
-	logger.L().Info(ctx, "Restored volume metadata via litestream",
-		zap.String("volume_id", volumeID),
-		zap.String("path", sqlitePath))
-
-	// Create metadata client with SQLite backend
-	sqliteURL := "sqlite3://" + sqlitePath + "?cache=shared&_journal=WAL&_timeout=5000"
 	metaConf := meta.DefaultConf()
 	metaConf.Retries = 10
 	metaConf.ReadOnly = false
-	metaCli := meta.NewClient(sqliteURL, metaConf)
+	metaCli := meta.NewClient(cm.url, metaConf)
 
 	// Load format from metadata
 	format, err := metaCli.Load(true)
@@ -111,7 +187,7 @@ func NewClient(volumeID string, _ int32, config Config) (*Client, error) {
 		zap.String("bucket", format.Bucket))
 
 	// Create object storage for data
-	blob, err := object.CreateStorage(format.Storage, format.Bucket, format.AccessKey, format.SecretKey, format.SessionToken)
+	storage, err := object.CreateStorage(format.Storage, format.Bucket, format.AccessKey, format.SecretKey, format.SessionToken)
 	if err != nil {
 		metaCli.Shutdown()
 		os.RemoveAll(tmpDir)
@@ -120,7 +196,7 @@ func NewClient(volumeID string, _ int32, config Config) (*Client, error) {
 
 	// Add prefix for volume-specific path (same pattern as CLI's mount.go)
 	// This ensures chunk operations go to gs://bucket/volumeName/chunks/...
-	blob = object.WithPrefix(blob, format.Name+"/")
+	blob := object.WithPrefix(storage, format.Name+"/")
 
 	// Create cache directory for chunk storage
 	cacheDir := filepath.Join(tmpDir, "cache")
@@ -138,14 +214,14 @@ func NewClient(volumeID string, _ int32, config Config) (*Client, error) {
 		PutTimeout:  60 * time.Second,
 		MaxUpload:   20,
 		MaxRetries:  10,
-		BufferSize:  300 << 20, // 300 MiB write buffer
-		CacheDir:    cacheDir,  // Cache directory for chunks
-		CacheSize:   1024,      // 1 GB max cache
-		FreeSpace:   0.1,       // Keep 10% disk free
-		AutoCreate:  true,      // Auto-create cache dir
-		CacheMode:   0o600,     // Cache file permissions
-		MaxDownload: 20,        // Max concurrent downloads
-		Prefetch:    1,         // Prefetch 1 chunk ahead
+		BufferSize:  config.bufferSize(),
+		CacheDir:    cacheDir, // Cache directory for chunks
+		CacheSize:   1024,     // 1 GB max cache
+		FreeSpace:   0.1,      // Keep 10% disk free
+		AutoCreate:  true,     // Auto-create cache dir
+		CacheMode:   0o600,    // Cache file permissions
+		MaxDownload: 20,       // Max concurrent downloads
+		Prefetch:    1,        // Prefetch 1 chunk ahead
 	}
 	// Use nil registerer to avoid metric conflicts between volumes
 	store := chunk.NewCachedStore(blob, chunkConf, nil)
@@ -179,19 +255,129 @@ func NewClient(volumeID string, _ int32, config Config) (*Client, error) {
 	logger.L().Info(ctx, "JuiceFS client initialized",
 		zap.String("volume_id", volumeID))
 
-	return &Client{
-		volumeID:   volumeID,
-		config:     config,
-		jfs:        jfs,
-		metaCli:    metaCli,
-		store:      store,
-		blob:       blob,
-		sqlitePath: sqlitePath,
-		tmpDir:     tmpDir,
-		closed:     false,
+	client := &Client{
+		volumeID:      volumeID,
+		metaEngine:    volume.MetaEngine,
+		config:        config,
+		jfs:           jfs,
+		metaCli:       metaCli,
+		store:         store,
+		blob:          blob,
+		format:        format,
+		storage:       storage,
+		sqlitePath:    cm.sqlitePath,
+		tmpDir:        tmpDir,
+		formatVersion: cm.formatVersion,
+		closed:        false,
+	}
+	if cm.replicaKnown {
+		client.replicaGeneration.Store(cm.replica)
+	}
+
+	return client, nil
+}
+
+// clientMeta is the metadata of the volume a client opens.
+type clientMeta struct {
+	// url is the JuiceFS metadata URL
+	url string
+
+	// tmpDir holds the files of the client, the restored SQLite metadata and the chunk cache
+	tmpDir     string
+	sqlitePath string
+
+	formatVersion int
+
+	// Generation of the metadata replica that was restored
+	replica      int64
+	replicaKnown bool
+}
+
+// openSQLiteMeta restores the SQLite metadata of the volume from its Litestream replica.
+func openSQLiteMeta(ctx context.Context, volumeID string, config Config) (clientMeta, error) {
+	// Read before the restore, a sync in between only makes the client reload once more
+	replica, replicaKnown := replicaGeneration(ctx, config.MetaReplicas, config.GCSBucket, volumeID)
+
+	// Restore metadata from litestream
+	restoreResult, err := restoreMetaDB(ctx, volumeID, config.GCSBucket)
+	if err != nil {
+		return clientMeta{}, fmt.Errorf("restore metadata: %w", err)
+	}
+
+	// Fresh volumes must be mounted to a sandbox first to initialize JuiceFS metadata
+	if restoreResult.IsFreshVolume {
+		cleanupRestoreDir(restoreResult)
+		return clientMeta{}, ErrVolumeNotInitialized
+	}
+
+	sqlitePath := restoreResult.MetaDBPath
+	tmpDir := filepath.Dir(sqlitePath)
+
+	// Volumes written by a newer release can have metadata this release would corrupt
+	formatVersion, err := readFormatVersion(ctx, sqlitePath)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return clientMeta{}, err
+	}
+	if err := volumeformat.Check(formatVersion); err != nil {
+		os.RemoveAll(tmpDir)
+		return clientMeta{}, err
+	}
+
+	// Keep WAL mode - JuiceFS works fine with WAL mode (sandbox proves this)
+	// Litestream requires WAL mode to track incremental changes
+
+	logger.L().Info(ctx, "Restored volume metadata via litestream",
+		zap.String("volume_id", volumeID),
+		zap.String("path", sqlitePath))
+
+	return clientMeta{
+		url:           "sqlite3://" + sqlitePath + "?cache=shared&_journal=WAL&_timeout=5000",
+		tmpDir:        tmpDir,
+		sqlitePath:    sqlitePath,
+		formatVersion: formatVersion,
+		replica:       replica,
+		replicaKnown:  replicaKnown,
 	}, nil
 }
 
+// openRedisMeta connects to the Redis metadata of the volume, shared live with the sandboxes.
+// The format version is only stamped in SQLite metadata, Redis metadata is always current.
+func openRedisMeta(ctx context.Context, volume Volume, config Config) (clientMeta, error) {
+	metaURL, err := redisMetaURL(config, volume)
+	if err != nil {
+		return clientMeta{}, err
+	}
+
+	// Fresh volumes must be mounted to a sandbox first to initialize JuiceFS metadata
+	formatted, err := redisMetaFormatted(ctx, metaURL)
+	if err != nil {
+		return clientMeta{}, err
+	}
+	if !formatted {
+		return clientMeta{}, ErrVolumeNotInitialized
+	}
+
+	if err := os.MkdirAll(metaRestoreDir, 0o755); err != nil {
+		return clientMeta{}, fmt.Errorf("create temp dir: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp(metaRestoreDir, volume.ID+"-")
+	if err != nil {
+		return clientMeta{}, fmt.Errorf("create temp dir: %w", err)
+	}
+
+	return clientMeta{
+		url:           metaURL,
+		tmpDir:        tmpDir,
+		formatVersion: volumeformat.Current,
+	}, nil
+}
+
+// ReplicaGeneration returns the generation of the metadata replica the client loaded or last synced.
+func (c *Client) ReplicaGeneration() int64 {
+	return c.replicaGeneration.Load()
+}
+
 // Close releases resources associated with this client.
 func (c *Client) Close() error {
 	c.mu.Lock()
@@ -224,22 +410,50 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// ErrWriteAborted is returned by the writes whose check failed, their metadata isn't synced to GCS.
+var ErrWriteAborted = errors.New("write aborted")
+
+// writeCheckKey is the context key of the check run before the metadata of a write is uploaded.
+type writeCheckKey struct{}
+
+// WithWriteCheck returns a context whose writes upload their metadata only while check passes,
+// e.g. while the write lease of the volume is held. Otherwise the write fails with the error of check.
+func WithWriteCheck(ctx context.Context, check func() error) context.Context {
+	return context.WithValue(ctx, writeCheckKey{}, check)
+}
+
+// checkWrite runs the check of the write, if any.
+func checkWrite(ctx context.Context) error {
+	check, ok := ctx.Value(writeCheckKey{}).(func() error)
+	if !ok {
+		return nil
+	}
+
+	return check()
+}
+
 // SyncToGCS syncs the current SQLite metadata to GCS via litestream.
 // This should be called after write operations to persist changes.
-func (c *Client) SyncToGCS() error {
+func (c *Client) SyncToGCS(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	return c.syncToGCSLocked()
+	return c.syncToGCSLocked(ctx)
 }
 
 // syncToGCSLocked syncs SQLite metadata to GCS via litestream (must hold lock).
 // Uses litestream replicate to ensure compatibility with sandbox's Litestream daemon.
-func (c *Client) syncToGCSLocked() error {
+func (c *Client) syncToGCSLocked(ctx context.Context) error {
+	// Redis metadata is written in place, there's nothing to upload
 	if c.sqlitePath == "" {
 		return nil
 	}
 
-	ctx := context.Background()
+	if err := checkWrite(ctx); err != nil {
+		return fmt.Errorf("%w: %w", ErrWriteAborted, err)
+	}
+
+	// The upload finishes even when the request is gone
+	ctx = context.WithoutCancel(ctx)
 
 	// Use litestream replicate to sync metadata to GCS
 	// This ensures compatibility with the sandbox's Litestream daemon
@@ -250,6 +464,28 @@ func (c *Client) syncToGCSLocked() error {
 	logger.L().Debug(ctx, "Synced metadata to GCS via litestream",
 		zap.String("volume_id", c.volumeID))
 
+	// The client wrote the new replica objects, it's still current
+	if generation, ok := replicaGeneration(ctx, c.config.MetaReplicas, c.config.GCSBucket, c.volumeID); ok {
+		c.replicaGeneration.Store(generation)
+	}
+
+	return nil
+}
+
+// syncAfterWriteLocked syncs the metadata to GCS after a write (must hold lock). A failed sync is only logged,
+// the next write syncs the metadata again, but an aborted write fails.
+func (c *Client) syncAfterWriteLocked(ctx context.Context, operation, path string) error {
+	err := c.syncToGCSLocked(ctx)
+	if errors.Is(err, ErrWriteAborted) {
+		return err
+	}
+	if err != nil {
+		logger.L().Warn(ctx, "Failed to sync metadata to GCS after "+operation,
+			zap.Error(err),
+			zap.String("volume_id", c.volumeID),
+			zap.String("path", path))
+	}
+
 	return nil
 }
 
@@ -279,17 +515,24 @@ func (c *Client) ListDir(ctx context.Context, path string, limit, offset int) (*
 
 	// Open directory
 	f, errno := c.jfs.Open(mctx, path, 0)
-	if errno != 0 {
-		if errno == syscall.ENOENT {
-			return nil, fmt.Errorf("path not found: %s", path)
-		}
+	switch errno {
+	case 0:
+	case syscall.ENOENT:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+	case syscall.ENOTDIR:
+		return nil, fmt.Errorf("%w: a parent of %s", ErrNotDirectory, path)
+	default:
 		return nil, fmt.Errorf("open directory: %s", errno)
 	}
 	defer f.Close(mctx)
 
 	// Read directory entries
 	entries, errno := f.ReaddirPlus(mctx, 0)
-	if errno != 0 {
+	switch errno {
+	case 0:
+	case syscall.ENOTDIR:
+		return nil, fmt.Errorf("%w: %s", ErrNotDirectory, path)
+	default:
 		return nil, fmt.Errorf("read directory: %s", errno)
 	}
 
@@ -325,9 +568,18 @@ func (c *Client) ListDir(ctx context.Context, path string, limit, offset int) (*
 			Size:       int64(entry.Attr.Length),
 			ModifiedAt: time.Unix(entry.Attr.Mtime, int64(entry.Attr.Mtimensec)),
 		}
-		if entry.Attr.Typ == meta.TypeDirectory {
+		switch entry.Attr.Typ {
+		case meta.TypeDirectory:
 			fi.Type = "directory"
-		} else {
+		case meta.TypeSymlink:
+			fi.Type = "symlink"
+
+			target, errno := c.jfs.Readlink(mctx, fi.Path)
+			if errno != 0 {
+				return nil, fmt.Errorf("read link %s: %s", fi.Path, errno)
+			}
+			fi.LinkTarget = string(target)
+		default:
 			fi.Type = "file"
 		}
 		result = append(result, fi)
@@ -364,6 +616,25 @@ func (r *jfsReader) Read(p []byte) (n int, err error) {
 	return n, nil
 }
 
+func (r *jfsReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position %d", offset)
+	}
+	r.offset = offset
+
+	return offset, nil
+}
+
 func (r *jfsReader) Close() error {
 	errno := r.file.Close(r.ctx)
 	if errno != 0 {
@@ -386,8 +657,16 @@ func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, int6
 	// Open file for reading
 	f, errno := c.jfs.Open(mctx, path, vfs.MODE_MASK_R)
 	if errno != 0 {
-		if errno == syscall.ENOENT {
-			return nil, 0, fmt.Errorf("file not found: %s", path)
+		if target, ok := c.danglingLink(mctx, path, errno); ok {
+			return nil, 0, fmt.Errorf("%w: %s links to %s", ErrDanglingSymlink, path, target)
+		}
+		switch errno {
+		case syscall.ENOENT:
+			return nil, 0, fmt.Errorf("%w: %s", ErrNotFound, path)
+		case syscall.ENOTDIR:
+			return nil, 0, fmt.Errorf("%w: a parent of %s", ErrNotDirectory, path)
+		case syscall.EISDIR:
+			return nil, 0, fmt.Errorf("%w: %s", ErrIsDirectory, path)
 		}
 		return nil, 0, fmt.Errorf("open file: %s", errno)
 	}
@@ -398,6 +677,10 @@ func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, int6
 		f.Close(mctx)
 		return nil, 0, fmt.Errorf("stat file: %w", err)
 	}
+	if info.IsDir() {
+		f.Close(mctx)
+		return nil, 0, fmt.Errorf("%w: %s", ErrIsDirectory, path)
+	}
 	size := info.Size()
 
 	reader := &jfsReader{
@@ -410,30 +693,141 @@ func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, int6
 	return reader, size, nil
 }
 
-// Upload streams content to a file at the given path.
-// Creates parent directories as needed.
+// UploadOptions controls how Upload validates the content before it replaces the file.
+type UploadOptions struct {
+	// Checksums the content must match, otherwise ErrChecksumMismatch is returned
+	Checksums Checksums
+	// SizeLimit is the size quota of the volume in bytes, 0 for no limit.
+	// A write that would grow the volume beyond it fails with ErrQuotaExceeded.
+	SizeLimit int64
+	// MaxSize is the largest content the upload accepts in bytes, 0 for no limit.
+	// Larger content fails with ErrUploadTooLarge.
+	MaxSize int64
+	// ContentType is stored as the content type of the file,
+	// detected from the file name and the content when empty.
+	ContentType string
+	// Attributes are set on the file, it's created with mode 0644 owned by root otherwise
+	Attributes Attributes
+}
+
+// staged reports whether the content must be validated before it replaces the file.
+func (o UploadOptions) staged() bool {
+	return !o.Checksums.IsZero() || o.SizeLimit > 0 || o.MaxSize > 0
+}
+
+// Upload streams content to a file at the given path and returns its size and checksums,
+// computed while streaming. Creates parent directories as needed.
+// If the content is validated against the options, it is staged and only moved to path once it passed,
+// otherwise an existing file at path is left untouched.
 // After upload, syncs metadata to GCS.
-func (c *Client) Upload(ctx context.Context, path string, content io.Reader) (int64, error) {
+func (c *Client) Upload(ctx context.Context, path string, content io.Reader, opts UploadOptions) (int64, Checksums, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if c.closed {
-		return 0, fmt.Errorf("client closed")
+		return 0, Checksums{}, fmt.Errorf("client closed")
 	}
 
 	mctx := c.metaCtx(ctx)
 
+	// A directory is never replaced by a file
+	if info, errno := c.jfs.Stat(mctx, path); errno == 0 && info.IsDir() {
+		return 0, Checksums{}, fmt.Errorf("%w: %s", ErrIsDirectory, path)
+	}
+
 	// Create parent directories
 	dir := filepath.Dir(path)
 	if dir != "/" && dir != "." {
 		errno := c.jfs.MkdirAll(mctx, dir, 0o755, 0o022)
+		if errno == syscall.ENOTDIR {
+			return 0, Checksums{}, fmt.Errorf("%w: a parent of %s", ErrNotDirectory, path)
+		}
 		if errno != 0 && errno != syscall.EEXIST {
-			return 0, fmt.Errorf("create directories: %s", errno)
+			return 0, Checksums{}, fmt.Errorf("create directories: %s", errno)
+		}
+	}
+
+	if opts.SizeLimit > 0 {
+		used, err := c.usedSpace(mctx)
+		if err != nil {
+			return 0, Checksums{}, err
+		}
+
+		// The replaced file frees its space
+		allowed := opts.SizeLimit - used
+		if info, errno := c.jfs.Stat(mctx, path); errno == 0 && !info.IsDir() {
+			allowed += info.Size()
+		}
+
+		content = &quotaReader{r: content, remaining: allowed}
+	}
+
+	if opts.MaxSize > 0 {
+		// Reading one byte more tells content of exactly the maximum size from larger content
+		content = io.LimitReader(content, opts.MaxSize+1)
+	}
+
+	target := path
+	if opts.staged() {
+		if errno := c.jfs.MkdirAll(mctx, UploadsDir, 0o755, 0o022); errno != 0 && errno != syscall.EEXIST {
+			return 0, Checksums{}, fmt.Errorf("create staging directory: %s", errno)
+		}
+		target = filepath.Join(UploadsDir, "put-"+uuid.NewString())
+	}
+
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType, content = detectContentType(path, content)
+	}
+
+	hashed := newChecksumReader(content)
+	totalWritten, err := c.writeFile(mctx, target, 0o644, hashed)
+	if err == nil {
+		// The content type moves with the staged file, an overwritten file gets the type of the new content
+		err = c.setContentType(mctx, target, contentType)
+	}
+	if err == nil {
+		err = c.setAttributes(mctx, target, opts.Attributes)
+	}
+	checksums := hashed.Checksums()
+	if target != path {
+		if err == nil && opts.MaxSize > 0 && totalWritten > opts.MaxSize {
+			err = fmt.Errorf("%w: the maximum is %d bytes", ErrUploadTooLarge, opts.MaxSize)
+		}
+		if err == nil {
+			err = checksums.Verify(opts.Checksums)
+		}
+		if err == nil {
+			if errno := c.jfs.Rename(mctx, target, path, 0); errno != 0 {
+				err = fmt.Errorf("move to destination: %s", errno)
+			}
+		}
+		if err != nil {
+			if errno := c.jfs.Delete(mctx, target); errno != 0 && errno != syscall.ENOENT {
+				logger.L().Warn(ctx, "Failed to remove upload staging file",
+					zap.String("volume_id", c.volumeID),
+					zap.String("path", target),
+					zap.String("errno", errno.Error()))
+			}
 		}
 	}
+	if err != nil {
+		return totalWritten, checksums, err
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "upload", path); err != nil {
+		return totalWritten, checksums, err
+	}
 
+	return totalWritten, checksums, nil
+}
+
+// writeFile writes content to the file at path, creating it with mode or truncating it if it exists.
+// The parent directory must exist. The caller must hold the write lock.
+func (c *Client) writeFile(mctx meta.Context, path string, mode uint16, content io.Reader) (int64, error) {
 	// Try to create file first; if it exists, open and truncate it
-	f, errno := c.jfs.Create(mctx, path, 0o644, 0o022)
+	f, errno := c.jfs.Create(mctx, path, mode, 0)
 	if errno == syscall.EEXIST {
 		// File exists, open it for writing and truncate
 		f, errno = c.jfs.Open(mctx, path, vfs.MODE_MASK_W)
@@ -447,6 +841,8 @@ func (c *Client) Upload(ctx context.Context, path string, content io.Reader) (in
 		if errno != 0 {
 			return 0, fmt.Errorf("truncate file: %s", errno)
 		}
+	} else if errno == syscall.ENOTDIR {
+		return 0, fmt.Errorf("%w: a parent of %s", ErrNotDirectory, path)
 	} else if errno != 0 {
 		return 0, fmt.Errorf("create file: %s", errno)
 	} else {
@@ -482,14 +878,6 @@ func (c *Client) Upload(ctx context.Context, path string, content io.Reader) (in
 		return totalWritten, fmt.Errorf("flush: %s", errno)
 	}
 
-	// Sync metadata to GCS so sandbox can see the changes
-	if err := c.syncToGCSLocked(); err != nil {
-		logger.L().Warn(ctx, "Failed to sync metadata to GCS after upload",
-			zap.Error(err),
-			zap.String("volume_id", c.volumeID),
-			zap.String("path", path))
-	}
-
 	return totalWritten, nil
 }
 
@@ -521,16 +909,16 @@ func (c *Client) Delete(ctx context.Context, path string, recursive bool) error
 			if errno == syscall.ENOENT {
 				return nil // Already deleted
 			}
+			if errno == syscall.ENOTEMPTY {
+				return fmt.Errorf("%w: %s", ErrNotEmpty, path)
+			}
 			return fmt.Errorf("delete: %s", errno)
 		}
 	}
 
 	// Sync metadata to GCS so sandbox can see the changes
-	if err := c.syncToGCSLocked(); err != nil {
-		logger.L().Warn(ctx, "Failed to sync metadata to GCS after delete",
-			zap.Error(err),
-			zap.String("volume_id", c.volumeID),
-			zap.String("path", path))
+	if err := c.syncAfterWriteLocked(ctx, "delete", path); err != nil {
+		return err
 	}
 
 	return nil
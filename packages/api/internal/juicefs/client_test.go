@@ -0,0 +1,34 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncAfterWriteAborted(t *testing.T) {
+	t.Parallel()
+
+	errLost := errors.New("lease lost")
+	ctx := WithWriteCheck(t.Context(), func() error { return errLost })
+
+	// The metadata replicated to GCS isn't overwritten by the aborted write
+	client := &Client{volumeID: "vol-1", sqlitePath: "/nonexistent/meta.db"}
+	err := client.syncAfterWriteLocked(ctx, "upload", "/file")
+	require.ErrorIs(t, err, ErrWriteAborted)
+	require.ErrorIs(t, err, errLost)
+
+	// Redis metadata is written in place, there's nothing to abort
+	client = &Client{volumeID: "vol-1"}
+	assert.NoError(t, client.syncAfterWriteLocked(ctx, "upload", "/file"))
+}
+
+func TestCheckWrite(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, checkWrite(context.Background()))
+	assert.NoError(t, checkWrite(WithWriteCheck(t.Context(), func() error { return nil })))
+}
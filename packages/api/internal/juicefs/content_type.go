@@ -0,0 +1,85 @@
+package juicefs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"syscall"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+const (
+	// ContentTypeXattr is the extended attribute holding the content type of a file,
+	// the attribute tools like Apache's mod_mime_xattr read.
+	ContentTypeXattr = "user.mime_type"
+
+	// DefaultContentType is the content type of files whose type isn't known.
+	DefaultContentType = "application/octet-stream"
+
+	// sniffLen is how much of the content is read to detect its type, all http.DetectContentType considers.
+	sniffLen = 512
+)
+
+// ContentType returns the content type of the file at the given path, the type stored on upload,
+// otherwise the type of its extension, otherwise DefaultContentType.
+func (c *Client) ContentType(ctx context.Context, filePath string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return "", fmt.Errorf("client closed")
+	}
+
+	return c.contentType(c.metaCtx(ctx), filePath)
+}
+
+func (c *Client) contentType(mctx meta.Context, filePath string) (string, error) {
+	value, errno := c.jfs.GetXattr(mctx, filePath, ContentTypeXattr)
+	switch errno {
+	case 0:
+		if len(value) > 0 {
+			return string(value), nil
+		}
+	case syscall.ENODATA:
+		// Written by a sandbox or before content types were stored
+	case syscall.ENOENT:
+		return "", fmt.Errorf("%w: %s", ErrNotFound, filePath)
+	default:
+		return "", fmt.Errorf("get content type: %s", errno)
+	}
+
+	if contentType := mime.TypeByExtension(path.Ext(filePath)); contentType != "" {
+		return contentType, nil
+	}
+
+	return DefaultContentType, nil
+}
+
+// setContentType stores the content type of the file at the given path. The caller must hold the write lock.
+func (c *Client) setContentType(mctx meta.Context, filePath, contentType string) error {
+	if errno := c.jfs.SetXattr(mctx, filePath, ContentTypeXattr, []byte(contentType), 0); errno != 0 {
+		return fmt.Errorf("set content type: %s", errno)
+	}
+
+	return nil
+}
+
+// detectContentType detects the type of content about to be written to the file with the given name,
+// by its extension or otherwise from the beginning of the content.
+// The content has to be read from the returned reader, it holds the beginning read for the detection.
+func detectContentType(name string, content io.Reader) (string, io.Reader) {
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		return contentType, content
+	}
+
+	buffered := bufio.NewReaderSize(content, sniffLen)
+	// A read error is returned again when the content is written
+	head, _ := buffered.Peek(sniffLen)
+
+	return http.DetectContentType(head), buffered
+}
@@ -0,0 +1,41 @@
+package juicefs
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		path     string
+		content  string
+		expected string
+	}{
+		{name: "by extension", path: "/assets/logo.png", content: "<html></html>", expected: "image/png"},
+		{name: "sniffed png", path: "/image", content: "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 16), expected: "image/png"},
+		{name: "sniffed text", path: "/README", content: "hello", expected: "text/plain; charset=utf-8"},
+		{name: "unknown binary", path: "/blob", content: "\x00\x01\x02\x03", expected: DefaultContentType},
+		{name: "content longer than the sniffed part", path: "/long", content: strings.Repeat("a", 2*sniffLen), expected: "text/plain; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contentType, reader := detectContentType(tt.path, strings.NewReader(tt.content))
+			assert.Equal(t, tt.expected, contentType)
+
+			// The whole content is still written
+			content, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, tt.content, string(content))
+		})
+	}
+}
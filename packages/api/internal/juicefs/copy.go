@@ -0,0 +1,464 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/vfs"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+var (
+	// ErrDestinationExists is returned when a copy would replace existing content without overwrite.
+	ErrDestinationExists = errors.New("destination already exists")
+
+	// ErrCopyIntoSource is returned when the destination is the source itself or inside of it.
+	ErrCopyIntoSource = errors.New("destination is inside the source")
+
+	// ErrSourceNotFound is returned when the path to copy doesn't exist.
+	ErrSourceNotFound = errors.New("source not found")
+)
+
+// copyBufferSize is the buffer used when chunks can't be shared between volumes.
+const copyBufferSize = 1 << 20 // 1 MiB
+
+// CopyResult summarizes a finished copy.
+type CopyResult struct {
+	Files       int64
+	Directories int64
+	Size        int64
+}
+
+// fileNode is the source entry being copied.
+type fileNode struct {
+	path   string
+	inode  meta.Ino
+	typ    uint8
+	mode   uint16
+	length uint64
+}
+
+// copier holds the state of a single copy operation. Both clients must be locked.
+type copier struct {
+	src    *Client
+	dst    *Client
+	srcCtx meta.Context
+	dstCtx meta.Context
+
+	overwrite bool
+	// shareChunks is set within a volume, files reference the same chunks as the source
+	shareChunks bool
+	// copyChunks is set between volumes with the same storage layout, chunk objects are copied in the bucket
+	copyChunks bool
+
+	result CopyResult
+}
+
+// Copy copies a file or directory tree from srcPath in src to dstPath in dst.
+// src and dst may be the same client. Parent directories of dstPath are created as needed.
+//
+// The content never passes through the API: within a volume the new files reference the
+// chunks of the source, between volumes the chunk objects are copied inside the bucket.
+// Only volumes with a different storage layout fall back to reading and writing the data.
+// After the copy, syncs the destination metadata to GCS.
+func Copy(ctx context.Context, src *Client, srcPath string, dst *Client, dstPath string, overwrite bool) (*CopyResult, error) {
+	unlock, err := lockForCopy(src, dst)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if src == dst && isWithin(dstPath, srcPath) {
+		return nil, ErrCopyIntoSource
+	}
+
+	c := &copier{
+		src:         src,
+		dst:         dst,
+		srcCtx:      src.metaCtx(ctx),
+		dstCtx:      dst.metaCtx(ctx),
+		overwrite:   overwrite,
+		shareChunks: src == dst,
+		copyChunks:  src != dst && compatibleFormats(src.format, dst.format),
+	}
+
+	info, errno := src.jfs.Stat(c.srcCtx, srcPath)
+	if errno != 0 {
+		if errno == syscall.ENOENT {
+			return nil, ErrSourceNotFound
+		}
+		return nil, fmt.Errorf("stat source: %s", errno)
+	}
+
+	root := fileNode{
+		path:   srcPath,
+		inode:  info.Inode(),
+		typ:    meta.TypeFile,
+		mode:   uint16(info.Mode().Perm()),
+		length: uint64(info.Size()),
+	}
+	switch {
+	case info.IsDir():
+		root.typ = meta.TypeDirectory
+	case info.IsSymlink():
+		root.typ = meta.TypeSymlink
+	}
+
+	dir := filepath.Dir(dstPath)
+	if dir != "/" && dir != "." {
+		errno := dst.jfs.MkdirAll(c.dstCtx, dir, 0o755, 0o022)
+		if errno != 0 && errno != syscall.EEXIST {
+			return nil, fmt.Errorf("create directories: %s", errno)
+		}
+	}
+
+	copyErr := c.copyNode(ctx, root, dstPath)
+
+	// Sync even after a partial copy, so the metadata in GCS matches the chunks already copied
+	if c.result.Files > 0 || c.result.Directories > 0 {
+		if err := dst.syncAfterWriteLocked(ctx, "copy", dstPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if copyErr != nil {
+		return nil, copyErr
+	}
+
+	logger.L().Info(ctx, "Copied volume files",
+		zap.String("source_volume_id", src.volumeID),
+		zap.String("source_path", srcPath),
+		zap.String("volume_id", dst.volumeID),
+		zap.String("path", dstPath),
+		zap.Bool("shared_chunks", c.shareChunks),
+		zap.Bool("copied_chunks", c.copyChunks),
+		zap.Int64("files", c.result.Files),
+		zap.Int64("size", c.result.Size))
+
+	return &c.result, nil
+}
+
+// copyNode copies a single entry, recursing into directories.
+func (c *copier) copyNode(ctx context.Context, n fileNode, dstPath string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	switch n.typ {
+	case meta.TypeDirectory:
+		return c.copyDir(ctx, n, dstPath)
+	case meta.TypeSymlink:
+		return c.copySymlink(n, dstPath)
+	case meta.TypeFile:
+		return c.copyFile(ctx, n, dstPath)
+	default:
+		// Devices, sockets and pipes have no content worth copying
+		return nil
+	}
+}
+
+func (c *copier) copyDir(ctx context.Context, n fileNode, dstPath string) error {
+	errno := c.dst.jfs.Mkdir(c.dstCtx, dstPath, n.mode, 0)
+	if errno == syscall.EEXIST {
+		// Merge into an existing directory, but never replace a file with a directory
+		existing, errno := c.dst.jfs.Stat(c.dstCtx, dstPath)
+		if errno != 0 {
+			return fmt.Errorf("stat %s: %s", dstPath, errno)
+		}
+		if !existing.IsDir() {
+			return fmt.Errorf("%w: %s", ErrDestinationExists, dstPath)
+		}
+	} else if errno != 0 {
+		return fmt.Errorf("create directory %s: %s", dstPath, errno)
+	}
+	c.result.Directories++
+
+	f, errno := c.src.jfs.Open(c.srcCtx, n.path, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", n.path, errno)
+	}
+	entries, errno := f.ReaddirPlus(c.srcCtx, 0)
+	f.Close(c.srcCtx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", n.path, errno)
+	}
+
+	for _, entry := range entries {
+		name := string(entry.Name)
+		child := fileNode{
+			path:   filepath.Join(n.path, name),
+			inode:  entry.Inode,
+			typ:    entry.Attr.Typ,
+			mode:   entry.Attr.Mode,
+			length: entry.Attr.Length,
+		}
+
+		if err := c.copyNode(ctx, child, filepath.Join(dstPath, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *copier) copySymlink(n fileNode, dstPath string) error {
+	target, errno := c.src.jfs.Readlink(c.srcCtx, n.path)
+	if errno != 0 {
+		return fmt.Errorf("read link %s: %s", n.path, errno)
+	}
+
+	errno = c.dst.jfs.Symlink(c.dstCtx, string(target), dstPath)
+	if errno == syscall.EEXIST && c.overwrite {
+		if errno = c.dst.jfs.Delete(c.dstCtx, dstPath); errno != 0 {
+			return fmt.Errorf("replace %s: %s", dstPath, errno)
+		}
+		errno = c.dst.jfs.Symlink(c.dstCtx, string(target), dstPath)
+	}
+
+	switch {
+	case errno == syscall.EEXIST:
+		return fmt.Errorf("%w: %s", ErrDestinationExists, dstPath)
+	case errno != 0:
+		return fmt.Errorf("create link %s: %s", dstPath, errno)
+	}
+
+	c.result.Files++
+
+	return nil
+}
+
+func (c *copier) copyFile(ctx context.Context, n fileNode, dstPath string) error {
+	dstIno, err := c.prepareFile(n, dstPath)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case n.length == 0:
+	case c.shareChunks:
+		var copied, outLength uint64
+		if errno := c.dst.metaCli.CopyFileRange(c.dstCtx, n.inode, 0, dstIno, 0, n.length, 0, &copied, &outLength); errno != 0 {
+			return fmt.Errorf("copy %s: %s", n.path, errno)
+		}
+	case c.copyChunks:
+		if err := c.copyFileChunks(ctx, n, dstIno, dstPath); err != nil {
+			return fmt.Errorf("copy %s: %w", n.path, err)
+		}
+	default:
+		if err := c.copyFileData(n, dstPath); err != nil {
+			return fmt.Errorf("copy %s: %w", n.path, err)
+		}
+	}
+
+	c.result.Files++
+	c.result.Size += int64(n.length)
+
+	return nil
+}
+
+// prepareFile creates an empty destination file, or truncates the existing one when overwriting.
+func (c *copier) prepareFile(n fileNode, dstPath string) (meta.Ino, error) {
+	f, errno := c.dst.jfs.Create(c.dstCtx, dstPath, n.mode, 0)
+	if errno == 0 {
+		defer f.Close(c.dstCtx)
+
+		return f.Inode(), nil
+	}
+	if errno != syscall.EEXIST {
+		return 0, fmt.Errorf("create file %s: %s", dstPath, errno)
+	}
+
+	existing, errno := c.dst.jfs.Stat(c.dstCtx, dstPath)
+	if errno != 0 {
+		return 0, fmt.Errorf("stat %s: %s", dstPath, errno)
+	}
+	if !c.overwrite || existing.IsDir() {
+		return 0, fmt.Errorf("%w: %s", ErrDestinationExists, dstPath)
+	}
+
+	if errno := c.dst.jfs.Truncate(c.dstCtx, dstPath, 0); errno != 0 {
+		return 0, fmt.Errorf("truncate %s: %s", dstPath, errno)
+	}
+
+	return existing.Inode(), nil
+}
+
+// copyFileChunks copies the chunk objects of a file to the destination volume and
+// writes the matching slices to its metadata.
+func (c *copier) copyFileChunks(ctx context.Context, n fileNode, dstIno meta.Ino, dstPath string) error {
+	for indx := uint32(0); uint64(indx)*meta.ChunkSize < n.length; indx++ {
+		var slices []meta.Slice
+		if errno := c.src.metaCli.Read(c.srcCtx, n.inode, indx, &slices); errno != 0 {
+			return fmt.Errorf("read chunk %d: %s", indx, errno)
+		}
+
+		// Overwritten slices show up once per visible part, copy their objects only once
+		copied := make(map[uint64]uint64)
+		for _, s := range slices {
+			// Holes have no data
+			if s.Id == 0 {
+				continue
+			}
+
+			id, ok := copied[s.Id]
+			if !ok {
+				if errno := c.dst.metaCli.NewSlice(c.dstCtx, &id); errno != 0 {
+					return fmt.Errorf("allocate slice: %s", errno)
+				}
+				if err := c.copySliceObjects(ctx, s.Id, id, s.Size); err != nil {
+					return err
+				}
+				copied[s.Id] = id
+			}
+
+			slice := meta.Slice{Id: id, Size: s.Size, Off: s.Off, Len: s.Len}
+			if errno := c.dst.metaCli.Write(c.dstCtx, dstIno, indx, s.Pos, slice, time.Now()); errno != 0 {
+				return fmt.Errorf("write chunk %d: %s", indx, errno)
+			}
+		}
+	}
+
+	// A trailing hole isn't covered by any slice
+	if errno := c.dst.jfs.Truncate(c.dstCtx, dstPath, n.length); errno != 0 {
+		return fmt.Errorf("set length: %s", errno)
+	}
+
+	return nil
+}
+
+// copySliceObjects copies every block object of a slice under the new slice ID.
+func (c *copier) copySliceObjects(ctx context.Context, srcID, dstID uint64, size uint32) error {
+	blockSize := c.src.format.BlockSize * 1024
+
+	for indx := 0; indx*blockSize < int(size); indx++ {
+		length := min(blockSize, int(size)-indx*blockSize)
+
+		srcKey := c.src.format.Name + "/" + blockKey(srcID, indx, length, c.src.format.HashPrefix)
+		dstKey := c.dst.format.Name + "/" + blockKey(dstID, indx, length, c.dst.format.HashPrefix)
+
+		if err := c.dst.storage.Copy(ctx, dstKey, srcKey); err != nil {
+			return fmt.Errorf("copy object %s: %w", srcKey, err)
+		}
+	}
+
+	return nil
+}
+
+// copyFileData reads and writes the file content, for volumes that can't share chunk objects.
+func (c *copier) copyFileData(n fileNode, dstPath string) error {
+	in, errno := c.src.jfs.Open(c.srcCtx, n.path, vfs.MODE_MASK_R)
+	if errno != 0 {
+		return fmt.Errorf("open source: %s", errno)
+	}
+	defer in.Close(c.srcCtx)
+
+	out, errno := c.dst.jfs.Open(c.dstCtx, dstPath, vfs.MODE_MASK_W)
+	if errno != 0 {
+		return fmt.Errorf("open destination: %s", errno)
+	}
+	defer out.Close(c.dstCtx)
+
+	buf := make([]byte, copyBufferSize)
+	var offset int64
+	for uint64(offset) < n.length {
+		read, err := in.Pread(c.srcCtx, buf, offset)
+		if read > 0 {
+			if _, errno := out.Pwrite(c.dstCtx, buf[:read], offset); errno != 0 {
+				return fmt.Errorf("write: %s", errno)
+			}
+			offset += int64(read)
+		}
+		if err != nil || read == 0 {
+			if uint64(offset) < n.length {
+				return fmt.Errorf("read at %d: %w", offset, err)
+			}
+			break
+		}
+	}
+
+	if errno := out.Flush(c.dstCtx); errno != 0 {
+		return fmt.Errorf("flush: %s", errno)
+	}
+
+	return nil
+}
+
+// lockForCopy locks both clients for the copy and returns the function releasing them.
+func lockForCopy(src, dst *Client) (func(), error) {
+	if src == dst {
+		src.mu.Lock()
+		if src.closed {
+			src.mu.Unlock()
+			return nil, fmt.Errorf("client closed")
+		}
+
+		return src.mu.Unlock, nil
+	}
+
+	// Lock in volume order, so copies in opposite directions can't deadlock
+	first, second := src, dst
+	if dst.volumeID < src.volumeID {
+		first, second = dst, src
+	}
+
+	lock := func(c *Client) {
+		if c == dst {
+			c.mu.Lock()
+		} else {
+			c.mu.RLock()
+		}
+	}
+	unlock := func(c *Client) {
+		if c == dst {
+			c.mu.Unlock()
+		} else {
+			c.mu.RUnlock()
+		}
+	}
+
+	lock(first)
+	lock(second)
+	release := func() {
+		unlock(second)
+		unlock(first)
+	}
+
+	if src.closed || dst.closed {
+		release()
+		return nil, fmt.Errorf("client closed")
+	}
+
+	return release, nil
+}
+
+// compatibleFormats reports whether chunk objects of one volume are valid in the other.
+func compatibleFormats(a, b *meta.Format) bool {
+	return a.Storage == b.Storage &&
+		a.Bucket == b.Bucket &&
+		a.BlockSize == b.BlockSize &&
+		a.Compression == b.Compression &&
+		a.EncryptKey == "" && b.EncryptKey == ""
+}
+
+// blockKey returns the object key of a slice block, relative to the volume prefix.
+// Matches the layout of the JuiceFS chunk store.
+func blockKey(id uint64, indx, size int, hashPrefix bool) string {
+	if hashPrefix {
+		return fmt.Sprintf("chunks/%02X/%v/%v_%v_%v", id%256, id/1000/1000, id, indx, size)
+	}
+
+	return fmt.Sprintf("chunks/%v/%v/%v_%v_%v", id/1000/1000, id/1000, id, indx, size)
+}
+
+// isWithin reports whether path is parent or inside of it.
+func isWithin(path, parent string) bool {
+	return parent == "/" || path == parent || strings.HasPrefix(path, parent+"/")
+}
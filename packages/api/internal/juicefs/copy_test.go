@@ -0,0 +1,35 @@
+package juicefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWithin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path   string
+		parent string
+		want   bool
+	}{
+		{path: "/data", parent: "/data", want: true},
+		{path: "/data/a/b", parent: "/data", want: true},
+		{path: "/anything", parent: "/", want: true},
+		{path: "/data2", parent: "/data", want: false},
+		{path: "/", parent: "/data", want: false},
+		{path: "/other/data", parent: "/data", want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isWithin(tt.path, tt.parent), "isWithin(%q, %q)", tt.path, tt.parent)
+	}
+}
+
+func TestBlockKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "chunks/1/1234/1234567_0_4194304", blockKey(1234567, 0, 4<<20, false))
+	assert.Equal(t, "chunks/87/1/1234567_2_1024", blockKey(1234567, 2, 1024, true))
+}
@@ -0,0 +1,421 @@
+package juicefs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// MaxSymlinkTarget bounds the target of a symlink, like PATH_MAX.
+const MaxSymlinkTarget = 4096
+
+// ErrInvalidArchive is returned when the content can't be read as an archive of the given format.
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// ExtractResult describes the entries unpacked from an archive.
+type ExtractResult struct {
+	// Files counts regular files and symlinks
+	Files       int64
+	Directories int64
+	// Size is the total size of the extracted files
+	Size int64
+}
+
+// ArchiveFormatFromContentType returns the archive format of a MIME type.
+func ArchiveFormatFromContentType(contentType string) (ArchiveFormat, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+
+	switch mediaType {
+	case "application/x-tar":
+		return ArchiveTar, true
+	case "application/gzip", "application/x-gzip":
+		return ArchiveTarGz, true
+	case "application/zip", "application/x-zip-compressed":
+		return ArchiveZip, true
+	default:
+		return "", false
+	}
+}
+
+// archiveEntry is an entry read from an archive.
+type archiveEntry struct {
+	name    string
+	typ     uint8
+	mode    uint16
+	modTime time.Time
+	// target is the link target of a symlink
+	target string
+	// content is the content of a regular file, valid until the next entry is read
+	content io.Reader
+}
+
+// Extract unpacks an archive into the directory at dirPath, creating it as needed.
+// Directory structure, permission bits and modification times are preserved, existing
+// files are overwritten. Hard links, devices and pipes are skipped.
+// Symlinks are created after all other entries, so no entry is written through a link of the archive.
+// After extraction, syncs metadata to GCS.
+func (c *Client) Extract(ctx context.Context, dirPath string, format ArchiveFormat, content io.Reader) (*ExtractResult, error) {
+	// Zip keeps its index at the end, spool it to disk before taking the lock
+	var spooled *os.File
+	if format == ArchiveZip {
+		f, err := spool(content)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			f.Close()
+			os.Remove(f.Name())
+		}()
+		spooled = f
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	if dirPath != "/" {
+		errno := c.jfs.MkdirAll(mctx, dirPath, 0o755, 0o022)
+		if errno != 0 && errno != syscall.EEXIST {
+			return nil, fmt.Errorf("create directories: %s", errno)
+		}
+	}
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	if errno != 0 {
+		return nil, fmt.Errorf("stat: %s", errno)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("path is not a directory: %s", dirPath)
+	}
+
+	x := &extractor{
+		client: c,
+		ctx:    ctx,
+		mctx:   mctx,
+		root:   dirPath,
+		result: &ExtractResult{},
+	}
+
+	var err error
+	switch format {
+	case ArchiveTar:
+		err = readTar(content, x.add)
+	case ArchiveTarGz:
+		gz, gzErr := gzip.NewReader(content)
+		if gzErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, gzErr)
+		}
+		err = readTar(gz, x.add)
+	case ArchiveZip:
+		stat, statErr := spooled.Stat()
+		if statErr != nil {
+			return nil, fmt.Errorf("stat spooled archive: %w", statErr)
+		}
+		err = readZip(spooled, stat.Size(), x.add)
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %s", format)
+	}
+
+	if err == nil {
+		err = x.finish()
+	}
+
+	// Sync also after a failure, part of the archive may already be on the volume
+	if syncErr := c.syncAfterWriteLocked(ctx, "extracting archive", dirPath); syncErr != nil {
+		return x.result, errors.Join(err, syncErr)
+	}
+
+	return x.result, err
+}
+
+// extractedDir is a directory whose attributes are applied once its content is extracted.
+type extractedDir struct {
+	path    string
+	mode    uint16
+	modTime time.Time
+}
+
+// extractedLink is a symlink created once all other entries are extracted.
+type extractedLink struct {
+	path   string
+	target string
+}
+
+// extractor writes archive entries below the root directory. The caller holds the write lock.
+type extractor struct {
+	client *Client
+	ctx    context.Context
+	mctx   meta.Context
+	root   string
+	result *ExtractResult
+
+	dirs  []extractedDir
+	links []extractedLink
+}
+
+func (x *extractor) add(entry archiveEntry) error {
+	if err := x.ctx.Err(); err != nil {
+		return err
+	}
+
+	target, err := entryPath(x.root, entry.name)
+	if err != nil {
+		return err
+	}
+	// The root itself, e.g. "./"
+	if target == x.root {
+		return nil
+	}
+
+	c := x.client
+
+	switch entry.typ {
+	case meta.TypeDirectory:
+		errno := c.jfs.MkdirAll(x.mctx, target, 0o755, 0o022)
+		if errno != 0 && errno != syscall.EEXIST {
+			return fmt.Errorf("create directory %s: %s", target, errno)
+		}
+		x.dirs = append(x.dirs, extractedDir{path: target, mode: entry.mode, modTime: entry.modTime})
+		x.result.Directories++
+	case meta.TypeSymlink:
+		x.links = append(x.links, extractedLink{path: target, target: entry.target})
+	case meta.TypeFile:
+		if err := x.mkdirParent(target); err != nil {
+			return err
+		}
+
+		written, err := c.writeFile(x.mctx, target, entry.mode, entry.content)
+		x.result.Size += written
+		if err != nil {
+			return fmt.Errorf("extract %s: %w", target, err)
+		}
+		x.result.Files++
+
+		x.setAttributes(target, entry.mode, entry.modTime)
+	}
+
+	return nil
+}
+
+// finish creates the symlinks and applies the directory attributes.
+func (x *extractor) finish() error {
+	c := x.client
+
+	for _, link := range x.links {
+		if err := x.mkdirParent(link.path); err != nil {
+			return err
+		}
+
+		errno := c.jfs.Symlink(x.mctx, link.target, link.path)
+		if errno == syscall.EEXIST {
+			// Replace the existing entry like extracting a file does
+			if errno = c.jfs.Delete(x.mctx, link.path); errno == 0 {
+				errno = c.jfs.Symlink(x.mctx, link.target, link.path)
+			}
+		}
+		if errno != 0 {
+			return fmt.Errorf("create symlink %s: %s", link.path, errno)
+		}
+		x.result.Files++
+	}
+
+	// Deepest first, setting the modification time of a directory before its content
+	// is extracted would be undone by the extraction
+	for _, dir := range slices.Backward(x.dirs) {
+		x.setAttributes(dir.path, dir.mode, dir.modTime)
+	}
+
+	return nil
+}
+
+func (x *extractor) mkdirParent(target string) error {
+	dir := path.Dir(target)
+	if dir == "/" {
+		return nil
+	}
+
+	errno := x.client.jfs.MkdirAll(x.mctx, dir, 0o755, 0o022)
+	if errno != 0 && errno != syscall.EEXIST {
+		return fmt.Errorf("create directories: %s", errno)
+	}
+
+	return nil
+}
+
+// setAttributes applies the permission bits and modification time of an entry.
+// Failures are logged, the content of the entry is extracted already.
+func (x *extractor) setAttributes(target string, mode uint16, modTime time.Time) {
+	c := x.client
+
+	f, errno := c.jfs.Open(x.mctx, target, 0)
+	if errno == 0 {
+		defer f.Close(x.mctx)
+
+		if errno = f.Chmod(x.mctx, mode); errno == 0 {
+			errno = f.Utime(x.mctx, modTime.UnixMilli(), modTime.UnixMilli())
+		}
+	}
+
+	if errno != 0 {
+		logger.L().Warn(x.ctx, "Failed to set attributes of extracted entry",
+			zap.String("volume_id", c.volumeID),
+			zap.String("path", target),
+			zap.String("errno", errno.Error()))
+	}
+}
+
+// entryPath returns the path of an archive entry below root.
+// Entries referring to a parent directory are rejected instead of being clamped to root.
+func entryPath(root, name string) (string, error) {
+	if slices.Contains(strings.Split(name, "/"), "..") {
+		return "", fmt.Errorf("%w: entry %q is outside the target directory", ErrInvalidArchive, name)
+	}
+
+	return path.Join(root, path.Clean("/"+name)), nil
+}
+
+// readTar calls add for every entry of a tar stream.
+func readTar(r io.Reader, add func(archiveEntry) error) error {
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+
+		entry := archiveEntry{
+			name:    header.Name,
+			mode:    uint16(header.Mode & 0o777),
+			modTime: header.ModTime,
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			entry.typ = meta.TypeDirectory
+		case tar.TypeReg:
+			entry.typ = meta.TypeFile
+			entry.content = tr
+		case tar.TypeSymlink:
+			entry.typ = meta.TypeSymlink
+			entry.target = header.Linkname
+		default:
+			// Hard links, devices, pipes and extended headers
+			continue
+		}
+
+		if err := add(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// readZip calls add for every entry of a zip archive.
+func readZip(r io.ReaderAt, size int64, add func(archiveEntry) error) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	for _, file := range zr.File {
+		mode := file.Mode()
+
+		entry := archiveEntry{
+			name:    file.Name,
+			mode:    uint16(mode.Perm()),
+			modTime: file.Modified,
+		}
+
+		switch {
+		case mode.IsDir():
+			entry.typ = meta.TypeDirectory
+			if err := add(entry); err != nil {
+				return err
+			}
+		case mode&os.ModeSymlink != 0:
+			// Zip stores the link target as the content of a symlink entry
+			target, err := readZipEntry(file, MaxSymlinkTarget)
+			if err != nil {
+				return err
+			}
+			entry.typ = meta.TypeSymlink
+			entry.target = string(target)
+			if err := add(entry); err != nil {
+				return err
+			}
+		case mode.IsRegular():
+			rc, err := file.Open()
+			if err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+			}
+			entry.typ = meta.TypeFile
+			entry.content = rc
+			err = add(entry)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func readZipEntry(file *zip.File, limit int64) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, limit))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	return content, nil
+}
+
+// spool copies content to a temporary file.
+func spool(content io.Reader) (*os.File, error) {
+	f, err := os.CreateTemp("", "moru-extract-*")
+	if err != nil {
+		return nil, fmt.Errorf("create spool file: %w", err)
+	}
+
+	if _, err := io.Copy(f, content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+
+		return nil, fmt.Errorf("spool archive: %w", err)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,148 @@
+package juicefs
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type readEntry struct {
+	name    string
+	typ     uint8
+	mode    uint16
+	target  string
+	content string
+}
+
+func collectEntries(t *testing.T) (func(archiveEntry) error, *[]readEntry) {
+	t.Helper()
+
+	var entries []readEntry
+
+	return func(entry archiveEntry) error {
+		read := readEntry{name: entry.name, typ: entry.typ, mode: entry.mode, target: entry.target}
+		if entry.content != nil {
+			content, err := io.ReadAll(entry.content)
+			require.NoError(t, err)
+			read.content = string(content)
+		}
+		entries = append(entries, read)
+
+		return nil
+	}, &entries
+}
+
+var expectedEntries = []readEntry{
+	{name: "data/", typ: meta.TypeDirectory, mode: 0o755},
+	{name: "data/a.txt", typ: meta.TypeFile, mode: 0o644, content: "hello"},
+	{name: "data/link", typ: meta.TypeSymlink, mode: 0o777, target: "a.txt"},
+}
+
+func TestReadTar(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writeTestEntries(t, newTarArchive(&buf, nil))
+
+	add, entries := collectEntries(t)
+	require.NoError(t, readTar(&buf, add))
+	assert.Equal(t, expectedEntries, *entries)
+}
+
+func TestReadTar_SkipsHardLinks(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeLink, Name: "b.txt", Linkname: "a.txt"}))
+	require.NoError(t, tw.Close())
+
+	add, entries := collectEntries(t)
+	require.NoError(t, readTar(&buf, add))
+	assert.Empty(t, *entries)
+}
+
+func TestReadTar_Invalid(t *testing.T) {
+	t.Parallel()
+
+	add, _ := collectEntries(t)
+	err := readTar(strings.NewReader(strings.Repeat("not a tar archive", 64)), add)
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestReadZip(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writeTestEntries(t, newZipArchive(&buf))
+
+	add, entries := collectEntries(t)
+	require.NoError(t, readZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), add))
+	assert.Equal(t, expectedEntries, *entries)
+}
+
+func TestReadZip_Invalid(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("not a zip archive")
+
+	add, _ := collectEntries(t)
+	err := readZip(bytes.NewReader(content), int64(len(content)), add)
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestEntryPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		root     string
+		name     string
+		expected string
+	}{
+		{root: "/app", name: "src/main.go", expected: "/app/src/main.go"},
+		{root: "/app", name: "./src/", expected: "/app/src"},
+		{root: "/app", name: "/etc/passwd", expected: "/app/etc/passwd"},
+		{root: "/", name: "a.txt", expected: "/a.txt"},
+		{root: "/app", name: "./", expected: "/app"},
+	}
+
+	for _, tt := range tests {
+		got, err := entryPath(tt.root, tt.name)
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expected, got, tt.name)
+	}
+
+	for _, name := range []string{"../escape", "a/../../escape", ".."} {
+		_, err := entryPath("/app", name)
+		assert.ErrorIs(t, err, ErrInvalidArchive, name)
+	}
+}
+
+func TestArchiveFormatFromContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]ArchiveFormat{
+		"application/x-tar":          ArchiveTar,
+		"application/gzip":           ArchiveTarGz,
+		"application/x-gzip":         ArchiveTarGz,
+		"application/zip":            ArchiveZip,
+		"application/zip; charset=x": ArchiveZip,
+	}
+
+	for contentType, expected := range tests {
+		format, ok := ArchiveFormatFromContentType(contentType)
+		assert.True(t, ok, contentType)
+		assert.Equal(t, expected, format, contentType)
+	}
+
+	for _, contentType := range []string{"application/octet-stream", "", "text/plain"} {
+		_, ok := ArchiveFormatFromContentType(contentType)
+		assert.False(t, ok, contentType)
+	}
+}
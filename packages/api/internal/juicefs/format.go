@@ -4,11 +4,10 @@ import (
 	"context"
 	"fmt"
 
-	"cloud.google.com/go/storage"
 	"go.uber.org/zap"
-	"google.golang.org/api/iterator"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 // FormatConfig holds configuration for formatting a new JuiceFS volume.
@@ -16,52 +15,49 @@ type FormatConfig struct {
 	// VolumeID is the unique identifier for the volume (used in GCS path prefix)
 	VolumeID string
 
+	// MetaEngine is the metadata engine of the volume, Redis metadata lives in the RedisDB database
+	MetaEngine volumestorage.MetaEngine
+	RedisDB    int32
+
 	// PoolConfig contains the shared GCS configuration
 	PoolConfig Config
 }
 
-// gcsPathsForVolume returns the GCS paths for a volume's data and metadata.
+// gcsPathsForVolume returns the bucket prefixes of a volume's data and metadata.
 func gcsPathsForVolume(bucket, volumeID string) (dataPrefix, metaPrefix string) {
 	dataPrefix = volumeID + "/"
-	metaPrefix = volumeID + "-meta/"
+	metaPrefix = volumeID + metaPrefixSuffix
 	return
 }
 
-// FormatVolume creates the GCS bucket paths for a new volume.
+// FormatVolume creates the bucket paths for a new volume.
 // This creates marker files to establish the paths for JuiceFS data and Litestream metadata.
 //
 // JuiceFS metadata initialization is handled by envd during first mount:
 // - litestream restore -if-replica-exists returns success for empty bucket
 // - juicefs format creates fresh SQLite metadata
-// - Litestream starts replicating to GCS
+// - Litestream starts replicating to the bucket
 func FormatVolume(ctx context.Context, cfg FormatConfig) error {
 	dataPrefix, metaPrefix := gcsPathsForVolume(cfg.PoolConfig.GCSBucket, cfg.VolumeID)
 
-	gcsClient, err := storage.NewClient(ctx)
+	bucket, err := openBucketStore(ctx, cfg.PoolConfig.GCSBucket)
 	if err != nil {
-		return fmt.Errorf("create GCS client: %w", err)
+		return err
 	}
-	defer gcsClient.Close()
-
-	bucket := gcsClient.Bucket(cfg.PoolConfig.GCSBucket)
+	defer bucket.close()
 
 	// Create marker files to establish bucket paths
-	// GCS doesn't support empty folders, so we use .keep files
-	markers := []string{
-		dataPrefix + ".keep",
-		metaPrefix + ".keep",
+	// Object storage doesn't support empty folders, so we use .keep files
+	// Redis metadata isn't replicated to the bucket
+	markers := []string{dataPrefix + ".keep"}
+	if cfg.MetaEngine != volumestorage.MetaEngineRedis {
+		markers = append(markers, metaPrefix+".keep")
 	}
 
 	for _, marker := range markers {
-		obj := bucket.Object(marker)
-		writer := obj.NewWriter(ctx)
-		if _, err := writer.Write([]byte{}); err != nil {
-			writer.Close()
+		if err := bucket.put(ctx, marker); err != nil {
 			return fmt.Errorf("write marker %s: %w", marker, err)
 		}
-		if err := writer.Close(); err != nil {
-			return fmt.Errorf("close marker %s: %w", marker, err)
-		}
 	}
 
 	logger.L().Info(ctx, "Volume paths created",
@@ -72,8 +68,43 @@ func FormatVolume(ctx context.Context, cfg FormatConfig) error {
 	return nil
 }
 
+// CheckBucket verifies that the volumes bucket is reachable by listing an object of it.
+func CheckBucket(ctx context.Context, gcsBucket string) error {
+	bucket, err := openBucketStore(ctx, gcsBucket)
+	if err != nil {
+		return err
+	}
+	defer bucket.close()
+
+	if err := bucket.probe(ctx); err != nil {
+		return fmt.Errorf("list objects: %w", err)
+	}
+
+	return nil
+}
+
+// CheckBucketWritable verifies that objects can be written to and deleted from the volumes bucket,
+// by writing an empty object under the given name and deleting it.
+func CheckBucketWritable(ctx context.Context, gcsBucket, object string) error {
+	bucket, err := openBucketStore(ctx, gcsBucket)
+	if err != nil {
+		return err
+	}
+	defer bucket.close()
+
+	if err := bucket.put(ctx, object); err != nil {
+		return fmt.Errorf("write object: %w", err)
+	}
+
+	if err := bucket.delete(ctx, object); err != nil {
+		return fmt.Errorf("delete object: %w", err)
+	}
+
+	return nil
+}
+
 // DestroyVolume removes all JuiceFS data for a volume.
-// This deletes both data objects and metadata from GCS.
+// This deletes the data objects from the bucket and the metadata from the bucket or the volumes Redis.
 func DestroyVolume(ctx context.Context, cfg FormatConfig, deleteData bool) error {
 	if !deleteData {
 		return nil
@@ -81,16 +112,14 @@ func DestroyVolume(ctx context.Context, cfg FormatConfig, deleteData bool) error
 
 	dataPrefix, metaPrefix := gcsPathsForVolume(cfg.PoolConfig.GCSBucket, cfg.VolumeID)
 
-	gcsClient, err := storage.NewClient(ctx)
+	bucket, err := openBucketStore(ctx, cfg.PoolConfig.GCSBucket)
 	if err != nil {
-		return fmt.Errorf("create GCS client: %w", err)
+		return err
 	}
-	defer gcsClient.Close()
-
-	bucket := gcsClient.Bucket(cfg.PoolConfig.GCSBucket)
+	defer bucket.close()
 
 	// Delete all objects under data prefix
-	dataDeleted, err := deleteGCSPrefix(ctx, bucket, dataPrefix)
+	dataDeleted, err := bucket.deletePrefix(ctx, dataPrefix)
 	if err != nil {
 		logger.L().Warn(ctx, "Failed to delete volume data",
 			zap.Error(err),
@@ -102,8 +131,12 @@ func DestroyVolume(ctx context.Context, cfg FormatConfig, deleteData bool) error
 			zap.Int("objects_deleted", dataDeleted))
 	}
 
+	if cfg.MetaEngine == volumestorage.MetaEngineRedis {
+		return destroyVolumeRedisMeta(ctx, cfg)
+	}
+
 	// Delete all objects under metadata prefix
-	metaDeleted, err := deleteGCSPrefix(ctx, bucket, metaPrefix)
+	metaDeleted, err := bucket.deletePrefix(ctx, metaPrefix)
 	if err != nil {
 		logger.L().Warn(ctx, "Failed to delete volume metadata",
 			zap.Error(err),
@@ -118,30 +151,47 @@ func DestroyVolume(ctx context.Context, cfg FormatConfig, deleteData bool) error
 	return nil
 }
 
-// deleteGCSPrefix deletes all objects under a prefix in GCS.
-// Returns the number of objects deleted.
-func deleteGCSPrefix(ctx context.Context, bucket *storage.BucketHandle, prefix string) (int, error) {
-	deleted := 0
+// destroyVolumeRedisMeta deletes the Redis metadata of a volume, its database is left empty.
+func destroyVolumeRedisMeta(ctx context.Context, cfg FormatConfig) error {
+	metaURL, err := redisMetaURL(cfg.PoolConfig, Volume{ID: cfg.VolumeID, RedisDB: cfg.RedisDB})
+	if err != nil {
+		return fmt.Errorf("delete volume metadata: %w", err)
+	}
 
-	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
-	for {
-		attrs, err := it.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return deleted, fmt.Errorf("list objects: %w", err)
-		}
+	if err := destroyRedisMeta(ctx, metaURL); err != nil {
+		return fmt.Errorf("delete volume metadata: %w", err)
+	}
 
-		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil {
-			// Log but continue - best effort deletion
-			logger.L().Debug(ctx, "Failed to delete object",
-				zap.String("object", attrs.Name),
-				zap.Error(err))
-			continue
-		}
-		deleted++
+	logger.L().Info(ctx, "Deleted volume metadata",
+		zap.String("volume_id", cfg.VolumeID),
+		zap.Int32("redis_db", cfg.RedisDB))
+
+	return nil
+}
+
+// DeleteMeta removes the metadata of a volume from its metadata engine, the data objects are kept.
+// It's used once the metadata of the volume was migrated to another engine.
+func DeleteMeta(ctx context.Context, cfg FormatConfig) error {
+	if cfg.MetaEngine == volumestorage.MetaEngineRedis {
+		return destroyVolumeRedisMeta(ctx, cfg)
+	}
+
+	_, metaPrefix := gcsPathsForVolume(cfg.PoolConfig.GCSBucket, cfg.VolumeID)
+
+	bucket, err := openBucketStore(ctx, cfg.PoolConfig.GCSBucket)
+	if err != nil {
+		return err
 	}
+	defer bucket.close()
 
-	return deleted, nil
+	metaDeleted, err := bucket.deletePrefix(ctx, metaPrefix)
+	if err != nil {
+		return fmt.Errorf("delete volume metadata: %w", err)
+	}
+
+	logger.L().Info(ctx, "Deleted volume metadata",
+		zap.String("volume_id", cfg.VolumeID),
+		zap.Int("objects_deleted", metaDeleted))
+
+	return nil
 }
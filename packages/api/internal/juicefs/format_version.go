@@ -0,0 +1,108 @@
+package juicefs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+)
+
+// formatMigrations upgrade the volume metadata from the format version to the next one.
+var formatMigrations = map[int]func(ctx context.Context, c *Client) error{
+	// Version 2 only stamps the metadata with its format version
+	volumeformat.Legacy: func(context.Context, *Client) error { return nil },
+}
+
+// readFormatVersion returns the format version stamped in the volume metadata.
+func readFormatVersion(ctx context.Context, metaDBPath string) (int, error) {
+	output, err := runSQLite(ctx, metaDBPath, volumeformat.ReadSQL())
+	if err != nil {
+		return 0, fmt.Errorf("read format version: %w", err)
+	}
+
+	return volumeformat.Parse(output)
+}
+
+// writeFormatVersion stamps the volume metadata with the format version.
+func writeFormatVersion(ctx context.Context, metaDBPath string, version int) error {
+	if _, err := runSQLite(ctx, metaDBPath, volumeformat.WriteSQL(version)); err != nil {
+		return fmt.Errorf("write format version: %w", err)
+	}
+
+	return nil
+}
+
+func runSQLite(ctx context.Context, metaDBPath, statements string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, SQLite3Binary, metaDBPath, statements)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("sqlite3 failed: %w\nstdout: %s\nstderr: %s",
+			err, stdout.String(), stderr.String())
+	}
+
+	return stdout.String(), nil
+}
+
+// FormatVersion returns the format version of the volume metadata.
+func (c *Client) FormatVersion() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.formatVersion
+}
+
+// MigrateFormat upgrades the volume metadata to the current format version and syncs it to GCS.
+// The volume must not be attached to any sandbox.
+func (c *Client) MigrateFormat(ctx context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return 0, fmt.Errorf("client closed")
+	}
+
+	from := c.formatVersion
+	for c.formatVersion < volumeformat.Current {
+		migrate, ok := formatMigrations[c.formatVersion]
+		if !ok {
+			return c.formatVersion, fmt.Errorf("no migration from format version %d", c.formatVersion)
+		}
+
+		if err := migrate(ctx, c); err != nil {
+			return c.formatVersion, fmt.Errorf("migrate from format version %d: %w", c.formatVersion, err)
+		}
+
+		if err := writeFormatVersion(ctx, c.sqlitePath, c.formatVersion+1); err != nil {
+			return c.formatVersion, err
+		}
+		c.formatVersion++
+	}
+
+	if c.formatVersion == from {
+		return c.formatVersion, nil
+	}
+
+	if err := c.syncToGCSLocked(ctx); err != nil {
+		return c.formatVersion, fmt.Errorf("sync migrated metadata: %w", err)
+	}
+
+	logger.L().Info(ctx, "Migrated volume format",
+		zap.String("volume_id", c.volumeID),
+		zap.Int("from", from),
+		zap.Int("to", c.formatVersion))
+
+	return c.formatVersion, nil
+}
@@ -0,0 +1,105 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// MetaReplicas returns the generation of the Litestream replica of the volume metadata in the bucket.
+// Every sync of the metadata writes new replica objects, the syncs of the sandboxes included,
+// so a client loaded at an older generation misses files written since.
+type MetaReplicas interface {
+	ReplicaGeneration(ctx context.Context, bucket, volumeID string) (int64, error)
+}
+
+// GCSMetaReplicas reads the generation of the metadata replicas from GCS.
+type GCSMetaReplicas struct {
+	gcs *storage.Client
+}
+
+func NewGCSMetaReplicas(ctx context.Context) (*GCSMetaReplicas, error) {
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create GCS client: %w", err)
+	}
+
+	return &GCSMetaReplicas{gcs: gcsClient}, nil
+}
+
+// ReplicaGeneration returns the newest object generation of the replica, 0 when the volume has no replica yet.
+func (r *GCSMetaReplicas) ReplicaGeneration(ctx context.Context, bucket, volumeID string) (int64, error) {
+	query := &storage.Query{Prefix: volumeID + "-meta/"}
+	if err := query.SetAttrSelection([]string{"Name", "Generation"}); err != nil {
+		return 0, fmt.Errorf("select object attributes: %w", err)
+	}
+
+	var generation int64
+	it := r.gcs.Bucket(bucket).Objects(ctx, query)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("list metadata replica: %w", err)
+		}
+
+		generation = max(generation, attrs.Generation)
+	}
+
+	return generation, nil
+}
+
+func (r *GCSMetaReplicas) Close() error {
+	return r.gcs.Close()
+}
+
+// freshness is the current generation of the volume metadata a cached client is compared with.
+// The generations that couldn't be read aren't compared.
+type freshness struct {
+	generation      int64
+	generationKnown bool
+
+	replica      int64
+	replicaKnown bool
+}
+
+// current reports whether the client loaded the current volume metadata.
+func (f freshness) current(pc *pooledClient) bool {
+	if f.generationKnown && pc.generation != f.generation {
+		// Another API instance wrote to the volume since the metadata was loaded
+		return false
+	}
+
+	if f.replicaKnown && pc.client.ReplicaGeneration() != f.replica {
+		// A sandbox or another API instance synced the metadata since it was loaded
+		return false
+	}
+
+	return true
+}
+
+// replicaGeneration returns the generation of the metadata replica of the volume, false when it isn't known.
+func replicaGeneration(ctx context.Context, replicas MetaReplicas, bucket, volumeID string) (int64, bool) {
+	if replicas == nil {
+		return 0, false
+	}
+
+	generation, err := replicas.ReplicaGeneration(ctx, bucket, volumeID)
+	if err != nil {
+		logger.L().Warn(ctx, "Failed to get the volume metadata replica generation",
+			zap.String("volume_id", volumeID),
+			zap.Error(err))
+
+		return 0, false
+	}
+
+	return generation, true
+}
@@ -0,0 +1,169 @@
+package juicefs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/object"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// maxFsckBrokenFiles caps the files with missing objects listed by a fsck report.
+	maxFsckBrokenFiles = 100
+
+	// leakedObjectMinAge is how old a chunk object no slice references must be to be leaked,
+	// younger objects can belong to a write whose slice isn't committed yet.
+	leakedObjectMinAge = time.Hour
+)
+
+// FsckReport describes the inconsistencies found in the metadata and the chunk objects of a volume.
+type FsckReport struct {
+	// MetadataIssues is what the JuiceFS metadata check found, e.g. dangling inodes or wrong directory
+	// statistics, empty when the metadata is consistent
+	MetadataIssues string
+	// MissingObjects counts the blocks referenced by files that aren't in the bucket, their data is lost
+	MissingObjects int64
+	// BrokenFiles are the paths of the files with missing objects, at most maxFsckBrokenFiles
+	BrokenFiles []string
+	// LeakedObjects and LeakedBytes count the chunk objects no file references anymore, e.g. after a crash mid-write
+	LeakedObjects int64
+	LeakedBytes   int64
+	// Repaired reports the metadata was repaired and the leaked objects deleted
+	Repaired bool
+}
+
+// Fsck checks the consistency of the metadata and of the chunk objects of the volume. With repair, the
+// metadata issues JuiceFS can fix are repaired and the leaked objects are deleted, files with missing
+// objects are only reported.
+//
+// The volume must not be written meanwhile, objects being written would be reported as leaked.
+func (c *Client) Fsck(ctx context.Context, repair bool) (*FsckReport, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+	report := &FsckReport{Repaired: repair}
+
+	// The check reports the broken entries it found as its error, repaired ones included
+	if err := c.metaCli.Check(mctx, "/", repair, true, true); err != nil {
+		report.MetadataIssues = err.Error()
+	}
+
+	objects := make(map[string]object.Object)
+	listed, err := c.blob.ListAll(ctx, chunksPrefix, "", false)
+	if err != nil {
+		return nil, fmt.Errorf("list chunk objects: %w", err)
+	}
+	for obj := range listed {
+		// A nil object marks a failed listing
+		if obj == nil {
+			return nil, fmt.Errorf("list chunk objects: listing interrupted")
+		}
+		objects[obj.Key()] = obj
+	}
+
+	inodeSlices := make(map[meta.Ino][]meta.Slice)
+	if errno := c.metaCli.ListSlices(mctx, &inodeSlices, false, false, nil); errno != 0 {
+		return nil, fmt.Errorf("list slices: %s", errno)
+	}
+
+	referenced := make(map[string]struct{})
+	var broken []meta.Ino
+	for ino, sliceList := range inodeSlices {
+		missing := false
+		for _, s := range sliceList {
+			// Holes have no data
+			if s.Id == 0 {
+				continue
+			}
+
+			for _, key := range sliceKeys(s.Id, s.Size, c.format.BlockSize*1024, c.format.HashPrefix) {
+				_, seen := referenced[key]
+				referenced[key] = struct{}{}
+
+				if _, ok := objects[key]; ok {
+					continue
+				}
+				missing = true
+				// Slices shared by several files are counted once
+				if !seen {
+					report.MissingObjects++
+				}
+			}
+		}
+
+		if missing {
+			broken = append(broken, ino)
+		}
+	}
+
+	for _, ino := range broken {
+		if len(report.BrokenFiles) >= maxFsckBrokenFiles {
+			break
+		}
+		report.BrokenFiles = append(report.BrokenFiles, c.metaCli.GetPaths(mctx, ino)...)
+	}
+	report.BrokenFiles = report.BrokenFiles[:min(len(report.BrokenFiles), maxFsckBrokenFiles)]
+	slices.Sort(report.BrokenFiles)
+
+	var leaked []string
+	for key, obj := range objects {
+		if _, ok := referenced[key]; ok || time.Since(obj.Mtime()) < leakedObjectMinAge {
+			continue
+		}
+
+		report.LeakedObjects++
+		report.LeakedBytes += obj.Size()
+		leaked = append(leaked, key)
+	}
+
+	if repair {
+		for _, key := range leaked {
+			if err := c.blob.Delete(ctx, key); err != nil {
+				return nil, fmt.Errorf("delete leaked object %s: %w", key, err)
+			}
+		}
+
+		if err := c.syncToGCSLocked(ctx); err != nil {
+			return nil, fmt.Errorf("sync repaired metadata: %w", err)
+		}
+	}
+
+	logger.L().Info(ctx, "Checked volume consistency",
+		zap.String("volume_id", c.volumeID),
+		zap.Bool("repair", repair),
+		zap.Bool("metadata_issues", report.MetadataIssues != ""),
+		zap.Int64("missing_objects", report.MissingObjects),
+		zap.Int64("leaked_objects", report.LeakedObjects))
+
+	return report, nil
+}
+
+// sliceKeys returns the keys of the chunk objects holding the blocks of a slice, relative to the volume
+// prefix. They follow the layout of the JuiceFS chunk store, the last block is shorter than the others.
+func sliceKeys(id uint64, size uint32, blockSize int, hashPrefix bool) []string {
+	length := int(size)
+	keys := make([]string, 0, (length+blockSize-1)/blockSize)
+
+	for indx := 0; indx*blockSize < length; indx++ {
+		bsize := min(blockSize, length-indx*blockSize)
+
+		if hashPrefix {
+			keys = append(keys, fmt.Sprintf("%s%02X/%d/%d_%d_%d", chunksPrefix, id%256, id/1000/1000, id, indx, bsize))
+		} else {
+			keys = append(keys, fmt.Sprintf("%s%d/%d/%d_%d_%d", chunksPrefix, id/1000/1000, id/1000, id, indx, bsize))
+		}
+	}
+
+	return keys
+}
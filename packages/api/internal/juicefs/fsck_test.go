@@ -0,0 +1,40 @@
+package juicefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceKeys(t *testing.T) {
+	t.Parallel()
+
+	const blockSize = 4 << 20
+
+	t.Run("single block", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"chunks/0/0/1_0_1024"}, sliceKeys(1, 1024, blockSize, false))
+	})
+
+	t.Run("last block is shorter", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{
+			"chunks/1/1234/1234567_0_4194304",
+			"chunks/1/1234/1234567_1_100",
+		}, sliceKeys(1234567, blockSize+100, blockSize, false))
+	})
+
+	t.Run("hash prefix", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"chunks/0F/0/271_0_4194304"}, sliceKeys(271, blockSize, blockSize, true))
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, sliceKeys(1, 0, blockSize, false))
+	})
+}
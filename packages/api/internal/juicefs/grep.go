@@ -0,0 +1,251 @@
+package juicefs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"syscall"
+	"unicode/utf8"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/vfs"
+)
+
+// maxGrepLineText is how much of a matching line is returned, longer lines are cut.
+const maxGrepLineText = 512
+
+// errGrepDone stops the walk once a limit of the grep is reached.
+var errGrepDone = errors.New("grep done")
+
+// GrepQuery is a content search in the files of a volume.
+type GrepQuery struct {
+	Pattern *regexp.Regexp
+	// Glob only searches the files whose name matches the path.Match pattern, all files when empty
+	Glob string
+
+	// MaxMatches stops the search after as many matching lines
+	MaxMatches int
+	// MaxFiles stops the search after reading as many files
+	MaxFiles int
+	// MaxFileSize skips larger files
+	MaxFileSize int64
+}
+
+// GrepMatch is a line matching the pattern.
+type GrepMatch struct {
+	Path string
+	// Line is the 1-based line number
+	Line int
+	// Text is the line without the line break, cut after maxGrepLineText bytes
+	Text string
+}
+
+// GrepResult contains the matching lines of a search.
+type GrepResult struct {
+	Matches []GrepMatch
+	// FilesSearched counts the files read, FilesSkipped the files too large or binary
+	FilesSearched int64
+	FilesSkipped  int64
+	// Truncated is set when the search stopped at a limit before all files were searched
+	Truncated bool
+}
+
+// Grep searches the files below dirPath, or the file at dirPath, for lines matching the pattern of the query.
+// The files are streamed from the volume in path order, symlinks are not followed
+// and binary files are skipped. The search stops at the limits of the query.
+func (c *Client) Grep(ctx context.Context, dirPath string, query GrepQuery) (*GrepResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	switch {
+	case errno == syscall.ENOENT:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, dirPath)
+	case errno != 0:
+		return nil, fmt.Errorf("stat %s: %s", dirPath, errno)
+	}
+
+	result := &GrepResult{Matches: []GrepMatch{}}
+
+	var err error
+	if info.IsDir() {
+		err = c.grepDir(ctx, mctx, query, result, dirPath)
+	} else {
+		err = c.grepFile(mctx, query, result, dirPath, info.Size())
+	}
+	if err != nil && !errors.Is(err, errGrepDone) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// grepDir searches the files of a directory, recursing into subdirectories.
+func (c *Client) grepDir(ctx context.Context, mctx meta.Context, query GrepQuery, result *GrepResult, dirPath string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	f, errno := c.jfs.Open(mctx, dirPath, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", dirPath, errno)
+	}
+	entries, errno := f.ReaddirPlus(mctx, 0)
+	f.Close(mctx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", dirPath, errno)
+	}
+
+	// Sort entries by name so the matches of the same tree are in a stable order
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Name) < string(entries[j].Name)
+	})
+
+	for _, entry := range entries {
+		entryPath := path.Join(dirPath, string(entry.Name))
+
+		switch entry.Attr.Typ {
+		case meta.TypeDirectory:
+			if err := c.grepDir(ctx, mctx, query, result, entryPath); err != nil {
+				return err
+			}
+		case meta.TypeFile:
+			if query.Glob != "" {
+				if ok, _ := path.Match(query.Glob, string(entry.Name)); !ok {
+					continue
+				}
+			}
+
+			if err := c.grepFile(mctx, query, result, entryPath, int64(entry.Attr.Length)); err != nil {
+				return err
+			}
+		default:
+			// Symlinks aren't followed, devices, sockets and pipes have no content
+		}
+	}
+
+	return nil
+}
+
+// grepFile adds the matching lines of a file to the result, errGrepDone is returned once a limit is reached.
+func (c *Client) grepFile(mctx meta.Context, query GrepQuery, result *GrepResult, filePath string, size int64) error {
+	if query.MaxFileSize > 0 && size > query.MaxFileSize {
+		result.FilesSkipped++
+
+		return nil
+	}
+
+	if query.MaxFiles > 0 && result.FilesSearched >= int64(query.MaxFiles) {
+		result.Truncated = true
+
+		return errGrepDone
+	}
+
+	f, errno := c.jfs.Open(mctx, filePath, vfs.MODE_MASK_R)
+	if errno != 0 {
+		return fmt.Errorf("open file %s: %s", filePath, errno)
+	}
+
+	reader := &jfsReader{
+		file: f,
+		ctx:  mctx,
+		size: size,
+	}
+	defer reader.Close()
+
+	maxMatches := 0
+	if query.MaxMatches > 0 {
+		// One more match than the limit tells whether the result is truncated
+		maxMatches = query.MaxMatches - len(result.Matches) + 1
+	}
+
+	matches, binary, err := grepReader(reader, query.Pattern, filePath, maxMatches)
+	if err != nil {
+		return fmt.Errorf("read file %s: %w", filePath, err)
+	}
+	if binary {
+		result.FilesSkipped++
+
+		return nil
+	}
+	result.FilesSearched++
+
+	if query.MaxMatches > 0 && len(result.Matches)+len(matches) > query.MaxMatches {
+		result.Matches = append(result.Matches, matches[:query.MaxMatches-len(result.Matches)]...)
+		result.Truncated = true
+
+		return errGrepDone
+	}
+	result.Matches = append(result.Matches, matches...)
+
+	return nil
+}
+
+// grepReader returns the lines of the content matching the pattern, at most maxMatches when it's positive.
+// Content with a NUL byte in its first sniffLen bytes is reported as binary and not searched.
+func grepReader(r io.Reader, pattern *regexp.Regexp, filePath string, maxMatches int) ([]GrepMatch, bool, error) {
+	br := bufio.NewReader(r)
+
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, false, err
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return nil, true, nil
+	}
+
+	var matches []GrepMatch
+	for line := 1; ; line++ {
+		text, err := br.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			// Lines longer than the buffer are matched on their beginning only
+			text = append([]byte(nil), text...)
+			for errors.Is(err, bufio.ErrBufferFull) {
+				_, err = br.ReadSlice('\n')
+			}
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, false, err
+		}
+
+		text = bytes.TrimRight(text, "\r\n")
+		if len(text) > 0 || err == nil {
+			if pattern.Match(text) {
+				matches = append(matches, GrepMatch{Path: filePath, Line: line, Text: lineText(text)})
+				if maxMatches > 0 && len(matches) == maxMatches {
+					return matches, false, nil
+				}
+			}
+		}
+
+		if err != nil {
+			return matches, false, nil
+		}
+	}
+}
+
+// lineText cuts a matching line to maxGrepLineText bytes, without splitting a UTF-8 sequence.
+func lineText(text []byte) string {
+	if len(text) <= maxGrepLineText {
+		return string(text)
+	}
+
+	cut := maxGrepLineText
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	return string(text[:cut])
+}
@@ -0,0 +1,60 @@
+package juicefs
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrepReader(t *testing.T) {
+	t.Parallel()
+
+	pattern := regexp.MustCompile(`func \w+\(`)
+	content := "package main\r\n\nfunc main() {\n\thello()\n}\n\nfunc hello() {}"
+
+	t.Run("matching lines", func(t *testing.T) {
+		t.Parallel()
+
+		matches, binary, err := grepReader(strings.NewReader(content), pattern, "/main.go", 0)
+		require.NoError(t, err)
+		assert.False(t, binary)
+		assert.Equal(t, []GrepMatch{
+			{Path: "/main.go", Line: 3, Text: "func main() {"},
+			{Path: "/main.go", Line: 7, Text: "func hello() {}"},
+		}, matches)
+	})
+
+	t.Run("max matches", func(t *testing.T) {
+		t.Parallel()
+
+		matches, _, err := grepReader(strings.NewReader(content), pattern, "/main.go", 1)
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		assert.Equal(t, 3, matches[0].Line)
+	})
+
+	t.Run("binary content", func(t *testing.T) {
+		t.Parallel()
+
+		matches, binary, err := grepReader(strings.NewReader("func main(\x00"), pattern, "/main", 0)
+		require.NoError(t, err)
+		assert.True(t, binary)
+		assert.Empty(t, matches)
+	})
+
+	t.Run("long lines", func(t *testing.T) {
+		t.Parallel()
+
+		long := "func main() {" + strings.Repeat("é", 10000)
+		matches, _, err := grepReader(strings.NewReader(long+"\nfunc other() {}\n"), pattern, "/main.go", 0)
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+		assert.Equal(t, 1, matches[0].Line)
+		assert.LessOrEqual(t, len(matches[0].Text), maxGrepLineText)
+		assert.True(t, strings.HasPrefix(long, matches[0].Text))
+		assert.Equal(t, 2, matches[1].Line)
+	})
+}
@@ -0,0 +1,176 @@
+package juicefs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// metaDumpThreads is the number of threads dumping the metadata of a volume being migrated.
+const metaDumpThreads = 10
+
+// MigrateMeta copies the JuiceFS metadata of a volume to another metadata engine, by loading a dump
+// of the source metadata into the empty target. The volume must not be attached to any sandbox.
+//
+// SQLite metadata is replicated to the bucket with Litestream, replacing any replica left by an earlier
+// migration. The source metadata is kept, it's deleted once the volume was moved to the target.
+func MigrateMeta(ctx context.Context, from, to Volume, config Config) error {
+	if from.Bucket != "" {
+		config.GCSBucket = from.Bucket
+	}
+
+	var (
+		source clientMeta
+		err    error
+	)
+	if from.MetaEngine == volumestorage.MetaEngineRedis {
+		source, err = openRedisMeta(ctx, from, config)
+	} else {
+		source, err = openSQLiteMeta(ctx, from.ID, config)
+	}
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(source.tmpDir)
+
+	// Redis metadata has no format version, only current metadata can be moved there
+	if source.formatVersion != volumeformat.Current {
+		return fmt.Errorf("metadata format version %d is not current, migrate the volume format first", source.formatVersion)
+	}
+
+	dumpPath := filepath.Join(source.tmpDir, "meta-dump.json")
+	if err := dumpMeta(source.url, dumpPath); err != nil {
+		return err
+	}
+
+	if to.MetaEngine == volumestorage.MetaEngineRedis {
+		err = loadRedisMeta(ctx, to, config, dumpPath)
+	} else {
+		err = loadSQLiteMeta(ctx, to.ID, config, filepath.Join(source.tmpDir, "target"), dumpPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.L().Info(ctx, "Migrated volume metadata",
+		zap.String("volume_id", from.ID),
+		zap.String("from", string(from.MetaEngine)),
+		zap.String("to", string(to.MetaEngine)))
+
+	return nil
+}
+
+// dumpMeta writes the metadata of the volume to a JSON dump, with the secrets of its format.
+func dumpMeta(metaURL, dumpPath string) error {
+	metaCli := meta.NewClient(metaURL, metaConfig(true))
+	defer metaCli.Shutdown()
+
+	if _, err := metaCli.Load(true); err != nil {
+		return fmt.Errorf("load format: %w", err)
+	}
+
+	f, err := os.Create(dumpPath)
+	if err != nil {
+		return fmt.Errorf("create metadata dump: %w", err)
+	}
+	defer f.Close()
+
+	if err := metaCli.DumpMeta(f, meta.RootInode, metaDumpThreads, true, false, false); err != nil {
+		return fmt.Errorf("dump metadata: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write metadata dump: %w", err)
+	}
+
+	return nil
+}
+
+// loadMeta loads the JSON dump into the empty metadata of the URL.
+func loadMeta(metaURL, dumpPath string) error {
+	f, err := os.Open(dumpPath)
+	if err != nil {
+		return fmt.Errorf("open metadata dump: %w", err)
+	}
+	defer f.Close()
+
+	metaCli := meta.NewClient(metaURL, metaConfig(false))
+	defer metaCli.Shutdown()
+
+	if err := metaCli.LoadMeta(f); err != nil {
+		return fmt.Errorf("load metadata: %w", err)
+	}
+
+	return nil
+}
+
+// loadRedisMeta loads the dump into the database of the volume, which must still be empty.
+func loadRedisMeta(ctx context.Context, volume Volume, config Config, dumpPath string) error {
+	metaURL, err := redisMetaURL(config, volume)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := redisMetaFormatted(ctx, metaURL)
+	if err != nil {
+		return err
+	}
+	if formatted {
+		return fmt.Errorf("redis database %d already holds a volume", volume.RedisDB)
+	}
+
+	return loadMeta(metaURL, dumpPath)
+}
+
+// loadSQLiteMeta loads the dump into a new SQLite database, stamps it with the current format version
+// and replicates it to the bucket in place of the previous replica of the volume.
+func loadSQLiteMeta(ctx context.Context, volumeID string, config Config, dir, dumpPath string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	metaDBPath := filepath.Join(dir, "meta.db")
+
+	// Litestream tracks the changes of WAL mode databases only
+	if err := loadMeta("sqlite3://"+metaDBPath+"?_journal=WAL&_timeout=5000", dumpPath); err != nil {
+		return err
+	}
+
+	if err := writeFormatVersion(ctx, metaDBPath, volumeformat.Current); err != nil {
+		return err
+	}
+
+	if err := checkWrite(ctx); err != nil {
+		return err
+	}
+
+	// A replica left by an earlier migration belongs to another database
+	_, metaPrefix := gcsPathsForVolume(config.GCSBucket, volumeID)
+	bucket, err := openBucketStore(ctx, config.GCSBucket)
+	if err != nil {
+		return err
+	}
+	defer bucket.close()
+
+	if _, err := bucket.deletePrefix(ctx, metaPrefix); err != nil {
+		return fmt.Errorf("delete previous metadata replica: %w", err)
+	}
+
+	return syncViaLitestream(ctx, volumeID, metaDBPath, config.GCSBucket)
+}
+
+// metaConfig returns the configuration of the metadata clients of a migration.
+func metaConfig(readOnly bool) *meta.Config {
+	conf := meta.DefaultConf()
+	conf.Retries = 10
+	conf.ReadOnly = readOnly
+
+	return conf
+}
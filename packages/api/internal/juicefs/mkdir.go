@@ -0,0 +1,74 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"syscall"
+)
+
+var (
+	// ErrExists is returned when a directory can't be created because the path exists.
+	ErrExists = errors.New("path already exists")
+
+	// ErrNotDirectory is returned when a path component is not a directory.
+	ErrNotDirectory = errors.New("not a directory")
+)
+
+// Mkdir creates a directory at the given path. With recursive, missing parent directories
+// are created too and an existing directory is not an error, like mkdir -p.
+// After creation, syncs metadata to GCS.
+func (c *Client) Mkdir(ctx context.Context, dirPath string, recursive bool) (*FileInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	var errno syscall.Errno
+	if recursive {
+		errno = c.jfs.MkdirAll(mctx, dirPath, 0o755, 0o022)
+	} else {
+		errno = c.jfs.Mkdir(mctx, dirPath, 0o755, 0o022)
+	}
+
+	switch {
+	case errno == syscall.EEXIST && !recursive:
+		return nil, fmt.Errorf("%w: %s", ErrExists, dirPath)
+	case errno == syscall.ENOENT:
+		return nil, fmt.Errorf("%w: parent of %s", ErrNotFound, dirPath)
+	case errno == syscall.ENOTDIR:
+		return nil, fmt.Errorf("%w: parent of %s", ErrNotDirectory, dirPath)
+	case errno != 0 && errno != syscall.EEXIST:
+		return nil, fmt.Errorf("create directory: %s", errno)
+	}
+
+	// An existing path may be a file, which doesn't satisfy mkdir -p either
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	if errno == syscall.ENOTDIR {
+		return nil, fmt.Errorf("%w: parent of %s", ErrNotDirectory, dirPath)
+	}
+	if errno != 0 {
+		return nil, fmt.Errorf("stat directory: %s", errno)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", ErrExists, dirPath)
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "mkdir", dirPath); err != nil {
+		return nil, err
+	}
+
+	return &FileInfo{
+		Name:       path.Base(dirPath),
+		Path:       dirPath,
+		Type:       "directory",
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime(),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// UploadsDir is the directory on the volume that stages the parts of multipart uploads.
+// Staging the parts in the volume keeps them across API restarts, so uploads can resume.
+const UploadsDir = "/.moru-uploads"
+
+// ErrPartMissing is returned when completing an upload with a part that was never staged.
+var ErrPartMissing = errors.New("upload part missing")
+
+// UploadPart describes a part of a multipart upload, in the order of the final file.
+type UploadPart struct {
+	Number int32
+	Size   int64
+}
+
+// partPath returns the staging path of an upload part.
+func partPath(uploadID string, number int32) string {
+	return path.Join(UploadsDir, uploadID, fmt.Sprintf("%05d", number))
+}
+
+// WritePart stages the content of a multipart upload part, replacing an earlier attempt
+// of the same part. Staged parts count against sizeLimit and a part can't be larger than maxSize, 0 for no limit.
+// Returns the size and the SHA-256 checksum of the staged content.
+func (c *Client) WritePart(ctx context.Context, uploadID string, number int32, content io.Reader, sizeLimit, maxSize int64) (int64, string, error) {
+	// Parts aren't served, their type isn't detected
+	size, checksums, err := c.Upload(ctx, partPath(uploadID, number), content, UploadOptions{
+		SizeLimit:   sizeLimit,
+		MaxSize:     maxSize,
+		ContentType: DefaultContentType,
+	})
+	if err != nil {
+		return size, "", err
+	}
+
+	return size, checksums.SHA256, nil
+}
+
+// CompleteUpload assembles the staged parts into the file at dstPath and removes the staging directory.
+// The parts are concatenated in metadata, the file references the chunks written for the parts.
+// After completion, syncs metadata to GCS.
+func (c *Client) CompleteUpload(ctx context.Context, uploadID string, parts []UploadPart, dstPath string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return 0, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	// Create parent directories
+	dir := filepath.Dir(dstPath)
+	if dir != "/" && dir != "." {
+		errno := c.jfs.MkdirAll(mctx, dir, 0o755, 0o022)
+		if errno != 0 && errno != syscall.EEXIST {
+			return 0, fmt.Errorf("create directories: %s", errno)
+		}
+	}
+
+	// Assemble into a staging file and rename it, so readers never see a partial file
+	assembled := path.Join(UploadsDir, uploadID, "assembled")
+	f, errno := c.jfs.Create(mctx, assembled, 0o644, 0o022)
+	if errno == syscall.EEXIST {
+		// Left behind by an interrupted completion
+		if errno = c.jfs.Truncate(mctx, assembled, 0); errno != 0 {
+			return 0, fmt.Errorf("truncate staging file: %s", errno)
+		}
+		f, errno = c.jfs.Open(mctx, assembled, 0)
+	}
+	if errno != 0 {
+		return 0, fmt.Errorf("create staging file: %s", errno)
+	}
+	dstIno := f.Inode()
+	f.Close(mctx)
+
+	var offset uint64
+	for _, part := range parts {
+		info, errno := c.jfs.Stat(mctx, partPath(uploadID, part.Number))
+		if errno != 0 {
+			if errno == syscall.ENOENT {
+				return 0, fmt.Errorf("%w: %d", ErrPartMissing, part.Number)
+			}
+			return 0, fmt.Errorf("stat part %d: %s", part.Number, errno)
+		}
+		if info.Size() != part.Size {
+			return 0, fmt.Errorf("%w: part %d has %d bytes staged, expected %d", ErrPartMissing, part.Number, info.Size(), part.Size)
+		}
+
+		if part.Size > 0 {
+			var copied, outLength uint64
+			errno = c.metaCli.CopyFileRange(mctx, info.Inode(), 0, dstIno, offset, uint64(part.Size), 0, &copied, &outLength)
+			if errno != 0 {
+				return 0, fmt.Errorf("append part %d: %s", part.Number, errno)
+			}
+		}
+		offset += uint64(part.Size)
+	}
+
+	if errno := c.jfs.Rename(mctx, assembled, dstPath, 0); errno != 0 {
+		return 0, fmt.Errorf("move to destination: %s", errno)
+	}
+
+	if errno := c.jfs.Rmr(mctx, path.Join(UploadsDir, uploadID), true, 1); errno != 0 && errno != syscall.ENOENT {
+		logger.L().Warn(ctx, "Failed to remove upload staging directory",
+			zap.String("volume_id", c.volumeID),
+			zap.String("upload_id", uploadID),
+			zap.String("errno", errno.Error()))
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "completing upload", dstPath); err != nil {
+		return 0, err
+	}
+
+	return int64(offset), nil
+}
+
+// AbortUpload removes the staged parts of an upload.
+func (c *Client) AbortUpload(ctx context.Context, uploadID string) error {
+	return c.Delete(ctx, path.Join(UploadsDir, uploadID), true)
+}
@@ -0,0 +1,338 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/vfs"
+)
+
+// errListDone stops the walk once the requested page of keys is found.
+var errListDone = errors.New("list done")
+
+// Object describes a file of a volume served as an object of a bucket.
+type Object struct {
+	// Key is the path of the file without the leading slash
+	Key         string
+	Size        int64
+	ModifiedAt  time.Time
+	ContentType string
+}
+
+// ObjectListing contains a page of the keys of a volume.
+type ObjectListing struct {
+	Objects []Object
+	// CommonPrefixes are the directories below the prefix, listed instead of their files when delimited
+	CommonPrefixes []string
+	// IsTruncated is set when more keys follow the page, the listing continues after NextKey
+	IsTruncated bool
+	// NextKey is the last key or common prefix of the page
+	NextKey string
+}
+
+// ObjectQuery selects the keys of a listing.
+type ObjectQuery struct {
+	// Prefix selects the keys starting with it
+	Prefix string
+	// Delimited lists the directories below the prefix as common prefixes, "/" is the delimiter
+	Delimited bool
+	// StartAfter selects the keys sorting after it
+	StartAfter string
+	// MaxKeys is the number of keys and common prefixes of the page
+	MaxKeys int
+}
+
+// keyPath returns the path of the file or directory of a key.
+func keyPath(key string) string {
+	return "/" + strings.TrimSuffix(key, "/")
+}
+
+// objectListing holds the state of a single listing walk.
+type objectListing struct {
+	query  ObjectQuery
+	result ObjectListing
+}
+
+// ListObjects lists the files of the volume as the keys of a bucket, in the lexicographic order of the keys.
+// The key of a file is its path without the leading slash. Symlinks to files are listed as files, symlinks
+// to directories and empty directories have no keys. The staged uploads aren't listed.
+// The walk starts at the deepest directory of the prefix and stops as soon as the page is complete.
+func (c *Client) ListObjects(ctx context.Context, query ObjectQuery) (*ObjectListing, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	l := &objectListing{query: query}
+	l.result.Objects = []Object{}
+	l.result.CommonPrefixes = []string{}
+
+	// Keys below a directory share its path as prefix
+	dirKey := query.Prefix[:strings.LastIndex(query.Prefix, "/")+1]
+	dirPath := keyPath(dirKey)
+
+	// Keys are clean paths, no key starts with a prefix like "a//" or "../"
+	if path.Clean(dirPath) != dirPath {
+		return &l.result, nil
+	}
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	switch {
+	case errno == syscall.ENOENT || errno == syscall.ENOTDIR:
+		return &l.result, nil
+	case errno != 0:
+		return nil, fmt.Errorf("stat %s: %s", dirKey, errno)
+	case !info.IsDir():
+		return &l.result, nil
+	}
+
+	err := c.listObjectsDir(ctx, mctx, l, dirKey)
+	if err != nil && !errors.Is(err, errListDone) {
+		return nil, err
+	}
+
+	return &l.result, nil
+}
+
+// listObjectsDir adds the keys of a directory to the listing, recursing into subdirectories.
+// Entries are visited in the order of their keys, a directory sorts as its name followed by the delimiter.
+func (c *Client) listObjectsDir(ctx context.Context, mctx meta.Context, l *objectListing, dirKey string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	dirPath := keyPath(dirKey)
+
+	f, errno := c.jfs.Open(mctx, dirPath, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", dirPath, errno)
+	}
+	entries, errno := f.ReaddirPlus(mctx, 0)
+	f.Close(mctx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", dirPath, errno)
+	}
+
+	sortKey := func(entry *meta.Entry) string {
+		if entry.Attr.Typ == meta.TypeDirectory {
+			return string(entry.Name) + "/"
+		}
+
+		return string(entry.Name)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return sortKey(entries[i]) < sortKey(entries[j])
+	})
+
+	for _, entry := range entries {
+		key := dirKey + string(entry.Name)
+
+		// Staged uploads aren't contents of the volume
+		if keyPath(key) == UploadsDir {
+			continue
+		}
+
+		switch entry.Attr.Typ {
+		case meta.TypeDirectory:
+			if err := c.listObjectsSubdir(ctx, mctx, l, key+"/"); err != nil {
+				return err
+			}
+		case meta.TypeSymlink:
+			info, errno := c.jfs.Stat(mctx, keyPath(key))
+			if errno != 0 || info.IsDir() {
+				// Dangling symlinks and symlinks to directories have no keys
+				continue
+			}
+
+			if err := l.addObject(Object{Key: key, Size: info.Size(), ModifiedAt: info.ModTime()}); err != nil {
+				return err
+			}
+		default:
+			modTime := time.Unix(entry.Attr.Mtime, int64(entry.Attr.Mtimensec))
+			if err := l.addObject(Object{Key: key, Size: int64(entry.Attr.Length), ModifiedAt: modTime}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// listObjectsSubdir adds the keys of a subdirectory, or the subdirectory as a common prefix when delimited.
+// Subdirectories outside of the prefix or sorting entirely before StartAfter are skipped.
+func (c *Client) listObjectsSubdir(ctx context.Context, mctx meta.Context, l *objectListing, dirKey string) error {
+	query := l.query
+
+	inPrefix := strings.HasPrefix(dirKey, query.Prefix)
+	if !inPrefix && !strings.HasPrefix(query.Prefix, dirKey) {
+		return nil
+	}
+
+	if inPrefix && query.Delimited {
+		if dirKey <= query.StartAfter {
+			return nil
+		}
+
+		if l.full() {
+			return errListDone
+		}
+		l.result.CommonPrefixes = append(l.result.CommonPrefixes, dirKey)
+		l.result.NextKey = dirKey
+
+		return nil
+	}
+
+	if dirKey < query.StartAfter && !strings.HasPrefix(query.StartAfter, dirKey) {
+		return nil
+	}
+
+	return c.listObjectsDir(ctx, mctx, l, dirKey)
+}
+
+// addObject adds a file to the page when its key is selected.
+func (l *objectListing) addObject(object Object) error {
+	if !strings.HasPrefix(object.Key, l.query.Prefix) || object.Key <= l.query.StartAfter {
+		return nil
+	}
+
+	if l.full() {
+		return errListDone
+	}
+	l.result.Objects = append(l.result.Objects, object)
+	l.result.NextKey = object.Key
+
+	return nil
+}
+
+// full reports whether the page is complete, the listing is truncated when another key is found then.
+func (l *objectListing) full() bool {
+	if len(l.result.Objects)+len(l.result.CommonPrefixes) < l.query.MaxKeys {
+		return false
+	}
+	l.result.IsTruncated = true
+
+	return true
+}
+
+// StatObject returns the file at the path as an object, following symlinks.
+// Directories aren't objects, ErrNotFound is returned for them like for missing files.
+func (c *Client) StatObject(ctx context.Context, filePath string) (*Object, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Stat(mctx, filePath)
+	switch {
+	case errno == syscall.ENOENT || errno == syscall.ENOTDIR || errno == syscall.ELOOP:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, filePath)
+	case errno != 0:
+		return nil, fmt.Errorf("stat: %s", errno)
+	case info.IsDir():
+		return nil, fmt.Errorf("%w: %s is a directory", ErrNotFound, filePath)
+	}
+
+	contentType, err := c.contentType(mctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Object{
+		Key:         strings.TrimPrefix(filePath, "/"),
+		Size:        info.Size(),
+		ModifiedAt:  info.ModTime(),
+		ContentType: contentType,
+	}, nil
+}
+
+// OpenObject opens the file at the path for reading as an object, following symlinks.
+// The reader seeks, so ranges of the object can be served.
+func (c *Client) OpenObject(ctx context.Context, filePath string) (io.ReadSeekCloser, *Object, error) {
+	object, err := c.StatObject(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	f, errno := c.jfs.Open(mctx, filePath, vfs.MODE_MASK_R)
+	if errno != 0 {
+		if errno == syscall.ENOENT {
+			return nil, nil, fmt.Errorf("%w: %s", ErrNotFound, filePath)
+		}
+		return nil, nil, fmt.Errorf("open file: %s", errno)
+	}
+
+	// The file may have been replaced since the stat, the size must be the one of the opened file
+	info, err := f.Stat()
+	if err != nil {
+		f.Close(mctx)
+		return nil, nil, fmt.Errorf("stat file: %w", err)
+	}
+	object.Size = info.Size()
+
+	return &jfsReader{file: f, ctx: mctx, size: object.Size}, object, nil
+}
+
+// DeleteObject removes the file at the path, or the directory at the path if it's empty with dir.
+// Like deleting an object of a bucket, deleting a missing file succeeds and a directory isn't removed as a file.
+// After deletion, syncs metadata to GCS.
+func (c *Client) DeleteObject(ctx context.Context, filePath string, dir bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Lstat(mctx, filePath)
+	switch {
+	case errno == syscall.ENOENT || errno == syscall.ENOTDIR:
+		return nil
+	case errno != 0:
+		return fmt.Errorf("stat: %s", errno)
+	case info.IsDir() != dir:
+		return nil
+	}
+
+	errno = c.jfs.Delete(mctx, filePath)
+	switch {
+	case errno == syscall.ENOENT || errno == syscall.ENOTEMPTY:
+		// A directory with files keeps existing like the prefix of their keys
+		return nil
+	case errno != 0:
+		return fmt.Errorf("delete: %s", errno)
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "delete", filePath); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package juicefs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// metaPrefixSuffix ends the prefix of the metadata replica of a volume, next to the prefix of its data.
+const metaPrefixSuffix = "-meta/"
+
+// ListPrefixes returns the top level prefixes of the bucket, the volumes keep their data and metadata
+// replica under prefixes named after them.
+func ListPrefixes(ctx context.Context, gcsBucket string) ([]string, error) {
+	bucket, err := openBucketStore(ctx, gcsBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.close()
+
+	return bucket.listPrefixes(ctx)
+}
+
+// PrefixVolumeID returns the ID of the volume a top level prefix of a bucket belongs to.
+func PrefixVolumeID(prefix string) string {
+	if id, ok := strings.CutSuffix(prefix, metaPrefixSuffix); ok {
+		return id
+	}
+
+	return strings.TrimSuffix(prefix, "/")
+}
+
+// DeletePrefix deletes all objects under the prefix of the bucket.
+func DeletePrefix(ctx context.Context, gcsBucket, prefix string) error {
+	bucket, err := openBucketStore(ctx, gcsBucket)
+	if err != nil {
+		return err
+	}
+	defer bucket.close()
+
+	deleted, err := bucket.deletePrefix(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("delete prefix: %w", err)
+	}
+
+	logger.L().Info(ctx, "Deleted prefix",
+		zap.String("bucket", gcsBucket),
+		zap.String("prefix", prefix),
+		zap.Int("objects_deleted", deleted))
+
+	return nil
+}
+
+// ListRedisMetaDBs returns the databases of the volumes Redis holding keys. Database 0 is never
+// given to a volume and isn't listed.
+func ListRedisMetaDBs(ctx context.Context, config Config) ([]int32, error) {
+	if config.RedisURL == "" {
+		return nil, ErrRedisNotConfigured
+	}
+
+	rdb, err := openRedisDB(config.RedisURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rdb.Close()
+
+	info, err := rdb.Info(ctx, "keyspace").Result()
+	if err != nil {
+		return nil, fmt.Errorf("get keyspace info: %w", err)
+	}
+
+	return parseKeyspace(info), nil
+}
+
+// DeleteRedisMetaDB deletes the metadata in the database of the volumes Redis.
+func DeleteRedisMetaDB(ctx context.Context, config Config, db int32) error {
+	metaURL, err := redisMetaURL(config, Volume{RedisDB: db})
+	if err != nil {
+		return err
+	}
+
+	if err := destroyRedisMeta(ctx, metaURL); err != nil {
+		return err
+	}
+
+	logger.L().Info(ctx, "Deleted redis database", zap.Int32("redis_db", db))
+
+	return nil
+}
+
+// parseKeyspace returns the databases in the keyspace section of the Redis INFO, with lines like
+// "db3:keys=10,expires=0,avg_ttl=0".
+func parseKeyspace(info string) []int32 {
+	var dbs []int32
+
+	scanner := bufio.NewScanner(strings.NewReader(info))
+	for scanner.Scan() {
+		name, _, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.HasPrefix(name, "db") {
+			continue
+		}
+
+		db, err := strconv.ParseInt(strings.TrimPrefix(name, "db"), 10, 32)
+		if err != nil || db == 0 {
+			continue
+		}
+
+		dbs = append(dbs, int32(db))
+	}
+
+	return dbs
+}
@@ -0,0 +1,29 @@
+package juicefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixVolumeID(t *testing.T) {
+	t.Parallel()
+
+	dataPrefix, metaPrefix := gcsPathsForVolume("bucket", "vol-abc")
+	assert.Equal(t, "vol-abc", PrefixVolumeID(dataPrefix))
+	assert.Equal(t, "vol-abc", PrefixVolumeID(metaPrefix))
+	assert.Equal(t, ".downloads", PrefixVolumeID(StagedDownloadsPrefix))
+}
+
+func TestParseKeyspace(t *testing.T) {
+	t.Parallel()
+
+	info := "# Keyspace\r\n" +
+		"db0:keys=3,expires=0,avg_ttl=0\r\n" +
+		"db2:keys=10,expires=0,avg_ttl=0\r\n" +
+		"db17:keys=1,expires=1,avg_ttl=100\r\n" +
+		"dbx:keys=1\r\n"
+
+	assert.Equal(t, []int32{2, 17}, parseKeyspace(info))
+	assert.Empty(t, parseKeyspace("# Keyspace\r\n"))
+}
@@ -4,20 +4,38 @@ package juicefs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
+const (
+	// evictGrace is how long after its last use a client can't be evicted, a request can still be using it.
+	evictGrace = time.Minute
+
+	// refreshInterval is how often the cached clients are checked for metadata synced by the sandboxes.
+	refreshInterval = 30 * time.Second
+)
+
+// ErrPoolFull is returned when the pool has no room for another client and all the cached clients are in use.
+var ErrPoolFull = errors.New("too many volumes are open")
+
 // Pool manages a pool of JuiceFS clients, one per volume.
 // Clients are cached and reused to avoid repeated initialization.
-// Cache is invalidated when volume mount state changes (sandbox starts/stops).
+// The number of clients is bounded by the configuration, the least recently used client is evicted for a new one.
+// Cache is invalidated when volume mount state changes (sandbox starts/stops),
+// when another API instance wrote to the volume and when the metadata replica changed upstream.
 type Pool struct {
-	config Config
+	config      Config
+	generations Generations
 
 	mu      sync.RWMutex
 	clients map[string]*pooledClient
@@ -29,48 +47,171 @@ type Pool struct {
 type pooledClient struct {
 	client   *Client
 	lastUsed time.Time
+
+	// Generation of the volume metadata the client was loaded at
+	generation int64
+}
+
+// Generations returns the generation of the volume metadata, bumped by every write through the API.
+type Generations interface {
+	Generation(ctx context.Context, volumeID string) (int64, error)
 }
 
 // NewPool creates a new client pool with the given configuration.
-func NewPool(config Config) *Pool {
+// Without generations, the clients are only invalidated on the mount state changes.
+func NewPool(config Config, generations Generations, meterProvider metric.MeterProvider) *Pool {
 	p := &Pool{
 		config:      config,
+		generations: generations,
 		clients:     make(map[string]*pooledClient),
 		idleTimeout: 5 * time.Minute,
 	}
 
+	meter := meterProvider.Meter("api.juicefs.pool")
+	_, err := telemetry.GetObservableUpDownCounter(meter, telemetry.ApiVolumeClientPoolSizeMeterName, func(_ context.Context, observer metric.Int64Observer) error {
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+
+		observer.Observe(int64(len(p.clients)))
+
+		return nil
+	})
+	if err != nil {
+		logger.L().Error(context.Background(), "Error registering volume client pool size metric", zap.Error(err))
+	}
+
 	// Start background cleanup goroutine
 	go p.cleanupLoop()
 
+	if config.MetaReplicas != nil {
+		go p.refreshLoop()
+	}
+
 	return p
 }
 
 // Get returns a client for the given volume, creating one if needed.
-// The redisDB parameter is deprecated and ignored (kept for API compatibility).
-func (p *Pool) Get(ctx context.Context, volumeID string, _ int32) (*Client, error) {
+func (p *Pool) Get(ctx context.Context, volume Volume) (*Client, error) {
+	volumeID := volume.ID
+	current := p.freshness(ctx, volume)
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	// Check for existing client
 	if pc, ok := p.clients[volumeID]; ok {
-		pc.lastUsed = time.Now()
-		return pc.client, nil
+		if current.current(pc) {
+			pc.lastUsed = time.Now()
+			return pc.client, nil
+		}
+
+		// The volume metadata changed since the client loaded it
+		p.closeClient(volumeID, pc)
+	}
+
+	// Each client holds a write buffer, make room instead of growing past the limit
+	if len(p.clients) >= p.config.maxClients() {
+		if err := p.evictLocked(); err != nil {
+			return nil, err
+		}
 	}
 
 	// Create new client
-	client, err := NewClient(volumeID, 0, p.config)
+	client, err := NewClient(volume, p.config)
 	if err != nil {
 		return nil, fmt.Errorf("create client for volume %s: %w", volumeID, err)
 	}
 
 	p.clients[volumeID] = &pooledClient{
-		client:   client,
-		lastUsed: time.Now(),
+		client:     client,
+		lastUsed:   time.Now(),
+		generation: current.generation,
 	}
 
 	return client, nil
 }
 
+// Open returns the cached client of the volume, or a new client that isn't added to the pool.
+// The returned function releases the client. Used by the background jobs going through all the volumes,
+// so they don't evict the clients of the requests.
+func (p *Pool) Open(ctx context.Context, volume Volume) (*Client, func(), error) {
+	volumeID := volume.ID
+	current := p.freshness(ctx, volume)
+
+	p.mu.Lock()
+	if pc, ok := p.clients[volumeID]; ok && current.current(pc) {
+		pc.lastUsed = time.Now()
+		p.mu.Unlock()
+
+		return pc.client, func() {}, nil
+	}
+	p.mu.Unlock()
+
+	client, err := NewClient(volume, p.config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create client for volume %s: %w", volumeID, err)
+	}
+
+	release := func() {
+		if err := client.Close(); err != nil {
+			logger.L().Warn(context.Background(), "Error closing volume client",
+				zap.String("volume_id", volumeID),
+				zap.Error(err))
+		}
+	}
+
+	return client, release, nil
+}
+
+// freshness returns the current generations of the volume metadata, read before taking the pool lock.
+// Redis metadata is shared live by the sandboxes and the API instances, the clients are always current.
+func (p *Pool) freshness(ctx context.Context, volume Volume) freshness {
+	var f freshness
+	if volume.MetaEngine == volumestorage.MetaEngineRedis {
+		return f
+	}
+
+	bucket := volume.Bucket
+	if bucket == "" {
+		bucket = p.config.GCSBucket
+	}
+
+	f.generation, f.generationKnown = p.generation(ctx, volume.ID)
+	f.replica, f.replicaKnown = replicaGeneration(ctx, p.config.MetaReplicas, bucket, volume.ID)
+
+	return f
+}
+
+// generation returns the current generation of the volume metadata and whether it can be compared
+// with the generation of the cached client.
+func (p *Pool) generation(ctx context.Context, volumeID string) (int64, bool) {
+	if p.generations == nil {
+		return 0, false
+	}
+
+	generation, err := p.generations.Generation(ctx, volumeID)
+	if err != nil {
+		logger.L().Warn(ctx, "Failed to get the volume metadata generation, using the cached client",
+			zap.String("volume_id", volumeID),
+			zap.Error(err))
+
+		return 0, false
+	}
+
+	return generation, true
+}
+
+// Advance records that the cached client of the volume wrote the given generation of the volume metadata,
+// so it isn't reloaded.
+func (p *Pool) Advance(volumeID string, generation int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pc, ok := p.clients[volumeID]; ok {
+		pc.generation = generation
+	}
+}
+
 // InvalidateVolume removes a volume's cached client.
 // This should be called when a sandbox starts or stops with the volume attached,
 // as the volume's metadata may have changed.
@@ -79,17 +220,61 @@ func (p *Pool) InvalidateVolume(volumeID string) {
 	defer p.mu.Unlock()
 
 	if pc, ok := p.clients[volumeID]; ok {
-		// Close the client (best effort - ignore errors during invalidation)
-		if err := pc.client.Close(); err != nil {
-			logger.L().Warn(context.Background(), "Error closing invalidated volume client",
-				zap.String("volume_id", volumeID),
-				zap.Error(err))
+		p.closeClient(volumeID, pc)
+	}
+}
+
+// closeClient closes and removes a cached client, the pool lock must be held.
+func (p *Pool) closeClient(volumeID string, pc *pooledClient) {
+	// Close the client (best effort - ignore errors during invalidation)
+	if err := pc.client.Close(); err != nil {
+		logger.L().Warn(context.Background(), "Error closing invalidated volume client",
+			zap.String("volume_id", volumeID),
+			zap.Error(err))
+	}
+	delete(p.clients, volumeID)
+
+	logger.L().Info(context.Background(), "Invalidated volume client cache",
+		zap.String("volume_id", volumeID))
+}
+
+// evictLocked closes the least recently used client, the pool lock must be held.
+// Returns ErrPoolFull when it was used too recently to be closed.
+func (p *Pool) evictLocked() error {
+	var (
+		lruVolumeID string
+		lru         *pooledClient
+	)
+	for volumeID, pc := range p.clients {
+		if lru == nil || pc.lastUsed.Before(lru.lastUsed) {
+			lruVolumeID, lru = volumeID, pc
 		}
-		delete(p.clients, volumeID)
+	}
+
+	if lru == nil {
+		return nil
+	}
 
-		logger.L().Info(context.Background(), "Invalidated volume client cache",
-			zap.String("volume_id", volumeID))
+	if time.Since(lru.lastUsed) < evictGrace {
+		return ErrPoolFull
 	}
+
+	if err := lru.client.Close(); err != nil {
+		logger.L().Warn(context.Background(), "Error closing evicted volume client",
+			zap.String("volume_id", lruVolumeID),
+			zap.Error(err))
+	}
+	delete(p.clients, lruVolumeID)
+
+	logger.L().Debug(context.Background(), "Evicted least recently used volume client",
+		zap.String("volume_id", lruVolumeID))
+
+	return nil
+}
+
+// MaxClients returns how many clients the pool caches at most.
+func (p *Pool) MaxClients() int {
+	return p.config.maxClients()
 }
 
 // Config returns the pool's configuration.
@@ -126,6 +311,75 @@ func (p *Pool) cleanupLoop() {
 	}
 }
 
+// refreshLoop periodically reloads the clients whose metadata changed upstream.
+func (p *Pool) refreshLoop() {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		p.refresh(context.Background())
+	}
+}
+
+// refresh reloads the cached clients whose metadata replica changed upstream, so the next request
+// doesn't wait for the reload. The clients used recently are left to Get, a request can still be using them.
+func (p *Pool) refresh(ctx context.Context) {
+	p.mu.RLock()
+	idle := make(map[string]*pooledClient, len(p.clients))
+	for volumeID, pc := range p.clients {
+		// Only SQLite metadata is replicated
+		if pc.client.metaEngine == volumestorage.MetaEngineRedis {
+			continue
+		}
+
+		if time.Since(pc.lastUsed) >= evictGrace {
+			idle[volumeID] = pc
+		}
+	}
+	p.mu.RUnlock()
+
+	for volumeID, pc := range idle {
+		replica, ok := replicaGeneration(ctx, p.config.MetaReplicas, pc.client.config.GCSBucket, volumeID)
+		if !ok || replica == pc.client.ReplicaGeneration() {
+			continue
+		}
+
+		client, err := NewClient(Volume{ID: volumeID, MetaEngine: pc.client.metaEngine}, pc.client.config)
+		if err != nil {
+			logger.L().Warn(ctx, "Failed to reload the volume metadata",
+				zap.String("volume_id", volumeID),
+				zap.Error(err))
+
+			continue
+		}
+
+		p.mu.Lock()
+		if p.clients[volumeID] != pc || time.Since(pc.lastUsed) < evictGrace {
+			// The client was replaced or used while the metadata was reloaded
+			p.mu.Unlock()
+			client.Close()
+
+			continue
+		}
+
+		if err := pc.client.Close(); err != nil {
+			logger.L().Warn(ctx, "Error closing refreshed volume client",
+				zap.String("volume_id", volumeID),
+				zap.Error(err))
+		}
+		p.clients[volumeID] = &pooledClient{
+			client:     client,
+			lastUsed:   pc.lastUsed,
+			generation: pc.generation,
+		}
+		p.mu.Unlock()
+
+		logger.L().Debug(ctx, "Reloaded volume metadata changed upstream",
+			zap.String("volume_id", volumeID),
+			zap.Int64("replica_generation", client.ReplicaGeneration()))
+	}
+}
+
 func (p *Pool) cleanup() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
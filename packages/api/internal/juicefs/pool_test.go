@@ -0,0 +1,176 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigMaxClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config Config
+		want   int
+	}{
+		{
+			name:   "default",
+			config: Config{},
+			want:   DefaultMaxClients,
+		},
+		{
+			name:   "configured",
+			config: Config{MaxClients: 4},
+			want:   4,
+		},
+		{
+			name:   "limited by memory",
+			config: Config{MaxClients: 10, BufferSize: 100 << 20, MemoryLimit: 350 << 20},
+			want:   3,
+		},
+		{
+			name:   "memory limit above the maximum",
+			config: Config{MaxClients: 2, BufferSize: 100 << 20, MemoryLimit: 1 << 30},
+			want:   2,
+		},
+		{
+			name:   "default buffer",
+			config: Config{MemoryLimit: 900 << 20},
+			want:   3,
+		},
+		{
+			name:   "at least one client",
+			config: Config{BufferSize: 100 << 20, MemoryLimit: 10 << 20},
+			want:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.config.maxClients())
+		})
+	}
+}
+
+func TestPoolEvict(t *testing.T) {
+	t.Parallel()
+
+	t.Run("closes the least recently used client", func(t *testing.T) {
+		t.Parallel()
+
+		oldest := &Client{volumeID: "vol-1"}
+		p := &Pool{clients: map[string]*pooledClient{
+			"vol-1": {client: oldest, lastUsed: time.Now().Add(-10 * time.Minute)},
+			"vol-2": {client: &Client{volumeID: "vol-2"}, lastUsed: time.Now().Add(-5 * time.Minute)},
+			"vol-3": {client: &Client{volumeID: "vol-3"}, lastUsed: time.Now()},
+		}}
+
+		require.NoError(t, p.evictLocked())
+
+		assert.NotContains(t, p.clients, "vol-1")
+		assert.Len(t, p.clients, 2)
+		assert.True(t, oldest.closed)
+	})
+
+	t.Run("keeps clients in use", func(t *testing.T) {
+		t.Parallel()
+
+		p := &Pool{clients: map[string]*pooledClient{
+			"vol-1": {client: &Client{volumeID: "vol-1"}, lastUsed: time.Now().Add(-time.Second)},
+			"vol-2": {client: &Client{volumeID: "vol-2"}, lastUsed: time.Now()},
+		}}
+
+		require.ErrorIs(t, p.evictLocked(), ErrPoolFull)
+		assert.Len(t, p.clients, 2)
+	})
+}
+
+type fakeMetaReplicas map[string]int64
+
+func (f fakeMetaReplicas) ReplicaGeneration(_ context.Context, _, volumeID string) (int64, error) {
+	generation, ok := f[volumeID]
+	if !ok {
+		return 0, errors.New("replica not found")
+	}
+
+	return generation, nil
+}
+
+func TestFreshnessCurrent(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{volumeID: "vol-1"}
+	client.replicaGeneration.Store(7)
+	pc := &pooledClient{client: client, generation: 3}
+
+	tests := []struct {
+		name      string
+		freshness freshness
+		want      bool
+	}{
+		{
+			name:      "nothing known",
+			freshness: freshness{},
+			want:      true,
+		},
+		{
+			name:      "same generations",
+			freshness: freshness{generation: 3, generationKnown: true, replica: 7, replicaKnown: true},
+			want:      true,
+		},
+		{
+			name:      "written by another API instance",
+			freshness: freshness{generation: 4, generationKnown: true, replica: 7, replicaKnown: true},
+			want:      false,
+		},
+		{
+			name:      "synced by a sandbox",
+			freshness: freshness{generation: 3, generationKnown: true, replica: 9, replicaKnown: true},
+			want:      false,
+		},
+		{
+			name:      "replica generation unknown",
+			freshness: freshness{generation: 3, generationKnown: true, replica: 9},
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.freshness.current(pc))
+		})
+	}
+}
+
+func TestPoolRefreshKeepsCurrentAndBusyClients(t *testing.T) {
+	t.Parallel()
+
+	current := &Client{volumeID: "vol-1"}
+	current.replicaGeneration.Store(5)
+	busy := &Client{volumeID: "vol-2"}
+	busy.replicaGeneration.Store(5)
+
+	p := &Pool{
+		config: Config{MetaReplicas: fakeMetaReplicas{"vol-1": 5, "vol-2": 8}},
+		clients: map[string]*pooledClient{
+			"vol-1": {client: current, lastUsed: time.Now().Add(-10 * time.Minute)},
+			"vol-2": {client: busy, lastUsed: time.Now()},
+		},
+	}
+
+	p.refresh(t.Context())
+
+	assert.Same(t, current, p.clients["vol-1"].client)
+	assert.Same(t, busy, p.clients["vol-2"].client)
+	assert.False(t, current.closed)
+	assert.False(t, busy.closed)
+}
@@ -0,0 +1,153 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// ErrNotContiguous is returned when the content of a file isn't stored as a single object,
+// so it can't be downloaded from the bucket directly.
+var ErrNotContiguous = errors.New("file content is not stored as a single object")
+
+// StagedDownloadsPrefix is the prefix of the bucket objects files are materialized under.
+const StagedDownloadsPrefix = ".downloads/"
+
+// ContiguousObject returns the bucket object holding the whole content of the file at the given path.
+// That's the case for a file written at once that fits a block, with neither compression nor
+// encryption. Otherwise ErrNotContiguous is returned.
+func (c *Client) ContiguousObject(ctx context.Context, filePath string) (string, int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return "", 0, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Stat(mctx, filePath)
+	if errno != 0 {
+		if errno == syscall.ENOENT {
+			return "", 0, fmt.Errorf("%w: %s", ErrNotFound, filePath)
+		}
+		return "", 0, fmt.Errorf("stat: %s", errno)
+	}
+	if info.IsDir() {
+		return "", 0, fmt.Errorf("%w: is a directory", ErrNotContiguous)
+	}
+
+	if (c.format.Compression != "" && c.format.Compression != "none") || c.format.EncryptKey != "" {
+		return "", 0, fmt.Errorf("%w: volume data is compressed or encrypted", ErrNotContiguous)
+	}
+
+	length := uint64(info.Size())
+	if length == 0 || length > uint64(c.format.BlockSize*1024) {
+		return "", 0, ErrNotContiguous
+	}
+
+	var slices []meta.Slice
+	if errno := c.metaCli.Read(mctx, info.Inode(), 0, &slices); errno != 0 {
+		return "", 0, fmt.Errorf("read chunk: %s", errno)
+	}
+
+	// The single block of a slice written at once holds exactly the file
+	if len(slices) != 1 {
+		return "", 0, ErrNotContiguous
+	}
+	s := slices[0]
+	if s.Id == 0 || s.Pos != 0 || s.Off != 0 || uint64(s.Len) != length || uint64(s.Size) != length {
+		return "", 0, ErrNotContiguous
+	}
+
+	return c.format.Name + "/" + blockKey(s.Id, 0, int(s.Size), c.format.HashPrefix), int64(length), nil
+}
+
+// Materialize writes the content of the file at the given path to a single object of the
+// volume bucket, so it can be downloaded from the bucket directly. Returns the size written.
+func (c *Client) Materialize(ctx context.Context, filePath string, object string) (int64, error) {
+	contentType, err := c.ContentType(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	reader, _, err := c.Download(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("create GCS client: %w", err)
+	}
+	defer gcsClient.Close()
+
+	writer := gcsClient.Bucket(c.config.GCSBucket).Object(object).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	size, err := io.Copy(writer, reader)
+	if err != nil {
+		writer.Close()
+		return 0, fmt.Errorf("write object: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return 0, fmt.Errorf("write object: %w", err)
+	}
+
+	return size, nil
+}
+
+// Bucket returns the bucket holding the volume data.
+func (c *Client) Bucket() string {
+	return c.config.GCSBucket
+}
+
+// SignedDownloadURL returns a URL to download the object until expiresAt without credentials.
+// The response has the given content type and asks to save the content under filename.
+func SignedDownloadURL(ctx context.Context, gcsBucket, object, filename, contentType string, expiresAt time.Time) (string, error) {
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("create GCS client: %w", err)
+	}
+	defer gcsClient.Close()
+
+	// The signing identity is detected from the credentials of the API
+	url, err := gcsClient.Bucket(gcsBucket).SignedURL(object, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: expiresAt,
+		QueryParameters: map[string][]string{
+			"response-content-disposition": {"attachment; filename=\"" + path.Base(filename) + "\""},
+			"response-content-type":        {contentType},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("sign URL: %w", err)
+	}
+
+	return url, nil
+}
+
+// DeleteObject deletes an object of the bucket, an object that doesn't exist isn't an error.
+func DeleteObject(ctx context.Context, gcsBucket, object string) error {
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("create GCS client: %w", err)
+	}
+	defer gcsClient.Close()
+
+	if err := gcsClient.Bucket(gcsBucket).Object(object).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("delete object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package juicefs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// ErrQuotaExceeded is returned when a write would grow the volume beyond its size limit.
+var ErrQuotaExceeded = errors.New("volume size limit exceeded")
+
+// ErrUploadTooLarge is returned when the content of an upload exceeds the maximum size of a single upload.
+var ErrUploadTooLarge = errors.New("upload exceeds the maximum size")
+
+// usedSpace returns the space used by the files of the volume, as accounted by JuiceFS.
+// The accounting rounds every file up to 4 KiB, so it is a bit larger than the sum of the file lengths.
+func (c *Client) usedSpace(mctx meta.Context) (int64, error) {
+	var total, avail, iused, iavail uint64
+	if errno := c.metaCli.StatFS(mctx, meta.RootInode, &total, &avail, &iused, &iavail); errno != 0 {
+		return 0, fmt.Errorf("stat filesystem: %s", errno)
+	}
+
+	return int64(total - avail), nil
+}
+
+// quotaReader fails with ErrQuotaExceeded once more than remaining bytes were read through it.
+type quotaReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (q *quotaReader) Read(p []byte) (int, error) {
+	n, err := q.r.Read(p)
+
+	q.remaining -= int64(n)
+	if q.remaining < 0 {
+		return n, ErrQuotaExceeded
+	}
+
+	return n, err
+}
@@ -0,0 +1,36 @@
+package juicefs
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("content within the limit", func(t *testing.T) {
+		t.Parallel()
+
+		content, err := io.ReadAll(&quotaReader{r: strings.NewReader("hello"), remaining: 5})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(content))
+	})
+
+	t.Run("content over the limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := io.ReadAll(&quotaReader{r: strings.NewReader("hello"), remaining: 4})
+		require.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+
+	t.Run("volume already over the limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := io.ReadAll(&quotaReader{r: strings.NewReader("a"), remaining: -10})
+		require.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+}
@@ -0,0 +1,95 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// redisFormatKey is the key JuiceFS keeps the format of a volume in, set by juicefs format.
+const redisFormatKey = "setting"
+
+// ErrRedisNotConfigured is returned for a volume with Redis metadata when the API has no volumes Redis.
+var ErrRedisNotConfigured = errors.New("volumes Redis is not configured")
+
+// redisMetaURL returns the metadata URL of a volume with Redis metadata, its own database of the volumes Redis.
+func redisMetaURL(config Config, volume Volume) (string, error) {
+	if config.RedisURL == "" {
+		return "", ErrRedisNotConfigured
+	}
+
+	return volumestorage.RedisMetaURL(config.RedisURL, volume.RedisDB)
+}
+
+// openRedisDB connects to the database of the metadata URL.
+func openRedisDB(metaURL string) (*redis.Client, error) {
+	opts, err := redis.ParseURL(metaURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis URL: %w", err)
+	}
+
+	return redis.NewClient(opts), nil
+}
+
+// redisMetaFormatted reports whether the volume was formatted in the database of the metadata URL,
+// envd formats it on the first mount.
+func redisMetaFormatted(ctx context.Context, metaURL string) (bool, error) {
+	rdb, err := openRedisDB(metaURL)
+	if err != nil {
+		return false, err
+	}
+	defer rdb.Close()
+
+	n, err := rdb.Exists(ctx, redisFormatKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("check volume format: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+// destroyRedisMeta deletes the metadata of a volume by flushing its database.
+func destroyRedisMeta(ctx context.Context, metaURL string) error {
+	rdb, err := openRedisDB(metaURL)
+	if err != nil {
+		return err
+	}
+	defer rdb.Close()
+
+	if err := rdb.FlushDB(ctx).Err(); err != nil {
+		return fmt.Errorf("flush metadata database: %w", err)
+	}
+
+	return nil
+}
+
+// RedisDBCapacity returns the number of databases of the volumes Redis, database 0 included.
+// Managed Redis services can deny reading the configuration.
+func RedisDBCapacity(ctx context.Context, config Config) (int32, error) {
+	if config.RedisURL == "" {
+		return 0, ErrRedisNotConfigured
+	}
+
+	rdb, err := openRedisDB(config.RedisURL)
+	if err != nil {
+		return 0, err
+	}
+	defer rdb.Close()
+
+	values, err := rdb.ConfigGet(ctx, "databases").Result()
+	if err != nil {
+		return 0, fmt.Errorf("get databases config: %w", err)
+	}
+
+	databases, err := strconv.ParseInt(values["databases"], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("parse databases config %q: %w", values["databases"], err)
+	}
+
+	return int32(databases), nil
+}
@@ -0,0 +1,39 @@
+package juicefs
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+)
+
+// Rename moves the file or directory at oldPath to newPath, replacing a file or an empty directory at newPath.
+// The parent of newPath must exist. After the rename, syncs metadata to GCS.
+func (c *Client) Rename(ctx context.Context, oldPath, newPath string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	errno := c.jfs.Rename(mctx, oldPath, newPath, 0)
+	switch {
+	case errno == syscall.ENOENT:
+		return fmt.Errorf("%w: %s or the parent of %s", ErrNotFound, oldPath, newPath)
+	case errno == syscall.ENOTDIR:
+		return fmt.Errorf("%w: parent of %s", ErrNotDirectory, newPath)
+	case errno == syscall.EEXIST || errno == syscall.ENOTEMPTY || errno == syscall.EISDIR:
+		return fmt.Errorf("%w: %s", ErrExists, newPath)
+	case errno != 0:
+		return fmt.Errorf("rename: %s", errno)
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "rename", newPath); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"syscall"
+)
+
+// maxSymlinkHops bounds the symlinks followed to resolve a path, like the limit of Linux.
+const maxSymlinkHops = 40
+
+// ErrSymlinkLoop is returned when a path goes through too many symlinks to be resolved.
+var ErrSymlinkLoop = errors.New("too many levels of symlinks")
+
+// ResolvePath returns the path of the entry the given path leads to once its symlinks are followed, like
+// realpath. Absolute targets are resolved from the volume root. The components after the first missing entry
+// are kept as they are, so the path of an entry about to be created resolves too.
+func (c *Client) ResolvePath(ctx context.Context, filePath string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return "", fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	return resolvePath(filePath, func(entryPath string) (string, bool, error) {
+		info, errno := c.jfs.Lstat(mctx, entryPath)
+		switch {
+		case errno == syscall.ENOENT || errno == syscall.ENOTDIR:
+			return "", false, fmt.Errorf("%w: %s", ErrNotFound, entryPath)
+		case errno != 0:
+			return "", false, fmt.Errorf("stat %s: %s", entryPath, errno)
+		case !info.IsSymlink():
+			return "", false, nil
+		}
+
+		target, errno := c.jfs.Readlink(mctx, entryPath)
+		if errno != 0 {
+			return "", false, fmt.Errorf("read link %s: %s", entryPath, errno)
+		}
+
+		return string(target), true, nil
+	})
+}
+
+// resolvePath follows the symlinks of the path, readLink returns the target of a symlink or false for the
+// other entries, and ErrNotFound for missing entries.
+func resolvePath(filePath string, readLink func(entryPath string) (string, bool, error)) (string, error) {
+	pending := splitPath(filePath)
+	resolved := "/"
+	hops := 0
+
+	for len(pending) > 0 {
+		next := path.Join(resolved, pending[0])
+		pending = pending[1:]
+
+		target, isLink, err := readLink(next)
+		if errors.Is(err, ErrNotFound) {
+			return path.Join(append([]string{next}, pending...)...), nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if !isLink {
+			resolved = next
+
+			continue
+		}
+
+		hops++
+		if hops > maxSymlinkHops {
+			return "", fmt.Errorf("%w: %s", ErrSymlinkLoop, filePath)
+		}
+
+		// The target replaces the link, relative targets start from the directory of the link
+		if !path.IsAbs(target) {
+			target = path.Join(resolved, target)
+		}
+		pending = append(splitPath(target), pending...)
+		resolved = "/"
+	}
+
+	return resolved, nil
+}
+
+// splitPath returns the names of the entries of a cleaned absolute path, none for the root.
+func splitPath(filePath string) []string {
+	cleaned := strings.TrimPrefix(path.Clean("/"+filePath), "/")
+	if cleaned == "" {
+		return nil
+	}
+
+	return strings.Split(cleaned, "/")
+}
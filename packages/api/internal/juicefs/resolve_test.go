@@ -0,0 +1,60 @@
+package juicefs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testReadLink reads the links of a volume with the given symlinks and directories.
+func testReadLink(links map[string]string, dirs ...string) func(string) (string, bool, error) {
+	return func(entryPath string) (string, bool, error) {
+		if target, ok := links[entryPath]; ok {
+			return target, true, nil
+		}
+		for _, dir := range dirs {
+			if dir == entryPath {
+				return "", false, nil
+			}
+		}
+
+		return "", false, fmt.Errorf("%w: %s", ErrNotFound, entryPath)
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	t.Parallel()
+
+	readLink := testReadLink(map[string]string{
+		"/data/shared":   "/other",
+		"/data/relative": "../other/dir",
+		"/data/inside":   "sub",
+		"/data/loop":     "loop",
+		"/data/escape":   "../../../..",
+	}, "/data", "/data/sub", "/other", "/other/dir")
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/", want: "/"},
+		{path: "/data/sub/file.txt", want: "/data/sub/file.txt"},
+		{path: "data/sub", want: "/data/sub"},
+		{path: "/data/shared/file.txt", want: "/other/file.txt"},
+		{path: "/data/relative/file.txt", want: "/other/dir/file.txt"},
+		{path: "/data/inside/file.txt", want: "/data/sub/file.txt"},
+		{path: "/data/escape/secret", want: "/secret"},
+		{path: "/data/missing/deeper", want: "/data/missing/deeper"},
+	}
+
+	for _, tt := range tests {
+		resolved, err := resolvePath(tt.path, readLink)
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, tt.want, resolved, tt.path)
+	}
+
+	_, err := resolvePath("/data/loop/file.txt", readLink)
+	require.ErrorIs(t, err, ErrSymlinkLoop)
+}
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 const (
@@ -31,6 +32,9 @@ const (
 
 	// ReplicateSyncInterval is the sync interval for litestream replicate
 	ReplicateSyncInterval = "100ms"
+
+	// metaRestoreDir holds the temp directories the metadata of the clients is restored to
+	metaRestoreDir = "/tmp/juicefs-api"
 )
 
 // RestoreResult contains the result of a litestream restore operation
@@ -45,38 +49,48 @@ type RestoreResult struct {
 // restoreMetaDB restores the SQLite metadata DB from Litestream replica in GCS.
 // For fresh volumes (no backup exists), this returns IsFreshVolume=true.
 //
-// The function creates a temp directory per volume at /tmp/juicefs-api/{volumeID}/
-// and restores the meta.db there.
+// The function creates a temp directory per client at /tmp/juicefs-api/{volumeID}-{random}/
+// and restores the meta.db there, so a client reloading the metadata doesn't touch the files of the client it replaces.
 func restoreMetaDB(ctx context.Context, volumeID string, gcsBucket string) (*RestoreResult, error) {
-	// Create temp directory for this volume
-	tmpDir := filepath.Join("/tmp/juicefs-api", volumeID)
-	if err := os.MkdirAll(tmpDir, 0o755); err != nil {
+	if err := os.MkdirAll(metaRestoreDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+
+	// Create temp directory for this client
+	tmpDir, err := os.MkdirTemp(metaRestoreDir, volumeID+"-")
+	if err != nil {
 		return nil, fmt.Errorf("create temp dir: %w", err)
 	}
 
 	metaDBPath := filepath.Join(tmpDir, "meta.db")
-	replicaURL := fmt.Sprintf("gs://%s/%s-meta", gcsBucket, volumeID)
+	provider, err := volumestorage.FromEnv()
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+	replicaURL := provider.MetaReplicaURL(gcsBucket, volumeID)
 
 	ctx, cancel := context.WithTimeout(ctx, RestoreTimeout)
 	defer cancel()
 
-	// Clean up any existing meta.db from a previous attempt
-	if err := os.Remove(metaDBPath); err != nil && !os.IsNotExist(err) {
-		logger.L().Debug(ctx, "Failed to remove existing meta.db",
-			zap.String("path", metaDBPath),
-			zap.Error(err))
-	}
+	// litestream restore -if-replica-exists -o /tmp/juicefs-api/{volumeID}-{random}/meta.db gs://bucket/volumeID-meta
+	args := []string{"restore", "-if-replica-exists", "-o", metaDBPath, replicaURL}
+	if endpoint := os.Getenv(volumestorage.S3EndpointEnv); provider == volumestorage.S3 && endpoint != "" {
+		// The endpoint is only read from the config, the replica is looked up by the database path there
+		configPath, err := writeLitestreamConfig(provider, tmpDir, metaDBPath, replicaURL)
+		if err != nil {
+			os.RemoveAll(tmpDir)
+			return nil, err
+		}
+		defer os.Remove(configPath)
 
-	// litestream restore -if-replica-exists -o /tmp/juicefs-api/{volumeID}/meta.db gs://bucket/volumeID-meta
-	cmd := exec.CommandContext(ctx, LitestreamBinary,
-		"restore",
-		"-if-replica-exists",
-		"-o", metaDBPath,
-		replicaURL,
-	)
+		args = []string{"restore", "-config", configPath, "-if-replica-exists", "-o", metaDBPath, metaDBPath}
+	}
+	cmd := exec.CommandContext(ctx, LitestreamBinary, args...)
 
 	// Use Application Default Credentials (ADC) - no token file needed for API server
 	// The API server runs with a service account that has GCS access
+	cmd.Env = litestreamEnv()
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -88,6 +102,7 @@ func restoreMetaDB(ctx context.Context, volumeID string, gcsBucket string) (*Res
 		zap.Strings("args", cmd.Args))
 
 	if err := cmd.Run(); err != nil {
+		os.RemoveAll(tmpDir)
 		return nil, fmt.Errorf("litestream restore failed: %w\nstdout: %s\nstderr: %s",
 			err, stdout.String(), stderr.String())
 	}
@@ -146,24 +161,19 @@ func setJournalMode(ctx context.Context, metaDBPath, mode string) error {
 // Database must be in WAL mode (which it is after litestream restore).
 // This runs litestream replicate -once which syncs and exits.
 func syncViaLitestream(ctx context.Context, volumeID, metaDBPath, gcsBucket string) error {
-	replicaURL := fmt.Sprintf("gs://%s/%s-meta", gcsBucket, volumeID)
+	provider, err := volumestorage.FromEnv()
+	if err != nil {
+		return err
+	}
+	replicaURL := provider.MetaReplicaURL(gcsBucket, volumeID)
 
 	// Database is already in WAL mode (from litestream restore)
 	// No mode conversion needed - JuiceFS works with WAL mode
 
 	// Create a temporary litestream config file
-	tmpDir := filepath.Dir(metaDBPath)
-	configPath := filepath.Join(tmpDir, "litestream.yml")
-
-	config := fmt.Sprintf(`dbs:
-  - path: %s
-    replicas:
-      - url: %s
-        sync-interval: %s
-`, metaDBPath, replicaURL, ReplicateSyncInterval)
-
-	if err := os.WriteFile(configPath, []byte(config), 0o644); err != nil {
-		return fmt.Errorf("write litestream config: %w", err)
+	configPath, err := writeLitestreamConfig(provider, filepath.Dir(metaDBPath), metaDBPath, replicaURL)
+	if err != nil {
+		return err
 	}
 	defer os.Remove(configPath)
 
@@ -172,6 +182,7 @@ func syncViaLitestream(ctx context.Context, volumeID, metaDBPath, gcsBucket stri
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, LitestreamBinary, "replicate", "-config", configPath, "-once")
+	cmd.Env = litestreamEnv()
 
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
@@ -197,8 +208,25 @@ func syncViaLitestream(ctx context.Context, volumeID, metaDBPath, gcsBucket stri
 	return nil
 }
 
-// cleanupVolumeDir removes the temp directory for a volume.
-func cleanupVolumeDir(volumeID string) error {
-	tmpDir := filepath.Join("/tmp/juicefs-api", volumeID)
-	return os.RemoveAll(tmpDir)
+// cleanupRestoreDir removes the temp directory the metadata was restored to.
+func cleanupRestoreDir(result *RestoreResult) error {
+	return os.RemoveAll(filepath.Dir(result.MetaDBPath))
+}
+
+// writeLitestreamConfig writes a temporary litestream config replicating the database to the replica.
+func writeLitestreamConfig(provider volumestorage.Provider, tmpDir, metaDBPath, replicaURL string) (string, error) {
+	configPath := filepath.Join(tmpDir, "litestream.yml")
+
+	config := fmt.Sprintf(`dbs:
+  - path: %s
+    replicas:
+      - url: %s
+        sync-interval: %s
+%s`, metaDBPath, replicaURL, ReplicateSyncInterval, provider.LitestreamReplicaOptions(os.Getenv(volumestorage.S3EndpointEnv)))
+
+	if err := os.WriteFile(configPath, []byte(config), 0o644); err != nil {
+		return "", fmt.Errorf("write litestream config: %w", err)
+	}
+
+	return configPath, nil
 }
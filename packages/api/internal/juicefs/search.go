@@ -0,0 +1,199 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// errSearchDone stops the walk once the requested page of matches is found.
+var errSearchDone = errors.New("search done")
+
+// SearchQuery filters the entries of a search, an entry must match every filter set.
+type SearchQuery struct {
+	// Name matches the entries whose name contains it
+	Name string
+	// Glob matches the entries whose name matches the path.Match pattern
+	Glob string
+	// MinSize and MaxSize match files by size, directories and symlinks never match a size filter
+	MinSize *int64
+	MaxSize *int64
+	// ModifiedAfter matches the entries modified at or after it, ModifiedBefore the ones modified before it
+	ModifiedAfter  *time.Time
+	ModifiedBefore *time.Time
+}
+
+// matches reports whether an entry matches the query.
+func (q SearchQuery) matches(name string, typ uint8, size int64, modTime time.Time) bool {
+	if q.Name != "" && !strings.Contains(name, q.Name) {
+		return false
+	}
+
+	if q.Glob != "" {
+		// The pattern is validated by the caller, an invalid one matches nothing
+		if ok, err := path.Match(q.Glob, name); err != nil || !ok {
+			return false
+		}
+	}
+
+	if q.MinSize != nil || q.MaxSize != nil {
+		if typ != meta.TypeFile {
+			return false
+		}
+		if q.MinSize != nil && size < *q.MinSize {
+			return false
+		}
+		if q.MaxSize != nil && size > *q.MaxSize {
+			return false
+		}
+	}
+
+	if q.ModifiedAfter != nil && modTime.Before(*q.ModifiedAfter) {
+		return false
+	}
+	if q.ModifiedBefore != nil && !modTime.Before(*q.ModifiedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// SearchResult contains a page of the entries matching a search.
+type SearchResult struct {
+	Files   []FileInfo
+	HasMore bool
+}
+
+// search holds the state of a single search walk.
+type search struct {
+	query  SearchQuery
+	offset int
+	limit  int
+
+	skipped int
+	result  SearchResult
+}
+
+// Search walks the tree below dirPath in the metadata and returns the entries matching the query,
+// in path order. offset matches are skipped, at most limit are returned. Symlinks are reported
+// but not followed. The walk stops as soon as the page is complete.
+func (c *Client) Search(ctx context.Context, dirPath string, query SearchQuery, limit, offset int) (*SearchResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	switch {
+	case errno == syscall.ENOENT:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, dirPath)
+	case errno == syscall.ENOTDIR:
+		return nil, fmt.Errorf("%w: parent of %s", ErrNotDirectory, dirPath)
+	case errno != 0:
+		return nil, fmt.Errorf("stat %s: %s", dirPath, errno)
+	case !info.IsDir():
+		return nil, fmt.Errorf("%w: %s", ErrNotDirectory, dirPath)
+	}
+
+	s := &search{query: query, offset: offset, limit: limit}
+	s.result.Files = []FileInfo{}
+
+	err := c.searchDir(ctx, mctx, s, dirPath)
+	if err != nil && !errors.Is(err, errSearchDone) {
+		return nil, err
+	}
+
+	return &s.result, nil
+}
+
+// searchDir adds the matching entries of a directory to the search, recursing into subdirectories.
+func (c *Client) searchDir(ctx context.Context, mctx meta.Context, s *search, dirPath string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	f, errno := c.jfs.Open(mctx, dirPath, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", dirPath, errno)
+	}
+	entries, errno := f.ReaddirPlus(mctx, 0)
+	f.Close(mctx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", dirPath, errno)
+	}
+
+	// Sort entries by name so the pages of the same tree are stable
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Name) < string(entries[j].Name)
+	})
+
+	for _, entry := range entries {
+		name := string(entry.Name)
+		entryPath := path.Join(dirPath, name)
+		modTime := time.Unix(entry.Attr.Mtime, int64(entry.Attr.Mtimensec))
+
+		if s.query.matches(name, entry.Attr.Typ, int64(entry.Attr.Length), modTime) {
+			if err := c.addSearchMatch(mctx, s, entry, entryPath, modTime); err != nil {
+				return err
+			}
+		}
+
+		if entry.Attr.Typ == meta.TypeDirectory {
+			if err := c.searchDir(ctx, mctx, s, entryPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// addSearchMatch adds a matching entry to the page, errSearchDone is returned once a match past the page is found.
+func (c *Client) addSearchMatch(mctx meta.Context, s *search, entry *meta.Entry, entryPath string, modTime time.Time) error {
+	if s.skipped < s.offset {
+		s.skipped++
+
+		return nil
+	}
+
+	if s.limit > 0 && len(s.result.Files) == s.limit {
+		s.result.HasMore = true
+
+		return errSearchDone
+	}
+
+	fi := FileInfo{
+		Name:       string(entry.Name),
+		Path:       entryPath,
+		Size:       int64(entry.Attr.Length),
+		ModifiedAt: modTime,
+	}
+	switch entry.Attr.Typ {
+	case meta.TypeDirectory:
+		fi.Type = "directory"
+	case meta.TypeSymlink:
+		fi.Type = "symlink"
+
+		target, errno := c.jfs.Readlink(mctx, entryPath)
+		if errno != 0 {
+			return fmt.Errorf("read link %s: %s", entryPath, errno)
+		}
+		fi.LinkTarget = string(target)
+	default:
+		fi.Type = "file"
+	}
+	s.result.Files = append(s.result.Files, fi)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package juicefs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchQueryMatches(t *testing.T) {
+	t.Parallel()
+
+	modTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	size := func(n int64) *int64 { return &n }
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name  string
+		query SearchQuery
+		entry string
+		typ   uint8
+		want  bool
+	}{
+		{name: "no filters", query: SearchQuery{}, entry: "app.log", typ: meta.TypeFile, want: true},
+		{name: "name substring", query: SearchQuery{Name: "app"}, entry: "my-app.log", typ: meta.TypeFile, want: true},
+		{name: "name is case sensitive", query: SearchQuery{Name: "App"}, entry: "app.log", typ: meta.TypeFile, want: false},
+		{name: "glob", query: SearchQuery{Glob: "*.log"}, entry: "app.log", typ: meta.TypeFile, want: true},
+		{name: "glob matches the whole name", query: SearchQuery{Glob: "*.log"}, entry: "app.log.gz", typ: meta.TypeFile, want: false},
+		{name: "invalid glob", query: SearchQuery{Glob: "[a-"}, entry: "app.log", typ: meta.TypeFile, want: false},
+		{name: "within the size range", query: SearchQuery{MinSize: size(100), MaxSize: size(100)}, entry: "app.log", typ: meta.TypeFile, want: true},
+		{name: "below the min size", query: SearchQuery{MinSize: size(101)}, entry: "app.log", typ: meta.TypeFile, want: false},
+		{name: "above the max size", query: SearchQuery{MaxSize: size(99)}, entry: "app.log", typ: meta.TypeFile, want: false},
+		{name: "directory with a size filter", query: SearchQuery{MinSize: size(0)}, entry: "logs", typ: meta.TypeDirectory, want: false},
+		{name: "modified after", query: SearchQuery{ModifiedAfter: at(modTime)}, entry: "app.log", typ: meta.TypeFile, want: true},
+		{name: "modified before", query: SearchQuery{ModifiedBefore: at(modTime)}, entry: "app.log", typ: meta.TypeFile, want: false},
+		{name: "all filters", query: SearchQuery{Name: "app", Glob: "*.log", MaxSize: size(1000), ModifiedBefore: at(modTime.Add(time.Second))}, entry: "app.log", typ: meta.TypeFile, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.query.matches(tt.entry, tt.typ, 100, modTime))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package juicefs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"path"
+	"syscall"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+	"github.com/juicedata/juicefs/pkg/vfs"
+)
+
+var (
+	// ErrNotFound is returned when a path doesn't exist on the volume.
+	ErrNotFound = errors.New("path not found")
+	// ErrIsDirectory is returned when a file operation finds a directory at the path.
+	ErrIsDirectory = errors.New("is a directory")
+	// ErrNotEmpty is returned when a directory with entries is deleted without recursion.
+	ErrNotEmpty = errors.New("directory not empty")
+)
+
+// FileStat describes a single entry of a volume.
+type FileStat struct {
+	FileInfo
+
+	// Mode holds the permission bits, including setuid, setgid and sticky
+	Mode iofs.FileMode
+	UID  uint32
+	GID  uint32
+	// LinkTarget is the target of a symlink
+	LinkTarget string
+	// Checksum is the hex encoded SHA-256 of a regular file, only computed when requested
+	Checksum string
+	// ContentType is the content type of a regular file
+	ContentType string
+}
+
+// Stat returns the metadata of the entry at the given path, without following a final symlink.
+// With checksum, the content of a regular file is read to compute its SHA-256, which is
+// proportional to the file size.
+func (c *Client) Stat(ctx context.Context, filePath string, checksum bool) (*FileStat, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	info, errno := c.jfs.Lstat(mctx, filePath)
+	if errno != 0 {
+		if errno == syscall.ENOENT {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, filePath)
+		}
+		return nil, fmt.Errorf("stat: %s", errno)
+	}
+
+	stat := &FileStat{
+		FileInfo: FileInfo{
+			Name:       path.Base(filePath),
+			Path:       filePath,
+			Type:       "file",
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		},
+		Mode: info.Mode() & (iofs.ModePerm | iofs.ModeSetuid | iofs.ModeSetgid | iofs.ModeSticky),
+		UID:  uint32(info.Uid()),
+		GID:  uint32(info.Gid()),
+	}
+
+	switch {
+	case info.IsDir():
+		stat.Type = "directory"
+	case info.IsSymlink():
+		stat.Type = "symlink"
+
+		target, errno := c.jfs.Readlink(mctx, filePath)
+		if errno != 0 {
+			return nil, fmt.Errorf("read link: %s", errno)
+		}
+		stat.LinkTarget = string(target)
+	default:
+		contentType, err := c.contentType(mctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		stat.ContentType = contentType
+
+		if !checksum {
+			break
+		}
+
+		stat.Checksum, err = c.fileChecksum(mctx, filePath, info.Size())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stat, nil
+}
+
+// fileChecksum reads a regular file to compute the hex encoded SHA-256 of its content.
+func (c *Client) fileChecksum(mctx meta.Context, filePath string, size int64) (string, error) {
+	f, errno := c.jfs.Open(mctx, filePath, vfs.MODE_MASK_R)
+	if errno != 0 {
+		return "", fmt.Errorf("open file: %s", errno)
+	}
+
+	reader := &jfsReader{file: f, ctx: mctx, size: size}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,102 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"syscall"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// ErrDanglingSymlink is returned when a symlink is followed to a target that doesn't exist in the volume.
+// Absolute targets are resolved from the volume root, not from the mount path of the sandbox.
+var ErrDanglingSymlink = errors.New("symlink target not found in the volume")
+
+// Symlink creates a symlink at linkPath pointing to target, creating parent directories as needed.
+// The target isn't required to exist. With overwrite, an existing file or symlink at linkPath is replaced.
+// After creation, syncs metadata to GCS.
+func (c *Client) Symlink(ctx context.Context, linkPath, target string, overwrite bool) (*FileInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	dir := path.Dir(linkPath)
+	if dir != "/" {
+		errno := c.jfs.MkdirAll(mctx, dir, 0o755, 0o022)
+		if errno == syscall.ENOTDIR {
+			return nil, fmt.Errorf("%w: parent of %s", ErrNotDirectory, linkPath)
+		}
+		if errno != 0 && errno != syscall.EEXIST {
+			return nil, fmt.Errorf("create directories: %s", errno)
+		}
+	}
+
+	errno := c.jfs.Symlink(mctx, target, linkPath)
+	if errno == syscall.EEXIST && overwrite {
+		// Directories aren't replaced, their content would be lost
+		info, statErr := c.jfs.Lstat(mctx, linkPath)
+		if statErr == 0 && info.IsDir() {
+			return nil, fmt.Errorf("%w: %s is a directory", ErrExists, linkPath)
+		}
+
+		if errno = c.jfs.Delete(mctx, linkPath); errno != 0 {
+			return nil, fmt.Errorf("replace %s: %s", linkPath, errno)
+		}
+		errno = c.jfs.Symlink(mctx, target, linkPath)
+	}
+
+	switch {
+	case errno == syscall.EEXIST:
+		return nil, fmt.Errorf("%w: %s", ErrExists, linkPath)
+	case errno == syscall.ENOTDIR:
+		return nil, fmt.Errorf("%w: parent of %s", ErrNotDirectory, linkPath)
+	case errno != 0:
+		return nil, fmt.Errorf("create symlink: %s", errno)
+	}
+
+	info, errno := c.jfs.Lstat(mctx, linkPath)
+	if errno != 0 {
+		return nil, fmt.Errorf("stat symlink: %s", errno)
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "creating symlink", linkPath); err != nil {
+		return nil, err
+	}
+
+	return &FileInfo{
+		Name:       path.Base(linkPath),
+		Path:       linkPath,
+		Type:       "symlink",
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime(),
+		LinkTarget: target,
+	}, nil
+}
+
+// danglingLink returns the target of the symlink at filePath when following it failed with errno
+// because the target doesn't exist or the links form a loop.
+func (c *Client) danglingLink(mctx meta.Context, filePath string, errno syscall.Errno) (string, bool) {
+	if errno != syscall.ENOENT && errno != syscall.ELOOP {
+		return "", false
+	}
+
+	info, lerrno := c.jfs.Lstat(mctx, filePath)
+	if lerrno != 0 || !info.IsSymlink() {
+		return "", false
+	}
+
+	target, lerrno := c.jfs.Readlink(mctx, filePath)
+	if lerrno != 0 {
+		return "", false
+	}
+
+	return string(target), true
+}
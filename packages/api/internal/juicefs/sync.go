@@ -0,0 +1,162 @@
+package juicefs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// SyncEntry is a file of the local directory compared with a directory of the volume.
+type SyncEntry struct {
+	// Path is relative to the synced directory
+	Path string
+	Size int64
+	// Checksum is the hex encoded SHA-256 of the content
+	Checksum string
+}
+
+// SyncDiff tells which files a sync has to transfer.
+type SyncDiff struct {
+	// Upload lists the manifest paths missing on the volume or with a different content, in manifest order
+	Upload []string
+	// Extra lists the files and symlinks below the directory that aren't in the manifest, sorted
+	Extra []string
+	// Unchanged counts the manifest files with the same content on the volume
+	Unchanged int64
+}
+
+// syncFile is an entry found below the synced directory.
+type syncFile struct {
+	typ  uint8
+	size int64
+}
+
+// DiffSync compares the files below dirPath with the manifest of a local directory. Files of the same
+// size are read to compare their SHA-256, so the cost is proportional to the size of the unchanged files.
+// A missing directory compares as empty. With deleteExtra, the files and symlinks that aren't in the
+// manifest are deleted, then metadata is synced to GCS.
+func (c *Client) DiffSync(ctx context.Context, dirPath string, manifest []SyncEntry, deleteExtra bool) (*SyncDiff, error) {
+	if deleteExtra {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	} else {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+	}
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	files := make(map[string]syncFile)
+
+	info, errno := c.jfs.Stat(mctx, dirPath)
+	switch {
+	case errno == syscall.ENOENT:
+	case errno != 0:
+		return nil, fmt.Errorf("stat %s: %s", dirPath, errno)
+	case !info.IsDir():
+		return nil, fmt.Errorf("%w: %s", ErrNotDirectory, dirPath)
+	default:
+		if err := c.syncDir(ctx, mctx, files, dirPath, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := &SyncDiff{Upload: []string{}, Extra: []string{}}
+
+	listed := make(map[string]bool, len(manifest))
+	for _, entry := range manifest {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		listed[entry.Path] = true
+
+		file, ok := files[entry.Path]
+		if !ok || file.typ != meta.TypeFile || file.size != entry.Size {
+			diff.Upload = append(diff.Upload, entry.Path)
+
+			continue
+		}
+
+		checksum, err := c.fileChecksum(mctx, path.Join(dirPath, entry.Path), file.size)
+		if err != nil {
+			return nil, fmt.Errorf("checksum %s: %w", entry.Path, err)
+		}
+
+		if strings.EqualFold(checksum, entry.Checksum) {
+			diff.Unchanged++
+		} else {
+			diff.Upload = append(diff.Upload, entry.Path)
+		}
+	}
+
+	for filePath, file := range files {
+		if file.typ != meta.TypeDirectory && !listed[filePath] {
+			diff.Extra = append(diff.Extra, filePath)
+		}
+	}
+	sort.Strings(diff.Extra)
+
+	if !deleteExtra || len(diff.Extra) == 0 {
+		return diff, nil
+	}
+
+	for _, filePath := range diff.Extra {
+		errno := c.jfs.Delete(mctx, path.Join(dirPath, filePath))
+		if errno != 0 && errno != syscall.ENOENT {
+			return nil, fmt.Errorf("delete %s: %s", filePath, errno)
+		}
+	}
+
+	// Sync metadata to GCS so sandbox can see the changes
+	if err := c.syncAfterWriteLocked(ctx, "sync delete", dirPath); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// syncDir adds the entries below a directory to files, keyed by their path relative to the synced directory.
+func (c *Client) syncDir(ctx context.Context, mctx meta.Context, files map[string]syncFile, dirPath, relPath string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	f, errno := c.jfs.Open(mctx, dirPath, 0)
+	if errno != 0 {
+		return fmt.Errorf("open directory %s: %s", dirPath, errno)
+	}
+	entries, errno := f.ReaddirPlus(mctx, 0)
+	f.Close(mctx)
+	if errno != 0 {
+		return fmt.Errorf("read directory %s: %s", dirPath, errno)
+	}
+
+	for _, entry := range entries {
+		name := string(entry.Name)
+		entryPath := path.Join(dirPath, name)
+		if entryPath == UploadsDir {
+			continue
+		}
+
+		entryRelPath := path.Join(relPath, name)
+		files[entryRelPath] = syncFile{typ: entry.Attr.Typ, size: int64(entry.Attr.Length)}
+
+		if entry.Attr.Typ == meta.TypeDirectory {
+			if err := c.syncDir(ctx, mctx, files, entryPath, entryRelPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
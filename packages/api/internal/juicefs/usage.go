@@ -0,0 +1,133 @@
+package juicefs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juicedata/juicefs/pkg/meta"
+)
+
+// chunksPrefix is where the chunk store keeps slice blocks, relative to the volume prefix.
+const chunksPrefix = "chunks/"
+
+// Usage describes how the files of a volume map to the stored data.
+type Usage struct {
+	// LogicalBytes is the sum of the file lengths
+	LogicalBytes int64
+	// ReferencedBytes is the data referenced by the files, holes excluded
+	ReferencedBytes int64
+	// UniqueBytes counts slices shared by several files (clones, copies) once, before compression
+	UniqueBytes int64
+	// StoredBytes is the size of the chunk objects in the bucket, after compression
+	StoredBytes int64
+
+	Files       int64
+	Directories int64
+	Compression string
+}
+
+// DedupRatio returns how many times the stored data is referenced on average.
+func (u *Usage) DedupRatio() float64 {
+	return ratio(u.ReferencedBytes, u.UniqueBytes)
+}
+
+// CompressionRatio returns how much the stored data shrank by compression.
+func (u *Usage) CompressionRatio() float64 {
+	return ratio(u.UniqueBytes, u.StoredBytes)
+}
+
+// Usage computes the storage usage of the volume from its metadata and the chunk objects.
+//
+// Listing the chunk objects is proportional to the volume size, callers should not
+// compute the usage on hot paths.
+func (c *Client) Usage(ctx context.Context) (*Usage, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	mctx := c.metaCtx(ctx)
+
+	var summary meta.Summary
+	if errno := c.metaCli.GetSummary(mctx, meta.RootInode, &summary, true, true); errno != 0 {
+		return nil, fmt.Errorf("get summary: %s", errno)
+	}
+
+	usage := &Usage{
+		LogicalBytes: int64(summary.Length),
+		Files:        int64(summary.Files),
+		// The summary includes the root directory itself
+		Directories: max(int64(summary.Dirs)-1, 0),
+		Compression: c.format.Compression,
+	}
+	if usage.Compression == "" {
+		usage.Compression = "none"
+	}
+
+	slices := make(map[meta.Ino][]meta.Slice)
+	if errno := c.metaCli.ListSlices(mctx, &slices, false, false, nil); errno != 0 {
+		return nil, fmt.Errorf("list slices: %s", errno)
+	}
+
+	seen := make(map[uint64]struct{})
+	for _, inodeSlices := range slices {
+		for _, s := range inodeSlices {
+			// Holes have no data
+			if s.Id == 0 {
+				continue
+			}
+
+			usage.ReferencedBytes += int64(s.Len)
+
+			if _, ok := seen[s.Id]; ok {
+				continue
+			}
+			seen[s.Id] = struct{}{}
+			usage.UniqueBytes += int64(s.Size)
+		}
+	}
+
+	objects, err := c.blob.ListAll(ctx, chunksPrefix, "", false)
+	if err != nil {
+		return nil, fmt.Errorf("list chunk objects: %w", err)
+	}
+
+	for obj := range objects {
+		// A nil object marks a failed listing
+		if obj == nil {
+			return nil, fmt.Errorf("list chunk objects: listing interrupted")
+		}
+		usage.StoredBytes += obj.Size()
+	}
+
+	return usage, nil
+}
+
+// Stats returns the total size and the number of files of the volume.
+// Unlike Usage, it only reads the directory statistics kept in the metadata.
+func (c *Client) Stats(ctx context.Context) (size, files int64, err error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return 0, 0, fmt.Errorf("client closed")
+	}
+
+	var summary meta.Summary
+	if errno := c.metaCli.GetSummary(c.metaCtx(ctx), meta.RootInode, &summary, true, false); errno != 0 {
+		return 0, 0, fmt.Errorf("get summary: %s", errno)
+	}
+
+	return int64(summary.Length), int64(summary.Files), nil
+}
+
+// ratio divides a by b, an empty volume has a ratio of 1.
+func ratio(a, b int64) float64 {
+	if a == 0 || b == 0 {
+		return 1
+	}
+
+	return float64(a) / float64(b)
+}
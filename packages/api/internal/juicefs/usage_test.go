@@ -0,0 +1,27 @@
+package juicefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageRatios(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty volume", func(t *testing.T) {
+		t.Parallel()
+
+		usage := &Usage{}
+		assert.InDelta(t, 1.0, usage.DedupRatio(), 0.0001)
+		assert.InDelta(t, 1.0, usage.CompressionRatio(), 0.0001)
+	})
+
+	t.Run("shared and compressed chunks", func(t *testing.T) {
+		t.Parallel()
+
+		usage := &Usage{ReferencedBytes: 300, UniqueBytes: 100, StoredBytes: 40}
+		assert.InDelta(t, 3.0, usage.DedupRatio(), 0.0001)
+		assert.InDelta(t, 2.5, usage.CompressionRatio(), 0.0001)
+	})
+}
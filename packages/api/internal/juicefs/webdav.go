@@ -0,0 +1,380 @@
+package juicefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/webdav"
+)
+
+var _ webdav.FileSystem = (*DAVFileSystem)(nil)
+
+// DAVFileSystem serves the files of a volume to a WebDAV handler. Symlinks are followed like by a file server.
+// Written files are streamed to Upload with the upload options of the file system, they only replace
+// the existing file once the whole content was written.
+type DAVFileSystem struct {
+	client *Client
+	upload UploadOptions
+
+	mu       sync.Mutex
+	abortErr error
+	writeErr error
+}
+
+// NewDAVFileSystem returns the WebDAV file system of a volume for a single request.
+func NewDAVFileSystem(client *Client, upload UploadOptions) *DAVFileSystem {
+	return &DAVFileSystem{client: client, upload: upload}
+}
+
+// Abort fails the files being written with err, for a request body that couldn't be read completely.
+func (f *DAVFileSystem) Abort(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.abortErr = err
+}
+
+// WriteErr returns why the last written file failed, nil when no write failed.
+func (f *DAVFileSystem) WriteErr() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.writeErr
+}
+
+func (f *DAVFileSystem) Mkdir(ctx context.Context, name string, _ os.FileMode) error {
+	_, err := f.client.Mkdir(ctx, name, false)
+
+	return davError("mkdir", name, err)
+}
+
+func (f *DAVFileSystem) RemoveAll(ctx context.Context, name string) error {
+	if name == "/" {
+		return &os.PathError{Op: "remove", Path: name, Err: os.ErrPermission}
+	}
+
+	return davError("remove", name, f.client.Delete(ctx, name, true))
+}
+
+func (f *DAVFileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	if oldName == "/" || newName == "/" {
+		return &os.PathError{Op: "rename", Path: oldName, Err: os.ErrPermission}
+	}
+
+	return davError("rename", oldName, f.client.Rename(ctx, oldName, newName))
+}
+
+func (f *DAVFileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	return f.client.davStat(ctx, name)
+}
+
+func (f *DAVFileSystem) OpenFile(ctx context.Context, name string, flag int, _ os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+		return f.create(ctx, name)
+	}
+
+	info, err := f.client.davStat(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &davDir{fs: f, ctx: ctx, name: name, info: info}, nil
+	}
+
+	reader, _, err := f.client.Download(ctx, name)
+	if err != nil {
+		return nil, davError("open", name, err)
+	}
+
+	return &davFile{jfsReader: reader.(*jfsReader), info: info}, nil
+}
+
+// create starts writing the file at name, WebDAV clients create the collections of a file before the file.
+func (f *DAVFileSystem) create(ctx context.Context, name string) (webdav.File, error) {
+	parent, err := f.client.davStat(ctx, path.Dir(name))
+	if err != nil {
+		return nil, err
+	}
+	if !parent.IsDir() {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+
+	if info, err := f.client.davStat(ctx, name); err == nil && info.IsDir() {
+		return nil, &os.PathError{Op: "open", Path: name, Err: syscall.EISDIR}
+	}
+
+	pr, pw := io.Pipe()
+	w := &davWriter{
+		fs:   f,
+		ctx:  ctx,
+		name: name,
+		pw:   pw,
+		done: make(chan error, 1),
+	}
+
+	go func() {
+		_, _, err := f.client.Upload(ctx, name, pr, f.upload)
+		// Writes after a failed upload fail instead of blocking
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+
+	return w, nil
+}
+
+func (f *DAVFileSystem) aborted() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.abortErr
+}
+
+func (f *DAVFileSystem) setWriteErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.writeErr = err
+}
+
+// davStat returns the info of the entry at the path, following symlinks.
+func (c *Client) davStat(ctx context.Context, name string) (os.FileInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	info, errno := c.jfs.Stat(c.metaCtx(ctx), name)
+	switch {
+	case errno == syscall.ENOENT || errno == syscall.ENOTDIR:
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	case errno != 0:
+		return nil, &os.PathError{Op: "stat", Path: name, Err: errno}
+	}
+
+	return &davFileInfo{FileInfo: info, client: c, path: name}, nil
+}
+
+// davError converts the errors of the client to the errors of the os package the WebDAV handler maps to statuses.
+func davError(op, name string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrNotDirectory), errors.Is(err, ErrDanglingSymlink):
+		return &os.PathError{Op: op, Path: name, Err: os.ErrNotExist}
+	case errors.Is(err, ErrExists):
+		return &os.PathError{Op: op, Path: name, Err: os.ErrExist}
+	}
+
+	return &os.PathError{Op: op, Path: name, Err: err}
+}
+
+// davFileInfo reads the content type of a file from the volume metadata,
+// the WebDAV handler would read the beginning of every listed file otherwise.
+type davFileInfo struct {
+	os.FileInfo
+
+	client *Client
+	path   string
+}
+
+func (i *davFileInfo) ContentType(ctx context.Context) (string, error) {
+	return i.client.ContentType(ctx, i.path)
+}
+
+// davFile is a file opened for reading.
+type davFile struct {
+	*jfsReader
+
+	info os.FileInfo
+}
+
+func (f *davFile) Readdir(int) ([]iofs.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: f.info.Name(), Err: syscall.ENOTDIR}
+}
+
+func (f *davFile) Stat() (iofs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *davFile) Write([]byte) (int, error) {
+	return 0, &os.PathError{Op: "write", Path: f.info.Name(), Err: os.ErrPermission}
+}
+
+// davDir is a directory opened for listing, the entries are read on the first Readdir.
+type davDir struct {
+	fs   *DAVFileSystem
+	ctx  context.Context //nolint:containedctx // the handler lists the directory within the request opening it
+	name string
+	info os.FileInfo
+
+	entries []iofs.FileInfo
+	loaded  bool
+}
+
+func (d *davDir) Readdir(count int) ([]iofs.FileInfo, error) {
+	if !d.loaded {
+		entries, err := d.fs.readDir(d.ctx, d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.loaded = true
+	}
+
+	if count <= 0 {
+		entries := d.entries
+		d.entries = nil
+
+		return entries, nil
+	}
+
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	n := min(count, len(d.entries))
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+
+	return entries, nil
+}
+
+func (d *davDir) Stat() (iofs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *davDir) Read([]byte) (int, error) {
+	return 0, &os.PathError{Op: "read", Path: d.name, Err: syscall.EISDIR}
+}
+
+func (d *davDir) Seek(int64, int) (int64, error) {
+	return 0, &os.PathError{Op: "seek", Path: d.name, Err: syscall.EISDIR}
+}
+
+func (d *davDir) Write([]byte) (int, error) {
+	return 0, &os.PathError{Op: "write", Path: d.name, Err: syscall.EISDIR}
+}
+
+func (d *davDir) Close() error {
+	return nil
+}
+
+// readDir returns the entries of a directory, symlinks are reported as their targets and dangling ones are left out.
+func (f *DAVFileSystem) readDir(ctx context.Context, name string) ([]iofs.FileInfo, error) {
+	result, err := f.client.ListDir(ctx, name, 0, 0)
+	if err != nil {
+		return nil, davError("readdir", name, err)
+	}
+
+	entries := make([]iofs.FileInfo, 0, len(result.Files))
+	for _, file := range result.Files {
+		if file.Type != "symlink" {
+			entries = append(entries, &davFileInfo{FileInfo: listedFileInfo{file: file}, client: f.client, path: file.Path})
+
+			continue
+		}
+
+		info, err := f.client.davStat(ctx, file.Path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, info)
+	}
+
+	return entries, nil
+}
+
+// listedFileInfo is a directory entry as an os.FileInfo.
+type listedFileInfo struct {
+	file FileInfo
+}
+
+func (i listedFileInfo) Name() string       { return i.file.Name }
+func (i listedFileInfo) Size() int64        { return i.file.Size }
+func (i listedFileInfo) ModTime() time.Time { return i.file.ModifiedAt }
+func (i listedFileInfo) IsDir() bool        { return i.file.Type == "directory" }
+func (i listedFileInfo) Sys() any           { return nil }
+
+func (i listedFileInfo) Mode() iofs.FileMode {
+	if i.IsDir() {
+		return iofs.ModeDir | 0o755
+	}
+
+	return 0o644
+}
+
+// davWriter streams a written file to Upload.
+type davWriter struct {
+	fs   *DAVFileSystem
+	ctx  context.Context //nolint:containedctx // the file is written within the request opening it
+	name string
+	pw   *io.PipeWriter
+	done chan error
+
+	finishOnce sync.Once
+	err        error
+}
+
+func (w *davWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// finish ends the content of the file and waits for the upload.
+func (w *davWriter) finish() error {
+	w.finishOnce.Do(func() {
+		// Content of an interrupted request is discarded, the upload doesn't replace the file then
+		if err := w.fs.aborted(); err != nil {
+			w.pw.CloseWithError(err)
+		} else if err := w.ctx.Err(); err != nil {
+			w.pw.CloseWithError(err)
+		} else {
+			w.pw.Close()
+		}
+
+		w.err = <-w.done
+		if w.err != nil {
+			w.fs.setWriteErr(w.err)
+		}
+	})
+
+	return w.err
+}
+
+// Stat finishes the file, the WebDAV handler computes the ETag of the written file from it.
+func (w *davWriter) Stat() (iofs.FileInfo, error) {
+	if err := w.finish(); err != nil {
+		return nil, err
+	}
+
+	return w.fs.client.davStat(w.ctx, w.name)
+}
+
+func (w *davWriter) Close() error {
+	return w.finish()
+}
+
+func (w *davWriter) Read([]byte) (int, error) {
+	return 0, &os.PathError{Op: "read", Path: w.name, Err: os.ErrPermission}
+}
+
+func (w *davWriter) Seek(int64, int) (int64, error) {
+	return 0, &os.PathError{Op: "seek", Path: w.name, Err: os.ErrPermission}
+}
+
+func (w *davWriter) Readdir(int) ([]iofs.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: w.name, Err: syscall.ENOTDIR}
+}
@@ -0,0 +1,100 @@
+package juicefs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAVError(t *testing.T) {
+	t.Parallel()
+
+	other := errors.New("disk failure")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "not found", err: fmt.Errorf("%w: /a", ErrNotFound), want: os.ErrNotExist},
+		{name: "not a directory", err: fmt.Errorf("%w: /a", ErrNotDirectory), want: os.ErrNotExist},
+		{name: "dangling symlink", err: fmt.Errorf("%w: /a", ErrDanglingSymlink), want: os.ErrNotExist},
+		{name: "exists", err: fmt.Errorf("%w: /a", ErrExists), want: os.ErrExist},
+		{name: "other", err: other, want: other},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := davError("open", "/a", tt.err)
+
+			var pathErr *os.PathError
+			require.ErrorAs(t, err, &pathErr)
+			assert.Equal(t, "/a", pathErr.Path)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	assert.NoError(t, davError("open", "/a", nil))
+}
+
+func TestDAVDirReaddir(t *testing.T) {
+	t.Parallel()
+
+	modTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []iofs.FileInfo{
+		listedFileInfo{file: FileInfo{Name: "a", Type: "directory", ModifiedAt: modTime}},
+		listedFileInfo{file: FileInfo{Name: "b", Type: "file", Size: 3, ModifiedAt: modTime}},
+		listedFileInfo{file: FileInfo{Name: "c", Type: "file", Size: 5, ModifiedAt: modTime}},
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		t.Parallel()
+
+		dir := &davDir{name: "/dir", entries: entries, loaded: true}
+
+		page, err := dir.Readdir(2)
+		require.NoError(t, err)
+		assert.Equal(t, entries[:2], page)
+
+		page, err = dir.Readdir(2)
+		require.NoError(t, err)
+		assert.Equal(t, entries[2:], page)
+
+		_, err = dir.Readdir(2)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+
+		dir := &davDir{name: "/dir", entries: entries, loaded: true}
+
+		all, err := dir.Readdir(0)
+		require.NoError(t, err)
+		assert.Equal(t, entries, all)
+
+		rest, err := dir.Readdir(0)
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+	})
+
+	t.Run("file info", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, entries[0].IsDir())
+		assert.Equal(t, iofs.ModeDir|0o755, entries[0].Mode())
+		assert.False(t, entries[1].IsDir())
+		assert.Equal(t, iofs.FileMode(0o644), entries[1].Mode())
+		assert.Equal(t, int64(3), entries[1].Size())
+		assert.Equal(t, modTime, entries[1].ModTime())
+	})
+}
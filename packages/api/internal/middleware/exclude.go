@@ -50,19 +50,25 @@ func shouldSkip(path string, patterns []string) bool {
 	return false
 }
 
+// matchPattern matches a path with a route pattern, ":name" segments match any segment
+// and a final "*name" segment matches the rest of the path, like in gin routes.
 func matchPattern(path, pattern string) bool {
 	pathSegments := strings.Split(path, "/")
 	patternSegments := strings.Split(pattern, "/")
 
-	if len(pathSegments) != len(patternSegments) {
-		return false
-	}
+	for i, patternSegment := range patternSegments {
+		if strings.HasPrefix(patternSegment, "*") && i == len(patternSegments)-1 {
+			return len(pathSegments) >= i
+		}
+
+		if i >= len(pathSegments) {
+			return false
+		}
 
-	for i := range pathSegments {
-		if patternSegments[i] != pathSegments[i] && !strings.HasPrefix(patternSegments[i], ":") {
+		if patternSegment != pathSegments[i] && !strings.HasPrefix(patternSegment, ":") {
 			return false
 		}
 	}
 
-	return true
+	return len(pathSegments) == len(patternSegments)
 }
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path    string
+		pattern string
+		want    bool
+	}{
+		{path: "/health", pattern: "/health", want: true},
+		{path: "/volumes/vol_1/files", pattern: "/volumes/:volumeID/files", want: true},
+		{path: "/volumes/vol_1/files/stat", pattern: "/volumes/:volumeID/files", want: false},
+		{path: "/volumes/vol_1", pattern: "/volumes/:volumeID/files", want: false},
+		{path: "/volumes/vol_1/webdav", pattern: "/volumes/:volumeID/webdav/*path", want: true},
+		{path: "/volumes/vol_1/webdav/", pattern: "/volumes/:volumeID/webdav/*path", want: true},
+		{path: "/volumes/vol_1/webdav/src/main.go", pattern: "/volumes/:volumeID/webdav/*path", want: true},
+		{path: "/volumes/vol_1/files/a", pattern: "/volumes/:volumeID/webdav/*path", want: false},
+		{path: "/volumes/vol_1", pattern: "/volumes/:volumeID/webdav/*path", want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matchPattern(tt.path, tt.pattern), "%s matching %s", tt.path, tt.pattern)
+	}
+}
@@ -1,16 +1,26 @@
 package middleware
 
 import (
+	"fmt"
+	"math"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"golang.org/x/time/rate"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/auth"
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 )
 
 // RateLimitConfig defines the rate limit configuration for an endpoint.
 type RateLimitConfig struct {
+	// Name identifies the limit in the metrics and the limits endpoint.
+	Name string
 	// RequestsPerMinute is the number of requests allowed per minute per key.
 	RequestsPerMinute int
 	// BurstSize is the maximum number of requests that can be made in a burst.
@@ -18,9 +28,77 @@ type RateLimitConfig struct {
 	BurstSize int
 }
 
+// Burst returns the burst size, RequestsPerMinute when BurstSize isn't set.
+func (c RateLimitConfig) Burst() int {
+	if c.BurstSize == 0 {
+		return c.RequestsPerMinute
+	}
+
+	return c.BurstSize
+}
+
+const (
+	// throttledWindow is how long the rejected requests of a key are counted for.
+	throttledWindow = 10 * time.Minute
+	// throttledBuckets splits the window in minutes, so old rejections expire gradually.
+	throttledBuckets = int64(throttledWindow / time.Minute)
+)
+
+// RateLimitUsage is the consumption of a rate limit by a key.
+type RateLimitUsage struct {
+	Config RateLimitConfig
+	// Remaining is the number of requests the key can make right now.
+	Remaining int
+	// ThrottledRecently is the number of requests of the key rejected in the throttled window.
+	ThrottledRecently int64
+}
+
+// RateLimits creates the rate limiting middlewares and keeps their limiters,
+// so the consumption of each key can be queried and is reported in the metrics.
+type RateLimits struct {
+	mu       sync.RWMutex
+	limiters []*rateLimiter
+
+	requests metric.Int64Counter
+}
+
+func NewRateLimits(meterProvider metric.MeterProvider) (*RateLimits, error) {
+	meter := meterProvider.Meter("api.ratelimit")
+
+	requests, err := telemetry.GetCounter(meter, telemetry.ApiRateLimitRequestsCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rate limit requests counter: %w", err)
+	}
+
+	return &RateLimits{requests: requests}, nil
+}
+
+// Usage returns the consumption of every rate limit by the key, in the order the limits were created.
+func (r *RateLimits) Usage(key string) []RateLimitUsage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	usage := make([]RateLimitUsage, 0, len(r.limiters))
+	for _, rl := range r.limiters {
+		usage = append(usage, rl.usage(key))
+	}
+
+	return usage
+}
+
+func (r *RateLimits) newRateLimiter(config RateLimitConfig) *rateLimiter {
+	rl := newRateLimiter(config)
+
+	r.mu.Lock()
+	r.limiters = append(r.limiters, rl)
+	r.mu.Unlock()
+
+	return rl
+}
+
 // rateLimiter stores rate limiters per key (e.g., per team or per IP).
 type rateLimiter struct {
-	mu       sync.RWMutex
+	mu       sync.Mutex
 	limiters map[string]*limiterEntry
 	config   RateLimitConfig
 	// cleanupInterval is how often to clean up expired limiters
@@ -32,15 +110,40 @@ type rateLimiter struct {
 type limiterEntry struct {
 	limiter    *rate.Limiter
 	lastAccess time.Time
+	throttled  throttledCounter
 }
 
-// newRateLimiter creates a new rate limiter with the given config.
-func newRateLimiter(config RateLimitConfig) *rateLimiter {
-	burstSize := config.BurstSize
-	if burstSize == 0 {
-		burstSize = config.RequestsPerMinute
+// throttledCounter counts rejected requests in buckets of a minute over the throttled window.
+type throttledCounter struct {
+	counts  [throttledBuckets]int64
+	minutes [throttledBuckets]int64
+}
+
+func (t *throttledCounter) add(now time.Time) {
+	minute := now.Unix() / 60
+	i := minute % throttledBuckets
+	if t.minutes[i] != minute {
+		t.minutes[i] = minute
+		t.counts[i] = 0
+	}
+	t.counts[i]++
+}
+
+func (t *throttledCounter) recent(now time.Time) int64 {
+	minute := now.Unix() / 60
+
+	var total int64
+	for i, count := range t.counts {
+		if minute-t.minutes[i] < throttledBuckets {
+			total += count
+		}
 	}
 
+	return total
+}
+
+// newRateLimiter creates a new rate limiter with the given config.
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
 	rl := &rateLimiter{
 		limiters:        make(map[string]*limiterEntry),
 		config:          config,
@@ -54,41 +157,49 @@ func newRateLimiter(config RateLimitConfig) *rateLimiter {
 	return rl
 }
 
-// getLimiter returns the rate limiter for the given key.
-func (rl *rateLimiter) getLimiter(key string) *rate.Limiter {
-	rl.mu.RLock()
+// allow reports whether a request of the key is allowed, counting it as throttled otherwise.
+func (rl *rateLimiter) allow(key string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+
 	entry, exists := rl.limiters[key]
-	rl.mu.RUnlock()
+	if !exists {
+		// rate.Limit is events per second, so divide by 60
+		entry = &limiterEntry{
+			limiter: rate.NewLimiter(rate.Limit(float64(rl.config.RequestsPerMinute)/60.0), rl.config.Burst()),
+		}
+		rl.limiters[key] = entry
+	}
+	entry.lastAccess = now
 
-	if exists {
-		rl.mu.Lock()
-		entry.lastAccess = time.Now()
-		rl.mu.Unlock()
-		return entry.limiter
+	if !entry.limiter.AllowN(now, 1) {
+		entry.throttled.add(now)
+
+		return false
 	}
 
+	return true
+}
+
+// usage returns the consumption of the limit by the key, a key without requests has the whole burst.
+func (rl *rateLimiter) usage(key string) RateLimitUsage {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	// Double-check after acquiring write lock
-	if entry, exists = rl.limiters[key]; exists {
-		entry.lastAccess = time.Now()
-		return entry.limiter
+	usage := RateLimitUsage{
+		Config:    rl.config,
+		Remaining: rl.config.Burst(),
 	}
 
-	// Create new limiter
-	// rate.Limit is events per second, so divide by 60
-	burstSize := rl.config.BurstSize
-	if burstSize == 0 {
-		burstSize = rl.config.RequestsPerMinute
-	}
-	limiter := rate.NewLimiter(rate.Limit(float64(rl.config.RequestsPerMinute)/60.0), burstSize)
-	rl.limiters[key] = &limiterEntry{
-		limiter:    limiter,
-		lastAccess: time.Now(),
+	if entry, exists := rl.limiters[key]; exists {
+		now := time.Now()
+		usage.Remaining = max(int(math.Floor(entry.limiter.TokensAt(now))), 0)
+		usage.ThrottledRecently = entry.throttled.recent(now)
 	}
 
-	return limiter
+	return usage
 }
 
 // cleanup removes expired limiters periodically.
@@ -112,12 +223,17 @@ func (rl *rateLimiter) cleanup() {
 // Common implementations: ByTeamID, ByIP.
 type KeyFunc func(c *gin.Context) string
 
+// TeamKey returns the rate limit key of the team, as returned by ByTeamID.
+func TeamKey(teamID string) string {
+	return "team:" + teamID
+}
+
 // ByTeamID returns the team ID from the context as the rate limit key.
 // Falls back to client IP if no team ID is found.
 func ByTeamID(c *gin.Context) string {
-	// Try to get team ID from context (set by auth middleware)
-	if teamID := c.GetString("team_id"); teamID != "" {
-		return "team:" + teamID
+	// Try to get team from context (set by auth middleware)
+	if team, ok := c.Value(auth.TeamContextKey).(*types.Team); ok {
+		return TeamKey(team.ID.String())
 	}
 	// Fall back to IP
 	return "ip:" + c.ClientIP()
@@ -128,19 +244,13 @@ func ByIP(c *gin.Context) string {
 	return "ip:" + c.ClientIP()
 }
 
-// RateLimitMiddleware creates a rate limiting middleware with the given config.
+// Middleware creates a rate limiting middleware with the given config.
 // The keyFunc determines how requests are grouped for rate limiting.
-func RateLimitMiddleware(config RateLimitConfig, keyFunc KeyFunc) gin.HandlerFunc {
-	rl := newRateLimiter(config)
+func (r *RateLimits) Middleware(config RateLimitConfig, keyFunc KeyFunc) gin.HandlerFunc {
+	rl := r.newRateLimiter(config)
 
 	return func(c *gin.Context) {
-		key := keyFunc(c)
-		limiter := rl.getLimiter(key)
-
-		if !limiter.Allow() {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"message": "Rate limit exceeded. Please try again later.",
-			})
+		if !r.allow(c, rl, keyFunc) {
 			return
 		}
 
@@ -148,9 +258,9 @@ func RateLimitMiddleware(config RateLimitConfig, keyFunc KeyFunc) gin.HandlerFun
 	}
 }
 
-// RateLimitForMethod creates a rate limiting middleware that only applies to specific HTTP methods.
-func RateLimitForMethod(config RateLimitConfig, keyFunc KeyFunc, methods ...string) gin.HandlerFunc {
-	rl := newRateLimiter(config)
+// ForMethod creates a rate limiting middleware that only applies to specific HTTP methods.
+func (r *RateLimits) ForMethod(config RateLimitConfig, keyFunc KeyFunc, methods ...string) gin.HandlerFunc {
+	rl := r.newRateLimiter(config)
 	methodSet := make(map[string]bool, len(methods))
 	for _, m := range methods {
 		methodSet[m] = true
@@ -163,13 +273,7 @@ func RateLimitForMethod(config RateLimitConfig, keyFunc KeyFunc, methods ...stri
 			return
 		}
 
-		key := keyFunc(c)
-		limiter := rl.getLimiter(key)
-
-		if !limiter.Allow() {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"message": "Rate limit exceeded. Please try again later.",
-			})
+		if !r.allow(c, rl, keyFunc) {
 			return
 		}
 
@@ -177,27 +281,128 @@ func RateLimitForMethod(config RateLimitConfig, keyFunc KeyFunc, methods ...stri
 	}
 }
 
+// allow checks the request against the limiter and aborts it with 429 when it's throttled.
+func (r *RateLimits) allow(c *gin.Context, rl *rateLimiter, keyFunc KeyFunc) bool {
+	key := keyFunc(c)
+	allowed := rl.allow(key)
+
+	result := "allowed"
+	if !allowed {
+		result = "throttled"
+	}
+	attributes := []attribute.KeyValue{
+		attribute.String("limit", rl.config.Name),
+		attribute.String("result", result),
+	}
+	// Requests without a team are limited by IP, which isn't recorded
+	if team, ok := c.Value(auth.TeamContextKey).(*types.Team); ok {
+		attributes = append(attributes, attribute.String("team_id", team.ID.String()))
+	}
+	r.requests.Add(c.Request.Context(), 1, metric.WithAttributes(attributes...))
+
+	if !allowed {
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"message": "Rate limit exceeded. Please try again later.",
+		})
+	}
+
+	return allowed
+}
+
 // FileAPIRateLimits defines rate limits for file API endpoints.
 var FileAPIRateLimits = struct {
-	List     RateLimitConfig
-	Upload   RateLimitConfig
-	Download RateLimitConfig
-	Delete   RateLimitConfig
+	List       RateLimitConfig
+	Stat       RateLimitConfig
+	Upload     RateLimitConfig
+	Download   RateLimitConfig
+	Archive    RateLimitConfig
+	Delete     RateLimitConfig
+	Mkdir      RateLimitConfig
+	Attributes RateLimitConfig
+	Symlink    RateLimitConfig
+	Search     RateLimitConfig
+	Grep       RateLimitConfig
+	Sync       RateLimitConfig
+	WebDAV     RateLimitConfig
+	S3         RateLimitConfig
 }{
 	List: RateLimitConfig{
+		Name:              "files.list",
+		RequestsPerMinute: 100,
+		BurstSize:         20,
+	},
+	Stat: RateLimitConfig{
+		Name:              "files.stat",
 		RequestsPerMinute: 100,
 		BurstSize:         20,
 	},
 	Upload: RateLimitConfig{
+		Name:              "files.upload",
 		RequestsPerMinute: 60,
 		BurstSize:         10,
 	},
 	Download: RateLimitConfig{
+		Name:              "files.download",
 		RequestsPerMinute: 60,
 		BurstSize:         10,
 	},
+	// Archives walk a whole directory tree, so they are limited more strictly than single downloads
+	Archive: RateLimitConfig{
+		Name:              "files.archive",
+		RequestsPerMinute: 10,
+		BurstSize:         2,
+	},
 	Delete: RateLimitConfig{
+		Name:              "files.delete",
+		RequestsPerMinute: 30,
+		BurstSize:         5,
+	},
+	// Creating directories is limited like uploads
+	Mkdir: RateLimitConfig{
+		Name:              "files.mkdir",
+		RequestsPerMinute: 60,
+		BurstSize:         10,
+	},
+	// Changing attributes is limited like deletes, both can walk a whole directory tree
+	Attributes: RateLimitConfig{
+		Name:              "files.attributes",
 		RequestsPerMinute: 30,
 		BurstSize:         5,
 	},
+	// Creating symlinks is limited like directories
+	Symlink: RateLimitConfig{
+		Name:              "files.symlink",
+		RequestsPerMinute: 60,
+		BurstSize:         10,
+	},
+	// Searches walk a whole directory tree, so they are limited like archives
+	Search: RateLimitConfig{
+		Name:              "files.search",
+		RequestsPerMinute: 10,
+		BurstSize:         2,
+	},
+	// Content searches read whole files, so they are limited like archives
+	Grep: RateLimitConfig{
+		Name:              "files.grep",
+		RequestsPerMinute: 10,
+		BurstSize:         2,
+	},
+	// Syncs compare a whole directory tree and read the files of the same size, so they are limited like archives
+	Sync: RateLimitConfig{
+		Name:              "files.sync",
+		RequestsPerMinute: 10,
+		BurstSize:         2,
+	},
+	// WebDAV clients send a request per file and directory they touch, a mounted volume browses in bursts
+	WebDAV: RateLimitConfig{
+		Name:              "files.webdav",
+		RequestsPerMinute: 300,
+		BurstSize:         60,
+	},
+	// S3 tools send a request per object and part, like WebDAV clients
+	S3: RateLimitConfig{
+		Name:              "files.s3",
+		RequestsPerMinute: 300,
+		BurstSize:         60,
+	},
 }
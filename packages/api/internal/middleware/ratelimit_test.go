@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestRateLimits_Usage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rateLimits, err := NewRateLimits(noop.NewMeterProvider())
+	require.NoError(t, err)
+
+	config := RateLimitConfig{Name: "test", RequestsPerMinute: 1, BurstSize: 2}
+	handler := rateLimits.Middleware(config, func(*gin.Context) string { return "key" })
+
+	statuses := make([]int, 0, 3)
+	for range 3 {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		handler(c)
+		statuses = append(statuses, c.Writer.Status())
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, statuses)
+
+	usage := rateLimits.Usage("key")
+	require.Len(t, usage, 1)
+	assert.Equal(t, "test", usage[0].Config.Name)
+	assert.Equal(t, 0, usage[0].Remaining)
+	assert.Equal(t, int64(1), usage[0].ThrottledRecently)
+
+	// A key without requests has the whole burst
+	usage = rateLimits.Usage("other")
+	require.Len(t, usage, 1)
+	assert.Equal(t, 2, usage[0].Remaining)
+	assert.Zero(t, usage[0].ThrottledRecently)
+}
+
+func TestThrottledCounter_Expires(t *testing.T) {
+	var counter throttledCounter
+
+	now := time.Unix(1_700_000_000, 0)
+	counter.add(now)
+	counter.add(now.Add(time.Minute))
+	assert.Equal(t, int64(2), counter.recent(now.Add(time.Minute)))
+
+	// The first rejection left the window
+	assert.Equal(t, int64(1), counter.recent(now.Add(throttledWindow)))
+	assert.Zero(t, counter.recent(now.Add(throttledWindow+time.Minute)))
+}
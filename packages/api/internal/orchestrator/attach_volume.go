@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/db/types"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+// AttachVolume mounts the volume in the running sandbox. The orchestrator refusing it, e.g. because
+// the sandbox already has a volume, is returned as ErrVolumeAttachRejected.
+func (o *Orchestrator) AttachVolume(
+	ctx context.Context,
+	sandboxID string,
+	volumeConfig *types.VolumeConfig,
+	clusterID uuid.UUID,
+	nodeID string,
+) error {
+	childCtx, childSpan := tracer.Start(ctx, "attach-volume",
+		trace.WithAttributes(
+			attribute.String("instance.id", sandboxID),
+			attribute.String("volume.id", volumeConfig.VolumeID),
+		),
+	)
+	defer childSpan.End()
+
+	client, childCtx, err := o.GetClient(childCtx, clusterID, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", nodeID, err)
+	}
+
+	_, err = client.Sandbox.AttachVolume(
+		childCtx, &orchestrator.SandboxAttachVolumeRequest{
+			SandboxId: sandboxID,
+			Volume: &orchestrator.VolumeConfig{
+				VolumeId: volumeConfig.VolumeID,
+				// Volumes without a team bucket are in the shared bucket of the deployment
+				GcsBucket:      cmp.Or(volumeConfig.GCSBucket, o.volumesBucket),
+				MountPath:      volumeConfig.MountPath,
+				ReadOnly:       volumeConfig.ReadOnly,
+				MountMemoryMb:  volumeConfig.MountMemoryMB,
+				MountCpuWeight: volumeConfig.MountCPUWeight,
+				MountOptions:   volumeConfig.MountOptions,
+				Subpath:        volumeConfig.Subpath,
+			},
+		},
+	)
+	if err != nil {
+		grpcErr, ok := status.FromError(err)
+		if ok && grpcErr.Code() == codes.NotFound {
+			return ErrSandboxNotFound
+		}
+
+		if ok && grpcErr.Code() == codes.FailedPrecondition {
+			return fmt.Errorf("%w: %s", ErrVolumeAttachRejected, grpcErr.Message())
+		}
+
+		err = utils.UnwrapGRPCError(err)
+
+		return fmt.Errorf("failed to attach volume to sandbox '%s': %w", sandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Attached volume")
+
+	return nil
+}
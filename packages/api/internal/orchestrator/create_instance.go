@@ -1,6 +1,7 @@
 package orchestrator
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -42,19 +43,18 @@ func buildNetworkConfig(network *types.SandboxNetworkConfig, allowInternetAccess
 
 	// Copy network configuration if provided
 	if network != nil && network.Egress != nil {
-		// Split allowed addresses into CIDRs/IPs and domains for the orchestrator
+		// Split allowed addresses into CIDRs/IPs and domains for the orchestrator.
+		// With domains, the orchestrator answers the DNS queries of the sandbox and only resolves allowed domains.
 		allowedAddresses, allowedDomains := sandbox_network.ParseAddressesAndDomains(network.Egress.AllowedAddresses)
 
-		// If allowed domain is provided, add the default nameserver to the allowed addresses
-		// This is to ensure that the sandbox can resolve the domain name to the IP address
-		if len(allowedDomains) > 0 {
-			allowedAddresses = append(allowedAddresses, sandbox_network.DefaultNameserver)
-		}
-
 		orchNetwork.Egress.AllowedCidrs = sandbox_network.AddressStringsToCIDRs(allowedAddresses)
 		orchNetwork.Egress.AllowedDomains = allowedDomains
 
-		orchNetwork.Egress.DeniedCidrs = sandbox_network.AddressStringsToCIDRs(network.Egress.DeniedAddresses)
+		// Split denied addresses the same way, denied domains are matched by the egress proxy
+		deniedAddresses, deniedDomains := sandbox_network.ParseAddressesAndDomains(network.Egress.DeniedAddresses)
+
+		orchNetwork.Egress.DeniedCidrs = sandbox_network.AddressStringsToCIDRs(deniedAddresses)
+		orchNetwork.Egress.DeniedDomains = deniedDomains
 	}
 
 	if network != nil && network.Ingress != nil {
@@ -90,6 +90,7 @@ func (o *Orchestrator) CreateSandbox(
 	build queries.EnvBuild,
 	metadata map[string]string,
 	envVars map[string]string,
+	secrets map[string]string,
 	startTime time.Time,
 	endTime time.Time,
 	timeout time.Duration,
@@ -216,17 +217,45 @@ func (o *Orchestrator) CreateSandbox(
 	// Build volume config for proto if provided
 	var sbxVolume *orchestrator.VolumeConfig
 	if volumeConfig != nil {
+		// Volumes without a team bucket are in the shared bucket of the deployment
+		gcsBucket := cmp.Or(volumeConfig.GCSBucket, o.volumesBucket)
 		sbxVolume = &orchestrator.VolumeConfig{
-			VolumeId:  volumeConfig.VolumeID,
-			MountPath: volumeConfig.MountPath,
-			RedisDb:   int32(volumeConfig.RedisDB),
-			GcsBucket: o.volumesBucket, // Set from orchestrator config
+			VolumeId:       volumeConfig.VolumeID,
+			MountPath:      volumeConfig.MountPath,
+			RedisDb:        int32(volumeConfig.RedisDB),
+			MetadataEngine: volumeConfig.MetadataEngine,
+			GcsBucket:      gcsBucket,
+			ReadOnlyRoot:   volumeConfig.ReadOnlyRoot,
+			OverlayPaths:   volumeConfig.OverlayPaths,
+			ReadOnly:       volumeConfig.ReadOnly,
+			MountMemoryMb:  volumeConfig.MountMemoryMB,
+			MountCpuWeight: volumeConfig.MountCPUWeight,
+			MountOptions:   volumeConfig.MountOptions,
+			PersistHome:    volumeConfig.PersistHome,
+			Prewarm:        volumeConfig.Prewarm,
+			Subpath:        volumeConfig.Subpath,
+
+			BandwidthBytesPerSecond: volumeConfig.BandwidthBytesPerSecond,
+			RequestsPerSecond:       volumeConfig.RequestsPerSecond,
+			AllowMountFailure:       volumeConfig.AllowMountFailure,
 		}
 		telemetry.ReportEvent(ctx, "Volume config set for sandbox",
 			attribute.String("volume.id", volumeConfig.VolumeID),
 			attribute.String("volume.mount_path", volumeConfig.MountPath),
+			attribute.String("volume.subpath", volumeConfig.Subpath),
+			attribute.String("volume.metadata_engine", volumeConfig.MetadataEngine),
 			attribute.Int("volume.redis_db", volumeConfig.RedisDB),
-			attribute.String("volume.gcs_bucket", o.volumesBucket),
+			attribute.String("volume.gcs_bucket", gcsBucket),
+			attribute.Bool("volume.read_only_root", volumeConfig.ReadOnlyRoot),
+			attribute.StringSlice("volume.overlay_paths", volumeConfig.OverlayPaths),
+			attribute.Bool("volume.read_only", volumeConfig.ReadOnly),
+			attribute.Int64("volume.mount_memory_mb", volumeConfig.MountMemoryMB),
+			attribute.Int64("volume.mount_cpu_weight", volumeConfig.MountCPUWeight),
+			attribute.Bool("volume.persist_home", volumeConfig.PersistHome),
+			attribute.StringSlice("volume.prewarm", volumeConfig.Prewarm),
+			attribute.Int64("volume.bandwidth_bytes_per_second", volumeConfig.BandwidthBytesPerSecond),
+			attribute.Int64("volume.requests_per_second", volumeConfig.RequestsPerSecond),
+			attribute.Bool("volume.allow_mount_failure", volumeConfig.AllowMountFailure),
 		)
 	} else {
 		telemetry.ReportEvent(ctx, "No volume config for sandbox")
@@ -246,6 +275,7 @@ func (o *Orchestrator) CreateSandbox(
 			EnvdVersion:         *build.EnvdVersion,
 			Metadata:            metadata,
 			EnvVars:             envVars,
+			Secrets:             secrets,
 			EnvdAccessToken:     envdAuthToken,
 			MaxSandboxLength:    team.Limits.MaxLengthHours,
 			HugePages:           hasHugePages,
@@ -260,6 +290,8 @@ func (o *Orchestrator) CreateSandbox(
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
+		// Resumed sandboxes must start from their own snapshot.
+		WarmPool: !isResume,
 	}
 
 	var node *nodemanager.Node
@@ -0,0 +1,64 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/utils"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+// DetachVolume flushes and unmounts the volume from the running sandbox. The orchestrator refusing it,
+// e.g. because the volume isn't the attached one, is returned as ErrVolumeDetachRejected.
+func (o *Orchestrator) DetachVolume(
+	ctx context.Context,
+	sandboxID string,
+	volumeID string,
+	clusterID uuid.UUID,
+	nodeID string,
+) error {
+	childCtx, childSpan := tracer.Start(ctx, "detach-volume",
+		trace.WithAttributes(
+			attribute.String("instance.id", sandboxID),
+			attribute.String("volume.id", volumeID),
+		),
+	)
+	defer childSpan.End()
+
+	client, childCtx, err := o.GetClient(childCtx, clusterID, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", nodeID, err)
+	}
+
+	_, err = client.Sandbox.DetachVolume(
+		childCtx, &orchestrator.SandboxDetachVolumeRequest{
+			SandboxId: sandboxID,
+			VolumeId:  volumeID,
+		},
+	)
+	if err != nil {
+		grpcErr, ok := status.FromError(err)
+		if ok && grpcErr.Code() == codes.NotFound {
+			return ErrSandboxNotFound
+		}
+
+		if ok && grpcErr.Code() == codes.FailedPrecondition {
+			return fmt.Errorf("%w: %s", ErrVolumeDetachRejected, grpcErr.Message())
+		}
+
+		err = utils.UnwrapGRPCError(err)
+
+		return fmt.Errorf("failed to detach volume from sandbox '%s': %w", sandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Detached volume")
+
+	return nil
+}
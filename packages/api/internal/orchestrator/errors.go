@@ -6,4 +6,6 @@ var (
 	ErrSandboxNotFound        = errors.New("sandbox not found")
 	ErrAccessForbidden        = errors.New("access forbidden")
 	ErrSandboxOperationFailed = errors.New("sandbox operation failed")
+	ErrVolumeAttachRejected   = errors.New("volume can't be attached to the sandbox")
+	ErrVolumeDetachRejected   = errors.New("volume can't be detached from the sandbox")
 )
@@ -66,11 +66,10 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]sandbox.Sandbox, error) {
 			}
 
 			if egress := config.GetNetwork().GetEgress(); egress != nil {
-				// Combine allowed CIDRs and domains back into AllowedAddresses
-				allowedAddresses := slices.Concat(egress.GetAllowedCidrs(), egress.GetAllowedDomains())
+				// Combine CIDRs and domains back into AllowedAddresses and DeniedAddresses
 				network.Egress = &types.SandboxNetworkEgressConfig{
-					AllowedAddresses: allowedAddresses,
-					DeniedAddresses:  egress.GetDeniedCidrs(),
+					AllowedAddresses: slices.Concat(egress.GetAllowedCidrs(), egress.GetAllowedDomains()),
+					DeniedAddresses:  slices.Concat(egress.GetDeniedCidrs(), egress.GetDeniedDomains()),
 				}
 			}
 		}
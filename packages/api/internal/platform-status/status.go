@@ -0,0 +1,54 @@
+package platformstatus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+)
+
+const (
+	// minRequests is how many requests are needed in the window to judge a component by its error rate,
+	// a few failed requests of a single team shouldn't mark the component degraded.
+	minRequests = 5
+
+	degradedErrorRate = 0.05
+	outageErrorRate   = 0.5
+
+	// degradedSchedulingLatency is the 95th percentile of the sandbox creations above which scheduling is degraded.
+	degradedSchedulingLatency = 30 * time.Second
+)
+
+var severity = map[api.PlatformStatusState]int{
+	api.PlatformStatusOperational: 0,
+	api.PlatformStatusDegraded:    1,
+	api.PlatformStatusOutage:      2,
+}
+
+// Status returns the state of the component from its recent requests, with the reason when it's not operational.
+func Status(name api.PlatformComponent, usage Usage) (api.PlatformStatusState, string) {
+	if usage.Requests >= minRequests {
+		rate := usage.ErrorRate()
+		switch {
+		case rate >= outageErrorRate:
+			return api.PlatformStatusOutage, fmt.Sprintf("%.0f%% of the recent requests failed", rate*100)
+		case rate >= degradedErrorRate:
+			return api.PlatformStatusDegraded, fmt.Sprintf("%.0f%% of the recent requests failed", rate*100)
+		}
+	}
+
+	if name == api.PlatformComponentSandboxScheduling && usage.LatencyP95 > degradedSchedulingLatency {
+		return api.PlatformStatusDegraded, fmt.Sprintf("Sandboxes take %s to start", usage.LatencyP95.Round(time.Second))
+	}
+
+	return api.PlatformStatusOperational, ""
+}
+
+// Worst returns the more severe of the states.
+func Worst(a, b api.PlatformStatusState) api.PlatformStatusState {
+	if severity[b] > severity[a] {
+		return b
+	}
+
+	return a
+}
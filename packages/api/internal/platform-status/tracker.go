@@ -0,0 +1,172 @@
+// Package platformstatus tracks the recent server errors and durations of the requests per platform component,
+// to report the health of the platform to the teams.
+package platformstatus
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+)
+
+const (
+	// Window is how long the requests of a component are counted for.
+	Window = 5 * time.Minute
+	// windowBuckets splits the window in minutes, so old requests expire gradually.
+	windowBuckets = int64(Window / time.Minute)
+
+	// latencySamples is how many recent request durations are kept to compute the percentile.
+	latencySamples = 256
+)
+
+// Usage is the recent requests of a component.
+type Usage struct {
+	Requests int64
+	Failures int64
+	// LatencyP95 is the 95th percentile of the recent request durations, 0 without duration tracking.
+	LatencyP95 time.Duration
+}
+
+// ErrorRate returns the share of the requests that failed, 0 without requests.
+func (u Usage) ErrorRate() float64 {
+	if u.Requests == 0 {
+		return 0
+	}
+
+	return float64(u.Failures) / float64(u.Requests)
+}
+
+// Tracker counts the requests and server errors of every component over the window.
+type Tracker struct {
+	mu         sync.Mutex
+	components map[api.PlatformComponent]*component
+}
+
+type component struct {
+	requests [windowBuckets]int64
+	failures [windowBuckets]int64
+	minutes  [windowBuckets]int64
+
+	// Ring of the recent request durations, only for the components with latency tracking
+	latencies []latencySample
+	next      int
+}
+
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{components: make(map[api.PlatformComponent]*component)}
+}
+
+// Middleware records the outcome of every request for the API component and for the component serving the route.
+// Responses with a server error count as failures.
+func (t *Tracker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		failed := c.Writer.Status() >= http.StatusInternalServerError
+		duration := time.Since(start)
+
+		t.Record(api.PlatformComponentAPI, failed, 0)
+		if name, ok := routeComponent(c.Request.Method, c.FullPath()); ok {
+			t.Record(name, failed, duration)
+		}
+	}
+}
+
+// routeComponent returns the component serving the route, the API component serves all of them.
+func routeComponent(method, route string) (api.PlatformComponent, bool) {
+	switch {
+	case route == "/volumes" || strings.HasPrefix(route, "/volumes/"):
+		return api.PlatformComponentVolumes, true
+	case strings.HasSuffix(route, "/logs"):
+		return api.PlatformComponentLogs, true
+	case method == http.MethodPost && (route == "/sandboxes" || route == "/sandboxes/:sandboxID/resume" || route == "/sandboxes/:sandboxID/connect"):
+		return api.PlatformComponentSandboxScheduling, true
+	}
+
+	return "", false
+}
+
+// Record counts a request of the component, the duration is only kept for sandbox scheduling.
+func (t *Tracker) Record(name api.PlatformComponent, failed bool, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	comp, ok := t.components[name]
+	if !ok {
+		comp = &component{}
+		t.components[name] = comp
+	}
+
+	now := time.Now()
+	minute := now.Unix() / 60
+	i := minute % windowBuckets
+	if comp.minutes[i] != minute {
+		comp.minutes[i] = minute
+		comp.requests[i] = 0
+		comp.failures[i] = 0
+	}
+	comp.requests[i]++
+	if failed {
+		comp.failures[i]++
+	}
+
+	if name != api.PlatformComponentSandboxScheduling || duration <= 0 {
+		return
+	}
+
+	sample := latencySample{at: now, duration: duration}
+	if len(comp.latencies) < latencySamples {
+		comp.latencies = append(comp.latencies, sample)
+
+		return
+	}
+	comp.latencies[comp.next] = sample
+	comp.next = (comp.next + 1) % latencySamples
+}
+
+// Usage returns the requests of the component in the window.
+func (t *Tracker) Usage(name api.PlatformComponent) Usage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	comp, ok := t.components[name]
+	if !ok {
+		return Usage{}
+	}
+
+	now := time.Now()
+	minute := now.Unix() / 60
+
+	var usage Usage
+	for i := range comp.requests {
+		if minute-comp.minutes[i] < windowBuckets {
+			usage.Requests += comp.requests[i]
+			usage.Failures += comp.failures[i]
+		}
+	}
+
+	durations := make([]time.Duration, 0, len(comp.latencies))
+	for _, sample := range comp.latencies {
+		if now.Sub(sample.at) < Window {
+			durations = append(durations, sample.duration)
+		}
+	}
+	if len(durations) > 0 {
+		slices.Sort(durations)
+		usage.LatencyP95 = durations[(len(durations)*95-1)/100]
+	}
+
+	return usage
+}
@@ -0,0 +1,97 @@
+package platformstatus
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/api"
+)
+
+func TestRouteComponent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		route  string
+		want   api.PlatformComponent
+		ok     bool
+	}{
+		{http.MethodGet, "/volumes", api.PlatformComponentVolumes, true},
+		{http.MethodPut, "/volumes/:volumeID/files/upload", api.PlatformComponentVolumes, true},
+		{http.MethodGet, "/sandboxes/:sandboxID/logs", api.PlatformComponentLogs, true},
+		{http.MethodGet, "/templates/:templateID/builds/:buildID/logs", api.PlatformComponentLogs, true},
+		{http.MethodPost, "/sandboxes", api.PlatformComponentSandboxScheduling, true},
+		{http.MethodPost, "/sandboxes/:sandboxID/resume", api.PlatformComponentSandboxScheduling, true},
+		{http.MethodGet, "/sandboxes", "", false},
+		{http.MethodGet, "/templates", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.route, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := routeComponent(tt.method, tt.route)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTrackerUsage(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	for i := range 20 {
+		tracker.Record(api.PlatformComponentSandboxScheduling, i < 2, time.Duration(i+1)*time.Second)
+	}
+	tracker.Record(api.PlatformComponentVolumes, false, time.Second)
+
+	usage := tracker.Usage(api.PlatformComponentSandboxScheduling)
+	assert.Equal(t, int64(20), usage.Requests)
+	assert.Equal(t, int64(2), usage.Failures)
+	assert.InDelta(t, 0.1, usage.ErrorRate(), 0.0001)
+	assert.Equal(t, 19*time.Second, usage.LatencyP95)
+
+	// Durations are only kept for sandbox scheduling
+	assert.Equal(t, Usage{Requests: 1}, tracker.Usage(api.PlatformComponentVolumes))
+	assert.Equal(t, Usage{}, tracker.Usage(api.PlatformComponentLogs))
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		component api.PlatformComponent
+		usage     Usage
+		want      api.PlatformStatusState
+	}{
+		{"no requests", api.PlatformComponentAPI, Usage{}, api.PlatformStatusOperational},
+		{"too few requests", api.PlatformComponentAPI, Usage{Requests: 4, Failures: 4}, api.PlatformStatusOperational},
+		{"few failures", api.PlatformComponentAPI, Usage{Requests: 100, Failures: 1}, api.PlatformStatusOperational},
+		{"degraded", api.PlatformComponentVolumes, Usage{Requests: 100, Failures: 10}, api.PlatformStatusDegraded},
+		{"outage", api.PlatformComponentLogs, Usage{Requests: 10, Failures: 5}, api.PlatformStatusOutage},
+		{"slow scheduling", api.PlatformComponentSandboxScheduling, Usage{Requests: 10, LatencyP95: time.Minute}, api.PlatformStatusDegraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, message := Status(tt.component, tt.usage)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want != api.PlatformStatusOperational, message != "")
+		})
+	}
+}
+
+func TestWorst(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, api.PlatformStatusDegraded, Worst(api.PlatformStatusOperational, api.PlatformStatusDegraded))
+	assert.Equal(t, api.PlatformStatusOutage, Worst(api.PlatformStatusOutage, api.PlatformStatusDegraded))
+	assert.Equal(t, api.PlatformStatusOperational, Worst(api.PlatformStatusOperational, api.PlatformStatusOperational))
+}
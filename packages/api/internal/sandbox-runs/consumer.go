@@ -27,11 +27,15 @@ const (
 // to invalidate the volume's cached JuiceFS client.
 type VolumeInvalidator func(volumeID string)
 
+// EphemeralVolumeCleaner is called when a sandbox is killed to delete the scratch volumes created for it.
+type EphemeralVolumeCleaner func(ctx context.Context, sandboxID string) error
+
 type Consumer struct {
-	redis             redis.UniversalClient
-	db                *sqlcdb.Client
-	consumerID        string
-	volumeInvalidator VolumeInvalidator
+	redis                  redis.UniversalClient
+	db                     *sqlcdb.Client
+	consumerID             string
+	volumeInvalidator      VolumeInvalidator
+	ephemeralVolumeCleaner EphemeralVolumeCleaner
 }
 
 // ConsumerOption configures the Consumer.
@@ -44,6 +48,13 @@ func WithVolumeInvalidator(invalidator VolumeInvalidator) ConsumerOption {
 	}
 }
 
+// WithEphemeralVolumeCleaner sets a callback to delete the scratch volumes of the sandboxes when they're killed.
+func WithEphemeralVolumeCleaner(cleaner EphemeralVolumeCleaner) ConsumerOption {
+	return func(c *Consumer) {
+		c.ephemeralVolumeCleaner = cleaner
+	}
+}
+
 func NewConsumer(redisClient redis.UniversalClient, db *sqlcdb.Client, opts ...ConsumerOption) *Consumer {
 	hostname, _ := os.Hostname()
 	consumerID := hostname + "-" + time.Now().Format("20060102150405")
@@ -208,6 +219,13 @@ func (c *Consumer) handleKilled(ctx context.Context, event events.SandboxEvent)
 		}
 	}
 
+	// The cleanup is retried with the event when it fails
+	if c.ephemeralVolumeCleaner != nil {
+		if err := c.ephemeralVolumeCleaner(ctx, event.SandboxID); err != nil {
+			return err
+		}
+	}
+
 	endReason := "killed"
 	if reason, ok := event.EventData["end_reason"].(string); ok && reason != "" {
 		endReason = reason
@@ -27,6 +27,7 @@ type PaginationConfig struct {
 	DefaultLimit int32
 	MaxLimit     int32
 	DefaultID    string // Default cursor ID when no token is provided (e.g., max UUID or max sandbox ID)
+	Ascending    bool   // Pages go from the oldest item, the default cursor is the zero time instead of now
 }
 
 // Cursor represents a parsed pagination cursor
@@ -60,7 +61,7 @@ func NewPagination[T any](params PaginationParams, config PaginationConfig) (*Pa
 
 	// Parse cursor token
 	var err error
-	p.cursor, err = parseCursorToken(params.NextToken, config.DefaultID)
+	p.cursor, err = parseCursorToken(params.NextToken, config.DefaultID, config.Ascending)
 	if err != nil {
 		return nil, fmt.Errorf("invalid next token: %w", err)
 	}
@@ -132,7 +133,7 @@ func (p *Pagination[T]) setHeader(c *gin.Context) {
 }
 
 // parseCursorToken parses a cursor token, returning default values if token is nil/empty
-func parseCursorToken(token *string, defaultID string) (Cursor, error) {
+func parseCursorToken(token *string, defaultID string, ascending bool) (Cursor, error) {
 	if token != nil && *token != "" {
 		cursorTime, cursorID, err := ParseCursor(*token)
 		if err != nil {
@@ -142,6 +143,10 @@ func parseCursorToken(token *string, defaultID string) (Cursor, error) {
 		return Cursor{Time: cursorTime, ID: cursorID}, nil
 	}
 
-	// Default to current time and provided default ID to get the first page
+	// Default to current time, or the zero time in ascending order, and provided default ID to get the first page
+	if ascending {
+		return Cursor{Time: time.Time{}, ID: defaultID}, nil
+	}
+
 	return Cursor{Time: time.Now(), ID: defaultID}, nil
 }
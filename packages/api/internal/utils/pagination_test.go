@@ -110,6 +110,16 @@ func TestNewPagination(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, config.DefaultID, p.CursorID())
 	})
+
+	t.Run("ascending without next token", func(t *testing.T) {
+		ascending := config
+		ascending.Ascending = true
+
+		p, err := NewPagination[testItem](PaginationParams{}, ascending)
+		require.NoError(t, err)
+		assert.True(t, p.CursorTime().IsZero())
+		assert.Equal(t, config.DefaultID, p.CursorID())
+	})
 }
 
 func TestPagination_Limit(t *testing.T) {
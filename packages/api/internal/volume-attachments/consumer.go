@@ -0,0 +1,233 @@
+package volumeattachments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	groupName = "api-volume-attachments"
+	batchSize = 100
+	blockTime = 5 * time.Second
+	claimTime = 5 * time.Minute
+
+	// foreignKeyViolation is the PostgreSQL error code of an attachment or a mount of a volume that no longer exists.
+	foreignKeyViolation = "23503"
+	// uniqueViolation is the PostgreSQL error code of a second read-write attachment of the volume.
+	uniqueViolation = "23505"
+)
+
+// Consumer keeps the volume attachments in PostgreSQL up to date and records the history of the mounts
+// in volume_runs from the volume.attached and volume.detached events of the orchestrators. The creation and
+// the deletion of the volumes are recorded by the audit log, the consumer skips them.
+type Consumer struct {
+	redis      redis.UniversalClient
+	db         *sqlcdb.Client
+	consumerID string
+}
+
+func NewConsumer(redisClient redis.UniversalClient, db *sqlcdb.Client) *Consumer {
+	hostname, _ := os.Hostname()
+	consumerID := hostname + "-" + time.Now().Format("20060102150405")
+
+	return &Consumer{
+		redis:      redisClient,
+		db:         db,
+		consumerID: consumerID,
+	}
+}
+
+func (c *Consumer) Run(ctx context.Context) {
+	logger.L().Info(ctx, "Starting volume attachments consumer",
+		zap.String("consumerID", c.consumerID),
+		zap.String("group", groupName))
+
+	// Create consumer group (idempotent)
+	err := c.redis.XGroupCreateMkStream(ctx, events.VolumeEventsStreamName, groupName, "0").Err()
+	if err != nil && err.Error() != "BUSYGROUP Consumer Group name already exists" {
+		logger.L().Error(ctx, "Failed to create consumer group", zap.Error(err))
+
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.L().Info(ctx, "Volume attachments consumer stopping")
+
+			return
+		default:
+			c.processBatch(ctx)
+		}
+	}
+}
+
+func (c *Consumer) processBatch(ctx context.Context) {
+	streams, err := c.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    groupName,
+		Consumer: c.consumerID,
+		Streams:  []string{events.VolumeEventsStreamName, ">"},
+		Count:    batchSize,
+		Block:    blockTime,
+	}).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.L().Error(ctx, "Failed to read from stream", zap.Error(err))
+		}
+
+		return
+	}
+
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			if err := c.processMessage(ctx, msg); err != nil {
+				logger.L().Error(ctx, "Failed to process message",
+					zap.String("messageID", msg.ID),
+					zap.Error(err))
+
+				continue // Don't ACK, will be redelivered
+			}
+
+			c.redis.XAck(ctx, events.VolumeEventsStreamName, groupName, msg.ID)
+		}
+	}
+
+	// Claim old pending messages from crashed consumers
+	c.claimPendingMessages(ctx)
+}
+
+func (c *Consumer) processMessage(ctx context.Context, msg redis.XMessage) error {
+	payload, ok := msg.Values["payload"].(string)
+	if !ok {
+		return nil // Skip malformed messages
+	}
+
+	var event events.VolumeEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil //nolint:nilerr // Skip unparseable messages intentionally
+	}
+
+	return c.handleEvent(ctx, event)
+}
+
+func (c *Consumer) handleEvent(ctx context.Context, event events.VolumeEvent) error {
+	// Attachments are only tracked for events carrying the sandbox
+	if event.SandboxID == "" {
+		return nil
+	}
+
+	switch event.Type {
+	case events.VolumeAttachedEvent:
+		return c.handleAttached(ctx, event)
+	case events.VolumeDetachedEvent:
+		return c.handleDetached(ctx, event)
+	}
+
+	return nil
+}
+
+func (c *Consumer) handleAttached(ctx context.Context, event events.VolumeEvent) error {
+	logger.L().Debug(ctx, "Processing volume attached event",
+		logger.WithSandboxID(event.SandboxID),
+		zap.String("volume_id", event.VolumeID))
+
+	readOnly, _ := event.EventData["read_only"].(bool)
+	subpath, _ := event.EventData["subpath"].(string)
+
+	// The mount is recorded even when its attachment is rejected below, it happened in the sandbox
+	err := c.db.CreateVolumeRun(ctx, queries.CreateVolumeRunParams{
+		VolumeID:  event.VolumeID,
+		SandboxID: event.SandboxID,
+		TeamID:    event.SandboxTeamID,
+		MountPath: event.MountPath,
+		ReadOnly:  readOnly,
+		Subpath:   subpath,
+		MountedAt: event.Timestamp,
+	})
+	if err == nil {
+		err = c.db.UpsertVolumeAttachment(ctx, queries.UpsertVolumeAttachmentParams{
+			VolumeID:  event.VolumeID,
+			SandboxID: event.SandboxID,
+			TeamID:    event.SandboxTeamID,
+			MountPath: event.MountPath,
+			ReadOnly:  readOnly,
+			Subpath:   subpath,
+			MountedAt: event.Timestamp,
+		})
+	}
+	if isPgError(err, foreignKeyViolation) {
+		// The volume was deleted before the event was processed
+		logger.L().Debug(ctx, "Volume of the attachment no longer exists, skipping",
+			logger.WithSandboxID(event.SandboxID),
+			zap.String("volume_id", event.VolumeID))
+
+		return nil
+	}
+	if isPgError(err, uniqueViolation) {
+		// The API rejects a second read-write attachment, the mount happened before the lock was recorded
+		logger.L().Warn(ctx, "Volume is already attached read-write to another sandbox, skipping",
+			logger.WithSandboxID(event.SandboxID),
+			zap.String("volume_id", event.VolumeID))
+
+		return nil
+	}
+
+	return err
+}
+
+func (c *Consumer) handleDetached(ctx context.Context, event events.VolumeEvent) error {
+	logger.L().Debug(ctx, "Processing volume detached event",
+		logger.WithSandboxID(event.SandboxID),
+		zap.String("volume_id", event.VolumeID))
+
+	err := c.db.EndVolumeRun(ctx, queries.EndVolumeRunParams{
+		DetachedAt: event.Timestamp,
+		VolumeID:   event.VolumeID,
+		SandboxID:  event.SandboxID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.db.DeleteVolumeAttachment(ctx, queries.DeleteVolumeAttachmentParams{
+		VolumeID:   event.VolumeID,
+		SandboxID:  event.SandboxID,
+		DetachedAt: event.Timestamp,
+	})
+}
+
+func (c *Consumer) claimPendingMessages(ctx context.Context) {
+	// Claim messages pending > 5 minutes (from crashed consumers)
+	messages, _, _ := c.redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   events.VolumeEventsStreamName,
+		Group:    groupName,
+		Consumer: c.consumerID,
+		MinIdle:  claimTime,
+		Start:    "0",
+		Count:    10,
+	}).Result()
+
+	for _, msg := range messages {
+		if err := c.processMessage(ctx, msg); err == nil {
+			c.redis.XAck(ctx, events.VolumeEventsStreamName, groupName, msg.ID)
+		}
+	}
+}
+
+func isPgError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
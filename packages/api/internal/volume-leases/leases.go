@@ -0,0 +1,263 @@
+package volumeleases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	leaseKeyPrefix      = "volume:write-lease:"
+	generationKeyPrefix = "volume:generation:"
+
+	// leaseTTL bounds how long the lease outlives an API instance that died while writing.
+	leaseTTL = 30 * time.Second
+	// leaseRefreshInterval keeps the lease of long writes, e.g. large uploads.
+	leaseRefreshInterval = leaseTTL / 3
+
+	// Short writes of other API instances are waited for, up to about 5 seconds
+	acquireRetryInterval = 100 * time.Millisecond
+	acquireRetries       = 50
+)
+
+var (
+	// ErrLeaseHeld is returned when another API instance is writing to the volume.
+	ErrLeaseHeld = errors.New("volume is being modified through another API instance")
+
+	// ErrLeaseLost is returned by the writes whose lease expired before it could be refreshed,
+	// another API instance may be writing to the volume since.
+	ErrLeaseLost = errors.New("volume write lease lost")
+)
+
+// lock is the Redis lock backing a write lease.
+type lock interface {
+	Refresh(ctx context.Context, ttl time.Duration, opt *redislock.Options) error
+	Release(ctx context.Context) error
+}
+
+// Leases coordinate the API writes to the volume metadata between the API instances. Each instance
+// works on its own copy of the metadata and uploads it back, so only one instance can write at a time.
+// The requests of the instance holding the lease share it. The generation of the volume metadata is
+// bumped after every write, so the other instances drop the metadata they loaded earlier.
+type Leases struct {
+	redis redis.UniversalClient
+
+	// obtain takes the Redis lock of the lease, newTicker paces its refreshes.
+	obtain    func(ctx context.Context, key string, ttl time.Duration) (lock, error)
+	newTicker func() (<-chan time.Time, func())
+
+	mu     sync.Mutex
+	leases map[string]*heldLease
+}
+
+type heldLease struct {
+	lock   lock
+	refs   int
+	cancel context.CancelFunc
+	done   chan struct{}
+	// lost is closed when the lease couldn't be refreshed.
+	lost chan struct{}
+}
+
+func New(redisClient redis.UniversalClient) *Leases {
+	locks := redislock.New(redisClient)
+
+	return &Leases{
+		redis: redisClient,
+		obtain: func(ctx context.Context, key string, ttl time.Duration) (lock, error) {
+			return locks.Obtain(ctx, key, ttl, nil)
+		},
+		newTicker: func() (<-chan time.Time, func()) {
+			ticker := time.NewTicker(leaseRefreshInterval)
+
+			return ticker.C, ticker.Stop
+		},
+		leases: make(map[string]*heldLease),
+	}
+}
+
+// Lease is the write lease of a volume held by a request.
+type Lease struct {
+	leases   *Leases
+	volumeID string
+	held     *heldLease
+	once     sync.Once
+}
+
+// Acquire obtains the write lease of the volume, waiting briefly for the writes of another API instance.
+func (l *Leases) Acquire(ctx context.Context, volumeID string) (*Lease, error) {
+	for attempt := 0; ; attempt++ {
+		held, err := l.tryAcquire(ctx, volumeID)
+		if err != nil {
+			return nil, err
+		}
+		if held != nil {
+			return &Lease{leases: l, volumeID: volumeID, held: held}, nil
+		}
+
+		if attempt >= acquireRetries {
+			return nil, ErrLeaseHeld
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(acquireRetryInterval):
+		}
+	}
+}
+
+// tryAcquire shares the lease held by the instance or obtains it, nil is returned when it's held elsewhere.
+func (l *Leases) tryAcquire(ctx context.Context, volumeID string) (*heldLease, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if held, ok := l.leases[volumeID]; ok {
+		// A lost lease may be held by another instance now, it's obtained again once its writes fail
+		if held.isLost() {
+			return nil, nil
+		}
+
+		held.refs++
+
+		return held, nil
+	}
+
+	lock, err := l.obtain(ctx, leaseKeyPrefix+volumeID, leaseTTL)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain the write lease: %w", err)
+	}
+
+	refreshCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	held := &heldLease{
+		lock:   lock,
+		refs:   1,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		lost:   make(chan struct{}),
+	}
+	l.leases[volumeID] = held
+
+	ticks, stop := l.newTicker()
+	go held.keepAlive(refreshCtx, volumeID, ticks, stop)
+
+	return held, nil
+}
+
+// keepAlive refreshes the lease until it's released. When a refresh fails, the lease is lost and the
+// writes of its holders fail instead of overwriting the writes of another instance.
+func (h *heldLease) keepAlive(ctx context.Context, volumeID string, ticks <-chan time.Time, stop func()) {
+	defer close(h.done)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticks:
+			if err := h.lock.Refresh(ctx, leaseTTL, nil); err != nil {
+				logger.L().Warn(ctx, "Failed to refresh the volume write lease, the writes holding it fail",
+					zap.String("volume_id", volumeID),
+					zap.Error(err))
+				close(h.lost)
+
+				return
+			}
+		}
+	}
+}
+
+func (h *heldLease) isLost() bool {
+	select {
+	case <-h.lost:
+		return true
+	default:
+		return false
+	}
+}
+
+// Err returns ErrLeaseLost once the lease is lost, the write must not be uploaded anymore.
+func (l *Lease) Err() error {
+	if l.held.isLost() {
+		return ErrLeaseLost
+	}
+
+	return nil
+}
+
+// Release gives up the write lease of the request, the lease is released once no request of the instance holds it.
+func (l *Lease) Release(ctx context.Context) {
+	l.once.Do(func() {
+		l.leases.release(ctx, l.volumeID, l.held)
+	})
+}
+
+func (l *Leases) release(ctx context.Context, volumeID string, held *heldLease) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.leases[volumeID] != held {
+		return
+	}
+
+	held.refs--
+	if held.refs > 0 {
+		return
+	}
+
+	delete(l.leases, volumeID)
+
+	held.cancel()
+	<-held.done
+
+	if err := held.lock.Release(ctx); err != nil && !errors.Is(err, redislock.ErrLockNotHeld) {
+		logger.L().Warn(ctx, "Failed to release the volume write lease",
+			zap.String("volume_id", volumeID),
+			zap.Error(err))
+	}
+}
+
+// IsHeld reports whether an API instance is writing to the volume.
+func (l *Leases) IsHeld(ctx context.Context, volumeID string) (bool, error) {
+	count, err := l.redis.Exists(ctx, leaseKeyPrefix+volumeID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check the write lease: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Generation returns the generation of the volume metadata, zero for volumes never written through the API.
+func (l *Leases) Generation(ctx context.Context, volumeID string) (int64, error) {
+	value, err := l.redis.Get(ctx, generationKeyPrefix+volumeID).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the metadata generation: %w", err)
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// Bump starts a new generation of the volume metadata and returns it.
+func (l *Leases) Bump(ctx context.Context, volumeID string) (int64, error) {
+	generation, err := l.redis.Incr(ctx, generationKeyPrefix+volumeID).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to bump the metadata generation: %w", err)
+	}
+
+	return generation, nil
+}
@@ -0,0 +1,222 @@
+package volumeleases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testVolumeID = "vol-1"
+
+// fakeLocks are the Redis locks of the leases, without Redis.
+type fakeLocks struct {
+	mu       sync.Mutex
+	held     map[string]*fakeLock
+	obtained int
+}
+
+type fakeLock struct {
+	locks *fakeLocks
+	key   string
+
+	mu         sync.Mutex
+	refreshErr error
+	refreshes  int
+}
+
+func (f *fakeLocks) obtain(_ context.Context, key string, _ time.Duration) (lock, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.held[key]; ok {
+		return nil, redislock.ErrNotObtained
+	}
+
+	l := &fakeLock{locks: f, key: key}
+	f.held[key] = l
+	f.obtained++
+
+	return l, nil
+}
+
+func (f *fakeLocks) holder(key string) *fakeLock {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.held[key]
+}
+
+func (l *fakeLock) Refresh(_ context.Context, _ time.Duration, _ *redislock.Options) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refreshes++
+
+	return l.refreshErr
+}
+
+func (l *fakeLock) Release(_ context.Context) error {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	if l.locks.held[l.key] != l {
+		return redislock.ErrLockNotHeld
+	}
+	delete(l.locks.held, l.key)
+
+	return nil
+}
+
+func (l *fakeLock) fail(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refreshErr = err
+}
+
+func (l *fakeLock) refreshCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.refreshes
+}
+
+// newTestLeases returns leases refreshed on the ticks sent to the returned channel.
+func newTestLeases() (*Leases, *fakeLocks, chan time.Time) {
+	locks := &fakeLocks{held: make(map[string]*fakeLock)}
+	ticks := make(chan time.Time)
+
+	leases := &Leases{
+		obtain: locks.obtain,
+		newTicker: func() (<-chan time.Time, func()) {
+			return ticks, func() {}
+		},
+		leases: make(map[string]*heldLease),
+	}
+
+	return leases, locks, ticks
+}
+
+func TestAcquireAndRelease(t *testing.T) {
+	t.Parallel()
+
+	leases, locks, _ := newTestLeases()
+
+	lease, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	require.NoError(t, lease.Err())
+	assert.NotNil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+
+	lease.Release(t.Context())
+	assert.Nil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+
+	// Releasing twice doesn't release the lease of a later request
+	again, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	lease.Release(t.Context())
+	assert.NotNil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+
+	again.Release(t.Context())
+	assert.Nil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+}
+
+func TestAcquireShared(t *testing.T) {
+	t.Parallel()
+
+	leases, locks, _ := newTestLeases()
+
+	first, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	second, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+
+	// The requests of the instance share the lease
+	assert.Equal(t, 1, locks.obtained)
+
+	first.Release(t.Context())
+	assert.NotNil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+
+	second.Release(t.Context())
+	assert.Nil(t, locks.holder(leaseKeyPrefix+testVolumeID))
+}
+
+func TestAcquireHeldElsewhere(t *testing.T) {
+	t.Parallel()
+
+	leases, locks, _ := newTestLeases()
+
+	// Another instance holds the lease
+	_, err := locks.obtain(t.Context(), leaseKeyPrefix+testVolumeID, leaseTTL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), acquireRetryInterval/10)
+	defer cancel()
+
+	_, err = leases.Acquire(ctx, testVolumeID)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLeaseRefreshed(t *testing.T) {
+	t.Parallel()
+
+	leases, locks, ticks := newTestLeases()
+
+	lease, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	defer lease.Release(t.Context())
+
+	// The second tick is received once the first refresh is done
+	ticks <- time.Now()
+	ticks <- time.Now()
+
+	assert.GreaterOrEqual(t, locks.holder(leaseKeyPrefix+testVolumeID).refreshCount(), 1)
+	require.NoError(t, lease.Err())
+}
+
+func TestLeaseLost(t *testing.T) {
+	t.Parallel()
+
+	leases, locks, ticks := newTestLeases()
+
+	first, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	second, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+
+	locks.holder(leaseKeyPrefix + testVolumeID).fail(errors.New("connection refused"))
+	ticks <- time.Now()
+
+	select {
+	case <-first.held.lost:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lease wasn't lost")
+	}
+
+	// Every holder fails its write
+	require.ErrorIs(t, first.Err(), ErrLeaseLost)
+	require.ErrorIs(t, second.Err(), ErrLeaseLost)
+
+	// A lost lease isn't shared with the new requests
+	ctx, cancel := context.WithTimeout(t.Context(), acquireRetryInterval/10)
+	defer cancel()
+
+	_, err = leases.Acquire(ctx, testVolumeID)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Once its holders are done, the lease is obtained again
+	first.Release(t.Context())
+	second.Release(t.Context())
+
+	lease, err := leases.Acquire(t.Context(), testVolumeID)
+	require.NoError(t, err)
+	defer lease.Release(t.Context())
+
+	require.NoError(t, lease.Err())
+	assert.Equal(t, 2, locks.obtained)
+}
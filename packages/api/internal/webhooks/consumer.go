@@ -0,0 +1,254 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/crypto"
+	sqlcdb "github.com/moru-ai/sandbox-infra/packages/db/client"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	groupName = "api-webhooks"
+	batchSize = 100
+	blockTime = 5 * time.Second
+	claimTime = 5 * time.Minute
+
+	// deliveryTimeout bounds a single attempt, slow receivers should queue the event and respond.
+	deliveryTimeout = 10 * time.Second
+	// deliveryAttempts bounds the attempts of a delivery, the event is dropped for the webhook afterwards.
+	deliveryAttempts = 3
+	// retryDelay is the delay before the second attempt, it doubles for each further attempt.
+	retryDelay = 2 * time.Second
+)
+
+// Consumer posts the volume events of the stream to the webhooks of the team of the volume.
+type Consumer struct {
+	redis      redis.UniversalClient
+	db         *sqlcdb.Client
+	encryptor  *crypto.Encryptor
+	httpClient *http.Client
+	consumerID string
+}
+
+func NewConsumer(redisClient redis.UniversalClient, db *sqlcdb.Client, encryptor *crypto.Encryptor) *Consumer {
+	hostname, _ := os.Hostname()
+	consumerID := hostname + "-" + time.Now().Format("20060102150405")
+
+	return &Consumer{
+		redis:     redisClient,
+		db:        db,
+		encryptor: encryptor,
+		httpClient: &http.Client{
+			Timeout: deliveryTimeout,
+			// Redirects aren't followed, a webhook must point at its final URL
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		consumerID: consumerID,
+	}
+}
+
+func (c *Consumer) Run(ctx context.Context) {
+	logger.L().Info(ctx, "Starting webhooks consumer",
+		zap.String("consumerID", c.consumerID),
+		zap.String("group", groupName))
+
+	// Create consumer group (idempotent), starting with the new events
+	err := c.redis.XGroupCreateMkStream(ctx, events.VolumeEventsStreamName, groupName, "$").Err()
+	if err != nil && err.Error() != "BUSYGROUP Consumer Group name already exists" {
+		logger.L().Error(ctx, "Failed to create consumer group", zap.Error(err))
+
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.L().Info(ctx, "Webhooks consumer stopping")
+
+			return
+		default:
+			c.processBatch(ctx)
+		}
+	}
+}
+
+func (c *Consumer) processBatch(ctx context.Context) {
+	streams, err := c.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    groupName,
+		Consumer: c.consumerID,
+		Streams:  []string{events.VolumeEventsStreamName, ">"},
+		Count:    batchSize,
+		Block:    blockTime,
+	}).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.L().Error(ctx, "Failed to read from stream", zap.Error(err))
+		}
+
+		return
+	}
+
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			if err := c.processMessage(ctx, msg); err != nil {
+				logger.L().Error(ctx, "Failed to process message",
+					zap.String("messageID", msg.ID),
+					zap.Error(err))
+
+				continue // Don't ACK, will be redelivered
+			}
+
+			c.redis.XAck(ctx, events.VolumeEventsStreamName, groupName, msg.ID)
+		}
+	}
+
+	// Claim old pending messages from crashed consumers
+	c.claimPendingMessages(ctx)
+}
+
+func (c *Consumer) processMessage(ctx context.Context, msg redis.XMessage) error {
+	payload, ok := msg.Values["payload"].(string)
+	if !ok {
+		return nil // Skip malformed messages
+	}
+
+	var event events.VolumeEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil //nolint:nilerr // Skip unparseable messages intentionally
+	}
+
+	// Only the events emitted by the API carry the team of the volume
+	if !slices.Contains(EventTypes, event.Type) || event.SandboxTeamID == uuid.Nil {
+		return nil
+	}
+
+	hooks, err := c.db.ListTeamWebhooks(ctx, event.SandboxTeamID)
+	if err != nil {
+		return fmt.Errorf("list team webhooks: %w", err)
+	}
+
+	// Failed deliveries are retried by deliver, the message is acknowledged once every webhook got a chance
+	var wg sync.WaitGroup
+	for _, hook := range hooks {
+		if !slices.Contains(hook.EventTypes, event.Type) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			c.deliver(ctx, hook, event, []byte(payload))
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// deliver posts the event to the webhook, retrying failed attempts with a growing delay.
+func (c *Consumer) deliver(ctx context.Context, hook queries.TeamWebhook, event events.VolumeEvent, body []byte) {
+	secret, err := c.encryptor.Decrypt(hook.SecretEncrypted)
+	if err != nil {
+		logger.L().Error(ctx, "Failed to decrypt webhook secret",
+			zap.Error(err),
+			zap.String("webhook_id", hook.ID.String()),
+			logger.WithTeamID(hook.TeamID.String()))
+
+		return
+	}
+
+	delay := retryDelay
+	for attempt := 1; ; attempt++ {
+		err = c.post(ctx, hook.Url, string(secret), event, body)
+		if err == nil {
+			return
+		}
+
+		if attempt == deliveryAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	logger.L().Warn(ctx, "Failed to deliver event to webhook",
+		zap.Error(err),
+		zap.String("webhook_id", hook.ID.String()),
+		zap.String("event_id", event.ID.String()),
+		zap.String("event_type", event.Type),
+		logger.WithTeamID(hook.TeamID.String()))
+}
+
+// post makes a single delivery attempt, any status but 2xx fails it.
+func (c *Consumer) post(ctx context.Context, url, secret string, event events.VolumeEvent, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Type)
+	req.Header.Set(DeliveryHeader, event.ID.String())
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Consumer) claimPendingMessages(ctx context.Context) {
+	// Claim messages pending > 5 minutes (from crashed consumers)
+	messages, _, _ := c.redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   events.VolumeEventsStreamName,
+		Group:    groupName,
+		Consumer: c.consumerID,
+		MinIdle:  claimTime,
+		Start:    "0",
+		Count:    10,
+	}).Result()
+
+	for _, msg := range messages {
+		if err := c.processMessage(ctx, msg); err == nil {
+			c.redis.XAck(ctx, events.VolumeEventsStreamName, groupName, msg.ID)
+		}
+	}
+}
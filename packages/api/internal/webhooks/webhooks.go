@@ -0,0 +1,52 @@
+// Package webhooks delivers the volume events of a team to the webhooks it configured.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+)
+
+// Headers of a delivery. The signature is the hex encoded HMAC-SHA256 of the timestamp, a dot and the body,
+// so receivers can reject replayed deliveries.
+const (
+	SignatureHeader = "X-Moru-Signature"
+	TimestampHeader = "X-Moru-Timestamp"
+	EventHeader     = "X-Moru-Event"
+	DeliveryHeader  = "X-Moru-Delivery"
+)
+
+// secretPrefix tells the webhook secrets apart from the other credentials of the team.
+const secretPrefix = "whsec_"
+
+// EventTypes lists the volume events delivered to webhooks, the API emits them with the team of the volume.
+var EventTypes = []string{
+	events.VolumeCreatedEvent,
+	events.VolumeDeletedEvent,
+	events.VolumeFileUploadedEvent,
+	events.VolumeFileDeletedEvent,
+}
+
+// NewSecret generates the secret signing the deliveries of a webhook.
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	return secretPrefix + hex.EncodeToString(secret), nil
+}
+
+// Sign returns the signature of a delivery body sent at timestamp.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
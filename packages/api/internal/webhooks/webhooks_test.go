@@ -0,0 +1,99 @@
+package webhooks
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/api/internal/crypto"
+	"github.com/moru-ai/sandbox-infra/packages/db/queries"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+)
+
+func TestNewSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, secretPrefix))
+	assert.Len(t, secret, len(secretPrefix)+64)
+
+	other, err := NewSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	signature := Sign("secret", "1700000000", []byte(`{"type":"volume.file.uploaded"}`))
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, Sign("secret", "1700000000", []byte(`{"type":"volume.file.uploaded"}`)))
+	assert.NotEqual(t, signature, Sign("other", "1700000000", []byte(`{"type":"volume.file.uploaded"}`)))
+	assert.NotEqual(t, signature, Sign("secret", "1700000001", []byte(`{"type":"volume.file.uploaded"}`)))
+}
+
+func TestDeliver(t *testing.T) {
+	t.Parallel()
+
+	encryptor, err := crypto.NewEncryptor(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+
+	secretEncrypted, err := encryptor.Encrypt([]byte("whsec_test"))
+	require.NoError(t, err)
+
+	event := events.NewVolumeEvent(events.VolumeFileUploadedEvent, "vol_test").WithFilePath("/out/report.csv")
+	body := []byte(`{"type":"volume.file.uploaded"}`)
+
+	t.Run("signed delivery", func(t *testing.T) {
+		t.Parallel()
+
+		var received atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received.Add(1)
+
+			got, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, body, got)
+			assert.Equal(t, events.VolumeFileUploadedEvent, r.Header.Get(EventHeader))
+			assert.Equal(t, event.ID.String(), r.Header.Get(DeliveryHeader))
+			assert.Equal(t, Sign("whsec_test", r.Header.Get(TimestampHeader), got), r.Header.Get(SignatureHeader))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		c := &Consumer{encryptor: encryptor, httpClient: server.Client()}
+		c.deliver(t.Context(), queries.TeamWebhook{ID: uuid.New(), Url: server.URL, SecretEncrypted: secretEncrypted}, event, body)
+
+		assert.Equal(t, int32(1), received.Load())
+	})
+
+	t.Run("failed attempt is retried", func(t *testing.T) {
+		t.Parallel()
+
+		var received atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if received.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		c := &Consumer{encryptor: encryptor, httpClient: server.Client()}
+		c.deliver(t.Context(), queries.TeamWebhook{ID: uuid.New(), Url: server.URL, SecretEncrypted: secretEncrypted}, event, body)
+
+		assert.Equal(t, int32(2), received.Load())
+	})
+}
@@ -55,6 +55,11 @@ const (
 	idleTimeout = 620 * time.Second
 
 	defaultPort = 80
+
+	// webdavRoute serves the paths of a volume over WebDAV
+	webdavRoute = "/volumes/:volumeID/webdav/*path"
+	// s3Route serves the volumes of the team over the S3 protocol
+	s3Route = "/s3/*path"
 )
 
 var (
@@ -115,6 +120,13 @@ func NewGinServer(ctx context.Context, config cfg.Config, tel *telemetry.Client,
 		"release",
 		"sdk_runtime",
 		"system",
+		// WebDAV headers
+		"Depth",
+		"Destination",
+		"If",
+		"Lock-Token",
+		"Overwrite",
+		"Timeout",
 	}
 	r.Use(cors.New(corsConfig))
 
@@ -125,22 +137,45 @@ func NewGinServer(ctx context.Context, config cfg.Config, tel *telemetry.Client,
 		apiStore.GetUserFromAccessToken,
 		apiStore.GetUserIDFromSupabaseToken,
 		apiStore.GetTeamFromSupabaseToken,
+		apiStore.GetS3CredentialsFromAccessKey,
 	)
+	apiStore.SetAuthenticationFunc(AuthenticationFunc)
+
+	// Archives uploaded for extraction are validated as opaque binary content,
+	// the default zip decoder would unpack the archive in memory
+	for _, contentType := range []string{"application/x-tar", "application/gzip", "application/zip"} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+	}
 
 	// Use our validation middleware to check all requests against the
 	// OpenAPI schema.
 	r.Use(
-		limits.RequestSizeLimiter(maxUploadLimit),
-		middleware.OapiRequestValidatorWithOptions(swagger,
-			&middleware.Options{
-				ErrorHandler:      utils.ErrorHandler,
-				MultiErrorHandler: utils.MultiErrorHandler,
-				Options: openapi3filter.Options{
-					AuthenticationFunc: AuthenticationFunc,
-					// Handle multiple errors as MultiError type
-					MultiError: true,
-				},
-			}),
+		// Uploads to volumes can be configured to be larger than the other requests
+		limits.RequestSizeLimiter(max(maxUploadLimit, config.VolumesMaxUploadBytes)),
+		// WebDAV and S3 aren't described by the OpenAPI schema, WebDAV clients send the API key or the S3 credentials
+		// of the team as Basic credentials and S3 clients sign the requests with the S3 credentials
+		customMiddleware.ExcludeRoutes(
+			middleware.OapiRequestValidatorWithOptions(swagger,
+				&middleware.Options{
+					ErrorHandler:      utils.ErrorHandler,
+					MultiErrorHandler: utils.MultiErrorHandler,
+					Options: openapi3filter.Options{
+						AuthenticationFunc: AuthenticationFunc,
+						// Handle multiple errors as MultiError type
+						MultiError: true,
+					},
+				}),
+			webdavRoute,
+			s3Route,
+		),
+		customMiddleware.IncludeRoutes(
+			auth.BasicAuthMiddleware(handlers.WebDAVRealm, apiStore.GetTeamFromAPIKey, apiStore.GetS3CredentialsFromAccessKey),
+			webdavRoute,
+		),
+		customMiddleware.IncludeRoutes(
+			auth.S3AuthMiddleware(apiStore.GetS3CredentialsFromAccessKey),
+			s3Route,
+		),
 	)
 
 	r.Use(customMiddleware.InitLaunchDarklyContext)
@@ -176,28 +211,88 @@ func NewGinServer(ctx context.Context, config cfg.Config, tel *telemetry.Client,
 		),
 	)
 
-	// Rate limiting for file API endpoints
+	// Server errors and durations of the requests, the platform status is served by GET /status
+	r.Use(
+		customMiddleware.ExcludeRoutes(
+			apiStore.PlatformStatus().Middleware(),
+			"/health",
+			"/status",
+		),
+	)
+
+	// Rate limiting for file API endpoints, the consumption of the teams is served by GET /limits
+	rateLimits := apiStore.RateLimits()
 	r.Use(
 		// List files (GET /volumes/:volumeID/files): 100 requests/min
 		customMiddleware.IncludeRoutes(
-			customMiddleware.RateLimitForMethod(customMiddleware.FileAPIRateLimits.List, customMiddleware.ByTeamID, http.MethodGet),
+			rateLimits.ForMethod(customMiddleware.FileAPIRateLimits.List, customMiddleware.ByTeamID, http.MethodGet),
 			"/volumes/:volumeID/files",
 		),
 		// Delete files (DELETE /volumes/:volumeID/files): 30 requests/min
 		customMiddleware.IncludeRoutes(
-			customMiddleware.RateLimitForMethod(customMiddleware.FileAPIRateLimits.Delete, customMiddleware.ByTeamID, http.MethodDelete),
+			rateLimits.ForMethod(customMiddleware.FileAPIRateLimits.Delete, customMiddleware.ByTeamID, http.MethodDelete),
 			"/volumes/:volumeID/files",
 		),
-		// Download files (GET /volumes/:volumeID/files/download): 60 requests/min
+		// File metadata (GET /volumes/:volumeID/files/stat): 100 requests/min
 		customMiddleware.IncludeRoutes(
-			customMiddleware.RateLimitMiddleware(customMiddleware.FileAPIRateLimits.Download, customMiddleware.ByTeamID),
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Stat, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/stat",
+		),
+		// Download files (GET and HEAD /volumes/:volumeID/files/download): 60 requests/min
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Download, customMiddleware.ByTeamID),
 			"/volumes/:volumeID/files/download",
 		),
+		// Download archives (GET /volumes/:volumeID/files/archive): 10 requests/min
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Archive, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/archive",
+		),
 		// Upload files (PUT /volumes/:volumeID/files/upload): 60 requests/min
 		customMiddleware.IncludeRoutes(
-			customMiddleware.RateLimitMiddleware(customMiddleware.FileAPIRateLimits.Upload, customMiddleware.ByTeamID),
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Upload, customMiddleware.ByTeamID),
 			"/volumes/:volumeID/files/upload",
 		),
+		// Create directories (POST /volumes/:volumeID/files/mkdir): 60 requests/min, like uploads
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Mkdir, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/mkdir",
+		),
+		// Set file attributes (PATCH /volumes/:volumeID/files/attributes): 30 requests/min
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Attributes, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/attributes",
+		),
+		// Create symlinks (POST /volumes/:volumeID/files/symlink): 60 requests/min, like directories
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Symlink, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/symlink",
+		),
+		// Search files (GET /volumes/:volumeID/files/search): 10 requests/min, like archives
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Search, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/search",
+		),
+		// Search file contents (GET /volumes/:volumeID/files/grep): 10 requests/min, like archives
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Grep, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/grep",
+		),
+		// Compare directories (POST /volumes/:volumeID/files/sync): 10 requests/min, like archives
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.Sync, customMiddleware.ByTeamID),
+			"/volumes/:volumeID/files/sync",
+		),
+		// WebDAV (/volumes/:volumeID/webdav): 300 requests/min
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.WebDAV, customMiddleware.ByTeamID),
+			webdavRoute,
+		),
+		// S3 (/s3): 300 requests/min
+		customMiddleware.IncludeRoutes(
+			rateLimits.Middleware(customMiddleware.FileAPIRateLimits.S3, customMiddleware.ByTeamID),
+			s3Route,
+		),
 	)
 
 	// We now register our store above as the handler for the interface
@@ -207,6 +302,20 @@ func NewGinServer(ctx context.Context, config cfg.Config, tel *telemetry.Client,
 		},
 	})
 
+	// Volumes served over WebDAV, the handler serves the volume root and the paths within it
+	for _, method := range handlers.WebDAVMethods {
+		r.Handle(method, "/volumes/:volumeID/webdav", apiStore.VolumeWebDAV)
+		r.Handle(method, webdavRoute, apiStore.VolumeWebDAV)
+	}
+
+	// Volumes served over the S3 protocol, the handler serves the service, the buckets and the objects
+	for _, method := range handlers.S3Methods {
+		r.Handle(method, "/s3", apiStore.VolumeS3)
+		r.Handle(method, "/s3/", apiStore.VolumeS3)
+		r.Handle(method, "/s3/:bucket", apiStore.VolumeS3)
+		r.Handle(method, "/s3/:bucket/*key", apiStore.VolumeS3)
+	}
+
 	r.MaxMultipartMemory = maxMultipartMemory
 
 	s := &http.Server{
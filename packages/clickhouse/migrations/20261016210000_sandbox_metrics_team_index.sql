@@ -0,0 +1,12 @@
+-- +goose Up
+
+-- Skip index for the resource usage of a team, the table is ordered by sandbox
+ALTER TABLE sandbox_metrics_gauge_local
+    ADD INDEX IF NOT EXISTS idx_team_id team_id TYPE bloom_filter GRANULARITY 4;
+
+ALTER TABLE sandbox_metrics_gauge_local
+    MATERIALIZE INDEX idx_team_id;
+
+-- +goose Down
+ALTER TABLE sandbox_metrics_gauge_local
+    DROP INDEX IF EXISTS idx_team_id;
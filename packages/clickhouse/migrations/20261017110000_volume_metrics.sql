@@ -0,0 +1,35 @@
+-- +goose Up
+
+-- Create target table, a volume is reported by every sandbox it's mounted in
+CREATE TABLE volume_metrics_gauge_local (
+   timestamp     DateTime64(9) CODEC (ZSTD(1)),
+   volume_id     String        CODEC (ZSTD(1)),
+   sandbox_id    String        CODEC (ZSTD(1)),
+   team_id       String        CODEC (ZSTD(1)),
+   metric_name   LowCardinality(String) CODEC (ZSTD(1)),
+   value         Float64       CODEC (ZSTD(1))
+) ENGINE = MergeTree()
+PARTITION BY toDate(timestamp)
+ORDER BY (volume_id, metric_name, toUnixTimestamp64Nano(timestamp))
+TTL toDateTime(timestamp) + INTERVAL 30 DAY;
+
+-- Create routing table that routes volume metrics
+CREATE TABLE volume_metrics_gauge AS volume_metrics_gauge_local
+    ENGINE = Distributed('cluster', currentDatabase(), 'volume_metrics_gauge_local', xxHash64(volume_id));
+
+-- Create materialized view that routes volume metrics exported through the collector
+CREATE MATERIALIZED VIEW volume_metrics_gauge_mv
+TO volume_metrics_gauge AS SELECT
+    toDateTime64(TimeUnix, 9) AS timestamp,
+    Attributes['volume_id'] AS volume_id,
+    Attributes['sandbox_id'] AS sandbox_id,
+    Attributes['team_id'] AS team_id,
+    MetricName AS metric_name,
+    Value AS value
+FROM metrics_gauge
+WHERE MetricName LIKE 'moru.volume.%';
+
+-- +goose Down
+DROP TABLE IF EXISTS volume_metrics_gauge_mv;
+DROP TABLE IF EXISTS volume_metrics_gauge;
+DROP TABLE IF EXISTS volume_metrics_gauge_local;
@@ -24,6 +24,11 @@ type Clickhouse interface {
 	QueryTeamMetrics(ctx context.Context, teamID string, start time.Time, end time.Time, step time.Duration) ([]TeamMetrics, error)
 	QueryMaxStartRateTeamMetrics(ctx context.Context, teamID string, start time.Time, end time.Time, step time.Duration) (MaxTeamMetric, error)
 	QueryMaxConcurrentTeamMetrics(ctx context.Context, teamID string, start time.Time, end time.Time) (MaxTeamMetric, error)
+	QueryTeamResourceMetrics(ctx context.Context, teamID string, start time.Time, end time.Time, step time.Duration) ([]TeamResourceMetrics, error)
+
+	// Volume metrics queries
+	QueryVolumeTimeRange(ctx context.Context, volumeID, teamID string) (start time.Time, end time.Time, err error)
+	QueryVolumeMetrics(ctx context.Context, volumeID, teamID string, start time.Time, end time.Time, step time.Duration) ([]VolumeMetrics, error)
 }
 
 type Client struct {
@@ -0,0 +1,250 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/batcher"
+	flags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+const InsertSandboxMetricQuery = `INSERT INTO sandbox_metrics_gauge
+(
+    timestamp,
+    sandbox_id,
+    team_id,
+    metric_name,
+    value
+)
+VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)`
+
+const InsertVolumeMetricQuery = `INSERT INTO volume_metrics_gauge
+(
+    timestamp,
+    volume_id,
+    sandbox_id,
+    team_id,
+    metric_name,
+    value
+)
+VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)`
+
+// SandboxSample is the resource usage of a sandbox at a point in time.
+type SandboxSample struct {
+	Timestamp time.Time
+	SandboxID string
+	TeamID    string
+
+	CPUCount       int64
+	CPUUsedPercent float64
+	MemTotal       int64
+	MemUsed        int64
+
+	// Disk usage is only reported by newer envd versions
+	HasDisk   bool
+	DiskTotal int64
+	DiskUsed  int64
+
+	// Volume is the usage of the volume mounted in the sandbox, if any
+	Volume *VolumeSample
+}
+
+// VolumeSample is the usage of a volume seen from the sandbox it's mounted in.
+type VolumeSample struct {
+	VolumeID string
+
+	Used     int64
+	Files    int64
+	ReadBps  float64
+	WriteBps float64
+	// LastSync is the last time no written data waited for upload, zero if unknown
+	LastSync time.Time
+}
+
+// gauge is a row of the sandbox metrics table, or of the volume metrics table when VolumeID is set,
+// one per metric of a sample.
+type gauge struct {
+	Timestamp  time.Time
+	VolumeID   string
+	SandboxID  string
+	TeamID     string
+	MetricName string
+	Value      float64
+}
+
+// ClickhouseDelivery writes the sandbox samples into the sandbox metrics table in batches,
+// with the same metric names as the samples exported through OpenTelemetry.
+type ClickhouseDelivery struct {
+	batcher *batcher.Batcher[gauge]
+	conn    driver.Conn
+}
+
+func NewDefaultClickhouseSandboxMetricsDelivery(ctx context.Context, conn driver.Conn, featureFlags *flags.Client) (*ClickhouseDelivery, error) {
+	maxBatchSize := featureFlags.IntFlag(ctx, flags.ClickhouseBatcherMaxBatchSize)
+
+	maxDelay := time.Duration(featureFlags.IntFlag(ctx, flags.ClickhouseBatcherMaxDelay)) * time.Millisecond
+
+	batcherQueueSize := featureFlags.IntFlag(ctx, flags.ClickhouseBatcherQueueSize, flags.SandboxContext("clickhouse-metrics-batcher"))
+
+	return NewClickhouseSandboxMetricsDelivery(
+		ctx, conn, batcher.BatcherOptions{
+			MaxBatchSize: maxBatchSize,
+			MaxDelay:     maxDelay,
+			QueueSize:    batcherQueueSize,
+			ErrorHandler: func(err error) {
+				logger.L().Error(ctx, "error batching sandbox metrics", zap.Error(err))
+			},
+		},
+	)
+}
+
+func NewClickhouseSandboxMetricsDelivery(ctx context.Context, conn driver.Conn, opts batcher.BatcherOptions) (*ClickhouseDelivery, error) {
+	var err error
+
+	delivery := &ClickhouseDelivery{conn: conn}
+	delivery.batcher, err = batcher.NewBatcher(delivery.batchInserter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batcher: %w", err)
+	}
+
+	if err = delivery.batcher.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start batcher: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// Publish queues the sample, it's dropped when the queue is full.
+func (c *ClickhouseDelivery) Publish(_ context.Context, sample SandboxSample) error {
+	for _, g := range gauges(sample) {
+		ok, err := c.batcher.Push(g)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return batcher.ErrBatcherQueueFull
+		}
+	}
+
+	return nil
+}
+
+func (c *ClickhouseDelivery) Close(context.Context) error {
+	defer c.conn.Close()
+
+	return c.batcher.Stop()
+}
+
+func (c *ClickhouseDelivery) batchInserter(ctx context.Context, gauges []gauge) error {
+	sandboxGauges := make([]gauge, 0, len(gauges))
+	volumeGauges := make([]gauge, 0)
+	for _, g := range gauges {
+		if g.VolumeID != "" {
+			volumeGauges = append(volumeGauges, g)
+		} else {
+			sandboxGauges = append(sandboxGauges, g)
+		}
+	}
+
+	if err := c.insert(ctx, InsertSandboxMetricQuery, sandboxGauges, func(g gauge) []any {
+		return []any{g.Timestamp, g.SandboxID, g.TeamID, g.MetricName, g.Value}
+	}); err != nil {
+		return err
+	}
+
+	return c.insert(ctx, InsertVolumeMetricQuery, volumeGauges, func(g gauge) []any {
+		return []any{g.Timestamp, g.VolumeID, g.SandboxID, g.TeamID, g.MetricName, g.Value}
+	})
+}
+
+// insert sends the gauges in a single batch of the query, with the columns returned by row.
+func (c *ClickhouseDelivery) insert(ctx context.Context, query string, gauges []gauge, row func(gauge) []any) error {
+	if len(gauges) == 0 {
+		return nil
+	}
+
+	batch, err := c.conn.PrepareBatch(ctx, query, driver.WithReleaseConnection())
+	if err != nil {
+		return fmt.Errorf("error preparing batch: %w", err)
+	}
+
+	for _, g := range gauges {
+		err := batch.Append(row(g)...)
+		if err != nil {
+			return fmt.Errorf("error appending %d metrics to batch: %w", len(gauges), err)
+		}
+	}
+
+	err = batch.Send()
+	if err != nil {
+		return fmt.Errorf("error sending %d metrics batch: %w", len(gauges), err)
+	}
+
+	return nil
+}
+
+// gauges splits the sample in a row per metric.
+func gauges(sample SandboxSample) []gauge {
+	out := make([]gauge, 0, 11)
+	add := func(name string, value float64) {
+		out = append(out, gauge{
+			Timestamp:  sample.Timestamp,
+			SandboxID:  sample.SandboxID,
+			TeamID:     sample.TeamID,
+			MetricName: name,
+			Value:      value,
+		})
+	}
+
+	add(string(telemetry.SandboxCpuTotalGaugeName), float64(sample.CPUCount))
+	add(string(telemetry.SandboxCpuUsedGaugeName), sample.CPUUsedPercent)
+	add(string(telemetry.SandboxRamTotalGaugeName), float64(sample.MemTotal))
+	add(string(telemetry.SandboxRamUsedGaugeName), float64(sample.MemUsed))
+	if sample.HasDisk {
+		add(string(telemetry.SandboxDiskTotalGaugeName), float64(sample.DiskTotal))
+		add(string(telemetry.SandboxDiskUsedGaugeName), float64(sample.DiskUsed))
+	}
+
+	if volume := sample.Volume; volume != nil {
+		addVolume := func(name string, value float64) {
+			out = append(out, gauge{
+				Timestamp:  sample.Timestamp,
+				VolumeID:   volume.VolumeID,
+				SandboxID:  sample.SandboxID,
+				TeamID:     sample.TeamID,
+				MetricName: name,
+				Value:      value,
+			})
+		}
+
+		addVolume(string(telemetry.VolumeUsedGaugeName), float64(volume.Used))
+		addVolume(string(telemetry.VolumeFilesGaugeName), float64(volume.Files))
+		addVolume(string(telemetry.VolumeReadThroughputGaugeName), volume.ReadBps)
+		addVolume(string(telemetry.VolumeWriteThroughputGaugeName), volume.WriteBps)
+		if !volume.LastSync.IsZero() {
+			addVolume(string(telemetry.VolumeLastSyncGaugeName), float64(volume.LastSync.Unix()))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+func TestGauges(t *testing.T) {
+	t.Parallel()
+
+	sample := SandboxSample{
+		Timestamp:      time.Unix(1700000000, 0),
+		SandboxID:      "sbx",
+		TeamID:         "team",
+		CPUCount:       2,
+		CPUUsedPercent: 50,
+		MemTotal:       1 << 30,
+		MemUsed:        1 << 29,
+	}
+
+	rows := gauges(sample)
+	require.Len(t, rows, 4)
+	assert.Equal(t, string(telemetry.SandboxCpuTotalGaugeName), rows[0].MetricName)
+	assert.InDelta(t, 2, rows[0].Value, 0)
+	assert.Equal(t, string(telemetry.SandboxCpuUsedGaugeName), rows[1].MetricName)
+	assert.InDelta(t, 50, rows[1].Value, 0)
+	for _, row := range rows {
+		assert.Equal(t, sample.Timestamp, row.Timestamp)
+		assert.Equal(t, "sbx", row.SandboxID)
+		assert.Equal(t, "team", row.TeamID)
+	}
+
+	sample.HasDisk = true
+	sample.DiskTotal = 10 << 30
+	sample.DiskUsed = 1 << 30
+
+	rows = gauges(sample)
+	require.Len(t, rows, 6)
+	assert.Equal(t, string(telemetry.SandboxDiskUsedGaugeName), rows[5].MetricName)
+	assert.InDelta(t, float64(1<<30), rows[5].Value, 0)
+}
+
+func TestGaugesWithVolume(t *testing.T) {
+	t.Parallel()
+
+	sample := SandboxSample{
+		Timestamp: time.Unix(1700000000, 0),
+		SandboxID: "sbx",
+		TeamID:    "team",
+		Volume: &VolumeSample{
+			VolumeID: "vol",
+			Used:     1 << 20,
+			Files:    3,
+			ReadBps:  100,
+			WriteBps: 200,
+		},
+	}
+
+	rows := gauges(sample)
+	require.Len(t, rows, 8)
+
+	volumeRows := rows[4:]
+	assert.Equal(t, string(telemetry.VolumeUsedGaugeName), volumeRows[0].MetricName)
+	assert.InDelta(t, float64(1<<20), volumeRows[0].Value, 0)
+	assert.Equal(t, string(telemetry.VolumeWriteThroughputGaugeName), volumeRows[3].MetricName)
+	assert.InDelta(t, 200, volumeRows[3].Value, 0)
+	for _, row := range volumeRows {
+		assert.Equal(t, "vol", row.VolumeID)
+		assert.Equal(t, "sbx", row.SandboxID)
+		assert.Equal(t, "team", row.TeamID)
+	}
+
+	// The sync time is only reported once known
+	sample.Volume.LastSync = time.Unix(1699999990, 0)
+
+	rows = gauges(sample)
+	require.Len(t, rows, 9)
+	assert.Equal(t, string(telemetry.VolumeLastSyncGaugeName), rows[8].MetricName)
+	assert.InDelta(t, 1699999990, rows[8].Value, 0)
+	for _, row := range rows[:4] {
+		assert.Empty(t, row.VolumeID)
+	}
+}
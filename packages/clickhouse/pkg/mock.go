@@ -40,3 +40,15 @@ func (m *NoopClient) QueryMaxStartRateTeamMetrics(context.Context, string, time.
 func (m *NoopClient) QueryMaxConcurrentTeamMetrics(context.Context, string, time.Time, time.Time) (MaxTeamMetric, error) {
 	return MaxTeamMetric{}, nil
 }
+
+func (m *NoopClient) QueryTeamResourceMetrics(context.Context, string, time.Time, time.Time, time.Duration) ([]TeamResourceMetrics, error) {
+	return nil, nil
+}
+
+func (m *NoopClient) QueryVolumeTimeRange(context.Context, string, string) (start time.Time, end time.Time, err error) {
+	return time.Time{}, time.Time{}, nil
+}
+
+func (m *NoopClient) QueryVolumeMetrics(context.Context, string, string, time.Time, time.Time, time.Duration) ([]VolumeMetrics, error) {
+	return nil, nil
+}
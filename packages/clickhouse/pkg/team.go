@@ -172,3 +172,73 @@ func (c *Client) QueryMaxConcurrentTeamMetrics(ctx context.Context, teamID strin
 
 	return out, nil
 }
+
+type TeamResourceMetrics struct {
+	Timestamp    time.Time `ch:"ts"`
+	SandboxCount uint64    `ch:"sandbox_count"`
+	CPUCount     float64   `ch:"cpu_total"`
+	CPUUsed      float64   `ch:"cpu_used"`
+	MemTotal     float64   `ch:"ram_total"`
+	MemUsed      float64   `ch:"ram_used"`
+	DiskTotal    float64   `ch:"disk_total"`
+	DiskUsed     float64   `ch:"disk_used"`
+}
+
+// The samples of each sandbox are aggregated per step first, so the sandboxes are counted once per step.
+// The CPU used is summed in cores, the sandboxes report it as a percentage of their CPUs.
+var teamResourceMetricsSelectQuery = fmt.Sprintf(`
+SELECT   ts,
+         count()                           AS sandbox_count,
+         sum(cpu_total)                    AS cpu_total,
+         sum(cpu_total * cpu_used / 100)   AS cpu_used,
+         sum(ram_total)                    AS ram_total,
+         sum(ram_used)                     AS ram_used,
+         sum(disk_total)                   AS disk_total,
+         sum(disk_used)                    AS disk_used
+FROM (
+    SELECT   toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
+             sandbox_id,
+             maxIf(value, metric_name = '%s') AS cpu_total,
+             maxIf(value, metric_name = '%s') AS cpu_used,
+             maxIf(value, metric_name = '%s') AS ram_total,
+             maxIf(value, metric_name = '%s') AS ram_used,
+             maxIf(value, metric_name = '%s') AS disk_total,
+             maxIf(value, metric_name = '%s') AS disk_used
+    FROM     sandbox_metrics_gauge
+    WHERE    team_id = {team_id:String}
+    AND      timestamp >= {start_time:DateTime64}
+    AND      timestamp <= {end_time:DateTime64}
+    GROUP BY ts, sandbox_id
+)
+GROUP BY ts
+ORDER BY ts;
+`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName)
+
+// QueryTeamResourceMetrics returns the resources of all the sandboxes of the team summed per step.
+func (c *Client) QueryTeamResourceMetrics(ctx context.Context, teamID string, start time.Time, end time.Time, step time.Duration) ([]TeamResourceMetrics, error) {
+	rows, err := c.conn.Query(ctx, teamResourceMetricsSelectQuery,
+		clickhouse.Named("team_id", teamID),
+		clickhouse.DateNamed("start_time", start, clickhouse.Seconds),
+		clickhouse.DateNamed("end_time", end, clickhouse.Seconds),
+		clickhouse.Named("step", strconv.Itoa(int(step.Seconds()))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query team resource metrics: %w", err)
+	}
+
+	defer rows.Close()
+	var out []TeamResourceMetrics
+	for rows.Next() {
+		var m TeamResourceMetrics
+		if err := rows.ScanStruct(&m); err != nil {
+			return nil, fmt.Errorf("error scanning team resource metrics: %w", err)
+		}
+		out = append(out, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over team resource metrics rows: %w", err)
+	}
+
+	return out, nil
+}
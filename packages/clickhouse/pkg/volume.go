@@ -0,0 +1,101 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+type VolumeMetrics struct {
+	Timestamp time.Time `ch:"ts"`
+	Used      float64   `ch:"used"`
+	Files     float64   `ch:"files"`
+	ReadBps   float64   `ch:"read_bps"`
+	WriteBps  float64   `ch:"write_bps"`
+	// LastSync is the Unix timestamp of the last sync reported in the interval, 0 if none
+	LastSync float64 `ch:"last_sync"`
+}
+
+const volumeMetricsTimeRangeSelectQuery = `
+SELECT Min(timestamp) AS start_time,
+       Max(timestamp) AS end_time
+FROM   volume_metrics_gauge
+WHERE  volume_id = {volume_id:String}
+       AND team_id = {team_id:String};
+`
+
+// The sandboxes a volume is mounted in report the same usage but their own throughput,
+// so the throughput of the sandboxes is summed up per interval.
+var volumeMetricsSelectQuery = fmt.Sprintf(`
+SELECT   ts,
+         max(used)      AS used,
+         max(files)     AS files,
+         sum(read_bps)  AS read_bps,
+         sum(write_bps) AS write_bps,
+         max(last_sync) AS last_sync
+FROM (
+    SELECT   toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
+             sandbox_id,
+             maxIf(value, metric_name = '%s')                AS used,
+             maxIf(value, metric_name = '%s')                AS files,
+             ifNotFinite(avgIf(value, metric_name = '%s'), 0) AS read_bps,
+             ifNotFinite(avgIf(value, metric_name = '%s'), 0) AS write_bps,
+             maxIf(value, metric_name = '%s')                AS last_sync
+    FROM     volume_metrics_gauge
+    WHERE    volume_id = {volume_id:String}
+    AND      team_id = {team_id:String}
+    AND      timestamp >= {start_time:DateTime64}
+    AND      timestamp <= {end_time:DateTime64}
+    GROUP BY ts, sandbox_id
+)
+GROUP BY ts
+ORDER BY ts;
+`, telemetry.VolumeUsedGaugeName, telemetry.VolumeFilesGaugeName, telemetry.VolumeReadThroughputGaugeName, telemetry.VolumeWriteThroughputGaugeName, telemetry.VolumeLastSyncGaugeName)
+
+func (c *Client) QueryVolumeTimeRange(ctx context.Context, volumeID string, teamID string) (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	err := c.conn.QueryRow(ctx, volumeMetricsTimeRangeSelectQuery,
+		clickhouse.Named("volume_id", volumeID),
+		clickhouse.Named("team_id", teamID),
+	).Scan(&start, &end)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("query volume time range: %w", err)
+	}
+
+	return start, end, nil
+}
+
+func (c *Client) QueryVolumeMetrics(ctx context.Context, volumeID string, teamID string, start time.Time, end time.Time, step time.Duration) ([]VolumeMetrics, error) {
+	rows, err := c.conn.Query(ctx, volumeMetricsSelectQuery,
+		clickhouse.Named("volume_id", volumeID),
+		clickhouse.Named("team_id", teamID),
+		clickhouse.DateNamed("start_time", start, clickhouse.Seconds),
+		clickhouse.DateNamed("end_time", end, clickhouse.Seconds),
+		clickhouse.Named("step", strconv.Itoa(int(step.Seconds()))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query volume metrics: %w", err)
+	}
+
+	defer rows.Close()
+	var out []VolumeMetrics
+	for rows.Next() {
+		var m VolumeMetrics
+		if err := rows.ScanStruct(&m); err != nil {
+			return nil, fmt.Errorf("error scanning volume metrics: %w", err)
+		}
+		out = append(out, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over volume metrics rows: %w", err)
+	}
+
+	return out, nil
+}
@@ -1,14 +1,21 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/grafana/loki/pkg/logproto"
 
 	api "github.com/moru-ai/sandbox-infra/packages/shared/pkg/http/edge"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logs"
+	catalog "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-catalog"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
 )
 
 const (
@@ -16,6 +23,10 @@ const (
 	sandboxLogsLimit       = 100
 
 	sandboxDefaultDirection = logproto.FORWARD
+
+	// sandboxLogsCacheTTL is how long the logs of a sandbox that isn't running are cached,
+	// it bounds how long logs ingested late by Loki are missing from polled responses
+	sandboxLogsCacheTTL = 30 * time.Second
 )
 
 func (a *APIStore) V1SandboxLogs(c *gin.Context, sandboxID string, params api.V1SandboxLogsParams) {
@@ -55,6 +66,18 @@ func (a *APIStore) V1SandboxLogs(c *gin.Context, sandboxID string, params api.V1
 		eventType = string(*params.EventType)
 	}
 
+	// The logs of a running sandbox change with each query, the others are cached so
+	// polling dashboards don't hit Loki
+	cacheKey := sandboxLogsCacheKey(sandboxID, params)
+	cacheable := !a.isSandboxRunning(ctx, sandboxID)
+	if cacheable {
+		if cached, ok := a.sandboxLogsCache.Get(cacheKey); ok {
+			c.JSON(http.StatusOK, cached)
+
+			return
+		}
+	}
+
 	// includeSystemLogs=false: show only stdout/stderr (user program output)
 	// Admins can query Loki directly via Grafana to see all logs
 	logsRaw, err := a.queryLogsProvider.QuerySandboxLogs(ctx, params.TeamID, sandboxID, start, end, limit, eventType, direction, false)
@@ -80,5 +103,72 @@ func (a *APIStore) V1SandboxLogs(c *gin.Context, sandboxID string, params api.V1
 		)
 	}
 
-	c.JSON(http.StatusOK, api.SandboxLogsResponse{Logs: l, LogEntries: le})
+	response := api.SandboxLogsResponse{Logs: l, LogEntries: le}
+	if cacheable {
+		a.sandboxLogsCache.Set(cacheKey, response)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// isSandboxRunning reports whether the sandbox is in the catalog of running sandboxes.
+// Sandboxes whose state can't be determined are treated as running.
+func (a *APIStore) isSandboxRunning(ctx context.Context, sandboxID string) bool {
+	_, err := a.sandboxes.GetSandbox(ctx, sandboxID)
+
+	return !errors.Is(err, catalog.ErrSandboxNotFound)
+}
+
+// sandboxLogsCacheKey identifies the logs query of a sandbox. The team is part of the key,
+// so a response is never served to another team.
+func sandboxLogsCacheKey(sandboxID string, params api.V1SandboxLogsParams) string {
+	// Without a cursor the logs are queried from the oldest limit, not from the epoch
+	cursor := ""
+	if params.Cursor != nil {
+		cursor = strconv.FormatInt(*params.Cursor, 10)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%d/%s/%s",
+		params.TeamID,
+		sandboxID,
+		cursor,
+		utils.DerefOrDefault(params.Limit, 0),
+		utils.DerefOrDefault(params.Direction, ""),
+		utils.DerefOrDefault(params.EventType, ""),
+	)
+}
+
+func (a *APIStore) V1SandboxLogsSummary(c *gin.Context, sandboxID string, params api.V1SandboxLogsSummaryParams) {
+	ctx := c.Request.Context()
+
+	_, templateSpan := tracer.Start(c, "sandbox-logs-summary-handler")
+	defer templateSpan.End()
+
+	end := time.Now()
+	start := end.Add(-sandboxLogsOldestLimit)
+	if params.Start != nil {
+		if paramsStart := time.UnixMilli(*params.Start); paramsStart.After(start) {
+			start = paramsStart
+		}
+	}
+
+	counts, err := a.queryLogsProvider.QuerySandboxLogsSummary(ctx, params.TeamID, sandboxID, start, end)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when fetching sandbox logs summary")
+		telemetry.ReportCriticalError(ctx, "error when fetching sandbox logs summary", err)
+
+		return
+	}
+
+	response := api.SandboxLogsSummaryResponse{Counts: make([]api.SandboxLogsCount, 0, len(counts))}
+	for _, count := range counts {
+		response.Total += count.Count
+		response.Counts = append(response.Counts, api.SandboxLogsCount{
+			EventType: api.SandboxLogEventType(count.EventType),
+			Level:     api.LogLevel(logs.LevelToString(count.Level)),
+			Count:     count.Count,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grafana/loki/pkg/logproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	loggerprovider "github.com/moru-ai/sandbox-infra/packages/proxy/internal/edge/logger-provider"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/cache"
+	api "github.com/moru-ai/sandbox-infra/packages/shared/pkg/http/edge"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logs"
+	catalog "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-catalog"
+)
+
+// fakeLogsProvider answers each sandbox logs query with a single line naming the query,
+// so a response served from the cache can be told apart from a fresh one.
+type fakeLogsProvider struct {
+	loggerprovider.LogsQueryProvider
+
+	queries int
+}
+
+func (p *fakeLogsProvider) QuerySandboxLogs(_ context.Context, _ string, _ string, _ time.Time, _ time.Time, _ int, _ string, _ logproto.Direction, _ bool) ([]logs.LogEntry, error) {
+	p.queries++
+
+	return []logs.LogEntry{{
+		Timestamp: time.UnixMilli(1_700_000_000_000).UTC(),
+		Message:   fmt.Sprintf("query %d", p.queries),
+		Raw:       fmt.Sprintf("query %d", p.queries),
+		EventType: "stdout",
+	}}, nil
+}
+
+func newSandboxLogsTestStore(t *testing.T) (*APIStore, *fakeLogsProvider, catalog.SandboxesCatalog) {
+	t.Helper()
+
+	provider := &fakeLogsProvider{}
+	sandboxes := catalog.NewMemorySandboxesCatalog()
+	logsCache := cache.NewCache(cache.Config[string, api.SandboxLogsResponse]{TTL: sandboxLogsCacheTTL})
+	t.Cleanup(func() {
+		_ = logsCache.Close(context.Background())
+		_ = sandboxes.Close(context.Background())
+	})
+
+	return &APIStore{
+		sandboxes:         sandboxes,
+		queryLogsProvider: provider,
+		sandboxLogsCache:  logsCache,
+	}, provider, sandboxes
+}
+
+func getSandboxLogs(t *testing.T, store *APIStore, sandboxID string, params api.V1SandboxLogsParams) api.SandboxLogsResponse {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/sandboxes/"+sandboxID+"/logs", nil)
+
+	store.V1SandboxLogs(c, sandboxID, params)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response api.SandboxLogsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.LogEntries, 1)
+
+	return response
+}
+
+func TestV1SandboxLogs_CachesFinishedSandbox(t *testing.T) {
+	t.Parallel()
+
+	store, provider, _ := newSandboxLogsTestStore(t)
+	params := api.V1SandboxLogsParams{TeamID: "team-1"}
+
+	first := getSandboxLogs(t, store, "sbx-finished", params)
+	second := getSandboxLogs(t, store, "sbx-finished", params)
+
+	assert.Equal(t, 1, provider.queries)
+	assert.Equal(t, first, second)
+	assert.Equal(t, "query 1", second.LogEntries[0].Message)
+}
+
+func TestV1SandboxLogs_DoesNotCacheRunningSandbox(t *testing.T) {
+	t.Parallel()
+
+	store, provider, sandboxes := newSandboxLogsTestStore(t)
+	require.NoError(t, sandboxes.StoreSandbox(t.Context(), "sbx-running", &catalog.SandboxInfo{
+		OrchestratorID:          "orch-1",
+		ExecutionID:             "exec-1",
+		SandboxStartedAt:        time.Now(),
+		SandboxMaxLengthInHours: 1,
+	}, time.Hour))
+	params := api.V1SandboxLogsParams{TeamID: "team-1"}
+
+	getSandboxLogs(t, store, "sbx-running", params)
+	second := getSandboxLogs(t, store, "sbx-running", params)
+
+	assert.Equal(t, 2, provider.queries)
+	assert.Equal(t, "query 2", second.LogEntries[0].Message)
+}
+
+func TestV1SandboxLogs_CacheKeyedByQuery(t *testing.T) {
+	t.Parallel()
+
+	cursor := int64(1_700_000_000_000)
+	otherCursor := cursor + 1000
+	limit := int32(10)
+	backward := api.LogsDirectionBackward
+
+	tests := []struct {
+		name   string
+		params api.V1SandboxLogsParams
+	}{
+		{
+			name:   "different cursor",
+			params: api.V1SandboxLogsParams{TeamID: "team-1", Cursor: &otherCursor},
+		},
+		{
+			name:   "different limit",
+			params: api.V1SandboxLogsParams{TeamID: "team-1", Cursor: &cursor, Limit: &limit},
+		},
+		{
+			name:   "different direction",
+			params: api.V1SandboxLogsParams{TeamID: "team-1", Cursor: &cursor, Direction: &backward},
+		},
+		{
+			name:   "different team",
+			params: api.V1SandboxLogsParams{TeamID: "team-2", Cursor: &cursor},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, provider, _ := newSandboxLogsTestStore(t)
+
+			getSandboxLogs(t, store, "sbx-finished", api.V1SandboxLogsParams{TeamID: "team-1", Cursor: &cursor})
+			response := getSandboxLogs(t, store, "sbx-finished", tt.params)
+
+			assert.Equal(t, 2, provider.queries)
+			assert.Equal(t, "query 2", response.LogEntries[0].Message)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	loggerprovider "github.com/moru-ai/sandbox-infra/packages/proxy/internal/edge/logger-provider"
 	metricsprovider "github.com/moru-ai/sandbox-infra/packages/proxy/internal/edge/metrics-provider"
 	moruorchestrators "github.com/moru-ai/sandbox-infra/packages/proxy/internal/edge/pool"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/cache"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/env"
 	api "github.com/moru-ai/sandbox-infra/packages/shared/pkg/http/edge"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
@@ -31,6 +32,7 @@ type APIStore struct {
 	sandboxes                   catalog.SandboxesCatalog
 	queryLogsProvider           loggerprovider.LogsQueryProvider
 	querySandboxMetricsProvider clickhouse.SandboxQueriesProvider
+	sandboxLogsCache            *cache.Cache[string, api.SandboxLogsResponse]
 }
 
 const (
@@ -59,6 +61,7 @@ func NewStore(
 		orchestratorPool:            orchestratorsPool,
 		queryLogsProvider:           queryLogsProvider,
 		querySandboxMetricsProvider: querySandboxMetricsProvider,
+		sandboxLogsCache:            cache.NewCache(cache.Config[string, api.SandboxLogsResponse]{TTL: sandboxLogsCacheTTL}),
 
 		info:      info,
 		logger:    l,
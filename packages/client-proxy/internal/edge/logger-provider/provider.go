@@ -17,6 +17,15 @@ type LogsQueryProvider interface {
 	// If includeSystemLogs is true, returns all logs including process_start, process_end events.
 	// If false, returns only stdout/stderr (user program output).
 	QuerySandboxLogs(ctx context.Context, teamID string, sandboxID string, start time.Time, end time.Time, limit int, eventType string, direction logproto.Direction, includeSystemLogs bool) ([]logs.LogEntry, error)
+	// QuerySandboxLogsSummary counts the sandbox logs returned to users per event type and level.
+	QuerySandboxLogsSummary(ctx context.Context, teamID string, sandboxID string, start time.Time, end time.Time) ([]SandboxLogsCount, error)
+}
+
+// SandboxLogsCount is the number of sandbox log entries with an event type and level.
+type SandboxLogsCount struct {
+	EventType string
+	Level     logs.LogLevel
+	Count     int64
 }
 
 func GetLogsQueryProvider(config cfg.Config) (LogsQueryProvider, error) {
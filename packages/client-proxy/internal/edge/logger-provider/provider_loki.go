@@ -7,6 +7,7 @@ import (
 	"time"
 
 	loki "github.com/grafana/loki/pkg/logcli/client"
+	"github.com/grafana/loki/pkg/loghttp"
 	"github.com/grafana/loki/pkg/logproto"
 	"go.uber.org/zap"
 
@@ -17,6 +18,9 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 )
 
+// sandboxLogsSummaryLimit is required by Loki for every query, even though it doesn't limit metric queries
+const sandboxLogsSummaryLimit = 100
+
 type LokiQueryProvider struct {
 	client *loki.DefaultClient
 }
@@ -59,25 +63,7 @@ func (l *LokiQueryProvider) QueryBuildLogs(ctx context.Context, templateID strin
 }
 
 func (l *LokiQueryProvider) QuerySandboxLogs(ctx context.Context, teamID string, sandboxID string, start time.Time, end time.Time, limit int, eventType string, direction logproto.Direction, includeSystemLogs bool) ([]logs.LogEntry, error) {
-	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
-	sandboxIdSanitized := strings.ReplaceAll(sandboxID, "`", "")
-	teamIdSanitized := strings.ReplaceAll(teamID, "`", "")
-
-	var query string
-	switch {
-	case includeSystemLogs:
-		// Admin view: include all logs (stdout, stderr, process_start, process_end, etc.)
-		query = fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\"}", teamIdSanitized, sandboxIdSanitized)
-	case eventType == "stdout":
-		// Filter to stdout but always include process lifecycle events for context
-		query = fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stdout|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
-	case eventType == "stderr":
-		// Filter to stderr but always include process lifecycle events for context
-		query = fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stderr|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
-	default:
-		// User view: include stdout/stderr and process lifecycle events (start/end)
-		query = fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stdout|stderr|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
-	}
+	query := sandboxLogsSelector(teamID, sandboxID, eventType, includeSystemLogs)
 
 	res, err := l.client.QueryRange(query, limit, start, end, direction, time.Duration(0), time.Duration(0), true)
 	if err != nil {
@@ -97,3 +83,76 @@ func (l *LokiQueryProvider) QuerySandboxLogs(ctx context.Context, teamID string,
 
 	return lm, nil
 }
+
+func (l *LokiQueryProvider) QuerySandboxLogsSummary(ctx context.Context, teamID string, sandboxID string, start time.Time, end time.Time) ([]SandboxLogsCount, error) {
+	rangeSeconds := max(int64(end.Sub(start).Seconds()), 1)
+
+	// The level is a field of the JSON log line, it's extracted under another name so it doesn't collide with a level label.
+	// Parsing errors are dropped so lines that aren't JSON are still counted, with the default level.
+	query := fmt.Sprintf(
+		"sum by (event_type, log_level) (count_over_time(%s | json log_level=\"level\" | drop __error__, __error_details__ [%ds]))",
+		sandboxLogsSelector(teamID, sandboxID, "", false),
+		rangeSeconds,
+	)
+
+	// The limit only applies to log lines, the result has a series per event type and level
+	res, err := l.client.Query(query, sandboxLogsSummaryLimit, end, logproto.FORWARD, true)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when returning logs summary for sandbox", err)
+		logger.L().Error(ctx, "error when returning logs summary for sandbox", zap.Error(err), logger.WithSandboxID(sandboxID))
+
+		return nil, fmt.Errorf("failed to query sandbox logs summary: %w", err)
+	}
+
+	if res.Data.Result.Type() != loghttp.ResultTypeVector {
+		return nil, fmt.Errorf("unexpected value type received from loki query: %s", res.Data.Result.Type())
+	}
+
+	type countKey struct {
+		eventType string
+		level     logs.LogLevel
+	}
+
+	// Unknown levels are mapped to the default one, so several series can have the same key
+	counts := make(map[countKey]int64)
+	keys := make([]countKey, 0)
+	for _, sample := range res.Data.Result.(loghttp.Vector) {
+		key := countKey{
+			eventType: string(sample.Metric["event_type"]),
+			level:     logs.StringToLevel(string(sample.Metric["log_level"])),
+		}
+
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+		}
+		counts[key] += int64(sample.Value)
+	}
+
+	summary := make([]SandboxLogsCount, 0, len(keys))
+	for _, key := range keys {
+		summary = append(summary, SandboxLogsCount{EventType: key.eventType, Level: key.level, Count: counts[key]})
+	}
+
+	return summary, nil
+}
+
+func sandboxLogsSelector(teamID string, sandboxID string, eventType string, includeSystemLogs bool) string {
+	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
+	sandboxIdSanitized := strings.ReplaceAll(sandboxID, "`", "")
+	teamIdSanitized := strings.ReplaceAll(teamID, "`", "")
+
+	switch {
+	case includeSystemLogs:
+		// Admin view: include all logs (stdout, stderr, process_start, process_end, etc.)
+		return fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\"}", teamIdSanitized, sandboxIdSanitized)
+	case eventType == "stdout":
+		// Filter to stdout but always include process lifecycle events for context
+		return fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stdout|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
+	case eventType == "stderr":
+		// Filter to stderr but always include process lifecycle events for context
+		return fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stderr|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
+	default:
+		// User view: include stdout/stderr and process lifecycle events (start/end)
+		return fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\", event_type=~\"stdout|stderr|process_start|process_end\"}", teamIdSanitized, sandboxIdSanitized)
+	}
+}
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Multipart uploads into volumes. Parts are staged in the volume itself,
+-- the tables only track which parts were received so interrupted uploads can resume.
+CREATE TABLE IF NOT EXISTS "public"."volume_uploads" (
+    "id"            TEXT        NOT NULL,
+    "volume_id"     TEXT        NOT NULL,
+    "team_id"       UUID        NOT NULL,
+    "path"          TEXT        NOT NULL,
+    "status"        TEXT        NOT NULL DEFAULT 'uploading',
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "expires_at"    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "volume_uploads_volume_id_fkey" FOREIGN KEY ("volume_id") REFERENCES "public"."volumes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "volume_uploads_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "volume_uploads_status_check" CHECK (status IN ('uploading', 'completed', 'aborted'))
+);
+
+CREATE INDEX IF NOT EXISTS "volume_uploads_volume_id_idx" ON "public"."volume_uploads" ("volume_id");
+CREATE INDEX IF NOT EXISTS "volume_uploads_expires_at_idx" ON "public"."volume_uploads" ("expires_at") WHERE status = 'uploading';
+
+CREATE TABLE IF NOT EXISTS "public"."volume_upload_parts" (
+    "upload_id"     TEXT        NOT NULL,
+    "part_number"   INT         NOT NULL,
+    "size"          BIGINT      NOT NULL,
+    "checksum"      TEXT        NOT NULL,
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("upload_id", "part_number"),
+    CONSTRAINT "volume_upload_parts_upload_id_fkey" FOREIGN KEY ("upload_id") REFERENCES "public"."volume_uploads" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Enable RLS
+ALTER TABLE "public"."volume_uploads" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "public"."volume_upload_parts" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "volume_uploads_team_isolation" ON volume_uploads
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."volume_upload_parts";
+DROP TABLE IF EXISTS "public"."volume_uploads";
+
+-- +goose StatementEnd
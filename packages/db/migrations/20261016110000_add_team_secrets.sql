@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Team secrets injected into sandboxes. Values are encrypted by the API,
+-- the database never sees them in plain text.
+CREATE TABLE IF NOT EXISTS "public"."team_secrets" (
+    "team_id"           UUID        NOT NULL,
+    "name"              TEXT        NOT NULL,
+    "value_encrypted"   BYTEA       NOT NULL,
+    "created_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("team_id", "name"),
+    CONSTRAINT "team_secrets_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Enable RLS
+ALTER TABLE "public"."team_secrets" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "team_secrets_team_isolation" ON team_secrets
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."team_secrets";
+
+-- +goose StatementEnd
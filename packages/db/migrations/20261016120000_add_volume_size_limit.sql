@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Optional size quota of a volume, NULL means unlimited.
+ALTER TABLE "public"."volumes"
+ADD COLUMN IF NOT EXISTS "size_limit_bytes" BIGINT CHECK ("size_limit_bytes" > 0);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "size_limit_bytes";
+
+-- +goose StatementEnd
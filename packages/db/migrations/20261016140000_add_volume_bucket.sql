@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bucket holding the volume data, NULL means the shared volumes bucket of the deployment.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "gcs_bucket" TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "gcs_bucket";
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleted volumes wait in pending_delete until delete_after, when their data is destroyed.
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_status_check";
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_status_check"
+    CHECK (status IN ('creating', 'available', 'pending_delete', 'deleting'));
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "delete_after" TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS "volumes_delete_after_idx" ON "public"."volumes" ("delete_after")
+    WHERE status = 'pending_delete';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."volumes_delete_after_idx";
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "delete_after";
+UPDATE "public"."volumes" SET status = 'available' WHERE status = 'pending_delete';
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_status_check";
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_status_check"
+    CHECK (status IN ('creating', 'available', 'deleting'));
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Asynchronous jobs running on volumes, so clients can follow them through a single API.
+-- Operations outlive their volume, a delete operation is still readable once the volume is gone.
+CREATE TABLE IF NOT EXISTS "public"."volume_operations" (
+    "id"            TEXT        NOT NULL,
+    "volume_id"     TEXT        NOT NULL,
+    "team_id"       UUID        NOT NULL,
+    "type"          TEXT        NOT NULL,
+    "state"         TEXT        NOT NULL DEFAULT 'pending',
+    "progress"      INT         NOT NULL DEFAULT 0,
+    "error"         TEXT,
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "started_at"    TIMESTAMPTZ,
+    "finished_at"   TIMESTAMPTZ,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "volume_operations_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "volume_operations_type_check" CHECK (type IN ('delete')),
+    CONSTRAINT "volume_operations_state_check" CHECK (state IN ('pending', 'running', 'succeeded', 'failed', 'canceled')),
+    CONSTRAINT "volume_operations_progress_check" CHECK (progress BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS "volume_operations_volume_id_idx" ON "public"."volume_operations" ("volume_id", "created_at");
+
+-- Enable RLS
+ALTER TABLE "public"."volume_operations" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "volume_operations_team_isolation" ON volume_operations
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."volume_operations";
+
+-- +goose StatementEnd
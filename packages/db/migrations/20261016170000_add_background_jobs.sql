@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Durable background work of the API. Instances claim due jobs by taking a lease,
+-- a job whose lease expires without being renewed is claimed again by another instance.
+CREATE TABLE IF NOT EXISTS "public"."background_jobs" (
+    "id"                UUID        NOT NULL DEFAULT gen_random_uuid(),
+    "kind"              TEXT        NOT NULL,
+    "payload"           JSONB       NOT NULL DEFAULT '{}',
+    "unique_key"        TEXT,
+    "state"             TEXT        NOT NULL DEFAULT 'queued',
+    "attempts"          INT         NOT NULL DEFAULT 0,
+    "max_attempts"      INT         NOT NULL DEFAULT 5,
+    "run_after"         TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "lease_owner"       TEXT,
+    "lease_expires_at"  TIMESTAMPTZ,
+    "last_error"        TEXT,
+    "created_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "finished_at"       TIMESTAMPTZ,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "background_jobs_state_check" CHECK (state IN ('queued', 'running', 'succeeded', 'failed'))
+);
+
+-- Jobs with the same kind and unique key are only enqueued once, until the finished job is cleaned up
+CREATE UNIQUE INDEX IF NOT EXISTS "background_jobs_unique_key_idx" ON "public"."background_jobs" ("kind", "unique_key")
+    WHERE unique_key IS NOT NULL;
+CREATE INDEX IF NOT EXISTS "background_jobs_claim_idx" ON "public"."background_jobs" ("kind", "run_after")
+    WHERE state IN ('queued', 'running');
+CREATE INDEX IF NOT EXISTS "background_jobs_finished_at_idx" ON "public"."background_jobs" ("finished_at")
+    WHERE state IN ('succeeded', 'failed');
+
+-- Only the API service accesses the jobs
+ALTER TABLE "public"."background_jobs" ENABLE ROW LEVEL SECURITY;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."background_jobs";
+
+-- +goose StatementEnd
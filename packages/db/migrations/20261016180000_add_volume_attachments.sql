@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Sandboxes the volumes are mounted in, fed by the volume.attached and volume.detached events of the orchestrators.
+CREATE TABLE IF NOT EXISTS "public"."volume_attachments" (
+    "volume_id"     TEXT        NOT NULL,
+    "sandbox_id"    TEXT        NOT NULL,
+    "team_id"       UUID        NOT NULL,
+    "mount_path"    TEXT        NOT NULL,
+    "read_only"     BOOLEAN     NOT NULL DEFAULT FALSE,
+    "mounted_at"    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY ("volume_id", "sandbox_id"),
+    CONSTRAINT "volume_attachments_volume_id_fkey" FOREIGN KEY ("volume_id") REFERENCES "public"."volumes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "volume_attachments_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "volume_attachments_sandbox_id_idx" ON "public"."volume_attachments" ("sandbox_id");
+
+-- Enable RLS
+ALTER TABLE "public"."volume_attachments" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "volume_attachments_team_isolation" ON volume_attachments
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."volume_attachments";
+
+-- +goose StatementEnd
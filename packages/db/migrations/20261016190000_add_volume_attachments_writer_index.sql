@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Keep only the latest read-write attachment of each volume, the earlier ones are from sandboxes mounted before the lock
+DELETE FROM "public"."volume_attachments" AS a
+USING "public"."volume_attachments" AS b
+WHERE a.volume_id = b.volume_id
+  AND NOT a.read_only
+  AND NOT b.read_only
+  AND (a.mounted_at, a.sandbox_id) < (b.mounted_at, b.sandbox_id);
+
+-- A volume can be attached read-write to a single sandbox, concurrent writers corrupt the volume metadata
+CREATE UNIQUE INDEX IF NOT EXISTS "volume_attachments_writer_idx" ON "public"."volume_attachments" ("volume_id") WHERE NOT "read_only";
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS "public"."volume_attachments_writer_idx";
+
+-- +goose StatementEnd
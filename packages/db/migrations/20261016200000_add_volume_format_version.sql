@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Format version of the volume metadata, volumes formatted before the metadata was stamped are version 1.
+-- Raised by the format migration job once the metadata of the volume was upgraded.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "format_version" INTEGER NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS "volumes_format_version_idx" ON "public"."volumes" ("format_version", "created_at") WHERE status = 'available';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS "public"."volumes_format_version_idx";
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "format_version";
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Volumes with deletion protection can't be deleted until the protection is cleared.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "deletion_protection" BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "deletion_protection";
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Team webhooks receiving the volume events. The signing secret is encrypted by the API,
+-- it's returned only when the webhook is created.
+CREATE TABLE IF NOT EXISTS "public"."team_webhooks" (
+    "id"                UUID        NOT NULL DEFAULT gen_random_uuid(),
+    "team_id"           UUID        NOT NULL,
+    "url"               TEXT        NOT NULL,
+    "event_types"       TEXT[]      NOT NULL,
+    "secret_encrypted"  BYTEA       NOT NULL,
+    "created_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "team_webhooks_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "idx_team_webhooks_team_id" ON "public"."team_webhooks" ("team_id");
+
+-- Enable RLS
+ALTER TABLE "public"."team_webhooks" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "team_webhooks_team_isolation" ON team_webhooks
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."team_webhooks";
+
+-- +goose StatementEnd
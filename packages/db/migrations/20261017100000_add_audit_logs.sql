@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Audit log of the operations of the teams on their volumes and files.
+-- The volume isn't a foreign key, the entries outlive the deleted volumes.
+CREATE TABLE IF NOT EXISTS "public"."audit_logs" (
+    "id"            UUID        NOT NULL DEFAULT gen_random_uuid(),
+    "team_id"       UUID        NOT NULL,
+    "action"        TEXT        NOT NULL,
+    "actor_type"    TEXT        NOT NULL,
+    "actor_id"      UUID,
+    "volume_id"     TEXT,
+    "path"          TEXT,
+    "sandbox_id"    TEXT,
+    "source_ip"     TEXT,
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "audit_logs_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "audit_logs_actor_type_check" CHECK (actor_type IN ('api_key', 'user'))
+);
+
+CREATE INDEX IF NOT EXISTS "audit_logs_team_id_created_at_idx" ON "public"."audit_logs" ("team_id", "created_at" DESC, "id" DESC);
+
+-- Enable RLS
+ALTER TABLE "public"."audit_logs" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "audit_logs_team_isolation" ON audit_logs
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."audit_logs";
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The metadata engine of a volume is chosen when it's created and never changes.
+-- SQLite volumes replicate their metadata to the bucket with Litestream, Redis volumes keep it
+-- in their own database of the volumes Redis.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "metadata_engine" TEXT NOT NULL DEFAULT 'sqlite';
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "redis_db" INT;
+
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_metadata_engine_check"
+    CHECK (metadata_engine IN ('sqlite', 'redis'));
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_redis_db_check"
+    CHECK ((metadata_engine = 'redis') = (redis_db IS NOT NULL));
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_redis_db_key" UNIQUE ("redis_db");
+
+-- Database 0 is left to the admin connections of the volumes Redis
+CREATE SEQUENCE IF NOT EXISTS "volumes_redis_db_seq" START 1;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP SEQUENCE IF EXISTS "volumes_redis_db_seq";
+
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_redis_db_key";
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_redis_db_check";
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_metadata_engine_check";
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "redis_db";
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "metadata_engine";
+
+-- +goose StatementEnd
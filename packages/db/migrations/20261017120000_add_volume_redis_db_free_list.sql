@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Databases of the volumes Redis given back by destroyed volumes, after their metadata was deleted.
+-- New volumes take a free database before a new one of volumes_redis_db_seq, the volumes Redis only
+-- has a fixed number of databases.
+CREATE TABLE IF NOT EXISTS "public"."volume_redis_dbs_free" (
+    "redis_db"  INT         NOT NULL,
+    "freed_at"  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("redis_db")
+);
+
+-- Only the API service accesses the free databases
+ALTER TABLE "public"."volume_redis_dbs_free" ENABLE ROW LEVEL SECURITY;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."volume_redis_dbs_free";
+
+-- +goose StatementEnd
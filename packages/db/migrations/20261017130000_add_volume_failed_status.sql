@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Volumes are prepared in the background while creating, volumes that couldn't be prepared are failed
+-- until they're created again or deleted.
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_status_check";
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_status_check"
+    CHECK (status IN ('creating', 'available', 'failed', 'pending_delete', 'deleting'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE "public"."volumes" SET status = 'deleting' WHERE status = 'failed';
+ALTER TABLE "public"."volumes" DROP CONSTRAINT IF EXISTS "volumes_status_check";
+ALTER TABLE "public"."volumes" ADD CONSTRAINT "volumes_status_check"
+    CHECK (status IN ('creating', 'available', 'pending_delete', 'deleting'));
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Consistency checks of volumes run as operations, their findings are kept with the operation.
+ALTER TABLE "public"."volume_operations" ADD COLUMN IF NOT EXISTS "fsck_report" JSONB NULL;
+ALTER TABLE "public"."volume_operations" DROP CONSTRAINT IF EXISTS "volume_operations_type_check";
+ALTER TABLE "public"."volume_operations" ADD CONSTRAINT "volume_operations_type_check"
+    CHECK (type IN ('delete', 'fsck'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM "public"."volume_operations" WHERE type = 'fsck';
+ALTER TABLE "public"."volume_operations" DROP CONSTRAINT IF EXISTS "volume_operations_type_check";
+ALTER TABLE "public"."volume_operations" ADD CONSTRAINT "volume_operations_type_check"
+    CHECK (type IN ('delete'));
+ALTER TABLE "public"."volume_operations" DROP COLUMN IF EXISTS "fsck_report";
+-- +goose StatementEnd
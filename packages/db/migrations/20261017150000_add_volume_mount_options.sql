@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- JuiceFS options of a volume by name, validated against the allowlist of the API. The format options
+-- apply when the volume is formatted on its first mount, the mount options on every mount.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "mount_options" JSONB;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "mount_options";
+
+-- +goose StatementEnd
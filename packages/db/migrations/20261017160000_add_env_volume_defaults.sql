@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Default JuiceFS mount options of the volumes attached to the sandboxes of a template, e.g. a larger
+-- local cache for templates reading the same training data over and over. The options of the volume
+-- and of the attach are applied over them.
+CREATE TABLE IF NOT EXISTS "public"."env_volume_defaults" (
+    "env_id"        TEXT        NOT NULL REFERENCES "public"."envs" ("id") ON DELETE CASCADE,
+    "mount_options" JSONB,
+    "updated_at"    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("env_id")
+);
+
+-- Only the API service accesses the defaults
+ALTER TABLE "public"."env_volume_defaults" ENABLE ROW LEVEL SECURITY;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."env_volume_defaults";
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Directory of the volume the sandbox mounts instead of the whole volume, empty for the whole volume.
+ALTER TABLE "public"."volume_attachments" ADD COLUMN IF NOT EXISTS "subpath" TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."volume_attachments" DROP COLUMN IF EXISTS "subpath";
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Volume attached to the sandboxes of the template when the request doesn't attach one, looked up by
+-- name in the team of the sandbox. A name ending with * is a prefix of the names.
+ALTER TABLE "public"."env_volume_defaults"
+    ADD COLUMN IF NOT EXISTS "volume_name" TEXT,
+    ADD COLUMN IF NOT EXISTS "mount_path"  TEXT,
+    ADD COLUMN IF NOT EXISTS "read_only"   BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."env_volume_defaults"
+    DROP COLUMN IF EXISTS "volume_name",
+    DROP COLUMN IF EXISTS "mount_path",
+    DROP COLUMN IF EXISTS "read_only";
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Sandbox the volume was created for as its scratch space, the volume is deleted when the sandbox is killed.
+-- NULL for the volumes of the team.
+ALTER TABLE "public"."volumes" ADD COLUMN IF NOT EXISTS "ephemeral_sandbox_id" TEXT;
+
+CREATE INDEX IF NOT EXISTS "idx_volumes_ephemeral_sandbox_id" ON "public"."volumes" ("ephemeral_sandbox_id")
+    WHERE "ephemeral_sandbox_id" IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS "public"."idx_volumes_ephemeral_sandbox_id";
+ALTER TABLE "public"."volumes" DROP COLUMN IF EXISTS "ephemeral_sandbox_id";
+
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- History of the mounts of the volumes in sandboxes, fed by the volume.attached and volume.detached events of
+-- the orchestrators. Unlike the attachments, the mounts are kept once the volume is detached.
+CREATE TABLE IF NOT EXISTS "public"."volume_runs" (
+    "volume_id"     TEXT        NOT NULL,
+    "sandbox_id"    TEXT        NOT NULL,
+    "team_id"       UUID        NOT NULL,
+    "mount_path"    TEXT        NOT NULL,
+    "read_only"     BOOLEAN     NOT NULL DEFAULT FALSE,
+    "subpath"       TEXT        NOT NULL DEFAULT '',
+    "mounted_at"    TIMESTAMPTZ NOT NULL,
+    "detached_at"   TIMESTAMPTZ,
+    PRIMARY KEY ("volume_id", "sandbox_id", "mounted_at"),
+    CONSTRAINT "volume_runs_volume_id_fkey" FOREIGN KEY ("volume_id") REFERENCES "public"."volumes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "volume_runs_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "volume_runs_volume_id_mounted_at_idx" ON "public"."volume_runs" ("volume_id", "mounted_at" DESC, "sandbox_id" DESC);
+
+-- Enable RLS
+ALTER TABLE "public"."volume_runs" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "volume_runs_team_isolation" ON volume_runs
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."volume_runs";
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- S3 credentials of the teams, signing the requests to the S3 gateway of the volumes. The secret access key
+-- is encrypted by the API, it's returned only when the credentials are created.
+CREATE TABLE IF NOT EXISTS "public"."team_s3_credentials" (
+    "access_key_id"     TEXT        NOT NULL,
+    "team_id"           UUID        NOT NULL,
+    "secret_encrypted"  BYTEA       NOT NULL,
+    "created_at"        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "last_used"         TIMESTAMPTZ,
+    PRIMARY KEY ("access_key_id"),
+    CONSTRAINT "team_s3_credentials_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "idx_team_s3_credentials_team_id" ON "public"."team_s3_credentials" ("team_id");
+
+-- Enable RLS
+ALTER TABLE "public"."team_s3_credentials" ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "team_s3_credentials_team_isolation" ON team_s3_credentials
+  FOR ALL USING (team_id = current_setting('app.team_id')::uuid);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "public"."team_s3_credentials";
+
+-- +goose StatementEnd
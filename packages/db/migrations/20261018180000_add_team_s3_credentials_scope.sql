@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Scope of the credentials: a single volume, only the paths under its subpath, and only reads.
+-- Credentials without a volume reach all the volumes of the team.
+ALTER TABLE "public"."team_s3_credentials"
+    ADD COLUMN IF NOT EXISTS "volume_id" TEXT,
+    ADD COLUMN IF NOT EXISTS "subpath"   TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "read_only" BOOLEAN NOT NULL DEFAULT false,
+    ADD CONSTRAINT "team_s3_credentials_volume_id_fkey" FOREIGN KEY ("volume_id") REFERENCES "public"."volumes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE "public"."team_s3_credentials"
+    DROP CONSTRAINT IF EXISTS "team_s3_credentials_volume_id_fkey",
+    DROP COLUMN IF EXISTS "read_only",
+    DROP COLUMN IF EXISTS "subpath",
+    DROP COLUMN IF EXISTS "volume_id";
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- name: CreateAuditLog :exec
+INSERT INTO "public"."audit_logs" (
+    team_id,
+    action,
+    actor_type,
+    actor_id,
+    volume_id,
+    path,
+    sandbox_id,
+    source_ip
+) VALUES (
+    @team_id,
+    @action,
+    @actor_type,
+    sqlc.narg(actor_id),
+    sqlc.narg(volume_id),
+    sqlc.narg(path),
+    sqlc.narg(sandbox_id),
+    sqlc.narg(source_ip)
+);
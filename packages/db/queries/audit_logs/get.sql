@@ -0,0 +1,12 @@
+-- name: ListAuditLogs :many
+-- Pages through the audit logs of the team from the newest, with the ID breaking ties. Null filters match all entries.
+SELECT * FROM "public"."audit_logs"
+WHERE team_id = @team_id
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action)::text)
+  AND (sqlc.narg(volume_id)::text IS NULL OR volume_id = sqlc.narg(volume_id)::text)
+  AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+  AND (created_at, id::text) < (@cursor_time, @cursor_id::text)
+ORDER BY created_at DESC, id DESC
+LIMIT @query_limit;
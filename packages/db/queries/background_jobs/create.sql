@@ -0,0 +1,16 @@
+-- name: EnqueueBackgroundJob :execrows
+-- Jobs with a unique key already enqueued under the kind are skipped
+INSERT INTO "public"."background_jobs" (
+    kind,
+    payload,
+    unique_key,
+    max_attempts,
+    run_after
+) VALUES (
+    @kind,
+    @payload,
+    @unique_key,
+    @max_attempts,
+    @run_after
+)
+ON CONFLICT (kind, unique_key) WHERE unique_key IS NOT NULL DO NOTHING;
@@ -0,0 +1,59 @@
+-- name: ClaimBackgroundJobs :many
+-- Leases due jobs of the kind, including running jobs whose lease expired because their instance stopped
+UPDATE "public"."background_jobs"
+SET state = 'running',
+    attempts = attempts + 1,
+    lease_owner = @lease_owner,
+    lease_expires_at = @lease_expires_at,
+    updated_at = NOW()
+WHERE id IN (
+    SELECT id FROM "public"."background_jobs"
+    WHERE kind = @kind
+      AND (
+        (state = 'queued' AND run_after <= NOW())
+        OR (state = 'running' AND lease_expires_at < NOW())
+      )
+    ORDER BY run_after ASC
+    LIMIT @max_jobs
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ExtendBackgroundJobLease :execrows
+UPDATE "public"."background_jobs"
+SET lease_expires_at = @lease_expires_at,
+    updated_at = NOW()
+WHERE id = @id AND lease_owner = @lease_owner AND state = 'running';
+
+-- name: CompleteBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'succeeded',
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = @id AND lease_owner = @lease_owner AND state = 'running';
+
+-- name: RetryBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'queued',
+    run_after = @run_after,
+    last_error = @last_error,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW()
+WHERE id = @id AND lease_owner = @lease_owner AND state = 'running';
+
+-- name: FailBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'failed',
+    last_error = @last_error,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = @id AND lease_owner = @lease_owner AND state = 'running';
+
+-- name: DeleteFinishedBackgroundJobs :execrows
+DELETE FROM "public"."background_jobs"
+WHERE state IN ('succeeded', 'failed') AND finished_at < @finished_before;
@@ -13,6 +13,76 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/db/types"
 )
 
+const allocateRedisDB = `-- name: AllocateRedisDB :one
+WITH reclaimed AS (
+    DELETE FROM "public"."volume_redis_dbs_free"
+    WHERE redis_db = (
+        SELECT redis_db FROM "public"."volume_redis_dbs_free"
+        ORDER BY redis_db
+        LIMIT 1
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING redis_db
+)
+SELECT COALESCE((SELECT redis_db FROM reclaimed), nextval('volumes_redis_db_seq')::int)::int AS redis_db
+`
+
+// Returns an unused database number of the volumes Redis for a new volume with Redis metadata,
+// the free databases of destroyed volumes are reused first
+func (q *Queries) AllocateRedisDB(ctx context.Context) (int32, error) {
+	row := q.db.QueryRow(ctx, allocateRedisDB)
+	var redis_db int32
+	err := row.Scan(&redis_db)
+	return redis_db, err
+}
+
+const createAuditLog = `-- name: CreateAuditLog :exec
+INSERT INTO "public"."audit_logs" (
+    team_id,
+    action,
+    actor_type,
+    actor_id,
+    volume_id,
+    path,
+    sandbox_id,
+    source_ip
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+`
+
+type CreateAuditLogParams struct {
+	TeamID    uuid.UUID
+	Action    string
+	ActorType string
+	ActorID   *uuid.UUID
+	VolumeID  *string
+	Path      *string
+	SandboxID *string
+	SourceIp  *string
+}
+
+func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error {
+	_, err := q.db.Exec(ctx, createAuditLog,
+		arg.TeamID,
+		arg.Action,
+		arg.ActorType,
+		arg.ActorID,
+		arg.VolumeID,
+		arg.Path,
+		arg.SandboxID,
+		arg.SourceIp,
+	)
+	return err
+}
+
 const createSandboxRun = `-- name: CreateSandboxRun :one
 INSERT INTO "public"."sandbox_runs" (
     sandbox_id,
@@ -75,25 +145,138 @@ func (q *Queries) CreateSandboxRun(ctx context.Context, arg CreateSandboxRunPara
 	return i, err
 }
 
+const createTeamS3Credential = `-- name: CreateTeamS3Credential :one
+INSERT INTO "public"."team_s3_credentials" (
+    access_key_id,
+    team_id,
+    secret_encrypted,
+    volume_id,
+    subpath,
+    read_only
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+RETURNING access_key_id, team_id, secret_encrypted, created_at, last_used, volume_id, subpath, read_only
+`
+
+type CreateTeamS3CredentialParams struct {
+	AccessKeyID     string
+	TeamID          uuid.UUID
+	SecretEncrypted []byte
+	VolumeID        *string
+	Subpath         string
+	ReadOnly        bool
+}
+
+func (q *Queries) CreateTeamS3Credential(ctx context.Context, arg CreateTeamS3CredentialParams) (TeamS3Credential, error) {
+	row := q.db.QueryRow(ctx, createTeamS3Credential,
+		arg.AccessKeyID,
+		arg.TeamID,
+		arg.SecretEncrypted,
+		arg.VolumeID,
+		arg.Subpath,
+		arg.ReadOnly,
+	)
+	var i TeamS3Credential
+	err := row.Scan(
+		&i.AccessKeyID,
+		&i.TeamID,
+		&i.SecretEncrypted,
+		&i.CreatedAt,
+		&i.LastUsed,
+		&i.VolumeID,
+		&i.Subpath,
+		&i.ReadOnly,
+	)
+	return i, err
+}
+
+const createTeamWebhook = `-- name: CreateTeamWebhook :one
+INSERT INTO "public"."team_webhooks" (
+    team_id,
+    url,
+    event_types,
+    secret_encrypted
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+RETURNING id, team_id, url, event_types, secret_encrypted, created_at
+`
+
+type CreateTeamWebhookParams struct {
+	TeamID          uuid.UUID
+	Url             string
+	EventTypes      []string
+	SecretEncrypted []byte
+}
+
+func (q *Queries) CreateTeamWebhook(ctx context.Context, arg CreateTeamWebhookParams) (TeamWebhook, error) {
+	row := q.db.QueryRow(ctx, createTeamWebhook,
+		arg.TeamID,
+		arg.Url,
+		arg.EventTypes,
+		arg.SecretEncrypted,
+	)
+	var i TeamWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Url,
+		&i.EventTypes,
+		&i.SecretEncrypted,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createVolume = `-- name: CreateVolume :one
 INSERT INTO "public"."volumes" (
     id,
     team_id,
     name,
-    status
+    status,
+    size_limit_bytes,
+    gcs_bucket,
+    deletion_protection,
+    metadata_engine,
+    redis_db,
+    mount_options,
+    ephemeral_sandbox_id
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
-) RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11
+) RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
 `
 
 type CreateVolumeParams struct {
-	ID     string
-	TeamID uuid.UUID
-	Name   string
-	Status string
+	ID                 string
+	TeamID             uuid.UUID
+	Name               string
+	Status             string
+	SizeLimitBytes     *int64
+	GcsBucket          *string
+	DeletionProtection bool
+	MetadataEngine     string
+	RedisDb            *int32
+	MountOptions       types.JSONBStringMap
+	EphemeralSandboxID *string
 }
 
 func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Volume, error) {
@@ -102,6 +285,13 @@ func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Vol
 		arg.TeamID,
 		arg.Name,
 		arg.Status,
+		arg.SizeLimitBytes,
+		arg.GcsBucket,
+		arg.DeletionProtection,
+		arg.MetadataEngine,
+		arg.RedisDb,
+		arg.MountOptions,
+		arg.EphemeralSandboxID,
 	)
 	var i Volume
 	err := row.Scan(
@@ -113,6 +303,387 @@ func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Vol
 		&i.TotalFileCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const createVolumeAttachment = `-- name: CreateVolumeAttachment :execrows
+INSERT INTO "public"."volume_attachments" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    mounted_at,
+    subpath
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+ON CONFLICT (volume_id, sandbox_id) DO NOTHING
+`
+
+type CreateVolumeAttachmentParams struct {
+	VolumeID  string
+	SandboxID string
+	TeamID    uuid.UUID
+	MountPath string
+	ReadOnly  bool
+	MountedAt time.Time
+	Subpath   string
+}
+
+// Records the attachment before the volume is mounted, a recorded attachment of the volume in the sandbox is kept
+func (q *Queries) CreateVolumeAttachment(ctx context.Context, arg CreateVolumeAttachmentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createVolumeAttachment,
+		arg.VolumeID,
+		arg.SandboxID,
+		arg.TeamID,
+		arg.MountPath,
+		arg.ReadOnly,
+		arg.MountedAt,
+		arg.Subpath,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createVolumeOperation = `-- name: CreateVolumeOperation :one
+INSERT INTO "public"."volume_operations" (
+    id,
+    volume_id,
+    team_id,
+    type,
+    state,
+    started_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    CASE WHEN $5 = 'running' THEN NOW() END
+) RETURNING id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report
+`
+
+type CreateVolumeOperationParams struct {
+	ID       string
+	VolumeID string
+	TeamID   uuid.UUID
+	Type     string
+	State    string
+}
+
+func (q *Queries) CreateVolumeOperation(ctx context.Context, arg CreateVolumeOperationParams) (VolumeOperation, error) {
+	row := q.db.QueryRow(ctx, createVolumeOperation,
+		arg.ID,
+		arg.VolumeID,
+		arg.TeamID,
+		arg.Type,
+		arg.State,
+	)
+	var i VolumeOperation
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Type,
+		&i.State,
+		&i.Progress,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.FsckReport,
+	)
+	return i, err
+}
+
+const createVolumeRun = `-- name: CreateVolumeRun :exec
+INSERT INTO "public"."volume_runs" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    subpath,
+    mounted_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+ON CONFLICT (volume_id, sandbox_id, mounted_at) DO NOTHING
+`
+
+type CreateVolumeRunParams struct {
+	VolumeID  string
+	SandboxID string
+	TeamID    uuid.UUID
+	MountPath string
+	ReadOnly  bool
+	Subpath   string
+	MountedAt time.Time
+}
+
+// Events can be redelivered, a mount of the volume in the sandbox is recorded once
+func (q *Queries) CreateVolumeRun(ctx context.Context, arg CreateVolumeRunParams) error {
+	_, err := q.db.Exec(ctx, createVolumeRun,
+		arg.VolumeID,
+		arg.SandboxID,
+		arg.TeamID,
+		arg.MountPath,
+		arg.ReadOnly,
+		arg.Subpath,
+		arg.MountedAt,
+	)
+	return err
+}
+
+const createVolumeUpload = `-- name: CreateVolumeUpload :one
+INSERT INTO "public"."volume_uploads" (
+    id,
+    volume_id,
+    team_id,
+    path,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING id, volume_id, team_id, path, status, created_at, updated_at, expires_at
+`
+
+type CreateVolumeUploadParams struct {
+	ID        string
+	VolumeID  string
+	TeamID    uuid.UUID
+	Path      string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateVolumeUpload(ctx context.Context, arg CreateVolumeUploadParams) (VolumeUpload, error) {
+	row := q.db.QueryRow(ctx, createVolumeUpload,
+		arg.ID,
+		arg.VolumeID,
+		arg.TeamID,
+		arg.Path,
+		arg.ExpiresAt,
+	)
+	var i VolumeUpload
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Path,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const enqueueBackgroundJob = `-- name: EnqueueBackgroundJob :execrows
+INSERT INTO "public"."background_jobs" (
+    kind,
+    payload,
+    unique_key,
+    max_attempts,
+    run_after
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (kind, unique_key) WHERE unique_key IS NOT NULL DO NOTHING
+`
+
+type EnqueueBackgroundJobParams struct {
+	Kind        string
+	Payload     []byte
+	UniqueKey   *string
+	MaxAttempts int32
+	RunAfter    time.Time
+}
+
+// Jobs with a unique key already enqueued under the kind are skipped
+func (q *Queries) EnqueueBackgroundJob(ctx context.Context, arg EnqueueBackgroundJobParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueBackgroundJob,
+		arg.Kind,
+		arg.Payload,
+		arg.UniqueKey,
+		arg.MaxAttempts,
+		arg.RunAfter,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const freeRedisDB = `-- name: FreeRedisDB :exec
+INSERT INTO "public"."volume_redis_dbs_free" (redis_db)
+VALUES ($1)
+ON CONFLICT DO NOTHING
+`
+
+// Gives the database of the volumes Redis back for reuse, once no volume uses it and its metadata was deleted
+func (q *Queries) FreeRedisDB(ctx context.Context, redisDb int32) error {
+	_, err := q.db.Exec(ctx, freeRedisDB, redisDb)
+	return err
+}
+
+const upsertTeamSecret = `-- name: UpsertTeamSecret :one
+INSERT INTO "public"."team_secrets" (
+    team_id,
+    name,
+    value_encrypted
+) VALUES (
+    $1,
+    $2,
+    $3
+)
+ON CONFLICT (team_id, name) DO UPDATE
+SET value_encrypted = EXCLUDED.value_encrypted,
+    updated_at = NOW()
+RETURNING team_id, name, value_encrypted, created_at, updated_at
+`
+
+type UpsertTeamSecretParams struct {
+	TeamID         uuid.UUID
+	Name           string
+	ValueEncrypted []byte
+}
+
+func (q *Queries) UpsertTeamSecret(ctx context.Context, arg UpsertTeamSecretParams) (TeamSecret, error) {
+	row := q.db.QueryRow(ctx, upsertTeamSecret, arg.TeamID, arg.Name, arg.ValueEncrypted)
+	var i TeamSecret
+	err := row.Scan(
+		&i.TeamID,
+		&i.Name,
+		&i.ValueEncrypted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertVolumeAttachment = `-- name: UpsertVolumeAttachment :exec
+INSERT INTO "public"."volume_attachments" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    mounted_at,
+    subpath
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+ON CONFLICT (volume_id, sandbox_id) DO UPDATE
+SET mount_path = EXCLUDED.mount_path,
+    read_only = EXCLUDED.read_only,
+    mounted_at = EXCLUDED.mounted_at,
+    subpath = EXCLUDED.subpath
+WHERE volume_attachments.mounted_at <= EXCLUDED.mounted_at
+`
+
+type UpsertVolumeAttachmentParams struct {
+	VolumeID  string
+	SandboxID string
+	TeamID    uuid.UUID
+	MountPath string
+	ReadOnly  bool
+	MountedAt time.Time
+	Subpath   string
+}
+
+// Events can be redelivered, the latest mount of the volume in the sandbox wins
+func (q *Queries) UpsertVolumeAttachment(ctx context.Context, arg UpsertVolumeAttachmentParams) error {
+	_, err := q.db.Exec(ctx, upsertVolumeAttachment,
+		arg.VolumeID,
+		arg.SandboxID,
+		arg.TeamID,
+		arg.MountPath,
+		arg.ReadOnly,
+		arg.MountedAt,
+		arg.Subpath,
+	)
+	return err
+}
+
+const upsertVolumeUploadPart = `-- name: UpsertVolumeUploadPart :one
+INSERT INTO "public"."volume_upload_parts" (
+    upload_id,
+    part_number,
+    size,
+    checksum
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT (upload_id, part_number) DO UPDATE
+SET size = EXCLUDED.size,
+    checksum = EXCLUDED.checksum,
+    created_at = NOW()
+RETURNING upload_id, part_number, size, checksum, created_at
+`
+
+type UpsertVolumeUploadPartParams struct {
+	UploadID   string
+	PartNumber int32
+	Size       int64
+	Checksum   string
+}
+
+func (q *Queries) UpsertVolumeUploadPart(ctx context.Context, arg UpsertVolumeUploadPartParams) (VolumeUploadPart, error) {
+	row := q.db.QueryRow(ctx, upsertVolumeUploadPart,
+		arg.UploadID,
+		arg.PartNumber,
+		arg.Size,
+		arg.Checksum,
+	)
+	var i VolumeUploadPart
+	err := row.Scan(
+		&i.UploadID,
+		&i.PartNumber,
+		&i.Size,
+		&i.Checksum,
+		&i.CreatedAt,
 	)
 	return i, err
 }
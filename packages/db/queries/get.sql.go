@@ -12,6 +12,152 @@ import (
 	"github.com/google/uuid"
 )
 
+const getActiveVolumeOperation = `-- name: GetActiveVolumeOperation :one
+SELECT id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report FROM "public"."volume_operations"
+WHERE volume_id = $1
+  AND type = $2
+  AND state IN ('pending', 'running')
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetActiveVolumeOperationParams struct {
+	VolumeID string
+	Type     string
+}
+
+// Returns the latest operation of the type on the volume that didn't finish yet
+func (q *Queries) GetActiveVolumeOperation(ctx context.Context, arg GetActiveVolumeOperationParams) (VolumeOperation, error) {
+	row := q.db.QueryRow(ctx, getActiveVolumeOperation, arg.VolumeID, arg.Type)
+	var i VolumeOperation
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Type,
+		&i.State,
+		&i.Progress,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.FsckReport,
+	)
+	return i, err
+}
+
+const getExpiredPendingDeleteVolumes = `-- name: GetExpiredPendingDeleteVolumes :many
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
+WHERE status = 'pending_delete' AND delete_after <= $1
+ORDER BY delete_after ASC
+`
+
+func (q *Queries) GetExpiredPendingDeleteVolumes(ctx context.Context, now *time.Time) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getExpiredPendingDeleteVolumes, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Volume
+	for rows.Next() {
+		var i Volume
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Status,
+			&i.TotalSizeBytes,
+			&i.TotalFileCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRedisDBUsage = `-- name: GetRedisDBUsage :one
+SELECT
+    (SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM volumes_redis_db_seq)::int AS allocated,
+    (SELECT COUNT(*) FROM "public"."volumes" WHERE redis_db IS NOT NULL)::int AS used,
+    (SELECT COUNT(*) FROM "public"."volume_redis_dbs_free")::int AS free
+`
+
+type GetRedisDBUsageRow struct {
+	Allocated int32
+	Used      int32
+	Free      int32
+}
+
+// How the databases of the volumes Redis are used, the allocated ones neither used nor free were lost
+// by volumes destroyed before the databases were reused
+func (q *Queries) GetRedisDBUsage(ctx context.Context) (GetRedisDBUsageRow, error) {
+	row := q.db.QueryRow(ctx, getRedisDBUsage)
+	var i GetRedisDBUsageRow
+	err := row.Scan(&i.Allocated, &i.Used, &i.Free)
+	return i, err
+}
+
+const getSandboxEphemeralVolumes = `-- name: GetSandboxEphemeralVolumes :many
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
+WHERE ephemeral_sandbox_id = $1
+ORDER BY created_at ASC
+`
+
+// Returns the volumes created as the scratch space of the sandbox
+func (q *Queries) GetSandboxEphemeralVolumes(ctx context.Context, sandboxID *string) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getSandboxEphemeralVolumes, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Volume
+	for rows.Next() {
+		var i Volume
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Status,
+			&i.TotalSizeBytes,
+			&i.TotalFileCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSandboxRun = `-- name: GetSandboxRun :one
 SELECT id, sandbox_id, team_id, template_id, build_id, status, end_reason, created_at, updated_at, ended_at, timeout_at, metadata, volume_id, volume_mount_path FROM "public"."sandbox_runs"
 WHERE sandbox_id = $1
@@ -39,8 +185,129 @@ func (q *Queries) GetSandboxRun(ctx context.Context, sandboxID string) (SandboxR
 	return i, err
 }
 
+const getTeamSecretCanary = `-- name: GetTeamSecretCanary :one
+SELECT value_encrypted FROM "public"."team_secrets"
+LIMIT 1
+`
+
+// Returns any stored secret, to verify the encryption key decrypts the stored secrets
+func (q *Queries) GetTeamSecretCanary(ctx context.Context) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getTeamSecretCanary)
+	var value_encrypted []byte
+	err := row.Scan(&value_encrypted)
+	return value_encrypted, err
+}
+
+const getTeamSecretsByNames = `-- name: GetTeamSecretsByNames :many
+SELECT team_id, name, value_encrypted, created_at, updated_at FROM "public"."team_secrets"
+WHERE team_id = $1 AND name = ANY($2::text[])
+`
+
+type GetTeamSecretsByNamesParams struct {
+	TeamID uuid.UUID
+	Names  []string
+}
+
+func (q *Queries) GetTeamSecretsByNames(ctx context.Context, arg GetTeamSecretsByNamesParams) ([]TeamSecret, error) {
+	rows, err := q.db.Query(ctx, getTeamSecretsByNames, arg.TeamID, arg.Names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamSecret
+	for rows.Next() {
+		var i TeamSecret
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Name,
+			&i.ValueEncrypted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamStorageUsage = `-- name: GetTeamStorageUsage :one
+SELECT COALESCE(SUM(total_size_bytes), 0)::bigint AS used_bytes, COUNT(*) AS volume_count
+FROM "public"."volumes"
+WHERE team_id = $1
+`
+
+type GetTeamStorageUsageRow struct {
+	UsedBytes   int64
+	VolumeCount int64
+}
+
+// Sums the sizes last recorded for the volumes of the team
+func (q *Queries) GetTeamStorageUsage(ctx context.Context, teamID uuid.UUID) (GetTeamStorageUsageRow, error) {
+	row := q.db.QueryRow(ctx, getTeamStorageUsage, teamID)
+	var i GetTeamStorageUsageRow
+	err := row.Scan(&i.UsedBytes, &i.VolumeCount)
+	return i, err
+}
+
+const getTeamVolumesCreatedBefore = `-- name: GetTeamVolumesCreatedBefore :many
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
+WHERE team_id = $1
+  AND starts_with(name, $2::text)
+  AND created_at < $3
+ORDER BY created_at ASC
+`
+
+type GetTeamVolumesCreatedBeforeParams struct {
+	TeamID        uuid.UUID
+	NamePrefix    string
+	CreatedBefore time.Time
+}
+
+// Returns the volumes of the team with the name prefix created before the given time
+func (q *Queries) GetTeamVolumesCreatedBefore(ctx context.Context, arg GetTeamVolumesCreatedBeforeParams) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getTeamVolumesCreatedBefore, arg.TeamID, arg.NamePrefix, arg.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Volume
+	for rows.Next() {
+		var i Volume
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Status,
+			&i.TotalSizeBytes,
+			&i.TotalFileCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getVolume = `-- name: GetVolume :one
-SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at FROM "public"."volumes"
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
 WHERE id = $1
 `
 
@@ -56,12 +323,77 @@ func (q *Queries) GetVolume(ctx context.Context, id string) (Volume, error) {
 		&i.TotalFileCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
 	)
 	return i, err
 }
 
+const getVolumeBuckets = `-- name: GetVolumeBuckets :many
+SELECT DISTINCT gcs_bucket::text AS gcs_bucket FROM "public"."volumes"
+WHERE gcs_bucket IS NOT NULL
+`
+
+// The buckets of the volumes that don't keep their data in the shared volumes bucket
+func (q *Queries) GetVolumeBuckets(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, getVolumeBuckets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var gcs_bucket string
+		if err := rows.Scan(&gcs_bucket); err != nil {
+			return nil, err
+		}
+		items = append(items, gcs_bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVolumeBucketsByIDs = `-- name: GetVolumeBucketsByIDs :many
+SELECT id, gcs_bucket FROM "public"."volumes"
+WHERE id = ANY($1::text[])
+`
+
+type GetVolumeBucketsByIDsRow struct {
+	ID        string
+	GcsBucket *string
+}
+
+func (q *Queries) GetVolumeBucketsByIDs(ctx context.Context, ids []string) ([]GetVolumeBucketsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getVolumeBucketsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVolumeBucketsByIDsRow
+	for rows.Next() {
+		var i GetVolumeBucketsByIDsRow
+		if err := rows.Scan(&i.ID, &i.GcsBucket); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getVolumeByName = `-- name: GetVolumeByName :one
-SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at FROM "public"."volumes"
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
 WHERE team_id = $1 AND name = $2
 `
 
@@ -82,12 +414,93 @@ func (q *Queries) GetVolumeByName(ctx context.Context, arg GetVolumeByNameParams
 		&i.TotalFileCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const getVolumeOperation = `-- name: GetVolumeOperation :one
+SELECT id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report FROM "public"."volume_operations"
+WHERE id = $1
+`
+
+func (q *Queries) GetVolumeOperation(ctx context.Context, id string) (VolumeOperation, error) {
+	row := q.db.QueryRow(ctx, getVolumeOperation, id)
+	var i VolumeOperation
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Type,
+		&i.State,
+		&i.Progress,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.FsckReport,
+	)
+	return i, err
+}
+
+const getVolumeRedisDBs = `-- name: GetVolumeRedisDBs :many
+SELECT redis_db::int AS redis_db FROM "public"."volumes"
+WHERE redis_db = ANY($1::int[])
+`
+
+// The databases of the volumes Redis given to a volume, out of the given ones
+func (q *Queries) GetVolumeRedisDBs(ctx context.Context, redisDbs []int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getVolumeRedisDBs, redisDbs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var redis_db int32
+		if err := rows.Scan(&redis_db); err != nil {
+			return nil, err
+		}
+		items = append(items, redis_db)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVolumeUpload = `-- name: GetVolumeUpload :one
+SELECT id, volume_id, team_id, path, status, created_at, updated_at, expires_at FROM "public"."volume_uploads"
+WHERE id = $1
+`
+
+func (q *Queries) GetVolumeUpload(ctx context.Context, id string) (VolumeUpload, error) {
+	row := q.db.QueryRow(ctx, getVolumeUpload, id)
+	var i VolumeUpload
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Path,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const getVolumesByStatus = `-- name: GetVolumesByStatus :many
-SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at FROM "public"."volumes"
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
 WHERE status = $1
 ORDER BY created_at ASC
 `
@@ -110,6 +523,67 @@ func (q *Queries) GetVolumesByStatus(ctx context.Context, status string) ([]Volu
 			&i.TotalFileCount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVolumesToMigrate = `-- name: GetVolumesToMigrate :many
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id FROM "public"."volumes"
+WHERE status = 'available' AND metadata_engine = 'sqlite' AND format_version < $1
+ORDER BY format_version ASC, created_at ASC
+LIMIT $2
+`
+
+type GetVolumesToMigrateParams struct {
+	FormatVersion int32
+	QueryLimit    int32
+}
+
+// Returns the available volumes with SQLite metadata older than the format version, the oldest first.
+// The format version is stamped in the SQLite metadata, Redis volumes don't have one.
+func (q *Queries) GetVolumesToMigrate(ctx context.Context, arg GetVolumesToMigrateParams) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getVolumesToMigrate, arg.FormatVersion, arg.QueryLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Volume
+	for rows.Next() {
+		var i Volume
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Status,
+			&i.TotalSizeBytes,
+			&i.TotalFileCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
 		); err != nil {
 			return nil, err
 		}
@@ -137,6 +611,73 @@ func (q *Queries) IsVolumeAttached(ctx context.Context, volumeID *string) (bool,
 	return is_attached, err
 }
 
+const listAuditLogs = `-- name: ListAuditLogs :many
+SELECT id, team_id, action, actor_type, actor_id, volume_id, path, sandbox_id, source_ip, created_at FROM "public"."audit_logs"
+WHERE team_id = $1
+  AND ($2::text IS NULL OR action = $2::text)
+  AND ($3::text IS NULL OR volume_id = $3::text)
+  AND ($4::uuid IS NULL OR actor_id = $4::uuid)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND (created_at, id::text) < ($7, $8::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type ListAuditLogsParams struct {
+	TeamID     uuid.UUID
+	Action     *string
+	VolumeID   *string
+	ActorID    *uuid.UUID
+	Since      *time.Time
+	Until      *time.Time
+	CursorTime time.Time
+	CursorID   string
+	QueryLimit int32
+}
+
+// Pages through the audit logs of the team from the newest, with the ID breaking ties. Null filters match all entries.
+func (q *Queries) ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLogs,
+		arg.TeamID,
+		arg.Action,
+		arg.VolumeID,
+		arg.ActorID,
+		arg.Since,
+		arg.Until,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.QueryLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Action,
+			&i.ActorType,
+			&i.ActorID,
+			&i.VolumeID,
+			&i.Path,
+			&i.SandboxID,
+			&i.SourceIp,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSandboxRuns = `-- name: ListSandboxRuns :many
 SELECT
     sr.sandbox_id,
@@ -205,23 +746,314 @@ func (q *Queries) ListSandboxRuns(ctx context.Context, arg ListSandboxRunsParams
 	return items, nil
 }
 
+const listTeamS3Credentials = `-- name: ListTeamS3Credentials :many
+SELECT access_key_id, team_id, secret_encrypted, created_at, last_used, volume_id, subpath, read_only FROM "public"."team_s3_credentials"
+WHERE team_id = $1
+ORDER BY created_at ASC, access_key_id ASC
+`
+
+func (q *Queries) ListTeamS3Credentials(ctx context.Context, teamID uuid.UUID) ([]TeamS3Credential, error) {
+	rows, err := q.db.Query(ctx, listTeamS3Credentials, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamS3Credential
+	for rows.Next() {
+		var i TeamS3Credential
+		if err := rows.Scan(
+			&i.AccessKeyID,
+			&i.TeamID,
+			&i.SecretEncrypted,
+			&i.CreatedAt,
+			&i.LastUsed,
+			&i.VolumeID,
+			&i.Subpath,
+			&i.ReadOnly,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamSecrets = `-- name: ListTeamSecrets :many
+SELECT team_id, name, value_encrypted, created_at, updated_at FROM "public"."team_secrets"
+WHERE team_id = $1
+ORDER BY name ASC
+`
+
+func (q *Queries) ListTeamSecrets(ctx context.Context, teamID uuid.UUID) ([]TeamSecret, error) {
+	rows, err := q.db.Query(ctx, listTeamSecrets, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamSecret
+	for rows.Next() {
+		var i TeamSecret
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Name,
+			&i.ValueEncrypted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamWebhooks = `-- name: ListTeamWebhooks :many
+SELECT id, team_id, url, event_types, secret_encrypted, created_at FROM "public"."team_webhooks"
+WHERE team_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListTeamWebhooks(ctx context.Context, teamID uuid.UUID) ([]TeamWebhook, error) {
+	rows, err := q.db.Query(ctx, listTeamWebhooks, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamWebhook
+	for rows.Next() {
+		var i TeamWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Url,
+			&i.EventTypes,
+			&i.SecretEncrypted,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVolumeAttachments = `-- name: ListVolumeAttachments :many
+SELECT volume_id, sandbox_id, team_id, mount_path, read_only, mounted_at, subpath FROM "public"."volume_attachments"
+WHERE volume_id = $1
+ORDER BY mounted_at ASC, sandbox_id ASC
+`
+
+func (q *Queries) ListVolumeAttachments(ctx context.Context, volumeID string) ([]VolumeAttachment, error) {
+	rows, err := q.db.Query(ctx, listVolumeAttachments, volumeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VolumeAttachment
+	for rows.Next() {
+		var i VolumeAttachment
+		if err := rows.Scan(
+			&i.VolumeID,
+			&i.SandboxID,
+			&i.TeamID,
+			&i.MountPath,
+			&i.ReadOnly,
+			&i.MountedAt,
+			&i.Subpath,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVolumeOperations = `-- name: ListVolumeOperations :many
+SELECT id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report FROM "public"."volume_operations"
+WHERE volume_id = $1
+  AND (created_at, id) < ($2, $3::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListVolumeOperationsParams struct {
+	VolumeID   string
+	CursorTime time.Time
+	CursorID   string
+	QueryLimit int32
+}
+
+func (q *Queries) ListVolumeOperations(ctx context.Context, arg ListVolumeOperationsParams) ([]VolumeOperation, error) {
+	rows, err := q.db.Query(ctx, listVolumeOperations,
+		arg.VolumeID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.QueryLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VolumeOperation
+	for rows.Next() {
+		var i VolumeOperation
+		if err := rows.Scan(
+			&i.ID,
+			&i.VolumeID,
+			&i.TeamID,
+			&i.Type,
+			&i.State,
+			&i.Progress,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.FsckReport,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVolumeRuns = `-- name: ListVolumeRuns :many
+SELECT volume_id, sandbox_id, team_id, mount_path, read_only, subpath, mounted_at, detached_at FROM "public"."volume_runs"
+WHERE volume_id = $1
+  AND (mounted_at, sandbox_id) < ($2, $3::text)
+ORDER BY mounted_at DESC, sandbox_id DESC
+LIMIT $4
+`
+
+type ListVolumeRunsParams struct {
+	VolumeID   string
+	CursorTime time.Time
+	CursorID   string
+	QueryLimit int32
+}
+
+// Pages through the mounts of the volume, the latest first
+func (q *Queries) ListVolumeRuns(ctx context.Context, arg ListVolumeRunsParams) ([]VolumeRun, error) {
+	rows, err := q.db.Query(ctx, listVolumeRuns,
+		arg.VolumeID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.QueryLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VolumeRun
+	for rows.Next() {
+		var i VolumeRun
+		if err := rows.Scan(
+			&i.VolumeID,
+			&i.SandboxID,
+			&i.TeamID,
+			&i.MountPath,
+			&i.ReadOnly,
+			&i.Subpath,
+			&i.MountedAt,
+			&i.DetachedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVolumeUploadParts = `-- name: ListVolumeUploadParts :many
+SELECT upload_id, part_number, size, checksum, created_at FROM "public"."volume_upload_parts"
+WHERE upload_id = $1
+ORDER BY part_number ASC
+`
+
+func (q *Queries) ListVolumeUploadParts(ctx context.Context, uploadID string) ([]VolumeUploadPart, error) {
+	rows, err := q.db.Query(ctx, listVolumeUploadParts, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VolumeUploadPart
+	for rows.Next() {
+		var i VolumeUploadPart
+		if err := rows.Scan(
+			&i.UploadID,
+			&i.PartNumber,
+			&i.Size,
+			&i.Checksum,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listVolumes = `-- name: ListVolumes :many
-SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at
+SELECT id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
 FROM "public"."volumes"
 WHERE team_id = $1
   AND ($2::text[] IS NULL OR status = ANY($2::text[]))
-ORDER BY created_at DESC
-LIMIT $3
+  AND starts_with(name, $3::text)
+  AND (
+    ($4::boolean AND (created_at, id) > ($5, $6::text))
+    OR (NOT $4::boolean AND (created_at, id) < ($5, $6::text))
+  )
+ORDER BY
+  CASE WHEN $4::boolean THEN created_at END ASC,
+  CASE WHEN $4::boolean THEN id END ASC,
+  CASE WHEN NOT $4::boolean THEN created_at END DESC,
+  CASE WHEN NOT $4::boolean THEN id END DESC
+LIMIT $7
 `
 
 type ListVolumesParams struct {
 	TeamID     uuid.UUID
 	Status     []string
+	NamePrefix string
+	Ascending  bool
+	CursorTime time.Time
+	CursorID   string
 	QueryLimit int32
 }
 
+// Pages through the volumes of the team by creation time, with the ID breaking ties
 func (q *Queries) ListVolumes(ctx context.Context, arg ListVolumesParams) ([]Volume, error) {
-	rows, err := q.db.Query(ctx, listVolumes, arg.TeamID, arg.Status, arg.QueryLimit)
+	rows, err := q.db.Query(ctx, listVolumes,
+		arg.TeamID,
+		arg.Status,
+		arg.NamePrefix,
+		arg.Ascending,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.QueryLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +1070,15 @@ func (q *Queries) ListVolumes(ctx context.Context, arg ListVolumesParams) ([]Vol
 			&i.TotalFileCount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SizeLimitBytes,
+			&i.GcsBucket,
+			&i.DeleteAfter,
+			&i.FormatVersion,
+			&i.DeletionProtection,
+			&i.MetadataEngine,
+			&i.RedisDb,
+			&i.MountOptions,
+			&i.EphemeralSandboxID,
 		); err != nil {
 			return nil, err
 		}
@@ -41,11 +41,41 @@ type Addon struct {
 	IdempotencyKey                *string
 }
 
+type AuditLog struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	Action    string
+	ActorType string
+	ActorID   *uuid.UUID
+	VolumeID  *string
+	Path      *string
+	SandboxID *string
+	SourceIp  *string
+	CreatedAt time.Time
+}
+
 type AuthUser struct {
 	ID    uuid.UUID
 	Email string
 }
 
+type BackgroundJob struct {
+	ID             uuid.UUID
+	Kind           string
+	Payload        []byte
+	UniqueKey      *string
+	State          string
+	Attempts       int32
+	MaxAttempts    int32
+	RunAfter       time.Time
+	LeaseOwner     *string
+	LeaseExpiresAt *time.Time
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	FinishedAt     *time.Time
+}
+
 type Cluster struct {
 	ID                 uuid.UUID
 	Endpoint           string
@@ -102,6 +132,15 @@ type EnvBuild struct {
 	CpuFlags           []string
 }
 
+type EnvVolumeDefault struct {
+	EnvID        string
+	MountOptions types.JSONBStringMap
+	UpdatedAt    time.Time
+	VolumeName   *string
+	MountPath    *string
+	ReadOnly     bool
+}
+
 type SandboxRun struct {
 	ID              uuid.UUID
 	SandboxID       string
@@ -164,13 +203,44 @@ type TeamApiKey struct {
 }
 
 type TeamLimit struct {
-	ID                       uuid.UUID
-	MaxLengthHours           int64
-	ConcurrentSandboxes      int32
-	ConcurrentTemplateBuilds int32
-	MaxVcpu                  int32
-	MaxRamMb                 int32
-	DiskMb                   int32
+	ID                            uuid.UUID
+	MaxLengthHours                int64
+	ConcurrentSandboxes           int32
+	ConcurrentTemplateBuilds      int32
+	MaxVcpu                       int32
+	MaxRamMb                      int32
+	DiskMb                        int32
+	MaxStorageBytes               *int64
+	VolumeBandwidthBytesPerSecond *int64
+	VolumeRequestsPerSecond       *int64
+}
+
+type TeamS3Credential struct {
+	AccessKeyID     string
+	TeamID          uuid.UUID
+	SecretEncrypted []byte
+	CreatedAt       time.Time
+	LastUsed        *time.Time
+	VolumeID        *string
+	Subpath         string
+	ReadOnly        bool
+}
+
+type TeamSecret struct {
+	TeamID         uuid.UUID
+	Name           string
+	ValueEncrypted []byte
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type TeamWebhook struct {
+	ID              uuid.UUID
+	TeamID          uuid.UUID
+	Url             string
+	EventTypes      []string
+	SecretEncrypted []byte
+	CreatedAt       time.Time
 }
 
 type Tier struct {
@@ -183,7 +253,10 @@ type Tier struct {
 	MaxVcpu             int64
 	MaxRamMb            int64
 	// The number of concurrent template builds the team can run
-	ConcurrentTemplateBuilds int64
+	ConcurrentTemplateBuilds      int64
+	MaxStorageBytes               *int64
+	VolumeBandwidthBytesPerSecond *int64
+	VolumeRequestsPerSecond       *int64
 }
 
 type User struct {
@@ -203,12 +276,81 @@ type UsersTeam struct {
 }
 
 type Volume struct {
-	ID             string
-	TeamID         uuid.UUID
-	Name           string
-	Status         string
-	TotalSizeBytes *int64
-	TotalFileCount *int64
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                 string
+	TeamID             uuid.UUID
+	Name               string
+	Status             string
+	TotalSizeBytes     *int64
+	TotalFileCount     *int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	SizeLimitBytes     *int64
+	GcsBucket          *string
+	DeleteAfter        *time.Time
+	FormatVersion      int32
+	DeletionProtection bool
+	MetadataEngine     string
+	RedisDb            *int32
+	MountOptions       types.JSONBStringMap
+	EphemeralSandboxID *string
+}
+
+type VolumeAttachment struct {
+	VolumeID  string
+	SandboxID string
+	TeamID    uuid.UUID
+	MountPath string
+	ReadOnly  bool
+	MountedAt time.Time
+	Subpath   string
+}
+
+type VolumeOperation struct {
+	ID         string
+	VolumeID   string
+	TeamID     uuid.UUID
+	Type       string
+	State      string
+	Progress   int32
+	Error      *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	FsckReport *types.VolumeFsckReport
+}
+
+type VolumeRedisDbsFree struct {
+	RedisDb int32
+	FreedAt time.Time
+}
+
+type VolumeRun struct {
+	VolumeID   string
+	SandboxID  string
+	TeamID     uuid.UUID
+	MountPath  string
+	ReadOnly   bool
+	Subpath    string
+	MountedAt  time.Time
+	DetachedAt *time.Time
+}
+
+type VolumeUpload struct {
+	ID        string
+	VolumeID  string
+	TeamID    uuid.UUID
+	Path      string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type VolumeUploadPart struct {
+	UploadID   string
+	PartNumber int32
+	Size       int64
+	Checksum   string
+	CreatedAt  time.Time
 }
@@ -20,3 +20,11 @@ SET
     ended_at = NOW(),
     updated_at = NOW()
 WHERE sandbox_id = @sandbox_id;
+
+-- name: UpdateSandboxRunVolume :exec
+UPDATE "public"."sandbox_runs"
+SET
+    volume_id = @volume_id,
+    volume_mount_path = @volume_mount_path,
+    updated_at = NOW()
+WHERE sandbox_id = @sandbox_id;
@@ -0,0 +1,17 @@
+-- name: CreateTeamS3Credential :one
+INSERT INTO "public"."team_s3_credentials" (
+    access_key_id,
+    team_id,
+    secret_encrypted,
+    volume_id,
+    subpath,
+    read_only
+) VALUES (
+    @access_key_id,
+    @team_id,
+    @secret_encrypted,
+    sqlc.narg(volume_id),
+    @subpath,
+    @read_only
+)
+RETURNING *;
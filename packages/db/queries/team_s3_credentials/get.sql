@@ -0,0 +1,4 @@
+-- name: ListTeamS3Credentials :many
+SELECT * FROM "public"."team_s3_credentials"
+WHERE team_id = @team_id
+ORDER BY created_at ASC, access_key_id ASC;
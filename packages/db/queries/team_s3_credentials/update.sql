@@ -0,0 +1,3 @@
+-- name: DeleteTeamS3Credential :execrows
+DELETE FROM "public"."team_s3_credentials"
+WHERE team_id = @team_id AND access_key_id = @access_key_id;
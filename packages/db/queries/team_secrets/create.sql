@@ -0,0 +1,14 @@
+-- name: UpsertTeamSecret :one
+INSERT INTO "public"."team_secrets" (
+    team_id,
+    name,
+    value_encrypted
+) VALUES (
+    @team_id,
+    @name,
+    @value_encrypted
+)
+ON CONFLICT (team_id, name) DO UPDATE
+SET value_encrypted = EXCLUDED.value_encrypted,
+    updated_at = NOW()
+RETURNING *;
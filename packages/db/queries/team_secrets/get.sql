@@ -0,0 +1,13 @@
+-- name: ListTeamSecrets :many
+SELECT * FROM "public"."team_secrets"
+WHERE team_id = @team_id
+ORDER BY name ASC;
+
+-- name: GetTeamSecretsByNames :many
+SELECT * FROM "public"."team_secrets"
+WHERE team_id = @team_id AND name = ANY(@names::text[]);
+
+-- name: GetTeamSecretCanary :one
+-- Returns any stored secret, to verify the encryption key decrypts the stored secrets
+SELECT value_encrypted FROM "public"."team_secrets"
+LIMIT 1;
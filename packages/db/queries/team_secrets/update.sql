@@ -0,0 +1,3 @@
+-- name: DeleteTeamSecret :execrows
+DELETE FROM "public"."team_secrets"
+WHERE team_id = @team_id AND name = @name;
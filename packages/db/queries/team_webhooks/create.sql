@@ -0,0 +1,13 @@
+-- name: CreateTeamWebhook :one
+INSERT INTO "public"."team_webhooks" (
+    team_id,
+    url,
+    event_types,
+    secret_encrypted
+) VALUES (
+    @team_id,
+    @url,
+    @event_types,
+    @secret_encrypted
+)
+RETURNING *;
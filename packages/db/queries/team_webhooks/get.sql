@@ -0,0 +1,4 @@
+-- name: ListTeamWebhooks :many
+SELECT * FROM "public"."team_webhooks"
+WHERE team_id = @team_id
+ORDER BY created_at ASC, id ASC;
@@ -0,0 +1,3 @@
+-- name: DeleteTeamWebhook :execrows
+DELETE FROM "public"."team_webhooks"
+WHERE team_id = @team_id AND id = @id;
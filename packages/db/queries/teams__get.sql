@@ -19,4 +19,12 @@ FROM "public"."teams" t
 JOIN "public"."team_limits" tl on tl.id = t.id
 WHERE tak.team_id = t.id
   AND tak.api_key_hash = $1
-RETURNING sqlc.embed(t), sqlc.embed(tl);
\ No newline at end of file
+RETURNING sqlc.embed(t), sqlc.embed(tl), tak.id AS api_key_id;
+-- name: GetTeamWithTierByS3AccessKeyWithUpdateLastUsed :one
+UPDATE "public"."team_s3_credentials" tsc
+SET last_used = now()
+FROM "public"."teams" t
+JOIN "public"."team_limits" tl on tl.id = t.id
+WHERE tsc.team_id = t.id
+  AND tsc.access_key_id = $1
+RETURNING sqlc.embed(t), sqlc.embed(tl), tsc.secret_encrypted, tsc.volume_id, tsc.subpath, tsc.read_only;
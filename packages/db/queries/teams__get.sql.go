@@ -18,12 +18,13 @@ FROM "public"."teams" t
 JOIN "public"."team_limits" tl on tl.id = t.id
 WHERE tak.team_id = t.id
   AND tak.api_key_hash = $1
-RETURNING t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb
+RETURNING t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.max_storage_bytes, tl.volume_bandwidth_bytes_per_second, tl.volume_requests_per_second, tak.id AS api_key_id
 `
 
 type GetTeamWithTierByAPIKeyWithUpdateLastUsedRow struct {
 	Team      Team
 	TeamLimit TeamLimit
+	ApiKeyID  uuid.UUID
 }
 
 func (q *Queries) GetTeamWithTierByAPIKeyWithUpdateLastUsed(ctx context.Context, apiKeyHash string) (GetTeamWithTierByAPIKeyWithUpdateLastUsedRow, error) {
@@ -46,12 +47,66 @@ func (q *Queries) GetTeamWithTierByAPIKeyWithUpdateLastUsed(ctx context.Context,
 		&i.TeamLimit.MaxVcpu,
 		&i.TeamLimit.MaxRamMb,
 		&i.TeamLimit.DiskMb,
+		&i.TeamLimit.MaxStorageBytes,
+		&i.TeamLimit.VolumeBandwidthBytesPerSecond,
+		&i.TeamLimit.VolumeRequestsPerSecond,
+		&i.ApiKeyID,
+	)
+	return i, err
+}
+
+const getTeamWithTierByS3AccessKeyWithUpdateLastUsed = `-- name: GetTeamWithTierByS3AccessKeyWithUpdateLastUsed :one
+UPDATE "public"."team_s3_credentials" tsc
+SET last_used = now()
+FROM "public"."teams" t
+JOIN "public"."team_limits" tl on tl.id = t.id
+WHERE tsc.team_id = t.id
+  AND tsc.access_key_id = $1
+RETURNING t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.max_storage_bytes, tl.volume_bandwidth_bytes_per_second, tl.volume_requests_per_second, tsc.secret_encrypted, tsc.volume_id, tsc.subpath, tsc.read_only
+`
+
+type GetTeamWithTierByS3AccessKeyWithUpdateLastUsedRow struct {
+	Team            Team
+	TeamLimit       TeamLimit
+	SecretEncrypted []byte
+	VolumeID        *string
+	Subpath         string
+	ReadOnly        bool
+}
+
+func (q *Queries) GetTeamWithTierByS3AccessKeyWithUpdateLastUsed(ctx context.Context, accessKeyID string) (GetTeamWithTierByS3AccessKeyWithUpdateLastUsedRow, error) {
+	row := q.db.QueryRow(ctx, getTeamWithTierByS3AccessKeyWithUpdateLastUsed, accessKeyID)
+	var i GetTeamWithTierByS3AccessKeyWithUpdateLastUsedRow
+	err := row.Scan(
+		&i.Team.ID,
+		&i.Team.CreatedAt,
+		&i.Team.IsBlocked,
+		&i.Team.Name,
+		&i.Team.Tier,
+		&i.Team.Email,
+		&i.Team.IsBanned,
+		&i.Team.BlockedReason,
+		&i.Team.ClusterID,
+		&i.TeamLimit.ID,
+		&i.TeamLimit.MaxLengthHours,
+		&i.TeamLimit.ConcurrentSandboxes,
+		&i.TeamLimit.ConcurrentTemplateBuilds,
+		&i.TeamLimit.MaxVcpu,
+		&i.TeamLimit.MaxRamMb,
+		&i.TeamLimit.DiskMb,
+		&i.TeamLimit.MaxStorageBytes,
+		&i.TeamLimit.VolumeBandwidthBytesPerSecond,
+		&i.TeamLimit.VolumeRequestsPerSecond,
+		&i.SecretEncrypted,
+		&i.VolumeID,
+		&i.Subpath,
+		&i.ReadOnly,
 	)
 	return i, err
 }
 
 const getTeamWithTierByTeamAndUser = `-- name: GetTeamWithTierByTeamAndUser :one
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.max_storage_bytes, tl.volume_bandwidth_bytes_per_second, tl.volume_requests_per_second
 FROM "public"."teams" t
 JOIN "public"."users_teams" ut ON ut.team_id = t.id
 JOIN "public"."team_limits" tl on tl.id = t.id
@@ -88,12 +143,15 @@ func (q *Queries) GetTeamWithTierByTeamAndUser(ctx context.Context, arg GetTeamW
 		&i.TeamLimit.MaxVcpu,
 		&i.TeamLimit.MaxRamMb,
 		&i.TeamLimit.DiskMb,
+		&i.TeamLimit.MaxStorageBytes,
+		&i.TeamLimit.VolumeBandwidthBytesPerSecond,
+		&i.TeamLimit.VolumeRequestsPerSecond,
 	)
 	return i, err
 }
 
 const getTeamsWithUsersTeamsWithTier = `-- name: GetTeamsWithUsersTeamsWithTier :many
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, ut.id, ut.user_id, ut.team_id, ut.is_default, ut.added_by, ut.created_at, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, ut.id, ut.user_id, ut.team_id, ut.is_default, ut.added_by, ut.created_at, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.max_storage_bytes, tl.volume_bandwidth_bytes_per_second, tl.volume_requests_per_second
 FROM "public"."teams" t
 JOIN "public"."users_teams" ut ON ut.team_id = t.id
 JOIN "public"."team_limits" tl on tl.id = t.id
@@ -138,6 +196,9 @@ func (q *Queries) GetTeamsWithUsersTeamsWithTier(ctx context.Context, userID uui
 			&i.TeamLimit.MaxVcpu,
 			&i.TeamLimit.MaxRamMb,
 			&i.TeamLimit.DiskMb,
+			&i.TeamLimit.MaxStorageBytes,
+			&i.TeamLimit.VolumeBandwidthBytesPerSecond,
+			&i.TeamLimit.VolumeRequestsPerSecond,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,30 @@
+-- name: GetEnvdUpdateCandidates :many
+-- Returns the latest uploaded build of the templates built with another envd version, the longest outdated first.
+-- Snapshots are skipped, as well as the templates with a build in progress or failed since the latest uploaded one.
+SELECT sqlc.embed(e), sqlc.embed(eb)
+FROM public.envs AS e
+JOIN LATERAL (
+    SELECT b.*
+    FROM public.env_builds AS b
+    WHERE b.env_id = e.id AND b.status = 'uploaded'
+    ORDER BY b.finished_at DESC
+    LIMIT 1
+) eb ON TRUE
+WHERE eb.envd_version IS DISTINCT FROM @envd_version::text
+  AND NOT EXISTS (
+    SELECT 1
+    FROM public.snapshots AS s
+    WHERE s.env_id = e.id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM public.env_builds AS b
+    WHERE b.env_id = e.id AND b.status IN ('waiting', 'building', 'snapshotting')
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM public.env_builds AS b
+    WHERE b.env_id = e.id AND b.status = 'failed' AND b.created_at > eb.finished_at
+  )
+ORDER BY eb.finished_at ASC
+LIMIT @query_limit;
@@ -0,0 +1,27 @@
+-- name: GetTemplateVolumeMountOptions :one
+-- Returns the default mount options of the volumes attached to the sandboxes of the template
+SELECT mount_options FROM "public"."env_volume_defaults"
+WHERE env_id = @template_id;
+
+-- name: SetTemplateVolumeMountOptions :exec
+-- Sets the default mount options of the volumes of the template, empty options remove the defaults
+INSERT INTO "public"."env_volume_defaults" (env_id, mount_options)
+VALUES (@template_id, sqlc.narg(mount_options))
+ON CONFLICT (env_id) DO UPDATE
+SET mount_options = EXCLUDED.mount_options,
+    updated_at = NOW();
+
+-- name: GetTemplateDefaultVolume :one
+-- Returns the volume attached to the sandboxes of the template when the request doesn't attach one
+SELECT volume_name, mount_path, read_only FROM "public"."env_volume_defaults"
+WHERE env_id = @template_id AND volume_name IS NOT NULL;
+
+-- name: SetTemplateDefaultVolume :exec
+-- Sets the default volume of the sandboxes of the template, a null name removes it
+INSERT INTO "public"."env_volume_defaults" (env_id, volume_name, mount_path, read_only)
+VALUES (@template_id, sqlc.narg(volume_name), sqlc.narg(mount_path), @read_only)
+ON CONFLICT (env_id) DO UPDATE
+SET volume_name = EXCLUDED.volume_name,
+    mount_path = EXCLUDED.mount_path,
+    read_only = EXCLUDED.read_only,
+    updated_at = NOW();
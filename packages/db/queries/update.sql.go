@@ -8,7 +8,201 @@ package queries
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/db/types"
+)
+
+const claimBackgroundJobs = `-- name: ClaimBackgroundJobs :many
+UPDATE "public"."background_jobs"
+SET state = 'running',
+    attempts = attempts + 1,
+    lease_owner = $1,
+    lease_expires_at = $2,
+    updated_at = NOW()
+WHERE id IN (
+    SELECT id FROM "public"."background_jobs"
+    WHERE kind = $3
+      AND (
+        (state = 'queued' AND run_after <= NOW())
+        OR (state = 'running' AND lease_expires_at < NOW())
+      )
+    ORDER BY run_after ASC
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
 )
+RETURNING id, kind, payload, unique_key, state, attempts, max_attempts, run_after, lease_owner, lease_expires_at, last_error, created_at, updated_at, finished_at
+`
+
+type ClaimBackgroundJobsParams struct {
+	LeaseOwner     *string
+	LeaseExpiresAt *time.Time
+	Kind           string
+	MaxJobs        int32
+}
+
+// Leases due jobs of the kind, including running jobs whose lease expired because their instance stopped
+func (q *Queries) ClaimBackgroundJobs(ctx context.Context, arg ClaimBackgroundJobsParams) ([]BackgroundJob, error) {
+	rows, err := q.db.Query(ctx, claimBackgroundJobs,
+		arg.LeaseOwner,
+		arg.LeaseExpiresAt,
+		arg.Kind,
+		arg.MaxJobs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BackgroundJob
+	for rows.Next() {
+		var i BackgroundJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.UniqueKey,
+			&i.State,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.RunAfter,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimPendingDeleteVolume = `-- name: ClaimPendingDeleteVolume :one
+UPDATE "public"."volumes"
+SET status = 'deleting',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending_delete'
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+// Starts destroying a volume in the trash, only one caller claims it
+func (q *Queries) ClaimPendingDeleteVolume(ctx context.Context, id string) (Volume, error) {
+	row := q.db.QueryRow(ctx, claimPendingDeleteVolume, id)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const completeBackgroundJob = `-- name: CompleteBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'succeeded',
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = $1 AND lease_owner = $2 AND state = 'running'
+`
+
+type CompleteBackgroundJobParams struct {
+	ID         uuid.UUID
+	LeaseOwner *string
+}
+
+func (q *Queries) CompleteBackgroundJob(ctx context.Context, arg CompleteBackgroundJobParams) error {
+	_, err := q.db.Exec(ctx, completeBackgroundJob, arg.ID, arg.LeaseOwner)
+	return err
+}
+
+const deleteFinishedBackgroundJobs = `-- name: DeleteFinishedBackgroundJobs :execrows
+DELETE FROM "public"."background_jobs"
+WHERE state IN ('succeeded', 'failed') AND finished_at < $1
+`
+
+func (q *Queries) DeleteFinishedBackgroundJobs(ctx context.Context, finishedBefore *time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFinishedBackgroundJobs, finishedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamS3Credential = `-- name: DeleteTeamS3Credential :execrows
+DELETE FROM "public"."team_s3_credentials"
+WHERE team_id = $1 AND access_key_id = $2
+`
+
+type DeleteTeamS3CredentialParams struct {
+	TeamID      uuid.UUID
+	AccessKeyID string
+}
+
+func (q *Queries) DeleteTeamS3Credential(ctx context.Context, arg DeleteTeamS3CredentialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamS3Credential, arg.TeamID, arg.AccessKeyID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamSecret = `-- name: DeleteTeamSecret :execrows
+DELETE FROM "public"."team_secrets"
+WHERE team_id = $1 AND name = $2
+`
+
+type DeleteTeamSecretParams struct {
+	TeamID uuid.UUID
+	Name   string
+}
+
+func (q *Queries) DeleteTeamSecret(ctx context.Context, arg DeleteTeamSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamSecret, arg.TeamID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamWebhook = `-- name: DeleteTeamWebhook :execrows
+DELETE FROM "public"."team_webhooks"
+WHERE team_id = $1 AND id = $2
+`
+
+type DeleteTeamWebhookParams struct {
+	TeamID uuid.UUID
+	ID     uuid.UUID
+}
+
+func (q *Queries) DeleteTeamWebhook(ctx context.Context, arg DeleteTeamWebhookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamWebhook, arg.TeamID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
 
 const deleteVolume = `-- name: DeleteVolume :exec
 DELETE FROM "public"."volumes"
@@ -20,6 +214,25 @@ func (q *Queries) DeleteVolume(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteVolumeAttachment = `-- name: DeleteVolumeAttachment :exec
+DELETE FROM "public"."volume_attachments"
+WHERE volume_id = $1
+  AND sandbox_id = $2
+  AND mounted_at <= $3
+`
+
+type DeleteVolumeAttachmentParams struct {
+	VolumeID   string
+	SandboxID  string
+	DetachedAt time.Time
+}
+
+// A detach older than the current mount is from an earlier mount of the volume in the sandbox
+func (q *Queries) DeleteVolumeAttachment(ctx context.Context, arg DeleteVolumeAttachmentParams) error {
+	_, err := q.db.Exec(ctx, deleteVolumeAttachment, arg.VolumeID, arg.SandboxID, arg.DetachedAt)
+	return err
+}
+
 const endSandboxRun = `-- name: EndSandboxRun :exec
 UPDATE "public"."sandbox_runs"
 SET
@@ -40,6 +253,353 @@ func (q *Queries) EndSandboxRun(ctx context.Context, arg EndSandboxRunParams) er
 	return err
 }
 
+const endVolumeRun = `-- name: EndVolumeRun :exec
+UPDATE "public"."volume_runs"
+SET detached_at = $1::timestamptz
+WHERE (volume_id, sandbox_id, mounted_at) = (
+    SELECT volume_id, sandbox_id, mounted_at FROM "public"."volume_runs"
+    WHERE volume_id = $2
+      AND sandbox_id = $3
+      AND mounted_at <= $1
+    ORDER BY mounted_at DESC
+    LIMIT 1
+)
+AND detached_at IS NULL
+`
+
+type EndVolumeRunParams struct {
+	DetachedAt time.Time
+	VolumeID   string
+	SandboxID  string
+}
+
+// Ends the latest mount of the volume in the sandbox started before the detach
+func (q *Queries) EndVolumeRun(ctx context.Context, arg EndVolumeRunParams) error {
+	_, err := q.db.Exec(ctx, endVolumeRun, arg.DetachedAt, arg.VolumeID, arg.SandboxID)
+	return err
+}
+
+const extendBackgroundJobLease = `-- name: ExtendBackgroundJobLease :execrows
+UPDATE "public"."background_jobs"
+SET lease_expires_at = $1,
+    updated_at = NOW()
+WHERE id = $2 AND lease_owner = $3 AND state = 'running'
+`
+
+type ExtendBackgroundJobLeaseParams struct {
+	LeaseExpiresAt *time.Time
+	ID             uuid.UUID
+	LeaseOwner     *string
+}
+
+func (q *Queries) ExtendBackgroundJobLease(ctx context.Context, arg ExtendBackgroundJobLeaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, extendBackgroundJobLease, arg.LeaseExpiresAt, arg.ID, arg.LeaseOwner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const failBackgroundJob = `-- name: FailBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'failed',
+    last_error = $1,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = $2 AND lease_owner = $3 AND state = 'running'
+`
+
+type FailBackgroundJobParams struct {
+	LastError  *string
+	ID         uuid.UUID
+	LeaseOwner *string
+}
+
+func (q *Queries) FailBackgroundJob(ctx context.Context, arg FailBackgroundJobParams) error {
+	_, err := q.db.Exec(ctx, failBackgroundJob, arg.LastError, arg.ID, arg.LeaseOwner)
+	return err
+}
+
+const finishVolumeCreation = `-- name: FinishVolumeCreation :one
+UPDATE "public"."volumes"
+SET status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND status = 'creating'
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+type FinishVolumeCreationParams struct {
+	Status string
+	ID     string
+}
+
+// Ends the creation of a volume as available or failed, unless it was deleted meanwhile
+func (q *Queries) FinishVolumeCreation(ctx context.Context, arg FinishVolumeCreationParams) (Volume, error) {
+	row := q.db.QueryRow(ctx, finishVolumeCreation, arg.Status, arg.ID)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const finishVolumeOperation = `-- name: FinishVolumeOperation :one
+UPDATE "public"."volume_operations"
+SET state = $1,
+    error = $2,
+    progress = CASE WHEN $1 = 'succeeded' THEN 100 ELSE progress END,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = $3 AND state IN ('pending', 'running')
+RETURNING id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report
+`
+
+type FinishVolumeOperationParams struct {
+	State string
+	Error *string
+	ID    string
+}
+
+// Moves an unfinished operation to its final state, a succeeded operation is complete
+func (q *Queries) FinishVolumeOperation(ctx context.Context, arg FinishVolumeOperationParams) (VolumeOperation, error) {
+	row := q.db.QueryRow(ctx, finishVolumeOperation, arg.State, arg.Error, arg.ID)
+	var i VolumeOperation
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Type,
+		&i.State,
+		&i.Progress,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.FsckReport,
+	)
+	return i, err
+}
+
+const markVolumePendingDelete = `-- name: MarkVolumePendingDelete :one
+UPDATE "public"."volumes"
+SET status = 'pending_delete',
+    delete_after = $1,
+    updated_at = NOW()
+WHERE id = $2 AND status = 'available'
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+type MarkVolumePendingDeleteParams struct {
+	DeleteAfter *time.Time
+	ID          string
+}
+
+// Moves an available volume to the trash until delete_after
+func (q *Queries) MarkVolumePendingDelete(ctx context.Context, arg MarkVolumePendingDeleteParams) (Volume, error) {
+	row := q.db.QueryRow(ctx, markVolumePendingDelete, arg.DeleteAfter, arg.ID)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const restoreVolume = `-- name: RestoreVolume :one
+UPDATE "public"."volumes"
+SET status = 'available',
+    delete_after = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending_delete'
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+// Takes a volume out of the trash
+func (q *Queries) RestoreVolume(ctx context.Context, id string) (Volume, error) {
+	row := q.db.QueryRow(ctx, restoreVolume, id)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const retryBackgroundJob = `-- name: RetryBackgroundJob :exec
+UPDATE "public"."background_jobs"
+SET state = 'queued',
+    run_after = $1,
+    last_error = $2,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = NOW()
+WHERE id = $3 AND lease_owner = $4 AND state = 'running'
+`
+
+type RetryBackgroundJobParams struct {
+	RunAfter   time.Time
+	LastError  *string
+	ID         uuid.UUID
+	LeaseOwner *string
+}
+
+func (q *Queries) RetryBackgroundJob(ctx context.Context, arg RetryBackgroundJobParams) error {
+	_, err := q.db.Exec(ctx, retryBackgroundJob,
+		arg.RunAfter,
+		arg.LastError,
+		arg.ID,
+		arg.LeaseOwner,
+	)
+	return err
+}
+
+const retryVolumeCreation = `-- name: RetryVolumeCreation :one
+UPDATE "public"."volumes"
+SET status = 'creating',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'failed'
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+// Creates a failed volume again
+func (q *Queries) RetryVolumeCreation(ctx context.Context, id string) (Volume, error) {
+	row := q.db.QueryRow(ctx, retryVolumeCreation, id)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const setVolumeOperationFsckReport = `-- name: SetVolumeOperationFsckReport :exec
+UPDATE "public"."volume_operations"
+SET fsck_report = $1,
+    updated_at = NOW()
+WHERE id = $2
+`
+
+type SetVolumeOperationFsckReportParams struct {
+	FsckReport *types.VolumeFsckReport
+	ID         string
+}
+
+func (q *Queries) SetVolumeOperationFsckReport(ctx context.Context, arg SetVolumeOperationFsckReportParams) error {
+	_, err := q.db.Exec(ctx, setVolumeOperationFsckReport, arg.FsckReport, arg.ID)
+	return err
+}
+
+const startVolumeOperation = `-- name: StartVolumeOperation :one
+UPDATE "public"."volume_operations"
+SET state = 'running',
+    started_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND state = 'pending'
+RETURNING id, volume_id, team_id, type, state, progress, error, created_at, updated_at, started_at, finished_at, fsck_report
+`
+
+func (q *Queries) StartVolumeOperation(ctx context.Context, id string) (VolumeOperation, error) {
+	row := q.db.QueryRow(ctx, startVolumeOperation, id)
+	var i VolumeOperation
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Type,
+		&i.State,
+		&i.Progress,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.FsckReport,
+	)
+	return i, err
+}
+
+const touchVolumeUpload = `-- name: TouchVolumeUpload :exec
+UPDATE "public"."volume_uploads"
+SET expires_at = $1,
+    updated_at = NOW()
+WHERE id = $2 AND status = 'uploading'
+`
+
+type TouchVolumeUploadParams struct {
+	ExpiresAt time.Time
+	ID        string
+}
+
+// Extends the expiration of an upload that is still receiving parts
+func (q *Queries) TouchVolumeUpload(ctx context.Context, arg TouchVolumeUploadParams) error {
+	_, err := q.db.Exec(ctx, touchVolumeUpload, arg.ExpiresAt, arg.ID)
+	return err
+}
+
 const updateSandboxRunStatus = `-- name: UpdateSandboxRunStatus :exec
 UPDATE "public"."sandbox_runs"
 SET
@@ -76,13 +636,147 @@ func (q *Queries) UpdateSandboxRunTimeout(ctx context.Context, arg UpdateSandbox
 	return err
 }
 
+const updateSandboxRunVolume = `-- name: UpdateSandboxRunVolume :exec
+UPDATE "public"."sandbox_runs"
+SET
+    volume_id = $1,
+    volume_mount_path = $2,
+    updated_at = NOW()
+WHERE sandbox_id = $3
+`
+
+type UpdateSandboxRunVolumeParams struct {
+	VolumeID        *string
+	VolumeMountPath *string
+	SandboxID       string
+}
+
+func (q *Queries) UpdateSandboxRunVolume(ctx context.Context, arg UpdateSandboxRunVolumeParams) error {
+	_, err := q.db.Exec(ctx, updateSandboxRunVolume, arg.VolumeID, arg.VolumeMountPath, arg.SandboxID)
+	return err
+}
+
+const updateVolumeDeletionProtection = `-- name: UpdateVolumeDeletionProtection :one
+UPDATE "public"."volumes"
+SET deletion_protection = $1,
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+type UpdateVolumeDeletionProtectionParams struct {
+	DeletionProtection bool
+	ID                 string
+}
+
+func (q *Queries) UpdateVolumeDeletionProtection(ctx context.Context, arg UpdateVolumeDeletionProtectionParams) (Volume, error) {
+	row := q.db.QueryRow(ctx, updateVolumeDeletionProtection, arg.DeletionProtection, arg.ID)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const updateVolumeFormatVersion = `-- name: UpdateVolumeFormatVersion :exec
+UPDATE "public"."volumes"
+SET format_version = $1,
+    updated_at = NOW()
+WHERE id = $2 AND format_version < $1
+`
+
+type UpdateVolumeFormatVersionParams struct {
+	FormatVersion int32
+	ID            string
+}
+
+// The format version only increases, the metadata is never downgraded
+func (q *Queries) UpdateVolumeFormatVersion(ctx context.Context, arg UpdateVolumeFormatVersionParams) error {
+	_, err := q.db.Exec(ctx, updateVolumeFormatVersion, arg.FormatVersion, arg.ID)
+	return err
+}
+
+const updateVolumeMetadataEngine = `-- name: UpdateVolumeMetadataEngine :one
+UPDATE "public"."volumes"
+SET metadata_engine = $1,
+    redis_db = $2,
+    updated_at = NOW()
+WHERE id = $3
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
+`
+
+type UpdateVolumeMetadataEngineParams struct {
+	MetadataEngine string
+	RedisDb        *int32
+	ID             string
+}
+
+// Moves the volume to the metadata engine its metadata was migrated to
+func (q *Queries) UpdateVolumeMetadataEngine(ctx context.Context, arg UpdateVolumeMetadataEngineParams) (Volume, error) {
+	row := q.db.QueryRow(ctx, updateVolumeMetadataEngine, arg.MetadataEngine, arg.RedisDb, arg.ID)
+	var i Volume
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.Status,
+		&i.TotalSizeBytes,
+		&i.TotalFileCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const updateVolumeOperationProgress = `-- name: UpdateVolumeOperationProgress :exec
+UPDATE "public"."volume_operations"
+SET progress = $1,
+    updated_at = NOW()
+WHERE id = $2 AND state = 'running'
+`
+
+type UpdateVolumeOperationProgressParams struct {
+	Progress int32
+	ID       string
+}
+
+func (q *Queries) UpdateVolumeOperationProgress(ctx context.Context, arg UpdateVolumeOperationProgressParams) error {
+	_, err := q.db.Exec(ctx, updateVolumeOperationProgress, arg.Progress, arg.ID)
+	return err
+}
+
 const updateVolumeStats = `-- name: UpdateVolumeStats :one
 UPDATE "public"."volumes"
 SET total_size_bytes = $1,
     total_file_count = $2,
     updated_at = NOW()
 WHERE id = $3
-RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
 `
 
 type UpdateVolumeStatsParams struct {
@@ -103,6 +797,15 @@ func (q *Queries) UpdateVolumeStats(ctx context.Context, arg UpdateVolumeStatsPa
 		&i.TotalFileCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
 	)
 	return i, err
 }
@@ -112,7 +815,7 @@ UPDATE "public"."volumes"
 SET status = $1,
     updated_at = NOW()
 WHERE id = $2
-RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at
+RETURNING id, team_id, name, status, total_size_bytes, total_file_count, created_at, updated_at, size_limit_bytes, gcs_bucket, delete_after, format_version, deletion_protection, metadata_engine, redis_db, mount_options, ephemeral_sandbox_id
 `
 
 type UpdateVolumeStatusParams struct {
@@ -132,6 +835,44 @@ func (q *Queries) UpdateVolumeStatus(ctx context.Context, arg UpdateVolumeStatus
 		&i.TotalFileCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SizeLimitBytes,
+		&i.GcsBucket,
+		&i.DeleteAfter,
+		&i.FormatVersion,
+		&i.DeletionProtection,
+		&i.MetadataEngine,
+		&i.RedisDb,
+		&i.MountOptions,
+		&i.EphemeralSandboxID,
+	)
+	return i, err
+}
+
+const updateVolumeUploadStatus = `-- name: UpdateVolumeUploadStatus :one
+UPDATE "public"."volume_uploads"
+SET status = $1,
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, volume_id, team_id, path, status, created_at, updated_at, expires_at
+`
+
+type UpdateVolumeUploadStatusParams struct {
+	Status string
+	ID     string
+}
+
+func (q *Queries) UpdateVolumeUploadStatus(ctx context.Context, arg UpdateVolumeUploadStatusParams) (VolumeUpload, error) {
+	row := q.db.QueryRow(ctx, updateVolumeUploadStatus, arg.Status, arg.ID)
+	var i VolumeUpload
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeID,
+		&i.TeamID,
+		&i.Path,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -0,0 +1,46 @@
+-- name: UpsertVolumeAttachment :exec
+-- Events can be redelivered, the latest mount of the volume in the sandbox wins
+INSERT INTO "public"."volume_attachments" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    mounted_at,
+    subpath
+) VALUES (
+    @volume_id,
+    @sandbox_id,
+    @team_id,
+    @mount_path,
+    @read_only,
+    @mounted_at,
+    @subpath
+)
+ON CONFLICT (volume_id, sandbox_id) DO UPDATE
+SET mount_path = EXCLUDED.mount_path,
+    read_only = EXCLUDED.read_only,
+    mounted_at = EXCLUDED.mounted_at,
+    subpath = EXCLUDED.subpath
+WHERE volume_attachments.mounted_at <= EXCLUDED.mounted_at;
+
+-- name: CreateVolumeAttachment :execrows
+-- Records the attachment before the volume is mounted, a recorded attachment of the volume in the sandbox is kept
+INSERT INTO "public"."volume_attachments" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    mounted_at,
+    subpath
+) VALUES (
+    @volume_id,
+    @sandbox_id,
+    @team_id,
+    @mount_path,
+    @read_only,
+    @mounted_at,
+    @subpath
+)
+ON CONFLICT (volume_id, sandbox_id) DO NOTHING;
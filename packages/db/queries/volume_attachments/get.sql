@@ -0,0 +1,4 @@
+-- name: ListVolumeAttachments :many
+SELECT * FROM "public"."volume_attachments"
+WHERE volume_id = @volume_id
+ORDER BY mounted_at ASC, sandbox_id ASC;
@@ -0,0 +1,6 @@
+-- name: DeleteVolumeAttachment :exec
+-- A detach older than the current mount is from an earlier mount of the volume in the sandbox
+DELETE FROM "public"."volume_attachments"
+WHERE volume_id = @volume_id
+  AND sandbox_id = @sandbox_id
+  AND mounted_at <= @detached_at;
@@ -0,0 +1,16 @@
+-- name: CreateVolumeOperation :one
+INSERT INTO "public"."volume_operations" (
+    id,
+    volume_id,
+    team_id,
+    type,
+    state,
+    started_at
+) VALUES (
+    @id,
+    @volume_id,
+    @team_id,
+    @type,
+    @state,
+    CASE WHEN @state = 'running' THEN NOW() END
+) RETURNING *;
@@ -0,0 +1,19 @@
+-- name: GetVolumeOperation :one
+SELECT * FROM "public"."volume_operations"
+WHERE id = @id;
+
+-- name: GetActiveVolumeOperation :one
+-- Returns the latest operation of the type on the volume that didn't finish yet
+SELECT * FROM "public"."volume_operations"
+WHERE volume_id = @volume_id
+  AND type = @type
+  AND state IN ('pending', 'running')
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListVolumeOperations :many
+SELECT * FROM "public"."volume_operations"
+WHERE volume_id = @volume_id
+  AND (created_at, id) < (@cursor_time, @cursor_id::text)
+ORDER BY created_at DESC, id DESC
+LIMIT @query_limit;
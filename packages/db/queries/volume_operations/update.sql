@@ -0,0 +1,30 @@
+-- name: StartVolumeOperation :one
+UPDATE "public"."volume_operations"
+SET state = 'running',
+    started_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id AND state = 'pending'
+RETURNING *;
+
+-- name: UpdateVolumeOperationProgress :exec
+UPDATE "public"."volume_operations"
+SET progress = @progress,
+    updated_at = NOW()
+WHERE id = @id AND state = 'running';
+
+-- name: FinishVolumeOperation :one
+-- Moves an unfinished operation to its final state, a succeeded operation is complete
+UPDATE "public"."volume_operations"
+SET state = @state,
+    error = @error,
+    progress = CASE WHEN @state = 'succeeded' THEN 100 ELSE progress END,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = @id AND state IN ('pending', 'running')
+RETURNING *;
+
+-- name: SetVolumeOperationFsckReport :exec
+UPDATE "public"."volume_operations"
+SET fsck_report = @fsck_report,
+    updated_at = NOW()
+WHERE id = @id;
@@ -0,0 +1,20 @@
+-- name: CreateVolumeRun :exec
+-- Events can be redelivered, a mount of the volume in the sandbox is recorded once
+INSERT INTO "public"."volume_runs" (
+    volume_id,
+    sandbox_id,
+    team_id,
+    mount_path,
+    read_only,
+    subpath,
+    mounted_at
+) VALUES (
+    @volume_id,
+    @sandbox_id,
+    @team_id,
+    @mount_path,
+    @read_only,
+    @subpath,
+    @mounted_at
+)
+ON CONFLICT (volume_id, sandbox_id, mounted_at) DO NOTHING;
@@ -0,0 +1,7 @@
+-- name: ListVolumeRuns :many
+-- Pages through the mounts of the volume, the latest first
+SELECT * FROM "public"."volume_runs"
+WHERE volume_id = @volume_id
+  AND (mounted_at, sandbox_id) < (@cursor_time, @cursor_id::text)
+ORDER BY mounted_at DESC, sandbox_id DESC
+LIMIT @query_limit;
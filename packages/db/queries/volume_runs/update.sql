@@ -0,0 +1,13 @@
+-- name: EndVolumeRun :exec
+-- Ends the latest mount of the volume in the sandbox started before the detach
+UPDATE "public"."volume_runs"
+SET detached_at = @detached_at::timestamptz
+WHERE (volume_id, sandbox_id, mounted_at) = (
+    SELECT volume_id, sandbox_id, mounted_at FROM "public"."volume_runs"
+    WHERE volume_id = @volume_id
+      AND sandbox_id = @sandbox_id
+      AND mounted_at <= @detached_at
+    ORDER BY mounted_at DESC
+    LIMIT 1
+)
+AND detached_at IS NULL;
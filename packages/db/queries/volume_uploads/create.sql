@@ -0,0 +1,32 @@
+-- name: CreateVolumeUpload :one
+INSERT INTO "public"."volume_uploads" (
+    id,
+    volume_id,
+    team_id,
+    path,
+    expires_at
+) VALUES (
+    @id,
+    @volume_id,
+    @team_id,
+    @path,
+    @expires_at
+) RETURNING *;
+
+-- name: UpsertVolumeUploadPart :one
+INSERT INTO "public"."volume_upload_parts" (
+    upload_id,
+    part_number,
+    size,
+    checksum
+) VALUES (
+    @upload_id,
+    @part_number,
+    @size,
+    @checksum
+)
+ON CONFLICT (upload_id, part_number) DO UPDATE
+SET size = EXCLUDED.size,
+    checksum = EXCLUDED.checksum,
+    created_at = NOW()
+RETURNING *;
@@ -0,0 +1,8 @@
+-- name: GetVolumeUpload :one
+SELECT * FROM "public"."volume_uploads"
+WHERE id = @id;
+
+-- name: ListVolumeUploadParts :many
+SELECT * FROM "public"."volume_upload_parts"
+WHERE upload_id = @upload_id
+ORDER BY part_number ASC;
@@ -0,0 +1,13 @@
+-- name: UpdateVolumeUploadStatus :one
+UPDATE "public"."volume_uploads"
+SET status = @status,
+    updated_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: TouchVolumeUpload :exec
+-- Extends the expiration of an upload that is still receiving parts
+UPDATE "public"."volume_uploads"
+SET expires_at = @expires_at,
+    updated_at = NOW()
+WHERE id = @id AND status = 'uploading';
@@ -3,10 +3,45 @@ INSERT INTO "public"."volumes" (
     id,
     team_id,
     name,
-    status
+    status,
+    size_limit_bytes,
+    gcs_bucket,
+    deletion_protection,
+    metadata_engine,
+    redis_db,
+    mount_options,
+    ephemeral_sandbox_id
 ) VALUES (
     @id,
     @team_id,
     @name,
-    @status
+    @status,
+    sqlc.narg(size_limit_bytes),
+    sqlc.narg(gcs_bucket),
+    @deletion_protection,
+    @metadata_engine,
+    sqlc.narg(redis_db),
+    sqlc.narg(mount_options),
+    sqlc.narg(ephemeral_sandbox_id)
 ) RETURNING *;
+
+-- name: AllocateRedisDB :one
+-- Returns an unused database number of the volumes Redis for a new volume with Redis metadata,
+-- the free databases of destroyed volumes are reused first
+WITH reclaimed AS (
+    DELETE FROM "public"."volume_redis_dbs_free"
+    WHERE redis_db = (
+        SELECT redis_db FROM "public"."volume_redis_dbs_free"
+        ORDER BY redis_db
+        LIMIT 1
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING redis_db
+)
+SELECT COALESCE((SELECT redis_db FROM reclaimed), nextval('volumes_redis_db_seq')::int)::int AS redis_db;
+
+-- name: FreeRedisDB :exec
+-- Gives the database of the volumes Redis back for reuse, once no volume uses it and its metadata was deleted
+INSERT INTO "public"."volume_redis_dbs_free" (redis_db)
+VALUES (@redis_db)
+ON CONFLICT DO NOTHING;
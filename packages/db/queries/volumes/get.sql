@@ -7,18 +7,47 @@ SELECT * FROM "public"."volumes"
 WHERE team_id = @team_id AND name = @name;
 
 -- name: ListVolumes :many
+-- Pages through the volumes of the team by creation time, with the ID breaking ties
 SELECT *
 FROM "public"."volumes"
 WHERE team_id = @team_id
   AND (@status::text[] IS NULL OR status = ANY(@status::text[]))
-ORDER BY created_at DESC
+  AND starts_with(name, @name_prefix::text)
+  AND (
+    (@ascending::boolean AND (created_at, id) > (@cursor_time, @cursor_id::text))
+    OR (NOT @ascending::boolean AND (created_at, id) < (@cursor_time, @cursor_id::text))
+  )
+ORDER BY
+  CASE WHEN @ascending::boolean THEN created_at END ASC,
+  CASE WHEN @ascending::boolean THEN id END ASC,
+  CASE WHEN NOT @ascending::boolean THEN created_at END DESC,
+  CASE WHEN NOT @ascending::boolean THEN id END DESC
 LIMIT @query_limit;
 
+-- name: GetTeamVolumesCreatedBefore :many
+-- Returns the volumes of the team with the name prefix created before the given time
+SELECT * FROM "public"."volumes"
+WHERE team_id = @team_id
+  AND starts_with(name, @name_prefix::text)
+  AND created_at < @created_before
+ORDER BY created_at ASC;
+
+-- name: GetExpiredPendingDeleteVolumes :many
+SELECT * FROM "public"."volumes"
+WHERE status = 'pending_delete' AND delete_after <= @now
+ORDER BY delete_after ASC;
+
 -- name: GetVolumesByStatus :many
 SELECT * FROM "public"."volumes"
 WHERE status = @status
 ORDER BY created_at ASC;
 
+-- name: GetSandboxEphemeralVolumes :many
+-- Returns the volumes created as the scratch space of the sandbox
+SELECT * FROM "public"."volumes"
+WHERE ephemeral_sandbox_id = @sandbox_id
+ORDER BY created_at ASC;
+
 -- name: IsVolumeAttached :one
 -- Returns true if the volume is currently attached to a running sandbox
 SELECT EXISTS (
@@ -26,3 +55,39 @@ SELECT EXISTS (
     WHERE volume_id = @volume_id
     AND status = 'running'
 ) AS is_attached;
+
+-- name: GetTeamStorageUsage :one
+-- Sums the sizes last recorded for the volumes of the team
+SELECT COALESCE(SUM(total_size_bytes), 0)::bigint AS used_bytes, COUNT(*) AS volume_count
+FROM "public"."volumes"
+WHERE team_id = @team_id;
+
+-- name: GetVolumesToMigrate :many
+-- Returns the available volumes with SQLite metadata older than the format version, the oldest first.
+-- The format version is stamped in the SQLite metadata, Redis volumes don't have one.
+SELECT * FROM "public"."volumes"
+WHERE status = 'available' AND metadata_engine = 'sqlite' AND format_version < @format_version
+ORDER BY format_version ASC, created_at ASC
+LIMIT @query_limit;
+
+-- name: GetVolumeBuckets :many
+-- The buckets of the volumes that don't keep their data in the shared volumes bucket
+SELECT DISTINCT gcs_bucket::text AS gcs_bucket FROM "public"."volumes"
+WHERE gcs_bucket IS NOT NULL;
+
+-- name: GetVolumeBucketsByIDs :many
+SELECT id, gcs_bucket FROM "public"."volumes"
+WHERE id = ANY(@ids::text[]);
+
+-- name: GetVolumeRedisDBs :many
+-- The databases of the volumes Redis given to a volume, out of the given ones
+SELECT redis_db::int AS redis_db FROM "public"."volumes"
+WHERE redis_db = ANY(@redis_dbs::int[]);
+
+-- name: GetRedisDBUsage :one
+-- How the databases of the volumes Redis are used, the allocated ones neither used nor free were lost
+-- by volumes destroyed before the databases were reused
+SELECT
+    (SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM volumes_redis_db_seq)::int AS allocated,
+    (SELECT COUNT(*) FROM "public"."volumes" WHERE redis_db IS NOT NULL)::int AS used,
+    (SELECT COUNT(*) FROM "public"."volume_redis_dbs_free")::int AS free;
@@ -16,3 +16,68 @@ RETURNING *;
 -- name: DeleteVolume :exec
 DELETE FROM "public"."volumes"
 WHERE id = @id;
+
+-- name: MarkVolumePendingDelete :one
+-- Moves an available volume to the trash until delete_after
+UPDATE "public"."volumes"
+SET status = 'pending_delete',
+    delete_after = @delete_after,
+    updated_at = NOW()
+WHERE id = @id AND status = 'available'
+RETURNING *;
+
+-- name: RestoreVolume :one
+-- Takes a volume out of the trash
+UPDATE "public"."volumes"
+SET status = 'available',
+    delete_after = NULL,
+    updated_at = NOW()
+WHERE id = @id AND status = 'pending_delete'
+RETURNING *;
+
+-- name: ClaimPendingDeleteVolume :one
+-- Starts destroying a volume in the trash, only one caller claims it
+UPDATE "public"."volumes"
+SET status = 'deleting',
+    updated_at = NOW()
+WHERE id = @id AND status = 'pending_delete'
+RETURNING *;
+
+-- name: UpdateVolumeFormatVersion :exec
+-- The format version only increases, the metadata is never downgraded
+UPDATE "public"."volumes"
+SET format_version = @format_version,
+    updated_at = NOW()
+WHERE id = @id AND format_version < @format_version;
+
+-- name: UpdateVolumeDeletionProtection :one
+UPDATE "public"."volumes"
+SET deletion_protection = @deletion_protection,
+    updated_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: UpdateVolumeMetadataEngine :one
+-- Moves the volume to the metadata engine its metadata was migrated to
+UPDATE "public"."volumes"
+SET metadata_engine = @metadata_engine,
+    redis_db = sqlc.narg(redis_db),
+    updated_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: FinishVolumeCreation :one
+-- Ends the creation of a volume as available or failed, unless it was deleted meanwhile
+UPDATE "public"."volumes"
+SET status = @status,
+    updated_at = NOW()
+WHERE id = @id AND status = 'creating'
+RETURNING *;
+
+-- name: RetryVolumeCreation :one
+-- Creates a failed volume again
+UPDATE "public"."volumes"
+SET status = 'creating',
+    updated_at = NOW()
+WHERE id = @id AND status = 'failed'
+RETURNING *;
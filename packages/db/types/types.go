@@ -42,8 +42,68 @@ type VolumeConfig struct {
 	// MountPath is the path where the volume should be mounted (e.g., "/workspace").
 	MountPath string `json:"mountPath"`
 
-	// RedisDB is the database number for JuiceFS metadata key prefix.
+	// Subpath is the directory of the volume mounted at the mount path, the whole volume when empty.
+	Subpath string `json:"subpath,omitempty"`
+
+	// MetadataEngine is where the JuiceFS metadata of the volume lives, SQLite when empty.
+	MetadataEngine string `json:"metadataEngine,omitempty"`
+
+	// RedisDB is the database of the volumes Redis with the JuiceFS metadata, for Redis metadata.
 	RedisDB int `json:"redisDb"`
+
+	// ReadOnlyRoot makes the template rootfs read-only inside the sandbox.
+	ReadOnlyRoot bool `json:"readOnlyRoot,omitempty"`
+
+	// OverlayPaths are sandbox paths whose contents are persisted on the volume (e.g., "/home").
+	OverlayPaths []string `json:"overlayPaths,omitempty"`
+
+	// ReadOnly mounts the volume read-only, so it can be shared by multiple sandboxes.
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// MountMemoryMB limits the memory of the volume processes in the sandbox, 0 uses the default.
+	MountMemoryMB int64 `json:"mountMemoryMb,omitempty"`
+
+	// MountCPUWeight is the relative CPU weight of the volume processes, 0 uses the default.
+	MountCPUWeight int64 `json:"mountCpuWeight,omitempty"`
+
+	// MountOptions are the JuiceFS options of the volume with the options of the attach applied over them.
+	MountOptions map[string]string `json:"mountOptions,omitempty"`
+
+	// PersistHome persists the home directory of the default user on the volume.
+	PersistHome bool `json:"persistHome,omitempty"`
+
+	// Prewarm are the glob patterns, relative to the mount path, of the files pulled into the cache on mount.
+	Prewarm []string `json:"prewarm,omitempty"`
+
+	// BandwidthBytesPerSecond and RequestsPerSecond limit the requests of the volume to the bucket, from
+	// the tier of the team. 0 uses the default of the orchestrator.
+	BandwidthBytesPerSecond int64 `json:"bandwidthBytesPerSecond,omitempty"`
+	RequestsPerSecond       int64 `json:"requestsPerSecond,omitempty"`
+
+	// GCSBucket is the bucket holding the volume data, empty for the shared volumes bucket.
+	GCSBucket string `json:"gcsBucket,omitempty"`
+
+	// AllowMountFailure starts the sandbox without the volume when it can't be mounted.
+	AllowMountFailure bool `json:"allowMountFailure,omitempty"`
+}
+
+// VolumeFsckReport holds the findings of a consistency check of a volume.
+type VolumeFsckReport struct {
+	// MetadataIssues is what the metadata check found, empty when the metadata is consistent.
+	MetadataIssues string `json:"metadataIssues,omitempty"`
+
+	// MissingObjects counts the blocks referenced by files that aren't in the bucket.
+	MissingObjects int64 `json:"missingObjects"`
+
+	// BrokenFiles are the paths of the files with missing objects, capped.
+	BrokenFiles []string `json:"brokenFiles,omitempty"`
+
+	// LeakedObjects and LeakedBytes count the chunk objects no file references.
+	LeakedObjects int64 `json:"leakedObjects"`
+	LeakedBytes   int64 `json:"leakedBytes"`
+
+	// Repaired reports the metadata was repaired and the leaked objects deleted.
+	Repaired bool `json:"repaired"`
 }
 
 // Status defines the type for the "status" enum field.
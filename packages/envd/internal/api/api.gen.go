@@ -23,6 +23,33 @@ const (
 	File EntryInfoType = "file"
 )
 
+// Defines values for ServiceStatusState.
+const (
+	ServiceStatusStateFailed           ServiceStatusState = "failed"
+	ServiceStatusStateRestarting       ServiceStatusState = "restarting"
+	ServiceStatusStateRunning          ServiceStatusState = "running"
+	ServiceStatusStateWaitingForVolume ServiceStatusState = "waiting_for_volume"
+)
+
+// Defines values for VolumeConfigMetaEngine.
+const (
+	Redis  VolumeConfigMetaEngine = "redis"
+	Sqlite VolumeConfigMetaEngine = "sqlite"
+)
+
+// Defines values for VolumeConfigStorageProvider.
+const (
+	Gcs VolumeConfigStorageProvider = "gcs"
+	S3  VolumeConfigStorageProvider = "s3"
+)
+
+// Defines values for VolumeReplicationState.
+const (
+	VolumeReplicationStateRestarting VolumeReplicationState = "restarting"
+	VolumeReplicationStateRunning    VolumeReplicationState = "running"
+	VolumeReplicationStateStopped    VolumeReplicationState = "stopped"
+)
+
 // EntryInfo defines model for EntryInfo.
 type EntryInfo struct {
 	// Name Name of the file
@@ -50,6 +77,21 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// InitResponse Acknowledgement of the configuration applied by the init request
+type InitResponse struct {
+	// Applied Request fields that were applied
+	Applied []string `json:"applied"`
+
+	// Ignored Request fields that were not recognized or were skipped
+	Ignored []string `json:"ignored"`
+
+	// VolumeMountError Why the volume couldn't be mounted, set when the sandbox was started without it
+	VolumeMountError *string `json:"volumeMountError,omitempty"`
+
+	// Warnings Non-fatal problems encountered while applying the configuration
+	Warnings []string `json:"warnings"`
+}
+
 // Metrics Resource usage metrics
 type Metrics struct {
 	// CpuCount Number of CPU cores
@@ -72,6 +114,229 @@ type Metrics struct {
 
 	// Ts Unix timestamp in UTC for current sandbox time
 	Ts *int64 `json:"ts,omitempty"`
+
+	// Volume Usage of the volume mounted in the sandbox
+	Volume *VolumeMetrics `json:"volume,omitempty"`
+}
+
+// Secrets Secret environment variables of the processes, they are not returned by the envs endpoint
+type Secrets map[string]string
+
+// ServiceStatus defines model for ServiceStatus.
+type ServiceStatus struct {
+	// Error Why the process last exited or failed to start
+	Error *string `json:"error,omitempty"`
+
+	// Name Name of the service, its process is tagged "service:<name>"
+	Name string `json:"name"`
+
+	// Pid Process ID of the running service
+	Pid *int64 `json:"pid,omitempty"`
+
+	// Restarts How many times the service was restarted since the volume was mounted
+	Restarts int `json:"restarts"`
+
+	// State State of the service
+	State ServiceStatusState `json:"state"`
+}
+
+// ServiceStatusState State of the service
+type ServiceStatusState string
+
+// ServicesStatus Status of the services the template declares to depend on the volume
+type ServicesStatus struct {
+	// MountPath Path the volume is mounted at
+	MountPath *string         `json:"mountPath,omitempty"`
+	Services  []ServiceStatus `json:"services"`
+
+	// VolumeMounted Whether the volume the services depend on is mounted
+	VolumeMounted bool `json:"volumeMounted"`
+}
+
+// VolumeCache Local disk cache of the JuiceFS mount, the counters are since the volume was mounted
+type VolumeCache struct {
+	// Blocks Number of cached blocks
+	Blocks int64 `json:"blocks"`
+
+	// Dir Directory of the cache
+	Dir string `json:"dir"`
+
+	// HitBytes Bytes read from the cache
+	HitBytes int64 `json:"hitBytes"`
+
+	// HitRatio Share of the reads served from the cache, unset before the first read
+	HitRatio *float64 `json:"hitRatio,omitempty"`
+
+	// Hits Reads served from the cache
+	Hits int64 `json:"hits"`
+
+	// MissBytes Bytes fetched from the bucket
+	MissBytes int64 `json:"missBytes"`
+
+	// Misses Reads fetched from the bucket
+	Misses int64 `json:"misses"`
+
+	// SizeLimit Size limit of the cache in bytes
+	SizeLimit int64 `json:"sizeLimit"`
+
+	// Used Bytes of the cached blocks
+	Used int64 `json:"used"`
+}
+
+// VolumeConfig Volume configuration for persistent storage mount
+type VolumeConfig struct {
+	// AllowMountFailure Start the sandbox without the volume when it can't be mounted instead of failing the init, the failure is reported in the init response
+	AllowMountFailure *bool `json:"allowMountFailure,omitempty"`
+
+	// CheckpointIntervalSeconds Interval in seconds between the checkpoints of the metadata replicated to the bucket, defaults to 5 minutes
+	CheckpointIntervalSeconds *int64 `json:"checkpointIntervalSeconds,omitempty"`
+
+	// GcsBucket Bucket for volume data, on the storage provider
+	GcsBucket *string `json:"gcsBucket,omitempty"`
+
+	// GcsEndpoint GCS emulator or other GCS compatible endpoint for volume data, the public API when not set
+	GcsEndpoint *string `json:"gcsEndpoint,omitempty"`
+
+	// GcsToken Downscoped credentials for the bucket, an OAuth2 access token on GCS or the output of an AWS credential process on S3
+	GcsToken *string `json:"gcsToken,omitempty"`
+
+	// GcsTokenExpiry Unix timestamp when token expires
+	GcsTokenExpiry *int64 `json:"gcsTokenExpiry,omitempty"`
+
+	// MetaEngine Metadata engine of the volume, SQLite replicated to the bucket when not set
+	MetaEngine *VolumeConfigMetaEngine `json:"metaEngine,omitempty"`
+
+	// MountAttempts How many times a mount failing transiently is attempted, with a backoff, defaults to 3
+	MountAttempts *int `json:"mountAttempts,omitempty"`
+
+	// MountCpuWeight Relative CPU weight of the volume processes, defaults to 100
+	MountCpuWeight *int64 `json:"mountCpuWeight,omitempty"`
+
+	// MountMemoryMb Memory limit in MiB for the volume processes, defaults to a quarter of the sandbox memory
+	MountMemoryMb *int64 `json:"mountMemoryMb,omitempty"`
+
+	// MountOptions JuiceFS options of the volume by name, validated against the allowlist of the API
+	MountOptions *map[string]string `json:"mountOptions,omitempty"`
+
+	// MountPath Path to mount volume (e.g., "/workspace/data")
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// OverlayPaths Paths whose contents are persisted on the volume (e.g., "/home")
+	OverlayPaths *[]string `json:"overlayPaths,omitempty"`
+
+	// PersistHome Persist the home directory of the default user on the volume, owned by the user
+	PersistHome *bool `json:"persistHome,omitempty"`
+
+	// Prewarm Glob patterns, relative to the mount path, of the files pulled into the local cache after the mount
+	Prewarm *[]string `json:"prewarm,omitempty"`
+
+	// ProxyToken Secret JuiceFS authenticates to the volume proxies of the sandbox with
+	ProxyToken *string `json:"proxyToken,omitempty"`
+
+	// ReadOnly Mount the volume read-only without replicating metadata changes
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// ReadOnlyRoot Make the template root filesystem read-only after the volume is mounted
+	ReadOnlyRoot *bool `json:"readOnlyRoot,omitempty"`
+
+	// RedisMetaUrl JuiceFS metadata URL of the Redis database of the volume, required with Redis metadata
+	RedisMetaUrl *string `json:"redisMetaUrl,omitempty"`
+
+	// S3Endpoint S3 compatible endpoint for volume data, like MinIO, AWS S3 when not set
+	S3Endpoint *string `json:"s3Endpoint,omitempty"`
+
+	// S3Region Region of the S3 bucket, required on S3
+	S3Region *string `json:"s3Region,omitempty"`
+
+	// StorageProvider Object storage of the volume bucket, GCS when not set
+	StorageProvider *VolumeConfigStorageProvider `json:"storageProvider,omitempty"`
+
+	// Subpath Absolute path of the directory of the volume bind-mounted at the mount path, the whole volume when not set
+	Subpath *string `json:"subpath,omitempty"`
+
+	// VolumeId Volume identifier (e.g., "vol_abc123")
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
+// VolumeConfigMetaEngine Metadata engine of the volume, SQLite replicated to the bucket when not set
+type VolumeConfigMetaEngine string
+
+// VolumeConfigStorageProvider Object storage of the volume bucket, GCS when not set
+type VolumeConfigStorageProvider string
+
+// VolumeMetrics Usage of the volume mounted in the sandbox
+type VolumeMetrics struct {
+	// Files Number of files and directories of the volume
+	Files int64 `json:"files"`
+
+	// LastSync Unix timestamp of the last time no written data waited for upload
+	LastSync *int64 `json:"last_sync,omitempty"`
+
+	// ReadBps Bytes read per second since the previous metrics
+	ReadBps float64 `json:"read_bps"`
+
+	// Used Used space of the volume in bytes
+	Used int64 `json:"used"`
+
+	// WriteBps Bytes written per second since the previous metrics
+	WriteBps float64 `json:"write_bps"`
+}
+
+// VolumeReplication Litestream replication of the SQLite metadata, unset for read-only volumes and Redis metadata
+type VolumeReplication struct {
+	// Error Why Litestream last exited or failed to start
+	Error *string `json:"error,omitempty"`
+
+	// LagSeconds How long the metadata changes not replicated yet have waited, 0 when the replica is up to date
+	LagSeconds float64 `json:"lagSeconds"`
+
+	// Pid Process ID of the running Litestream daemon
+	Pid *int64 `json:"pid,omitempty"`
+
+	// Restarts How many times Litestream was restarted since the volume was mounted
+	Restarts int `json:"restarts"`
+
+	// State State of the Litestream daemon
+	State VolumeReplicationState `json:"state"`
+}
+
+// VolumeReplicationState State of the Litestream daemon
+type VolumeReplicationState string
+
+// VolumeStatus defines model for VolumeStatus.
+type VolumeStatus struct {
+	// Cache Local disk cache of the JuiceFS mount, the counters are since the volume was mounted
+	Cache *VolumeCache `json:"cache,omitempty"`
+
+	// MountPath Path where the volume is mounted
+	MountPath string `json:"mountPath"`
+
+	// ReadOnly Whether the volume is mounted read-only
+	ReadOnly bool `json:"readOnly"`
+
+	// Replication Litestream replication of the SQLite metadata, unset for read-only volumes and Redis metadata
+	Replication *VolumeReplication `json:"replication,omitempty"`
+
+	// VolumeId Identifier of the mounted volume (e.g., "vol_abc123")
+	VolumeId string `json:"volumeId"`
+}
+
+// VolumeToken defines model for VolumeToken.
+type VolumeToken struct {
+	// GcsToken Downscoped credentials for the volume data and metadata replica, an OAuth2 access token on GCS or the output of an AWS credential process on S3
+	GcsToken string `json:"gcsToken"`
+
+	// GcsTokenExpiry Unix timestamp when the token expires
+	GcsTokenExpiry int64 `json:"gcsTokenExpiry"`
+
+	// VolumeId Identifier of the mounted volume (e.g., "vol_abc123")
+	VolumeId string `json:"volumeId"`
+}
+
+// VolumeUnmount defines model for VolumeUnmount.
+type VolumeUnmount struct {
+	// VolumeId Identifier of the mounted volume (e.g., "vol_abc123")
+	VolumeId string `json:"volumeId"`
 }
 
 // FilePath defines model for FilePath.
@@ -156,26 +421,20 @@ type PostInitJSONBody struct {
 	// HyperloopIP IP address of the hyperloop server to connect to
 	HyperloopIP *string `json:"hyperloopIP,omitempty"`
 
+	// Secrets Secret environment variables of the processes, they are not returned by the envs endpoint
+	Secrets *Secrets `json:"secrets,omitempty"`
+
 	// Timestamp The current timestamp in RFC3339 format
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 
 	// Volume Volume configuration for persistent storage mount
-	Volume *struct {
-		// GcsBucket GCS bucket for volume data
-		GcsBucket *string `json:"gcsBucket,omitempty"`
-
-		// GcsToken Downscoped OAuth2 access token for GCS
-		GcsToken *string `json:"gcsToken,omitempty"`
-
-		// GcsTokenExpiry Unix timestamp when token expires
-		GcsTokenExpiry *int64 `json:"gcsTokenExpiry,omitempty"`
-
-		// MountPath Path to mount volume (e.g., "/workspace/data")
-		MountPath *string `json:"mountPath,omitempty"`
+	Volume *VolumeConfig `json:"volume,omitempty"`
+}
 
-		// VolumeId Volume identifier (e.g., "vol_abc123")
-		VolumeId *string `json:"volumeId,omitempty"`
-	} `json:"volume,omitempty"`
+// GetVolumeStatusParams defines parameters for GetVolumeStatus.
+type GetVolumeStatusParams struct {
+	// VolumeId The mounted volume, required when several volumes are mounted
+	VolumeId *string `form:"volumeId,omitempty" json:"volumeId,omitempty"`
 }
 
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
@@ -184,6 +443,15 @@ type PostFilesMultipartRequestBody PostFilesMultipartBody
 // PostInitJSONRequestBody defines body for PostInit for application/json ContentType.
 type PostInitJSONRequestBody PostInitJSONBody
 
+// PostMountJSONRequestBody defines body for PostMount for application/json ContentType.
+type PostMountJSONRequestBody = VolumeConfig
+
+// PostUnmountJSONRequestBody defines body for PostUnmount for application/json ContentType.
+type PostUnmountJSONRequestBody = VolumeUnmount
+
+// PostVolumeTokenJSONRequestBody defines body for PostVolumeToken for application/json ContentType.
+type PostVolumeTokenJSONRequestBody = VolumeToken
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the environment variables
@@ -204,6 +472,21 @@ type ServerInterface interface {
 	// Get the stats of the service
 	// (GET /metrics)
 	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// Mount a volume in the running sandbox, several volumes can be mounted at their own paths
+	// (POST /mount)
+	PostMount(w http.ResponseWriter, r *http.Request)
+	// Get the status of the services the template declares to depend on the volume
+	// (GET /services)
+	GetServices(w http.ResponseWriter, r *http.Request)
+	// Flush and unmount the volume mounted in the running sandbox
+	// (POST /unmount)
+	PostUnmount(w http.ResponseWriter, r *http.Request)
+	// Get the status of a mounted volume and of the replication of its metadata
+	// (GET /volume/status)
+	GetVolumeStatus(w http.ResponseWriter, r *http.Request, params GetVolumeStatusParams)
+	// Replace the GCS token of the mounted volume before it expires
+	// (POST /volume/token)
+	PostVolumeToken(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -246,6 +529,36 @@ func (_ Unimplemented) GetMetrics(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Mount a volume in the running sandbox, several volumes can be mounted at their own paths
+// (POST /mount)
+func (_ Unimplemented) PostMount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of the services the template declares to depend on the volume
+// (GET /services)
+func (_ Unimplemented) GetServices(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Flush and unmount the volume mounted in the running sandbox
+// (POST /unmount)
+func (_ Unimplemented) PostUnmount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of a mounted volume and of the replication of its metadata
+// (GET /volume/status)
+func (_ Unimplemented) GetVolumeStatus(w http.ResponseWriter, r *http.Request, params GetVolumeStatusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the GCS token of the mounted volume before it expires
+// (POST /volume/token)
+func (_ Unimplemented) PostVolumeToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -443,6 +756,119 @@ func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// PostMount operation middleware
+func (siw *ServerInterfaceWrapper) PostMount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServices operation middleware
+func (siw *ServerInterfaceWrapper) GetServices(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServices(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUnmount operation middleware
+func (siw *ServerInterfaceWrapper) PostUnmount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUnmount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVolumeStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumeStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVolumeStatusParams
+
+	// ------------- Optional query parameter "volumeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "volumeId", r.URL.Query(), &params.VolumeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volumeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVolumeStatus(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostVolumeToken operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumeToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostVolumeToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -574,6 +1000,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/metrics", wrapper.GetMetrics)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mount", wrapper.PostMount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/services", wrapper.GetServices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/unmount", wrapper.PostUnmount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/volume/status", wrapper.GetVolumeStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/volume/token", wrapper.PostVolumeToken)
+	})
 
 	return r
 }
@@ -1,7 +1,6 @@
 package api
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -9,6 +8,7 @@ import (
 	"time"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/keys"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
 )
 
 const (
@@ -33,7 +33,7 @@ func (a *API) WithAuthorization(handler http.Handler) http.Handler {
 			// check if this path is allowed without authentication (e.g., health check, endpoints supporting signing)
 			allowedPath := slices.Contains(allowedPaths, req.Method+req.URL.Path)
 
-			if authHeader != *a.accessToken && !allowedPath {
+			if !a.isValidAccessToken(authHeader) && !allowedPath {
 				a.logger.Error().Msg("Trying to access secured envd without correct access token")
 
 				err := fmt.Errorf("unauthorized access, please provide a valid access token or method signing if supported")
@@ -47,26 +47,35 @@ func (a *API) WithAuthorization(handler http.Handler) http.Handler {
 	})
 }
 
-func (a *API) generateSignature(path string, username string, operation string, signatureExpiration *int64) (string, error) {
-	if a.accessToken == nil {
-		return "", fmt.Errorf("access token is not set")
+// isValidAccessToken reports whether the token is the access token envd was initialized with,
+// or an unexpired token signed with it.
+func (a *API) isValidAccessToken(token string) bool {
+	if token == *a.accessToken {
+		return true
 	}
 
+	return keys.VerifyEnvdAccessToken(*a.accessToken, token, time.Now()) == nil
+}
+
+// signingTokens returns the access tokens a valid signature can be made with.
+func (a *API) signingTokens() []string {
+	return append([]string{*a.accessToken}, keys.ValidEnvdAccessTokens(*a.accessToken, time.Now())...)
+}
+
+func generateSignature(accessToken string, path string, username string, operation string, signatureExpiration *int64) string {
 	var signature string
 	hasher := keys.NewSHA256Hashing()
 
 	if signatureExpiration == nil {
-		signature = fmt.Sprintf("%s:%s:%s:%s", path, operation, username, *a.accessToken)
+		signature = fmt.Sprintf("%s:%s:%s:%s", path, operation, username, accessToken)
 	} else {
-		signature = fmt.Sprintf("%s:%s:%s:%s:%s", path, operation, username, *a.accessToken, strconv.FormatInt(*signatureExpiration, 10))
+		signature = fmt.Sprintf("%s:%s:%s:%s:%s", path, operation, username, accessToken, strconv.FormatInt(*signatureExpiration, 10))
 	}
 
-	return fmt.Sprintf("v1_%s", hasher.HashWithoutPrefix([]byte(signature))), nil
+	return fmt.Sprintf("v1_%s", hasher.HashWithoutPrefix([]byte(signature)))
 }
 
-func (a *API) validateSigning(r *http.Request, signature *string, signatureExpiration *int, username *string, path string, operation string) (err error) {
-	var expectedSignature string
-
+func (a *API) validateSigning(r *http.Request, signature *string, signatureExpiration *int, username *string, path string, operation string) error {
 	// no need to validate signing key if access token is not set
 	if a.accessToken == nil {
 		return nil
@@ -75,7 +84,7 @@ func (a *API) validateSigning(r *http.Request, signature *string, signatureExpir
 	// check if access token is sent in the header
 	tokenFromHeader := r.Header.Get(accessTokenHeader)
 	if tokenFromHeader != "" {
-		if tokenFromHeader != *a.accessToken {
+		if !a.isValidAccessToken(tokenFromHeader) {
 			return fmt.Errorf("access token present in header but does not match")
 		}
 
@@ -92,30 +101,22 @@ func (a *API) validateSigning(r *http.Request, signature *string, signatureExpir
 		signatureUsername = *username
 	}
 
-	if signatureExpiration == nil {
-		expectedSignature, err = a.generateSignature(path, signatureUsername, operation, nil)
-	} else {
-		exp := int64(*signatureExpiration)
-		expectedSignature, err = a.generateSignature(path, signatureUsername, operation, &exp)
-	}
-
-	if err != nil {
-		a.logger.Error().Err(err).Msg("error generating signing key")
-
-		return errors.New("invalid signature")
+	var exp *int64
+	if signatureExpiration != nil {
+		exp = utils.ToPtr(int64(*signatureExpiration))
 	}
 
-	// signature validation
-	if expectedSignature != *signature {
+	// signature validation, it can be made with any access token that is valid now
+	valid := slices.ContainsFunc(a.signingTokens(), func(token string) bool {
+		return generateSignature(token, path, signatureUsername, operation, exp) == *signature
+	})
+	if !valid {
 		return fmt.Errorf("invalid signature")
 	}
 
 	// signature expiration
-	if signatureExpiration != nil {
-		exp := int64(*signatureExpiration)
-		if exp < time.Now().Unix() {
-			return fmt.Errorf("signature is already expired")
-		}
+	if exp != nil && *exp < time.Now().Unix() {
+		return fmt.Errorf("signature is already expired")
 	}
 
 	return nil
@@ -2,6 +2,8 @@ package api
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -14,15 +16,13 @@ import (
 
 func TestKeyGenerationAlgorithmIsStable(t *testing.T) {
 	apiToken := "secret-access-token"
-	api := &API{accessToken: &apiToken}
 
 	path := "/path/to/demo.txt"
 	username := "root"
 	operation := "write"
 	timestamp := time.Now().Unix()
 
-	signature, err := api.generateSignature(path, username, operation, &timestamp)
-	require.NoError(t, err)
+	signature := generateSignature(apiToken, path, username, operation, &timestamp)
 	assert.NotEmpty(t, signature)
 
 	// locally generated signature
@@ -35,14 +35,12 @@ func TestKeyGenerationAlgorithmIsStable(t *testing.T) {
 
 func TestKeyGenerationAlgorithmWithoutExpirationIsStable(t *testing.T) {
 	apiToken := "secret-access-token"
-	api := &API{accessToken: &apiToken}
 
 	path := "/path/to/resource.txt"
 	username := "user"
 	operation := "read"
 
-	signature, err := api.generateSignature(path, username, operation, nil)
-	require.NoError(t, err)
+	signature := generateSignature(apiToken, path, username, operation, nil)
 	assert.NotEmpty(t, signature)
 
 	// locally generated signature
@@ -52,3 +50,29 @@ func TestKeyGenerationAlgorithmWithoutExpirationIsStable(t *testing.T) {
 
 	assert.Equal(t, localSignature, signature)
 }
+
+func TestValidateSigningWithExpiringToken(t *testing.T) {
+	apiToken := "secret-access-token"
+	api := &API{accessToken: &apiToken}
+
+	path := "/path/to/demo.txt"
+	username := "root"
+	request := httptest.NewRequest(http.MethodGet, "/files", nil)
+
+	// Signed by a client holding an expiring token instead of the access token
+	token := keys.NewEnvdAccessToken(apiToken, time.Now())
+	signature := generateSignature(token, path, username, SigningReadOperation, nil)
+	require.NoError(t, api.validateSigning(request, &signature, nil, &username, path, SigningReadOperation))
+
+	// A token signed with another access token isn't valid
+	otherToken := keys.NewEnvdAccessToken("other-access-token", time.Now())
+	signature = generateSignature(otherToken, path, username, SigningReadOperation, nil)
+	require.Error(t, api.validateSigning(request, &signature, nil, &username, path, SigningReadOperation))
+
+	// Expiring tokens are accepted in the header too
+	request.Header.Set(accessTokenHeader, token)
+	require.NoError(t, api.validateSigning(request, nil, nil, &username, path, SigningReadOperation))
+
+	request.Header.Set(accessTokenHeader, otherToken)
+	require.Error(t, api.validateSigning(request, nil, nil, &username, path, SigningReadOperation))
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,9 @@ import (
 	"net/http"
 	"net/netip"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -17,6 +21,7 @@ import (
 
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/logs"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/permissions"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
 )
 
@@ -35,23 +40,49 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 	operationID := logs.AssignOperationID()
 	logger := a.logger.With().Str(string(logs.OperationIDKey), operationID).Logger()
 
-	if r.Body != nil {
-		var initRequest PostInitJSONBody
+	ack := newInitAck()
 
-		err := json.NewDecoder(r.Body).Decode(&initRequest)
-		if err != nil && !errors.Is(err, io.EOF) {
-			logger.Error().Msgf("Failed to decode request: %v", err)
+	if r.Body != nil {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Error().Msgf("Failed to read request: %v", err)
 			w.WriteHeader(http.StatusBadRequest)
 
 			return
 		}
 
+		var initRequest PostInitJSONBody
+
+		if len(bytes.TrimSpace(body)) > 0 {
+			// Decode into a raw map first so fields this envd version doesn't know about
+			// are reported back instead of being silently dropped.
+			var rawRequest map[string]json.RawMessage
+			if err := json.Unmarshal(body, &rawRequest); err != nil {
+				logger.Error().Msgf("Failed to decode request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			if err := json.Unmarshal(body, &initRequest); err != nil {
+				logger.Error().Msgf("Failed to decode request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			for _, field := range unknownInitFields(rawRequest) {
+				logger.Warn().Msgf("Ignoring unknown init field %q", field)
+				ack.ignore(field)
+			}
+		}
+
 		a.initLock.Lock()
 		defer a.initLock.Unlock()
 
 		// Update data only if the request is newer or if there's no timestamp at all
 		if initRequest.Timestamp == nil || a.lastSetTime.SetToGreater(initRequest.Timestamp.UnixNano()) {
-			err = a.SetData(logger, initRequest)
+			err = a.SetData(logger, initRequest, ack)
 			if err != nil {
 				switch {
 				case errors.Is(err, ErrAccessTokenAlreadySet):
@@ -64,60 +95,37 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 
 				return
 			}
+		} else {
+			ack.ignoreStale(initRequest)
 		}
 
 		// Mount volume synchronously if configured in the request
-		if initRequest.Volume != nil && initRequest.Volume.VolumeId != nil {
-			volumeConfig := &host.VolumeConfig{
-				VolumeID:       *initRequest.Volume.VolumeId,
-				MountPath:      derefString(initRequest.Volume.MountPath, "/workspace"),
-				GCSBucket:      derefString(initRequest.Volume.GcsBucket, ""),
-				GCSToken:       derefString(initRequest.Volume.GcsToken, ""),
-				GCSTokenExpiry: derefInt64(initRequest.Volume.GcsTokenExpiry, 0),
-			}
-
-			// Debug: log token info
-			tokenLen := len(volumeConfig.GCSToken)
-			tokenPrefix := ""
-			if tokenLen > 10 {
-				tokenPrefix = volumeConfig.GCSToken[:10] + "..."
-			} else if tokenLen > 0 {
-				tokenPrefix = "[token too short]"
-			}
-			logger.Info().Msgf("Mounting volume %s at %s (bucket=%s, token_len=%d, token_prefix=%s)",
-				volumeConfig.VolumeID, volumeConfig.MountPath, volumeConfig.GCSBucket, tokenLen, tokenPrefix)
-
-			// Network diagnostics: test multiple endpoints to see what's working
-			testNetworkConnectivity(logger)
+		if initRequest.Volume != nil && initRequest.Volume.VolumeId == nil {
+			ack.ignore("volume")
+			ack.warn("volume configuration without volumeId was ignored")
+		}
 
-			if host.DefaultVolumeMounterFactory == nil {
-				logger.Error().Msg("Volume mount requested but no mounter factory registered")
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte("volume mount not available"))
-				return
+		if initRequest.Volume != nil && initRequest.Volume.VolumeId != nil {
+			var status int
+			// A sandbox resumed from a snapshot taken while the volume was mounted, because the unmount
+			// before the pause failed, keeps the mount and only needs the token minted for the resume
+			if current := mountedVolumes()[*initRequest.Volume.VolumeId]; current != nil {
+				status, err = a.adoptMountedVolume(logger, current, initRequest.Volume)
+			} else {
+				status, err = a.mountVolume(logger, initRequest.Volume)
 			}
-
-			mounter := host.DefaultVolumeMounterFactory(volumeConfig)
-			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
-			defer cancel()
-
-			if err := mounter.Mount(ctx); err != nil {
-				logger.Error().Msgf("Failed to mount volume %s at %s: %v",
-					volumeConfig.VolumeID, volumeConfig.MountPath, err)
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(fmt.Sprintf("volume mount failed: %v", err)))
+			switch {
+			case err == nil:
+				ack.apply("volume")
+			case status == http.StatusInternalServerError && initRequest.Volume.AllowMountFailure != nil && *initRequest.Volume.AllowMountFailure:
+				// The sandbox starts without the volume, an invalid volume config still fails the init
+				logger.Warn().Msgf("Starting without volume %s: %v", *initRequest.Volume.VolumeId, err)
+				ack.failVolumeMount(err)
+			default:
+				w.WriteHeader(status)
+				w.Write([]byte(err.Error()))
 				return
 			}
-
-			logger.Info().Msgf("Successfully mounted volume %s at %s",
-				volumeConfig.VolumeID, volumeConfig.MountPath)
-
-			// Store env vars for the volume
-			a.defaults.EnvVars.Store("MORU_VOLUME_ID", volumeConfig.VolumeID)
-			a.defaults.EnvVars.Store("MORU_VOLUME_MOUNT_PATH", volumeConfig.MountPath)
-
-			// Store the volume config for graceful shutdown
-			host.CurrentVolumeConfig = volumeConfig
 		}
 	}
 
@@ -128,9 +136,50 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	w.Header().Set("Cache-Control", "no-store")
-	w.Header().Set("Content-Type", "")
+	w.Header().Set("Content-Type", "application/json")
 
-	w.WriteHeader(http.StatusNoContent)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ack.response()); err != nil {
+		logger.Error().Msgf("Failed to encode init response: %v", err)
+	}
+}
+
+// resolvePersistedHome looks up the home directory and owner of the user and adds
+// the home directory to the overlay paths persisted on the volume.
+func resolvePersistedHome(config *host.VolumeConfig, username string) error {
+	if username == "" {
+		return errors.New("persisting the home directory requires a default user")
+	}
+
+	u, err := permissions.GetUser(username)
+	if err != nil {
+		return err
+	}
+
+	uid, gid, err := permissions.GetUserIdInts(u)
+	if err != nil {
+		return err
+	}
+
+	home := filepath.Clean(u.HomeDir)
+	if !filepath.IsAbs(home) || home == "/" {
+		return fmt.Errorf("user %s has no home directory that can be persisted", username)
+	}
+
+	if home == config.MountPath || strings.HasPrefix(home, config.MountPath+"/") || strings.HasPrefix(config.MountPath, home+"/") {
+		return fmt.Errorf("home directory %s of user %s overlaps the volume mount path", home, username)
+	}
+
+	if !slices.Contains(config.OverlayPaths, home) {
+		config.OverlayPaths = append(config.OverlayPaths, home)
+	}
+
+	config.PersistHome = true
+	config.HomeDir = home
+	config.HomeUID = uid
+	config.HomeGID = gid
+
+	return nil
 }
 
 // derefString returns the dereferenced string value or the default if nil.
@@ -149,7 +198,7 @@ func derefInt64(i *int64, def int64) int64 {
 	return *i
 }
 
-func (a *API) SetData(logger zerolog.Logger, data PostInitJSONBody) error {
+func (a *API) SetData(logger zerolog.Logger, data PostInitJSONBody, ack *initAck) error {
 	if data.Timestamp != nil {
 		// Check if current time differs significantly from the received timestamp
 		if shouldSetSystemTime(time.Now(), *data.Timestamp) {
@@ -158,10 +207,12 @@ func (a *API) SetData(logger zerolog.Logger, data PostInitJSONBody) error {
 			err := unix.ClockSettime(unix.CLOCK_REALTIME, &ts)
 			if err != nil {
 				logger.Error().Msgf("Failed to set system time: %v", err)
+				ack.warn("failed to set system time: %v", err)
 			}
 		} else {
 			logger.Debug().Msgf("Current time is within acceptable range of timestamp %v, not setting system time", *data.Timestamp)
 		}
+		ack.apply("timestamp")
 	}
 
 	if data.EnvVars != nil {
@@ -171,6 +222,17 @@ func (a *API) SetData(logger zerolog.Logger, data PostInitJSONBody) error {
 			logger.Debug().Msgf("Setting env var for %s", key)
 			a.defaults.EnvVars.Store(key, value)
 		}
+		ack.apply("envVars")
+	}
+
+	if data.Secrets != nil {
+		// Only the count is logged, the names and values stay out of the logs
+		logger.Debug().Msgf("Setting %d secrets", len(*data.Secrets))
+
+		for key, value := range *data.Secrets {
+			a.defaults.Secrets.Store(key, value)
+		}
+		ack.apply("secrets")
 	}
 
 	if data.AccessToken != nil {
@@ -182,20 +244,38 @@ func (a *API) SetData(logger zerolog.Logger, data PostInitJSONBody) error {
 
 		logger.Debug().Msg("Setting access token")
 		a.accessToken = data.AccessToken
+		ack.apply("accessToken")
 	}
 
 	if data.HyperloopIP != nil {
+		// The events of the volume mounted below are published through hyperloop
+		a.events.SetHyperloopAddress(*data.HyperloopIP)
 		go a.SetupHyperloop(*data.HyperloopIP)
+		ack.apply("hyperloopIP")
 	}
 
-	if data.DefaultUser != nil && *data.DefaultUser != "" {
-		logger.Debug().Msgf("Setting default user to: %s", *data.DefaultUser)
-		a.defaults.User = *data.DefaultUser
+	if data.DefaultUser != nil {
+		if *data.DefaultUser != "" {
+			logger.Debug().Msgf("Setting default user to: %s", *data.DefaultUser)
+			a.defaults.User = *data.DefaultUser
+			ack.apply("defaultUser")
+
+			if _, err := permissions.GetUser(*data.DefaultUser); err != nil {
+				ack.warn("default user %q does not exist in the sandbox", *data.DefaultUser)
+			}
+		} else {
+			ack.ignore("defaultUser")
+		}
 	}
 
-	if data.DefaultWorkdir != nil && *data.DefaultWorkdir != "" {
-		logger.Debug().Msgf("Setting default workdir to: %s", *data.DefaultWorkdir)
-		a.defaults.Workdir = data.DefaultWorkdir
+	if data.DefaultWorkdir != nil {
+		if *data.DefaultWorkdir != "" {
+			logger.Debug().Msgf("Setting default workdir to: %s", *data.DefaultWorkdir)
+			a.defaults.Workdir = data.DefaultWorkdir
+			ack.apply("defaultWorkdir")
+		} else {
+			ack.ignore("defaultWorkdir")
+		}
 	}
 
 	return nil
@@ -280,14 +360,19 @@ func shouldSetSystemTime(sandboxTime, hostTime time.Time) bool {
 
 // testNetworkConnectivity tests connectivity to multiple endpoints and logs results.
 // This helps diagnose whether network issues during /init are specific to GCS or general.
-func testNetworkConnectivity(logger zerolog.Logger) {
+// gcsEndpoint replaces the public GCS API when set.
+func testNetworkConnectivity(logger zerolog.Logger, gcsEndpoint string) {
+	if gcsEndpoint == "" {
+		gcsEndpoint = "https://storage.googleapis.com"
+	}
+
 	endpoints := []struct {
 		name string
 		url  string
 	}{
 		{"google-dns", "https://8.8.8.8"},
 		{"google-www", "https://www.google.com"},
-		{"gcs-api", "https://storage.googleapis.com"},
+		{"gcs-api", gcsEndpoint},
 		{"cloudflare", "https://1.1.1.1"},
 	}
 
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// knownInitFields are the top-level /init request fields this envd version understands.
+var knownInitFields = map[string]struct{}{
+	"hyperloopIP":    {},
+	"envVars":        {},
+	"secrets":        {},
+	"accessToken":    {},
+	"timestamp":      {},
+	"defaultUser":    {},
+	"defaultWorkdir": {},
+	"volume":         {},
+}
+
+// initAck collects which parts of an /init request were applied so the caller
+// can verify the sandbox was configured the way it asked.
+type initAck struct {
+	applied  []string
+	ignored  []string
+	warnings []string
+
+	// volumeMountError is why the volume couldn't be mounted when the sandbox starts without it
+	volumeMountError string
+}
+
+func newInitAck() *initAck {
+	return &initAck{
+		applied:  []string{},
+		ignored:  []string{},
+		warnings: []string{},
+	}
+}
+
+func (a *initAck) apply(field string) {
+	a.applied = append(a.applied, field)
+}
+
+func (a *initAck) ignore(field string) {
+	a.ignored = append(a.ignored, field)
+}
+
+func (a *initAck) warn(format string, args ...any) {
+	a.warnings = append(a.warnings, fmt.Sprintf(format, args...))
+}
+
+// failVolumeMount records that the sandbox starts without the volume because it couldn't be mounted.
+func (a *initAck) failVolumeMount(err error) {
+	a.ignore("volume")
+	a.warn("volume was not mounted: %v", err)
+	a.volumeMountError = err.Error()
+}
+
+// ignoreStale marks every configuration field of a request as ignored because
+// a newer request has already been applied.
+func (a *initAck) ignoreStale(data PostInitJSONBody) {
+	fields := []struct {
+		name string
+		set  bool
+	}{
+		{"timestamp", data.Timestamp != nil},
+		{"envVars", data.EnvVars != nil},
+		{"secrets", data.Secrets != nil},
+		{"accessToken", data.AccessToken != nil},
+		{"hyperloopIP", data.HyperloopIP != nil},
+		{"defaultUser", data.DefaultUser != nil},
+		{"defaultWorkdir", data.DefaultWorkdir != nil},
+	}
+
+	for _, f := range fields {
+		if f.set {
+			a.ignore(f.name)
+		}
+	}
+
+	a.warn("init request is older than the last applied one, configuration was not updated")
+}
+
+func (a *initAck) response() InitResponse {
+	response := InitResponse{
+		Applied:  a.applied,
+		Ignored:  a.ignored,
+		Warnings: a.warnings,
+	}
+	if a.volumeMountError != "" {
+		response.VolumeMountError = &a.volumeMountError
+	}
+
+	return response
+}
+
+// unknownInitFields returns the request fields not recognized by this envd version, sorted by name.
+func unknownInitFields(raw map[string]json.RawMessage) []string {
+	var unknown []string
+	for field := range raw {
+		if _, ok := knownInitFields[field]; !ok {
+			unknown = append(unknown, field)
+		}
+	}
+
+	slices.Sort(unknown)
+
+	return unknown
+}
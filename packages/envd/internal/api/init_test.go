@@ -1,14 +1,20 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
+	"os/user"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
 )
 
 func TestSimpleCases(t *testing.T) {
@@ -109,3 +115,73 @@ func TestShouldSetSystemTime(t *testing.T) {
 		})
 	}
 }
+
+func TestUnknownInitFields(t *testing.T) {
+	raw := map[string]json.RawMessage{
+		"envVars":      json.RawMessage(`{}`),
+		"volume":       json.RawMessage(`{}`),
+		"volumeMounts": json.RawMessage(`[]`),
+		"secrets":      json.RawMessage(`{}`),
+		"sshKeys":      json.RawMessage(`[]`),
+	}
+
+	assert.Equal(t, []string{"sshKeys", "volumeMounts"}, unknownInitFields(raw))
+	assert.Empty(t, unknownInitFields(map[string]json.RawMessage{"timestamp": json.RawMessage(`null`)}))
+}
+
+func TestInitAckIgnoreStale(t *testing.T) {
+	user := "user"
+	envVars := EnvVars{"A": "B"}
+	secrets := Secrets{"TOKEN": "secret"}
+
+	ack := newInitAck()
+	ack.ignoreStale(PostInitJSONBody{DefaultUser: &user, EnvVars: &envVars, Secrets: &secrets})
+
+	resp := ack.response()
+	assert.Empty(t, resp.Applied)
+	assert.Equal(t, []string{"envVars", "secrets", "defaultUser"}, resp.Ignored)
+	assert.Len(t, resp.Warnings, 1)
+}
+
+func TestInitAckFailVolumeMount(t *testing.T) {
+	ack := newInitAck()
+	ack.apply("envVars")
+
+	resp := ack.response()
+	assert.Nil(t, resp.VolumeMountError)
+
+	ack.failVolumeMount(errors.New("volume mount failed: juicefs mount failed"))
+
+	resp = ack.response()
+	assert.Equal(t, []string{"envVars"}, resp.Applied)
+	assert.Equal(t, []string{"volume"}, resp.Ignored)
+	assert.Len(t, resp.Warnings, 1)
+	require.NotNil(t, resp.VolumeMountError)
+	assert.Equal(t, "volume mount failed: juicefs mount failed", *resp.VolumeMountError)
+}
+
+func TestResolvePersistedHome(t *testing.T) {
+	current, err := user.Current()
+	require.NoError(t, err)
+
+	config := &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/home", OverlayPaths: []string{"/opt/cache"}}
+	err = resolvePersistedHome(config, current.Username)
+	if current.HomeDir == "" || current.HomeDir == "/" {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+
+	assert.True(t, config.PersistHome)
+	assert.Equal(t, filepath.Clean(current.HomeDir), config.HomeDir)
+	assert.Equal(t, []string{"/opt/cache", config.HomeDir}, config.OverlayPaths)
+	assert.Equal(t, current.Uid, strconv.Itoa(config.HomeUID))
+	assert.Equal(t, current.Gid, strconv.Itoa(config.HomeGID))
+
+	// Resolving again doesn't duplicate the overlay path
+	require.NoError(t, resolvePersistedHome(config, current.Username))
+	assert.Len(t, config.OverlayPaths, 2)
+
+	require.Error(t, resolvePersistedHome(&host.VolumeConfig{}, ""))
+	require.Error(t, resolvePersistedHome(&host.VolumeConfig{}, "moru-missing-user"))
+}
@@ -0,0 +1,518 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/events"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/logs"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+// volumeMountTimeout bounds mounting a volume, restoring its metadata included.
+const volumeMountTimeout = 3 * time.Minute
+
+var errVolumeMountUnavailable = errors.New("volume mount not available")
+
+// DefaultMountedVolumes is set by the volume package during init, it returns the configs of the
+// mounted volumes by volume ID.
+var DefaultMountedVolumes func() map[string]*host.VolumeConfig
+
+// mountedVolumes returns the configs of the mounted volumes by volume ID, none without the volume
+// package.
+func mountedVolumes() map[string]*host.VolumeConfig {
+	if DefaultMountedVolumes == nil {
+		return nil
+	}
+
+	return DefaultMountedVolumes()
+}
+
+// PostMount mounts a volume in the running sandbox, so a volume can be attached after the start.
+// Several volumes can be mounted, each at its own path.
+func (a *API) PostMount(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := a.logger.With().Str(string(logs.OperationIDKey), operationID).Logger()
+
+	var volume VolumeConfig
+	if err := json.NewDecoder(r.Body).Decode(&volume); err != nil {
+		logger.Error().Msgf("Failed to decode request: %v", err)
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+
+		return
+	}
+
+	if volume.VolumeId == nil || *volume.VolumeId == "" {
+		jsonError(w, http.StatusBadRequest, errors.New("volumeId is required"))
+
+		return
+	}
+
+	// Mounting is serialized with /init, which mounts the volume configured at the start
+	a.initLock.Lock()
+	defer a.initLock.Unlock()
+
+	mountPath := derefString(volume.MountPath, "/workspace")
+	for _, mounted := range mountedVolumes() {
+		if mounted.VolumeID == *volume.VolumeId || mounted.MountPath == mountPath {
+			jsonError(w, http.StatusConflict, fmt.Errorf("volume %s is already mounted at %s", mounted.VolumeID, mounted.MountPath))
+
+			return
+		}
+	}
+
+	if status, err := a.mountVolume(logger, &volume); err != nil {
+		jsonError(w, status, err)
+
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mountVolume mounts the volume, the mounter registry keeps it for the unmount and the graceful
+// shutdown. Returns the status code of the failure with the error.
+func (a *API) mountVolume(logger zerolog.Logger, volume *VolumeConfig) (int, error) {
+	volumeConfig := &host.VolumeConfig{
+		VolumeID:       *volume.VolumeId,
+		MountPath:      derefString(volume.MountPath, "/workspace"),
+		GCSBucket:      derefString(volume.GcsBucket, ""),
+		GCSToken:       derefString(volume.GcsToken, ""),
+		GCSTokenExpiry: derefInt64(volume.GcsTokenExpiry, 0),
+		GCSEndpoint:    derefString(volume.GcsEndpoint, ""),
+		S3Region:       derefString(volume.S3Region, ""),
+		S3Endpoint:     derefString(volume.S3Endpoint, ""),
+		RedisMetaURL:   derefString(volume.RedisMetaUrl, ""),
+		ProxyToken:     derefString(volume.ProxyToken, ""),
+		Subpath:        derefString(volume.Subpath, ""),
+		ReadOnlyRoot:   volume.ReadOnlyRoot != nil && *volume.ReadOnlyRoot,
+		ReadOnly:       volume.ReadOnly != nil && *volume.ReadOnly,
+		MountMemoryMB:  derefInt64(volume.MountMemoryMb, 0),
+		MountCPUWeight: derefInt64(volume.MountCpuWeight, 0),
+
+		CheckpointIntervalSeconds: derefInt64(volume.CheckpointIntervalSeconds, 0),
+		AllowMountFailure:         volume.AllowMountFailure != nil && *volume.AllowMountFailure,
+	}
+	if volume.MountAttempts != nil {
+		volumeConfig.MountAttempts = *volume.MountAttempts
+	}
+	if volume.OverlayPaths != nil {
+		volumeConfig.OverlayPaths = *volume.OverlayPaths
+	}
+	if volume.Prewarm != nil {
+		volumeConfig.Prewarm = *volume.Prewarm
+	}
+	if volume.MountOptions != nil {
+		if err := volumeoptions.Validate(*volume.MountOptions); err != nil {
+			return http.StatusBadRequest, err
+		}
+		volumeConfig.MountOptions = *volume.MountOptions
+	}
+
+	var providerName string
+	if volume.StorageProvider != nil {
+		providerName = string(*volume.StorageProvider)
+	}
+	provider, err := volumestorage.Parse(providerName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if provider == volumestorage.S3 && volumeConfig.S3Region == "" {
+		return http.StatusBadRequest, errors.New("s3Region is required for volumes on S3")
+	}
+	volumeConfig.StorageProvider = provider
+
+	var metaEngineName string
+	if volume.MetaEngine != nil {
+		metaEngineName = string(*volume.MetaEngine)
+	}
+	metaEngine, err := volumestorage.ParseMetaEngine(metaEngineName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if metaEngine == volumestorage.MetaEngineRedis && volumeConfig.RedisMetaURL == "" {
+		return http.StatusBadRequest, errors.New("redisMetaUrl is required for volumes with Redis metadata")
+	}
+	volumeConfig.MetaEngine = metaEngine
+
+	if volume.PersistHome != nil && *volume.PersistHome {
+		if err := resolvePersistedHome(volumeConfig, a.defaults.User); err != nil {
+			logger.Error().Msgf("Failed to resolve the home directory to persist: %v", err)
+			return http.StatusBadRequest, err
+		}
+
+		logger.Info().Msgf("Persisting home directory %s of user %s on volume %s",
+			volumeConfig.HomeDir, a.defaults.User, volumeConfig.VolumeID)
+	}
+
+	// Debug: log token info
+	tokenLen := len(volumeConfig.GCSToken)
+	tokenPrefix := ""
+	if tokenLen > 10 {
+		tokenPrefix = volumeConfig.GCSToken[:10] + "..."
+	} else if tokenLen > 0 {
+		tokenPrefix = "[token too short]"
+	}
+	logger.Info().Msgf("Mounting volume %s at %s (bucket=%s, token_len=%d, token_prefix=%s)",
+		volumeConfig.VolumeID, volumeConfig.MountPath, volumeConfig.GCSBucket, tokenLen, tokenPrefix)
+
+	// Network diagnostics: test multiple endpoints to see what's working
+	testNetworkConnectivity(logger, volumeConfig.GCSEndpoint)
+
+	if host.DefaultVolumeMounterFactory == nil {
+		logger.Error().Msg("Volume mount requested but no mounter factory registered")
+		return http.StatusInternalServerError, errVolumeMountUnavailable
+	}
+
+	mounter := host.DefaultVolumeMounterFactory(volumeConfig)
+	ctx, cancel := context.WithTimeout(context.Background(), volumeMountTimeout)
+	defer cancel()
+
+	a.events.PublishVolumeEvent(ctx, volumeEvent(events.VolumeMountStarted, volumeConfig))
+	started := time.Now()
+
+	if err := mounter.Mount(ctx); err != nil {
+		logger.Error().Msgf("Failed to mount volume %s at %s: %v",
+			volumeConfig.VolumeID, volumeConfig.MountPath, err)
+		a.events.PublishVolumeEvent(context.WithoutCancel(ctx), volumeEvent(events.VolumeMountFailed, volumeConfig).
+			WithDuration(time.Since(started)).
+			WithError(err))
+
+		return http.StatusInternalServerError, fmt.Errorf("volume mount failed: %w", err)
+	}
+
+	a.events.PublishVolumeEvent(ctx, volumeEvent(events.VolumeMountCompleted, volumeConfig).
+		WithDuration(time.Since(started)))
+
+	logger.Info().Msgf("Successfully mounted volume %s at %s",
+		volumeConfig.VolumeID, volumeConfig.MountPath)
+
+	// The env vars and the services follow the first volume mounted
+	if _, ok := a.defaults.EnvVars.Load("MORU_VOLUME_ID"); !ok {
+		a.defaults.EnvVars.Store("MORU_VOLUME_ID", volumeConfig.VolumeID)
+		a.defaults.EnvVars.Store("MORU_VOLUME_MOUNT_PATH", volumeConfig.MountPath)
+
+		if a.services != nil {
+			a.services.VolumeMounted(volumeConfig.MountPath)
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// primaryVolume reports whether the env vars and the services follow the volume, the first one
+// mounted.
+func (a *API) primaryVolume(volumeID string) bool {
+	primary, ok := a.defaults.EnvVars.Load("MORU_VOLUME_ID")
+
+	return ok && primary == volumeID
+}
+
+// unsetPrimaryVolume removes the env vars of the volume once it's unmounted, the next volume
+// mounted sets them.
+func (a *API) unsetPrimaryVolume() {
+	a.defaults.EnvVars.Delete("MORU_VOLUME_ID")
+	a.defaults.EnvVars.Delete("MORU_VOLUME_MOUNT_PATH")
+}
+
+// adoptMountedVolume keeps the volume that is still mounted and replaces its GCS token with the
+// token of the request, the token it was mounted with may have expired while the sandbox was paused.
+func (a *API) adoptMountedVolume(logger zerolog.Logger, current *host.VolumeConfig, volume *VolumeConfig) (int, error) {
+	logger.Warn().
+		Str("volumeId", current.VolumeID).
+		Str("mountPath", current.MountPath).
+		Msg("Volume is still mounted, keeping the mount")
+
+	token := derefString(volume.GcsToken, "")
+	if token == "" || token == current.GCSToken {
+		return http.StatusOK, nil
+	}
+
+	if DefaultVolumeTokenWriter == nil {
+		logger.Error().Msg("Volume token refresh requested but no token writer registered")
+		return http.StatusInternalServerError, errVolumeMountUnavailable
+	}
+
+	if err := DefaultVolumeTokenWriter(current.VolumeID, token); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("volume token refresh failed: %w", err)
+	}
+
+	current.GCSToken = token
+	current.GCSTokenExpiry = derefInt64(volume.GcsTokenExpiry, 0)
+
+	return http.StatusOK, nil
+}
+
+// volumeEvent returns an event of the volume for analytics.
+func volumeEvent(eventType string, volumeConfig *host.VolumeConfig) events.VolumeEvent {
+	return events.NewVolumeEvent(eventType, volumeConfig.VolumeID, volumeConfig.MountPath)
+}
+
+// PostUnmount flushes and unmounts a mounted volume, stopping its metadata replication,
+// so a volume can be detached without stopping the sandbox.
+func (a *API) PostUnmount(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := a.logger.With().Str(string(logs.OperationIDKey), operationID).Logger()
+
+	var body VolumeUnmount
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		logger.Error().Msgf("Failed to decode request: %v", err)
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+
+		return
+	}
+
+	if body.VolumeId == "" {
+		jsonError(w, http.StatusBadRequest, errors.New("volumeId is required"))
+
+		return
+	}
+
+	a.initLock.Lock()
+	defer a.initLock.Unlock()
+
+	volumeConfig := mountedVolumes()[body.VolumeId]
+	if volumeConfig == nil {
+		jsonError(w, http.StatusNotFound, fmt.Errorf("volume %s is not mounted", body.VolumeId))
+
+		return
+	}
+
+	// Unmounting would take away the paths the sandbox filesystem was set up on top of the volume
+	if len(volumeConfig.OverlayPaths) > 0 || volumeConfig.ReadOnlyRoot {
+		jsonError(w, http.StatusConflict, fmt.Errorf("volume %s backs paths of the sandbox filesystem, it's only unmounted when the sandbox stops", volumeConfig.VolumeID))
+
+		return
+	}
+
+	if DefaultVolumeUnmounterFactory == nil {
+		logger.Error().Msg("Volume unmount requested but no unmounter factory registered")
+		jsonError(w, http.StatusInternalServerError, errVolumeMountUnavailable)
+
+		return
+	}
+
+	logger.Info().
+		Str("volumeId", volumeConfig.VolumeID).
+		Str("mountPath", volumeConfig.MountPath).
+		Msg("Unmounting volume")
+
+	// The services keep files on the volume open, they're started again when a volume is mounted
+	primary := a.primaryVolume(volumeConfig.VolumeID)
+	if primary && a.services != nil {
+		a.services.VolumeUnmounted()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), volumeMountTimeout)
+	defer cancel()
+
+	if err := DefaultVolumeUnmounterFactory(volumeConfig).Unmount(ctx); err != nil {
+		logger.Error().
+			Err(err).
+			Str("volumeId", volumeConfig.VolumeID).
+			Str("mountPath", volumeConfig.MountPath).
+			Msg("Failed to unmount volume")
+		jsonError(w, http.StatusInternalServerError, fmt.Errorf("volume unmount failed: %w", err))
+
+		return
+	}
+
+	if primary {
+		a.unsetPrimaryVolume()
+	}
+
+	logger.Info().
+		Str("volumeId", volumeConfig.VolumeID).
+		Str("mountPath", volumeConfig.MountPath).
+		Msg("Volume unmounted successfully")
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DefaultVolumeTokenWriter is set by the volume package during init, it replaces the GCS token
+// the mount processes of the volume read.
+var DefaultVolumeTokenWriter func(volumeID, token string) error
+
+// PostVolumeToken replaces the GCS token of a mounted volume, so the volume stays writable
+// after the token it was mounted with expires.
+func (a *API) PostVolumeToken(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := a.logger.With().Str(string(logs.OperationIDKey), operationID).Logger()
+
+	var body VolumeToken
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		logger.Error().Msgf("Failed to decode request: %v", err)
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+
+		return
+	}
+
+	if body.VolumeId == "" || body.GcsToken == "" {
+		jsonError(w, http.StatusBadRequest, errors.New("volumeId and gcsToken are required"))
+
+		return
+	}
+
+	// Serialized with mounting and unmounting, the token belongs to the mounted volume
+	a.initLock.Lock()
+	defer a.initLock.Unlock()
+
+	volumeConfig := mountedVolumes()[body.VolumeId]
+	if volumeConfig == nil {
+		jsonError(w, http.StatusNotFound, fmt.Errorf("volume %s is not mounted", body.VolumeId))
+
+		return
+	}
+
+	if DefaultVolumeTokenWriter == nil {
+		logger.Error().Msg("Volume token refresh requested but no token writer registered")
+		jsonError(w, http.StatusInternalServerError, errVolumeMountUnavailable)
+
+		return
+	}
+
+	if err := DefaultVolumeTokenWriter(volumeConfig.VolumeID, body.GcsToken); err != nil {
+		logger.Error().
+			Err(err).
+			Str("volumeId", volumeConfig.VolumeID).
+			Msg("Failed to write volume token")
+		jsonError(w, http.StatusInternalServerError, fmt.Errorf("volume token refresh failed: %w", err))
+
+		return
+	}
+
+	volumeConfig.GCSToken = body.GcsToken
+	volumeConfig.GCSTokenExpiry = body.GcsTokenExpiry
+
+	logger.Info().
+		Str("volumeId", volumeConfig.VolumeID).
+		Time("expiresAt", time.Unix(body.GcsTokenExpiry, 0)).
+		Msg("Volume token refreshed")
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DefaultVolumeReplication is set by the volume package during init, it returns the state of the
+// replication of the metadata of the mounted volume, nil when it isn't replicated.
+var DefaultVolumeReplication func(volumeID string) *host.VolumeReplication
+
+// DefaultVolumeCache is set by the volume package during init, it returns the local disk cache of
+// the mounted volume.
+var DefaultVolumeCache func(volumeID string) (*host.VolumeCache, error)
+
+// GetVolumeStatus returns a mounted volume, the state of the replication of its metadata, so a
+// Litestream daemon that keeps dying or falls behind is visible before the changes are lost, and how
+// many reads its local disk cache serves. The volume can be left out when only one is mounted.
+func (a *API) GetVolumeStatus(w http.ResponseWriter, r *http.Request, params GetVolumeStatusParams) {
+	defer r.Body.Close()
+
+	a.logger.Trace().Msg("Get volume status")
+
+	// Serialized with mounting and unmounting, the replication belongs to the mounted volume
+	a.initLock.Lock()
+	defer a.initLock.Unlock()
+
+	volumes := mountedVolumes()
+	var volumeConfig *host.VolumeConfig
+	switch {
+	case params.VolumeId != nil:
+		volumeConfig = volumes[*params.VolumeId]
+		if volumeConfig == nil {
+			jsonError(w, http.StatusNotFound, fmt.Errorf("volume %s is not mounted", *params.VolumeId))
+
+			return
+		}
+	case len(volumes) == 0:
+		jsonError(w, http.StatusNotFound, errors.New("no volume is mounted"))
+
+		return
+	case len(volumes) > 1:
+		jsonError(w, http.StatusBadRequest, errors.New("volumeId is required when several volumes are mounted"))
+
+		return
+	default:
+		for _, mounted := range volumes {
+			volumeConfig = mounted
+		}
+	}
+
+	response := VolumeStatus{
+		VolumeId:  volumeConfig.VolumeID,
+		MountPath: volumeConfig.MountPath,
+		ReadOnly:  volumeConfig.ReadOnly,
+	}
+	if DefaultVolumeReplication != nil {
+		if replication := DefaultVolumeReplication(volumeConfig.VolumeID); replication != nil {
+			response.Replication = volumeReplication(replication)
+		}
+	}
+	if DefaultVolumeCache != nil {
+		cache, err := DefaultVolumeCache(volumeConfig.VolumeID)
+		if err != nil {
+			a.logger.Warn().Err(err).Str("volume_id", volumeConfig.VolumeID).Msg("Failed to get volume cache")
+		} else if cache != nil {
+			response.Cache = volumeCache(cache)
+		}
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		a.logger.Error().Err(err).Msg("Failed to encode volume status")
+	}
+}
+
+func volumeCache(cache *host.VolumeCache) *VolumeCache {
+	result := &VolumeCache{
+		Dir:       cache.Dir,
+		SizeLimit: int64(cache.SizeLimit),
+		Used:      int64(cache.Used),
+		Blocks:    int64(cache.Blocks),
+		Hits:      int64(cache.Hits),
+		Misses:    int64(cache.Misses),
+		HitBytes:  int64(cache.HitBytes),
+		MissBytes: int64(cache.MissBytes),
+	}
+	if reads := cache.Hits + cache.Misses; reads > 0 {
+		ratio := float64(cache.Hits) / float64(reads)
+		result.HitRatio = &ratio
+	}
+
+	return result
+}
+
+func volumeReplication(replication *host.VolumeReplication) *VolumeReplication {
+	result := &VolumeReplication{
+		State:      VolumeReplicationState(replication.State),
+		Restarts:   replication.Restarts,
+		LagSeconds: replication.Lag.Seconds(),
+	}
+	if replication.Pid != 0 {
+		pid := int64(replication.Pid)
+		result.Pid = &pid
+	}
+	if replication.Error != "" {
+		result.Error = &replication.Error
+	}
+
+	return result
+}
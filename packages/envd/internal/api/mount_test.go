@@ -0,0 +1,170 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+func TestPostMount_RequiresVolumeID(t *testing.T) {
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.PostMount(w, httptest.NewRequest(http.MethodPost, "/mount", strings.NewReader(`{"mountPath":"/mnt/data"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// setMountedVolumes replaces the mounted volumes of the volume package for the test.
+func setMountedVolumes(t *testing.T, volumes ...*host.VolumeConfig) {
+	t.Helper()
+
+	DefaultMountedVolumes = func() map[string]*host.VolumeConfig {
+		mounted := make(map[string]*host.VolumeConfig, len(volumes))
+		for _, volume := range volumes {
+			mounted[volume.VolumeID] = volume
+		}
+
+		return mounted
+	}
+	t.Cleanup(func() { DefaultMountedVolumes = nil })
+}
+
+func TestPostMount_VolumeAlreadyMounted(t *testing.T) {
+	setMountedVolumes(t, &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data"})
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	for _, body := range []string{
+		`{"volumeId":"vol_1","mountPath":"/mnt/other"}`,
+		`{"volumeId":"vol_2","mountPath":"/mnt/data"}`,
+	} {
+		w := httptest.NewRecorder()
+		api.PostMount(w, httptest.NewRequest(http.MethodPost, "/mount", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "vol_1")
+	}
+}
+
+func TestPostUnmount_VolumeNotMounted(t *testing.T) {
+	setMountedVolumes(t, &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data"})
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.PostUnmount(w, httptest.NewRequest(http.MethodPost, "/unmount", strings.NewReader(`{"volumeId":"vol_2"}`)))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPostUnmount_OverlayVolume(t *testing.T) {
+	setMountedVolumes(t, &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data", OverlayPaths: []string{"/home"}})
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.PostUnmount(w, httptest.NewRequest(http.MethodPost, "/unmount", strings.NewReader(`{"volumeId":"vol_1"}`)))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPostVolumeToken_VolumeNotMounted(t *testing.T) {
+	mounted := &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data", GCSToken: "old"}
+	setMountedVolumes(t, mounted)
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.PostVolumeToken(w, httptest.NewRequest(http.MethodPost, "/volume/token", strings.NewReader(`{"volumeId":"vol_2","gcsToken":"new","gcsTokenExpiry":1700000000}`)))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "old", mounted.GCSToken)
+}
+
+func TestPostVolumeToken_ReplacesToken(t *testing.T) {
+	other := &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data", GCSToken: "old"}
+	mounted := &host.VolumeConfig{VolumeID: "vol_2", MountPath: "/mnt/other", GCSToken: "old"}
+	setMountedVolumes(t, other, mounted)
+
+	var writtenVolume, written string
+	DefaultVolumeTokenWriter = func(volumeID, token string) error {
+		writtenVolume, written = volumeID, token
+
+		return nil
+	}
+	t.Cleanup(func() { DefaultVolumeTokenWriter = nil })
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.PostVolumeToken(w, httptest.NewRequest(http.MethodPost, "/volume/token", strings.NewReader(`{"volumeId":"vol_2","gcsToken":"new","gcsTokenExpiry":1700000000}`)))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "vol_2", writtenVolume)
+	assert.Equal(t, "new", written)
+	assert.Equal(t, "new", mounted.GCSToken)
+	assert.Equal(t, int64(1700000000), mounted.GCSTokenExpiry)
+	assert.Equal(t, "old", other.GCSToken)
+}
+
+func TestGetVolumeStatus_SeveralVolumesMounted(t *testing.T) {
+	setMountedVolumes(t,
+		&host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data"},
+		&host.VolumeConfig{VolumeID: "vol_2", MountPath: "/mnt/other", ReadOnly: true},
+	)
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	w := httptest.NewRecorder()
+	api.GetVolumeStatus(w, httptest.NewRequest(http.MethodGet, "/volume/status", nil), GetVolumeStatusParams{})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	volumeID := "vol_2"
+	w = httptest.NewRecorder()
+	api.GetVolumeStatus(w, httptest.NewRequest(http.MethodGet, "/volume/status?volumeId=vol_2", nil), GetVolumeStatusParams{VolumeId: &volumeID})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"volumeId":"vol_2","mountPath":"/mnt/other","readOnly":true}`, w.Body.String())
+
+	volumeID = "vol_3"
+	w = httptest.NewRecorder()
+	api.GetVolumeStatus(w, httptest.NewRequest(http.MethodGet, "/volume/status?volumeId=vol_3", nil), GetVolumeStatusParams{VolumeId: &volumeID})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestAdoptMountedVolume_ReplacesToken(t *testing.T) {
+	current := &host.VolumeConfig{VolumeID: "vol_1", MountPath: "/mnt/data", GCSToken: "old"}
+
+	var written string
+	DefaultVolumeTokenWriter = func(_, token string) error {
+		written = token
+
+		return nil
+	}
+	t.Cleanup(func() { DefaultVolumeTokenWriter = nil })
+
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	volumeID, token, expiry := "vol_1", "resumed", int64(1700000000)
+	status, err := api.adoptMountedVolume(logger, current, &VolumeConfig{VolumeId: &volumeID, GcsToken: &token, GcsTokenExpiry: &expiry})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "resumed", written)
+	assert.Equal(t, "resumed", current.GCSToken)
+	assert.Equal(t, expiry, current.GCSTokenExpiry)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/supervisor"
+)
+
+// GetServices returns the status of the services depending on the volume, so it's visible
+// whether a service waits for the volume or keeps restarting.
+func (a *API) GetServices(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	a.logger.Trace().Msg("Get services")
+
+	response := ServicesStatus{Services: []ServiceStatus{}}
+	if a.services != nil {
+		mountPath, statuses := a.services.Status()
+		if mountPath != "" {
+			response.VolumeMounted = true
+			response.MountPath = &mountPath
+		}
+
+		for _, status := range statuses {
+			response.Services = append(response.Services, serviceStatus(status))
+		}
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		a.logger.Error().Err(err).Msg("Failed to encode services status")
+	}
+}
+
+func serviceStatus(status supervisor.Status) ServiceStatus {
+	result := ServiceStatus{
+		Name:     status.Name,
+		State:    ServiceStatusState(status.State),
+		Restarts: status.Restarts,
+	}
+	if status.Pid != 0 {
+		pid := int64(status.Pid)
+		result.Pid = &pid
+	}
+	if status.Error != "" {
+		result.Error = &status.Error
+	}
+
+	return result
+}
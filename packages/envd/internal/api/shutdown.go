@@ -2,9 +2,17 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/rs/zerolog"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/events"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/logs"
 )
@@ -27,7 +35,7 @@ type VolumeUnmounterFactory func(config *host.VolumeConfig) VolumeUnmounter
 var DefaultVolumeUnmounterFactory VolumeUnmounterFactory
 
 // PostShutdown handles the POST /shutdown endpoint.
-// This endpoint should be called before terminating the sandbox to ensure
+// This endpoint should be called before terminating or pausing the sandbox to ensure
 // all data is flushed (e.g., JuiceFS has a 300MB write buffer).
 func (a *API) PostShutdown(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
@@ -40,38 +48,31 @@ func (a *API) PostShutdown(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
-	// Unmount volumes if configured
-	volumeConfig := host.CurrentVolumeConfig
-	if volumeConfig != nil && DefaultVolumeUnmounterFactory != nil {
-		// TODO: Emit sandbox.shutdown.volume_unmount.started analytics event when envd events delivery is added
-		// Event should use events.SandboxShutdownVolumeUnmountStartedEvent type from shared/pkg/events/volume.go
-		logger.Info().
-			Str("volumeId", volumeConfig.VolumeID).
-			Str("mountPath", volumeConfig.MountPath).
-			Str("event", "sandbox.shutdown.volume_unmount.started").
-			Msg("Unmounting volume for graceful shutdown")
-
-		unmounter := DefaultVolumeUnmounterFactory(volumeConfig)
-		if err := unmounter.Unmount(ctx); err != nil {
-			// TODO: Emit sandbox.shutdown.volume_unmount.failed analytics event when envd events delivery is added
-			// Event should use events.SandboxShutdownVolumeUnmountFailedEvent type from shared/pkg/events/volume.go
-			logger.Error().
-				Err(err).
-				Str("volumeId", volumeConfig.VolumeID).
-				Str("mountPath", volumeConfig.MountPath).
-				Str("event", "sandbox.shutdown.volume_unmount.failed").
-				Msg("Failed to unmount volume")
+	// Serialized with mounting, /init of a resumed sandbox mounts the volume again
+	a.initLock.Lock()
+	defer a.initLock.Unlock()
+
+	// Unmount the volumes, the nested mount paths first
+	volumes := slices.SortedFunc(maps.Values(mountedVolumes()), func(first, second *host.VolumeConfig) int {
+		return strings.Compare(second.MountPath, first.MountPath)
+	})
+	if len(volumes) > 0 && DefaultVolumeUnmounterFactory != nil {
+		// Stopping the services flushes their writes to the volume before it's unmounted
+		if a.services != nil {
+			a.services.VolumeUnmounted()
+		}
+
+		var errs []error
+		for _, volumeConfig := range volumes {
+			if err := a.shutdownVolume(ctx, logger, volumeConfig); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
 			jsonError(w, http.StatusInternalServerError, err)
 			return
 		}
-
-		// TODO: Emit sandbox.shutdown.volume_unmount.completed analytics event when envd events delivery is added
-		// Event should use events.SandboxShutdownVolumeUnmountCompletedEvent type from shared/pkg/events/volume.go
-		logger.Info().
-			Str("volumeId", volumeConfig.VolumeID).
-			Str("mountPath", volumeConfig.MountPath).
-			Str("event", "sandbox.shutdown.volume_unmount.completed").
-			Msg("Volume unmounted successfully")
 	} else {
 		logger.Info().Msg("No volume to unmount")
 	}
@@ -80,3 +81,45 @@ func (a *API) PostShutdown(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "")
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// shutdownVolume unmounts the volume for the graceful shutdown.
+func (a *API) shutdownVolume(ctx context.Context, logger zerolog.Logger, volumeConfig *host.VolumeConfig) error {
+	a.events.PublishVolumeEvent(ctx, volumeEvent(events.ShutdownVolumeUnmountStarted, volumeConfig))
+	logger.Info().
+		Str("volumeId", volumeConfig.VolumeID).
+		Str("mountPath", volumeConfig.MountPath).
+		Str("event", events.ShutdownVolumeUnmountStarted).
+		Msg("Unmounting volume for graceful shutdown")
+
+	started := time.Now()
+	unmounter := DefaultVolumeUnmounterFactory(volumeConfig)
+	if err := unmounter.Unmount(ctx); err != nil {
+		a.events.PublishVolumeEvent(context.WithoutCancel(ctx), volumeEvent(events.ShutdownVolumeUnmountFailed, volumeConfig).
+			WithDuration(time.Since(started)).
+			WithError(err))
+		logger.Error().
+			Err(err).
+			Str("volumeId", volumeConfig.VolumeID).
+			Str("mountPath", volumeConfig.MountPath).
+			Str("event", events.ShutdownVolumeUnmountFailed).
+			Msg("Failed to unmount volume")
+
+		return fmt.Errorf("unmount volume %s: %w", volumeConfig.VolumeID, err)
+	}
+
+	// A paused sandbox is resumed from its snapshot with the volume unmounted,
+	// /init mounts it again with a token minted for the resume
+	if a.primaryVolume(volumeConfig.VolumeID) {
+		a.unsetPrimaryVolume()
+	}
+
+	a.events.PublishVolumeEvent(ctx, volumeEvent(events.ShutdownVolumeUnmountCompleted, volumeConfig).
+		WithDuration(time.Since(started)))
+	logger.Info().
+		Str("volumeId", volumeConfig.VolumeID).
+		Str("mountPath", volumeConfig.MountPath).
+		Str("event", events.ShutdownVolumeUnmountCompleted).
+		Msg("Volume unmounted successfully")
+
+	return nil
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/events"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/execcontext"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/supervisor"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/utils"
 )
 
@@ -21,17 +23,25 @@ type API struct {
 	mmdsChan      chan *host.MMDSOpts
 	hyperloopLock sync.Mutex
 
+	// events publishes the volume events through hyperloop
+	events *events.Publisher
+
 	lastSetTime *utils.AtomicMax
 	initLock    sync.Mutex
+
+	// services are started when the first volume is mounted and stopped before it's unmounted
+	services *supervisor.Supervisor
 }
 
-func New(l *zerolog.Logger, defaults *execcontext.Defaults, mmdsChan chan *host.MMDSOpts, isNotFC bool) *API {
+func New(l *zerolog.Logger, defaults *execcontext.Defaults, mmdsChan chan *host.MMDSOpts, isNotFC bool, services *supervisor.Supervisor) *API {
 	return &API{
 		logger:      l,
 		defaults:    defaults,
 		mmdsChan:    mmdsChan,
 		isNotFC:     isNotFC,
 		lastSetTime: utils.NewAtomicMax(),
+		services:    services,
+		events:      events.NewPublisher(l),
 	}
 }
 
@@ -46,6 +56,9 @@ func (a *API) GetHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// DefaultVolumeStats is set by the volume package during init, it returns nil when the volume isn't mounted.
+var DefaultVolumeStats func(volumeID string) (*host.VolumeMetrics, error)
+
 func (a *API) GetMetrics(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
@@ -62,6 +75,29 @@ func (a *API) GetMetrics(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The resource metrics are still reported when a volume can't be read
+	if DefaultVolumeStats != nil {
+		for volumeID := range mountedVolumes() {
+			volumeMetrics, err := DefaultVolumeStats(volumeID)
+			if err != nil {
+				a.logger.Warn().Err(err).Str("volume_id", volumeID).Msg("Failed to get volume metrics")
+
+				continue
+			}
+			if volumeMetrics == nil {
+				continue
+			}
+
+			if metrics.Volumes == nil {
+				metrics.Volumes = make(map[string]*host.VolumeMetrics)
+			}
+			metrics.Volumes[volumeID] = volumeMetrics
+			if a.primaryVolume(volumeID) {
+				metrics.Volume = volumeMetrics
+			}
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(metrics)
 }
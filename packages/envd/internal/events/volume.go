@@ -0,0 +1,140 @@
+// Package events publishes the events of envd through hyperloop. The orchestrator adds the context
+// of the sandbox and delivers them to analytics.
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Volume events, the types of the hyperloop VolumeEvent.
+const (
+	VolumeMountStarted   = "volume.mount.started"
+	VolumeMountCompleted = "volume.mount.completed"
+	VolumeMountFailed    = "volume.mount.failed"
+
+	ShutdownVolumeUnmountStarted   = "sandbox.shutdown.volume_unmount.started"
+	ShutdownVolumeUnmountCompleted = "sandbox.shutdown.volume_unmount.completed"
+	ShutdownVolumeUnmountFailed    = "sandbox.shutdown.volume_unmount.failed"
+)
+
+// PublishTimeout bounds delivering an event to hyperloop, the mount waits for it.
+const PublishTimeout = 2 * time.Second
+
+// VolumeEvent is a mount or unmount event of the volume of the sandbox.
+type VolumeEvent struct {
+	Type         string `json:"type"`
+	VolumeID     string `json:"volumeID"`
+	MountPath    string `json:"mountPath,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	DurationMs   *int64 `json:"durationMs,omitempty"`
+}
+
+// NewVolumeEvent returns an event of the volume mounted at the path.
+func NewVolumeEvent(eventType, volumeID, mountPath string) VolumeEvent {
+	return VolumeEvent{
+		Type:      eventType,
+		VolumeID:  volumeID,
+		MountPath: mountPath,
+	}
+}
+
+// WithDuration adds how long the mount or the unmount took to the event.
+func (e VolumeEvent) WithDuration(duration time.Duration) VolumeEvent {
+	ms := duration.Milliseconds()
+	e.DurationMs = &ms
+
+	return e
+}
+
+// WithError adds why the mount or the unmount failed to the event.
+func (e VolumeEvent) WithError(err error) VolumeEvent {
+	e.ErrorMessage = err.Error()
+
+	return e
+}
+
+// Publisher sends the events to hyperloop once its address is known. A nil publisher drops the events.
+type Publisher struct {
+	client http.Client
+	logger *zerolog.Logger
+
+	mu      sync.RWMutex
+	address string
+}
+
+// NewPublisher creates a publisher without the hyperloop address, the events are dropped until it's set.
+func NewPublisher(logger *zerolog.Logger) *Publisher {
+	return &Publisher{
+		client: http.Client{
+			Timeout: PublishTimeout,
+		},
+		logger: logger,
+	}
+}
+
+// SetHyperloopAddress sets the IP address hyperloop is reachable at.
+func (p *Publisher) SetHyperloopAddress(address string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.address = fmt.Sprintf("http://%s", address)
+}
+
+// PublishVolumeEvent sends the volume event to hyperloop. The events are best effort, a failed delivery
+// is only logged.
+func (p *Publisher) PublishVolumeEvent(ctx context.Context, event VolumeEvent) {
+	if p == nil {
+		return
+	}
+
+	p.mu.RLock()
+	address := p.address
+	p.mu.RUnlock()
+
+	if address == "" {
+		p.logger.Debug().Str("event_type", event.Type).Msg("Dropping volume event, the hyperloop address isn't set")
+
+		return
+	}
+
+	if err := p.send(ctx, address+"/events/volumes", event); err != nil {
+		p.logger.Warn().Err(err).Str("event_type", event.Type).Str("volume_id", event.VolumeID).Msg("Failed to publish volume event")
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, url string, event any) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("send event: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	return nil
+}
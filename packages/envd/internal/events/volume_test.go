@@ -0,0 +1,59 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishVolumeEvent(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan VolumeEvent, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event VolumeEvent
+		if r.URL.Path != "/events/volumes" || json.NewDecoder(r.Body).Decode(&event) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		received <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(server.Close)
+
+	logger := zerolog.Nop()
+	p := NewPublisher(&logger)
+
+	// The events are dropped until the hyperloop address is known
+	p.PublishVolumeEvent(t.Context(), NewVolumeEvent(VolumeMountStarted, "vol_test", "/workspace"))
+	assert.Empty(t, received)
+
+	p.SetHyperloopAddress(strings.TrimPrefix(server.URL, "http://"))
+	p.PublishVolumeEvent(t.Context(), NewVolumeEvent(VolumeMountFailed, "vol_test", "/workspace").
+		WithDuration(1500*time.Millisecond).
+		WithError(errors.New("bucket not reachable")))
+
+	require.Len(t, received, 1)
+	event := <-received
+	assert.Equal(t, VolumeMountFailed, event.Type)
+	assert.Equal(t, "vol_test", event.VolumeID)
+	assert.Equal(t, "/workspace", event.MountPath)
+	assert.Equal(t, "bucket not reachable", event.ErrorMessage)
+	require.NotNil(t, event.DurationMs)
+	assert.Equal(t, int64(1500), *event.DurationMs)
+
+	// A nil publisher drops the events
+	var nilPublisher *Publisher
+	nilPublisher.SetHyperloopAddress("127.0.0.1")
+	nilPublisher.PublishVolumeEvent(t.Context(), NewVolumeEvent(VolumeMountStarted, "vol_test", "/workspace"))
+}
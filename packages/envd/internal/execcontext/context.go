@@ -8,6 +8,8 @@ import (
 
 type Defaults struct {
 	EnvVars *utils.Map[string, string]
+	// Secrets are set in the environment of the processes like EnvVars, but are never returned by the envs endpoint.
+	Secrets *utils.Map[string, string]
 	User    string
 	Workdir *string
 }
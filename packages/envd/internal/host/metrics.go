@@ -28,6 +28,41 @@ type Metrics struct {
 
 	DiskUsed  uint64 `json:"disk_used"`  // Used disk space in bytes
 	DiskTotal uint64 `json:"disk_total"` // Total disk space in bytes
+
+	Volume  *VolumeMetrics            `json:"volume,omitempty"`  // Usage of the first volume mounted, unset without a volume
+	Volumes map[string]*VolumeMetrics `json:"volumes,omitempty"` // Usage of the mounted volumes by volume ID
+}
+
+// VolumeMetrics is the usage of the volume mounted in the sandbox.
+type VolumeMetrics struct {
+	Used  uint64 `json:"used"`  // Used space of the volume in bytes
+	Files uint64 `json:"files"` // Number of files and directories of the volume
+
+	ReadBps  float64 `json:"read_bps"`  // Bytes read per second since the previous metrics
+	WriteBps float64 `json:"write_bps"` // Bytes written per second since the previous metrics
+
+	LastSync int64 `json:"last_sync,omitempty"` // Unix timestamp of the last time no written data waited for upload
+}
+
+// VolumeReplication is the state of the Litestream daemon replicating the metadata of the mounted volume.
+type VolumeReplication struct {
+	State    string        // running, restarting or stopped
+	Pid      int           // Process ID of the running daemon
+	Restarts int           // Restarts since the volume was mounted
+	Error    string        // Why the daemon last exited or failed to start
+	Lag      time.Duration // How long the metadata changes not replicated yet have waited
+}
+
+// VolumeCache is the local disk cache of the mounted volume, the counters are since the volume was mounted.
+type VolumeCache struct {
+	Dir       string // Directory of the cache
+	SizeLimit uint64 // Size limit of the cache in bytes
+	Used      uint64 // Bytes of the cached blocks
+	Blocks    uint64 // Number of cached blocks
+	Hits      uint64 // Reads served from the cache
+	Misses    uint64 // Reads fetched from the bucket
+	HitBytes  uint64 // Bytes read from the cache
+	MissBytes uint64 // Bytes fetched from the bucket
 }
 
 func GetMetrics() (*Metrics, error) {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 const (
@@ -35,14 +36,81 @@ type VolumeConfig struct {
 	// MountPath is the path where the volume should be mounted (e.g., "/workspace").
 	MountPath string `json:"mountPath"`
 
-	// GCSBucket is the bucket name for volume data storage.
+	// StorageProvider is the object storage of the bucket, GCS when empty.
+	StorageProvider volumestorage.Provider `json:"storageProvider,omitempty"`
+
+	// GCSBucket is the bucket name for volume data storage, on the storage provider.
 	GCSBucket string `json:"gcsBucket"`
 
-	// GCSToken is the downscoped OAuth2 access token for GCS operations.
+	// GCSToken is the downscoped credentials for the bucket: the OAuth2 access token on GCS,
+	// the output of an AWS credential process on S3.
 	GCSToken string `json:"gcsToken"`
 
 	// GCSTokenExpiry is the Unix timestamp when the GCS token expires.
 	GCSTokenExpiry int64 `json:"gcsTokenExpiry"`
+
+	// GCSEndpoint is the GCS emulator or other GCS compatible endpoint for volume data, empty for the public API.
+	GCSEndpoint string `json:"gcsEndpoint,omitempty"`
+
+	// S3Region is the region of the S3 bucket.
+	S3Region string `json:"s3Region,omitempty"`
+
+	// S3Endpoint is the S3 compatible endpoint of the bucket, AWS S3 when empty.
+	S3Endpoint string `json:"s3Endpoint,omitempty"`
+
+	// MetaEngine is the metadata engine of the volume, SQLite replicated by Litestream when empty.
+	MetaEngine volumestorage.MetaEngine `json:"metaEngine,omitempty"`
+
+	// RedisMetaURL is the JuiceFS metadata URL of the Redis database of a volume with Redis metadata.
+	RedisMetaURL string `json:"redisMetaUrl,omitempty"`
+
+	// ProxyToken is the secret JuiceFS authenticates to the volume proxies of the sandbox with.
+	ProxyToken string `json:"proxyToken,omitempty"`
+
+	// Subpath is the directory of the volume (e.g., "/datasets/imagenet") mounted at MountPath instead
+	// of the whole volume, the rest of the volume isn't reachable from the sandbox.
+	Subpath string `json:"subpath,omitempty"`
+
+	// ReadOnlyRoot makes the template rootfs read-only once the volume is mounted.
+	ReadOnlyRoot bool `json:"readOnlyRoot,omitempty"`
+
+	// OverlayPaths are paths whose contents are persisted on the volume (e.g., "/home").
+	OverlayPaths []string `json:"overlayPaths,omitempty"`
+
+	// ReadOnly mounts the volume read-only without replicating metadata changes.
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// MountMemoryMB limits the memory of the JuiceFS and Litestream processes, 0 uses the default.
+	MountMemoryMB int64 `json:"mountMemoryMb,omitempty"`
+
+	// MountCPUWeight is the cgroup CPU weight of the JuiceFS and Litestream processes, 0 uses the default.
+	MountCPUWeight int64 `json:"mountCpuWeight,omitempty"`
+
+	// MountOptions are the JuiceFS format and mount options of the volume, see volumeoptions.
+	MountOptions map[string]string `json:"mountOptions,omitempty"`
+
+	// CheckpointIntervalSeconds is the interval between the checkpoints of the metadata replicated
+	// by Litestream, 0 uses the default.
+	CheckpointIntervalSeconds int64 `json:"checkpointIntervalSeconds,omitempty"`
+
+	// MountAttempts is how many times a mount failing transiently is attempted, 0 uses the default.
+	MountAttempts int `json:"mountAttempts,omitempty"`
+
+	// AllowMountFailure starts the sandbox without the volume when it can't be mounted.
+	AllowMountFailure bool `json:"allowMountFailure,omitempty"`
+
+	// Prewarm are the glob patterns, relative to MountPath, of the files pulled into the local
+	// cache after the mount.
+	Prewarm []string `json:"prewarm,omitempty"`
+
+	// PersistHome persists the home directory of the default user on the volume.
+	// envd resolves it into HomeDir, which is added to OverlayPaths, and its owner.
+	PersistHome bool `json:"persistHome,omitempty"`
+
+	// HomeDir is the persisted home directory, owned by HomeUID and HomeGID on the volume.
+	HomeDir string `json:"homeDir,omitempty"`
+	HomeUID int    `json:"homeUid,omitempty"`
+	HomeGID int    `json:"homeGid,omitempty"`
 }
 
 func (opts *MMDSOpts) Update(sandboxID, templateID, collectorAddress string) {
@@ -137,10 +205,6 @@ type VolumeMounterFactory func(config *VolumeConfig) VolumeMounter
 // DefaultVolumeMounterFactory is set by the volume package during init.
 var DefaultVolumeMounterFactory VolumeMounterFactory
 
-// CurrentVolumeConfig stores the volume config for the current sandbox.
-// This is set when the volume is mounted during init.
-var CurrentVolumeConfig *VolumeConfig
-
 func PollForMMDSOpts(ctx context.Context, mmdsChan chan<- *MMDSOpts, envVars *utils.Map[string, string]) {
 	httpClient := &http.Client{}
 	defer httpClient.CloseIdleConnections()
@@ -6,6 +6,8 @@ const (
 	ProcessTypePTY   ProcessType = "pty"
 	ProcessTypeUser  ProcessType = "user"
 	ProcessTypeSocat ProcessType = "socat"
+	// ProcessTypeVolume runs the JuiceFS and Litestream processes serving a mounted volume.
+	ProcessTypeVolume ProcessType = "volume"
 )
 
 type Manager interface {
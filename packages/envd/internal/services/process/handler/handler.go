@@ -120,6 +120,14 @@ func New(
 		})
 	}
 
+	if defaults.Secrets != nil {
+		defaults.Secrets.Range(func(key string, value string) bool {
+			formattedVars = append(formattedVars, key+"="+value)
+
+			return true
+		})
+	}
+
 	// Only the last values of the env vars are used - this allows for overwriting defaults
 	for key, value := range req.GetProcess().GetEnvs() {
 		formattedVars = append(formattedVars, key+"="+value)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os/user"
 	"strconv"
+	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
@@ -52,6 +53,51 @@ func (s *Service) InitializeStartProcess(ctx context.Context, user *user.User, r
 	return nil
 }
 
+// StartService starts a process of a service supervised by envd, it's listed with the other processes under its tag.
+// The returned channel is closed when the process ends.
+func (s *Service) StartService(ctx context.Context, user *user.User, req *rpc.StartRequest) (uint32, <-chan struct{}, error) {
+	ctx = logs.AddRequestIDToContext(ctx)
+
+	handlerL := s.logger.With().Str(string(logs.OperationIDKey), ctx.Value(logs.OperationIDKey).(string)).Logger()
+
+	procCtx, procCancel := context.WithCancel(ctx)
+	proc, err := handler.New(procCtx, user, req, &handlerL, s.defaults, s.cgroupManager, procCancel)
+	if err != nil {
+		procCancel()
+
+		return 0, nil, err
+	}
+
+	pid, err := proc.Start()
+	if err != nil {
+		procCancel()
+
+		return 0, nil, err
+	}
+
+	s.processes.Store(pid, proc)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer s.processes.Delete(pid)
+
+		proc.Wait()
+	}()
+
+	return pid, done, nil
+}
+
+// SignalProcess sends the signal to the process started by envd.
+func (s *Service) SignalProcess(pid uint32, signal syscall.Signal) error {
+	proc, ok := s.processes.Load(pid)
+	if !ok {
+		return fmt.Errorf("process with pid %d not found", pid)
+	}
+
+	return proc.SendSignal(signal)
+}
+
 func (s *Service) Start(ctx context.Context, req *connect.Request[rpc.StartRequest], stream *connect.ServerStream[rpc.StartResponse]) error {
 	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.handleStart)
 }
@@ -0,0 +1,365 @@
+// Package supervisor runs the services templates declare to depend on the volume, like a database
+// keeping its data directory on the volume. The services are started once the volume is mounted,
+// stopped before it's unmounted and restarted when it's mounted again.
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/execcontext"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/permissions"
+	rpc "github.com/moru-ai/sandbox-infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	// ConfigPath is the file in the template filesystem declaring the services.
+	ConfigPath = "/etc/moru/services.json"
+
+	// TagPrefix prefixes the name of the service in the tag of its process.
+	TagPrefix = "service:"
+
+	// stopTimeout is how long a service has to exit after SIGTERM before it's killed.
+	stopTimeout = 10 * time.Second
+
+	minRestartDelay = time.Second
+	maxRestartDelay = 30 * time.Second
+)
+
+// State is the state of a service.
+type State string
+
+const (
+	// StateWaitingForVolume is a service waiting for the volume to be mounted.
+	StateWaitingForVolume State = "waiting_for_volume"
+	// StateRunning is a service with a running process.
+	StateRunning State = "running"
+	// StateRestarting is a service whose process exited and is restarted after a delay.
+	StateRestarting State = "restarting"
+	// StateFailed is a service whose process could not be started, the start is retried after a delay.
+	StateFailed State = "failed"
+)
+
+// Definition is a service declared by the template.
+type Definition struct {
+	Name string   `json:"name"`
+	Cmd  string   `json:"cmd"`
+	Args []string `json:"args,omitempty"`
+	// User runs the service, the default user of the sandbox when empty.
+	User string            `json:"user,omitempty"`
+	Cwd  *string           `json:"cwd,omitempty"`
+	Envs map[string]string `json:"envs,omitempty"`
+}
+
+type config struct {
+	Services []Definition `json:"services"`
+}
+
+// Status is the current state of a service.
+type Status struct {
+	Name  string
+	State State
+	// Pid is the process of the running service, 0 when it's not running.
+	Pid      uint32
+	Restarts int
+	// Error is why the process last exited or failed to start.
+	Error string
+}
+
+// Starter starts and signals the processes of the services.
+type Starter interface {
+	StartService(ctx context.Context, user *user.User, req *rpc.StartRequest) (uint32, <-chan struct{}, error)
+	SignalProcess(pid uint32, signal syscall.Signal) error
+}
+
+// Supervisor starts the declared services when the volume is mounted and keeps them running until it's unmounted.
+type Supervisor struct {
+	logger     *zerolog.Logger
+	starter    Starter
+	defaults   *execcontext.Defaults
+	configPath string
+
+	mu        sync.Mutex
+	mountPath string
+	services  []*service
+}
+
+type service struct {
+	definition Definition
+	cancel     context.CancelFunc
+	done       chan struct{}
+
+	mu       sync.Mutex
+	state    State
+	pid      uint32
+	restarts int
+	err      string
+}
+
+func New(logger *zerolog.Logger, starter Starter, defaults *execcontext.Defaults) *Supervisor {
+	return &Supervisor{
+		logger:     logger,
+		starter:    starter,
+		defaults:   defaults,
+		configPath: ConfigPath,
+	}
+}
+
+// VolumeMounted starts the declared services in their order, the running services are restarted first,
+// so the services of a remounted volume don't keep files of the previous mount open.
+func (s *Supervisor) VolumeMounted(mountPath string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopLocked()
+	s.mountPath = mountPath
+
+	definitions, err := loadDefinitions(s.configPath)
+	if err != nil {
+		s.logger.Error().Err(err).Str("path", s.configPath).Msg("Failed to load the services depending on the volume")
+		s.services = nil
+
+		return
+	}
+
+	s.services = make([]*service, 0, len(definitions))
+	for _, definition := range definitions {
+		ctx, cancel := context.WithCancel(context.Background())
+		svc := &service{
+			definition: definition,
+			cancel:     cancel,
+			done:       make(chan struct{}),
+			state:      StateWaitingForVolume,
+		}
+		s.services = append(s.services, svc)
+
+		// The next service is only started after the process of this one
+		started := make(chan struct{})
+		go s.supervise(ctx, svc, started)
+		<-started
+	}
+
+	if len(s.services) > 0 {
+		s.logger.Info().Int("services", len(s.services)).Str("mountPath", mountPath).Msg("Started the services depending on the volume")
+	}
+}
+
+// VolumeUnmounted stops the services, they wait for the volume to be mounted again.
+func (s *Supervisor) VolumeUnmounted() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopLocked()
+	s.mountPath = ""
+}
+
+// Status returns the mount path of the volume, empty when no volume is mounted, and the state of the services.
+func (s *Supervisor) Status() (string, []Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.services == nil && s.mountPath == "" {
+		// Nothing was started yet, the declared services are all waiting for the volume
+		definitions, err := loadDefinitions(s.configPath)
+		if err != nil {
+			return "", nil
+		}
+
+		statuses := make([]Status, 0, len(definitions))
+		for _, definition := range definitions {
+			statuses = append(statuses, Status{Name: definition.Name, State: StateWaitingForVolume})
+		}
+
+		return "", statuses
+	}
+
+	statuses := make([]Status, 0, len(s.services))
+	for _, svc := range s.services {
+		statuses = append(statuses, svc.status())
+	}
+
+	return s.mountPath, statuses
+}
+
+// stopLocked stops the services in the reverse order of their start.
+func (s *Supervisor) stopLocked() {
+	for i := len(s.services) - 1; i >= 0; i-- {
+		svc := s.services[i]
+		if svc.cancel == nil {
+			continue
+		}
+
+		svc.cancel()
+		<-svc.done
+
+		svc.cancel = nil
+		svc.set(StateWaitingForVolume, 0, "")
+	}
+}
+
+// supervise starts the process of the service and restarts it when it exits, until the context is canceled.
+func (s *Supervisor) supervise(ctx context.Context, svc *service, started chan<- struct{}) {
+	defer close(svc.done)
+
+	logger := s.logger.With().Str("service", svc.definition.Name).Logger()
+
+	delay := minRestartDelay
+	for first := true; ; first = false {
+		pid, exited, err := s.start(svc.definition)
+		if first {
+			close(started)
+		}
+
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to start the service")
+			svc.set(StateFailed, 0, err.Error())
+		} else {
+			svc.set(StateRunning, pid, "")
+			startedAt := time.Now()
+
+			select {
+			case <-ctx.Done():
+				s.stop(logger, pid, exited)
+
+				return
+			case <-exited:
+			}
+
+			// A service that ran for a while is restarted right away again
+			if time.Since(startedAt) > maxRestartDelay {
+				delay = minRestartDelay
+			}
+
+			logger.Warn().Uint32("pid", pid).Dur("restartIn", delay).Msg("Service exited, restarting")
+			svc.set(StateRestarting, 0, "process exited")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		svc.restarted()
+		delay = min(delay*2, maxRestartDelay)
+	}
+}
+
+func (s *Supervisor) start(definition Definition) (uint32, <-chan struct{}, error) {
+	username := definition.User
+	if username == "" {
+		username = s.defaults.User
+	}
+
+	u, err := permissions.GetUser(username)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get user %q: %w", username, err)
+	}
+
+	tag := TagPrefix + definition.Name
+	envs := definition.Envs
+	if envs == nil {
+		envs = make(map[string]string)
+	}
+
+	// The service outlives the request mounting the volume, it's stopped by the supervisor
+	return s.starter.StartService(context.Background(), u, &rpc.StartRequest{
+		Tag: &tag,
+		Process: &rpc.ProcessConfig{
+			Cmd:  definition.Cmd,
+			Args: definition.Args,
+			Envs: envs,
+			Cwd:  definition.Cwd,
+		},
+	})
+}
+
+// stop terminates the process of the service, killing it when it doesn't exit in time.
+func (s *Supervisor) stop(logger zerolog.Logger, pid uint32, exited <-chan struct{}) {
+	if err := s.starter.SignalProcess(pid, syscall.SIGTERM); err != nil {
+		logger.Warn().Err(err).Uint32("pid", pid).Msg("Failed to terminate the service")
+	}
+
+	select {
+	case <-exited:
+		return
+	case <-time.After(stopTimeout):
+	}
+
+	logger.Warn().Uint32("pid", pid).Msg("Service didn't exit in time, killing it")
+	if err := s.starter.SignalProcess(pid, syscall.SIGKILL); err != nil {
+		logger.Warn().Err(err).Uint32("pid", pid).Msg("Failed to kill the service")
+	}
+
+	<-exited
+}
+
+func (svc *service) set(state State, pid uint32, err string) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.state = state
+	svc.pid = pid
+	svc.err = err
+}
+
+func (svc *service) restarted() {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.restarts++
+}
+
+func (svc *service) status() Status {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	return Status{
+		Name:     svc.definition.Name,
+		State:    svc.state,
+		Pid:      svc.pid,
+		Restarts: svc.restarts,
+		Error:    svc.err,
+	}
+}
+
+// loadDefinitions reads the declared services, templates without the file have no services.
+func loadDefinitions(path string) ([]Definition, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the services: %w", err)
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode the services: %w", err)
+	}
+
+	names := make(map[string]struct{}, len(cfg.Services))
+	for i, definition := range cfg.Services {
+		if definition.Name == "" {
+			return nil, fmt.Errorf("service %d has no name", i)
+		}
+		if definition.Cmd == "" {
+			return nil, fmt.Errorf("service %q has no cmd", definition.Name)
+		}
+		if _, ok := names[definition.Name]; ok {
+			return nil, fmt.Errorf("service %q is declared more than once", definition.Name)
+		}
+		names[definition.Name] = struct{}{}
+	}
+
+	return cfg.Services, nil
+}
@@ -0,0 +1,150 @@
+package supervisor
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/execcontext"
+	rpc "github.com/moru-ai/sandbox-infra/packages/envd/internal/services/spec/process"
+)
+
+type fakeStarter struct {
+	mu      sync.Mutex
+	nextPid uint32
+	started []string
+	signals []syscall.Signal
+	exited  map[uint32]chan struct{}
+}
+
+func newFakeStarter() *fakeStarter {
+	return &fakeStarter{nextPid: 100, exited: make(map[uint32]chan struct{})}
+}
+
+func (f *fakeStarter) StartService(_ context.Context, _ *user.User, req *rpc.StartRequest) (uint32, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextPid++
+	f.started = append(f.started, req.GetTag())
+	exited := make(chan struct{})
+	f.exited[f.nextPid] = exited
+
+	return f.nextPid, exited, nil
+}
+
+func (f *fakeStarter) SignalProcess(pid uint32, signal syscall.Signal) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.signals = append(f.signals, signal)
+	if exited, ok := f.exited[pid]; ok {
+		close(exited)
+		delete(f.exited, pid)
+	}
+
+	return nil
+}
+
+func newTestSupervisor(t *testing.T, services string) (*Supervisor, *fakeStarter) {
+	t.Helper()
+
+	currentUser, err := user.Current()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "services.json")
+	require.NoError(t, os.WriteFile(path, []byte(services), 0o644))
+
+	logger := zerolog.Nop()
+	starter := newFakeStarter()
+	s := New(&logger, starter, &execcontext.Defaults{User: currentUser.Username})
+	s.configPath = path
+
+	return s, starter
+}
+
+func TestSupervisor_WaitsForVolume(t *testing.T) {
+	s, starter := newTestSupervisor(t, `{"services":[{"name":"db","cmd":"postgres"}]}`)
+
+	mountPath, statuses := s.Status()
+
+	assert.Empty(t, mountPath)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, StateWaitingForVolume, statuses[0].State)
+	assert.Empty(t, starter.started)
+}
+
+func TestSupervisor_StartsInOrderAndRestartsOnRemount(t *testing.T) {
+	s, starter := newTestSupervisor(t, `{"services":[{"name":"db","cmd":"postgres"},{"name":"app","cmd":"app"}]}`)
+
+	s.VolumeMounted("/workspace")
+
+	mountPath, statuses := s.Status()
+	assert.Equal(t, "/workspace", mountPath)
+	require.Len(t, statuses, 2)
+	for _, status := range statuses {
+		assert.Equal(t, StateRunning, status.State)
+		assert.NotZero(t, status.Pid)
+	}
+	assert.Equal(t, []string{"service:db", "service:app"}, starter.started)
+
+	s.VolumeUnmounted()
+
+	mountPath, statuses = s.Status()
+	assert.Empty(t, mountPath)
+	for _, status := range statuses {
+		assert.Equal(t, StateWaitingForVolume, status.State)
+		assert.Zero(t, status.Pid)
+	}
+	assert.Equal(t, []syscall.Signal{syscall.SIGTERM, syscall.SIGTERM}, starter.signals)
+
+	s.VolumeMounted("/workspace")
+
+	_, statuses = s.Status()
+	for _, status := range statuses {
+		assert.Equal(t, StateRunning, status.State)
+	}
+	assert.Len(t, starter.started, 4)
+}
+
+func TestSupervisor_RemountStopsRunningServices(t *testing.T) {
+	s, starter := newTestSupervisor(t, `{"services":[{"name":"db","cmd":"postgres"}]}`)
+
+	s.VolumeMounted("/workspace")
+	s.VolumeMounted("/data")
+
+	mountPath, statuses := s.Status()
+	assert.Equal(t, "/data", mountPath)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, uint32(102), statuses[0].Pid)
+	assert.Equal(t, []syscall.Signal{syscall.SIGTERM}, starter.signals)
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	dir := t.TempDir()
+
+	definitions, err := loadDefinitions(filepath.Join(dir, "missing.json"))
+	require.NoError(t, err)
+	assert.Empty(t, definitions)
+
+	for name, content := range map[string]string{
+		"no name":   `{"services":[{"cmd":"postgres"}]}`,
+		"no cmd":    `{"services":[{"name":"db"}]}`,
+		"duplicate": `{"services":[{"name":"db","cmd":"a"},{"name":"db","cmd":"b"}]}`,
+		"invalid":   `{"services":`,
+	} {
+		path := filepath.Join(dir, "services.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+		_, err := loadDefinitions(path)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,69 @@
+package volume
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
+)
+
+const (
+	// defaultCacheSizeMB is the size of the local disk cache of the mount.
+	defaultCacheSizeMB = 1024
+
+	// The metrics of the stats file of the local disk cache.
+	cacheHitsMetric      = "juicefs_blockcache_hits"
+	cacheMissesMetric    = "juicefs_blockcache_miss"
+	cacheHitBytesMetric  = "juicefs_blockcache_hit_bytes"
+	cacheMissBytesMetric = "juicefs_blockcache_miss_bytes"
+	cacheBlocksMetric    = "juicefs_blockcache_blocks"
+	cacheBytesMetric     = "juicefs_blockcache_bytes"
+)
+
+// cacheDir returns the directory of the JuiceFS local cache of the volume. A cache directory of the
+// volume options gets a directory per volume, so the volumes mounted in turn don't share blocks.
+func (m *Mounter) cacheDir() string {
+	if dir := volumeoptions.String(m.config.MountOptions, volumeoptions.CacheDir, ""); dir != "" {
+		return filepath.Join(dir, m.config.VolumeID)
+	}
+
+	return filepath.Join(m.stateDir(), "jfscache")
+}
+
+// cacheSizeMB returns the size limit of the JuiceFS local cache of the volume.
+func (m *Mounter) cacheSizeMB() int64 {
+	return volumeoptions.Int(m.config.MountOptions, volumeoptions.CacheSizeMB, defaultCacheSizeMB)
+}
+
+// CacheStatus returns the configuration and the hit counters of the local disk cache of the volume,
+// the counters are since the volume was mounted.
+func (m *Mounter) CacheStatus() (*host.VolumeCache, error) {
+	data, err := os.ReadFile(filepath.Join(m.mountPath, StatsFile))
+	if err != nil {
+		return nil, fmt.Errorf("read volume stats: %w", err)
+	}
+	counters := parseStats(data)
+
+	return &host.VolumeCache{
+		Dir:       m.cacheDir(),
+		SizeLimit: uint64(m.cacheSizeMB()) << 20,
+		Used:      uint64(counters[cacheBytesMetric]),
+		Blocks:    uint64(counters[cacheBlocksMetric]),
+		Hits:      uint64(counters[cacheHitsMetric]),
+		Misses:    uint64(counters[cacheMissesMetric]),
+		HitBytes:  uint64(counters[cacheHitBytesMetric]),
+		MissBytes: uint64(counters[cacheMissBytesMetric]),
+	}, nil
+}
+
+// VolumeCacheStatus returns the local disk cache of the mounted volume, nil when the volume isn't mounted.
+func VolumeCacheStatus(volumeID string) (*host.VolumeCache, error) {
+	m := mountedVolume(volumeID)
+	if m == nil {
+		return nil, nil
+	}
+
+	return m.CacheStatus()
+}
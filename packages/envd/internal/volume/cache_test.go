@@ -0,0 +1,66 @@
+package volume
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+func TestMounterCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		m := NewMounter(&host.VolumeConfig{VolumeID: "vol_test", MountPath: t.TempDir()})
+
+		assert.Equal(t, filepath.Join(StateDir, "vol_test", "jfscache"), m.cacheDir())
+		assert.Equal(t, int64(defaultCacheSizeMB), m.cacheSizeMB())
+	})
+
+	t.Run("volume options", func(t *testing.T) {
+		t.Parallel()
+
+		m := NewMounter(&host.VolumeConfig{
+			VolumeID:     "vol_test",
+			MountPath:    t.TempDir(),
+			MountOptions: map[string]string{"cacheDir": "/mnt/cache", "cacheSizeMB": "20480"},
+		})
+
+		assert.Equal(t, "/mnt/cache/vol_test", m.cacheDir())
+		assert.Equal(t, int64(20480), m.cacheSizeMB())
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		mountPath := t.TempDir()
+		m := NewMounter(&host.VolumeConfig{VolumeID: "vol_test", MountPath: mountPath})
+
+		require.NoError(t, os.WriteFile(filepath.Join(mountPath, StatsFile), []byte(`juicefs_blockcache_hits 30
+juicefs_blockcache_miss 10
+juicefs_blockcache_hit_bytes 3.2e+07
+juicefs_blockcache_miss_bytes 4096
+juicefs_blockcache_blocks 8
+juicefs_blockcache_bytes 1.6e+07
+`), 0o644))
+
+		cache, err := m.CacheStatus()
+		require.NoError(t, err)
+		assert.Equal(t, &host.VolumeCache{
+			Dir:       m.cacheDir(),
+			SizeLimit: defaultCacheSizeMB << 20,
+			Used:      16000000,
+			Blocks:    8,
+			Hits:      30,
+			Misses:    10,
+			HitBytes:  32000000,
+			MissBytes: 4096,
+		}, cache)
+	})
+}
@@ -0,0 +1,97 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// checkpointSyncTimeout is how long a periodic checkpoint waits for Litestream to replicate it.
+const checkpointSyncTimeout = time.Minute
+
+// checkpointer runs the periodic checkpoints of a mounted volume until it's stopped.
+type checkpointer struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// checkpointInterval returns the interval between the checkpoints of the replicated metadata.
+func (m *Mounter) checkpointInterval() time.Duration {
+	if m.config.CheckpointIntervalSeconds > 0 {
+		return time.Duration(m.config.CheckpointIntervalSeconds) * time.Second
+	}
+
+	return DefaultCheckpointInterval
+}
+
+// startCheckpoints checkpoints the metadata at the checkpoint interval and waits for Litestream to
+// replicate it, so the replica in the bucket is never more than an interval behind, even when the
+// sandbox is lost before the unmount.
+func (m *Mounter) startCheckpoints() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &checkpointer{cancel: cancel, done: make(chan struct{})}
+	m.checkpoints = c
+
+	interval := m.checkpointInterval()
+	watchdog := m.litestream
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := m.checkpoint(ctx, watchdog); err != nil && ctx.Err() == nil {
+				m.logger.Error().Err(err).Msg("Volume checkpoint failed")
+			}
+		}
+	}()
+
+	m.logger.Info().Dur("interval", interval).Msg("Volume checkpoints started")
+}
+
+// stopCheckpoints stops the periodic checkpoints and waits for a running one to return.
+func (m *Mounter) stopCheckpoints() {
+	if m.checkpoints == nil {
+		return
+	}
+
+	m.checkpoints.cancel()
+	<-m.checkpoints.done
+	m.checkpoints = nil
+}
+
+// checkpoint moves the WAL of the metadata into the DB and waits for Litestream to replicate the
+// changes. The checkpoint is passive so it never blocks JuiceFS, the frames Litestream still reads
+// are checkpointed the next time.
+func (m *Mounter) checkpoint(ctx context.Context, watchdog *litestreamWatchdog) error {
+	started := time.Now()
+
+	output, err := m.walCheckpoint(ctx, "PASSIVE")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkpointSyncTimeout)
+	defer cancel()
+
+	if err := watchdog.waitSynced(ctx); err != nil {
+		return fmt.Errorf("replicate checkpoint: %w", err)
+	}
+
+	m.logger.Info().
+		Str("step", "checkpoint").
+		Str("result", strings.TrimSpace(output)).
+		Dur("duration", time.Since(started)).
+		Msg("Volume checkpoint completed")
+
+	return nil
+}
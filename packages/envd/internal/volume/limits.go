@@ -0,0 +1,172 @@
+package volume
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/services/cgroups"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
+)
+
+// cgroupRoot is the cgroup2 hierarchy the mount cgroup is created in, envd sets it from its cgroup root.
+var cgroupRoot = "/sys/fs/cgroup"
+
+// SetCgroupRoot sets the cgroup2 hierarchy of the mount cgroups, it must be called before any volume is mounted.
+func SetCgroupRoot(root string) {
+	cgroupRoot = root
+}
+
+const (
+	// CgroupPath prefixes the cgroup, relative to the cgroup root, the JuiceFS and Litestream processes of
+	// a volume run in. Each volume has its own cgroup with its own limits.
+	CgroupPath = "volume"
+
+	// defaultBufferSizeMB is the JuiceFS default read/write buffer size.
+	defaultBufferSizeMB = 300
+
+	// defaultMemoryDivisor gives the volume processes a quarter of the sandbox memory by default.
+	defaultMemoryDivisor = 4
+
+	// defaultCPUWeight matches the weight of other system processes.
+	defaultCPUWeight = 100
+
+	// helperOOMScoreAdj makes the kernel pick user processes over the volume processes on OOM.
+	helperOOMScoreAdj = -500
+)
+
+// mountLimits are the resources granted to the processes serving the volume.
+type mountLimits struct {
+	MemoryMB     int64
+	BufferSizeMB int64
+	CPUWeight    int64
+}
+
+// resolveMountLimits applies the defaults to the limits from the attach spec and sizes
+// the JuiceFS buffer to fit the memory limit.
+func resolveMountLimits(config *host.VolumeConfig, memTotalMB int64) mountLimits {
+	limits := mountLimits{
+		MemoryMB:  config.MountMemoryMB,
+		CPUWeight: config.MountCPUWeight,
+	}
+
+	if limits.MemoryMB <= 0 {
+		limits.MemoryMB = memTotalMB / defaultMemoryDivisor
+	}
+
+	if limits.CPUWeight <= 0 {
+		limits.CPUWeight = defaultCPUWeight
+	}
+
+	// A smaller buffer makes the mount slower under pressure, running out of memory kills it
+	bufferSizeMB := volumeoptions.Int(config.MountOptions, volumeoptions.BufferSizeMB, defaultBufferSizeMB)
+	limits.BufferSizeMB = min(bufferSizeMB, limits.MemoryMB-volumeoptions.HelperOverheadMB)
+	if limits.BufferSizeMB < volumeoptions.MinBufferSizeMB {
+		limits.BufferSizeMB = volumeoptions.MinBufferSizeMB
+		limits.MemoryMB = volumeoptions.MinMountMemoryMB
+	}
+
+	return limits
+}
+
+// cgroupProperties translates the limits to cgroup2 controller files.
+//
+// Only memory.high is set, not memory.max: when the processes reach the limit they are
+// throttled and their memory reclaimed instead of being killed by the cgroup OOM killer.
+func (l mountLimits) cgroupProperties() map[string]string {
+	return map[string]string{
+		"memory.high": strconv.FormatInt(l.MemoryMB*1024*1024, 10),
+		"memory.low":  strconv.FormatInt(min(l.MemoryMB, volumeoptions.HelperOverheadMB)*1024*1024, 10),
+		"cpu.weight":  strconv.FormatInt(l.CPUWeight, 10),
+	}
+}
+
+// setupLimits creates the cgroup for the volume processes. When the cgroup can't be created,
+// the processes run without limits, the mount itself must not fail because of it.
+func (m *Mounter) setupLimits() {
+	var memTotalMB int64
+	if metrics, err := host.GetMetrics(); err == nil {
+		memTotalMB = int64(metrics.MemTotalMiB)
+	} else {
+		m.logger.Warn().Err(err).Msg("Failed to read the memory for the volume limits")
+	}
+
+	m.limits = resolveMountLimits(m.config, memTotalMB)
+
+	cgroupManager, err := cgroups.NewCgroup2Manager(
+		cgroups.WithCgroup2RootSysFSPath(cgroupRoot),
+		cgroups.WithCgroup2ProcessType(cgroups.ProcessTypeVolume, m.cgroupPath(), m.limits.cgroupProperties()),
+	)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("Running the volume processes without cgroup limits")
+	} else {
+		m.cgroupManager = cgroupManager
+	}
+
+	m.logger.Info().
+		Int64("memory_mb", m.limits.MemoryMB).
+		Int64("buffer_size_mb", m.limits.BufferSizeMB).
+		Int64("cpu_weight", m.limits.CPUWeight).
+		Bool("cgroup", m.cgroupManager != nil).
+		Msg("Volume limits set up")
+}
+
+// cgroupPath returns the cgroup of the processes of the volume, relative to the cgroup root.
+func (m *Mounter) cgroupPath() string {
+	return CgroupPath + "-" + m.config.VolumeID
+}
+
+// sysProcAttr starts a process directly in the volume cgroup.
+func (m *Mounter) sysProcAttr() *syscall.SysProcAttr {
+	if m.cgroupManager == nil {
+		return nil
+	}
+
+	fd, ok := m.cgroupManager.GetFileDescriptor(cgroups.ProcessTypeVolume)
+	if !ok {
+		return nil
+	}
+
+	return &syscall.SysProcAttr{
+		UseCgroupFD: true,
+		CgroupFD:    fd,
+	}
+}
+
+// protectHelpers lowers the OOM score of every process in the volume cgroup,
+// including the daemonized JuiceFS processes that envd doesn't track directly.
+func (m *Mounter) protectHelpers() {
+	if m.cgroupManager == nil {
+		return
+	}
+
+	procs, err := os.ReadFile(filepath.Join(cgroupRoot, m.cgroupPath(), "cgroup.procs"))
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("Failed to list the processes of the volume cgroup")
+
+		return
+	}
+
+	for _, pid := range strings.Fields(string(procs)) {
+		path := filepath.Join("/proc", pid, "oom_score_adj")
+		if err := os.WriteFile(path, []byte(strconv.Itoa(helperOOMScoreAdj)), 0o644); err != nil {
+			m.logger.Warn().Err(err).Str("pid", pid).Msg("Failed to set the OOM score of a volume process")
+		}
+	}
+}
+
+// releaseLimits closes the cgroup file descriptor held for starting processes.
+func (m *Mounter) releaseLimits() {
+	if m.cgroupManager == nil {
+		return
+	}
+
+	if err := m.cgroupManager.Close(); err != nil {
+		m.logger.Warn().Err(err).Msg("Failed to close the volume cgroup")
+	}
+
+	m.cgroupManager = nil
+}
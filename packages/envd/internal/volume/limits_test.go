@@ -0,0 +1,67 @@
+package volume
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+func TestResolveMountLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		config     host.VolumeConfig
+		memTotalMB int64
+		want       mountLimits
+	}{
+		{
+			name:       "defaults to a quarter of the memory",
+			memTotalMB: 4096,
+			want:       mountLimits{MemoryMB: 1024, BufferSizeMB: 300, CPUWeight: 100},
+		},
+		{
+			name:       "shrinks the buffer to fit the memory",
+			memTotalMB: 1024,
+			want:       mountLimits{MemoryMB: 256, BufferSizeMB: 160, CPUWeight: 100},
+		},
+		{
+			name:       "raises the memory to fit the minimal buffer",
+			memTotalMB: 256,
+			want:       mountLimits{MemoryMB: 128, BufferSizeMB: 32, CPUWeight: 100},
+		},
+		{
+			name:       "unknown memory",
+			memTotalMB: 0,
+			want:       mountLimits{MemoryMB: 128, BufferSizeMB: 32, CPUWeight: 100},
+		},
+		{
+			name:       "explicit limits",
+			config:     host.VolumeConfig{MountMemoryMB: 512, MountCPUWeight: 50},
+			memTotalMB: 4096,
+			want:       mountLimits{MemoryMB: 512, BufferSizeMB: 300, CPUWeight: 50},
+		},
+		{
+			name:       "buffer size option",
+			config:     host.VolumeConfig{MountMemoryMB: 1024, MountOptions: map[string]string{"bufferSizeMB": "512"}},
+			memTotalMB: 4096,
+			want:       mountLimits{MemoryMB: 1024, BufferSizeMB: 512, CPUWeight: 100},
+		},
+		{
+			name:       "buffer size option capped by the memory",
+			config:     host.VolumeConfig{MountOptions: map[string]string{"bufferSizeMB": "2048"}},
+			memTotalMB: 2048,
+			want:       mountLimits{MemoryMB: 512, BufferSizeMB: 416, CPUWeight: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, resolveMountLimits(&tt.config, tt.memTotalMB))
+		})
+	}
+}
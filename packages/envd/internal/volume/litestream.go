@@ -0,0 +1,299 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+const (
+	// minLitestreamRestartDelay and maxLitestreamRestartDelay bound the backoff between restarts of Litestream.
+	minLitestreamRestartDelay = time.Second
+	maxLitestreamRestartDelay = 30 * time.Second
+
+	// lagSampleInterval is how often the replication lag is sampled, like the sync interval of Litestream.
+	lagSampleInterval = time.Second
+)
+
+const (
+	replicationRunning    = "running"
+	replicationRestarting = "restarting"
+	replicationStopped    = "stopped"
+)
+
+// litestreamWatchdog keeps the Litestream replication daemon running until the volume is unmounted,
+// the daemon is restarted with a backoff whenever it exits. Without it, the metadata changes made after
+// the daemon died would be lost with the sandbox.
+type litestreamWatchdog struct {
+	logger zerolog.Logger
+	newCmd func() *exec.Cmd
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+
+	mu       sync.Mutex
+	state    string
+	pid      int
+	restarts int
+	err      string
+	stopErr  error
+	lag      lagTracker
+}
+
+// startLitestreamWatchdog starts the daemon built by newCmd and watches it. Only failing to start the
+// first process is returned, later failures are retried.
+func startLitestreamWatchdog(logger zerolog.Logger, dbPath string, newCmd func() *exec.Cmd) (*litestreamWatchdog, error) {
+	cmd := newCmd()
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start litestream: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &litestreamWatchdog{
+		logger: logger,
+		newCmd: newCmd,
+		cancel: cancel,
+		state:  replicationRunning,
+		pid:    cmd.Process.Pid,
+		lag:    lagTracker{dbPath: dbPath},
+	}
+
+	w.done.Add(2)
+	go func() {
+		defer w.done.Done()
+		w.watch(ctx, cmd)
+	}()
+	go func() {
+		defer w.done.Done()
+		w.sampleLag(ctx)
+	}()
+
+	return w, nil
+}
+
+// watch waits for the daemon to exit and restarts it, until the watchdog is stopped.
+func (w *litestreamWatchdog) watch(ctx context.Context, cmd *exec.Cmd) {
+	delay := minLitestreamRestartDelay
+
+	for {
+		if cmd != nil {
+			startedAt := time.Now()
+			exited := make(chan error, 1)
+			go func() {
+				exited <- cmd.Wait()
+			}()
+
+			select {
+			case <-ctx.Done():
+				err := w.terminate(cmd, exited)
+
+				w.mu.Lock()
+				w.state, w.pid, w.stopErr = replicationStopped, 0, err
+				w.mu.Unlock()
+
+				return
+			case err := <-exited:
+				// A daemon that ran for a while exited for a new reason, it's restarted quickly again
+				if time.Since(startedAt) > maxLitestreamRestartDelay {
+					delay = minLitestreamRestartDelay
+				}
+
+				reason := "process exited"
+				if err != nil {
+					reason = fmt.Sprintf("process exited: %v", err)
+				}
+				w.logger.Warn().Int("pid", cmd.Process.Pid).Str("reason", reason).Dur("restart_in", delay).
+					Msg("Litestream exited")
+
+				w.mu.Lock()
+				w.state, w.pid, w.err = replicationRestarting, 0, reason
+				w.mu.Unlock()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			w.mu.Lock()
+			w.state = replicationStopped
+			w.mu.Unlock()
+
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxLitestreamRestartDelay)
+
+		cmd = w.newCmd()
+		if err := cmd.Start(); err != nil {
+			w.logger.Error().Err(err).Dur("restart_in", delay).Msg("Failed to restart Litestream")
+
+			w.mu.Lock()
+			w.err = fmt.Sprintf("start: %v", err)
+			w.mu.Unlock()
+
+			cmd = nil
+
+			continue
+		}
+
+		w.mu.Lock()
+		w.state, w.pid = replicationRunning, cmd.Process.Pid
+		w.restarts++
+		restarts := w.restarts
+		w.mu.Unlock()
+
+		w.logger.Info().Int("pid", cmd.Process.Pid).Int("restarts", restarts).Msg("Litestream restarted")
+	}
+}
+
+// terminate stops the daemon gracefully so it replicates the last changes, it's killed after
+// LitestreamShutdownTimeout.
+func (w *litestreamWatchdog) terminate(cmd *exec.Cmd, exited <-chan error) error {
+	// Send SIGTERM for graceful shutdown, the process may have already exited
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		w.logger.Warn().Err(err).Msg("Failed to send SIGTERM to Litestream")
+	}
+
+	select {
+	case <-exited:
+		w.logger.Info().Msg("Litestream stopped gracefully")
+	case <-time.After(LitestreamShutdownTimeout):
+		// Force kill if graceful shutdown takes too long
+		w.logger.Warn().Dur("timeout", LitestreamShutdownTimeout).Msg("Killing Litestream after the shutdown timeout")
+		if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("kill litestream: %w", err)
+		}
+		<-exited
+	}
+
+	return nil
+}
+
+// sampleLag keeps the replication lag up to date, so the age of the oldest pending change is known
+// within a sample interval.
+func (w *litestreamWatchdog) sampleLag(ctx context.Context) {
+	ticker := time.NewTicker(lagSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			w.mu.Lock()
+			w.lag.observe(now)
+			w.mu.Unlock()
+		}
+	}
+}
+
+// waitSynced waits for Litestream to replicate the changes of the metadata made so far.
+func (w *litestreamWatchdog) waitSynced(ctx context.Context) error {
+	ticker := time.NewTicker(lagSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		w.mu.Lock()
+		lag := w.lag.observe(time.Now())
+		w.mu.Unlock()
+
+		if lag == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("replica is %s behind: %w", lag.Round(time.Second), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop stops the daemon and waits for the watchdog to exit.
+func (w *litestreamWatchdog) stop() error {
+	w.cancel()
+	w.done.Wait()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.stopErr
+}
+
+// status returns the state of the daemon and the current replication lag.
+func (w *litestreamWatchdog) status() *host.VolumeReplication {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return &host.VolumeReplication{
+		State:    w.state,
+		Pid:      w.pid,
+		Restarts: w.restarts,
+		Error:    w.err,
+		Lag:      w.lag.observe(time.Now()),
+	}
+}
+
+// lagTracker measures the replication lag from the modification times of the metadata database and of
+// the local state Litestream keeps next to it, which it writes on every sync. The database changed after
+// the last sync has changes waiting for replication.
+type lagTracker struct {
+	dbPath string
+
+	// pendingSince is when the database was first seen ahead of the replica, zero when in sync
+	pendingSince time.Time
+}
+
+// observe samples the modification times and returns how long the pending changes have waited.
+func (t *lagTracker) observe(now time.Time) time.Duration {
+	changed := newestModTime(t.dbPath, t.dbPath+"-wal")
+	synced := newestModTime(t.dbPath + "-litestream")
+
+	if !changed.After(synced) {
+		t.pendingSince = time.Time{}
+
+		return 0
+	}
+
+	if t.pendingSince.IsZero() {
+		t.pendingSince = changed
+	}
+
+	return max(now.Sub(t.pendingSince), 0)
+}
+
+// newestModTime returns the newest modification time of the files at the paths, directories included
+// recursively. Missing paths are skipped.
+func newestModTime(paths ...string) time.Time {
+	var newest time.Time
+
+	for _, path := range paths {
+		_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			if info.ModTime().After(newest) {
+				newest = info.ModTime()
+			}
+
+			return nil
+		})
+	}
+
+	return newest
+}
@@ -0,0 +1,128 @@
+package volume
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLagTracker(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "meta.db")
+	stateFile := filepath.Join(dbPath+"-litestream", "ltx", "0", "0000000000000001-0000000000000001.ltx")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stateFile), 0o755))
+
+	now := time.Now().Truncate(time.Second)
+	touch := func(path string, mtime time.Time) {
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+
+	tracker := lagTracker{dbPath: dbPath}
+
+	// Nothing written yet
+	assert.Zero(t, tracker.observe(now))
+
+	// Synced after the last change
+	touch(dbPath, now.Add(-10*time.Second))
+	touch(stateFile, now.Add(-5*time.Second))
+	assert.Zero(t, tracker.observe(now))
+
+	// Changed after the last sync, the lag grows while Litestream doesn't sync
+	touch(dbPath+"-wal", now.Add(-2*time.Second))
+	assert.Equal(t, 2*time.Second, tracker.observe(now))
+	touch(dbPath+"-wal", now.Add(time.Second))
+	assert.Equal(t, 5*time.Second, tracker.observe(now.Add(3*time.Second)))
+
+	// Synced again
+	touch(stateFile, now.Add(4*time.Second))
+	assert.Zero(t, tracker.observe(now.Add(4*time.Second)))
+}
+
+func TestLitestreamWatchdog(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "meta.db")
+
+	t.Run("restarts exited daemon", func(t *testing.T) {
+		t.Parallel()
+
+		w, err := startLitestreamWatchdog(zerolog.Nop(), dbPath, func() *exec.Cmd {
+			return exec.Command("sh", "-c", "exit 1")
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return w.status().Restarts >= 1
+		}, 5*time.Second, 50*time.Millisecond)
+
+		status := w.status()
+		assert.Contains(t, status.Error, "exit status 1")
+
+		require.NoError(t, w.stop())
+		assert.Equal(t, replicationStopped, w.status().State)
+	})
+
+	t.Run("stops running daemon", func(t *testing.T) {
+		t.Parallel()
+
+		w, err := startLitestreamWatchdog(zerolog.Nop(), dbPath, func() *exec.Cmd {
+			return exec.Command("sleep", "60")
+		})
+		require.NoError(t, err)
+
+		status := w.status()
+		assert.Equal(t, replicationRunning, status.State)
+		assert.NotZero(t, status.Pid)
+
+		require.NoError(t, w.stop())
+
+		status = w.status()
+		assert.Equal(t, replicationStopped, status.State)
+		assert.Zero(t, status.Pid)
+		assert.Zero(t, status.Restarts)
+	})
+
+	t.Run("first start fails", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := startLitestreamWatchdog(zerolog.Nop(), dbPath, func() *exec.Cmd {
+			return exec.Command(filepath.Join(t.TempDir(), "missing"))
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestWaitSynced(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "meta.db")
+	stateFile := filepath.Join(dbPath+"-litestream", "generation")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stateFile), 0o755))
+
+	touch := func(path string, mtime time.Time) {
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+
+	w := &litestreamWatchdog{lag: lagTracker{dbPath: dbPath}}
+
+	now := time.Now()
+	touch(stateFile, now.Add(-time.Minute))
+	touch(dbPath, now.Add(-time.Second))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, w.waitSynced(ctx), context.DeadlineExceeded)
+
+	touch(stateFile, now)
+	require.NoError(t, w.waitSynced(t.Context()))
+}
@@ -0,0 +1,38 @@
+package volume
+
+import (
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// logger is the logger of the volume package. It writes to stderr until envd sets its own logger,
+// which ships the logs with the other logs of the sandbox.
+var logger = zerolog.New(os.Stderr).With().Timestamp().Logger()
+
+// SetLogger sets the logger of the volume package, it must be called before any volume is mounted.
+func SetLogger(l *zerolog.Logger) {
+	logger = *l
+}
+
+// newMounterLogger returns the logger of a mounter, every log has the volume and its mount path.
+func newMounterLogger(volumeID, mountPath string) zerolog.Logger {
+	return logger.With().Str("volume_id", volumeID).Str("mount_path", mountPath).Logger()
+}
+
+// step runs a step of the mount or the unmount and logs its duration, or its error when it fails.
+func (m *Mounter) step(name string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+
+	event := m.logger.Info()
+	msg := "Volume step completed"
+	if err != nil {
+		event = m.logger.Error().Err(err)
+		msg = "Volume step failed"
+	}
+	event.Str("step", name).Dur("duration", time.Since(start)).Msg(msg)
+
+	return err
+}
@@ -3,15 +3,24 @@ package volume
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/rs/zerolog"
+
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/api"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/services/cgroups"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+	volumeoptions "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-options"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 func init() {
@@ -24,6 +33,21 @@ func init() {
 	api.DefaultVolumeUnmounterFactory = func(config *host.VolumeConfig) api.VolumeUnmounter {
 		return NewMounter(config)
 	}
+
+	// Register the usage of the mounted volume with the metrics of the api package
+	api.DefaultVolumeStats = VolumeStats
+
+	// Register the token refresh of the mounted volume with the api package
+	api.DefaultVolumeTokenWriter = WriteToken
+
+	// Register the replication state of the mounted volume with the api package
+	api.DefaultVolumeReplication = VolumeReplicationStatus
+
+	// Register the local disk cache of the mounted volume with the api package
+	api.DefaultVolumeCache = VolumeCacheStatus
+
+	// Register the mounted volumes with the api package
+	api.DefaultMountedVolumes = MountedVolumes
 }
 
 const (
@@ -36,150 +60,313 @@ const (
 	// SQLite3Binary is the path to the SQLite3 binary.
 	SQLite3Binary = "/usr/bin/sqlite3"
 
-	// GCSTokenFile is the path where the GCS token is written.
-	GCSTokenFile = "/tmp/gcs-token"
+	// GCSEmulatorHostEnv makes JuiceFS and Litestream send GCS requests to the custom endpoint.
+	GCSEmulatorHostEnv = "STORAGE_EMULATOR_HOST"
 
-	// MetaDBPath is the path for the SQLite metadata database.
-	MetaDBPath = "/tmp/meta.db"
-
-	// LitestreamConfigPath is the path for the Litestream configuration.
-	LitestreamConfigPath = "/tmp/litestream.yml"
+	// MetaPasswordEnv is the password JuiceFS authenticates to the Redis metadata engine with, kept
+	// out of the metadata URL in the command line of the JuiceFS processes.
+	MetaPasswordEnv = "META_PASSWORD"
 
 	// MountTimeout is the maximum time to wait for mount to complete.
 	MountTimeout = 2 * time.Minute
 
+	// DefaultMountAttempts is how many times a mount failing transiently is attempted.
+	DefaultMountAttempts = 3
+
+	// minMountRetryDelay and maxMountRetryDelay bound the backoff between the mount attempts.
+	minMountRetryDelay = time.Second
+	maxMountRetryDelay = 10 * time.Second
+
 	// LitestreamShutdownTimeout is the max time to wait for Litestream graceful shutdown.
 	LitestreamShutdownTimeout = 10 * time.Second
+
+	// DefaultCheckpointInterval is the interval between the checkpoints of the replicated metadata.
+	DefaultCheckpointInterval = 5 * time.Minute
 )
 
-// currentMounter holds the active mounter instance for graceful shutdown.
-// This is needed because Unmount is called via a factory that creates a new instance,
-// but we need access to the litestreamCmd from the original Mount call.
-var currentMounter *Mounter
+// ErrReadOnlyEmptyVolume is returned when a volume without any data is mounted read-only.
+var ErrReadOnlyEmptyVolume = errors.New("volume has no data yet and can't be mounted read-only")
+
+// errBinaryNotFound is returned when the image of the sandbox lacks a binary the mount needs.
+var errBinaryNotFound = errors.New("binary not found")
+
+// errInvalidSubpath is returned when the subpath of the volume can't be mounted as it is on the volume.
+var errInvalidSubpath = errors.New("invalid subpath")
 
 // Mounter handles JuiceFS volume mounting with SQLite + Litestream.
 type Mounter struct {
 	config        *host.VolumeConfig
-	mountPath     string
-	litestreamCmd *exec.Cmd // Track for graceful shutdown
+	mountPath     string              // Where JuiceFS is mounted, in the state directory when a subpath is mounted
+	logger        zerolog.Logger      // Logs with the volume ID and the mount path
+	litestream    *litestreamWatchdog // Keeps Litestream running until unmount
+	checkpoints   *checkpointer       // Checkpoints the replicated metadata periodically, nil when not started
+	overlayMounts []string            // Bind mounts made on top of the volume, in mount order
+	limits        mountLimits
+	cgroupManager cgroups.Manager // Cgroup of the JuiceFS and Litestream processes, nil if unavailable
+	stats         statsState      // Previous sample of the I/O counters, for the throughput
 }
 
-// NewMounter creates a new volume mounter.
+// NewMounter creates a new volume mounter. When only a subpath of the volume is mounted, JuiceFS
+// is mounted in the state directory, which only envd can traverse, and the subpath is bind mounted
+// at the mount path.
 func NewMounter(config *host.VolumeConfig) *Mounter {
+	mountPath := config.MountPath
+	if config.Subpath != "" {
+		mountPath = filepath.Join(StateDir, config.VolumeID, "root")
+	}
+
 	return &Mounter{
 		config:    config,
-		mountPath: config.MountPath,
+		mountPath: mountPath,
+		logger:    newMounterLogger(config.VolumeID, config.MountPath),
 	}
 }
 
-// Mount mounts the JuiceFS volume at the configured path.
+// Mount mounts the JuiceFS volume at the configured path. Failures that can be transient, like
+// a bucket not reachable for a moment, are retried with a backoff up to the mount attempts.
 func (m *Mounter) Mount(ctx context.Context) error {
-	fmt.Fprintf(os.Stderr, "[volume.mount.started] volume_id=%s mount_path=%s\n",
-		m.config.VolumeID, m.mountPath)
+	attempts := m.mountAttempts()
+	delay := minMountRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		err := m.mount(ctx)
+		if err == nil || attempt >= attempts || !retryableMountError(err) {
+			return err
+		}
+
+		m.logger.Warn().Err(err).
+			Int("attempt", attempt).
+			Int("attempts", attempts).
+			Dur("retry_in", delay).
+			Msg("Volume mount failed, retrying")
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxMountRetryDelay)
+	}
+}
+
+// mountAttempts returns how many times a failing mount is attempted.
+func (m *Mounter) mountAttempts() int {
+	if m.config.MountAttempts > 0 {
+		return m.config.MountAttempts
+	}
+
+	return DefaultMountAttempts
+}
+
+// retryableMountError reports whether the mount can succeed when attempted again. The volume, its
+// format and the sandbox image don't change between the attempts.
+func retryableMountError(err error) bool {
+	return !errors.Is(err, ErrReadOnlyEmptyVolume) &&
+		!errors.Is(err, ErrVolumeAlreadyMounted) &&
+		!errors.Is(err, errBinaryNotFound) &&
+		!errors.Is(err, errInvalidSubpath) &&
+		!errors.Is(err, volumeformat.ErrUnsupported)
+}
+
+// mount attempts to mount the volume once and logs the outcome with its duration.
+func (m *Mounter) mount(ctx context.Context) error {
+	m.logger.Info().Msg("Volume mount started")
+
+	started := time.Now()
+	err := m.mountOnce(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Dur("duration", time.Since(started)).Msg("Volume mount failed")
 
+		return err
+	}
+
+	m.logger.Info().Dur("duration", time.Since(started)).Msg("Volume mount completed")
+
+	return nil
+}
+
+// mountOnce runs the steps of the mount, the processes started are stopped when it fails.
+func (m *Mounter) mountOnce(ctx context.Context) (err error) {
 	// Check if JuiceFS binary exists
 	if _, err := os.Stat(JuiceFSBinary); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("JuiceFS binary not found at %s", JuiceFSBinary)
+		return fmt.Errorf("JuiceFS %w at %s", errBinaryNotFound, JuiceFSBinary)
 	}
 
-	// Check if Litestream binary exists
-	if _, err := os.Stat(LitestreamBinary); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("Litestream binary not found at %s", LitestreamBinary)
+	// Check if Litestream binary exists, only SQLite metadata is replicated
+	if _, err := os.Stat(LitestreamBinary); os.IsNotExist(err) && !m.redisMeta() {
+		return fmt.Errorf("Litestream %w at %s", errBinaryNotFound, LitestreamBinary)
 	}
 
-	// Create mount directory if it doesn't exist
-	if err := os.MkdirAll(m.mountPath, 0o755); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("create mount directory: %w", err)
+	// Each volume has its own mount processes, a mounted volume must be unmounted first. The volume
+	// and its path are reserved until the mount completes, so a concurrent mount of either fails.
+	if err := reserveMount(m); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			releaseMount(m)
+		}
+	}()
 
-	// Step 1: Write GCS token to file
-	if err := m.writeGCSToken(); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("write GCS token: %w", err)
+	// Create the state directory with the credentials, only readable by envd
+	if err := os.MkdirAll(m.stateDir(), 0o700); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
 	}
 
-	// Step 2: Restore metadata database from Litestream (if replica exists)
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=2_restore_start time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-	if err := m.restoreMetaDB(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("restore metadata DB: %w", err)
+	// Create mount directory if it doesn't exist
+	if err := os.MkdirAll(m.config.MountPath, 0o755); err != nil {
+		return fmt.Errorf("create mount directory: %w", err)
+	}
+	if err := os.MkdirAll(m.mountPath, 0o755); err != nil {
+		return fmt.Errorf("create JuiceFS mount directory: %w", err)
 	}
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=2_restore_done time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
 
-	// Step 2b: For fresh volumes, format JuiceFS (creates meta.db)
-	if _, err := os.Stat(MetaDBPath); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=2b_format_start time=%v\n",
-			m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-		if err := m.formatVolume(ctx); err != nil {
-			fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-				m.config.VolumeID, m.mountPath, err)
-			return fmt.Errorf("format volume: %w", err)
-		}
-		fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=2b_format_done time=%v\n",
-			m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
+	// Step 1: Write the bucket credentials to file
+	if err := m.step("1_token", m.writeToken); err != nil {
+		return fmt.Errorf("write bucket credentials: %w", err)
 	}
 
-	// Step 3: Convert journal mode to DELETE (required after restore)
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=3_journal_start time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-	if err := m.convertJournalMode(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("convert journal mode: %w", err)
+	// Steps 2-3: Prepare the metadata, SQLite is restored from its replica and Redis is used in place
+	prepareMeta := m.prepareSQLiteMeta
+	if m.redisMeta() {
+		prepareMeta = m.prepareRedisMeta
+	}
+	if err := prepareMeta(ctx); err != nil {
+		return err
 	}
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=3_journal_done time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
+
+	// Limit the resources of the JuiceFS and Litestream processes started below
+	m.setupLimits()
 
 	// Step 4: Start Litestream replication daemon
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=4_litestream_start time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-	if err := m.startLitestream(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
-		return fmt.Errorf("start Litestream: %w", err)
+	// Read-only mounts don't change the metadata, and several of them may exist at once,
+	// so they must never replicate their copy back. Redis metadata isn't replicated at all.
+	if !m.config.ReadOnly && !m.redisMeta() {
+		if err := m.step("4_litestream", func() error { return m.startLitestream(ctx) }); err != nil {
+			m.releaseLimits()
+			return fmt.Errorf("start Litestream: %w", err)
+		}
 	}
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=4_litestream_done time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
 
 	// Step 5: Mount JuiceFS
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=5_mount_start time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-	if err := m.mountJuiceFS(ctx); err != nil {
+	if err := m.step("5_mount", func() error { return m.mountJuiceFS(ctx) }); err != nil {
 		// Cleanup Litestream on mount failure
 		m.stopLitestream()
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
+		m.releaseLimits()
 		return fmt.Errorf("mount JuiceFS: %w", err)
 	}
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=5_mount_done time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
 
-	// Verify mount is accessible
-	fmt.Fprintf(os.Stderr, "[volume.mount.step] volume_id=%s step=6_verify_start time=%v\n",
-		m.config.VolumeID, time.Now().UTC().Format(time.RFC3339Nano))
-	if err := m.verifyMount(); err != nil {
-		// Cleanup on verification failure
+	// Step 6: Verify mount is accessible
+	if err := m.step("6_verify", m.verifyMount); err != nil {
+		// Cleanup on verification failure, the JuiceFS mount is left to no mounter otherwise
+		m.abortJuiceFSMount(ctx)
 		m.stopLitestream()
-		fmt.Fprintf(os.Stderr, "[volume.mount.failed] volume_id=%s mount_path=%s error=%v\n",
-			m.config.VolumeID, m.mountPath, err)
+		m.releaseLimits()
 		return fmt.Errorf("mount verification failed: %w", err)
 	}
 
-	// Store the current mounter for graceful shutdown
-	currentMounter = m
+	// Step 6b: Expose only the subpath of the volume at the mount path
+	if m.config.Subpath != "" {
+		if err := m.step("6b_subpath", m.mountSubpath); err != nil {
+			m.removeOverlays()
+			m.abortJuiceFSMount(ctx)
+			m.stopLitestream()
+			m.releaseLimits()
+			return fmt.Errorf("mount subpath: %w", err)
+		}
+	}
+
+	// Step 7: Persist overlay paths on the volume and protect the root filesystem
+	if len(m.config.OverlayPaths) > 0 || m.config.ReadOnlyRoot {
+		if err := m.step("7_overlays", func() error { return m.applyOverlays(ctx) }); err != nil {
+			m.removeOverlays()
+			m.abortJuiceFSMount(ctx)
+			m.stopLitestream()
+			m.releaseLimits()
+			return fmt.Errorf("apply overlays: %w", err)
+		}
+	}
 
-	fmt.Fprintf(os.Stderr, "[volume.mount.completed] volume_id=%s mount_path=%s\n",
-		m.config.VolumeID, m.mountPath)
+	// The daemonized JuiceFS processes exist only now, protect them from the OOM killer
+	m.protectHelpers()
+
+	// Bound how far behind the metadata replica gets until the unmount
+	if m.litestream != nil {
+		m.startCheckpoints()
+	}
+
+	// Step 8: Pull the prewarmed files into the cache, the volume is usable without them
+	if len(m.config.Prewarm) > 0 {
+		_ = m.step("8_prewarm", func() error { return m.prewarm(ctx) })
+	}
+
+	// Record the mounter for the unmount and the graceful shutdown
+	registerMounter(m)
+
+	return nil
+}
+
+// redisMeta reports whether the metadata of the volume is in Redis instead of SQLite.
+func (m *Mounter) redisMeta() bool {
+	return m.config.MetaEngine == volumestorage.MetaEngineRedis
+}
+
+// metaURL returns the JuiceFS metadata URL of the volume, the Redis database of the volume
+// or the SQLite database restored from its replica.
+func (m *Mounter) metaURL() string {
+	if m.redisMeta() {
+		return m.config.RedisMetaURL
+	}
+
+	return fmt.Sprintf("sqlite3://%s", m.metaDBPath())
+}
+
+// prepareSQLiteMeta restores the SQLite metadata from its Litestream replica, formats fresh volumes
+// and checks the format version.
+func (m *Mounter) prepareSQLiteMeta(ctx context.Context) error {
+	// Step 2: Restore metadata database from Litestream (if replica exists)
+	if err := m.step("2_restore", func() error { return m.restoreMetaDB(ctx) }); err != nil {
+		return fmt.Errorf("restore metadata DB: %w", err)
+	}
+
+	// Step 2b: For fresh volumes, format JuiceFS (creates meta.db)
+	formatted := false
+	if _, err := os.Stat(m.metaDBPath()); os.IsNotExist(err) {
+		// A read-only mount must not format, it would create a filesystem nobody replicates
+		if m.config.ReadOnly {
+			return ErrReadOnlyEmptyVolume
+		}
+
+		if err := m.step("2b_format", func() error { return m.formatVolume(ctx) }); err != nil {
+			return fmt.Errorf("format volume: %w", err)
+		}
+		formatted = true
+	}
+
+	// Step 2c: Refuse volumes written by a newer envd with an incompatible format, stamp fresh volumes
+	if err := m.step("2c_format_version", func() error { return m.checkFormatVersion(ctx, formatted) }); err != nil {
+		return fmt.Errorf("check volume format: %w", err)
+	}
+
+	// Step 3: Convert journal mode to DELETE (required after restore)
+	if err := m.step("3_journal", func() error { return m.convertJournalMode(ctx) }); err != nil {
+		return fmt.Errorf("convert journal mode: %w", err)
+	}
+
+	return nil
+}
+
+// prepareRedisMeta formats the volume in its Redis database on the first mount. The format leaves
+// formatted volumes as they are, the metadata is shared live with the other mounts and the API.
+func (m *Mounter) prepareRedisMeta(ctx context.Context) error {
+	// A read-only mount must not format, juicefs mount fails on an unformatted database instead
+	if m.config.ReadOnly {
+		return nil
+	}
+
+	if err := m.step("2b_format", func() error { return m.formatVolume(ctx) }); err != nil {
+		return fmt.Errorf("format volume: %w", err)
+	}
 
 	return nil
 }
@@ -189,129 +376,244 @@ func (m *Mounter) Unmount(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, MountTimeout)
 	defer cancel()
 
+	// Use the mounter of the Mount call, which has the overlays and the Litestream watchdog
+	mounted := mountedVolume(m.config.VolumeID)
+
+	// Release the overlay bind mounts first, they keep the volume busy. The final checkpoint below
+	// replaces the periodic ones.
+	if mounted != nil {
+		mounted.stopCheckpoints()
+		mounted.removeOverlays()
+	}
+
 	// Step 1: Unmount JuiceFS with --flush to wait for all data to be uploaded to GCS
 	// Without --flush, umount returns before uploads complete, causing data loss
+	if err := m.step("unmount_1_flush", func() error { return m.unmountJuiceFS(ctx) }); err != nil {
+		return err
+	}
+
+	// Step 2: Checkpoint WAL to ensure all changes are in main DB file
+	// A failure is only logged, Litestream still replicates the main DB
+	_ = m.step("unmount_2_checkpoint", func() error { return m.checkpointWAL(ctx) })
+
+	// Step 3: Stop Litestream gracefully
+	if mounted != nil {
+		if err := m.step("unmount_3_litestream", mounted.stopLitestream); err != nil {
+			return fmt.Errorf("stop Litestream: %w", err)
+		}
+		mounted.releaseLimits()
+		unregisterMounter(m.config.VolumeID)
+	}
+
+	return nil
+}
+
+// unmountJuiceFS unmounts the JuiceFS mount of the volume once its data is uploaded.
+func (m *Mounter) unmountJuiceFS(ctx context.Context) error {
 	cmd := exec.CommandContext(ctx, JuiceFSBinary, "umount", "--flush", m.mountPath)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("juicefs umount failed: %w\nOutput: %s", err, string(output))
 	}
 
-	// Step 2: Checkpoint WAL to ensure all changes are in main DB file
-	if err := m.checkpointWAL(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.unmount.warning] WAL checkpoint failed: %v\n", err)
-		// Continue with Litestream shutdown - it will still replicate the main DB
+	return nil
+}
+
+// abortJuiceFSMount unmounts JuiceFS when a step after the mount fails. The mounter isn't registered
+// yet, so nothing would unmount it later and a retry would mount on top of it. A failure is only
+// logged, the mount error is returned instead.
+func (m *Mounter) abortJuiceFSMount(ctx context.Context) {
+	if err := m.unmountJuiceFS(ctx); err != nil {
+		m.logger.Warn().Err(err).Msg("Failed to unmount JuiceFS after a failed mount")
+	}
+}
+
+// storageEnv returns the environment of the JuiceFS and Litestream commands. On GCS, it has the
+// token file in tokenFileEnv and the custom GCS endpoint, if any. On S3, it has the AWS config
+// reading the token file and the region of the bucket.
+func (m *Mounter) storageEnv(tokenFileEnv string) []string {
+	if m.config.StorageProvider == volumestorage.S3 {
+		return append(os.Environ(),
+			"AWS_CONFIG_FILE="+m.awsConfigFile(),
+			"AWS_SDK_LOAD_CONFIG=1",
+			"AWS_REGION="+m.config.S3Region,
+		)
 	}
 
-	// Step 3: Stop Litestream gracefully
-	// Use the currentMounter which has the litestreamCmd from Mount()
-	if currentMounter != nil {
-		if err := currentMounter.stopLitestream(); err != nil {
-			return fmt.Errorf("stop Litestream: %w", err)
+	env := append(os.Environ(), tokenFileEnv+"="+m.tokenFile())
+	if m.config.GCSEndpoint != "" {
+		env = append(env, GCSEmulatorHostEnv+"="+m.config.GCSEndpoint)
+	}
+
+	return env
+}
+
+// juiceFSEnv returns the environment of the JuiceFS commands, the storage one with the proxy token
+// as password of the Redis metadata engine. The Redis proxy only lets JuiceFS in with it.
+func (m *Mounter) juiceFSEnv() []string {
+	env := m.storageEnv("JFS_GCS_TOKEN_FILE")
+	if m.redisMeta() && m.config.ProxyToken != "" {
+		env = append(env, MetaPasswordEnv+"="+m.config.ProxyToken)
+	}
+
+	return env
+}
+
+// writeToken writes the bucket credentials to a file, and on S3 the AWS config reading them.
+func (m *Mounter) writeToken() error {
+	if err := os.WriteFile(m.tokenFile(), []byte(m.config.GCSToken), 0o600); err != nil {
+		return fmt.Errorf("write token file: %w", err)
+	}
+
+	if m.config.StorageProvider == volumestorage.S3 {
+		config := fmt.Sprintf("[default]\ncredential_process = /bin/cat %s\n", m.tokenFile())
+		if err := os.WriteFile(m.awsConfigFile(), []byte(config), 0o600); err != nil {
+			return fmt.Errorf("write AWS config: %w", err)
 		}
-		currentMounter = nil
 	}
 
 	return nil
 }
 
-// writeGCSToken writes the GCS access token to a file.
-func (m *Mounter) writeGCSToken() error {
-	if err := os.WriteFile(GCSTokenFile, []byte(m.config.GCSToken), 0o600); err != nil {
+// WriteToken replaces the bucket credentials of the running JuiceFS and Litestream processes of the
+// volume, which read the token file for each request on GCS, and when the previous credentials expire
+// on S3. The file is replaced atomically so they never read partial credentials.
+func WriteToken(volumeID, token string) error {
+	m := mountedVolume(volumeID)
+	if m == nil {
+		return fmt.Errorf("volume %s is not mounted", volumeID)
+	}
+
+	tokenFile := m.tokenFile()
+	tmp, err := os.CreateTemp(filepath.Dir(tokenFile), filepath.Base(tokenFile)+".*")
+	if err != nil {
+		return fmt.Errorf("create token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(token); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write token file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
 		return fmt.Errorf("write token file: %w", err)
 	}
+
+	if err := os.Rename(tmp.Name(), tokenFile); err != nil {
+		return fmt.Errorf("replace token file: %w", err)
+	}
+
 	return nil
 }
 
 // restoreMetaDB restores the SQLite metadata DB from Litestream replica.
 // For fresh volumes (no backup exists), this is a no-op.
 func (m *Mounter) restoreMetaDB(ctx context.Context) error {
-	replicaURL := fmt.Sprintf("gs://%s/%s-meta", m.config.GCSBucket, m.config.VolumeID)
+	replicaURL := m.config.StorageProvider.MetaReplicaURL(m.config.GCSBucket, m.config.VolumeID)
 
 	ctx, cancel := context.WithTimeout(ctx, MountTimeout)
 	defer cancel()
 
-	// Debug: Check token file
-	tokenData, tokenErr := os.ReadFile(GCSTokenFile)
-	if tokenErr != nil {
-		fmt.Fprintf(os.Stderr, "[volume.restore.debug] token_file_error=%v\n", tokenErr)
-	} else {
-		tokenLen := len(tokenData)
-		tokenPreview := string(tokenData)
-		if len(tokenPreview) > 50 {
-			tokenPreview = tokenPreview[:50] + "..."
-		}
-		fmt.Fprintf(os.Stderr, "[volume.restore.debug] token_file=%s token_len=%d token_preview=%s\n",
-			GCSTokenFile, tokenLen, tokenPreview)
-	}
-
-	fmt.Fprintf(os.Stderr, "[volume.restore.debug] volume_id=%s replica_url=%s\n",
-		m.config.VolumeID, replicaURL)
-
 	// Clean up any existing meta.db from a previous failed attempt (e.g., /init retry)
-	if err := os.Remove(MetaDBPath); err != nil && !os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "[volume.restore.debug] failed to remove existing meta.db: %v\n", err)
+	if err := os.Remove(m.metaDBPath()); err != nil && !os.IsNotExist(err) {
+		m.logger.Warn().Err(err).Msg("Failed to remove the existing metadata DB")
 	}
 
-	// litestream restore -if-replica-exists -o /tmp/meta.db gs://bucket/volumeID-meta
-	cmd := exec.CommandContext(ctx, LitestreamBinary,
-		"restore",
-		"-if-replica-exists",
-		"-o", MetaDBPath,
-		replicaURL,
-	)
-
-	cmd.Env = append(os.Environ(),
-		"LITESTREAM_GCS_TOKEN_FILE="+GCSTokenFile,
-	)
+	// litestream restore -if-replica-exists -o /tmp/volumes/volumeID/meta.db gs://bucket/volumeID-meta
+	args := []string{"restore", "-if-replica-exists", "-o", m.metaDBPath(), replicaURL}
+	if m.config.S3Endpoint != "" {
+		// The endpoint is only read from the config, the replica is looked up by the database path there
+		if err := m.writeLitestreamConfig(); err != nil {
+			return fmt.Errorf("write litestream config: %w", err)
+		}
+		args = []string{"restore", "-config", m.litestreamConfigPath(), "-if-replica-exists", "-o", m.metaDBPath(), m.metaDBPath()}
+	}
+	cmd := exec.CommandContext(ctx, LitestreamBinary, args...)
 
-	fmt.Fprintf(os.Stderr, "[volume.restore.debug] cmd=%v\n", cmd.Args)
+	cmd.Env = m.storageEnv("LITESTREAM_GCS_TOKEN_FILE")
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "[volume.restore.failed] volume_id=%s error=%v full_output=%s\n",
-			m.config.VolumeID, err, string(output))
 		return fmt.Errorf("litestream restore failed: %w\nOutput: %s", err, string(output))
 	}
 
-	fmt.Fprintf(os.Stderr, "[volume.mount.restore] volume_id=%s output=%s\n",
-		m.config.VolumeID, string(output))
+	m.logger.Debug().Str("replica_url", replicaURL).Str("output", string(output)).Msg("Metadata DB restored")
 
 	return nil
 }
 
-// formatVolume initializes a fresh JuiceFS volume with SQLite metadata.
-// This is called when no existing backup was restored (fresh volume).
+// formatVolume initializes a fresh JuiceFS volume in its metadata engine.
+// This is called when no existing backup was restored (fresh volume) and on every writable
+// mount with Redis metadata, --no-update leaves formatted volumes untouched.
 func (m *Mounter) formatVolume(ctx context.Context) error {
-	metaURL := fmt.Sprintf("sqlite3://%s", MetaDBPath)
-	dataURL := fmt.Sprintf("gs://%s/%s", m.config.GCSBucket, m.config.VolumeID)
+	metaURL := m.metaURL()
+	dataURL := m.config.StorageProvider.DataURL(m.config.GCSBucket, m.config.VolumeID, m.config.S3Region, m.config.S3Endpoint)
 
 	ctx, cancel := context.WithTimeout(ctx, MountTimeout)
 	defer cancel()
 
-	// juicefs format --storage gs --bucket gs://bucket/volumeID sqlite3:///tmp/meta.db volumeID
+	// juicefs format --storage gs --bucket gs://bucket/volumeID sqlite3:///tmp/volumes/volumeID/meta.db volumeID
+	// On S3: --storage s3 --bucket https://bucket.s3.region.amazonaws.com, or http://endpoint/bucket on an
+	// S3 compatible endpoint. The data is under volumeID/ either way
 	// --force: Allow formatting even if bucket has existing data (handles transition from Redis to SQLite)
-	cmd := exec.CommandContext(ctx, JuiceFSBinary,
+	// The block size and compression of the volume options only apply here, --no-update keeps them afterwards
+	args := []string{
 		"format",
-		"--storage", "gs",
+		"--storage", m.config.StorageProvider.JuiceFSStorage(),
 		"--bucket", dataURL,
 		"--no-update",
 		"--force",
-		metaURL,
-		m.config.VolumeID,
-	)
+	}
+	args = append(args, volumeoptions.FormatArgs(m.config.MountOptions)...)
+	args = append(args, metaURL, m.config.VolumeID)
 
-	cmd.Env = append(os.Environ(),
-		"JFS_GCS_TOKEN_FILE="+GCSTokenFile,
-	)
+	cmd := exec.CommandContext(ctx, JuiceFSBinary, args...)
+
+	cmd.Env = m.juiceFSEnv()
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("juicefs format failed: %w\nOutput: %s", err, string(output))
 	}
 
-	fmt.Fprintf(os.Stderr, "[volume.mount.format] volume_id=%s output=%s\n",
-		m.config.VolumeID, string(output))
+	m.logger.Debug().Str("output", string(output)).Msg("Volume formatted")
+
+	return nil
+}
+
+// checkFormatVersion stamps freshly formatted volumes with the current format version and checks
+// the format version of the other volumes is supported.
+func (m *Mounter) checkFormatVersion(ctx context.Context, formatted bool) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if formatted {
+		cmd := exec.CommandContext(ctx, SQLite3Binary, m.metaDBPath(), volumeformat.WriteSQL(volumeformat.Current))
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("sqlite3 format stamp failed: %w\nOutput: %s", err, string(output))
+		}
+
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, SQLite3Binary, m.metaDBPath(), volumeformat.ReadSQL())
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("sqlite3 format version failed: %w\nOutput: %s", err, string(output))
+	}
+
+	version, err := volumeformat.Parse(string(output))
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info().Int("format_version", version).Msg("Volume format version read")
+
+	if err := volumeformat.Check(version); err != nil {
+		return fmt.Errorf("%w, update the template to mount it", err)
+	}
 
 	return nil
 }
@@ -320,28 +622,27 @@ func (m *Mounter) formatVolume(ctx context.Context) error {
 // This is required after Litestream restore because JuiceFS cannot use WAL mode.
 func (m *Mounter) convertJournalMode(ctx context.Context) error {
 	// Only convert if the database file exists (fresh volume won't have one)
-	if _, err := os.Stat(MetaDBPath); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "[volume.mount.journal] volume_id=%s skipping (no existing DB)\n",
-			m.config.VolumeID)
+	if _, err := os.Stat(m.metaDBPath()); os.IsNotExist(err) {
+		m.logger.Debug().Msg("Skipping the journal mode, the metadata DB doesn't exist")
 		return nil
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, SQLite3Binary, MetaDBPath, "PRAGMA journal_mode=DELETE;")
+	cmd := exec.CommandContext(ctx, SQLite3Binary, m.metaDBPath(), "PRAGMA journal_mode=DELETE;")
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("sqlite3 journal mode failed: %w\nOutput: %s", err, string(output))
 	}
 
-	fmt.Fprintf(os.Stderr, "[volume.mount.journal] volume_id=%s mode=%s\n",
-		m.config.VolumeID, string(output))
+	m.logger.Debug().Str("journal_mode", strings.TrimSpace(string(output))).Msg("Journal mode converted")
 
 	return nil
 }
 
-// startLitestream starts the Litestream replication daemon in the background.
+// startLitestream starts the Litestream replication daemon in the background, under a watchdog
+// restarting it whenever it exits.
 func (m *Mounter) startLitestream(ctx context.Context) error {
 	// Write Litestream config file
 	if err := m.writeLitestreamConfig(); err != nil {
@@ -349,134 +650,146 @@ func (m *Mounter) startLitestream(ctx context.Context) error {
 	}
 
 	// Start Litestream replicate daemon
-	cmd := exec.Command(LitestreamBinary, "replicate", "-config", LitestreamConfigPath)
-	cmd.Env = append(os.Environ(),
-		"LITESTREAM_GCS_TOKEN_FILE="+GCSTokenFile,
-	)
-	cmd.Stdout = os.Stderr
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("start litestream: %w", err)
+	watchdog, err := startLitestreamWatchdog(m.logger, m.metaDBPath(), func() *exec.Cmd {
+		cmd := exec.Command(LitestreamBinary, "replicate", "-config", m.litestreamConfigPath())
+		cmd.Env = m.storageEnv("LITESTREAM_GCS_TOKEN_FILE")
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		cmd.SysProcAttr = m.sysProcAttr()
+
+		return cmd
+	})
+	if err != nil {
+		return err
 	}
 
-	m.litestreamCmd = cmd
+	m.litestream = watchdog
 
-	fmt.Fprintf(os.Stderr, "[volume.mount.litestream] volume_id=%s pid=%d\n",
-		m.config.VolumeID, cmd.Process.Pid)
+	m.logger.Info().Int("pid", watchdog.status().Pid).Msg("Litestream started")
 
 	return nil
 }
 
 // writeLitestreamConfig writes the Litestream configuration file.
 func (m *Mounter) writeLitestreamConfig() error {
-	replicaURL := fmt.Sprintf("gs://%s/%s-meta", m.config.GCSBucket, m.config.VolumeID)
+	replicaURL := m.config.StorageProvider.MetaReplicaURL(m.config.GCSBucket, m.config.VolumeID)
 
 	config := fmt.Sprintf(`dbs:
   - path: %s
     replicas:
       - url: %s
         sync-interval: 1s
-`, MetaDBPath, replicaURL)
+%s`, m.metaDBPath(), replicaURL, m.config.StorageProvider.LitestreamReplicaOptions(m.config.S3Endpoint))
 
-	if err := os.WriteFile(LitestreamConfigPath, []byte(config), 0o644); err != nil {
+	if err := os.WriteFile(m.litestreamConfigPath(), []byte(config), 0o644); err != nil {
 		return fmt.Errorf("write config file: %w", err)
 	}
 
 	return nil
 }
 
-// stopLitestream gracefully stops the Litestream daemon.
+// stopLitestream stops the periodic checkpoints, then gracefully stops the Litestream daemon and its watchdog.
 func (m *Mounter) stopLitestream() error {
-	if m.litestreamCmd == nil || m.litestreamCmd.Process == nil {
+	m.stopCheckpoints()
+
+	if m.litestream == nil {
 		return nil
 	}
 
-	// Send SIGTERM for graceful shutdown
-	if err := m.litestreamCmd.Process.Signal(syscall.SIGTERM); err != nil {
-		// Process may have already exited
-		if err.Error() != "os: process already finished" {
-			fmt.Fprintf(os.Stderr, "[volume.unmount.litestream] SIGTERM failed: %v\n", err)
-		}
+	err := m.litestream.stop()
+	m.litestream = nil
+
+	return err
+}
+
+// ReplicationStatus returns the state of the replication of the metadata, nil for read-only volumes
+// and Redis metadata, which aren't replicated.
+func (m *Mounter) ReplicationStatus() *host.VolumeReplication {
+	if m.litestream == nil {
+		return nil
 	}
 
-	// Wait for graceful shutdown with timeout
-	done := make(chan error, 1)
-	go func() {
-		_, err := m.litestreamCmd.Process.Wait()
-		done <- err
-	}()
+	return m.litestream.status()
+}
 
-	select {
-	case <-done:
-		fmt.Fprintf(os.Stderr, "[volume.unmount.litestream] volume_id=%s stopped gracefully\n",
-			m.config.VolumeID)
-	case <-time.After(LitestreamShutdownTimeout):
-		// Force kill if graceful shutdown takes too long
-		fmt.Fprintf(os.Stderr, "[volume.unmount.litestream] volume_id=%s forcing kill after timeout\n",
-			m.config.VolumeID)
-		if err := m.litestreamCmd.Process.Kill(); err != nil {
-			return fmt.Errorf("kill litestream: %w", err)
-		}
+// VolumeReplicationStatus returns the replication state of the mounted volume, nil when the volume
+// isn't mounted or replicated.
+func VolumeReplicationStatus(volumeID string) *host.VolumeReplication {
+	m := mountedVolume(volumeID)
+	if m == nil {
+		return nil
 	}
 
-	m.litestreamCmd = nil
-	return nil
+	return m.ReplicationStatus()
 }
 
 // checkpointWAL forces a WAL checkpoint to ensure all changes are in the main DB file.
 func (m *Mounter) checkpointWAL(ctx context.Context) error {
-	if _, err := os.Stat(MetaDBPath); os.IsNotExist(err) {
-		return nil
+	output, err := m.walCheckpoint(ctx, "TRUNCATE")
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info().Str("result", strings.TrimSpace(output)).Msg("Metadata DB checkpointed")
+
+	return nil
+}
+
+// walCheckpoint runs a WAL checkpoint of the metadata DB in the mode and returns its result, the busy
+// flag and the frames of the WAL and checkpointed.
+func (m *Mounter) walCheckpoint(ctx context.Context, mode string) (string, error) {
+	if _, err := os.Stat(m.metaDBPath()); os.IsNotExist(err) {
+		return "", nil
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, SQLite3Binary, MetaDBPath, "PRAGMA wal_checkpoint(TRUNCATE);")
+	cmd := exec.CommandContext(ctx, SQLite3Binary, m.metaDBPath(), fmt.Sprintf("PRAGMA wal_checkpoint(%s);", mode))
 	output, err := cmd.CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("wal checkpoint failed: %w\nOutput: %s", err, string(output))
+		return "", fmt.Errorf("wal checkpoint failed: %w\nOutput: %s", err, string(output))
 	}
 
-	fmt.Fprintf(os.Stderr, "[volume.unmount.checkpoint] volume_id=%s result=%s\n",
-		m.config.VolumeID, string(output))
-
-	return nil
+	return string(output), nil
 }
 
-// CacheDir is the directory for JuiceFS local cache.
-const CacheDir = "/tmp/jfscache"
-
-// mountJuiceFS mounts the JuiceFS filesystem using SQLite metadata.
+// mountJuiceFS mounts the JuiceFS filesystem using the metadata of the volume.
 func (m *Mounter) mountJuiceFS(ctx context.Context) error {
-	metaURL := fmt.Sprintf("sqlite3://%s", MetaDBPath)
+	metaURL := m.metaURL()
 
 	ctx, cancel := context.WithTimeout(ctx, MountTimeout)
 	defer cancel()
 
 	// Create cache directory
-	if err := os.MkdirAll(CacheDir, 0o755); err != nil {
+	if err := os.MkdirAll(m.cacheDir(), 0o755); err != nil {
 		return fmt.Errorf("create cache dir: %w", err)
 	}
 
-	cmd := exec.CommandContext(ctx, JuiceFSBinary,
+	args := []string{
 		"mount",
 		"--no-usage-report",
 		"--no-bgjob",
 		"-d",                // daemon mode
 		"-o", "allow_other", // allow non-root users to access mount
-		"--writeback", // enable writeback mode for faster writes
-		"--cache-dir", CacheDir,
-		"--cache-size", "1024", // 1GB cache
-		metaURL,
-		m.mountPath,
-	)
-
-	// Set environment variables for JuiceFS
-	cmd.Env = append(os.Environ(),
-		"JFS_GCS_TOKEN_FILE="+GCSTokenFile,
-	)
+		"--cache-dir", m.cacheDir(),
+		"--cache-size", strconv.FormatInt(m.cacheSizeMB(), 10),
+		"--buffer-size", strconv.FormatInt(m.limits.BufferSizeMB, 10), // sized to fit the memory limit
+	}
+
+	if m.config.ReadOnly {
+		args = append(args, "--read-only")
+	} else if volumeoptions.Bool(m.config.MountOptions, volumeoptions.Writeback, true) {
+		args = append(args, "--writeback") // enable writeback mode for faster writes
+	}
+
+	args = append(args, metaURL, m.mountPath)
+
+	cmd := exec.CommandContext(ctx, JuiceFSBinary, args...)
+	// The daemonized JuiceFS processes inherit the cgroup
+	cmd.SysProcAttr = m.sysProcAttr()
+
+	cmd.Env = m.juiceFSEnv()
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -500,9 +813,92 @@ func (m *Mounter) verifyMount() error {
 	return nil
 }
 
+// mountSubpath bind mounts the subpath of the volume at the mount path, read-write mounts create
+// it. The subpath can't go through symlinks, they could lead the sandbox out of it.
+func (m *Mounter) mountSubpath() error {
+	source := m.visibleRoot()
+
+	info, err := os.Stat(source)
+	switch {
+	case os.IsNotExist(err) && !m.config.ReadOnly:
+		if err := createSubpath(m.mountPath, m.config.Subpath); err != nil {
+			return fmt.Errorf("create subpath: %w", err)
+		}
+	case os.IsNotExist(err):
+		return fmt.Errorf("%w: %s doesn't exist on the volume", errInvalidSubpath, m.config.Subpath)
+	case err != nil:
+		return fmt.Errorf("stat subpath: %w", err)
+	case !info.IsDir():
+		return fmt.Errorf("%w: %s is not a directory", errInvalidSubpath, m.config.Subpath)
+	}
+
+	root, err := filepath.EvalSymlinks(m.mountPath)
+	if err != nil {
+		return fmt.Errorf("resolve mount directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(source)
+	if err != nil {
+		return fmt.Errorf("resolve subpath: %w", err)
+	}
+	if resolved != filepath.Join(root, m.config.Subpath) {
+		return fmt.Errorf("%w: %s goes through a symlink", errInvalidSubpath, m.config.Subpath)
+	}
+
+	// Recorded with the overlays, so it's unmounted after them and before JuiceFS
+	if err := m.bindMount(source, m.config.MountPath, false); err != nil {
+		return err
+	}
+
+	m.logger.Info().Str("subpath", m.config.Subpath).Msg("Subpath mounted")
+
+	return nil
+}
+
+// createSubpath creates the missing directories of the subpath under the root of the volume,
+// with the mode and owner of the root so the sandbox users get the same access to them.
+func createSubpath(root, subpath string) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return fmt.Errorf("stat volume root: %w", err)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("no owner for volume root %s", root)
+	}
+
+	dir := root
+	for _, name := range strings.Split(strings.Trim(subpath, "/"), "/") {
+		dir = filepath.Join(dir, name)
+
+		err := os.Mkdir(dir, info.Mode().Perm())
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// The mode given to mkdir is masked by the umask
+		if err := os.Chmod(dir, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("set mode of %s: %w", dir, err)
+		}
+		if err := os.Lchown(dir, int(stat.Uid), int(stat.Gid)); err != nil {
+			return fmt.Errorf("set owner of %s: %w", dir, err)
+		}
+	}
+
+	return nil
+}
+
+// visibleRoot returns the directory of the volume seen at the mount path, through the JuiceFS mount.
+// The JuiceFS tools find the volume from the paths under it, not from the ones of the bind mount.
+func (m *Mounter) visibleRoot() string {
+	return filepath.Join(m.mountPath, m.config.Subpath)
+}
+
 // MountPath returns the mount path.
 func (m *Mounter) MountPath() string {
-	return m.mountPath
+	return m.config.MountPath
 }
 
 // IsMounted checks if the volume is currently mounted.
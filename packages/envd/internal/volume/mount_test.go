@@ -0,0 +1,198 @@
+package volume
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+	volumeformat "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-format"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+func TestStorageEnv(t *testing.T) {
+	t.Parallel()
+
+	gcs := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", GCSEndpoint: "http://10.0.0.1:4443"})
+	env := gcs.storageEnv("JFS_GCS_TOKEN_FILE")
+	assert.Contains(t, env, "JFS_GCS_TOKEN_FILE=/tmp/volumes/vol_1/gcs-token")
+	assert.Contains(t, env, GCSEmulatorHostEnv+"=http://10.0.0.1:4443")
+	assert.NotContains(t, env, "AWS_CONFIG_FILE=/tmp/volumes/vol_1/aws-config")
+
+	s3 := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", StorageProvider: volumestorage.S3, S3Region: "eu-west-1"})
+	env = s3.storageEnv("JFS_GCS_TOKEN_FILE")
+	assert.Contains(t, env, "AWS_CONFIG_FILE=/tmp/volumes/vol_1/aws-config")
+	assert.Contains(t, env, "AWS_REGION=eu-west-1")
+	assert.NotContains(t, env, "JFS_GCS_TOKEN_FILE=/tmp/volumes/vol_1/gcs-token")
+}
+
+func TestJuiceFSEnv(t *testing.T) {
+	t.Parallel()
+
+	redis := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", MetaEngine: volumestorage.MetaEngineRedis, ProxyToken: "token"})
+	env := redis.juiceFSEnv()
+	assert.Contains(t, env, "JFS_GCS_TOKEN_FILE=/tmp/volumes/vol_1/gcs-token")
+	assert.Contains(t, env, MetaPasswordEnv+"=token")
+
+	// The SQLite metadata isn't behind the Redis proxy
+	sqlite := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", ProxyToken: "token"})
+	assert.NotContains(t, sqlite.juiceFSEnv(), MetaPasswordEnv+"=token")
+}
+
+func TestMetaURL(t *testing.T) {
+	t.Parallel()
+
+	sqlite := NewMounter(&host.VolumeConfig{VolumeID: "vol_1"})
+	assert.False(t, sqlite.redisMeta())
+	assert.Equal(t, "sqlite3:///tmp/volumes/vol_1/meta.db", sqlite.metaURL())
+
+	redis := NewMounter(&host.VolumeConfig{
+		VolumeID:     "vol_1",
+		MetaEngine:   volumestorage.MetaEngineRedis,
+		RedisMetaURL: "redis://10.12.0.1:5018/7",
+	})
+	assert.True(t, redis.redisMeta())
+	assert.Equal(t, "redis://10.12.0.1:5018/7", redis.metaURL())
+}
+
+func TestSubpathMountPaths(t *testing.T) {
+	t.Parallel()
+
+	whole := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", MountPath: "/data"})
+	assert.Equal(t, "/data", whole.mountPath)
+	assert.Equal(t, "/data", whole.visibleRoot())
+	assert.Equal(t, "/data/.moru-overlays/home", whole.overlaySource("/home"))
+
+	// JuiceFS is mounted out of reach of the sandbox, only the subpath is at the mount path
+	sub := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", MountPath: "/data", Subpath: "/datasets/imagenet"})
+	assert.Equal(t, "/tmp/volumes/vol_1/root", sub.mountPath)
+	assert.Equal(t, "/tmp/volumes/vol_1/root/datasets/imagenet", sub.visibleRoot())
+	assert.Equal(t, "/tmp/volumes/vol_1/root/datasets/imagenet/.moru-overlays/home", sub.overlaySource("/home"))
+	assert.Equal(t, "/data", sub.MountPath())
+}
+
+func TestCreateSubpath(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	require.NoError(t, os.Chmod(root, 0o751))
+	require.NoError(t, os.Mkdir(filepath.Join(root, "datasets"), 0o700))
+
+	require.NoError(t, createSubpath(root, "/datasets/imagenet/train"))
+
+	// The existing directories are left as they are, the new ones get the mode of the root
+	for dir, mode := range map[string]os.FileMode{
+		"datasets":                0o700,
+		"datasets/imagenet":       0o751,
+		"datasets/imagenet/train": 0o751,
+	} {
+		info, err := os.Stat(filepath.Join(root, dir))
+		require.NoError(t, err)
+		assert.Equal(t, mode, info.Mode().Perm(), dir)
+	}
+
+	require.NoError(t, createSubpath(root, "/datasets/imagenet"))
+}
+
+func TestCheckpointInterval(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultCheckpointInterval, NewMounter(&host.VolumeConfig{VolumeID: "vol_1"}).checkpointInterval())
+
+	m := NewMounter(&host.VolumeConfig{VolumeID: "vol_1", CheckpointIntervalSeconds: 60})
+	assert.Equal(t, time.Minute, m.checkpointInterval())
+}
+
+func TestMounterRegistry(t *testing.T) {
+	t.Parallel()
+
+	first := NewMounter(&host.VolumeConfig{VolumeID: "vol_registry_1", MountPath: "/mnt/registry-1"})
+	second := NewMounter(&host.VolumeConfig{VolumeID: "vol_registry_2", MountPath: "/mnt/registry-2"})
+	assert.NotEqual(t, first.stateDir(), second.stateDir())
+	assert.NotEqual(t, first.cgroupPath(), second.cgroupPath())
+
+	require.NoError(t, reserveMount(first))
+	registerMounter(first)
+	t.Cleanup(func() { unregisterMounter("vol_registry_1") })
+
+	assert.Same(t, first, mountedVolume("vol_registry_1"))
+	assert.Nil(t, mountedVolume("vol_registry_2"))
+	other := NewMounter(&host.VolumeConfig{VolumeID: "vol_registry_1", MountPath: "/mnt/other"})
+	require.ErrorIs(t, reserveMount(other), ErrVolumeAlreadyMounted)
+	samePath := NewMounter(&host.VolumeConfig{VolumeID: "vol_registry_2", MountPath: "/mnt/registry-1"})
+	require.ErrorIs(t, reserveMount(samePath), ErrVolumeAlreadyMounted)
+
+	require.NoError(t, reserveMount(second))
+	registerMounter(second)
+	t.Cleanup(func() { unregisterMounter("vol_registry_2") })
+	assert.Same(t, second, mountedVolume("vol_registry_2"))
+	assert.Equal(t, map[string]*host.VolumeConfig{
+		"vol_registry_1": first.config,
+		"vol_registry_2": second.config,
+	}, filterVolumes(MountedVolumes(), "vol_registry_"))
+
+	unregisterMounter("vol_registry_1")
+	assert.Nil(t, mountedVolume("vol_registry_1"))
+	assert.Same(t, second, mountedVolume("vol_registry_2"))
+}
+
+func TestMounterRegistryReservation(t *testing.T) {
+	t.Parallel()
+
+	m := NewMounter(&host.VolumeConfig{VolumeID: "vol_reserved_1", MountPath: "/mnt/reserved-1"})
+	require.NoError(t, reserveMount(m))
+
+	// The volume and its path can't be mounted again while the mount runs, it isn't mounted yet
+	again := NewMounter(&host.VolumeConfig{VolumeID: "vol_reserved_1", MountPath: "/mnt/reserved-1"})
+	require.ErrorIs(t, reserveMount(again), ErrVolumeAlreadyMounted)
+	samePath := NewMounter(&host.VolumeConfig{VolumeID: "vol_reserved_2", MountPath: "/mnt/reserved-1"})
+	require.ErrorIs(t, reserveMount(samePath), ErrVolumeAlreadyMounted)
+	assert.Nil(t, mountedVolume("vol_reserved_1"))
+	assert.NotContains(t, MountedVolumes(), "vol_reserved_1")
+
+	// A failed mount gives the volume and its path back, the release of another mounter doesn't
+	releaseMount(again)
+	require.ErrorIs(t, reserveMount(samePath), ErrVolumeAlreadyMounted)
+	releaseMount(m)
+	require.NoError(t, reserveMount(samePath))
+	releaseMount(samePath)
+	require.NoError(t, reserveMount(again))
+	releaseMount(again)
+}
+
+// filterVolumes returns the volumes with the ID prefix, the registry is shared by the tests.
+func filterVolumes(volumes map[string]*host.VolumeConfig, prefix string) map[string]*host.VolumeConfig {
+	filtered := make(map[string]*host.VolumeConfig)
+	for volumeID, config := range volumes {
+		if strings.HasPrefix(volumeID, prefix) {
+			filtered[volumeID] = config
+		}
+	}
+
+	return filtered
+}
+
+func TestMountAttempts(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultMountAttempts, NewMounter(&host.VolumeConfig{VolumeID: "vol_1"}).mountAttempts())
+	assert.Equal(t, 5, NewMounter(&host.VolumeConfig{VolumeID: "vol_1", MountAttempts: 5}).mountAttempts())
+}
+
+func TestRetryableMountError(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, retryableMountError(errors.New("juicefs mount failed: connection reset")))
+	assert.False(t, retryableMountError(ErrReadOnlyEmptyVolume))
+	assert.False(t, retryableMountError(fmt.Errorf("volume vol_1 is %w", ErrVolumeAlreadyMounted)))
+	assert.False(t, retryableMountError(fmt.Errorf("JuiceFS %w at %s", errBinaryNotFound, JuiceFSBinary)))
+	assert.False(t, retryableMountError(fmt.Errorf("check volume format: %w", volumeformat.ErrUnsupported)))
+	assert.False(t, retryableMountError(fmt.Errorf("mount subpath: %w: /datasets is not a directory", errInvalidSubpath)))
+}
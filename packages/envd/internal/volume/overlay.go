@@ -0,0 +1,206 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// OverlayDir is the directory on the volume that holds persisted overlay paths.
+	OverlayDir = ".moru-overlays"
+
+	// SeedTimeout is the maximum time to copy template content into a fresh overlay.
+	SeedTimeout = 5 * time.Minute
+)
+
+// scratchPaths stay writable when the root filesystem is made read-only.
+// envd keeps the volume metadata and cache under /tmp, so it must never be protected.
+var scratchPaths = []string{
+	"/proc",
+	"/sys",
+	"/dev",
+	"/run",
+	"/tmp",
+}
+
+// overlaySource returns the volume directory backing the given sandbox path, under the subpath
+// when only a subpath of the volume is mounted.
+func (m *Mounter) overlaySource(path string) string {
+	return filepath.Join(m.visibleRoot(), OverlayDir, strings.TrimPrefix(path, "/"))
+}
+
+// applyOverlays seeds the overlay directories, protects the root filesystem if requested
+// and bind mounts the volume-backed directories over their sandbox paths.
+func (m *Mounter) applyOverlays(ctx context.Context) error {
+	for _, path := range m.config.OverlayPaths {
+		if err := m.seedOverlay(ctx, path); err != nil {
+			return fmt.Errorf("seed overlay %s: %w", path, err)
+		}
+
+		// The home directory can be seeded from a template without it, or be reused by a
+		// template whose user has another uid, so it is handed to the user on every mount.
+		if m.config.PersistHome && path == m.config.HomeDir {
+			if err := os.Chown(m.overlaySource(path), m.config.HomeUID, m.config.HomeGID); err != nil {
+				return fmt.Errorf("set owner of home overlay %s: %w", path, err)
+			}
+		}
+	}
+
+	if m.config.ReadOnlyRoot {
+		if err := m.protectRoot(); err != nil {
+			return fmt.Errorf("protect root filesystem: %w", err)
+		}
+	}
+
+	for _, path := range m.config.OverlayPaths {
+		if err := m.bindMount(m.overlaySource(path), path, false); err != nil {
+			return fmt.Errorf("bind overlay %s: %w", path, err)
+		}
+
+		m.logger.Info().Str("path", path).Str("source", m.overlaySource(path)).Msg("Overlay mounted")
+	}
+
+	return nil
+}
+
+// seedOverlay creates the volume directory for an overlay path. On first use the
+// directory is populated with the template content so the sandbox starts from the same state.
+func (m *Mounter) seedOverlay(ctx context.Context, path string) error {
+	source := m.overlaySource(path)
+
+	if _, err := os.Stat(source); err == nil {
+		return os.MkdirAll(path, 0o755)
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("stat overlay source: %w", err)
+	}
+
+	// Copy into a temporary directory and rename it, so an interrupted seed is retried
+	// on the next mount instead of leaving a partially populated overlay behind.
+	staging := source + ".seeding"
+	if err := os.RemoveAll(staging); err != nil {
+		return fmt.Errorf("remove stale staging directory: %w", err)
+	}
+
+	if err := os.MkdirAll(staging, 0o755); err != nil {
+		return fmt.Errorf("create staging directory: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	switch {
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			return fmt.Errorf("create overlay path: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("stat overlay path: %w", err)
+	case !info.IsDir():
+		return fmt.Errorf("overlay path is not a directory")
+	default:
+		ctx, cancel := context.WithTimeout(ctx, SeedTimeout)
+		defer cancel()
+
+		// cp -a preserves ownership and permissions of the template content
+		cmd := exec.CommandContext(ctx, "cp", "-a", path+"/.", staging+"/")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("copy template content: %w\nOutput: %s", err, string(output))
+		}
+	}
+
+	if err := os.Rename(staging, source); err != nil {
+		return fmt.Errorf("finalize overlay source: %w", err)
+	}
+
+	m.logger.Info().Str("path", path).Msg("Overlay seeded from the template")
+
+	return nil
+}
+
+// protectRoot makes every top-level directory of the template rootfs read-only,
+// except the scratch paths. Recursive bind mounts keep mounts below them (like the
+// volume itself) intact and writable.
+//
+// The root directory entry itself stays writable - processes keep resolving "/"
+// through the original mount, so it can't be replaced without pivoting the root.
+func (m *Mounter) protectRoot() error {
+	entries, err := os.ReadDir("/")
+	if err != nil {
+		return fmt.Errorf("read root directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		// Symlinks (e.g., /bin -> usr/bin) are covered by their targets
+		if !entry.IsDir() {
+			continue
+		}
+
+		path := "/" + entry.Name()
+		if isScratchPath(path) {
+			continue
+		}
+
+		if err := m.bindMount(path, path, true); err != nil {
+			return fmt.Errorf("protect %s: %w", path, err)
+		}
+	}
+
+	m.logger.Info().Msg("Root filesystem protected read-only")
+
+	return nil
+}
+
+// bindMount bind mounts source at target and records it for unmounting.
+func (m *Mounter) bindMount(source, target string, readOnly bool) error {
+	flags := uintptr(unix.MS_BIND)
+	if readOnly {
+		flags |= unix.MS_REC
+	}
+
+	if err := unix.Mount(source, target, "", flags, ""); err != nil {
+		return fmt.Errorf("bind mount: %w", err)
+	}
+
+	m.overlayMounts = append(m.overlayMounts, target)
+
+	if readOnly {
+		// MS_RDONLY is ignored on the initial bind, it must be applied with a remount
+		if err := unix.Mount("", target, "", unix.MS_REMOUNT|unix.MS_BIND|unix.MS_RDONLY, ""); err != nil {
+			return fmt.Errorf("remount read-only: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeOverlays unmounts the overlay and read-only bind mounts in reverse order,
+// so the volume mount below them can be released.
+func (m *Mounter) removeOverlays() {
+	for i := len(m.overlayMounts) - 1; i >= 0; i-- {
+		target := m.overlayMounts[i]
+		if err := unix.Unmount(target, 0); err != nil {
+			m.logger.Warn().Err(err).Str("path", target).Msg("Failed to unmount the overlay, detaching it lazily")
+			if err := unix.Unmount(target, unix.MNT_DETACH); err != nil {
+				m.logger.Error().Err(err).Str("path", target).Msg("Failed to detach the overlay")
+			}
+		}
+	}
+
+	m.overlayMounts = nil
+}
+
+// isScratchPath reports whether the path must stay writable under a read-only root.
+func isScratchPath(path string) bool {
+	for _, scratch := range scratchPaths {
+		if path == scratch {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// prewarmTimeout bounds pulling the prewarmed files into the cache, the sandbox start waits for it.
+const prewarmTimeout = time.Minute
+
+// prewarmPaths expands the glob patterns, relative to the mount path, into the paths to prewarm.
+// Patterns matching nothing are skipped, the files may not exist yet on a new volume.
+func prewarmPaths(mountPath string, patterns []string) ([]string, error) {
+	var paths []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(mountPath, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("prewarm pattern %q: %w", pattern, err)
+		}
+
+		for _, match := range matches {
+			// Patterns going up from the mount path can't reach out of the volume
+			if rel, err := filepath.Rel(mountPath, match); err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+				continue
+			}
+
+			paths = append(paths, match)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return slices.Compact(paths), nil
+}
+
+// prewarm pulls the files matching the prewarm patterns of the volume into the local cache, so the
+// first reads of the sandbox don't wait for the object storage.
+func (m *Mounter) prewarm(ctx context.Context) error {
+	paths, err := prewarmPaths(m.visibleRoot(), m.config.Prewarm)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) == 0 {
+		m.logger.Info().Strs("patterns", m.config.Prewarm).Msg("No files match the prewarm patterns")
+
+		return nil
+	}
+
+	// The paths are passed in a file, there can be more of them than fit in the arguments
+	listFile := filepath.Join(m.stateDir(), "prewarm")
+	if err := os.WriteFile(listFile, []byte(strings.Join(paths, "\n")+"\n"), 0o600); err != nil {
+		return fmt.Errorf("write prewarm list: %w", err)
+	}
+	defer os.Remove(listFile)
+
+	ctx, cancel := context.WithTimeout(ctx, prewarmTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, JuiceFSBinary, "warmup", "--file", listFile)
+	// The cache is filled by the JuiceFS processes of the volume, the warmup counts toward their limits
+	cmd.SysProcAttr = m.sysProcAttr()
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("juicefs warmup failed: %w\nOutput: %s", err, string(output))
+	}
+
+	m.logger.Info().Int("paths", len(paths)).Msg("Volume prewarmed")
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package volume
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrewarmPaths(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mountPath := filepath.Join(dir, "mnt")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "outside"), nil, 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(mountPath, "models"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(mountPath, "data"), 0o755))
+	for _, name := range []string{"models/a.bin", "models/b.bin", "models/config.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(mountPath, name), nil, 0o644))
+	}
+
+	paths, err := prewarmPaths(mountPath, []string{"models/*.bin", "data", "models/a.bin", "missing/*", "../outside"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(mountPath, "data"),
+		filepath.Join(mountPath, "models/a.bin"),
+		filepath.Join(mountPath, "models/b.bin"),
+	}, paths)
+
+	_, err = prewarmPaths(mountPath, []string{"models/[a"})
+	require.Error(t, err)
+}
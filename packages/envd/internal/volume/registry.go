@@ -0,0 +1,132 @@
+package volume
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+// StateDir holds a directory per mounted volume with the files of its JuiceFS and Litestream
+// processes: the metadata DB, the Litestream config, the bucket credentials and, unless the volume
+// options move it, the JuiceFS cache.
+const StateDir = "/tmp/volumes"
+
+// ErrVolumeAlreadyMounted is returned when the volume, or another volume at the same path, is mounted
+// or being mounted.
+var ErrVolumeAlreadyMounted = errors.New("already mounted")
+
+var (
+	mountersMu sync.Mutex
+	// mounters are the mounted volumes by volume ID. Unmount is called via a factory that creates
+	// a new instance, the registry gives it the mounter of the original Mount call.
+	mounters = make(map[string]*Mounter)
+	// reserved are the volumes being mounted by volume ID, their ID and mount path can't be mounted
+	// by another mounter until the mount completes or fails.
+	reserved = make(map[string]*Mounter)
+)
+
+// mountedVolume returns the mounter of the mounted volume, nil when the volume isn't mounted.
+func mountedVolume(volumeID string) *Mounter {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	return mounters[volumeID]
+}
+
+// MountedVolumes returns the configs of the mounted volumes by volume ID.
+func MountedVolumes() map[string]*host.VolumeConfig {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	volumes := make(map[string]*host.VolumeConfig, len(mounters))
+	for volumeID, m := range mounters {
+		volumes[volumeID] = m.config
+	}
+
+	return volumes
+}
+
+// reserveMount reserves the volume and its mount path for the mounter, it returns an error when the
+// volume or another volume at the same path is mounted or being mounted. The reservation is
+// released with releaseMount when the mount fails, registerMounter turns it into the mount.
+func reserveMount(m *Mounter) error {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	volumeID, mountPath := m.config.VolumeID, m.config.MountPath
+	if _, ok := mounters[volumeID]; ok {
+		return fmt.Errorf("volume %s is %w", volumeID, ErrVolumeAlreadyMounted)
+	}
+	if _, ok := reserved[volumeID]; ok {
+		return fmt.Errorf("volume %s is being mounted: %w", volumeID, ErrVolumeAlreadyMounted)
+	}
+
+	for _, registry := range []map[string]*Mounter{mounters, reserved} {
+		for _, other := range registry {
+			if other.config.MountPath == mountPath {
+				return fmt.Errorf("volume %s is %w at %s", other.config.VolumeID, ErrVolumeAlreadyMounted, mountPath)
+			}
+		}
+	}
+
+	reserved[volumeID] = m
+
+	return nil
+}
+
+// releaseMount releases the reservation of a volume that failed to mount.
+func releaseMount(m *Mounter) {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	if reserved[m.config.VolumeID] == m {
+		delete(reserved, m.config.VolumeID)
+	}
+}
+
+// registerMounter records the mounter of a volume that was mounted, in place of its reservation.
+func registerMounter(m *Mounter) {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	delete(reserved, m.config.VolumeID)
+	mounters[m.config.VolumeID] = m
+}
+
+// unregisterMounter forgets the mounter of a volume that was unmounted.
+func unregisterMounter(volumeID string) {
+	mountersMu.Lock()
+	defer mountersMu.Unlock()
+
+	delete(mounters, volumeID)
+}
+
+// stateDir returns the directory of the files of the mount processes of the volume.
+func (m *Mounter) stateDir() string {
+	return filepath.Join(StateDir, m.config.VolumeID)
+}
+
+// metaDBPath returns the path of the SQLite metadata DB of the volume.
+func (m *Mounter) metaDBPath() string {
+	return filepath.Join(m.stateDir(), "meta.db")
+}
+
+// litestreamConfigPath returns the path of the Litestream configuration of the volume.
+func (m *Mounter) litestreamConfigPath() string {
+	return filepath.Join(m.stateDir(), "litestream.yml")
+}
+
+// tokenFile returns the path where the credentials of the volume bucket are written: the GCS token,
+// or the output of the AWS credential process on S3.
+func (m *Mounter) tokenFile() string {
+	return filepath.Join(m.stateDir(), "gcs-token")
+}
+
+// awsConfigFile returns the AWS config of JuiceFS and Litestream on S3. Its credential process reads
+// the token file, so the credentials are refreshed like the GCS token.
+func (m *Mounter) awsConfigFile() string {
+	return filepath.Join(m.stateDir(), "aws-config")
+}
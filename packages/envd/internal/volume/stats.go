@@ -0,0 +1,113 @@
+package volume
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+const (
+	// StatsFile is the virtual file in the root of a JuiceFS mount with the metrics of the mount process.
+	StatsFile = ".stats"
+
+	// The metrics of the stats file used for the volume metrics.
+	readBytesMetric     = "juicefs_fuse_read_size_bytes_sum"
+	writtenBytesMetric  = "juicefs_fuse_written_size_bytes_sum"
+	stagingBlocksMetric = "juicefs_staging_blocks"
+)
+
+// statsState is the previous sample of the I/O counters of the mount, the throughput is the
+// difference to it.
+type statsState struct {
+	mu sync.Mutex
+
+	sampledAt    time.Time
+	readBytes    float64
+	writtenBytes float64
+	lastSync     time.Time
+}
+
+// VolumeStats returns the usage of the mounted volume, or nil when the volume isn't mounted.
+func VolumeStats(volumeID string) (*host.VolumeMetrics, error) {
+	m := mountedVolume(volumeID)
+	if m == nil {
+		return nil, nil
+	}
+
+	return m.Stats(time.Now())
+}
+
+// Stats returns the usage of the volume, the throughput is averaged since the previous call.
+//
+// The volume is in sync when JuiceFS has no written blocks staged for upload, the metadata
+// is replicated by Litestream within a second.
+func (m *Mounter) Stats(now time.Time) (*host.VolumeMetrics, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(m.mountPath, &st); err != nil {
+		return nil, fmt.Errorf("statfs volume: %w", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(m.mountPath, StatsFile))
+	if err != nil {
+		return nil, fmt.Errorf("read volume stats: %w", err)
+	}
+	counters := parseStats(data)
+
+	m.stats.mu.Lock()
+	defer m.stats.mu.Unlock()
+
+	metrics := &host.VolumeMetrics{
+		Used:  (st.Blocks - st.Bfree) * uint64(st.Bsize),
+		Files: st.Files - st.Ffree,
+	}
+
+	readBytes, writtenBytes := counters[readBytesMetric], counters[writtenBytesMetric]
+	if elapsed := now.Sub(m.stats.sampledAt).Seconds(); !m.stats.sampledAt.IsZero() && elapsed > 0 {
+		metrics.ReadBps = max(readBytes-m.stats.readBytes, 0) / elapsed
+		metrics.WriteBps = max(writtenBytes-m.stats.writtenBytes, 0) / elapsed
+	}
+	m.stats.sampledAt, m.stats.readBytes, m.stats.writtenBytes = now, readBytes, writtenBytes
+
+	if counters[stagingBlocksMetric] == 0 {
+		m.stats.lastSync = now
+	}
+	if !m.stats.lastSync.IsZero() {
+		metrics.LastSync = m.stats.lastSync.UTC().Unix()
+	}
+
+	return metrics, nil
+}
+
+// parseStats parses the "name value" lines of the stats file. Metrics with labels are summed
+// over the labels, malformed lines are skipped.
+func parseStats(data []byte) map[string]float64 {
+	counters := make(map[string]float64)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+
+		name, _, _ := strings.Cut(fields[0], "{")
+		counters[name] += value
+	}
+
+	return counters
+}
@@ -0,0 +1,83 @@
+package volume
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/host"
+)
+
+func TestParseStats(t *testing.T) {
+	t.Parallel()
+
+	counters := parseStats([]byte(`juicefs_fuse_read_size_bytes_sum 1024
+juicefs_fuse_written_size_bytes_sum 2.5e+03
+juicefs_object_request_data_bytes{method="GET"} 10
+juicefs_object_request_data_bytes{method="PUT"} 20
+malformed
+juicefs_staging_blocks not-a-number
+`))
+
+	assert.Equal(t, map[string]float64{
+		readBytesMetric:                     1024,
+		writtenBytesMetric:                  2500,
+		"juicefs_object_request_data_bytes": 30,
+	}, counters)
+}
+
+func TestMounterStats(t *testing.T) {
+	t.Parallel()
+
+	mountPath := t.TempDir()
+	m := NewMounter(&host.VolumeConfig{VolumeID: "vol_test", MountPath: mountPath})
+
+	writeStats := func(read, written, staging int) {
+		t.Helper()
+
+		data := []byte(
+			"juicefs_fuse_read_size_bytes_sum " + strconv.Itoa(read) + "\n" +
+				"juicefs_fuse_written_size_bytes_sum " + strconv.Itoa(written) + "\n" +
+				"juicefs_staging_blocks " + strconv.Itoa(staging) + "\n")
+		require.NoError(t, os.WriteFile(filepath.Join(mountPath, StatsFile), data, 0o644))
+	}
+
+	start := time.Unix(1700000000, 0)
+
+	// The first sample has no throughput
+	writeStats(100, 100, 0)
+	metrics, err := m.Stats(start)
+	require.NoError(t, err)
+	assert.Zero(t, metrics.ReadBps)
+	assert.Zero(t, metrics.WriteBps)
+	assert.Equal(t, start.Unix(), metrics.LastSync)
+
+	// Blocks staged for upload keep the previous sync time
+	writeStats(1100, 2100, 3)
+	metrics, err = m.Stats(start.Add(10 * time.Second))
+	require.NoError(t, err)
+	assert.InDelta(t, 100, metrics.ReadBps, 0.001)
+	assert.InDelta(t, 200, metrics.WriteBps, 0.001)
+	assert.Equal(t, start.Unix(), metrics.LastSync)
+
+	// Counters restarting with the mount don't give a negative throughput
+	writeStats(0, 0, 0)
+	metrics, err = m.Stats(start.Add(20 * time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, metrics.ReadBps)
+	assert.Zero(t, metrics.WriteBps)
+	assert.Equal(t, start.Add(20*time.Second).Unix(), metrics.LastSync)
+}
+
+func TestVolumeStatsWithoutVolume(t *testing.T) {
+	t.Parallel()
+
+	metrics, err := VolumeStats("vol_not_mounted")
+	require.NoError(t, err)
+	assert.Nil(t, metrics)
+}
@@ -26,9 +26,9 @@ import (
 	filesystemRpc "github.com/moru-ai/sandbox-infra/packages/envd/internal/services/filesystem"
 	processRpc "github.com/moru-ai/sandbox-infra/packages/envd/internal/services/process"
 	processSpec "github.com/moru-ai/sandbox-infra/packages/envd/internal/services/spec/process"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/supervisor"
 	"github.com/moru-ai/sandbox-infra/packages/envd/internal/utils"
-	// Import volume package to register the volume mounter factory
-	_ "github.com/moru-ai/sandbox-infra/packages/envd/internal/volume"
+	"github.com/moru-ai/sandbox-infra/packages/envd/internal/volume"
 )
 
 const (
@@ -49,7 +49,7 @@ const (
 )
 
 var (
-	Version = "0.4.3"
+	Version = "0.4.19"
 
 	commitSHA string
 
@@ -157,6 +157,7 @@ func main() {
 	defaults := &execcontext.Defaults{
 		User:    defaultUser,
 		EnvVars: utils.NewMap[string, string](),
+		Secrets: utils.NewMap[string, string](),
 	}
 	isFCBoolStr := strconv.FormatBool(!isNotFC)
 	defaults.EnvVars.Store("MORU_SANDBOX", isFCBoolStr)
@@ -189,7 +190,16 @@ func main() {
 	processLogger := l.With().Str("logger", "process").Logger()
 	processService := processRpc.Handle(m, &processLogger, defaults, cgroupManager)
 
-	service := api.New(&envLogger, defaults, mmdsChan, isNotFC)
+	servicesLogger := l.With().Str("logger", "services").Logger()
+	services := supervisor.New(&servicesLogger, processService, defaults)
+
+	volumeLogger := l.With().Str("logger", "volume").Logger()
+	volume.SetLogger(&volumeLogger)
+	if cgroupRoot != "" {
+		volume.SetCgroupRoot(cgroupRoot)
+	}
+
+	service := api.New(&envLogger, defaults, mmdsChan, isNotFC, services)
 
 	// Register the shutdown endpoint (not part of OpenAPI spec)
 	m.Post("/shutdown", service.PostShutdown)
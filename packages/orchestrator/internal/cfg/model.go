@@ -91,7 +91,63 @@ type Config struct {
 	VolumesRedisTLSCA    string `env:"VOLUMES_REDIS_TLS_CA_BASE64"`
 	VolumesRedisPassword string `env:"VOLUMES_REDIS_PASSWORD"`
 	VolumesGCSBucket     string `env:"VOLUMES_BUCKET"`
-	VolumesTokenMinterSA string `env:"VOLUMES_TOKEN_MINTER_SA"` // SA email for token minting (optional, uses VM SA if empty)
+	VolumesTokenMinterSA string `env:"VOLUMES_TOKEN_MINTER_SA"` // SA email for token minting (optional, uses the minter credentials if empty)
+	// VolumesTokenMinterCredentialsFile is a service account key or workload identity federation
+	// config for token minting, for nodes without a metadata server (uses ADC if empty).
+	VolumesTokenMinterCredentialsFile string `env:"VOLUMES_TOKEN_MINTER_CREDENTIALS_FILE"`
+	// VolumesTokenLifetime is the lifetime of the downscoped volume tokens, an hour when unset.
+	// Only honored with VOLUMES_TOKEN_MINTER_SA.
+	VolumesTokenLifetime time.Duration `env:"VOLUMES_TOKEN_LIFETIME"`
+	// VolumesCheckpointInterval bounds how far behind the metadata replica of a mounted volume can be,
+	// envd checkpoints and replicates the metadata at this interval. Five minutes when unset.
+	VolumesCheckpointInterval time.Duration `env:"VOLUMES_CHECKPOINT_INTERVAL"`
+	// VolumesMountAttempts is how many times envd attempts a volume mount failing transiently, 3 when unset.
+	VolumesMountAttempts int `env:"VOLUMES_MOUNT_ATTEMPTS"`
+	// VolumesAllowMountFailure starts the sandboxes without their volume when it can't be mounted,
+	// a volume.mount.failed event is published instead of failing the sandbox. Sandboxes created with
+	// volumeAllowMountFailure are started without their volume regardless.
+	VolumesAllowMountFailure bool `env:"VOLUMES_ALLOW_MOUNT_FAILURE"`
+	// VolumesGCSProxyBytesPerSecond and VolumesGCSProxyRequestsPerSecond limit the requests of each sandbox
+	// volume to the bucket through the GCS proxy when the tier of the team sets no limit, unlimited when unset.
+	VolumesGCSProxyBytesPerSecond    int64 `env:"VOLUMES_GCS_PROXY_BYTES_PER_SECOND"`
+	VolumesGCSProxyRequestsPerSecond int64 `env:"VOLUMES_GCS_PROXY_REQUESTS_PER_SECOND"`
+	// VolumesGCSProxyDownscopedTokens makes the GCS proxy send the requests of the sandboxes with the
+	// downscoped token of their volume instead of the credentials of the node.
+	VolumesGCSProxyDownscopedTokens bool `env:"VOLUMES_GCS_PROXY_DOWNSCOPED_TOKENS"`
+	// VolumesRedisProxyPoolSize is the number of connections to the volumes Redis shared by the JuiceFS
+	// connections of each sandbox, each JuiceFS connection gets its own when unset.
+	VolumesRedisProxyPoolSize int `env:"VOLUMES_REDIS_PROXY_POOL_SIZE"`
+	// VolumesGCSEndpoint is the GCS compatible endpoint used for volume data instead of the public API,
+	// defaults to STORAGE_EMULATOR_HOST. It must be reachable from inside the sandboxes.
+	VolumesGCSEndpoint string `env:"VOLUMES_GCS_ENDPOINT"`
+	// VolumesStorageProvider is the object storage of the volume data, gcs (default) or s3.
+	VolumesStorageProvider string `env:"VOLUMES_STORAGE_PROVIDER"`
+	// VolumesS3Region is the region of the volume buckets on S3.
+	VolumesS3Region string `env:"VOLUMES_S3_REGION"`
+	// VolumesS3RoleARN is the role assumed to mint the session credentials of the volumes on S3.
+	VolumesS3RoleARN string `env:"VOLUMES_S3_ROLE_ARN"`
+	// VolumesS3Endpoint is the URL of an S3 compatible storage used instead of AWS S3, like MinIO for
+	// local development. It must be reachable from inside the sandboxes.
+	VolumesS3Endpoint string `env:"VOLUMES_S3_ENDPOINT"`
+	// VolumesS3AccessKeyID and VolumesS3SecretAccessKey are handed out to the volumes instead of minted
+	// session credentials, for S3 compatible storage without STS. Only for development and CI.
+	VolumesS3AccessKeyID     string `env:"VOLUMES_S3_ACCESS_KEY_ID"`
+	VolumesS3SecretAccessKey string `env:"VOLUMES_S3_SECRET_ACCESS_KEY"`
+
+	WarmPool WarmPoolConfig
+}
+
+// WarmPoolConfig sizes the pools of pre-booted sandboxes kept per template and read-only volume.
+type WarmPoolConfig struct {
+	// Size is the number of ready sandboxes kept per pool, zero disables the warm pool.
+	Size int `env:"WARM_POOL_SIZE" envDefault:"0"`
+	// MaxPools limits how many template and volume combinations are kept warm at once.
+	MaxPools int `env:"WARM_POOL_MAX_POOLS" envDefault:"4"`
+	// MaxAge is how long a pre-booted sandbox waits for a claim before it's replaced.
+	// It must stay below the lifetime of the volume GCS tokens minted at boot.
+	MaxAge time.Duration `env:"WARM_POOL_MAX_AGE" envDefault:"30m"`
+	// IdleTimeout drops a pool that had no create requests for this long.
+	IdleTimeout time.Duration `env:"WARM_POOL_IDLE_TIMEOUT" envDefault:"1h"`
 }
 
 func Parse() (Config, error) {
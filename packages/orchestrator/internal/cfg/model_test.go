@@ -2,6 +2,7 @@ package cfg
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,4 +62,23 @@ func TestParse(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "/a/b/c/build", config.DefaultCacheDir)
 	})
+
+	t.Run("warm pool is disabled by default", func(t *testing.T) {
+		config, err := Parse()
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, config.WarmPool.Size)
+		assert.Equal(t, 30*time.Minute, config.WarmPool.MaxAge)
+	})
+
+	t.Run("warm pool config is parsed correctly", func(t *testing.T) {
+		t.Setenv("WARM_POOL_SIZE", "2")
+		t.Setenv("WARM_POOL_IDLE_TIMEOUT", "15m")
+
+		config, err := Parse()
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, config.WarmPool.Size)
+		assert.Equal(t, 15*time.Minute, config.WarmPool.IdleTimeout)
+	})
 }
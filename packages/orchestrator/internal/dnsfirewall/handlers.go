@@ -0,0 +1,160 @@
+package dnsfirewall
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger/sandbox"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
+)
+
+// Decision represents how a query is answered.
+type Decision int
+
+const (
+	// DecisionRefused queries are answered with REFUSED without contacting the upstream resolver.
+	DecisionRefused Decision = iota
+	// DecisionForwarded queries are resolved, the egress rules decide on the resolved addresses.
+	DecisionForwarded
+	// DecisionAllowed queries are resolved and the resolved addresses are allowed.
+	DecisionAllowed
+)
+
+// queryDecision decides how to answer a query for name, following the priority of the egress firewall:
+//  1. Allow domain (resolve and allow the addresses)
+//  2. Deny domain (refuse)
+//  3. All traffic denied, only allowed domains are resolved (refuse)
+//  4. Default: resolve
+func queryDecision(egress *orchestrator.SandboxNetworkEgressConfig, name string) Decision {
+	for _, domain := range egress.GetAllowedDomains() {
+		if sandbox_network.MatchDomain(name, domain) {
+			return DecisionAllowed
+		}
+	}
+
+	for _, domain := range egress.GetDeniedDomains() {
+		if sandbox_network.MatchDomain(name, domain) {
+			return DecisionRefused
+		}
+	}
+
+	if slices.Contains(egress.GetDeniedCidrs(), sandbox_network.AllInternetTrafficCIDR) {
+		return DecisionRefused
+	}
+
+	return DecisionForwarded
+}
+
+// answerIPs returns the IPv4 addresses of the answer, including the ones of CNAME targets.
+// The sandbox firewall only filters IPv4 traffic.
+func answerIPs(msg *dns.Msg) []net.IP {
+	var ips []net.IP
+	for _, rr := range msg.Answer {
+		if a, ok := rr.(*dns.A); ok {
+			ips = append(ips, a.A)
+		}
+	}
+
+	return ips
+}
+
+// answerStrings formats the answer records for the query log.
+func answerStrings(msg *dns.Msg) []string {
+	answers := make([]string, 0, len(msg.Answer))
+	for _, rr := range msg.Answer {
+		switch record := rr.(type) {
+		case *dns.A:
+			answers = append(answers, record.A.String())
+		case *dns.AAAA:
+			answers = append(answers, record.AAAA.String())
+		case *dns.CNAME:
+			answers = append(answers, strings.TrimSuffix(record.Target, "."))
+		default:
+			answers = append(answers, dns.TypeToString[rr.Header().Rrtype])
+		}
+	}
+
+	return answers
+}
+
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	ctx := context.Background()
+
+	source := w.RemoteAddr().String()
+	sbx, err := s.sandboxes.GetByHostPort(source)
+	if err != nil {
+		s.logger.Error(ctx, "failed to find sandbox for DNS query", zap.String("source", source), zap.Error(err))
+		reply(w, req, dns.RcodeRefused)
+
+		return
+	}
+
+	l := s.logger.With(logger.WithSandboxID(sbx.Runtime.SandboxID))
+
+	if len(req.Question) != 1 {
+		reply(w, req, dns.RcodeFormatError)
+
+		return
+	}
+
+	question := req.Question[0]
+	query := sbxlogger.SandboxDNSQueryFields{
+		Name: strings.TrimSuffix(question.Name, "."),
+		Type: dns.TypeToString[question.Qtype],
+	}
+
+	decision := queryDecision(sbx.Config.Network.GetEgress(), query.Name)
+	if decision == DecisionRefused {
+		query.Rcode = dns.RcodeToString[dns.RcodeRefused]
+		sbxlogger.E(sbx).DNSQuery(ctx, query)
+		reply(w, req, dns.RcodeRefused)
+
+		return
+	}
+
+	network := "udp"
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		network = "tcp"
+	}
+
+	resp, _, err := s.clients[network].ExchangeContext(ctx, req, s.upstream)
+	if err != nil {
+		l.Warn(ctx, "DNS upstream query failed", zap.String("name", query.Name), zap.Error(err))
+		reply(w, req, dns.RcodeServerFailure)
+
+		return
+	}
+
+	// The addresses are allowed before answering, the sandbox connects right after
+	if decision == DecisionAllowed {
+		err = sbx.Slot.AllowResolvedIPs(answerIPs(resp))
+		if err != nil {
+			l.Error(ctx, "failed to allow resolved addresses", zap.String("name", query.Name), zap.Error(err))
+		}
+	}
+
+	query.Allowed = true
+	query.Rcode = dns.RcodeToString[resp.Rcode]
+	query.Answers = answerStrings(resp)
+	sbxlogger.E(sbx).DNSQuery(ctx, query)
+
+	err = w.WriteMsg(resp)
+	if err != nil {
+		l.Debug(ctx, "failed to write DNS response", zap.Error(err))
+	}
+}
+
+// reply answers the query with an empty response and the given code.
+func reply(w dns.ResponseWriter, req *dns.Msg, rcode int) {
+	msg := new(dns.Msg)
+	msg.SetRcode(req, rcode)
+
+	_ = w.WriteMsg(msg)
+}
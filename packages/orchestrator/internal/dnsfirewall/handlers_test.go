@@ -0,0 +1,122 @@
+package dnsfirewall
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
+)
+
+func TestQueryDecision(t *testing.T) {
+	tests := []struct {
+		name   string
+		egress *orchestrator.SandboxNetworkEgressConfig
+		query  string
+		want   Decision
+	}{
+		{
+			name:   "nil egress config forwards",
+			egress: nil,
+			query:  "example.com",
+			want:   DecisionForwarded,
+		},
+		{
+			name: "allowed domain is allowed",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				AllowedDomains: []string{"example.com"},
+				DeniedCidrs:    []string{sandbox_network.AllInternetTrafficCIDR},
+			},
+			query: "example.com",
+			want:  DecisionAllowed,
+		},
+		{
+			name: "allowed wildcard domain is allowed",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				AllowedDomains: []string{"*.example.com"},
+				DeniedCidrs:    []string{sandbox_network.AllInternetTrafficCIDR},
+			},
+			query: "API.example.com",
+			want:  DecisionAllowed,
+		},
+		{
+			name: "other domain is refused when all traffic is denied",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				AllowedDomains: []string{"example.com"},
+				DeniedCidrs:    []string{sandbox_network.AllInternetTrafficCIDR},
+			},
+			query: "attacker.example.org",
+			want:  DecisionRefused,
+		},
+		{
+			name: "denied domain is refused",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				DeniedDomains: []string{"*.example.org"},
+			},
+			query: "uploads.example.org",
+			want:  DecisionRefused,
+		},
+		{
+			name: "other domain forwards when only domains are denied",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				DeniedDomains: []string{"*.example.org"},
+			},
+			query: "example.com",
+			want:  DecisionForwarded,
+		},
+		{
+			name: "allowed domain takes precedence over denied domain",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				AllowedDomains: []string{"api.example.com"},
+				DeniedDomains:  []string{"*.example.com"},
+			},
+			query: "api.example.com",
+			want:  DecisionAllowed,
+		},
+		{
+			name: "denied CIDR other than all traffic forwards",
+			egress: &orchestrator.SandboxNetworkEgressConfig{
+				AllowedDomains: []string{"example.com"},
+				DeniedCidrs:    []string{"10.0.0.0/8"},
+			},
+			query: "example.org",
+			want:  DecisionForwarded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := queryDecision(tt.egress, tt.query)
+			if got != tt.want {
+				t.Errorf("queryDecision(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerIPs(t *testing.T) {
+	msg := new(dns.Msg)
+	msg.Answer = []dns.RR{
+		&dns.CNAME{Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeCNAME}, Target: "cdn.example.net."},
+		&dns.A{Hdr: dns.RR_Header{Name: "cdn.example.net.", Rrtype: dns.TypeA}, A: net.ParseIP("93.184.216.34")},
+		&dns.AAAA{Hdr: dns.RR_Header{Name: "cdn.example.net.", Rrtype: dns.TypeAAAA}, AAAA: net.ParseIP("2606:2800:220:1::")},
+	}
+
+	ips := answerIPs(msg)
+	if len(ips) != 1 || !ips[0].Equal(net.ParseIP("93.184.216.34")) {
+		t.Errorf("answerIPs() = %v, want [93.184.216.34]", ips)
+	}
+
+	answers := answerStrings(msg)
+	want := []string{"cdn.example.net", "93.184.216.34", "2606:2800:220:1::"}
+	if len(answers) != len(want) {
+		t.Fatalf("answerStrings() = %v, want %v", answers, want)
+	}
+	for i := range want {
+		if answers[i] != want[i] {
+			t.Errorf("answerStrings()[%d] = %q, want %q", i, answers[i], want[i])
+		}
+	}
+}
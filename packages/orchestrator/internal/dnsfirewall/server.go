@@ -0,0 +1,101 @@
+package dnsfirewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
+)
+
+// upstreamTimeout is the maximum time to wait for the upstream resolver.
+const upstreamTimeout = 5 * time.Second
+
+// Server resolves the DNS queries of sandboxes with domain egress rules.
+// Queries for names the egress rules don't allow are refused, and the addresses resolved
+// for allowed domains are added to the allow rules of the sandbox firewall.
+type Server struct {
+	logger    logger.Logger
+	sandboxes *sandbox.Map
+
+	upstream string
+	clients  map[string]*dns.Client
+	servers  []*dns.Server
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func New(logger logger.Logger, networkConfig network.Config, sandboxes *sandbox.Map) *Server {
+	addr := fmt.Sprintf("0.0.0.0:%d", networkConfig.SandboxDNSPort)
+
+	s := &Server{
+		logger:    logger,
+		sandboxes: sandboxes,
+		upstream:  net.JoinHostPort(sandbox_network.DefaultNameserver, "53"),
+		clients: map[string]*dns.Client{
+			"udp": {Net: "udp", Timeout: upstreamTimeout},
+			"tcp": {Net: "tcp", Timeout: upstreamTimeout},
+		},
+	}
+
+	s.servers = []*dns.Server{
+		{Addr: addr, Net: "udp", Handler: s},
+		{Addr: addr, Net: "tcp", Handler: s},
+	}
+
+	return s
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	for _, server := range s.servers {
+		g.Go(func() error {
+			err := server.ListenAndServe()
+			if err != nil {
+				return fmt.Errorf("dns %s server: %w", server.Net, err)
+			}
+
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		<-ctx.Done()
+		// The servers return once shut down, a server that failed to start already reported its error
+		_ = s.Close(context.WithoutCancel(ctx))
+
+		return nil
+	})
+
+	s.logger.Info(ctx, "DNS firewall started", zap.String("address", s.servers[0].Addr))
+
+	return g.Wait()
+}
+
+func (s *Server) Close(ctx context.Context) error {
+	s.closeOnce.Do(func() {
+		var errs []error
+		for _, server := range s.servers {
+			err := server.ShutdownContext(ctx)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("shutdown dns %s server: %w", server.Net, err))
+			}
+		}
+
+		s.closeErr = errors.Join(errs...)
+	})
+
+	return s.closeErr
+}
@@ -0,0 +1,92 @@
+package gcsproxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+var (
+	meter                = otel.GetMeterProvider().Meter("orchestrator.internal.gcsproxy")
+	requestsCounter      = utils.Must(telemetry.GetCounter(meter, telemetry.GCSProxyRequestsCounterName))
+	uploadBytesCounter   = utils.Must(telemetry.GetCounter(meter, telemetry.GCSProxyUploadBytesCounterName))
+	downloadBytesCounter = utils.Must(telemetry.GetCounter(meter, telemetry.GCSProxyDownloadBytesCounterName))
+	rejectedCounter      = utils.Must(telemetry.GetCounter(meter, telemetry.GCSProxyRejectedCounterName))
+)
+
+// volumeAttributes returns the attributes the metrics of the proxy are accounted to the volume with.
+func volumeAttributes(cfg Config) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("volume_id", cfg.VolumeID),
+		attribute.String("sandbox_id", cfg.SandboxID),
+		attribute.String("team_id", cfg.TeamID),
+	}
+}
+
+// recordRequest records a forwarded request with the bytes it uploaded and downloaded.
+func (p *Proxy) recordRequest(ctx context.Context, method string, status int, uploaded, downloaded int64) {
+	requestsCounter.Add(ctx, 1, metric.WithAttributes(slices.Concat(p.attributes, []attribute.KeyValue{
+		attribute.String("method", method),
+		attribute.Int("status", status),
+	})...))
+
+	attributes := metric.WithAttributes(p.attributes...)
+	uploadBytesCounter.Add(ctx, uploaded, attributes)
+	downloadBytesCounter.Add(ctx, downloaded, attributes)
+}
+
+// recordRejected records a request rejected for a path outside of the volume or without the client token.
+func (p *Proxy) recordRejected(ctx context.Context) {
+	rejectedCounter.Add(ctx, 1, metric.WithAttributes(p.attributes...))
+}
+
+// countingReader counts the bytes of the request body read by the reverse proxy.
+type countingReader struct {
+	io.ReadCloser
+
+	n int64
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	n, err := r.ReadCloser.Read(b)
+	r.n += int64(n)
+
+	return n, err
+}
+
+// countingWriter counts the bytes of the response body and records its status.
+type countingWriter struct {
+	http.ResponseWriter
+
+	status int
+	n      int64
+}
+
+func (w *countingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.n += int64(n)
+
+	return n, err
+}
+
+// Unwrap lets the reverse proxy flush the streamed downloads through the writer.
+func (w *countingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,66 @@
+package gcsproxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+func TestProxyMetrics(t *testing.T) {
+	// The counters of the package are bound to the global provider once it's set
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = w.Write([]byte("object data"))
+	}))
+	t.Cleanup(upstream.Close)
+
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	p, err := New(Config{VolumeID: "vol_1", SandboxID: "sbx_1", TeamID: "team_1", Bucket: "bucket", Endpoint: upstream.URL}, logger.NewNopLogger())
+	require.NoError(t, err)
+	handler := p.wrapHandler(httputil.NewSingleHostReverseProxy(upstreamURL), upstreamURL)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/upload/storage/v1/b/bucket/o?name=vol_1/chunks/1", strings.NewReader("chunk")))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/storage/v1/b/bucket/o/vol_2%2Fchunks%2F1?alt=media", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+
+	sums := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					sums[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, int64(1), sums[string(telemetry.GCSProxyRequestsCounterName)])
+	assert.Equal(t, int64(len("chunk")), sums[string(telemetry.GCSProxyUploadBytesCounterName)])
+	assert.Equal(t, int64(len("object data")), sums[string(telemetry.GCSProxyDownloadBytesCounterName)])
+	assert.Equal(t, int64(1), sums[string(telemetry.GCSProxyRejectedCounterName)])
+}
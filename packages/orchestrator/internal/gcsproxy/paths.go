@@ -0,0 +1,338 @@
+package gcsproxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// jsonAPIPrefix, uploadAPIPrefix and batchAPIPath are the paths of the GCS JSON API, the paths
+	// of the XML API start with the bucket instead.
+	jsonAPIPrefix   = "/storage/v1/b/"
+	uploadAPIPrefix = "/upload/storage/v1/b/"
+	batchAPIPath    = "/batch/storage/v1"
+
+	// maxInspectedBodySize bounds the metadata, compose and batch bodies read to validate the
+	// objects they name.
+	maxInspectedBodySize = 1 << 20
+)
+
+var errBodyTooLarge = errors.New("request body too large to inspect")
+
+// isRequestAllowed checks if the request only accesses the objects of the volume. It understands
+// the JSON API, its batch and upload endpoints, the XML API and the resumable upload sessions of both.
+// The bodies naming objects are inspected and restored for the upstream.
+func (p *Proxy) isRequestAllowed(r *http.Request) bool {
+	query := r.URL.Query()
+
+	// Upload sessions are only continued when they were started through this proxy
+	if uploadID := query.Get("upload_id"); uploadID != "" && !p.hasUploadSession(uploadID) {
+		return false
+	}
+
+	path := r.URL.EscapedPath()
+	switch {
+	case path == batchAPIPath:
+		return p.isBatchAllowed(r)
+	case strings.HasPrefix(path, uploadAPIPrefix):
+		return p.isJSONRequestAllowed(r, query, strings.TrimPrefix(path, uploadAPIPrefix))
+	case strings.HasPrefix(path, jsonAPIPrefix):
+		return p.isJSONRequestAllowed(r, query, strings.TrimPrefix(path, jsonAPIPrefix))
+	default:
+		return p.isXMLRequestAllowed(r, query, strings.TrimPrefix(path, "/"))
+	}
+}
+
+// isJSONRequestAllowed checks a JSON API request, path is what follows /b/:
+// - {bucket} for the bucket metadata
+// - {bucket}/o?prefix=${prefix} for lists and {bucket}/o?name=${object} for uploads
+// - {bucket}/o/{object} for downloads, updates and deletes
+// - {bucket}/o/{object}/compose and {bucket}/o/{object}/(copyTo|rewriteTo)/b/{bucket}/o/{object}
+func (p *Proxy) isJSONRequestAllowed(r *http.Request, query url.Values, path string) bool {
+	bucket, path, _ := strings.Cut(path, "/")
+	if !p.isBucket(bucket) {
+		return false
+	}
+
+	switch {
+	case path == "":
+		// The bucket metadata can be read, like when checking the bucket exists, but not changed
+		return r.Method == http.MethodGet || r.Method == http.MethodHead
+	case path == "o":
+		switch {
+		case query.Has("upload_id"):
+			// The session was checked when it was started
+			return true
+		case r.Method == http.MethodGet:
+			return p.inVolume(query.Get("prefix"))
+		case r.Method == http.MethodPost:
+			return p.isInsertAllowed(r, query)
+		default:
+			return false
+		}
+	case strings.HasPrefix(path, "o/"):
+		// Object names are escaped in the JSON API, a slash separates the object from its action
+		object, action, hasAction := strings.Cut(strings.TrimPrefix(path, "o/"), "/")
+		if !p.isEscapedObject(object) {
+			return false
+		}
+
+		if !hasAction {
+			return true
+		}
+
+		verb, destination, _ := strings.Cut(action, "/")
+		switch verb {
+		case "compose":
+			return destination == "" && p.areComposeSourcesAllowed(r)
+		case "copyTo", "rewriteTo":
+			bucket, object, ok := strings.Cut(strings.TrimPrefix(destination, "b/"), "/o/")
+			return ok && strings.HasPrefix(destination, "b/") && p.isBucket(bucket) && p.isEscapedObject(object)
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+// isXMLRequestAllowed checks an XML API request, path is {bucket} or {bucket}/{object} with the
+// slashes of the object name unescaped.
+func (p *Proxy) isXMLRequestAllowed(r *http.Request, query url.Values, path string) bool {
+	bucket, object, _ := strings.Cut(path, "/")
+	if !p.isBucket(bucket) {
+		return false
+	}
+
+	if object == "" {
+		// Lists of the objects and of the multipart uploads, and checking the bucket exists
+		if query.Has("prefix") {
+			return (r.Method == http.MethodGet || r.Method == http.MethodHead) && p.inVolume(query.Get("prefix"))
+		}
+
+		return r.Method == http.MethodHead && len(query) == 0
+	}
+
+	if !p.isEscapedObject(object) {
+		return false
+	}
+
+	// Copies name their source in a header, it has to be in the volume too
+	for _, header := range []string{"X-Goog-Copy-Source", "X-Amz-Copy-Source"} {
+		if source := r.Header.Get(header); source != "" {
+			bucket, object, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+			if !p.isBucket(bucket) || !p.isEscapedObject(object) {
+				return false
+			}
+		}
+	}
+
+	if query.Has("compose") {
+		return p.areComposeSourcesAllowed(r)
+	}
+
+	return true
+}
+
+// isBatchAllowed checks every request of a JSON API batch, the outer request carries the credentials
+// for all of them.
+func (p *Proxy) isBatchAllowed(r *http.Request) bool {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return false
+	}
+
+	body, err := peekBody(r)
+	if err != nil {
+		return false
+	}
+
+	parts := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if errors.Is(err, io.EOF) {
+			return true
+		}
+		if err != nil {
+			return false
+		}
+
+		request, err := http.ReadRequest(bufio.NewReader(part))
+		if err != nil {
+			return false
+		}
+
+		// Batches can't be nested
+		if request.URL.EscapedPath() == batchAPIPath || !p.isRequestAllowed(request) {
+			return false
+		}
+	}
+}
+
+// isInsertAllowed checks the object names of a JSON API upload, in the query and in the metadata
+// of the multipart uploads, the resumable uploads and the metadata only inserts.
+func (p *Proxy) isInsertAllowed(r *http.Request, query url.Values) bool {
+	name := query.Get("name")
+	if name != "" && !p.inVolume(name) {
+		return false
+	}
+
+	var metadata struct {
+		Name string `json:"name"`
+	}
+
+	switch query.Get("uploadType") {
+	case "media":
+		// The body is the object, its name is only in the query
+		return name != ""
+	case "multipart":
+		if err := decodeMultipartMetadata(r, &metadata); err != nil {
+			return false
+		}
+	default:
+		// Resumable uploads and metadata only inserts can name the object in their JSON metadata
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+			body, err := peekBody(r)
+			if err != nil {
+				return false
+			}
+
+			if err := json.Unmarshal(body, &metadata); err != nil {
+				return false
+			}
+		}
+	}
+
+	if metadata.Name != "" {
+		return p.inVolume(metadata.Name)
+	}
+
+	return name != ""
+}
+
+// areComposeSourcesAllowed checks the source objects of a compose request, in the JSON or the XML body.
+func (p *Proxy) areComposeSourcesAllowed(r *http.Request) bool {
+	body, err := peekBody(r)
+	if err != nil {
+		return false
+	}
+
+	var names []string
+	if strings.HasPrefix(r.URL.EscapedPath(), jsonAPIPrefix) {
+		var request struct {
+			SourceObjects []struct {
+				Name string `json:"name"`
+			} `json:"sourceObjects"`
+		}
+		if err := json.Unmarshal(body, &request); err != nil {
+			return false
+		}
+
+		for _, source := range request.SourceObjects {
+			names = append(names, source.Name)
+		}
+	} else {
+		var request struct {
+			Components []struct {
+				Name string `xml:"Name"`
+			} `xml:"Component"`
+		}
+		if err := xml.Unmarshal(body, &request); err != nil {
+			return false
+		}
+
+		for _, component := range request.Components {
+			names = append(names, component.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return false
+	}
+
+	for _, name := range names {
+		if !p.inVolume(name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isBucket checks the escaped bucket name of a path is the bucket of the volume.
+func (p *Proxy) isBucket(escaped string) bool {
+	bucket, err := url.PathUnescape(escaped)
+
+	return err == nil && bucket == p.config.Bucket
+}
+
+// isEscapedObject checks the escaped object name of a path is in the volume.
+func (p *Proxy) isEscapedObject(escaped string) bool {
+	object, err := url.PathUnescape(escaped)
+
+	return err == nil && p.inVolume(object)
+}
+
+// inVolume checks the object name or the listed prefix is in the volume.
+func (p *Proxy) inVolume(name string) bool {
+	return strings.HasPrefix(name, p.config.VolumeID+"/")
+}
+
+// peekBody reads the request body to inspect it and puts it back for the upstream.
+func peekBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxInspectedBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	r.Body = restoredBody{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	if len(body) > maxInspectedBodySize {
+		return nil, errBodyTooLarge
+	}
+
+	return body, nil
+}
+
+// decodeMultipartMetadata decodes the JSON metadata in the first part of a multipart upload. Only the
+// bytes read for it are buffered, the object data that follows is streamed to the upstream.
+func decodeMultipartMetadata(r *http.Request, metadata any) error {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return err
+	}
+
+	if r.Body == nil || r.Body == http.NoBody {
+		return io.ErrUnexpectedEOF
+	}
+
+	var read bytes.Buffer
+	defer func() {
+		r.Body = restoredBody{Reader: io.MultiReader(&read, r.Body), Closer: r.Body}
+	}()
+
+	part, err := multipart.NewReader(io.TeeReader(r.Body, &read), params["boundary"]).NextPart()
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(io.LimitReader(part, maxInspectedBodySize)).Decode(metadata)
+}
+
+// restoredBody is a request body put back after it was inspected.
+type restoredBody struct {
+	io.Reader
+	io.Closer
+}
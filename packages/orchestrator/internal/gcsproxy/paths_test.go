@@ -0,0 +1,214 @@
+package gcsproxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+func newTestProxy(t *testing.T, endpoint string) *Proxy {
+	t.Helper()
+
+	p, err := New(Config{VolumeID: "vol_1", Bucket: "bucket", Endpoint: endpoint}, logger.NewNopLogger())
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestIsRequestAllowed(t *testing.T) {
+	t.Parallel()
+
+	batch := func(paths ...string) string {
+		var body strings.Builder
+		for _, path := range paths {
+			body.WriteString("--batch\r\nContent-Type: application/http\r\n\r\nDELETE " + path + " HTTP/1.1\r\n\r\n\r\n")
+		}
+		body.WriteString("--batch--\r\n")
+
+		return body.String()
+	}
+
+	multipartUpload := func(name string) string {
+		return "--upload\r\nContent-Type: application/json\r\n\r\n{\"name\":\"" + name + "\"}\r\n" +
+			"--upload\r\nContent-Type: application/octet-stream\r\n\r\nchunk data\r\n--upload--\r\n"
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		headers map[string]string
+		body    string
+		allowed bool
+	}{
+		// JSON API
+		{name: "json download", method: http.MethodGet, target: "/storage/v1/b/bucket/o/vol_1%2Fchunks%2F1?alt=media", allowed: true},
+		{name: "json download of another volume", method: http.MethodGet, target: "/storage/v1/b/bucket/o/vol_2%2Fchunks%2F1?alt=media"},
+		{name: "json download from another bucket", method: http.MethodGet, target: "/storage/v1/b/other/o/vol_1%2Fchunks%2F1?alt=media"},
+		{name: "json list", method: http.MethodGet, target: "/storage/v1/b/bucket/o?prefix=vol_1%2Fchunks%2F", allowed: true},
+		{name: "json list of the bucket", method: http.MethodGet, target: "/storage/v1/b/bucket/o"},
+		{name: "json bucket metadata", method: http.MethodGet, target: "/storage/v1/b/bucket?alt=json", allowed: true},
+		{name: "json bucket update", method: http.MethodPatch, target: "/storage/v1/b/bucket"},
+		{name: "json bucket iam", method: http.MethodGet, target: "/storage/v1/b/bucket/iam"},
+		{name: "json copy", method: http.MethodPost, target: "/storage/v1/b/bucket/o/vol_1%2Fa/rewriteTo/b/bucket/o/vol_1%2Fb", allowed: true},
+		{name: "json copy to another volume", method: http.MethodPost, target: "/storage/v1/b/bucket/o/vol_1%2Fa/copyTo/b/bucket/o/vol_2%2Fb"},
+		{name: "json unescaped object", method: http.MethodPost, target: "/storage/v1/b/bucket/o/vol_1/a/copyTo/b/bucket/o/vol_2%2Fb"},
+		{
+			name:    "json compose",
+			method:  http.MethodPost,
+			target:  "/storage/v1/b/bucket/o/vol_1%2Fab/compose",
+			body:    `{"sourceObjects":[{"name":"vol_1/a"},{"name":"vol_1/b"}]}`,
+			allowed: true,
+		},
+		{
+			name:   "json compose from another volume",
+			method: http.MethodPost,
+			target: "/storage/v1/b/bucket/o/vol_1%2Fab/compose",
+			body:   `{"sourceObjects":[{"name":"vol_1/a"},{"name":"vol_2/b"}]}`,
+		},
+
+		// JSON API uploads
+		{name: "media upload", method: http.MethodPost, target: "/upload/storage/v1/b/bucket/o?uploadType=media&name=vol_1%2Fa", body: "data", allowed: true},
+		{name: "media upload of another volume", method: http.MethodPost, target: "/upload/storage/v1/b/bucket/o?uploadType=media&name=vol_2%2Fa", body: "data"},
+		{
+			name:    "multipart upload",
+			method:  http.MethodPost,
+			target:  "/upload/storage/v1/b/bucket/o?uploadType=multipart",
+			headers: map[string]string{"Content-Type": "multipart/related; boundary=upload"},
+			body:    multipartUpload("vol_1/a"),
+			allowed: true,
+		},
+		{
+			name:    "multipart upload of another volume",
+			method:  http.MethodPost,
+			target:  "/upload/storage/v1/b/bucket/o?uploadType=multipart&name=vol_1%2Fa",
+			headers: map[string]string{"Content-Type": "multipart/related; boundary=upload"},
+			body:    multipartUpload("vol_2/a"),
+		},
+		{
+			name:    "resumable upload",
+			method:  http.MethodPost,
+			target:  "/upload/storage/v1/b/bucket/o?uploadType=resumable",
+			headers: map[string]string{"Content-Type": "application/json"},
+			body:    `{"name":"vol_1/a"}`,
+			allowed: true,
+		},
+		{name: "resumable upload without a name", method: http.MethodPost, target: "/upload/storage/v1/b/bucket/o?uploadType=resumable"},
+		{name: "unknown upload session", method: http.MethodPut, target: "/upload/storage/v1/b/bucket/o?uploadType=resumable&upload_id=unknown", body: "data"},
+
+		// JSON API batches
+		{
+			name:    "batch",
+			method:  http.MethodPost,
+			target:  "/batch/storage/v1",
+			headers: map[string]string{"Content-Type": "multipart/mixed; boundary=batch"},
+			body:    batch("/storage/v1/b/bucket/o/vol_1%2Fa", "/storage/v1/b/bucket/o/vol_1%2Fb"),
+			allowed: true,
+		},
+		{
+			name:    "batch with another volume",
+			method:  http.MethodPost,
+			target:  "/batch/storage/v1",
+			headers: map[string]string{"Content-Type": "multipart/mixed; boundary=batch"},
+			body:    batch("/storage/v1/b/bucket/o/vol_1%2Fa", "/storage/v1/b/bucket/o/vol_2%2Fb"),
+		},
+		{
+			name:    "nested batch",
+			method:  http.MethodPost,
+			target:  "/batch/storage/v1",
+			headers: map[string]string{"Content-Type": "multipart/mixed; boundary=batch"},
+			body:    batch("/batch/storage/v1"),
+		},
+
+		// XML API
+		{name: "xml download", method: http.MethodGet, target: "/bucket/vol_1/chunks/1", allowed: true},
+		{name: "xml download of another volume", method: http.MethodGet, target: "/bucket/vol_2/chunks/1"},
+		{name: "xml download from another bucket", method: http.MethodGet, target: "/other/vol_1/chunks/1"},
+		{name: "xml upload", method: http.MethodPut, target: "/bucket/vol_1/chunks/1", body: "data", allowed: true},
+		{name: "xml multipart upload", method: http.MethodPost, target: "/bucket/vol_1/chunks/1?uploads", allowed: true},
+		{name: "xml list", method: http.MethodGet, target: "/bucket?prefix=vol_1%2F", allowed: true},
+		{name: "xml list of the bucket", method: http.MethodGet, target: "/bucket"},
+		{name: "xml bucket exists", method: http.MethodHead, target: "/bucket", allowed: true},
+		{name: "xml copy", method: http.MethodPut, target: "/bucket/vol_1/b", headers: map[string]string{"X-Goog-Copy-Source": "/bucket/vol_1/a"}, allowed: true},
+		{name: "xml copy from another volume", method: http.MethodPut, target: "/bucket/vol_1/b", headers: map[string]string{"X-Goog-Copy-Source": "/bucket/vol_2/a"}},
+		{
+			name:    "xml compose",
+			method:  http.MethodPut,
+			target:  "/bucket/vol_1/ab?compose",
+			body:    "<ComposeRequest><Component><Name>vol_1/a</Name></Component><Component><Name>vol_1/b</Name></Component></ComposeRequest>",
+			allowed: true,
+		},
+		{
+			name:   "xml compose from another volume",
+			method: http.MethodPut,
+			target: "/bucket/vol_1/ab?compose",
+			body:   "<ComposeRequest><Component><Name>vol_2/a</Name></Component></ComposeRequest>",
+		},
+	}
+
+	p := newTestProxy(t, "http://127.0.0.1:1")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			for key, value := range tt.headers {
+				r.Header.Set(key, value)
+			}
+
+			assert.Equal(t, tt.allowed, p.isRequestAllowed(r))
+
+			// The inspected body is passed on whole
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
+
+func TestUploadSessions(t *testing.T) {
+	t.Parallel()
+
+	var upstreamURL *url.URL
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", upstreamURL.String()+"/upload/storage/v1/b/bucket/o?uploadType=resumable&upload_id=session")
+		}
+		_, _ = io.Copy(io.Discard, r.Body)
+	}))
+	t.Cleanup(upstream.Close)
+
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	p := newTestProxy(t, upstream.URL)
+	handler := p.wrapHandler(httputil.NewSingleHostReverseProxy(upstreamURL), upstreamURL)
+
+	r := httptest.NewRequest(http.MethodPost, "http://10.12.0.1:5017/upload/storage/v1/b/bucket/o?uploadType=resumable", strings.NewReader(`{"name":"vol_1/a"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// The session continues through the proxy
+	assert.Equal(t, "http://10.12.0.1:5017/upload/storage/v1/b/bucket/o?uploadType=resumable&upload_id=session", w.Header().Get("Location"))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/upload/storage/v1/b/bucket/o?uploadType=resumable&upload_id=session", strings.NewReader("data")))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// The finished session can't be continued
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/upload/storage/v1/b/bucket/o?uploadType=resumable&upload_id=session", strings.NewReader("data")))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
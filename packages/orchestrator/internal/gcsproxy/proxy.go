@@ -4,6 +4,7 @@ package gcsproxy
 
 import (
 	"context"
+	"crypto/subtle"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -15,18 +16,17 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/storage"
 )
 
-const (
-	// Port is the port the GCS proxy listens on in sandbox netns.
-	Port = 5017
-
-	gcsEndpoint = "https://storage.googleapis.com"
-)
+// Port is the port the GCS proxy listens on in sandbox netns.
+const Port = 5017
 
 // Config holds the configuration for a GCS proxy instance.
 type Config struct {
@@ -36,8 +36,32 @@ type Config struct {
 	// VolumeID is the volume ID for path validation (e.g., "vol_abc123").
 	VolumeID string
 
+	// SandboxID and TeamID are the sandbox the volume is mounted in and its team, the requests and
+	// the bandwidth of the volume are accounted to them.
+	SandboxID string
+	TeamID    string
+
 	// Bucket is the GCS bucket name.
 	Bucket string
+
+	// Endpoint is the URL of the GCS API requests are forwarded to, empty for the public API.
+	// Requests to an emulator or another custom endpoint are sent without credentials.
+	Endpoint string
+
+	// BytesPerSecond limits the bytes the volume sends and receives, 0 is unlimited.
+	BytesPerSecond int64
+
+	// RequestsPerSecond limits the requests of the volume, 0 is unlimited.
+	RequestsPerSecond int64
+
+	// TokenSource provides the tokens injected into the requests, like the downscoped tokens of
+	// the volume. The Application Default Credentials of the host are used when it's nil.
+	TokenSource oauth2.TokenSource
+
+	// ClientToken is the bearer token the clients authenticate to the proxy with, their
+	// Authorization header is replaced by the credentials of the volume. The clients aren't
+	// authenticated when empty.
+	ClientToken string
 }
 
 // Proxy is an HTTP reverse proxy that injects GCS credentials.
@@ -47,8 +71,21 @@ type Proxy struct {
 	server   *http.Server
 	listener net.Listener
 
-	// tokenSource provides GCS access tokens via ADC.
-	tokenSource *google.Credentials
+	// tokenSource provides the GCS access tokens, nil for a custom endpoint without one configured.
+	tokenSource oauth2.TokenSource
+
+	// attributes account the metrics of the requests to the volume.
+	attributes []attribute.KeyValue
+
+	// throttle limits the requests of the volume and their bandwidth.
+	throttle *throttle
+
+	// uploads are the resumable upload sessions started through the proxy.
+	uploadsMu sync.Mutex
+	uploads   map[string]struct{}
+
+	// ready is closed once the proxy listens.
+	ready chan struct{}
 
 	mu      sync.Mutex
 	running bool
@@ -58,17 +95,37 @@ type Proxy struct {
 func New(cfg Config, logger logger.Logger) (*Proxy, error) {
 	ctx := context.Background()
 
-	// Get default credentials for GCS
-	creds, err := google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/devstorage.full_control")
-	if err != nil {
-		return nil, fmt.Errorf("get default credentials: %w", err)
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = storage.DefaultGCSEndpoint
 	}
 
-	return &Proxy{
-		config:      cfg,
-		logger:      logger,
-		tokenSource: creds,
-	}, nil
+	p := &Proxy{
+		config:     cfg,
+		logger:     logger,
+		attributes: volumeAttributes(cfg),
+		throttle:   newThrottle(cfg),
+		uploads:    make(map[string]struct{}),
+		ready:      make(chan struct{}),
+	}
+
+	// Emulators and air-gapped endpoints don't use Google credentials
+	switch {
+	case cfg.TokenSource != nil:
+		p.tokenSource = cfg.TokenSource
+	case !storage.IsCustomGCSEndpoint(cfg.Endpoint):
+		creds, err := google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/devstorage.full_control")
+		if err != nil {
+			return nil, fmt.Errorf("get default credentials: %w", err)
+		}
+		p.tokenSource = creds.TokenSource
+	}
+
+	return p, nil
+}
+
+// Ready is closed once the proxy listens for requests.
+func (p *Proxy) Ready() <-chan struct{} {
+	return p.ready
 }
 
 // Start starts the proxy and blocks until the context is cancelled.
@@ -89,8 +146,10 @@ func (p *Proxy) Start(ctx context.Context) error {
 	}
 
 	// Parse upstream URL
-	upstream, err := url.Parse(gcsEndpoint)
+	upstream, err := url.Parse(p.config.Endpoint)
 	if err != nil {
+		p.listener.Close()
+
 		return fmt.Errorf("parse GCS endpoint: %w", err)
 	}
 
@@ -106,7 +165,7 @@ func (p *Proxy) Start(ctx context.Context) error {
 	}
 
 	// Wrap with path validation and credential injection
-	handler := p.wrapHandler(reverseProxy)
+	handler := p.wrapHandler(reverseProxy, upstream)
 
 	p.server = &http.Server{
 		Handler:           handler,
@@ -125,8 +184,14 @@ func (p *Proxy) Start(ctx context.Context) error {
 		zap.String("addr", p.config.ListenAddr),
 		zap.String("volumeId", p.config.VolumeID),
 		zap.String("bucket", p.config.Bucket),
+		zap.String("endpoint", p.config.Endpoint),
+		zap.Int64("bytesPerSecond", p.config.BytesPerSecond),
+		zap.Int64("requestsPerSecond", p.config.RequestsPerSecond),
+		zap.Bool("hostCredentials", p.config.TokenSource == nil && p.tokenSource != nil),
 	)
 
+	close(p.ready)
+
 	err = p.server.Serve(p.listener)
 	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("serve: %w", err)
@@ -144,95 +209,89 @@ func (p *Proxy) Close() error {
 }
 
 // wrapHandler wraps the reverse proxy with path validation and credential injection.
-func (p *Proxy) wrapHandler(proxy *httputil.ReverseProxy) http.Handler {
+func (p *Proxy) wrapHandler(proxy *httputil.ReverseProxy, upstream *url.URL) http.Handler {
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		p.trackUploadSession(resp, upstream)
+
+		return nil
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		// Validate path prefix
-		if !p.isPathAllowed(r.URL.Path, r.URL.RawQuery) {
+		// Only JuiceFS has the token, the other processes of the sandbox can't reach the volume
+		if !p.isClientAuthenticated(r) {
+			p.logger.Warn(ctx, "GCS proxy: client not authenticated", zap.String("volumeId", p.config.VolumeID))
+			p.recordRejected(ctx)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		// Validate the objects of the request are in the volume
+		if !p.isRequestAllowed(r) {
 			p.logger.Warn(ctx, "GCS proxy: path not allowed",
 				zap.String("path", r.URL.Path),
 				zap.String("volumeId", p.config.VolumeID),
 			)
+			p.recordRejected(ctx)
 			http.Error(w, "Forbidden: path not allowed for this volume", http.StatusForbidden)
 			return
 		}
 
-		// Inject authorization header
-		token, err := p.getToken(ctx)
-		if err != nil {
-			p.logger.Error(ctx, "GCS proxy: failed to get token", zap.Error(err))
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		// Requests over the limit wait for their turn, JuiceFS retries the ones given up on
+		if err := p.throttle.waitRequest(ctx); err != nil {
+			http.Error(w, "Too many requests for this volume", http.StatusTooManyRequests)
 			return
 		}
-		r.Header.Set("Authorization", "Bearer "+token)
 
-		// Update host header for GCS
-		r.Host = "storage.googleapis.com"
+		// Inject authorization header
+		if p.tokenSource != nil {
+			token, err := p.getToken(ctx)
+			if err != nil {
+				p.logger.Error(ctx, "GCS proxy: failed to get token", zap.Error(err))
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		// Update host header for the upstream, the upload session URLs are pointed back to the proxy
+		r = r.WithContext(context.WithValue(ctx, proxyHostKey{}, r.Host))
+		r.Host = upstream.Host
+
+		// Count the bytes the volume sends and receives for its bandwidth, within its limit
+		body := &countingReader{ReadCloser: p.throttle.body(ctx, r.Body)}
+		if r.Body != nil && r.Body != http.NoBody {
+			r.Body = body
+		}
+		writer := &countingWriter{ResponseWriter: p.throttle.writer(ctx, w)}
+
+		proxy.ServeHTTP(writer, r)
 
-		proxy.ServeHTTP(w, r)
+		p.recordRequest(ctx, r.Method, writer.status, body.n, writer.n)
 	})
 }
 
-// isPathAllowed checks if the request path is for the allowed volume.
-// GCS JSON API uses paths like:
-// - GET /storage/v1/b/${bucket}/o/${object}?alt=media
-// - POST /upload/storage/v1/b/${bucket}/o?name=${object}
-// - GET /storage/v1/b/${bucket}/o?prefix=${prefix}
-func (p *Proxy) isPathAllowed(path, query string) bool {
-	// Volume prefix for path matching
-	volumePrefix := p.config.VolumeID + "/"
-
-	// Check object name in query parameters (for uploads and lists)
-	if query != "" {
-		values, err := url.ParseQuery(query)
-		if err == nil {
-			// Check "name" parameter (used in uploads)
-			if name := values.Get("name"); name != "" {
-				if !strings.HasPrefix(name, volumePrefix) {
-					return false
-				}
-				return true
-			}
-			// Check "prefix" parameter (used in list operations)
-			if prefix := values.Get("prefix"); prefix != "" {
-				if !strings.HasPrefix(prefix, volumePrefix) {
-					return false
-				}
-				return true
-			}
-		}
+// isClientAuthenticated checks the request has the bearer token of the clients, when the proxy has one.
+func (p *Proxy) isClientAuthenticated(r *http.Request) bool {
+	if p.config.ClientToken == "" {
+		return true
 	}
 
-	// Check object name in path (for downloads and deletes)
-	// Path format: /storage/v1/b/{bucket}/o/{object}
-	if strings.Contains(path, "/o/") {
-		parts := strings.SplitN(path, "/o/", 2)
-		if len(parts) == 2 {
-			// URL-decode the object name
-			objectName, err := url.PathUnescape(parts[1])
-			if err != nil {
-				return false
-			}
-			if !strings.HasPrefix(objectName, volumePrefix) {
-				return false
-			}
-			return true
-		}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(p.config.ClientToken)) != 1 {
+		return false
 	}
 
-	// Allow bucket-level operations without object name
-	// (like checking if bucket exists)
-	if strings.Contains(path, "/b/"+p.config.Bucket) && !strings.Contains(path, "/o/") && query == "" {
-		return true
-	}
+	// The token of the client isn't sent upstream
+	r.Header.Del("Authorization")
 
-	return false
+	return true
 }
 
 // getToken returns a valid GCS access token.
 func (p *Proxy) getToken(ctx context.Context) (string, error) {
-	token, err := p.tokenSource.TokenSource.Token()
+	token, err := p.tokenSource.Token()
 	if err != nil {
 		return "", err
 	}
@@ -247,16 +306,21 @@ func StartInNamespace(ctx context.Context, cfg Config, log logger.Logger) (*Prox
 		return nil, err
 	}
 
+	errCh := make(chan error, 1)
 	go func() {
 		if err := proxy.Start(ctx); err != nil {
 			log.Error(ctx, "GCS proxy error", zap.Error(err))
+			errCh <- err
 		}
 	}()
 
-	// Give the proxy a moment to start
-	time.Sleep(10 * time.Millisecond)
-
-	return proxy, nil
+	// Wait for the proxy to listen or fail
+	select {
+	case err := <-errCh:
+		return nil, fmt.Errorf("proxy startup failed: %w", err)
+	case <-proxy.Ready():
+		return proxy, nil
+	}
 }
 
 // Copy is a simple bidirectional copy between two connections.
@@ -0,0 +1,88 @@
+package gcsproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+func TestProxyTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var authorization string
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(upstream.Close)
+
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	p, err := New(Config{
+		VolumeID:    "vol_1",
+		Bucket:      "bucket",
+		Endpoint:    upstream.URL,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "downscoped"}),
+	}, logger.NewNopLogger())
+	require.NoError(t, err)
+	handler := p.wrapHandler(httputil.NewSingleHostReverseProxy(upstreamURL), upstreamURL)
+
+	// The token of the volume replaces the credentials the sandbox sent
+	r := httptest.NewRequest(http.MethodGet, "/bucket/vol_1/chunks/1", nil)
+	r.Header.Set("Authorization", "Bearer sandbox")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Bearer downscoped", authorization)
+}
+
+func TestProxyClientToken(t *testing.T) {
+	t.Parallel()
+
+	var authorization []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+	}))
+	t.Cleanup(upstream.Close)
+
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	// The emulators are sent the requests without credentials
+	p, err := New(Config{
+		VolumeID:    "vol_1",
+		Bucket:      "bucket",
+		Endpoint:    upstream.URL,
+		ClientToken: "token",
+	}, logger.NewNopLogger())
+	require.NoError(t, err)
+	handler := p.wrapHandler(httputil.NewSingleHostReverseProxy(upstreamURL), upstreamURL)
+
+	for header, status := range map[string]int{
+		"":             http.StatusUnauthorized,
+		"Bearer other": http.StatusUnauthorized,
+		"Basic token":  http.StatusUnauthorized,
+		"Bearer token": http.StatusOK,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/bucket/vol_1/chunks/1", nil)
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, status, w.Code, header)
+	}
+
+	// The token of the client isn't sent upstream
+	assert.Equal(t, []string{""}, authorization)
+}
@@ -0,0 +1,113 @@
+package gcsproxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// minBandwidthBurst lets the limited bandwidth pass in chunks large enough to not slow down the copy.
+const minBandwidthBurst = 64 << 10
+
+// throttle limits the requests of a volume and the bytes they send and receive, so one sandbox
+// reading a large dataset doesn't saturate the network of the node for the others.
+type throttle struct {
+	// requests and bandwidth are nil when they aren't limited.
+	requests  *rate.Limiter
+	bandwidth *rate.Limiter
+}
+
+// newThrottle returns the throttle of the limits of the config, zero limits are unlimited.
+func newThrottle(cfg Config) *throttle {
+	t := &throttle{}
+
+	if cfg.RequestsPerSecond > 0 {
+		t.requests = rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), int(max(cfg.RequestsPerSecond, 1)))
+	}
+
+	if cfg.BytesPerSecond > 0 {
+		t.bandwidth = rate.NewLimiter(rate.Limit(cfg.BytesPerSecond), int(max(cfg.BytesPerSecond, minBandwidthBurst)))
+	}
+
+	return t
+}
+
+// waitRequest waits until the request can be forwarded, it fails when the request is canceled first.
+func (t *throttle) waitRequest(ctx context.Context) error {
+	if t.requests == nil {
+		return nil
+	}
+
+	return t.requests.Wait(ctx)
+}
+
+// waitBytes waits until n bytes can be sent or received.
+func (t *throttle) waitBytes(ctx context.Context, n int) error {
+	for n > 0 {
+		chunk := min(n, t.bandwidth.Burst())
+		if err := t.bandwidth.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+
+	return nil
+}
+
+// body returns the request body limited to the bandwidth.
+func (t *throttle) body(ctx context.Context, body io.ReadCloser) io.ReadCloser {
+	if t.bandwidth == nil || body == nil || body == http.NoBody {
+		return body
+	}
+
+	return &throttledReader{ReadCloser: body, ctx: ctx, throttle: t}
+}
+
+// writer returns the response writer limited to the bandwidth.
+func (t *throttle) writer(ctx context.Context, w http.ResponseWriter) http.ResponseWriter {
+	if t.bandwidth == nil {
+		return w
+	}
+
+	return &throttledWriter{ResponseWriter: w, ctx: ctx, throttle: t}
+}
+
+// throttledReader waits for the bandwidth after each read of the request body.
+type throttledReader struct {
+	io.ReadCloser
+
+	ctx      context.Context //nolint:containedctx // the context of the request the body is read for
+	throttle *throttle
+}
+
+func (r *throttledReader) Read(b []byte) (int, error) {
+	n, err := r.ReadCloser.Read(b)
+	if waitErr := r.throttle.waitBytes(r.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+
+	return n, err
+}
+
+// throttledWriter waits for the bandwidth before each write of the response body.
+type throttledWriter struct {
+	http.ResponseWriter
+
+	ctx      context.Context //nolint:containedctx // the context of the request the response is written for
+	throttle *throttle
+}
+
+func (w *throttledWriter) Write(b []byte) (int, error) {
+	if err := w.throttle.waitBytes(w.ctx, len(b)); err != nil {
+		return 0, err
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets the reverse proxy flush the streamed downloads through the writer.
+func (w *throttledWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,55 @@
+package gcsproxy
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unlimited", func(t *testing.T) {
+		t.Parallel()
+
+		throttle := newThrottle(Config{})
+		w := httptest.NewRecorder()
+
+		assert.NoError(t, throttle.waitRequest(t.Context()))
+		assert.Same(t, w, throttle.writer(t.Context(), w))
+	})
+
+	t.Run("requests", func(t *testing.T) {
+		t.Parallel()
+
+		throttle := newThrottle(Config{RequestsPerSecond: 1})
+		require.NoError(t, throttle.waitRequest(t.Context()))
+
+		// The next request is only allowed in a second
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, throttle.waitRequest(ctx))
+	})
+
+	t.Run("bandwidth", func(t *testing.T) {
+		t.Parallel()
+
+		throttle := newThrottle(Config{BytesPerSecond: 1024})
+
+		w := httptest.NewRecorder()
+		n, err := throttle.writer(t.Context(), w).Write(make([]byte, minBandwidthBurst))
+		require.NoError(t, err)
+		assert.Equal(t, minBandwidthBurst, n)
+
+		// The burst is used up, the next bytes wait for a whole second
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = throttle.writer(ctx, w).Write(make([]byte, 2048))
+		assert.Error(t, err)
+		assert.Equal(t, minBandwidthBurst, w.Body.Len())
+	})
+}
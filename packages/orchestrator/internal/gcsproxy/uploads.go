@@ -0,0 +1,56 @@
+package gcsproxy
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// proxyHostKey is the context key of the host the sandbox sent the request to.
+type proxyHostKey struct{}
+
+// trackUploadSession records the resumable upload sessions started through the proxy and forgets the
+// finished ones. The session URLs the upstream returns are pointed to the proxy, so the uploaded
+// data keeps going through it with the credentials, the checks and the limits of the volume.
+func (p *Proxy) trackUploadSession(resp *http.Response, upstream *url.URL) {
+	if uploadID := resp.Request.URL.Query().Get("upload_id"); uploadID != "" {
+		switch resp.StatusCode {
+		// 499 is the response to a canceled session
+		case http.StatusOK, http.StatusCreated, http.StatusNotFound, http.StatusGone, 499:
+			p.uploadsMu.Lock()
+			delete(p.uploads, uploadID)
+			p.uploadsMu.Unlock()
+		}
+
+		return
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return
+	}
+
+	uploadID := location.Query().Get("upload_id")
+	if uploadID == "" {
+		return
+	}
+
+	p.uploadsMu.Lock()
+	p.uploads[uploadID] = struct{}{}
+	p.uploadsMu.Unlock()
+
+	if host, ok := resp.Request.Context().Value(proxyHostKey{}).(string); ok && location.Host == upstream.Host {
+		location.Scheme = "http"
+		location.Host = host
+		resp.Header.Set("Location", location.String())
+	}
+}
+
+// hasUploadSession checks the resumable upload session was started through the proxy.
+func (p *Proxy) hasUploadSession(uploadID string) bool {
+	p.uploadsMu.Lock()
+	defer p.uploadsMu.Unlock()
+
+	_, ok := p.uploads[uploadID]
+
+	return ok
+}
@@ -0,0 +1,138 @@
+package gcstoken
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// baseTokenMaxAge bounds how long a base token is reused. The downscoped tokens expire with
+	// the base token, so a sandbox gets a token with at least the base lifetime minus this.
+	baseTokenMaxAge = 15 * time.Minute
+	// baseTokenExpiryMargin is the lifetime a base token must have left to be reused.
+	baseTokenExpiryMargin = 5 * time.Minute
+
+	// prewarmInterval refreshes the base token before it ages out, so minting doesn't wait for it.
+	prewarmInterval      = 10 * time.Minute
+	prewarmRetryInterval = 30 * time.Second
+)
+
+// baseToken is a cached token to be downscoped.
+type baseToken struct {
+	accessToken string
+	fetchedAt   time.Time
+	expiresAt   time.Time
+}
+
+func (t *baseToken) valid(now time.Time) bool {
+	return t != nil &&
+		now.Sub(t.fetchedAt) < baseTokenMaxAge &&
+		now.Before(t.expiresAt.Add(-baseTokenExpiryMargin))
+}
+
+// getBaseToken returns the cached base token of the scope, fetching a new one when it's missing
+// or too old. Concurrent callers wait for a single fetch. Tokens with a custom lifetime aren't
+// cached, reusing them would shorten the lifetime.
+func (m *Minter) getBaseToken(ctx context.Context, scope string, lifetime time.Duration) (string, error) {
+	if lifetime != 0 {
+		accessToken, _, err := m.fetchBaseToken(ctx, scope, lifetime)
+
+		return accessToken, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if base := m.base[scope]; base.valid(time.Now()) {
+		return base.accessToken, nil
+	}
+
+	return m.refreshBaseTokenLocked(ctx, scope)
+}
+
+// refreshBaseTokens fetches new base tokens for the default scope and the scopes used so far.
+func (m *Minter) refreshBaseTokens(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	defaultScope, err := RoleObjectAdmin.scope()
+	if err != nil {
+		return err
+	}
+
+	scopes := []string{defaultScope}
+	for scope := range m.base {
+		if scope != defaultScope {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	var errs []error
+	for _, scope := range scopes {
+		if _, err := m.refreshBaseTokenLocked(ctx, scope); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *Minter) refreshBaseTokenLocked(ctx context.Context, scope string) (string, error) {
+	fetchedAt := time.Now()
+
+	accessToken, expiresAt, err := m.fetchBaseToken(ctx, scope, defaultTokenLifetime)
+	if err != nil {
+		return "", err
+	}
+
+	m.base[scope] = &baseToken{
+		accessToken: accessToken,
+		fetchedAt:   fetchedAt,
+		expiresAt:   expiresAt,
+	}
+
+	return accessToken, nil
+}
+
+// Start pre-warms the base token in the background, so attaching a volume only waits
+// for the STS exchange.
+func (m *Minter) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	go func() {
+		defer close(m.done)
+
+		for {
+			wait := prewarmInterval
+			if err := m.refreshBaseTokens(ctx); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				logger.L().Warn(ctx, "failed to pre-warm GCS base token", zap.Error(err))
+				wait = prewarmRetryInterval
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+	}()
+}
+
+// Close stops the pre-warming.
+func (m *Minter) Close(context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+		<-m.done
+	}
+
+	return nil
+}
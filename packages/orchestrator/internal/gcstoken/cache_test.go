@@ -0,0 +1,105 @@
+package gcstoken
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// roundTripFunc answers the requests of the minter without a network.
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// countingTokenSource stands in for the minter's own credentials.
+type countingTokenSource struct {
+	calls atomic.Int32
+}
+
+func (c *countingTokenSource) Token() (*oauth2.Token, error) {
+	c.calls.Add(1)
+
+	return &oauth2.Token{AccessToken: "caller", Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestMintDownscopedTokenCachesBaseToken(t *testing.T) {
+	t.Parallel()
+
+	var stsCalls atomic.Int32
+
+	credentials := &countingTokenSource{}
+	m := newMinter("", credentials)
+	m.httpClient.Transport = roundTripFunc(func(*http.Request) *http.Response {
+		stsCalls.Add(1)
+
+		return jsonResponse(`{"access_token":"downscoped","expires_in":3000}`)
+	})
+
+	for range 3 {
+		token, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "downscoped", token.AccessToken)
+	}
+
+	assert.Equal(t, int32(1), credentials.calls.Load())
+	assert.Equal(t, int32(3), stsCalls.Load())
+}
+
+func TestBaseTokenValid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	var missing *baseToken
+	assert.False(t, missing.valid(now))
+
+	fresh := &baseToken{accessToken: "base", fetchedAt: now, expiresAt: now.Add(time.Hour)}
+	assert.True(t, fresh.valid(now))
+	assert.False(t, fresh.valid(now.Add(baseTokenMaxAge)), "aged out")
+
+	expiring := &baseToken{accessToken: "base", fetchedAt: now, expiresAt: now.Add(baseTokenExpiryMargin)}
+	assert.False(t, expiring.valid(now), "expires too soon")
+}
+
+func TestStartPrewarmsBaseToken(t *testing.T) {
+	t.Parallel()
+
+	credentials := &countingTokenSource{}
+	m := newMinter("", credentials)
+
+	m.Start(context.Background())
+	t.Cleanup(func() { require.NoError(t, m.Close(context.Background())) })
+
+	require.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		scope, _ := RoleObjectAdmin.scope()
+
+		return m.base[scope].valid(time.Now())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	scope, err := RoleObjectAdmin.scope()
+	require.NoError(t, err)
+	_, err = m.getBaseToken(context.Background(), scope, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), credentials.calls.Load())
+}
@@ -0,0 +1,60 @@
+package gcstoken
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// cloudPlatformScope is the scope of the minter's own token, impersonation requires it.
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// findCredentials returns the credentials the minter authenticates with. The credentials file
+// holds a service account key or a workload identity federation config. Without it, the
+// Application Default Credentials are used: GOOGLE_APPLICATION_CREDENTIALS, the gcloud
+// credentials for local development, or the metadata server on GCE and GKE with workload identity.
+func findCredentials(ctx context.Context, credentialsFile string) (oauth2.TokenSource, error) {
+	// The token source refreshes with the context, it must outlive the caller
+	ctx = context.WithoutCancel(ctx)
+
+	if credentialsFile == "" {
+		creds, err := google.FindDefaultCredentials(ctx, cloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("find default credentials: %w", err)
+		}
+
+		return creds.TokenSource, nil
+	}
+
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read credentials file: %w", err)
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, data, cloudPlatformScope)
+	if err != nil {
+		return nil, fmt.Errorf("parse credentials file: %w", err)
+	}
+
+	return creds.TokenSource, nil
+}
+
+// getCallerToken returns an access token of the minter's own credentials and its expiry.
+// Tokens without an expiry are reused up to baseTokenMaxAge.
+func (m *Minter) getCallerToken() (string, time.Time, error) {
+	token, err := m.credentials.Token()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("get credentials token: %w", err)
+	}
+
+	expiresAt := token.Expiry
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(defaultTokenLifetime)
+	}
+
+	return token.AccessToken, expiresAt, nil
+}
@@ -0,0 +1,35 @@
+package gcstoken
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCredentialsFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := findCredentials(context.Background(), filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+
+	invalid := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"type":"unknown"}`), 0o600))
+	_, err = findCredentials(context.Background(), invalid)
+	require.Error(t, err)
+
+	// A workload identity federation config, the token is only exchanged on use
+	external := filepath.Join(t.TempDir(), "external.json")
+	require.NoError(t, os.WriteFile(external, []byte(`{
+		"type": "external_account",
+		"audience": "//iam.googleapis.com/projects/1/locations/global/workloadIdentityPools/pool/providers/provider",
+		"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+		"token_url": "https://sts.googleapis.com/v1/token",
+		"credential_source": {"file": "/var/run/token"}
+	}`), 0o600))
+	credentials, err := findCredentials(context.Background(), external)
+	require.NoError(t, err)
+	require.NotNil(t, credentials)
+}
@@ -1,6 +1,9 @@
 // Package gcstoken provides functionality for minting downscoped GCS access tokens.
 // Downscoped tokens allow sandboxes to access only their specific volume prefix in GCS.
 // See: https://cloud.google.com/iam/docs/downscoping-short-lived-credentials
+//
+// For volumes on S3, S3Minter mints STS session credentials limited the same way.
+// StaticMinter hands out static keys instead, for S3 compatible storage in development.
 package gcstoken
 
 import (
@@ -11,53 +14,147 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenMinter mints the credentials envd mounts a volume with, downscoped to the prefixes of the volume.
+type TokenMinter interface {
+	MintDownscopedToken(ctx context.Context, bucket, volumeID string, opts MintOptions) (*Token, error)
+	// Start prepares the credentials of the minter in the background until Close.
+	Start(ctx context.Context)
+	Close(ctx context.Context) error
+}
+
+var (
+	_ TokenMinter = (*Minter)(nil)
+	_ TokenMinter = (*S3Minter)(nil)
+	_ TokenMinter = (*StaticMinter)(nil)
 )
 
 // Token represents a downscoped GCS access token.
 type Token struct {
+	// AccessToken is the OAuth2 access token, or the output of an AWS credential process for S3.
 	AccessToken string
 	ExpiresIn   int       // seconds until expiry
 	ExpiresAt   time.Time // absolute expiry time
 }
 
+// Role is the GCS role a downscoped token grants on the prefixes of the volume.
+type Role string
+
+const (
+	// RoleObjectViewer allows listing and reading the objects of the volume, for read-only mounts.
+	RoleObjectViewer Role = "roles/storage.objectViewer"
+	// RoleObjectAdmin allows listing, reading, writing and deleting the objects of the volume.
+	RoleObjectAdmin Role = "roles/storage.objectAdmin"
+)
+
+const (
+	// defaultTokenLifetime is the lifetime of the impersonated base tokens.
+	defaultTokenLifetime = time.Hour
+	// maxTokenLifetime is the longest lifetime GCP grants with the extended lifetime org policy.
+	maxTokenLifetime = 12 * time.Hour
+)
+
+// scope returns the OAuth scope of the base token the role is downscoped from.
+func (r Role) scope() (string, error) {
+	switch r {
+	case RoleObjectViewer:
+		return "https://www.googleapis.com/auth/devstorage.read_only", nil
+	case RoleObjectAdmin:
+		return "https://www.googleapis.com/auth/devstorage.read_write", nil
+	default:
+		return "", fmt.Errorf("unsupported role %q", r)
+	}
+}
+
+// MintOptions are the per-attach options of a downscoped token.
+type MintOptions struct {
+	// Role granted on the volume, RoleObjectAdmin when empty.
+	Role Role
+	// Lifetime of the token, defaultTokenLifetime when zero. Downscoped tokens expire with the
+	// token they are downscoped from, so the lifetime is only honored when impersonating,
+	// the tokens of the minter's own credentials have a fixed lifetime.
+	Lifetime time.Duration
+}
+
 // Minter creates downscoped GCS tokens for volume access.
 type Minter struct {
-	bucket                    string
-	impersonateServiceAccount string // SA email to impersonate (optional)
+	impersonateServiceAccount string             // SA email to impersonate (optional)
+	credentials               oauth2.TokenSource // the minter's own credentials
 	httpClient                *http.Client
+
+	// The base tokens with the default lifetime are shared by all volumes, keyed by their scope.
+	// Only the STS exchange is per volume.
+	mu   sync.Mutex
+	base map[string]*baseToken
+
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
-// NewMinter creates a new token minter for the given GCS bucket.
+// NewMinter creates a new token minter.
 // If impersonateSA is provided, the minter will impersonate that service account
 // when generating tokens (recommended for security isolation).
-func NewMinter(bucket string, impersonateSA string) *Minter {
+// The minter authenticates with the credentials file when provided, otherwise with the
+// Application Default Credentials, see findCredentials.
+func NewMinter(ctx context.Context, impersonateSA, credentialsFile string) (*Minter, error) {
+	credentials, err := findCredentials(ctx, credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMinter(impersonateSA, credentials), nil
+}
+
+func newMinter(impersonateSA string, credentials oauth2.TokenSource) *Minter {
 	return &Minter{
-		bucket:                    bucket,
 		impersonateServiceAccount: impersonateSA,
+		credentials:               credentials,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		base: make(map[string]*baseToken),
+		done: make(chan struct{}),
 	}
 }
 
-// MintDownscopedToken creates a downscoped token for volume operations.
-// The token is scoped to the specific volume prefix with minimal permissions:
-//   - objectAdmin: list + get + create (restricted to volumeID/ and volumeID-meta/ prefixes)
+// MintDownscopedToken creates a downscoped token for volume operations in the bucket holding the volume,
+// either the shared volumes bucket or the bucket of the volume's team.
+// The token is scoped to the specific volume prefix with the role of the options:
+//   - objectViewer: list + get (restricted to volumeID/ and volumeID-meta/ prefixes)
+//   - objectAdmin: list + get + create + delete (restricted to the same prefixes)
 //
 // Uses CAB availabilityCondition with:
 //   - resource.name.startsWith() for GET/PUT operations
 //   - api.getAttribute('storage.googleapis.com/objectListPrefix') for LIST operations
-func (m *Minter) MintDownscopedToken(ctx context.Context, volumeID string) (*Token, error) {
-	// Step 1: Get base token (either via impersonation or directly from metadata)
-	baseToken, err := m.getBaseToken(ctx)
+func (m *Minter) MintDownscopedToken(ctx context.Context, bucket, volumeID string, opts MintOptions) (*Token, error) {
+	role := opts.Role
+	if role == "" {
+		role = RoleObjectAdmin
+	}
+
+	scope, err := role.scope()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Lifetime < 0 || opts.Lifetime > maxTokenLifetime {
+		return nil, fmt.Errorf("token lifetime %s out of range, at most %s", opts.Lifetime, maxTokenLifetime)
+	}
+
+	// Step 1: Get base token (either via impersonation or the minter's own), cached across volumes
+	baseToken, err := m.getBaseToken(ctx, scope, opts.Lifetime)
 	if err != nil {
 		return nil, fmt.Errorf("get base token: %w", err)
 	}
 
 	// Step 2: Create credential access boundary with minimal permissions
-	// Using objectAdmin with CEL condition to restrict to volume prefix
-	bucketResource := fmt.Sprintf("//storage.googleapis.com/projects/_/buckets/%s", m.bucket)
+	// Using the role with CEL condition to restrict to volume prefix
+	bucketResource := fmt.Sprintf("//storage.googleapis.com/projects/_/buckets/%s", bucket)
 
 	// Build CEL condition for volume isolation
 	// - resource.name.startsWith() for GET/PUT operations
@@ -67,14 +164,14 @@ func (m *Minter) MintDownscopedToken(ctx context.Context, volumeID string) (*Tok
 			"resource.name.startsWith('projects/_/buckets/%s/objects/%s-meta/') || "+
 			"api.getAttribute('storage.googleapis.com/objectListPrefix', '').startsWith('%s/') || "+
 			"api.getAttribute('storage.googleapis.com/objectListPrefix', '').startsWith('%s-meta/')",
-		m.bucket, volumeID, m.bucket, volumeID, volumeID, volumeID,
+		bucket, volumeID, bucket, volumeID, volumeID, volumeID,
 	)
 
 	cab := CredentialAccessBoundary{
 		AccessBoundary: AccessBoundary{
 			AccessBoundaryRules: []AccessBoundaryRule{
 				{
-					AvailablePermissions: []string{"inRole:roles/storage.objectAdmin"},
+					AvailablePermissions: []string{"inRole:" + string(role)},
 					AvailableResource:    bucketResource,
 					AvailabilityCondition: &AvailabilityCondition{
 						Title:      "Volume isolation",
@@ -89,23 +186,24 @@ func (m *Minter) MintDownscopedToken(ctx context.Context, volumeID string) (*Tok
 	return m.exchangeToken(ctx, baseToken, cab)
 }
 
-// getBaseToken gets the token to be downscoped.
+// fetchBaseToken fetches the token to be downscoped and its expiry.
 // If impersonation is configured, it generates an access token for the target SA.
-// Otherwise, it uses the VM's default service account token.
-func (m *Minter) getBaseToken(ctx context.Context) (string, error) {
+// Otherwise, it uses the token of the minter's own credentials.
+func (m *Minter) fetchBaseToken(ctx context.Context, scope string, lifetime time.Duration) (string, time.Time, error) {
 	if m.impersonateServiceAccount != "" {
-		return m.getImpersonatedToken(ctx)
+		return m.getImpersonatedToken(ctx, scope, lifetime)
 	}
-	return m.getMetadataToken(ctx)
+	return m.getCallerToken()
 }
 
 // getImpersonatedToken generates an access token by impersonating another service account.
 // This requires the caller to have the iam.serviceAccountTokenCreator role on the target SA.
-func (m *Minter) getImpersonatedToken(ctx context.Context) (string, error) {
+// Lifetimes over an hour require the extended lifetime org policy for the SA.
+func (m *Minter) getImpersonatedToken(ctx context.Context, scope string, lifetime time.Duration) (string, time.Time, error) {
 	// First get our own token to authenticate the impersonation request
-	callerToken, err := m.getMetadataToken(ctx)
+	callerToken, _, err := m.getCallerToken()
 	if err != nil {
-		return "", fmt.Errorf("get caller token: %w", err)
+		return "", time.Time{}, fmt.Errorf("get caller token: %w", err)
 	}
 
 	// Generate access token for the target service account
@@ -116,32 +214,30 @@ func (m *Minter) getImpersonatedToken(ctx context.Context) (string, error) {
 	)
 
 	body := map[string]interface{}{
-		"scope": []string{
-			"https://www.googleapis.com/auth/devstorage.full_control",
-		},
-		"lifetime": "3600s", // 1 hour max
+		"scope":    []string{scope},
+		"lifetime": fmt.Sprintf("%ds", int(lifetime.Seconds())),
 	}
 	bodyJSON, err := json.Marshal(body)
 	if err != nil {
-		return "", fmt.Errorf("marshal body: %w", err)
+		return "", time.Time{}, fmt.Errorf("marshal body: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(bodyJSON)))
 	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return "", time.Time{}, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Authorization", "Bearer "+callerToken)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := m.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("request impersonation: %w", err)
+		return "", time.Time{}, fmt.Errorf("request impersonation: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("impersonation failed with %d: %s", resp.StatusCode, string(respBody))
+		return "", time.Time{}, fmt.Errorf("impersonation failed with %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	var tokenResp struct {
@@ -149,44 +245,15 @@ func (m *Minter) getImpersonatedToken(ctx context.Context) (string, error) {
 		ExpireTime  string `json:"expireTime"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return "", fmt.Errorf("decode response: %w", err)
-	}
-
-	return tokenResp.AccessToken, nil
-}
-
-// getMetadataToken retrieves an access token from the GCP metadata server.
-func (m *Minter) getMetadataToken(ctx context.Context) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		"http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token",
-		nil,
-	)
-	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return "", time.Time{}, fmt.Errorf("decode response: %w", err)
 	}
-	req.Header.Set("Metadata-Flavor", "Google")
 
-	resp, err := m.httpClient.Do(req)
+	expiresAt, err := time.Parse(time.RFC3339, tokenResp.ExpireTime)
 	if err != nil {
-		return "", fmt.Errorf("request metadata: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("metadata server returned %d: %s", resp.StatusCode, string(body))
-	}
-
-	var tokenResp struct {
-		AccessToken string `json:"access_token"`
-		ExpiresIn   int    `json:"expires_in"`
-		TokenType   string `json:"token_type"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return "", fmt.Errorf("decode response: %w", err)
+		return "", time.Time{}, fmt.Errorf("parse expire time: %w", err)
 	}
 
-	return tokenResp.AccessToken, nil
+	return tokenResp.AccessToken, expiresAt, nil
 }
 
 // exchangeToken exchanges a base token for a downscoped token via GCP STS.
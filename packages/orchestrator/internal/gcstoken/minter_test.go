@@ -0,0 +1,102 @@
+package gcstoken
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGCP records the impersonation and STS requests of the minter.
+type fakeGCP struct {
+	mu             sync.Mutex
+	impersonations []map[string]any
+	boundaries     []CredentialAccessBoundary
+}
+
+func (f *fakeGCP) roundTrip(req *http.Request) *http.Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch req.URL.Host {
+	case "iamcredentials.googleapis.com":
+		var body map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		f.impersonations = append(f.impersonations, body)
+
+		return jsonResponse(`{"accessToken":"impersonated","expireTime":"` + time.Now().Add(time.Hour).UTC().Format(time.RFC3339) + `"}`)
+	case "sts.googleapis.com":
+		_ = req.ParseForm()
+
+		var cab CredentialAccessBoundary
+		_ = json.Unmarshal([]byte(req.PostForm.Get("options")), &cab)
+		f.boundaries = append(f.boundaries, cab)
+
+		return jsonResponse(`{"access_token":"downscoped","expires_in":3600}`)
+	default:
+		return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}
+	}
+}
+
+func newFakeMinter(impersonateSA string) (*Minter, *fakeGCP) {
+	fake := &fakeGCP{}
+	m := newMinter(impersonateSA, &countingTokenSource{})
+	m.httpClient.Transport = roundTripFunc(fake.roundTrip)
+
+	return m, fake
+}
+
+func TestMintDownscopedTokenRole(t *testing.T) {
+	t.Parallel()
+
+	m, fake := newFakeMinter("minter@project.iam.gserviceaccount.com")
+
+	_, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{})
+	require.NoError(t, err)
+	_, err = m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Role: RoleObjectViewer})
+	require.NoError(t, err)
+
+	require.Len(t, fake.boundaries, 2)
+	assert.Equal(t, []string{"inRole:roles/storage.objectAdmin"}, fake.boundaries[0].AccessBoundary.AccessBoundaryRules[0].AvailablePermissions)
+	assert.Equal(t, []string{"inRole:roles/storage.objectViewer"}, fake.boundaries[1].AccessBoundary.AccessBoundaryRules[0].AvailablePermissions)
+
+	// Each role is downscoped from a base token with the matching scope
+	require.Len(t, fake.impersonations, 2)
+	assert.Equal(t, []any{"https://www.googleapis.com/auth/devstorage.read_write"}, fake.impersonations[0]["scope"])
+	assert.Equal(t, []any{"https://www.googleapis.com/auth/devstorage.read_only"}, fake.impersonations[1]["scope"])
+}
+
+func TestMintDownscopedTokenLifetime(t *testing.T) {
+	t.Parallel()
+
+	m, fake := newFakeMinter("minter@project.iam.gserviceaccount.com")
+
+	for range 2 {
+		_, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: 15 * time.Minute})
+		require.NoError(t, err)
+	}
+
+	// Tokens with a custom lifetime are impersonated for each mint
+	require.Len(t, fake.impersonations, 2)
+	assert.Equal(t, "900s", fake.impersonations[0]["lifetime"])
+}
+
+func TestMintDownscopedTokenInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newFakeMinter("")
+
+	_, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Role: "roles/storage.admin"})
+	require.Error(t, err)
+
+	_, err = m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: 24 * time.Hour})
+	require.Error(t, err)
+
+	_, err = m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: -time.Minute})
+	require.Error(t, err)
+}
@@ -0,0 +1,206 @@
+package gcstoken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// minS3TokenLifetime is the shortest session STS grants.
+const minS3TokenLifetime = 15 * time.Minute
+
+// S3Minter creates downscoped S3 credentials for volume access, by assuming a role with a session
+// policy limited to the prefixes of the volume. The role must allow the S3 actions on the bucket,
+// the session gets the intersection of both.
+type S3Minter struct {
+	roleARN     string
+	client      *sts.Client
+	credentials aws.CredentialsProvider
+}
+
+// NewS3Minter creates a minter assuming the role with the default AWS credentials of the node.
+func NewS3Minter(ctx context.Context, roleARN, region string) (*S3Minter, error) {
+	if roleARN == "" {
+		return nil, errors.New("a role to assume is required to mint S3 credentials")
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+
+	return &S3Minter{
+		roleARN:     roleARN,
+		client:      sts.NewFromConfig(cfg),
+		credentials: cfg.Credentials,
+	}, nil
+}
+
+// s3Actions returns the S3 object actions of the role.
+func (r Role) s3Actions() ([]string, error) {
+	switch r {
+	case RoleObjectViewer:
+		return []string{"s3:GetObject"}, nil
+	case RoleObjectAdmin:
+		return []string{
+			"s3:GetObject",
+			"s3:PutObject",
+			"s3:DeleteObject",
+			"s3:AbortMultipartUpload",
+			"s3:ListMultipartUploadParts",
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported role %q", r)
+	}
+}
+
+// MintDownscopedToken creates session credentials for the volume in the bucket, returned as the
+// output of an AWS credential process. The session policy allows:
+//   - the object actions of the role on the volumeID/ and volumeID-meta/ prefixes
+//   - listing the bucket under the same prefixes
+func (m *S3Minter) MintDownscopedToken(ctx context.Context, bucket, volumeID string, opts MintOptions) (*Token, error) {
+	role := opts.Role
+	if role == "" {
+		role = RoleObjectAdmin
+	}
+
+	actions, err := role.s3Actions()
+	if err != nil {
+		return nil, err
+	}
+
+	lifetime := opts.Lifetime
+	if lifetime == 0 {
+		lifetime = defaultTokenLifetime
+	}
+	if lifetime < minS3TokenLifetime || lifetime > maxTokenLifetime {
+		return nil, fmt.Errorf("token lifetime %s out of range, between %s and %s", lifetime, minS3TokenLifetime, maxTokenLifetime)
+	}
+
+	policy, err := s3SessionPolicy(bucket, volumeID, actions)
+	if err != nil {
+		return nil, fmt.Errorf("marshal session policy: %w", err)
+	}
+
+	out, err := m.client.AssumeRole(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String(m.roleARN),
+		RoleSessionName: aws.String(volumeID),
+		Policy:          aws.String(policy),
+		DurationSeconds: aws.Int32(int32(lifetime.Seconds())),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assume role: %w", err)
+	}
+
+	if out.Credentials == nil {
+		return nil, errors.New("assume role returned no credentials")
+	}
+
+	return credentialProcessToken(
+		aws.ToString(out.Credentials.AccessKeyId),
+		aws.ToString(out.Credentials.SecretAccessKey),
+		aws.ToString(out.Credentials.SessionToken),
+		aws.ToTime(out.Credentials.Expiration),
+	)
+}
+
+// Start retrieves the credentials of the node in the background, so the first mint only waits
+// for assuming the role. The AWS SDK refreshes them from then on.
+func (m *S3Minter) Start(ctx context.Context) {
+	go func() {
+		if _, err := m.credentials.Retrieve(ctx); err != nil {
+			logger.L().Warn(ctx, "failed to pre-warm AWS credentials", zap.Error(err))
+		}
+	}()
+}
+
+// Close is a no-op, the AWS SDK has no background work to stop.
+func (m *S3Minter) Close(context.Context) error {
+	return nil
+}
+
+// credentialProcessOutput is the output of an AWS credential process.
+// See: https://docs.aws.amazon.com/sdkref/latest/guide/feature-process-credentials.html
+type credentialProcessOutput struct {
+	Version         int    `json:"Version"`
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	SessionToken    string `json:"SessionToken,omitempty"`
+	Expiration      string `json:"Expiration"`
+}
+
+// credentialProcessToken returns the credentials as a token, in the output of an AWS credential process.
+func credentialProcessToken(accessKeyID, secretAccessKey, sessionToken string, expiresAt time.Time) (*Token, error) {
+	processOutput, err := json.Marshal(credentialProcessOutput{
+		Version:         1,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+		Expiration:      expiresAt.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal credentials: %w", err)
+	}
+
+	return &Token{
+		AccessToken: string(processOutput),
+		ExpiresIn:   int(time.Until(expiresAt).Seconds()),
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// s3PolicyStatement is a statement of an IAM policy.
+type s3PolicyStatement struct {
+	Effect    string                    `json:"Effect"`
+	Action    []string                  `json:"Action"`
+	Resource  []string                  `json:"Resource"`
+	Condition map[string]map[string]any `json:"Condition,omitempty"`
+}
+
+// s3SessionPolicy returns the session policy limiting the credentials to the prefixes of the volume.
+func s3SessionPolicy(bucket, volumeID string, actions []string) (string, error) {
+	bucketARN := "arn:aws:s3:::" + bucket
+
+	policy := struct {
+		Version   string              `json:"Version"`
+		Statement []s3PolicyStatement `json:"Statement"`
+	}{
+		Version: "2012-10-17",
+		Statement: []s3PolicyStatement{
+			{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: []string{bucketARN + "/" + volumeID + "/*", bucketARN + "/" + volumeID + "-meta/*"},
+			},
+			{
+				Effect:   "Allow",
+				Action:   []string{"s3:ListBucket"},
+				Resource: []string{bucketARN},
+				Condition: map[string]map[string]any{
+					"StringLike": {"s3:prefix": []string{volumeID + "/*", volumeID + "-meta/*"}},
+				},
+			},
+			{
+				Effect:   "Allow",
+				Action:   []string{"s3:GetBucketLocation"},
+				Resource: []string{bucketARN},
+			},
+		},
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
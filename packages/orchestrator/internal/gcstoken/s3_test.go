@@ -0,0 +1,47 @@
+package gcstoken
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3SessionPolicy(t *testing.T) {
+	t.Parallel()
+
+	actions, err := RoleObjectViewer.s3Actions()
+	require.NoError(t, err)
+
+	policy, err := s3SessionPolicy("bucket", "vol_test", actions)
+	require.NoError(t, err)
+
+	var parsed struct {
+		Statement []s3PolicyStatement `json:"Statement"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(policy), &parsed))
+	require.Len(t, parsed.Statement, 3)
+
+	objects := parsed.Statement[0]
+	assert.Equal(t, []string{"s3:GetObject"}, objects.Action)
+	assert.Equal(t, []string{"arn:aws:s3:::bucket/vol_test/*", "arn:aws:s3:::bucket/vol_test-meta/*"}, objects.Resource)
+
+	list := parsed.Statement[1]
+	assert.Equal(t, []string{"s3:ListBucket"}, list.Action)
+	assert.Equal(t, []any{"vol_test/*", "vol_test-meta/*"}, list.Condition["StringLike"]["s3:prefix"])
+}
+
+func TestS3MinterInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	m := &S3Minter{roleARN: "arn:aws:iam::123456789012:role/volumes"}
+
+	_, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Role: "roles/storage.admin"})
+	require.Error(t, err)
+
+	_, err = m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: 5 * time.Minute})
+	require.Error(t, err)
+}
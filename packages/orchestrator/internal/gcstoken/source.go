@@ -0,0 +1,57 @@
+package gcstoken
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenRefreshMargin is the lifetime a volume token has left when it's minted again, so the
+// requests in flight don't get to use an expired token.
+const tokenRefreshMargin = 5 * time.Minute
+
+// volumeTokenSource mints the downscoped tokens of a volume.
+type volumeTokenSource struct {
+	ctx      context.Context //nolint:containedctx // oauth2.TokenSource has no context, the tokens are minted for the sandbox
+	minter   TokenMinter
+	bucket   string
+	volumeID string
+	opts     MintOptions
+}
+
+func (s *volumeTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.minter.MintDownscopedToken(s.ctx, s.bucket, s.volumeID, s.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth2Token(token), nil
+}
+
+// NewVolumeTokenSource returns a source of the downscoped tokens of the volume, minting a new one
+// before the previous expires. The initial token, when not nil, is used until then.
+func NewVolumeTokenSource(ctx context.Context, minter TokenMinter, bucket, volumeID string, opts MintOptions, initial *Token) oauth2.TokenSource {
+	source := &volumeTokenSource{
+		ctx:      context.WithoutCancel(ctx),
+		minter:   minter,
+		bucket:   bucket,
+		volumeID: volumeID,
+		opts:     opts,
+	}
+
+	var token *oauth2.Token
+	if initial != nil {
+		token = oauth2Token(initial)
+	}
+
+	return oauth2.ReuseTokenSourceWithExpiry(token, source, tokenRefreshMargin)
+}
+
+func oauth2Token(token *Token) *oauth2.Token {
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      token.ExpiresAt,
+	}
+}
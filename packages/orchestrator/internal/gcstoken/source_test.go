@@ -0,0 +1,36 @@
+package gcstoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeTokenSource(t *testing.T) {
+	t.Parallel()
+
+	m, fake := newFakeMinter("")
+
+	initial := &Token{AccessToken: "initial", ExpiresAt: time.Now().Add(time.Hour)}
+	source := NewVolumeTokenSource(context.Background(), m, "bucket", "vol_test", MintOptions{}, initial)
+
+	// The initial token is used while it's valid
+	token, err := source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "initial", token.AccessToken)
+	assert.Empty(t, fake.boundaries)
+
+	// A token about to expire is replaced by a new downscoped one
+	expiring := &Token{AccessToken: "expiring", ExpiresAt: time.Now().Add(tokenRefreshMargin / 2)}
+	source = NewVolumeTokenSource(context.Background(), m, "bucket", "vol_test", MintOptions{}, expiring)
+
+	token, err = source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "downscoped", token.AccessToken)
+	assert.True(t, token.Expiry.After(time.Now().Add(tokenRefreshMargin)))
+	require.Len(t, fake.boundaries, 1)
+	assert.Equal(t, "Volume isolation", fake.boundaries[0].AccessBoundary.AccessBoundaryRules[0].AvailabilityCondition.Title)
+}
@@ -0,0 +1,59 @@
+package gcstoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StaticMinter hands out static access keys as the volume credentials, for S3 compatible storage
+// without STS like MinIO in local development and CI. The keys aren't downscoped, all volumes get
+// the same access to the bucket, so it must not be used in production.
+type StaticMinter struct {
+	accessKeyID     string
+	secretAccessKey string
+}
+
+// NewStaticMinter creates a minter handing out the access keys.
+func NewStaticMinter(accessKeyID, secretAccessKey string) (*StaticMinter, error) {
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, errors.New("access key ID and secret access key are required for static credentials")
+	}
+
+	return &StaticMinter{
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+	}, nil
+}
+
+// MintDownscopedToken returns the access keys, valid for the lifetime of the options so the
+// credentials of the volumes are refreshed like minted ones.
+func (m *StaticMinter) MintDownscopedToken(_ context.Context, _, _ string, opts MintOptions) (*Token, error) {
+	role := opts.Role
+	if role == "" {
+		role = RoleObjectAdmin
+	}
+
+	if _, err := role.s3Actions(); err != nil {
+		return nil, err
+	}
+
+	lifetime := opts.Lifetime
+	if lifetime == 0 {
+		lifetime = defaultTokenLifetime
+	}
+	if lifetime < 0 || lifetime > maxTokenLifetime {
+		return nil, fmt.Errorf("token lifetime %s out of range, at most %s", lifetime, maxTokenLifetime)
+	}
+
+	return credentialProcessToken(m.accessKeyID, m.secretAccessKey, "", time.Now().Add(lifetime))
+}
+
+// Start is a no-op, there are no credentials to prepare.
+func (m *StaticMinter) Start(context.Context) {}
+
+// Close is a no-op, there is no background work to stop.
+func (m *StaticMinter) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,36 @@
+package gcstoken
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticMinter(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewStaticMinter("", "secret")
+	require.Error(t, err)
+
+	m, err := NewStaticMinter("minio", "minio123")
+	require.NoError(t, err)
+
+	token, err := m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: 30 * time.Minute})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), token.ExpiresAt, time.Minute)
+
+	var output credentialProcessOutput
+	require.NoError(t, json.Unmarshal([]byte(token.AccessToken), &output))
+	assert.Equal(t, 1, output.Version)
+	assert.Equal(t, "minio", output.AccessKeyID)
+	assert.Equal(t, "minio123", output.SecretAccessKey)
+	assert.Empty(t, output.SessionToken)
+	assert.NotContains(t, token.AccessToken, "SessionToken")
+
+	_, err = m.MintDownscopedToken(context.Background(), "bucket", "vol_test", MintOptions{Lifetime: 13 * time.Hour})
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	api "github.com/moru-ai/sandbox-infra/packages/shared/pkg/http/hyperloop"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// VolumeEvents publishes the volume mount and unmount events of envd with the context of the sandbox
+// sending them.
+func (h *APIStore) VolumeEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	sbx, err := h.sandboxes.GetByHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Error when finding source sandbox")
+		h.logger.Error(ctx, "error finding sandbox for source addr", zap.String("addr", c.Request.RemoteAddr), zap.Error(err))
+
+		return
+	}
+
+	sbxID := sbx.Runtime.SandboxID
+
+	var body api.VolumeEvent
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Invalid body for volume event")
+		h.logger.Error(ctx, "error when parsing volume event request", zap.Error(err), logger.WithSandboxID(sbxID))
+
+		return
+	}
+
+	// The volume is set on the sandbox only after an attach returns, envd reports its mount before
+	if volume := sbx.Config.Volume; volume != nil && volume.GetVolumeId() != body.VolumeID {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Volume is not attached to the sandbox")
+		h.logger.Warn(ctx, "volume event for a volume not attached to the sandbox", zap.String("volume_id", body.VolumeID), logger.WithSandboxID(sbxID))
+
+		return
+	}
+
+	teamID, err := uuid.Parse(sbx.Runtime.TeamID)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusInternalServerError, "Error when parsing team ID of the sandbox")
+		h.logger.Error(ctx, "error parsing team ID", zap.String("team_id", sbx.Runtime.TeamID), zap.Error(err), logger.WithSandboxID(sbxID))
+
+		return
+	}
+
+	// The sandbox context comes from the orchestrator to avoid spoofing
+	event := events.NewVolumeEvent(string(body.Type), body.VolumeID).
+		WithSandboxContext(sbxID, sbx.Runtime.ExecutionID, teamID)
+	if body.MountPath != nil {
+		event = event.WithMountPath(*body.MountPath)
+	}
+	if body.ErrorMessage != nil {
+		event = event.WithError(*body.ErrorMessage, volumeEventErrorCode(body.Type))
+	}
+	if body.DurationMs != nil {
+		event = event.WithEventData(map[string]any{"duration_ms": *body.DurationMs})
+	}
+
+	if h.volEventsService != nil {
+		go h.volEventsService.Publish(context.WithoutCancel(ctx), teamID, event)
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// volumeEventErrorCode returns the error code of a failed volume event.
+func volumeEventErrorCode(eventType api.VolumeEventType) string {
+	if eventType == api.SandboxShutdownVolumeUnmountFailed {
+		return "unmount_failed"
+	}
+
+	return "mount_failed"
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/events"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
 	api "github.com/moru-ai/sandbox-infra/packages/shared/pkg/http/hyperloop"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
@@ -18,15 +19,19 @@ type APIStore struct {
 	logger    logger.Logger
 	sandboxes *sandbox.Map
 
+	volEventsService *events.VolumeEventsService
+
 	collectorClient http.Client
 	collectorAddr   string
 }
 
-func NewHyperloopStore(logger logger.Logger, sandboxes *sandbox.Map, sandboxCollectorAddr string) *APIStore {
+func NewHyperloopStore(logger logger.Logger, sandboxes *sandbox.Map, sandboxCollectorAddr string, volEventsService *events.VolumeEventsService) *APIStore {
 	return &APIStore{
 		logger:    logger,
 		sandboxes: sandboxes,
 
+		volEventsService: volEventsService,
+
 		collectorAddr: sandboxCollectorAddr,
 		collectorClient: http.Client{
 			Timeout: CollectorExporterTimeout,
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	middleware "github.com/oapi-codegen/gin-middleware"
 
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/events"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/hyperloopserver/handlers"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/env"
@@ -19,9 +20,9 @@ import (
 
 const maxUploadLimit = 1 << 28 // 256 MiB
 
-func NewHyperloopServer(ctx context.Context, port uint16, logger logger.Logger, sandboxes *sandbox.Map) (*http.Server, error) {
+func NewHyperloopServer(ctx context.Context, port uint16, logger logger.Logger, sandboxes *sandbox.Map, volEventsService *events.VolumeEventsService) (*http.Server, error) {
 	sandboxCollectorAddr := env.LogsCollectorAddress()
-	store := handlers.NewHyperloopStore(logger, sandboxes, sandboxCollectorAddr)
+	store := handlers.NewHyperloopStore(logger, sandboxes, sandboxCollectorAddr, volEventsService)
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("error getting swagger spec: %w", err)
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	clickhousemetrics "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/metrics"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger/sandbox"
@@ -45,12 +46,19 @@ type (
 	GetSandboxMetricsFunc func(ctx context.Context) (*sandbox.Metrics, error)
 )
 
+// SampleSink receives the resource samples of the sandboxes, writing them into ClickHouse without the collector.
+type SampleSink interface {
+	Publish(ctx context.Context, sample clickhousemetrics.SandboxSample) error
+}
+
 type SandboxObserver struct {
 	meterExporter  sdkmetric.Exporter
 	registration   metric.Registration
 	exportInterval time.Duration
 
 	sandboxes *sandbox.Map
+	// Samples are published to the sink instead of the OpenTelemetry gauges when set
+	sink SampleSink
 
 	meter       metric.Meter
 	cpuTotal    metric.Int64ObservableGauge
@@ -59,9 +67,15 @@ type SandboxObserver struct {
 	memoryUsed  metric.Int64ObservableGauge
 	diskTotal   metric.Int64ObservableGauge
 	diskUsed    metric.Int64ObservableGauge
+
+	volumeUsed     metric.Int64ObservableGauge
+	volumeFiles    metric.Int64ObservableGauge
+	volumeRead     metric.Float64ObservableGauge
+	volumeWrite    metric.Float64ObservableGauge
+	volumeLastSync metric.Int64ObservableGauge
 }
 
-func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit, serviceVersion, serviceInstanceID string, sandboxes *sandbox.Map) (*SandboxObserver, error) {
+func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit, serviceVersion, serviceInstanceID string, sandboxes *sandbox.Map, sink SampleSink) (*SandboxObserver, error) {
 	deltaTemporality := otlpmetricgrpc.WithTemporalitySelector(func(kind sdkmetric.InstrumentKind) metricdata.Temporality {
 		// Use delta temporality for gauges and cumulative for all other instrument kinds.
 		// This is used to prevent reporting sandbox metrics indefinitely.
@@ -118,10 +132,36 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		return nil, fmt.Errorf("failed to create disk used gauge: %w", err)
 	}
 
+	volumeUsed, err := telemetry.GetGaugeInt(meter, telemetry.VolumeUsedGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume used gauge: %w", err)
+	}
+
+	volumeFiles, err := telemetry.GetGaugeInt(meter, telemetry.VolumeFilesGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume files gauge: %w", err)
+	}
+
+	volumeRead, err := telemetry.GetGaugeFloat(meter, telemetry.VolumeReadThroughputGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume read throughput gauge: %w", err)
+	}
+
+	volumeWrite, err := telemetry.GetGaugeFloat(meter, telemetry.VolumeWriteThroughputGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume write throughput gauge: %w", err)
+	}
+
+	volumeLastSync, err := telemetry.GetGaugeInt(meter, telemetry.VolumeLastSyncGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume last sync gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
 		exportInterval: sandboxMetricExportPeriod,
 		meterExporter:  externalMeterExporter,
 		sandboxes:      sandboxes,
+		sink:           sink,
 		meter:          meter,
 		cpuTotal:       cpuTotal,
 		cpuUsed:        cpuUsed,
@@ -129,6 +169,11 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		memoryUsed:     memoryUsed,
 		diskTotal:      diskTotal,
 		diskUsed:       diskUsed,
+		volumeUsed:     volumeUsed,
+		volumeFiles:    volumeFiles,
+		volumeRead:     volumeRead,
+		volumeWrite:    volumeWrite,
+		volumeLastSync: volumeLastSync,
 	}
 
 	registration, err := so.startObserving()
@@ -207,9 +252,6 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						}
 					}
 
-					o.ObserveInt64(so.cpuTotal, sbxMetrics.CPUCount, attributes)
-					o.ObserveFloat64(so.cpuUsed, sbxMetrics.CPUUsedPercent, attributes)
-
 					var memoryTotal int64
 					var memoryUsed int64
 
@@ -226,16 +268,73 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						memoryUsed = sbxMetrics.MemUsedMiB << shiftFromMiBToBytes
 					}
 
-					o.ObserveInt64(so.memoryTotal, memoryTotal, attributes)
-					o.ObserveInt64(so.memoryUsed, memoryUsed, attributes)
-
-					ok, err = utils.IsGTEVersion(sbx.Config.Envd.Version, minEnvdVersionForDiskMetrics)
+					hasDisk, err := utils.IsGTEVersion(sbx.Config.Envd.Version, minEnvdVersionForDiskMetrics)
 					if err != nil {
 						logger.L().Error(ctx, "Failed to check envd version for disk metrics", zap.Error(err), logger.WithSandboxID(sbx.Runtime.SandboxID))
 					}
-					if ok {
-						o.ObserveInt64(so.diskTotal, sbxMetrics.DiskTotal, attributes)
-						o.ObserveInt64(so.diskUsed, sbxMetrics.DiskUsed, attributes)
+
+					// The volume is taken from the sandbox config, envd only reports its usage
+					var volumeSample *clickhousemetrics.VolumeSample
+					if volume := sbx.Config.Volume; volume != nil {
+						volumeMetrics := sbxMetrics.Volume
+						if sbxMetrics.Volumes != nil {
+							volumeMetrics = sbxMetrics.Volumes[volume.GetVolumeId()]
+						}
+
+						if volumeMetrics != nil {
+							volumeSample = &clickhousemetrics.VolumeSample{
+								VolumeID: volume.GetVolumeId(),
+								Used:     volumeMetrics.Used,
+								Files:    volumeMetrics.Files,
+								ReadBps:  volumeMetrics.ReadBps,
+								WriteBps: volumeMetrics.WriteBps,
+							}
+							if volumeMetrics.LastSync > 0 {
+								volumeSample.LastSync = time.Unix(volumeMetrics.LastSync, 0)
+							}
+						}
+					}
+
+					if so.sink != nil {
+						err = so.sink.Publish(ctx, clickhousemetrics.SandboxSample{
+							Timestamp:      time.Now(),
+							SandboxID:      sbx.Runtime.SandboxID,
+							TeamID:         sbx.Runtime.TeamID,
+							CPUCount:       sbxMetrics.CPUCount,
+							CPUUsedPercent: sbxMetrics.CPUUsedPercent,
+							MemTotal:       memoryTotal,
+							MemUsed:        memoryUsed,
+							HasDisk:        hasDisk,
+							DiskTotal:      sbxMetrics.DiskTotal,
+							DiskUsed:       sbxMetrics.DiskUsed,
+							Volume:         volumeSample,
+						})
+						if err != nil {
+							logger.L().Warn(ctx, "Failed to publish sandbox metrics", zap.Error(err), logger.WithSandboxID(sbx.Runtime.SandboxID))
+						}
+					} else {
+						o.ObserveInt64(so.cpuTotal, sbxMetrics.CPUCount, attributes)
+						o.ObserveFloat64(so.cpuUsed, sbxMetrics.CPUUsedPercent, attributes)
+						o.ObserveInt64(so.memoryTotal, memoryTotal, attributes)
+						o.ObserveInt64(so.memoryUsed, memoryUsed, attributes)
+						if hasDisk {
+							o.ObserveInt64(so.diskTotal, sbxMetrics.DiskTotal, attributes)
+							o.ObserveInt64(so.diskUsed, sbxMetrics.DiskUsed, attributes)
+						}
+						if volumeSample != nil {
+							volumeAttributes := metric.WithAttributes(
+								attribute.String("volume_id", volumeSample.VolumeID),
+								attribute.String("sandbox_id", sbx.Runtime.SandboxID),
+								attribute.String("team_id", sbx.Runtime.TeamID),
+							)
+							o.ObserveInt64(so.volumeUsed, volumeSample.Used, volumeAttributes)
+							o.ObserveInt64(so.volumeFiles, volumeSample.Files, volumeAttributes)
+							o.ObserveFloat64(so.volumeRead, volumeSample.ReadBps, volumeAttributes)
+							o.ObserveFloat64(so.volumeWrite, volumeSample.WriteBps, volumeAttributes)
+							if !volumeSample.LastSync.IsZero() {
+								o.ObserveInt64(so.volumeLastSync, volumeSample.LastSync.Unix(), volumeAttributes)
+							}
+						}
 					}
 
 					// Log warnings if memory or CPU usage exceeds thresholds
@@ -266,7 +365,8 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 			}
 
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed)
+		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed,
+		so.volumeUsed, so.volumeFiles, so.volumeRead, so.volumeWrite, so.volumeLastSync)
 	if err != nil {
 		return nil, err
 	}
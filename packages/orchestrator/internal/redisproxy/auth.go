@@ -0,0 +1,60 @@
+package redisproxy
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"slices"
+)
+
+const (
+	noAuthReply    = "-NOAUTH Authentication required.\r\n"
+	wrongPassReply = "-WRONGPASS invalid username-password pair or user is disabled.\r\n"
+	authArgsReply  = "-ERR wrong number of arguments for 'auth' command\r\n"
+)
+
+// authenticate checks the client authenticated with the client password before its commands are
+// forwarded. AUTH is answered by the proxy, HELLO is forwarded without its credentials since the
+// upstream connections are authenticated with the ones of the volume. It returns the reply of the
+// proxy when the command isn't forwarded, or the command to forward.
+func (s *session) authenticate(cmd *command) (string, *command) {
+	switch cmd.name() {
+	case "AUTH":
+		// AUTH <password> or AUTH <username> <password>, the username isn't checked
+		if len(cmd.args) != 2 && len(cmd.args) != 3 {
+			return authArgsReply, nil
+		}
+		if !s.checkPassword(cmd.args[len(cmd.args)-1]) {
+			return wrongPassReply, nil
+		}
+
+		return "+OK\r\n", nil
+	case "HELLO":
+		// HELLO <protover> [AUTH <username> <password>] [SETNAME <clientname>]
+		i := slices.IndexFunc(cmd.args, func(arg []byte) bool {
+			return bytes.EqualFold(arg, []byte("AUTH"))
+		})
+		if i < 2 || i+2 >= len(cmd.args) {
+			break
+		}
+		if !s.checkPassword(cmd.args[i+2]) {
+			return wrongPassReply, nil
+		}
+
+		return "", newCommand(slices.Delete(slices.Clone(cmd.args), i, i+3))
+	}
+
+	if !s.authenticated {
+		return noAuthReply, nil
+	}
+
+	return "", cmd
+}
+
+// checkPassword compares the password to the client password in constant time, the client is
+// authenticated when it matches.
+func (s *session) checkPassword(password []byte) bool {
+	ok := subtle.ConstantTimeCompare(password, []byte(s.proxy.config.ClientPassword)) == 1
+	s.authenticated = s.authenticated || ok
+
+	return ok
+}
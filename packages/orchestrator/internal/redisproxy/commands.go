@@ -0,0 +1,126 @@
+package redisproxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+var (
+	meter           = otel.GetMeterProvider().Meter("orchestrator.internal.redisproxy")
+	rejectedCounter = utils.Must(telemetry.GetCounter(meter, telemetry.RedisProxyRejectedCounterName))
+)
+
+// allowedCommands are the commands the JuiceFS metadata engine uses, on the keys of the volume, and
+// the commands of the clients setting up their connection. The ACL of the volume user allows all
+// the commands on its keys, the administration and the scripting ones are only rejected here.
+var allowedCommands = commandSet(
+	// Connection
+	"PING", "ECHO", "SELECT", "HELLO", "CLIENT", "INFO", "TIME", "DBSIZE",
+	// Transactions
+	"MULTI", "EXEC", "DISCARD", "WATCH", "UNWATCH",
+	// Keys
+	"DEL", "UNLINK", "EXISTS", "TYPE", "TTL", "PTTL", "EXPIRE", "PEXPIRE", "EXPIREAT", "PEXPIREAT",
+	"PERSIST", "SCAN", "RENAME", "RENAMENX",
+	// Strings
+	"GET", "SET", "SETNX", "SETEX", "PSETEX", "GETSET", "GETDEL", "GETEX", "MGET", "MSET", "MSETNX",
+	"INCR", "INCRBY", "DECR", "DECRBY", "INCRBYFLOAT", "APPEND", "STRLEN", "GETRANGE", "SETRANGE",
+	// Hashes
+	"HGET", "HSET", "HSETNX", "HMSET", "HMGET", "HDEL", "HEXISTS", "HGETALL", "HKEYS", "HVALS", "HLEN",
+	"HINCRBY", "HINCRBYFLOAT", "HSCAN", "HSTRLEN",
+	// Lists
+	"LPUSH", "RPUSH", "LPUSHX", "RPUSHX", "LPOP", "RPOP", "LRANGE", "LLEN", "LREM", "LTRIM", "LINDEX",
+	"LSET", "LINSERT", "RPOPLPUSH", "LMOVE",
+	// Sets
+	"SADD", "SREM", "SMEMBERS", "SISMEMBER", "SMISMEMBER", "SCARD", "SSCAN", "SPOP", "SRANDMEMBER",
+	// Sorted sets
+	"ZADD", "ZREM", "ZRANGE", "ZRANGEBYSCORE", "ZREVRANGE", "ZREVRANGEBYSCORE", "ZRANGEBYLEX", "ZSCORE",
+	"ZMSCORE", "ZCARD", "ZCOUNT", "ZINCRBY", "ZRANK", "ZREVRANK", "ZREMRANGEBYSCORE", "ZREMRANGEBYRANK",
+	"ZSCAN", "ZPOPMIN", "ZPOPMAX",
+)
+
+// allowedClientCommands are the CLIENT subcommands naming and identifying the connection.
+var allowedClientCommands = commandSet("SETNAME", "SETINFO", "GETNAME", "ID", "INFO")
+
+// reportedCommands are the rejected commands reported by name, the others are reported as "other"
+// so the sandboxes can't make up the attributes of the metric.
+var reportedCommands = commandSet(
+	"FLUSHALL", "FLUSHDB", "CONFIG", "EVAL", "EVALSHA", "EVAL_RO", "EVALSHA_RO", "SCRIPT", "FUNCTION",
+	"FCALL", "FCALL_RO", "MONITOR", "SYNC", "PSYNC", "DEBUG", "SHUTDOWN", "SAVE", "BGSAVE",
+	"BGREWRITEAOF", "MODULE", "ACL", "AUTH", "KEYS", "SUBSCRIBE", "PSUBSCRIBE", "SSUBSCRIBE", "PUBLISH",
+	"MIGRATE", "RESTORE", "DUMP", "OBJECT", "SWAPDB", "MOVE", "COPY", "REPLICAOF", "SLAVEOF", "CLUSTER",
+	"RESET", "CLIENT", "HELLO", "SELECT",
+)
+
+func commandSet(names ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+
+	return set
+}
+
+// allowed checks the command is one the volumes need. The volumes share the Redis of the node and are
+// only separated by their database, which the ACL of the volume user can't restrict, so only the
+// database of the volume can be selected.
+func allowed(cmd *command, db int) bool {
+	name := cmd.name()
+	if _, ok := allowedCommands[name]; !ok {
+		return false
+	}
+
+	switch name {
+	case "SELECT":
+		return len(cmd.args) == 2 && string(cmd.args[1]) == strconv.Itoa(db)
+	case "CLIENT":
+		_, ok := allowedClientCommands[cmd.arg(1)]
+
+		return ok
+	case "HELLO":
+		// The proxy authenticates the connections, the clients can't switch to another user
+		return !slices.ContainsFunc(cmd.args[1:], func(arg []byte) bool {
+			return bytes.EqualFold(arg, []byte("AUTH"))
+		})
+	default:
+		return true
+	}
+}
+
+// notAllowedReply is the error reply to a rejected command, the names made up by the clients
+// aren't sent back.
+func notAllowedReply(name string) string {
+	if reported := reportedName(name); reported != "other" {
+		return fmt.Sprintf("-NOPERM the '%s' command isn't allowed for volumes\r\n", reported)
+	}
+
+	return "-NOPERM this command isn't allowed for volumes\r\n"
+}
+
+// reportedName returns the name the rejected command is reported with.
+func reportedName(name string) string {
+	if _, ok := reportedCommands[name]; ok {
+		return name
+	}
+
+	return "other"
+}
+
+// recordRejected records a command rejected for the volume.
+func (p *Proxy) recordRejected(ctx context.Context, name string) {
+	rejectedCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("volume_id", p.config.VolumeID),
+		attribute.String("sandbox_id", p.config.SandboxID),
+		attribute.String("team_id", p.config.TeamID),
+		attribute.String("command", reportedName(name)),
+	))
+}
@@ -0,0 +1,48 @@
+package redisproxy
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		command string
+		allowed bool
+	}{
+		{command: "HGET i1 name", allowed: true},
+		{command: "zrangebyscore delfiles 0 100", allowed: true},
+		{command: "SELECT 1", allowed: true},
+		{command: "SELECT 3"},
+		{command: "SELECT 01"},
+		{command: "SELECT"},
+		{command: "HELLO 3", allowed: true},
+		{command: "HELLO 3 SETNAME juicefs", allowed: true},
+		{command: "HELLO 3 auth default secret"},
+		{command: "CLIENT SETINFO LIB-NAME go-redis", allowed: true},
+		{command: "CLIENT KILL ID 1"},
+		{command: "CLIENT REPLY OFF"},
+		{command: "FLUSHALL"},
+		{command: "FLUSHDB"},
+		{command: "CONFIG GET maxmemory-policy"},
+		{command: "EVALSHA abc 0"},
+		{command: "SCRIPT LOAD x"},
+		{command: "FUNCTION LIST"},
+		{command: "MONITOR"},
+		{command: "SUBSCRIBE events"},
+		{command: "KEYS *"},
+		{command: "AUTH default secret"},
+	}
+
+	for _, tt := range tests {
+		cmd, err := readCommand(bufio.NewReader(strings.NewReader(tt.command + "\r\n")))
+		require.NoError(t, err)
+		assert.Equal(t, tt.allowed, allowed(cmd, 1), tt.command)
+	}
+}
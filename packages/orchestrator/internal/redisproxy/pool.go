@@ -0,0 +1,216 @@
+package redisproxy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// healthCheckIdleTime is how long a connection can stay idle before it's checked with a PING
+	// when it's taken from the pool again.
+	healthCheckIdleTime = 30 * time.Second
+	healthCheckTimeout  = 2 * time.Second
+	// maxIdleTime is how long a connection stays in the pool unused before it's closed, so idle
+	// sandboxes don't hold on to the connections of the upstream.
+	maxIdleTime = 5 * time.Minute
+)
+
+// upstreamConn is an authenticated connection to the upstream Redis, with the session state the
+// clients using it expect.
+type upstreamConn struct {
+	net.Conn
+
+	r *bufio.Reader
+	w *bufio.Writer
+
+	// db is the selected database and protocol the RESP version of the connection.
+	db       int
+	protocol int
+
+	idleSince time.Time
+}
+
+func newUpstreamConn(conn net.Conn) *upstreamConn {
+	return &upstreamConn{
+		Conn:     conn,
+		r:        bufio.NewReader(conn),
+		w:        bufio.NewWriter(conn),
+		protocol: 2,
+	}
+}
+
+// do sends a command of the proxy and checks its reply isn't an error.
+func (c *upstreamConn) do(args ...string) error {
+	if err := c.send(args...); err != nil {
+		return err
+	}
+
+	typ, err := copyReply(io.Discard, c.r)
+	if err != nil {
+		return err
+	}
+	if typ == '-' || typ == '!' {
+		return fmt.Errorf("%s failed", args[0])
+	}
+
+	return nil
+}
+
+// query sends a command of the proxy and returns its reply.
+func (c *upstreamConn) query(args ...string) (any, error) {
+	if err := c.send(args...); err != nil {
+		return nil, err
+	}
+
+	return readValue(c.r)
+}
+
+func (c *upstreamConn) send(args ...string) error {
+	fmt.Fprintf(c.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	return c.w.Flush()
+}
+
+// healthy checks the connection can be reused, the connections idle for a while are pinged.
+func (c *upstreamConn) healthy(now time.Time) bool {
+	idle := now.Sub(c.idleSince)
+	if idle > maxIdleTime {
+		return false
+	}
+	if idle < healthCheckIdleTime {
+		return true
+	}
+
+	if err := c.SetDeadline(now.Add(healthCheckTimeout)); err != nil {
+		return false
+	}
+	defer c.SetDeadline(time.Time{})
+
+	return c.do("PING") == nil
+}
+
+// pool holds the upstream connections shared by the client connections of the proxy.
+type pool struct {
+	dial func(ctx context.Context) (net.Conn, error)
+
+	// slots bounds the connections open to the upstream, idle or in use.
+	slots chan struct{}
+
+	mu     sync.Mutex
+	idle   []*upstreamConn
+	closed bool
+}
+
+func newPool(size int, dial func(ctx context.Context) (net.Conn, error)) *pool {
+	return &pool{
+		dial:  dial,
+		slots: make(chan struct{}, size),
+	}
+}
+
+// get returns an idle healthy connection or a new one, it waits for one to be put back when all
+// the connections are in use.
+func (p *pool) get(ctx context.Context) (*upstreamConn, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for {
+		p.mu.Lock()
+		if len(p.idle) == 0 {
+			p.mu.Unlock()
+
+			break
+		}
+
+		// The most recently used connections are the least likely to need a health check
+		conn := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		p.mu.Unlock()
+
+		if conn.healthy(time.Now()) {
+			return conn, nil
+		}
+
+		conn.Close()
+	}
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		<-p.slots
+
+		return nil, err
+	}
+
+	return newUpstreamConn(conn), nil
+}
+
+// put gives the connection back, it's closed when it isn't reusable.
+func (p *pool) put(conn *upstreamConn, reusable bool) {
+	defer func() { <-p.slots }()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !reusable || p.closed {
+		conn.Close()
+
+		return
+	}
+
+	conn.idleSince = time.Now()
+	p.idle = append(p.idle, conn)
+}
+
+// closeIdle closes the connections idle for longer than maxIdleTime.
+func (p *pool) closeIdle(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The idle connections are ordered by the time they were put back
+	n := 0
+	for n < len(p.idle) && now.Sub(p.idle[n].idleSince) > maxIdleTime {
+		p.idle[n].Close()
+		n++
+	}
+	p.idle = p.idle[n:]
+}
+
+// close closes the idle connections, the ones in use are closed when they're put back.
+func (p *pool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, conn := range p.idle {
+		conn.Close()
+	}
+	p.idle = nil
+}
+
+// run closes the connections idle for too long until the context is done, then all of them.
+func (p *pool) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.close()
+
+			return
+		case now := <-ticker.C:
+			p.closeIdle(now)
+		}
+	}
+}
@@ -1,4 +1,5 @@
-// Package redisproxy provides a TCP proxy for Redis that injects per-volume ACL credentials.
+// Package redisproxy provides a proxy for Redis that injects per-volume ACL credentials and only
+// forwards the commands the volumes need.
 package redisproxy
 
 import (
@@ -7,10 +8,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -25,15 +27,26 @@ const (
 	Port = 5018
 )
 
+// errClusterUpstream rejects the Redis Cluster upstreams, the databases of the volumes can't be
+// selected on a cluster and its keyspace would be shared by the volumes.
+var errClusterUpstream = errors.New("redis cluster upstreams aren't supported for volumes, their databases can't be separated")
+
 // Config holds the configuration for a Redis proxy instance.
 type Config struct {
 	// ListenAddr is the address to listen on (e.g., "10.12.0.1:5018").
 	ListenAddr string
 
 	// UpstreamURL is the Redis URL (e.g., "redis://10.0.0.1:6379" or "rediss://10.0.0.1:6379?insecure-skip-verify=true").
-	// Supports redis:// (plain) and rediss:// (TLS) schemes.
+	// Supports redis:// (plain) and rediss:// (TLS) schemes, with +sentinel to find the master with
+	// Redis Sentinel (e.g., "rediss+sentinel://10.0.0.1:26379,10.0.0.2:26379/mymaster").
 	UpstreamURL string
 
+	// VolumeID, SandboxID and TeamID are the volume of the proxy, the sandbox it's mounted in and
+	// its team, the rejected commands are accounted to them.
+	VolumeID  string
+	SandboxID string
+	TeamID    string
+
 	// RedisDB is the database number for key prefix isolation.
 	// Used to construct username: db_{RedisDB}
 	RedisDB int
@@ -41,41 +54,82 @@ type Config struct {
 	// Password is the per-volume password for Redis ACL authentication.
 	Password string
 
+	// ClientPassword is the password the clients authenticate to the proxy with, with AUTH or
+	// HELLO AUTH, before their commands are forwarded. The clients aren't authenticated when empty.
+	ClientPassword string
+
 	// TLSCABase64 is the base64-encoded TLS CA certificate for verifying the Redis server.
 	// If set, proper TLS verification is used instead of insecure-skip-verify.
 	TLSCABase64 string
+
+	// PoolSize is the number of upstream connections the client connections share, the commands
+	// are relayed over any idle one. When 0, each client connection gets its own upstream connection.
+	PoolSize int
 }
 
 // upstreamConfig holds parsed upstream connection configuration.
 type upstreamConfig struct {
+	// host is the address of the standalone Redis, the addresses of the sentinels are listed in it
+	// for the logs.
 	host      string
 	tlsConfig *tls.Config
+
+	// sentinels are the addresses of the sentinels monitoring the master named masterName,
+	// authenticated with sentinelPassword when it's set.
+	sentinels        []string
+	masterName       string
+	sentinelPassword string
 }
 
 // parseUpstreamURL parses the upstream URL and returns connection configuration.
 // If tlsCABase64 is provided, it will be used to verify the server certificate.
+//
+// The redis+sentinel:// and rediss+sentinel:// schemes list the sentinels as hosts and name the
+// master in the path, like redis+sentinel://:password@10.0.0.1:26379,10.0.0.2:26379/mymaster.
+//
+// Redis Cluster upstreams are rejected: a cluster only has the database 0 and the volumes are
+// separated by their database.
 func parseUpstreamURL(rawURL string, tlsCABase64 string) (*upstreamConfig, error) {
-	u, err := url.Parse(rawURL)
+	firstHostURL, hosts := splitHosts(rawURL)
+
+	u, err := url.Parse(firstHostURL)
 	if err != nil {
 		return nil, fmt.Errorf("parse URL: %w", err)
 	}
 
-	host := u.Host
-	if host == "" {
+	if slices.Contains(hosts, "") || u.Host == "" {
 		return nil, fmt.Errorf("empty host in URL: %s", rawURL)
 	}
 
-	// Add default port if not specified
-	if !strings.Contains(host, ":") {
-		host = host + ":6379"
-	}
+	cfg := &upstreamConfig{}
 
-	cfg := &upstreamConfig{
-		host: host,
+	scheme, topology, _ := strings.Cut(u.Scheme, "+")
+	switch topology {
+	case "":
+		if len(hosts) > 1 {
+			return nil, fmt.Errorf("multiple hosts in URL: %s (expected a sentinel scheme)", rawURL)
+		}
+
+		cfg.host = withDefaultPort(u.Host, "6379")
+	case "sentinel":
+		cfg.masterName = strings.Trim(u.Path, "/")
+		if cfg.masterName == "" {
+			return nil, fmt.Errorf("missing master name in URL: %s", rawURL)
+		}
+
+		cfg.sentinelPassword, _ = u.User.Password()
+		for _, sentinel := range hosts {
+			cfg.sentinels = append(cfg.sentinels, withDefaultPort(sentinel, "26379"))
+		}
+		cfg.host = strings.Join(cfg.sentinels, ",")
+	case "cluster":
+		return nil, errClusterUpstream
+	default:
+		return nil, fmt.Errorf("unsupported scheme: %s (expected redis:// or redis+sentinel://)", u.Scheme)
 	}
 
 	// Check scheme for TLS
-	switch u.Scheme {
+	switch scheme {
 	case "redis":
 		// Plain TCP, no TLS
 		cfg.tlsConfig = nil
@@ -109,6 +163,38 @@ func parseUpstreamURL(rawURL string, tlsCABase64 string) (*upstreamConfig, error
 	return cfg, nil
 }
 
+// splitHosts takes the comma-separated hosts out of the URL, the URL is returned with the first
+// one since it can only be parsed with a single host.
+func splitHosts(rawURL string) (string, []string) {
+	scheme, rest, ok := strings.Cut(rawURL, "://")
+	if !ok {
+		return rawURL, nil
+	}
+
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+
+	userinfo, hosts := "", rest[:end]
+	if at := strings.LastIndex(hosts, "@"); at >= 0 {
+		userinfo, hosts = hosts[:at+1], hosts[at+1:]
+	}
+
+	list := strings.Split(hosts, ",")
+
+	return scheme + "://" + userinfo + list[0] + rest[end:], list
+}
+
+// withDefaultPort adds the port to the host when it has none.
+func withDefaultPort(host, port string) string {
+	if !strings.Contains(host, ":") {
+		return host + ":" + port
+	}
+
+	return host
+}
+
 // Proxy is a TCP proxy that injects Redis ACL authentication.
 type Proxy struct {
 	config   Config
@@ -118,6 +204,20 @@ type Proxy struct {
 	// upstream holds parsed upstream configuration (parsed once at start)
 	upstream *upstreamConfig
 
+	// pool holds the shared upstream connections, nil without a pool size.
+	pool *pool
+
+	// sentinels finds the master of a Sentinel upstream.
+	sentinels *sentinels
+
+	// ready is closed once the proxy listens.
+	ready chan struct{}
+
+	// conns are the client connections, closed when the proxy stops.
+	connsMu sync.Mutex
+	conns   map[net.Conn]struct{}
+	wg      sync.WaitGroup
+
 	mu      sync.Mutex
 	running bool
 	ctx     context.Context
@@ -129,10 +229,18 @@ func New(cfg Config, logger logger.Logger) *Proxy {
 	return &Proxy{
 		config: cfg,
 		logger: logger,
+		ready:  make(chan struct{}),
+		conns:  make(map[net.Conn]struct{}),
 	}
 }
 
-// Start starts the proxy and blocks until the context is cancelled.
+// Ready is closed once the proxy listens for connections.
+func (p *Proxy) Ready() <-chan struct{} {
+	return p.ready
+}
+
+// Start starts the proxy and blocks until the context is cancelled and the client connections
+// are closed.
 func (p *Proxy) Start(ctx context.Context) error {
 	p.mu.Lock()
 	if p.running {
@@ -156,25 +264,47 @@ func (p *Proxy) Start(ctx context.Context) error {
 		return fmt.Errorf("listen on %s: %w", p.config.ListenAddr, err)
 	}
 
+	if len(p.upstream.sentinels) > 0 {
+		p.sentinels = newSentinels(p.upstream.sentinels, p.upstream.masterName, p.upstream.sentinelPassword, p.dial)
+	}
+
+	if p.config.PoolSize > 0 {
+		p.pool = newPool(p.config.PoolSize, p.dialUpstream)
+		go p.pool.run(p.ctx)
+	}
+
 	p.logger.Info(ctx, "Redis proxy started",
 		zap.String("addr", p.config.ListenAddr),
 		zap.String("upstream", p.upstream.host),
 		zap.Bool("tls", p.upstream.tlsConfig != nil),
+		zap.Bool("sentinel", p.sentinels != nil),
 		zap.Int("redisDb", p.config.RedisDB),
+		zap.Int("poolSize", p.config.PoolSize),
 	)
 
 	// Start shutdown goroutine
 	go func() {
 		<-p.ctx.Done()
 		p.listener.Close()
+
+		// The clients of a stopped sandbox don't close their connections
+		p.connsMu.Lock()
+		for conn := range p.conns {
+			conn.Close()
+		}
+		p.connsMu.Unlock()
 	}()
 
+	close(p.ready)
+
 	// Accept loop
 	for {
 		conn, err := p.listener.Accept()
 		if err != nil {
 			select {
 			case <-p.ctx.Done():
+				p.wg.Wait()
+
 				return nil
 			default:
 				p.logger.Error(ctx, "Redis proxy accept error", zap.Error(err))
@@ -182,10 +312,43 @@ func (p *Proxy) Start(ctx context.Context) error {
 			}
 		}
 
-		go p.handleConnection(conn)
+		if !p.track(conn) {
+			conn.Close()
+
+			continue
+		}
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			defer p.untrack(conn)
+
+			p.handleConnection(conn)
+		}()
 	}
 }
 
+// track adds the client connection to the ones closed when the proxy stops, it's refused when the
+// proxy is already stopping.
+func (p *Proxy) track(conn net.Conn) bool {
+	p.connsMu.Lock()
+	defer p.connsMu.Unlock()
+
+	if p.ctx.Err() != nil {
+		return false
+	}
+	p.conns[conn] = struct{}{}
+
+	return true
+}
+
+func (p *Proxy) untrack(conn net.Conn) {
+	p.connsMu.Lock()
+	defer p.connsMu.Unlock()
+
+	delete(p.conns, conn)
+}
+
 // Close stops the proxy.
 func (p *Proxy) Close() error {
 	p.mu.Lock()
@@ -200,50 +363,60 @@ func (p *Proxy) Close() error {
 	return nil
 }
 
-// handleConnection handles a single client connection.
-func (p *Proxy) handleConnection(clientConn net.Conn) {
-	defer clientConn.Close()
-	ctx := p.ctx
-
-	// Connect to upstream Redis
-	var upstreamConn net.Conn
-	var err error
-
-	if p.upstream.tlsConfig != nil {
-		dialer := &net.Dialer{Timeout: 10 * time.Second}
-		upstreamConn, err = tls.DialWithDialer(dialer, "tcp", p.upstream.host, p.upstream.tlsConfig)
-	} else {
-		upstreamConn, err = net.DialTimeout("tcp", p.upstream.host, 10*time.Second)
+// dialUpstream connects to the upstream Redis, authenticated with the credentials of the volume.
+// The master of a Sentinel upstream is found again for each connection, so they follow a failover.
+func (p *Proxy) dialUpstream(ctx context.Context) (net.Conn, error) {
+	addr := p.upstream.host
+	if p.sentinels != nil {
+		var err error
+		if addr, err = p.sentinels.master(ctx); err != nil {
+			return nil, err
+		}
 	}
+
+	upstreamConn, err := p.dial(ctx, addr)
 	if err != nil {
-		p.logger.Error(ctx, "Redis proxy: failed to connect to upstream", zap.Error(err))
-		return
+		return nil, fmt.Errorf("connect to upstream: %w", err)
 	}
-	defer upstreamConn.Close()
 
 	// Authenticate with upstream using per-volume ACL credentials (if password is set)
 	if p.config.Password != "" {
 		if err := p.authenticate(upstreamConn); err != nil {
-			p.logger.Error(ctx, "Redis proxy: authentication failed", zap.Error(err))
-			return
+			upstreamConn.Close()
+
+			return nil, fmt.Errorf("authentication failed: %w", err)
 		}
 	}
 
-	// Bidirectional copy
-	done := make(chan struct{}, 2)
+	return upstreamConn, nil
+}
 
-	go func() {
-		io.Copy(upstreamConn, clientConn)
-		done <- struct{}{}
-	}()
+// dial connects to the address, with TLS for the rediss schemes.
+func (p *Proxy) dial(ctx context.Context, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
 
-	go func() {
-		io.Copy(clientConn, upstreamConn)
-		done <- struct{}{}
-	}()
+	if p.upstream.tlsConfig != nil {
+		return (&tls.Dialer{NetDialer: dialer, Config: p.upstream.tlsConfig}).DialContext(ctx, "tcp", addr)
+	}
 
-	// Wait for either direction to finish
-	<-done
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// handleConnection handles a single client connection.
+func (p *Proxy) handleConnection(clientConn net.Conn) {
+	defer clientConn.Close()
+	ctx := p.ctx
+
+	pool := p.pool
+	if pool == nil {
+		// The client connection gets its own upstream connection
+		pool = newPool(1, p.dialUpstream)
+		defer pool.close()
+	}
+
+	if err := newSession(p, pool, clientConn).run(ctx); err != nil {
+		p.logger.Warn(ctx, "Redis proxy: session failed", zap.Error(err))
+	}
 }
 
 // authenticate sends the AUTH command to upstream Redis.
@@ -299,7 +472,7 @@ func StartInNamespace(ctx context.Context, cfg Config, log logger.Logger) (*Prox
 	select {
 	case err := <-errCh:
 		return nil, fmt.Errorf("proxy startup failed: %w", err)
-	case <-time.After(100 * time.Millisecond):
+	case <-proxy.Ready():
 		// Proxy started successfully (blocking in accept loop)
 		return proxy, nil
 	}
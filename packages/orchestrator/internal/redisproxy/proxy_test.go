@@ -1,10 +1,17 @@
 package redisproxy
 
 import (
+	"bufio"
+	"context"
+	"io"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
 )
 
 func TestParseUpstreamURL(t *testing.T) {
@@ -14,6 +21,8 @@ func TestParseUpstreamURL(t *testing.T) {
 		wantHost       string
 		wantTLS        bool
 		wantSkipVerify bool
+		wantSentinels  []string
+		wantMaster     string
 		wantErr        bool
 	}{
 		{
@@ -53,6 +62,41 @@ func TestParseUpstreamURL(t *testing.T) {
 			wantHost: "redis.example.com:6380",
 			wantTLS:  false,
 		},
+		{
+			name:          "sentinel",
+			rawURL:        "redis+sentinel://:secret@10.0.0.1:26380,10.0.0.2/mymaster",
+			wantHost:      "10.0.0.1:26380,10.0.0.2:26379",
+			wantSentinels: []string{"10.0.0.1:26380", "10.0.0.2:26379"},
+			wantMaster:    "mymaster",
+		},
+		{
+			name:          "sentinel with TLS",
+			rawURL:        "rediss+sentinel://10.0.0.1:26379/mymaster",
+			wantHost:      "10.0.0.1:26379",
+			wantTLS:       true,
+			wantSentinels: []string{"10.0.0.1:26379"},
+			wantMaster:    "mymaster",
+		},
+		{
+			name:    "sentinel without master name",
+			rawURL:  "redis+sentinel://10.0.0.1:26379",
+			wantErr: true,
+		},
+		{
+			name:    "cluster",
+			rawURL:  "rediss+cluster://10.0.0.1:7000,10.0.0.2",
+			wantErr: true,
+		},
+		{
+			name:    "multiple hosts without sentinel",
+			rawURL:  "redis://10.0.0.1:6379,10.0.0.2:6379",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported topology",
+			rawURL:  "redis+replica://10.0.0.1:6379",
+			wantErr: true,
+		},
 		{
 			name:    "unsupported scheme",
 			rawURL:  "http://10.0.0.1:6379",
@@ -81,6 +125,8 @@ func TestParseUpstreamURL(t *testing.T) {
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.wantHost, cfg.host)
+			assert.Equal(t, tt.wantSentinels, cfg.sentinels)
+			assert.Equal(t, tt.wantMaster, cfg.masterName)
 
 			if tt.wantTLS {
 				require.NotNil(t, cfg.tlsConfig)
@@ -91,3 +137,50 @@ func TestParseUpstreamURL(t *testing.T) {
 		})
 	}
 }
+
+func TestProxyClosesConnections(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	listener := listenLocal(t)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	p := New(Config{ListenAddr: addr, UpstreamURL: "redis://" + upstream.listener.Addr().String()}, logger.NewNopLogger())
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- p.Start(ctx) }()
+	<-p.Ready()
+
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := &testClient{conn: conn, r: bufio.NewReader(conn)}
+	assert.Equal(t, "+PONG\r\n", client.do(t, "PING"))
+
+	// The proxy returns once the connections of the clients are closed
+	cancel()
+	require.NoError(t, <-stopped)
+
+	_, err = client.r.ReadByte()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestProxyRejectsClusterUpstream(t *testing.T) {
+	t.Parallel()
+
+	// The database of the volume can't be selected on a cluster, its keys would be in the database 0
+	upstream := newFakeRedis(t)
+	p := New(Config{
+		ListenAddr:  "127.0.0.1:0",
+		UpstreamURL: "redis+cluster://" + upstream.listener.Addr().String(),
+		RedisDB:     1,
+		Password:    "secret",
+	}, logger.NewNopLogger())
+
+	err := p.Start(t.Context())
+	require.ErrorIs(t, err, errClusterUpstream)
+	assert.Equal(t, int32(0), upstream.accepted.Load())
+}
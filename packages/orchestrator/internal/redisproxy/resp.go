@@ -0,0 +1,247 @@
+package redisproxy
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	// maxCommandSize bounds the commands of the sandboxes read into memory, the JuiceFS metadata
+	// commands are much smaller.
+	maxCommandSize = 64 << 20
+	// maxLineSize bounds the simple values and the headers of the aggregates.
+	maxLineSize = 64 << 10
+)
+
+var errCommandTooLarge = errors.New("command too large")
+
+// command is a command of a client, with its RESP encoding to forward it as it was sent.
+type command struct {
+	raw  []byte
+	args [][]byte
+}
+
+// name returns the upper case name of the command.
+func (c *command) name() string {
+	if len(c.args) == 0 {
+		return ""
+	}
+
+	return string(bytes.ToUpper(c.args[0]))
+}
+
+// arg returns the upper case i-th argument of the command, empty when it's missing.
+func (c *command) arg(i int) string {
+	if i >= len(c.args) {
+		return ""
+	}
+
+	return string(bytes.ToUpper(c.args[i]))
+}
+
+// readCommand reads a command of a client, as an array of bulk strings or an inline command.
+func readCommand(r *bufio.Reader) (*command, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if line[0] != '*' {
+		// Inline commands, like the ones typed in telnet, are forwarded as they are
+		return &command{raw: line, args: bytes.Fields(line)}, nil
+	}
+
+	n, err := parseLength(line)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &command{raw: line, args: make([][]byte, 0, min(n, 1024))}
+	for range n {
+		header, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if header[0] != '$' {
+			return nil, fmt.Errorf("unexpected %q in command", header[0])
+		}
+
+		size, err := parseLength(header)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, errors.New("null bulk string in command")
+		}
+		if len(cmd.raw)+len(header)+size+2 > maxCommandSize {
+			return nil, errCommandTooLarge
+		}
+
+		start := len(cmd.raw) + len(header)
+		cmd.raw = append(cmd.raw, header...)
+		cmd.raw = append(cmd.raw, make([]byte, size+2)...)
+		if _, err := io.ReadFull(r, cmd.raw[start:]); err != nil {
+			return nil, err
+		}
+
+		cmd.args = append(cmd.args, cmd.raw[start:start+size])
+	}
+
+	return cmd, nil
+}
+
+// newCommand encodes the arguments as a command, to forward a command the proxy rewrote.
+func newCommand(args [][]byte) *command {
+	raw := fmt.Appendf(nil, "*%d\r\n", len(args))
+	for _, arg := range args {
+		raw = fmt.Appendf(raw, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	return &command{raw: raw, args: args}
+}
+
+// copyReply copies a whole reply of RESP2 or RESP3 from r to w and returns its type. The attributes
+// preceding a reply are copied with it.
+func copyReply(w io.Writer, r *bufio.Reader) (byte, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := w.Write(line); err != nil {
+		return 0, err
+	}
+
+	typ := line[0]
+	switch typ {
+	case '+', '-', ':', '_', '#', ',', '(':
+		return typ, nil
+	case '$', '!', '=':
+		size, err := parseLength(line)
+		if err != nil || size < 0 {
+			return typ, err
+		}
+
+		_, err = io.CopyN(w, r, int64(size)+2)
+
+		return typ, err
+	case '*', '~', '>', '%', '|':
+		n, err := parseLength(line)
+		if err != nil || n < 0 {
+			return typ, err
+		}
+
+		// Maps and attributes have a key and a value per entry
+		if typ == '%' || typ == '|' {
+			n *= 2
+		}
+
+		for range n {
+			if _, err := copyReply(w, r); err != nil {
+				return typ, err
+			}
+		}
+
+		if typ == '|' {
+			return copyReply(w, r)
+		}
+
+		return typ, nil
+	default:
+		return typ, fmt.Errorf("unexpected %q in reply", typ)
+	}
+}
+
+// redisError is an error reply to a command of the proxy.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// readValue reads the RESP2 reply to a command of the proxy, as a string, an int64, a []any or nil.
+// The error replies are returned as a redisError.
+func readValue(r *bufio.Reader) (any, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1 : len(line)-2]), nil
+	case '-':
+		return nil, redisError(line[1 : len(line)-2])
+	case ':':
+		return strconv.ParseInt(string(line[1:len(line)-2]), 10, 64)
+	case '$':
+		size, err := parseLength(line)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+
+		value := make([]byte, size+2)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+
+		return string(value[:size]), nil
+	case '*':
+		n, err := parseLength(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+
+		values := make([]any, 0, min(n, 1024))
+		for range n {
+			value, err := readValue(r)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q in reply", line[0])
+	}
+}
+
+// readLine reads a line ending with CRLF, the CRLF included.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(line) > maxLineSize {
+			return nil, errCommandTooLarge
+		}
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return nil, err
+		}
+	}
+
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed line %q", line)
+	}
+
+	return line, nil
+}
+
+// parseLength parses the length of a bulk string or an aggregate header line, -1 for the nulls.
+func parseLength(line []byte) (int, error) {
+	n, err := strconv.Atoi(string(line[1 : len(line)-2]))
+	if err != nil || n < -1 || n > maxCommandSize {
+		return 0, fmt.Errorf("malformed length %q", line)
+	}
+
+	return n, nil
+}
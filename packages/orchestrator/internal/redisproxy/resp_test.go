@@ -0,0 +1,73 @@
+package redisproxy
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCommand(t *testing.T) {
+	t.Parallel()
+
+	r := bufio.NewReader(strings.NewReader("*2\r\n$6\r\nselect\r\n$1\r\n3\r\nPING\r\n*1\r\n$-1\r\n"))
+
+	cmd, err := readCommand(r)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT", cmd.name())
+	assert.Equal(t, "3", cmd.arg(1))
+	assert.Empty(t, cmd.arg(2))
+	assert.Equal(t, "*2\r\n$6\r\nselect\r\n$1\r\n3\r\n", string(cmd.raw))
+
+	// Inline commands
+	cmd, err = readCommand(r)
+	require.NoError(t, err)
+	assert.Equal(t, "PING", cmd.name())
+	assert.Equal(t, "PING\r\n", string(cmd.raw))
+
+	_, err = readCommand(r)
+	assert.Error(t, err)
+
+	_, err = readCommand(bufio.NewReader(strings.NewReader("*1\r\n$100000000\r\n")))
+	assert.Error(t, err)
+}
+
+func TestCopyReply(t *testing.T) {
+	t.Parallel()
+
+	replies := []struct {
+		reply string
+		typ   byte
+	}{
+		{reply: "+OK\r\n", typ: '+'},
+		{reply: "-ERR wrong\r\n", typ: '-'},
+		{reply: ":42\r\n", typ: ':'},
+		{reply: "$5\r\nhello\r\n", typ: '$'},
+		{reply: "$-1\r\n", typ: '$'},
+		{reply: "*2\r\n$1\r\na\r\n*1\r\n:1\r\n", typ: '*'},
+		{reply: "*-1\r\n", typ: '*'},
+		// RESP3
+		{reply: "_\r\n", typ: '_'},
+		{reply: "%2\r\n+proto\r\n:3\r\n+modules\r\n*0\r\n", typ: '%'},
+		{reply: "~1\r\n,1.5\r\n", typ: '~'},
+		{reply: "=7\r\ntxt:abc\r\n", typ: '='},
+		{reply: "|1\r\n+ttl\r\n:3600\r\n$3\r\nval\r\n", typ: '$'},
+	}
+
+	var stream strings.Builder
+	for _, reply := range replies {
+		stream.WriteString(reply.reply)
+	}
+
+	r := bufio.NewReader(strings.NewReader(stream.String()))
+	for _, reply := range replies {
+		var copied bytes.Buffer
+		typ, err := copyReply(&copied, r)
+		require.NoError(t, err)
+		assert.Equal(t, reply.typ, typ, reply.reply)
+		assert.Equal(t, reply.reply, copied.String())
+	}
+}
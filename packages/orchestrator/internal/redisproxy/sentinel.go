@@ -0,0 +1,105 @@
+package redisproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// sentinelTimeout bounds the time a sentinel takes to answer with the address of the master.
+const sentinelTimeout = 5 * time.Second
+
+// sentinels finds the master of a Redis monitored by Redis Sentinel. The master is asked for on each
+// new upstream connection, so they follow the failovers. The sentinels disconnect the clients of a
+// demoted master, the connections in use are closed and the next ones are opened to the new master.
+type sentinels struct {
+	masterName string
+	password   string
+	dial       func(ctx context.Context, addr string) (net.Conn, error)
+
+	// addrs are the addresses of the sentinels, the last one answering first.
+	mu    sync.Mutex
+	addrs []string
+}
+
+func newSentinels(addrs []string, masterName, password string, dial func(ctx context.Context, addr string) (net.Conn, error)) *sentinels {
+	return &sentinels{
+		masterName: masterName,
+		password:   password,
+		dial:       dial,
+		addrs:      addrs,
+	}
+}
+
+// master returns the address of the master from the first sentinel knowing it.
+func (s *sentinels) master(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	addrs := append([]string(nil), s.addrs...)
+	s.mu.Unlock()
+
+	var errs []error
+	for i, addr := range addrs {
+		master, err := s.askMaster(ctx, addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sentinel %s: %w", addr, err))
+
+			continue
+		}
+
+		if i > 0 {
+			s.mu.Lock()
+			s.addrs = append([]string{addr}, append(addrs[:i:i], addrs[i+1:]...)...)
+			s.mu.Unlock()
+		}
+
+		return master, nil
+	}
+
+	return "", fmt.Errorf("find master %s: %w", s.masterName, errors.Join(errs...))
+}
+
+// askMaster asks the sentinel for the address of the master.
+func (s *sentinels) askMaster(ctx context.Context, addr string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, sentinelTimeout)
+	defer cancel()
+
+	netConn, err := s.dial(ctx, addr)
+	if err != nil {
+		return "", err
+	}
+	defer netConn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		netConn.SetDeadline(deadline)
+	}
+
+	conn := newUpstreamConn(netConn)
+	if s.password != "" {
+		if err := conn.do("AUTH", s.password); err != nil {
+			return "", err
+		}
+	}
+
+	reply, err := conn.query("SENTINEL", "GET-MASTER-ADDR-BY-NAME", s.masterName)
+	if err != nil {
+		return "", err
+	}
+
+	// The sentinels answer with the host and the port, or a null for the masters they don't monitor
+	values, _ := reply.([]any)
+	if len(values) != 2 {
+		return "", errors.New("unknown master")
+	}
+
+	host, _ := values[0].(string)
+	port, _ := values[1].(string)
+	if _, err := strconv.Atoi(port); host == "" || err != nil {
+		return "", fmt.Errorf("malformed master address %v", values)
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,58 @@
+package redisproxy
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeSentinel answers the address of the master named mymaster, the other masters are unknown.
+func newFakeSentinel(t *testing.T, master string) *fakeRedis {
+	t.Helper()
+
+	host, port, err := net.SplitHostPort(master)
+	require.NoError(t, err)
+
+	return serveFakeRedis(t, listenLocal(t), func(cmd *command) (string, bool) {
+		switch {
+		case cmd.name() == "SENTINEL" && cmd.arg(2) == "MYMASTER":
+			return fmt.Sprintf("*2\r\n$%d\r\n%s\r\n$%d\r\n%s\r\n", len(host), host, len(port), port), true
+		case cmd.name() == "SENTINEL":
+			return "*-1\r\n", true
+		default:
+			return "", false
+		}
+	})
+}
+
+func TestSentinel(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	sentinel := newFakeSentinel(t, upstream.listener.Addr().String())
+	sentinelAddr := sentinel.listener.Addr().String()
+
+	// The address of a closed listener refuses the connections
+	listener := listenLocal(t)
+	downAddr := listener.Addr().String()
+	listener.Close()
+
+	p, connect := startProxy(t, "redis+sentinel://:secret@"+downAddr+","+sentinelAddr+"/mymaster", 2)
+	client := connect()
+
+	assert.Equal(t, "+PONG\r\n", client.do(t, "PING"))
+	assert.Equal(t, int32(1), upstream.accepted.Load())
+
+	// The sentinel answering is asked first the next time
+	p.sentinels.mu.Lock()
+	assert.Equal(t, []string{sentinelAddr, downAddr}, p.sentinels.addrs)
+	p.sentinels.mu.Unlock()
+
+	// The masters the sentinels don't monitor aren't found
+	unknown := newSentinels([]string{sentinelAddr}, "othermaster", "", p.dial)
+	_, err := unknown.master(t.Context())
+	assert.ErrorContains(t, err, "unknown master")
+}
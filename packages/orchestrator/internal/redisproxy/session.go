@@ -0,0 +1,270 @@
+package redisproxy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// acquireTimeout bounds how long a command waits for an upstream connection when all of them
+	// are in use.
+	acquireTimeout = 10 * time.Second
+	// maxPipeline bounds the commands forwarded before their replies are read.
+	maxPipeline = 1024
+)
+
+// execAbortReply is the reply to the EXEC of a transaction with a rejected command, it's discarded
+// like Redis does for the commands failing to be queued.
+const execAbortReply = "-EXECABORT Transaction discarded because of previous errors.\r\n"
+
+// pendingCommand is a command waiting for its reply, from the upstream when it was forwarded.
+// The reply of the proxy is sent instead when it's set.
+type pendingCommand struct {
+	name      string
+	arg       string
+	forwarded bool
+	reply     string
+}
+
+// session relays the commands of a client connection over the pooled upstream connections.
+// A connection is taken for a command, or for the commands pipelined with it, and put back once
+// their replies are relayed, so the idle client connections don't hold upstream connections.
+// Transactions keep their connection until they end. The commands leaving a state on the
+// connection that can't be restored on another one, like the subscriptions, aren't allowed.
+// When the proxy has a client password, the commands are only forwarded once the client sent it.
+type session struct {
+	proxy  *Proxy
+	pool   *pool
+	client net.Conn
+	r      *bufio.Reader
+	w      *bufio.Writer
+
+	conn    *upstreamConn
+	pending []pendingCommand
+
+	// db is the database of the volume and protocol the one the client set, restored on the
+	// connections it's given.
+	db       int
+	protocol int
+
+	// multi and watching are set during the transactions.
+	multi    bool
+	watching bool
+
+	// queuing is set from the MULTI to the end of the transaction forwarded, before their replies
+	// are read, and aborted once a command of the transaction is rejected.
+	queuing bool
+	aborted bool
+
+	// authenticated is set once the client sent the client password, the commands are only
+	// forwarded from then on when the proxy has one.
+	authenticated bool
+}
+
+func newSession(proxy *Proxy, pool *pool, client net.Conn) *session {
+	// The database of the volume is selected on the upstream connections before any command
+	return &session{
+		proxy:    proxy,
+		pool:     pool,
+		client:   client,
+		r:        bufio.NewReader(client),
+		w:        bufio.NewWriter(client),
+		db:       proxy.config.RedisDB,
+		protocol: 2,
+	}
+}
+
+// run relays the commands of the client until it disconnects.
+func (s *session) run(ctx context.Context) error {
+	defer func() {
+		if s.conn != nil {
+			// Transactions left open aren't rolled back, the connection can't be reused
+			s.pool.put(s.conn, false)
+		}
+	}()
+
+	for {
+		cmd, err := readCommand(s.r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := cmd.name()
+		if name == "QUIT" {
+			s.w.WriteString("+OK\r\n")
+
+			return s.w.Flush()
+		}
+
+		if err := s.forward(ctx, cmd); err != nil {
+			return err
+		}
+
+		// The commands pipelined by the client are forwarded together
+		if s.r.Buffered() > 0 && len(s.pending) < maxPipeline {
+			continue
+		}
+
+		if err := s.relayReplies(); err != nil {
+			return err
+		}
+
+		if s.conn != nil && !s.multi && !s.watching {
+			s.pool.put(s.conn, true)
+			s.conn = nil
+		}
+	}
+}
+
+// forward sends the command upstream, or queues the reply of the proxy when it's rejected.
+func (s *session) forward(ctx context.Context, cmd *command) error {
+	name := cmd.name()
+
+	if s.proxy.config.ClientPassword != "" {
+		var reply string
+		if reply, cmd = s.authenticate(cmd); cmd == nil {
+			if reply != "+OK\r\n" {
+				s.proxy.recordRejected(ctx, name)
+				s.aborted = s.aborted || s.queuing
+			}
+			s.pending = append(s.pending, pendingCommand{name: name, reply: reply})
+
+			return nil
+		}
+	}
+
+	if !allowed(cmd, s.proxy.config.RedisDB) {
+		s.proxy.recordRejected(ctx, name)
+		s.aborted = s.aborted || s.queuing
+		s.pending = append(s.pending, pendingCommand{name: name, reply: notAllowedReply(name)})
+
+		return nil
+	}
+
+	if s.conn == nil {
+		conn, err := s.acquire(ctx)
+		if err != nil {
+			s.pending = append(s.pending, pendingCommand{name: name, reply: errorReply(err)})
+
+			return nil
+		}
+		s.conn = conn
+	}
+
+	pending := pendingCommand{name: name, arg: cmd.arg(1), forwarded: true}
+	raw := cmd.raw
+	switch name {
+	case "MULTI":
+		s.queuing = true
+	case "EXEC", "DISCARD":
+		if name == "EXEC" && s.aborted {
+			raw = []byte("*1\r\n$7\r\nDISCARD\r\n")
+			pending.reply = execAbortReply
+		}
+		s.queuing, s.aborted = false, false
+	}
+
+	if _, err := s.conn.w.Write(raw); err != nil {
+		return err
+	}
+	s.pending = append(s.pending, pending)
+
+	return nil
+}
+
+// acquire takes an upstream connection and restores the state of the client on it.
+func (s *session) acquire(ctx context.Context) (*upstreamConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, acquireTimeout)
+	defer cancel()
+
+	conn, err := s.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.protocol != s.protocol {
+		if err := conn.do("HELLO", strconv.Itoa(s.protocol)); err != nil {
+			s.pool.put(conn, false)
+
+			return nil, err
+		}
+		conn.protocol = s.protocol
+	}
+
+	if conn.db != s.db {
+		if err := conn.do("SELECT", strconv.Itoa(s.db)); err != nil {
+			s.pool.put(conn, false)
+
+			return nil, err
+		}
+		conn.db = s.db
+	}
+
+	return conn, nil
+}
+
+// relayReplies sends the forwarded commands and relays their replies to the client, in the order
+// of the commands with the replies of the proxy.
+func (s *session) relayReplies() error {
+	if s.conn != nil {
+		if err := s.conn.w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	for _, cmd := range s.pending {
+		if cmd.forwarded {
+			var w io.Writer = s.w
+			if cmd.reply != "" {
+				w = io.Discard
+			}
+
+			typ, err := copyReply(w, s.conn.r)
+			if err != nil {
+				return err
+			}
+
+			s.track(cmd, typ != '-' && typ != '!')
+		}
+
+		if cmd.reply != "" {
+			s.w.WriteString(cmd.reply)
+		}
+	}
+	s.pending = s.pending[:0]
+
+	return s.w.Flush()
+}
+
+// track follows the state the command set on the connection.
+func (s *session) track(cmd pendingCommand, ok bool) {
+	switch cmd.name {
+	case "HELLO":
+		if protocol, err := strconv.Atoi(cmd.arg); ok && err == nil {
+			s.protocol, s.conn.protocol = protocol, protocol
+		}
+	case "MULTI":
+		s.multi = s.multi || ok
+	case "WATCH":
+		s.watching = s.watching || ok
+	case "UNWATCH":
+		s.watching = s.watching && !ok
+	case "EXEC", "DISCARD":
+		s.multi, s.watching = false, false
+	}
+}
+
+// errorReply is the error reply to a command the proxy failed to send, the joined errors are put
+// on a single line.
+func errorReply(err error) string {
+	return "-ERR redis proxy: " + strings.Join(strings.Fields(err.Error()), " ") + "\r\n"
+}
@@ -0,0 +1,401 @@
+package redisproxy
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+)
+
+// fakeRedis answers the commands the tests send, with the selected database of each connection.
+// The commands outside of the transactions are answered by handle first when it's set.
+type fakeRedis struct {
+	listener net.Listener
+	accepted atomic.Int32
+	handle   func(cmd *command) (string, bool)
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+
+	return serveFakeRedis(t, listenLocal(t), nil)
+}
+
+func listenLocal(t *testing.T) net.Listener {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	return listener
+}
+
+func serveFakeRedis(t *testing.T, listener net.Listener, handle func(cmd *command) (string, bool)) *fakeRedis {
+	t.Helper()
+
+	f := &fakeRedis{listener: listener, handle: handle}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			f.accepted.Add(1)
+			go f.serve(conn)
+		}
+	}()
+
+	return f
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	db := 0
+	var queued []string
+
+	for {
+		cmd, err := readCommand(r)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		handled := false
+		if f.handle != nil && queued == nil {
+			reply, handled = f.handle(cmd)
+		}
+
+		switch name := cmd.name(); {
+		case handled:
+		case queued != nil && name != "EXEC" && name != "DISCARD":
+			queued = append(queued, "+OK\r\n")
+			reply = "+QUEUED\r\n"
+		case name == "AUTH":
+			reply = "+OK\r\n"
+		case name == "PING":
+			reply = "+PONG\r\n"
+		case name == "SELECT":
+			db, _ = strconv.Atoi(cmd.arg(1))
+			reply = "+OK\r\n"
+		case name == "DBSIZE":
+			// The selected database stands in for the number of keys
+			reply = fmt.Sprintf(":%d\r\n", db)
+		case name == "MULTI":
+			queued = []string{}
+			reply = "+OK\r\n"
+		case name == "EXEC":
+			reply = fmt.Sprintf("*%d\r\n", len(queued))
+			for _, queuedReply := range queued {
+				reply += queuedReply
+			}
+			queued = nil
+		case name == "DISCARD":
+			queued = nil
+			reply = "+OK\r\n"
+		default:
+			reply = "+OK\r\n"
+		}
+
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+// testClient is a client connection to the proxy.
+type testClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (c *testClient) send(t *testing.T, commands ...[]string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	for _, args := range commands {
+		fmt.Fprintf(&buf, "*%d\r\n", len(args))
+		for _, arg := range args {
+			fmt.Fprintf(&buf, "$%d\r\n%s\r\n", len(arg), arg)
+		}
+	}
+
+	_, err := c.conn.Write(buf.Bytes())
+	require.NoError(t, err)
+}
+
+func (c *testClient) reply(t *testing.T) string {
+	t.Helper()
+
+	var reply bytes.Buffer
+	_, err := copyReply(&reply, c.r)
+	require.NoError(t, err)
+
+	return reply.String()
+}
+
+func (c *testClient) do(t *testing.T, args ...string) string {
+	t.Helper()
+
+	c.send(t, args)
+
+	return c.reply(t)
+}
+
+func startPooledProxy(t *testing.T, upstream *fakeRedis, poolSize int) func() *testClient {
+	t.Helper()
+
+	_, connect := startProxy(t, "redis://"+upstream.listener.Addr().String(), poolSize)
+
+	return connect
+}
+
+func startProxy(t *testing.T, upstreamURL string, poolSize int) (*Proxy, func() *testClient) {
+	t.Helper()
+
+	return startProxyWithConfig(t, Config{
+		UpstreamURL: upstreamURL,
+		RedisDB:     1,
+		Password:    "secret",
+		PoolSize:    poolSize,
+	})
+}
+
+func startProxyWithConfig(t *testing.T, cfg Config) (*Proxy, func() *testClient) {
+	t.Helper()
+
+	// The address is reserved first, the listener of the proxy is only set once it started
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	cfg.ListenAddr = addr
+	p, err := StartInNamespace(t.Context(), cfg, logger.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { p.Close() })
+
+	return p, func() *testClient {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		return &testClient{conn: conn, r: bufio.NewReader(conn)}
+	}
+}
+
+func TestSessionSharesConnections(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	connect := startPooledProxy(t, upstream, 4)
+
+	// Short lived client connections reuse the same upstream connection
+	for range 3 {
+		client := connect()
+		assert.Equal(t, "+PONG\r\n", client.do(t, "PING"))
+		client.conn.Close()
+	}
+	assert.Equal(t, int32(1), upstream.accepted.Load())
+
+	// Idle client connections don't hold upstream connections
+	a, b := connect(), connect()
+	assert.Equal(t, "+PONG\r\n", a.do(t, "PING"))
+	assert.Equal(t, "+PONG\r\n", b.do(t, "PING"))
+	assert.Equal(t, int32(1), upstream.accepted.Load())
+
+	// Pipelined commands are relayed in order
+	a.send(t, []string{"PING"}, []string{"SET", "k", "v"}, []string{"PING"})
+	assert.Equal(t, "+PONG\r\n", a.reply(t))
+	assert.Equal(t, "+OK\r\n", a.reply(t))
+	assert.Equal(t, "+PONG\r\n", a.reply(t))
+}
+
+func TestSessionRestoresState(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	connect := startPooledProxy(t, upstream, 4)
+
+	a, b := connect(), connect()
+
+	// The database of the volume is selected before the first command
+	assert.Equal(t, ":1\r\n", a.do(t, "DBSIZE"))
+	assert.Equal(t, "+OK\r\n", a.do(t, "SELECT", "1"))
+
+	// The databases of the other volumes can't be selected
+	assert.Equal(t, "-NOPERM the 'SELECT' command isn't allowed for volumes\r\n", a.do(t, "SELECT", "3"))
+	assert.Equal(t, ":1\r\n", a.do(t, "DBSIZE"))
+	assert.Equal(t, ":1\r\n", b.do(t, "DBSIZE"))
+	assert.Equal(t, int32(1), upstream.accepted.Load())
+}
+
+func TestSessionTransactions(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	connect := startPooledProxy(t, upstream, 4)
+
+	a, b := connect(), connect()
+	assert.Equal(t, "+OK\r\n", a.do(t, "MULTI"))
+
+	// The transaction keeps its connection, the other client gets another one
+	assert.Equal(t, "+PONG\r\n", b.do(t, "PING"))
+	assert.Equal(t, int32(2), upstream.accepted.Load())
+
+	assert.Equal(t, "+QUEUED\r\n", a.do(t, "SET", "k", "v"))
+	assert.Equal(t, "*1\r\n+OK\r\n", a.do(t, "EXEC"))
+
+	// Both connections are idle again
+	assert.Equal(t, "+PONG\r\n", a.do(t, "PING"))
+	assert.Equal(t, "+PONG\r\n", b.do(t, "PING"))
+	assert.Equal(t, int32(2), upstream.accepted.Load())
+}
+
+func TestSessionPoolSize(t *testing.T) {
+	t.Parallel()
+
+	upstream := newFakeRedis(t)
+	connect := startPooledProxy(t, upstream, 1)
+
+	a, b := connect(), connect()
+	assert.Equal(t, "+OK\r\n", a.do(t, "MULTI"))
+
+	// The only connection is in the transaction, the other client waits for it
+	b.send(t, []string{"PING"})
+	assert.Equal(t, "+QUEUED\r\n", a.do(t, "PING"))
+	assert.Equal(t, "*1\r\n+OK\r\n", a.do(t, "EXEC"))
+
+	assert.Equal(t, "+PONG\r\n", b.reply(t))
+	assert.Equal(t, int32(1), upstream.accepted.Load())
+}
+
+// metricsReader collects the metrics of the package, the counters are bound to the global
+// provider the first time it's set.
+var metricsReader = sync.OnceValue(func() *sdkmetric.ManualReader {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	return reader
+})
+
+func rejectedCommands(t *testing.T) map[string]int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, metricsReader().Collect(t.Context(), &rm))
+
+	rejected := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != string(telemetry.RedisProxyRejectedCounterName) {
+				continue
+			}
+
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				command, _ := point.Attributes.Value("command")
+				rejected[command.AsString()] += point.Value
+			}
+		}
+	}
+
+	return rejected
+}
+
+func TestSessionRejectsCommands(t *testing.T) {
+	before := rejectedCommands(t)
+	upstream := newFakeRedis(t)
+
+	for _, poolSize := range []int{0, 2} {
+		connect := startPooledProxy(t, upstream, poolSize)
+		client := connect()
+
+		assert.Equal(t, "-NOPERM the 'FLUSHALL' command isn't allowed for volumes\r\n", client.do(t, "FLUSHALL"))
+		assert.Equal(t, "-NOPERM the 'CONFIG' command isn't allowed for volumes\r\n", client.do(t, "config", "set", "maxmemory", "0"))
+		assert.Equal(t, "-NOPERM this command isn't allowed for volumes\r\n", client.do(t, "MADEUP\r\n"))
+		assert.Equal(t, "-NOPERM the 'HELLO' command isn't allowed for volumes\r\n", client.do(t, "HELLO", "3", "AUTH", "default", "secret"))
+
+		// The replies of the rejected commands keep their place between the pipelined ones
+		client.send(t, []string{"PING"}, []string{"EVAL", "return 1", "0"}, []string{"PING"})
+		assert.Equal(t, "+PONG\r\n", client.reply(t))
+		assert.Equal(t, "-NOPERM the 'EVAL' command isn't allowed for volumes\r\n", client.reply(t))
+		assert.Equal(t, "+PONG\r\n", client.reply(t))
+
+		// A transaction with a rejected command is discarded
+		client.send(t, []string{"MULTI"}, []string{"SET", "k", "v"}, []string{"SCRIPT", "FLUSH"}, []string{"EXEC"})
+		assert.Equal(t, "+OK\r\n", client.reply(t))
+		assert.Equal(t, "+QUEUED\r\n", client.reply(t))
+		assert.Equal(t, "-NOPERM the 'SCRIPT' command isn't allowed for volumes\r\n", client.reply(t))
+		assert.Equal(t, execAbortReply, client.reply(t))
+
+		// The connection is usable after the transaction
+		assert.Equal(t, "+PONG\r\n", client.do(t, "PING"))
+	}
+
+	after := rejectedCommands(t)
+	for command, count := range map[string]int64{"FLUSHALL": 2, "CONFIG": 2, "other": 2, "HELLO": 2, "EVAL": 2, "SCRIPT": 2} {
+		assert.Equal(t, count, after[command]-before[command], command)
+	}
+}
+
+func TestSessionAuthenticatesClients(t *testing.T) {
+	t.Parallel()
+
+	var hello atomic.Value
+	upstream := serveFakeRedis(t, listenLocal(t), func(cmd *command) (string, bool) {
+		if cmd.name() == "HELLO" {
+			hello.Store(string(bytes.Join(cmd.args, []byte(" "))))
+		}
+
+		return "", false
+	})
+
+	for _, poolSize := range []int{0, 2} {
+		_, connect := startProxyWithConfig(t, Config{
+			UpstreamURL:    "redis://" + upstream.listener.Addr().String(),
+			RedisDB:        1,
+			Password:       "secret",
+			ClientPassword: "token",
+			PoolSize:       poolSize,
+		})
+
+		client := connect()
+		assert.Equal(t, noAuthReply, client.do(t, "PING"))
+		assert.Equal(t, noAuthReply, client.do(t, "HELLO", "3"))
+		assert.Equal(t, wrongPassReply, client.do(t, "AUTH", "secret"))
+		assert.Equal(t, authArgsReply, client.do(t, "AUTH"))
+		assert.Equal(t, noAuthReply, client.do(t, "PING"))
+
+		// The password is checked by the proxy, the pipelined commands are forwarded once it matched
+		client.send(t, []string{"AUTH", "default", "token"}, []string{"PING"})
+		assert.Equal(t, "+OK\r\n", client.reply(t))
+		assert.Equal(t, "+PONG\r\n", client.reply(t))
+
+		// HELLO is forwarded without the credentials
+		client = connect()
+		assert.Equal(t, wrongPassReply, client.do(t, "HELLO", "3", "AUTH", "default", "secret"))
+		assert.Equal(t, "+OK\r\n", client.do(t, "HELLO", "3", "auth", "default", "token", "SETNAME", "juicefs"))
+		assert.Equal(t, "HELLO 3 SETNAME juicefs", hello.Load())
+		assert.Equal(t, "+PONG\r\n", client.do(t, "PING"))
+
+		// The clients can quit without authenticating
+		assert.Equal(t, "+OK\r\n", connect().do(t, "QUIT"))
+	}
+}
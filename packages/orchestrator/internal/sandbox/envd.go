@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
@@ -16,15 +19,37 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/consts"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/keys"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 const (
 	loopDelay = 5 * time.Millisecond
+
+	// minEnvdVersionForExpiringAccessTokens is the first envd version accepting expiring access tokens.
+	minEnvdVersionForExpiringAccessTokens = "0.4.7"
 )
 
+// envdAuthToken returns the token authenticating a request of the orchestrator to envd. Envd versions
+// supporting it get a fresh expiring token, so the access token itself is only sent in the init body.
+func envdAuthToken(accessToken *string, envdVersion string) *string {
+	if accessToken == nil {
+		return nil
+	}
+
+	ok, err := utils.IsGTEVersion(envdVersion, minEnvdVersionForExpiringAccessTokens)
+	if err != nil || !ok {
+		return accessToken
+	}
+
+	return utils.ToPtr(keys.NewEnvdAccessToken(*accessToken, time.Now()))
+}
+
 // doRequestWithInfiniteRetries does a request with infinite retries until the context is done.
 // The parent context should have a deadline or a timeout.
 func doRequestWithInfiniteRetries(
@@ -34,6 +59,7 @@ func doRequestWithInfiniteRetries(
 	accessToken *string,
 	envdInitRequestTimeout time.Duration,
 	envVars map[string]string,
+	secrets map[string]string,
 	sandboxID,
 	envdVersion,
 	hyperloopIP string,
@@ -47,6 +73,7 @@ func doRequestWithInfiniteRetries(
 
 		jsonBody := &PostInitJSONBody{
 			EnvVars:        &envVars,
+			Secrets:        secrets,
 			HyperloopIP:    &hyperloopIP,
 			AccessToken:    accessToken,
 			Timestamp:      &now,
@@ -71,8 +98,8 @@ func doRequestWithInfiniteRetries(
 
 		// make sure request to already authorized envd will not fail
 		// this can happen in sandbox resume and in some edge cases when previous request was success, but we continued
-		if accessToken != nil {
-			request.Header.Set("X-Access-Token", *accessToken)
+		if authToken := envdAuthToken(accessToken, envdVersion); authToken != nil {
+			request.Header.Set("X-Access-Token", *authToken)
 		}
 
 		response, err := sandboxHttpClient.Do(request)
@@ -94,6 +121,7 @@ func doRequestWithInfiniteRetries(
 
 type PostInitJSONBody struct {
 	EnvVars        *map[string]string `json:"envVars"`
+	Secrets        map[string]string  `json:"secrets,omitempty"`
 	AccessToken    *string            `json:"accessToken,omitempty"`
 	HyperloopIP    *string            `json:"hyperloopIP,omitempty"`
 	Timestamp      *time.Time         `json:"timestamp,omitempty"`
@@ -114,6 +142,44 @@ type InitVolumeConfig struct {
 	GCSToken string `json:"gcsToken"`
 	// GCSTokenExpiry is the Unix timestamp when the token expires.
 	GCSTokenExpiry int64 `json:"gcsTokenExpiry"`
+	// GCSEndpoint is the GCS emulator or other GCS compatible endpoint for volume data, empty for the public API.
+	GCSEndpoint string `json:"gcsEndpoint,omitempty"`
+	// StorageProvider is the object storage of the volume data, GCS when empty.
+	StorageProvider volumestorage.Provider `json:"storageProvider,omitempty"`
+	// S3Region is the region of the bucket on S3.
+	S3Region string `json:"s3Region,omitempty"`
+	// S3Endpoint is the S3 compatible endpoint of the bucket, AWS S3 when empty.
+	S3Endpoint string `json:"s3Endpoint,omitempty"`
+	// MetaEngine is where the JuiceFS metadata of the volume lives, SQLite when empty.
+	MetaEngine volumestorage.MetaEngine `json:"metaEngine,omitempty"`
+	// RedisMetaURL is the JuiceFS metadata URL of a volume with Redis metadata, through the Redis proxy of the sandbox.
+	RedisMetaURL string `json:"redisMetaUrl,omitempty"`
+	// ProxyToken is the secret JuiceFS authenticates to the volume proxies of the sandbox with, so the other processes can't reach the volume storage.
+	ProxyToken string `json:"proxyToken,omitempty"`
+	// ReadOnlyRoot makes the template rootfs read-only inside the guest.
+	ReadOnlyRoot bool `json:"readOnlyRoot,omitempty"`
+	// OverlayPaths are guest paths whose contents are persisted on the volume.
+	OverlayPaths []string `json:"overlayPaths,omitempty"`
+	// ReadOnly mounts the volume read-only without replicating metadata back.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// MountMemoryMB limits the memory of the processes serving the volume, 0 uses the envd default.
+	MountMemoryMB int64 `json:"mountMemoryMb,omitempty"`
+	// MountCPUWeight is the cgroup CPU weight of the processes serving the volume, 0 uses the envd default.
+	MountCPUWeight int64 `json:"mountCpuWeight,omitempty"`
+	// MountOptions are the JuiceFS format and mount options of the volume.
+	MountOptions map[string]string `json:"mountOptions,omitempty"`
+	// CheckpointIntervalSeconds is the interval between the checkpoints of the replicated metadata, 0 uses the envd default.
+	CheckpointIntervalSeconds int64 `json:"checkpointIntervalSeconds,omitempty"`
+	// MountAttempts is how many times a mount failing transiently is attempted, 0 uses the envd default.
+	MountAttempts int `json:"mountAttempts,omitempty"`
+	// AllowMountFailure starts the sandbox without the volume when it can't be mounted.
+	AllowMountFailure bool `json:"allowMountFailure,omitempty"`
+	// PersistHome persists the home directory of the default user on the volume.
+	PersistHome bool `json:"persistHome,omitempty"`
+	// Prewarm are the glob patterns, relative to the mount path, of the files pulled into the cache after the mount.
+	Prewarm []string `json:"prewarm,omitempty"`
+	// Subpath is the directory of the volume mounted at the mount path instead of the whole volume.
+	Subpath string `json:"subpath,omitempty"`
 }
 
 func (s *Sandbox) initEnvd(ctx context.Context) (e error) {
@@ -140,6 +206,7 @@ func (s *Sandbox) initEnvd(ctx context.Context) (e error) {
 		s.Config.Envd.AccessToken,
 		s.internalConfig.EnvdInitRequestTimeout,
 		s.Config.Envd.Vars,
+		s.Config.Envd.Secrets,
 		s.Runtime.SandboxID,
 		s.Config.Envd.Version,
 		hyperloopIP,
@@ -167,7 +234,13 @@ func (s *Sandbox) initEnvd(ctx context.Context) (e error) {
 		return fmt.Errorf("failed to read envd init response body: %w", err)
 	}
 
-	if response.StatusCode != http.StatusNoContent {
+	switch response.StatusCode {
+	case http.StatusOK:
+		s.recordEnvdInitAck(ctx, span, body)
+	case http.StatusNoContent:
+		// Older envd versions don't acknowledge which fields were applied.
+		span.SetAttributes(attribute.Bool("envd.init.acknowledged", false))
+	default:
 		logger.L().Error(ctx, "envd init request failed",
 			logger.WithSandboxID(s.Runtime.SandboxID),
 			logger.WithEnvdVersion(s.Config.Envd.Version),
@@ -183,6 +256,91 @@ func (s *Sandbox) initEnvd(ctx context.Context) (e error) {
 	return nil
 }
 
+// InitResponse is the acknowledgement returned by envd for the /init request.
+type InitResponse struct {
+	// Applied lists the request fields envd applied.
+	Applied []string `json:"applied"`
+	// Ignored lists the request fields envd did not recognize or skipped.
+	Ignored []string `json:"ignored"`
+	// Warnings lists non-fatal problems envd hit while applying the configuration.
+	Warnings []string `json:"warnings"`
+	// VolumeMountError is why the volume couldn't be mounted, set when envd started the sandbox without it.
+	VolumeMountError string `json:"volumeMountError,omitempty"`
+}
+
+// recordEnvdInitAck records the envd init acknowledgement on the span and logs
+// any configuration that was requested but not applied.
+func (s *Sandbox) recordEnvdInitAck(ctx context.Context, span trace.Span, body []byte) {
+	var ack InitResponse
+	if err := json.Unmarshal(body, &ack); err != nil {
+		logger.L().Warn(ctx, "failed to decode envd init response",
+			logger.WithSandboxID(s.Runtime.SandboxID),
+			logger.WithEnvdVersion(s.Config.Envd.Version),
+			zap.Error(err),
+		)
+		span.SetAttributes(attribute.Bool("envd.init.acknowledged", false))
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Bool("envd.init.acknowledged", true),
+		attribute.StringSlice("envd.init.applied", ack.Applied),
+		attribute.StringSlice("envd.init.ignored", ack.Ignored),
+	)
+
+	for _, warning := range ack.Warnings {
+		span.AddEvent("envd init warning", trace.WithAttributes(attribute.String("warning", warning)))
+	}
+
+	if ack.VolumeMountError != "" {
+		s.volumeMountError = ack.VolumeMountError
+		span.SetAttributes(attribute.String("envd.init.volume_mount_error", ack.VolumeMountError))
+	}
+
+	missing := missingInitFields(s.requestedInitFields(), ack.Applied)
+	if len(ack.Ignored) > 0 || len(ack.Warnings) > 0 || len(missing) > 0 {
+		logger.L().Warn(ctx, "envd did not fully apply init configuration",
+			logger.WithSandboxID(s.Runtime.SandboxID),
+			logger.WithEnvdVersion(s.Config.Envd.Version),
+			zap.Strings("ignored", ack.Ignored),
+			zap.Strings("not_applied", missing),
+			zap.Strings("warnings", ack.Warnings),
+		)
+	}
+}
+
+// requestedInitFields returns the configuration fields sent to envd that are expected to be applied.
+func (s *Sandbox) requestedInitFields() []string {
+	fields := []string{"envVars"}
+	if len(s.Config.Envd.Secrets) > 0 {
+		fields = append(fields, "secrets")
+	}
+	if s.Config.Envd.DefaultUser != nil && *s.Config.Envd.DefaultUser != "" {
+		fields = append(fields, "defaultUser")
+	}
+	if s.Config.Envd.DefaultWorkdir != nil && *s.Config.Envd.DefaultWorkdir != "" {
+		fields = append(fields, "defaultWorkdir")
+	}
+	if s.volumeInitConfig != nil {
+		fields = append(fields, "volume")
+	}
+
+	return fields
+}
+
+// missingInitFields returns the requested fields that are not in the applied list.
+func missingInitFields(requested, applied []string) []string {
+	var missing []string
+	for _, field := range requested {
+		if !slices.Contains(applied, field) {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
 const (
 	// shutdownTimeout is the maximum time to wait for the envd shutdown endpoint.
 	// JuiceFS has a 300MB write buffer that needs to be flushed.
@@ -207,8 +365,8 @@ func (s *Sandbox) callEnvdShutdown(ctx context.Context) error {
 	}
 
 	// Include access token if set
-	if s.Config.Envd.AccessToken != nil {
-		request.Header.Set("X-Access-Token", *s.Config.Envd.AccessToken)
+	if authToken := envdAuthToken(s.Config.Envd.AccessToken, s.Config.Envd.Version); authToken != nil {
+		request.Header.Set("X-Access-Token", *authToken)
 	}
 
 	response, err := sandboxHttpClient.Do(request)
@@ -219,7 +377,8 @@ func (s *Sandbox) callEnvdShutdown(ctx context.Context) error {
 
 	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(response.Body)
-		return fmt.Errorf("envd shutdown returned status %d: %s", response.StatusCode, string(body))
+
+		return &envdMountStatusError{endpoint: "shutdown", statusCode: response.StatusCode, err: errors.New(string(body))}
 	}
 
 	logger.L().Info(ctx, "envd shutdown completed successfully",
@@ -228,3 +387,63 @@ func (s *Sandbox) callEnvdShutdown(ctx context.Context) error {
 
 	return nil
 }
+
+// UnmountVolume flushes and unmounts the volume of the sandbox through envd before the sandbox is
+// stopped or paused. Only the first call unmounts, the later ones return its result. When envd doesn't
+// unmount the volume within the shutdown timeout, the sandbox is killed anyway and the failure is
+// recorded as a volume unmount failed event.
+func (s *Sandbox) UnmountVolume(ctx context.Context) error {
+	return s.volumeUnmount.GetOrInit(func() error {
+		volume := s.Config.Volume
+		if volume == nil {
+			return nil
+		}
+
+		err := s.callEnvdShutdown(ctx)
+		if err == nil {
+			return nil
+		}
+
+		logger.L().Warn(ctx, "failed to unmount the volume, volume data may be lost",
+			zap.Error(err),
+			logger.WithSandboxID(s.Runtime.SandboxID),
+			zap.String("volume_id", volume.GetVolumeId()),
+		)
+
+		// Envd responding with an error published the failure itself
+		var statusErr *envdMountStatusError
+		if !errors.As(err, &statusErr) || !s.EnvdPublishesVolumeEvents() {
+			s.publishVolumeUnmountFailedEvent(ctx, volume, err)
+		}
+
+		return fmt.Errorf("unmount volume %s: %w", volume.GetVolumeId(), err)
+	})
+}
+
+// publishVolumeUnmountFailedEvent records that the volume wasn't unmounted before the sandbox stopped.
+func (s *Sandbox) publishVolumeUnmountFailedEvent(ctx context.Context, volume *orchestrator.VolumeConfig, unmountErr error) {
+	if s.volumeEvents == nil {
+		return
+	}
+
+	teamID, err := uuid.Parse(s.Runtime.TeamID)
+	if err != nil {
+		logger.L().Error(ctx, "error parsing team ID", logger.WithSandboxID(s.Runtime.SandboxID), zap.String("team_id", s.Runtime.TeamID), zap.Error(err))
+
+		return
+	}
+
+	code := "unmount_failed"
+	if errors.Is(unmountErr, context.DeadlineExceeded) {
+		code = "unmount_timeout"
+	}
+
+	go s.volumeEvents.Publish(
+		context.WithoutCancel(ctx),
+		teamID,
+		events.NewVolumeEvent(events.SandboxShutdownVolumeUnmountFailedEvent, volume.GetVolumeId()).
+			WithSandboxContext(s.Runtime.SandboxID, s.Runtime.ExecutionID, teamID).
+			WithMountPath(volume.GetMountPath()).
+			WithError(unmountErr.Error(), code),
+	)
+}
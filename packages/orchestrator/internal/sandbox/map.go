@@ -71,6 +71,17 @@ func (m *Map) Insert(sbx *Sandbox) {
 	})
 }
 
+// Rename moves the sandbox stored under oldSandboxID to its current sandbox ID.
+func (m *Map) Rename(oldSandboxID string, sbx *Sandbox) {
+	m.sandboxes.Insert(sbx.Runtime.SandboxID, sbx)
+	m.sandboxes.Remove(oldSandboxID)
+
+	go m.trigger(func(s MapSubscriber) {
+		s.OnRemove(oldSandboxID)
+		s.OnInsert(sbx)
+	})
+}
+
 func (m *Map) Remove(sandboxID string) {
 	m.sandboxes.Remove(sandboxID)
 
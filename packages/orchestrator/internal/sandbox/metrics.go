@@ -22,6 +22,9 @@ type Metrics struct {
 	DiskUsed  int64 `json:"disk_used"`  // Used disk space in bytes
 	DiskTotal int64 `json:"disk_total"` // Total disk space in bytes
 
+	Volume  *VolumeMetrics            `json:"volume,omitempty"`  // Usage of the first volume mounted, unset without a volume or with older envd
+	Volumes map[string]*VolumeMetrics `json:"volumes,omitempty"` // Usage of the mounted volumes by volume ID, unset with older envd
+
 	// Deprecated
 	MemTotalMiB int64 `json:"mem_total_mib"` // Total virtual memory in MiB
 
@@ -29,6 +32,17 @@ type Metrics struct {
 	MemUsedMiB int64 `json:"mem_used_mib"` // Used virtual memory in MiB
 }
 
+// VolumeMetrics is the usage of the volume mounted in the sandbox, as seen by envd.
+type VolumeMetrics struct {
+	Used  int64 `json:"used"`  // Used space of the volume in bytes
+	Files int64 `json:"files"` // Number of files and directories of the volume
+
+	ReadBps  float64 `json:"read_bps"`  // Bytes read per second since the previous metrics
+	WriteBps float64 `json:"write_bps"` // Bytes written per second since the previous metrics
+
+	LastSync int64 `json:"last_sync,omitempty"` // Unix timestamp of the last time no written data waited for upload
+}
+
 func (c *Checks) GetMetrics(ctx context.Context, timeout time.Duration) (*Metrics, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -40,8 +54,8 @@ func (c *Checks) GetMetrics(ctx context.Context, timeout time.Duration) (*Metric
 		return nil, err
 	}
 
-	if c.sandbox.Config.Envd.AccessToken != nil {
-		request.Header.Set("X-Access-Token", *c.sandbox.Config.Envd.AccessToken)
+	if authToken := envdAuthToken(c.sandbox.Config.Envd.AccessToken, c.sandbox.Config.Envd.Version); authToken != nil {
+		request.Header.Set("X-Access-Token", *authToken)
 	}
 
 	response, err := sandboxHttpClient.Do(request)
@@ -43,6 +43,8 @@ type Firewall struct {
 	useTCPFirewall bool
 	// tcpFirewallSkipRule allows us to add/remove the rule dynamically by marking all TCP packets.
 	tcpFirewallSkipRule *nftables.Rule
+	// dnsRules accept DNS queries before the deny rules, they are redirected to the DNS firewall on the host.
+	dnsRules []*nftables.Rule
 
 	tapInterface string
 
@@ -299,6 +301,9 @@ func (fw *Firewall) Reset() error {
 	if err := fw.SetTCPFirewall(defaultUseTCPFirewall); err != nil {
 		return fmt.Errorf("clear TCP firewall: %w", err)
 	}
+	if err := fw.SetDNSFirewall(false); err != nil {
+		return fmt.Errorf("clear DNS firewall: %w", err)
+	}
 	if err := fw.ResetDeniedSets(); err != nil {
 		return fmt.Errorf("clear denied set: %w", err)
 	}
@@ -395,6 +400,79 @@ func (fw *Firewall) SetTCPFirewall(useTCPFirewall bool) error {
 	return nil
 }
 
+// SetDNSFirewall controls whether DNS queries (UDP and TCP port 53) are accepted regardless of the
+// destination, so the host can redirect them to the DNS firewall. The rules are inserted at the
+// beginning of the filter chain (before deny rules).
+func (fw *Firewall) SetDNSFirewall(useDNSFirewall bool) error {
+	if !useDNSFirewall {
+		if len(fw.dnsRules) == 0 {
+			return nil
+		}
+
+		for _, rule := range fw.dnsRules {
+			if err := fw.conn.DelRule(rule); err != nil {
+				return fmt.Errorf("delete DNS rule: %w", err)
+			}
+		}
+		if err := fw.conn.Flush(); err != nil {
+			return fmt.Errorf("flush delete DNS rules: %w", err)
+		}
+		fw.dnsRules = nil
+
+		return nil
+	}
+
+	if len(fw.dnsRules) > 0 {
+		return nil
+	}
+
+	protocols := []byte{unix.IPPROTO_UDP, unix.IPPROTO_TCP}
+	for _, protocol := range protocols {
+		fw.conn.InsertRule(&nftables.Rule{
+			Table: fw.table,
+			Chain: fw.filterChain,
+			Exprs: append(append(fw.tapIfaceMatch(),
+				// Match protocol
+				&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+				&expr.Cmp{
+					Op:       expr.CmpOpEq,
+					Register: 1,
+					Data:     []byte{protocol},
+				},
+				// Match destination port
+				&expr.Payload{
+					DestRegister: 1,
+					Base:         expr.PayloadBaseTransportHeader,
+					Offset:       2, // UDP and TCP destination port offset
+					Len:          2,
+				},
+				&expr.Cmp{
+					Op:       expr.CmpOpEq,
+					Register: 1,
+					Data:     binaryutil.BigEndian.PutUint16(53),
+				}),
+				markAndAccept()...,
+			),
+		})
+	}
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("flush add DNS rules: %w", err)
+	}
+
+	// Retrieve the rules from the kernel to get their Handles (required for DelRule)
+	rules, err := fw.conn.GetRules(fw.table, fw.filterChain)
+	if err != nil {
+		return fmt.Errorf("get rules after adding DNS rules: %w", err)
+	}
+	if len(rules) < len(protocols) {
+		return fmt.Errorf("no rules found after adding DNS rules")
+	}
+	// The rules we just inserted are the first ones in the chain
+	fw.dnsRules = rules[:len(protocols)]
+
+	return nil
+}
+
 // AllowTCPPort adds a rule to allow TCP traffic to a specific IP:port.
 // This is used to allow sandbox access to volume proxies (GCS, Redis) on the veth interface.
 // The rule is inserted at the beginning of the filter chain (before deny rules).
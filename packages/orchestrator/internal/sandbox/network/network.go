@@ -250,6 +250,13 @@ func (s *Slot) RemoveNetwork() error {
 		errs = append(errs, fmt.Errorf("error closing firewall: %w", err))
 	}
 
+	if s.dnsFirewall.CompareAndSwap(true, false) {
+		err = s.redirectDNS(false)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error removing DNS redirect: %w", err))
+		}
+	}
+
 	tables, err := iptables.New()
 	if err != nil {
 		errs = append(errs, fmt.Errorf("error initializing iptables: %w", err))
@@ -57,6 +57,9 @@ type Config struct {
 
 	// SandboxTCPFirewallPort is the port to redirect TCP traffic to for egress filtering
 	SandboxTCPFirewallPort uint16 `env:"SANDBOX_TCP_FIREWALL_PORT" envDefault:"5016"`
+
+	// SandboxDNSPort is the port to redirect DNS queries to for sandboxes with domain egress rules
+	SandboxDNSPort uint16 `env:"SANDBOX_DNS_PORT" envDefault:"5019"`
 }
 
 func ParseConfig() (Config, error) {
@@ -2,14 +2,17 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/coreos/go-iptables/iptables"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -17,6 +20,7 @@ import (
 
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/env"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
 )
 
 var tracer = otel.Tracer("github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/network")
@@ -33,6 +37,9 @@ const (
 	tapInterfaceName = "tap0"
 	tapIp            = "169.254.0.22"
 	tapMAC           = "02:FC:00:00:00:05"
+
+	// maxResolvedIPs bounds the addresses allowed by the DNS firewall for a single sandbox.
+	maxResolvedIPs = 4096
 )
 
 var (
@@ -63,6 +70,12 @@ type Slot struct {
 
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
+	// dnsFirewall is used to track if DNS queries are redirected to the DNS firewall and need a cleanup.
+	dnsFirewall atomic.Bool
+
+	// resolvedIPs holds the addresses the DNS firewall resolved for allowed domains.
+	resolvedMu  sync.Mutex
+	resolvedIPs map[string]struct{}
 
 	vPeerIp net.IP
 	vEthIp  net.IP
@@ -80,6 +93,7 @@ type Slot struct {
 	hyperloopIP, hyperloopPort string
 
 	tcpFirewallPort string
+	dnsPort         string
 }
 
 func NewSlot(key string, idx int, config Config) (*Slot, error) {
@@ -139,6 +153,9 @@ func NewSlot(key string, idx int, config Config) (*Slot, error) {
 		hyperloopPort: strconv.FormatUint(uint64(config.HyperloopProxyPort), 10),
 
 		tcpFirewallPort: strconv.FormatUint(uint64(config.SandboxTCPFirewallPort), 10),
+		dnsPort:         strconv.FormatUint(uint64(config.SandboxDNSPort), 10),
+
+		resolvedIPs: make(map[string]struct{}),
 	}
 
 	return slot, nil
@@ -270,13 +287,17 @@ func (s *Slot) ConfigureInternet(ctx context.Context, network *orchestrator.Sand
 	defer span.End()
 
 	egress := network.GetEgress()
-	if len(egress.GetAllowedCidrs()) == 0 && len(egress.GetDeniedCidrs()) == 0 && len(egress.GetAllowedDomains()) == 0 {
+	if len(egress.GetAllowedCidrs()) == 0 && len(egress.GetDeniedCidrs()) == 0 &&
+		len(egress.GetAllowedDomains()) == 0 && len(egress.GetDeniedDomains()) == 0 {
 		// Internet access is allowed by default.
 		return nil
 	}
 
 	s.firewallCustomRules.Store(true)
 
+	// Domain rules are enforced on DNS too, the queries are answered by the DNS firewall on the host
+	useDNSFirewall := len(egress.GetAllowedDomains()) > 0 || len(egress.GetDeniedDomains()) > 0
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -303,15 +324,103 @@ func (s *Slot) ConfigureInternet(ctx context.Context, network *orchestrator.Sand
 			}
 		}
 
+		if useDNSFirewall {
+			err = s.Firewall.SetDNSFirewall(true)
+			if err != nil {
+				return fmt.Errorf("error setting DNS firewall: %w", err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
 	}
 
+	if useDNSFirewall {
+		s.dnsFirewall.Store(true)
+
+		err = s.redirectDNS(true)
+		if err != nil {
+			return fmt.Errorf("error redirecting DNS queries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// redirectDNS adds or removes the host rules redirecting DNS queries of the sandbox to the DNS firewall.
+// Queries to any resolver are redirected, the sandbox keeps its resolver configuration.
+func (s *Slot) redirectDNS(enabled bool) error {
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	var errs []error
+	for _, protocol := range []string{"udp", "tcp"} {
+		rule := []string{
+			"-i", s.VethName(),
+			"-p", protocol, "--dport", "53",
+			"-j", "REDIRECT", "--to-port", s.dnsPort,
+		}
+
+		if enabled {
+			// Inserted first, so TCP queries are not redirected to the egress proxy
+			err = tables.Insert("nat", "PREROUTING", 1, rule...)
+		} else {
+			err = tables.DeleteIfExists("nat", "PREROUTING", rule...)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error updating %s DNS redirect rule: %w", protocol, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AllowResolvedIPs allows egress to the addresses the DNS firewall resolved for an allowed domain,
+// until the internet configuration of the slot is reset. The nftables connection of the firewall
+// is bound to the slot namespace, so it can be used from the host namespace.
+func (s *Slot) AllowResolvedIPs(ips []net.IP) error {
+	s.resolvedMu.Lock()
+	defer s.resolvedMu.Unlock()
+
+	for _, ip := range ips {
+		key := ip.String()
+		if _, ok := s.resolvedIPs[key]; ok {
+			continue
+		}
+
+		if len(s.resolvedIPs) >= maxResolvedIPs {
+			return fmt.Errorf("resolved addresses limit of %d reached for slot %s", maxResolvedIPs, s.Key)
+		}
+
+		err := s.Firewall.AddAllowedCIDR(sandbox_network.AddressStringToCIDR(key))
+		if err != nil {
+			return fmt.Errorf("error allowing resolved address %s: %w", key, err)
+		}
+
+		s.resolvedIPs[key] = struct{}{}
+	}
+
 	return nil
 }
 
+// IsResolvedIP checks if the address was resolved for an allowed domain by the DNS firewall.
+func (s *Slot) IsResolvedIP(ip net.IP) bool {
+	if s == nil {
+		return false
+	}
+
+	s.resolvedMu.Lock()
+	defer s.resolvedMu.Unlock()
+
+	_, ok := s.resolvedIPs[ip.String()]
+
+	return ok
+}
+
 func (s *Slot) ResetInternet(ctx context.Context) error {
 	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
@@ -322,6 +431,17 @@ func (s *Slot) ResetInternet(ctx context.Context) error {
 		return nil
 	}
 
+	if s.dnsFirewall.CompareAndSwap(true, false) {
+		err := s.redirectDNS(false)
+		if err != nil {
+			return fmt.Errorf("error removing DNS redirect: %w", err)
+		}
+	}
+
+	s.resolvedMu.Lock()
+	clear(s.resolvedIPs)
+	s.resolvedMu.Unlock()
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -1,10 +1,13 @@
 package sandbox
 
 import (
+	"cmp"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +18,8 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/cfg"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/gcsproxy"
@@ -29,6 +34,8 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/uffd"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/template/metadata"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/volumeproxy"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
 	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
@@ -37,6 +44,7 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/storage/header"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 var (
@@ -84,6 +92,9 @@ type EnvdMetadata struct {
 	DefaultWorkdir *string
 	AccessToken    *string
 	Version        string
+
+	// Secrets are injected into the processes like Vars, but envd keeps them out of its env endpoint.
+	Secrets map[string]string
 }
 
 type RuntimeMetadata struct {
@@ -136,11 +147,33 @@ type Sandbox struct {
 	// This is set during ResumeSandbox if a volume is configured.
 	volumeInitConfig *InitVolumeConfig
 
+	// volumeProxies supervises the proxies of the volume, nil without them.
+	volumeProxies *volumeproxy.Manager
+
+	// volumeMountError is why envd started the sandbox without its volume, empty when it was mounted.
+	volumeMountError string
+
+	// volumeUnmount is the result of unmounting the volume before the sandbox stopped or paused.
+	volumeUnmount utils.Lazy[error]
+	// volumeEvents records the volume unmounts that failed, nil when the events aren't published.
+	volumeEvents VolumeEventsPublisher
+
 	exit *utils.ErrorOnce
 
 	stop utils.Lazy[error]
 }
 
+// VolumeProxies returns the health of the proxies of the volume of the sandbox.
+func (s *Sandbox) VolumeProxies() []volumeproxy.Status {
+	return s.volumeProxies.Status()
+}
+
+// VolumeMountError returns why the volume of the sandbox couldn't be mounted when envd started the
+// sandbox without it, empty when the volume is mounted.
+func (s *Sandbox) VolumeMountError() string {
+	return s.volumeMountError
+}
+
 func (s *Sandbox) LoggerMetadata() sbxlogger.SandboxMetadata {
 	return sbxlogger.SandboxMetadata{
 		SandboxID:  s.Runtime.SandboxID,
@@ -156,18 +189,64 @@ type networkSlotRes struct {
 
 // VolumesConfig contains configuration for volume proxy connections.
 type VolumesConfig struct {
-	// RedisURL is the upstream Redis cluster URL for volumes metadata.
+	// RedisURL is the upstream Redis URL for the metadata of the volumes with Redis metadata, the
+	// redis+sentinel scheme finds the master with Redis Sentinel. Redis Cluster isn't supported, the
+	// volumes are separated by their database.
 	RedisURL string
 	// RedisTLSCA is the base64-encoded TLS CA certificate for Redis.
 	RedisTLSCA string
 	// RedisPassword is the password for Redis ACL authentication.
 	RedisPassword string
-	// GCSBucket is the GCS bucket name for volume data storage.
-	// Used for minting downscoped tokens.
+	// RedisPoolSize is the number of Redis connections shared by the connections of each sandbox,
+	// a connection per sandbox connection when zero.
+	RedisPoolSize int
+	// GCSBucket is the shared GCS bucket name for volume data storage.
+	// Downscoped tokens are minted when it's set, for the bucket the API sends with each volume.
 	GCSBucket string
 	// TokenMinterSA is the service account email to impersonate for token minting.
-	// If empty, uses the VM's default service account.
+	// If empty, the tokens are downscoped from the minter credentials.
 	TokenMinterSA string
+	// TokenMinterCredentialsFile is a service account key or workload identity federation config
+	// the minter authenticates with. If empty, uses the Application Default Credentials.
+	TokenMinterCredentialsFile string
+	// TokenLifetime is the lifetime of the minted tokens, the default of the minter when zero.
+	TokenLifetime time.Duration
+	// CheckpointInterval is the interval between the checkpoints of the metadata the volumes
+	// replicate to the bucket, the envd default when zero.
+	CheckpointInterval time.Duration
+	// MountAttempts is how many times envd attempts a mount failing transiently, the envd default when zero.
+	MountAttempts int
+	// AllowMountFailure starts the sandboxes without their volume when it can't be mounted,
+	// instead of failing them. A sandbox can also allow it for itself in its volume config.
+	AllowMountFailure bool
+	// GCSEndpoint is the URL of the GCS API for volume data. No tokens are minted for
+	// an emulator or another custom endpoint.
+	GCSEndpoint string
+	// GCSProxyBytesPerSecond and GCSProxyRequestsPerSecond limit the requests of each sandbox volume
+	// through the GCS proxy when the volume has no limits of its own, unlimited when zero.
+	GCSProxyBytesPerSecond    int64
+	GCSProxyRequestsPerSecond int64
+	// GCSProxyDownscopedTokens makes the GCS proxy use the downscoped tokens of the volumes, the
+	// credentials of the node never reach the requests of the sandboxes.
+	GCSProxyDownscopedTokens bool
+	// StorageProvider is the object storage of the volume data on this node.
+	StorageProvider volumestorage.Provider
+	// S3Region is the region of the volume buckets on S3.
+	S3Region string
+	// S3RoleARN is the role assumed to mint the session credentials of the volumes on S3.
+	S3RoleARN string
+	// S3Endpoint is the URL of an S3 compatible storage used instead of AWS S3, like MinIO.
+	// It must be reachable from inside the sandboxes.
+	S3Endpoint string
+	// S3AccessKeyID and S3SecretAccessKey are handed out to all volumes instead of minting session
+	// credentials, for S3 compatible storage without STS. Only for development and CI.
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+// VolumeEventsPublisher publishes the volume events of the sandboxes.
+type VolumeEventsPublisher interface {
+	Publish(ctx context.Context, teamID uuid.UUID, event events.VolumeEvent)
 }
 
 type Factory struct {
@@ -176,8 +255,9 @@ type Factory struct {
 	devicePool   *nbd.DevicePool
 	featureFlags *featureflags.Client
 	volumes      *VolumesConfig
-	tokenMinter  *gcstoken.Minter
+	tokenMinter  gcstoken.TokenMinter
 	sandboxes    *Map
+	volumeEvents VolumeEventsPublisher
 }
 
 func NewFactory(
@@ -196,13 +276,180 @@ func NewFactory(
 	}
 }
 
+// SetVolumeEvents sets the publisher of the volume events of the sandboxes the factory creates.
+func (f *Factory) SetVolumeEvents(volumeEvents VolumeEventsPublisher) {
+	f.volumeEvents = volumeEvents
+}
+
 // SetVolumesConfig configures the factory for volume support.
 // This is separate from NewFactory to maintain backward compatibility.
-func (f *Factory) SetVolumesConfig(cfg *VolumesConfig) {
+// The token minter pre-warms its base token until Close.
+func (f *Factory) SetVolumesConfig(ctx context.Context, cfg *VolumesConfig) {
 	f.volumes = cfg
-	if cfg.GCSBucket != "" {
-		f.tokenMinter = gcstoken.NewMinter(cfg.GCSBucket, cfg.TokenMinterSA)
+
+	switch {
+	case cfg.StorageProvider == volumestorage.S3 && cfg.S3AccessKeyID != "":
+		minter, err := gcstoken.NewStaticMinter(cfg.S3AccessKeyID, cfg.S3SecretAccessKey)
+		if err != nil {
+			logger.L().Error(ctx, "failed to create static S3 credentials minter, volumes can't be mounted", zap.Error(err))
+
+			return
+		}
+
+		logger.L().Warn(ctx, "volumes get static S3 credentials, they aren't limited to the volume")
+		f.tokenMinter = minter
+	case cfg.StorageProvider == volumestorage.S3:
+		minter, err := gcstoken.NewS3Minter(ctx, cfg.S3RoleARN, cfg.S3Region)
+		if err != nil {
+			// There is no proxy for S3, volumes can't be mounted without the credentials
+			logger.L().Error(ctx, "failed to create S3 credentials minter, volumes can't be mounted", zap.Error(err))
+
+			return
+		}
+
+		f.tokenMinter = minter
+	case cfg.GCSBucket != "" && !storage.IsCustomGCSEndpoint(cfg.GCSEndpoint):
+		minter, err := gcstoken.NewMinter(ctx, cfg.TokenMinterSA, cfg.TokenMinterCredentialsFile)
+		if err != nil {
+			// Without the minter, envd goes through the GCS proxy of the sandbox, which can't get
+			// the tokens either when it only uses downscoped ones
+			logger.L().Error(ctx, "failed to create GCS token minter, volumes use the GCS proxy", zap.Error(err), zap.Bool("proxy_downscoped_tokens", cfg.GCSProxyDownscopedTokens))
+
+			return
+		}
+
+		f.tokenMinter = minter
+	default:
+		return
+	}
+
+	f.tokenMinter.Start(ctx)
+}
+
+// Close stops the background work of the factory.
+func (f *Factory) Close(ctx context.Context) error {
+	if f.tokenMinter != nil {
+		return f.tokenMinter.Close(ctx)
 	}
+
+	return nil
+}
+
+// UnmountVolumes flushes and unmounts the volumes of the running sandboxes when the node is drained.
+// The sandboxes don't outlive the orchestrator, the writes envd hasn't flushed would be lost.
+func (f *Factory) UnmountVolumes(ctx context.Context) error {
+	if f.sandboxes == nil {
+		return nil
+	}
+
+	var mu sync.Mutex
+	var errs []error
+
+	var wg sync.WaitGroup
+	for _, sbx := range f.sandboxes.Items() {
+		if sbx.Config.Volume == nil {
+			continue
+		}
+
+		wg.Go(func() {
+			if err := sbx.UnmountVolume(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("sandbox %s: %w", sbx.Runtime.SandboxID, err))
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// newVolumeInitConfig returns the config envd mounts the volume with, with a downscoped token
+// for the volume when tokens are minted. A failure to mint the token is logged, envd then goes
+// through the GCS proxy of the sandbox. Volumes on S3 can't be mounted without a token.
+func (f *Factory) newVolumeInitConfig(ctx context.Context, volume *orchestrator.VolumeConfig) *InitVolumeConfig {
+	volumeInitConfig := &InitVolumeConfig{
+		VolumeID:     volume.GetVolumeId(),
+		MountPath:    volume.GetMountPath(),
+		GCSBucket:    volume.GetGcsBucket(),
+		ReadOnlyRoot: volume.GetReadOnlyRoot(),
+		OverlayPaths: volume.GetOverlayPaths(),
+		ReadOnly:     volume.GetReadOnly(),
+
+		MountMemoryMB:  volume.GetMountMemoryMb(),
+		MountCPUWeight: volume.GetMountCpuWeight(),
+		MountOptions:   volume.GetMountOptions(),
+		PersistHome:    volume.GetPersistHome(),
+		Prewarm:        volume.GetPrewarm(),
+		Subpath:        volume.GetSubpath(),
+
+		CheckpointIntervalSeconds: int64(f.volumes.CheckpointInterval.Seconds()),
+		MountAttempts:             f.volumes.MountAttempts,
+		AllowMountFailure:         f.volumes.AllowMountFailure || volume.GetAllowMountFailure(),
+	}
+	if volume.GetMetadataEngine() == string(volumestorage.MetaEngineRedis) {
+		volumeInitConfig.MetaEngine = volumestorage.MetaEngineRedis
+	}
+	if f.volumes.StorageProvider == volumestorage.S3 {
+		volumeInitConfig.StorageProvider = volumestorage.S3
+		volumeInitConfig.S3Region = f.volumes.S3Region
+		volumeInitConfig.S3Endpoint = f.volumes.S3Endpoint
+	} else if storage.IsCustomGCSEndpoint(f.volumes.GCSEndpoint) {
+		volumeInitConfig.GCSEndpoint = f.volumes.GCSEndpoint
+	}
+
+	// Mint downscoped token for this volume
+	if f.tokenMinter != nil {
+		token, err := f.tokenMinter.MintDownscopedToken(ctx, volume.GetGcsBucket(), volume.GetVolumeId(), f.volumeTokenOptions(volume))
+		if err != nil {
+			logger.L().Warn(ctx, "failed to mint volume token",
+				zap.Error(err),
+				zap.String("volume_id", volume.GetVolumeId()),
+			)
+		} else {
+			volumeInitConfig.GCSToken = token.AccessToken
+			volumeInitConfig.GCSTokenExpiry = token.ExpiresAt.Unix()
+			logger.L().Info(ctx, "minted downscoped volume token",
+				zap.String("volume_id", volume.GetVolumeId()),
+				zap.Int("expires_in_seconds", token.ExpiresIn),
+			)
+			telemetry.ReportEvent(ctx, "minted volume token")
+		}
+	}
+
+	return volumeInitConfig
+}
+
+// volumeTokenOptions returns the options of the tokens minted for the volume, read-only mounts
+// only get to read the objects of the volume.
+func (f *Factory) volumeTokenOptions(volume *orchestrator.VolumeConfig) gcstoken.MintOptions {
+	role := gcstoken.RoleObjectAdmin
+	if volume.GetReadOnly() {
+		role = gcstoken.RoleObjectViewer
+	}
+
+	return gcstoken.MintOptions{
+		Role:     role,
+		Lifetime: f.volumes.TokenLifetime,
+	}
+}
+
+// gcsProxyTokenSource returns the downscoped tokens of the volume for its GCS proxy, starting with
+// the token minted for envd and minting new ones before they expire.
+func (f *Factory) gcsProxyTokenSource(ctx context.Context, volume *orchestrator.VolumeConfig, volumeInitConfig *InitVolumeConfig) (oauth2.TokenSource, error) {
+	if f.tokenMinter == nil {
+		return nil, fmt.Errorf("no token minter for the GCS proxy of volume %s", volume.GetVolumeId())
+	}
+
+	var initial *gcstoken.Token
+	if volumeInitConfig.GCSToken != "" {
+		initial = &gcstoken.Token{
+			AccessToken: volumeInitConfig.GCSToken,
+			ExpiresAt:   time.Unix(volumeInitConfig.GCSTokenExpiry, 0),
+		}
+	}
+
+	return gcstoken.NewVolumeTokenSource(ctx, f.tokenMinter, volume.GetGcsBucket(), volume.GetVolumeId(), f.volumeTokenOptions(volume), initial), nil
 }
 
 // CreateSandbox creates the sandbox.
@@ -358,7 +605,9 @@ func (f *Factory) CreateSandbox(
 
 		cleanup: cleanup,
 
-		APIStoredConfig: apiConfigToStore,
+		APIStoredConfig: withoutSecrets(apiConfigToStore),
+
+		volumeEvents: f.volumeEvents,
 
 		exit: exit,
 	}
@@ -548,73 +797,104 @@ func (f *Factory) ResumeSandbox(
 	// Prepare volume config for passing to envd via /init request
 	// Volume is now mounted synchronously in envd during /init instead of async via MMDS
 	var volumeInitConfig *InitVolumeConfig
+	var volumeProxies *volumeproxy.Manager
 	if config.Volume != nil && f.volumes != nil {
 		vethIP := ips.slot.VethIP().String()
 
-		// Prepare volume init config for passing to envd via /init request
-		volumeInitConfig = &InitVolumeConfig{
-			VolumeID:  config.Volume.GetVolumeId(),
-			MountPath: config.Volume.GetMountPath(),
-			GCSBucket: config.Volume.GetGcsBucket(),
+		if err := f.checkVolumeStorageSupported(config.Envd.Version, config.Volume); err != nil {
+			return nil, err
 		}
 
-		// Mint downscoped GCS token for this volume
-		if f.tokenMinter != nil {
-			token, err := f.tokenMinter.MintDownscopedToken(ctx, config.Volume.GetVolumeId())
+		// The volume was unmounted when the sandbox was paused, it's mounted with a new token
+		volumeInitConfig = f.newVolumeInitConfig(ctx, config.Volume)
+
+		// The proxies are restarted when they crash, and stopped with their connections with the sandbox
+		volumeProxies = volumeproxy.NewManager(execCtx, runtime.SandboxID, logger.L())
+		cleanup.Add(ctx, volumeProxies.Close)
+
+		// Only JuiceFS gets the token of the proxies, the other processes of the sandbox reach them too.
+		// The Redis proxy lets the clients of older envd versions in without it, the GCS proxy never
+		// does: it injects the credentials of the volume, and no envd version sends its requests through it.
+		volumeInitConfig.ProxyToken = rand.Text()
+
+		var gcsProxyPort uint16
+		if volumeInitConfig.StorageProvider == volumestorage.S3 {
+			if volumeInitConfig.GCSToken == "" {
+				return nil, fmt.Errorf("no S3 credentials for volume %s", config.Volume.GetVolumeId())
+			}
+		} else {
+			// Start GCS proxy for this sandbox (still needed until envd uses token directly)
+			gcsProxyCfg := gcsproxy.Config{
+				ListenAddr: fmt.Sprintf("%s:%d", vethIP, gcsproxy.Port),
+				VolumeID:   config.Volume.GetVolumeId(),
+				SandboxID:  runtime.SandboxID,
+				TeamID:     runtime.TeamID,
+				Bucket:     config.Volume.GetGcsBucket(),
+				Endpoint:   f.volumes.GCSEndpoint,
+
+				BytesPerSecond:    cmp.Or(config.Volume.GetBandwidthBytesPerSecond(), f.volumes.GCSProxyBytesPerSecond),
+				RequestsPerSecond: cmp.Or(config.Volume.GetRequestsPerSecond(), f.volumes.GCSProxyRequestsPerSecond),
+
+				ClientToken: volumeInitConfig.ProxyToken,
+			}
+			if f.volumes.GCSProxyDownscopedTokens && !storage.IsCustomGCSEndpoint(f.volumes.GCSEndpoint) {
+				gcsProxyCfg.TokenSource, err = f.gcsProxyTokenSource(ctx, config.Volume, volumeInitConfig)
+				if err != nil {
+					return nil, err
+				}
+			}
+			err = volumeProxies.Start(ctx, "gcs", func() (volumeproxy.Proxy, error) {
+				return gcsproxy.New(gcsProxyCfg, logger.L())
+			})
 			if err != nil {
-				logger.L().Warn(ctx, "failed to mint GCS token, falling back to proxy",
-					zap.Error(err),
-					zap.String("volume_id", config.Volume.GetVolumeId()),
-				)
-			} else {
-				volumeInitConfig.GCSToken = token.AccessToken
-				volumeInitConfig.GCSTokenExpiry = token.ExpiresAt.Unix()
-				logger.L().Info(ctx, "minted downscoped GCS token",
-					zap.String("volume_id", config.Volume.GetVolumeId()),
-					zap.Int("expires_in_seconds", token.ExpiresIn),
-				)
-				telemetry.ReportEvent(ctx, "minted GCS token")
+				return nil, fmt.Errorf("failed to start GCS proxy: %w", err)
 			}
-		}
+			telemetry.ReportEvent(ctx, "started GCS proxy")
 
-		// Start GCS proxy for this sandbox (still needed until envd uses token directly)
-		gcsProxyCfg := gcsproxy.Config{
-			ListenAddr: fmt.Sprintf("%s:%d", vethIP, gcsproxy.Port),
-			VolumeID:   config.Volume.GetVolumeId(),
-			Bucket:     config.Volume.GetGcsBucket(),
+			gcsProxyPort = gcsproxy.Port
 		}
-		gcsProxy, err := gcsproxy.StartInNamespace(execCtx, gcsProxyCfg, logger.L())
-		if err != nil {
-			return nil, fmt.Errorf("failed to start GCS proxy: %w", err)
-		}
-		cleanup.Add(ctx, func(ctx context.Context) error {
-			return gcsProxy.Close()
-		})
-		telemetry.ReportEvent(ctx, "started GCS proxy")
-
-		// Start Redis proxy for this sandbox
-		redisProxyCfg := redisproxy.Config{
-			ListenAddr:  fmt.Sprintf("%s:%d", vethIP, redisproxy.Port),
-			UpstreamURL: f.volumes.RedisURL,
-			RedisDB:     int(config.Volume.GetRedisDb()),
-			Password:    f.volumes.RedisPassword,
-			TLSCABase64: f.volumes.RedisTLSCA,
-		}
-		redisProxy, err := redisproxy.StartInNamespace(execCtx, redisProxyCfg, logger.L())
-		if err != nil {
-			return nil, fmt.Errorf("failed to start Redis proxy: %w", err)
+
+		// Only the volumes with Redis metadata reach the volumes Redis, through a proxy authenticating
+		// as the user of their database
+		if volumeInitConfig.MetaEngine == volumestorage.MetaEngineRedis {
+			redisProxyCfg := redisproxy.Config{
+				ListenAddr:  fmt.Sprintf("%s:%d", vethIP, redisproxy.Port),
+				UpstreamURL: f.volumes.RedisURL,
+				VolumeID:    config.Volume.GetVolumeId(),
+				SandboxID:   runtime.SandboxID,
+				TeamID:      runtime.TeamID,
+				RedisDB:     int(config.Volume.GetRedisDb()),
+				Password:    f.volumes.RedisPassword,
+				TLSCABase64: f.volumes.RedisTLSCA,
+				PoolSize:    f.volumes.RedisPoolSize,
+			}
+			if envdAuthenticatesToVolumeProxies(config.Envd.Version) {
+				redisProxyCfg.ClientPassword = volumeInitConfig.ProxyToken
+			}
+			err = volumeProxies.Start(ctx, "redis", func() (volumeproxy.Proxy, error) {
+				return redisproxy.New(redisProxyCfg, logger.L()), nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to start Redis proxy: %w", err)
+			}
+			telemetry.ReportEvent(ctx, "started Redis proxy")
+
+			volumeInitConfig.RedisMetaURL, err = volumestorage.RedisMetaURL(fmt.Sprintf("redis://%s:%d", vethIP, redisproxy.Port), config.Volume.GetRedisDb())
+			if err != nil {
+				return nil, fmt.Errorf("failed to build Redis metadata URL: %w", err)
+			}
 		}
-		cleanup.Add(ctx, func(ctx context.Context) error {
-			return redisProxy.Close()
-		})
-		telemetry.ReportEvent(ctx, "started Redis proxy")
 
 		// Allow sandbox to reach the volume proxies through the firewall
-		if err := ips.slot.AllowProxyPort(gcsproxy.Port); err != nil {
-			return nil, fmt.Errorf("failed to allow GCS proxy port: %w", err)
+		if gcsProxyPort != 0 {
+			if err := ips.slot.AllowProxyPort(gcsProxyPort); err != nil {
+				return nil, fmt.Errorf("failed to allow GCS proxy port: %w", err)
+			}
 		}
-		if err := ips.slot.AllowProxyPort(redisproxy.Port); err != nil {
-			return nil, fmt.Errorf("failed to allow Redis proxy port: %w", err)
+		if volumeInitConfig.RedisMetaURL != "" {
+			if err := ips.slot.AllowProxyPort(redisproxy.Port); err != nil {
+				return nil, fmt.Errorf("failed to allow Redis proxy port: %w", err)
+			}
 		}
 	}
 
@@ -665,9 +945,11 @@ func (f *Factory) ResumeSandbox(
 
 		cleanup: cleanup,
 
-		APIStoredConfig: apiConfigToStore,
+		APIStoredConfig: withoutSecrets(apiConfigToStore),
 
 		volumeInitConfig: volumeInitConfig,
+		volumeProxies:    volumeProxies,
+		volumeEvents:     f.volumeEvents,
 
 		exit: exit,
 	}
@@ -743,6 +1025,50 @@ func startExecutionSpan(ctx context.Context) (context.Context, trace.Span) {
 	return ctx, span //nolint:spancheck // this is still just a helper method
 }
 
+// Claim hands a pre-booted sandbox over to a new owner. The identity and envd configuration
+// are replaced and envd is initialized again with the owner's env vars and access token.
+// The volume is already mounted from the first initialization, so it isn't sent again.
+func (s *Sandbox) Claim(
+	ctx context.Context,
+	baseTemplateID string,
+	envd EnvdMetadata,
+	runtime RuntimeMetadata,
+	endAt time.Time,
+	apiConfigToStore *orchestrator.SandboxConfig,
+) error {
+	ctx, span := tracer.Start(ctx, "claim sandbox")
+	defer span.End()
+
+	s.Config.BaseTemplateID = baseTemplateID
+	s.Config.Envd = envd
+	s.Runtime = runtime
+	s.StartedAt = time.Now()
+	s.EndAt = endAt
+	s.APIStoredConfig = withoutSecrets(apiConfigToStore)
+	s.volumeInitConfig = nil
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.EnvdTimeout)
+	defer cancel()
+
+	if err := s.initEnvd(ctx); err != nil {
+		return fmt.Errorf("failed to init envd for the new owner: %w", err)
+	}
+
+	return nil
+}
+
+// withoutSecrets returns the config without the team secrets, the stored config is returned by the list endpoint.
+func withoutSecrets(config *orchestrator.SandboxConfig) *orchestrator.SandboxConfig {
+	if len(config.GetSecrets()) == 0 {
+		return config
+	}
+
+	stored := proto.CloneOf(config)
+	stored.Secrets = nil
+
+	return stored
+}
+
 func (s *Sandbox) Wait(ctx context.Context) error {
 	return s.exit.WaitWithContext(ctx)
 }
@@ -774,16 +1100,10 @@ func (s *Sandbox) doStop(ctx context.Context) error {
 	// Stop the health checks before stopping the sandbox
 	s.Checks.Stop()
 
-	// Call envd shutdown to flush volume buffers before killing the process
-	// This is best-effort: we log errors but don't fail the stop operation
-	if s.Config.Volume != nil {
-		if err := s.callEnvdShutdown(ctx); err != nil {
-			logger.L().Warn(ctx, "failed to call envd shutdown (volume data may be lost)",
-				zap.Error(err),
-				zap.String("sandbox_id", s.Runtime.SandboxID),
-				zap.String("volume_id", s.Config.Volume.GetVolumeId()),
-			)
-		}
+	// Flush and unmount the volume before killing the process, the process is killed when it fails
+	// too, the failure is returned with the errors of the stop
+	if err := s.UnmountVolume(ctx); err != nil {
+		errs = append(errs, err)
 	}
 
 	fcStopErr := s.process.Stop(ctx)
@@ -888,6 +1208,12 @@ func (s *Sandbox) Pause(
 	// Stop the health check before pausing the VM
 	s.Checks.Stop()
 
+	// The volume is flushed, its metadata checkpointed and unmounted before the snapshot, the token
+	// it was mounted with can expire while the sandbox is paused. On resume, envd mounts it again with
+	// a newly minted token. The sandbox is paused even when the unmount fails, the failure is recorded
+	// by the unmount and envd keeps the mount on resume, only replacing its token.
+	_ = s.UnmountVolume(ctx)
+
 	if err := s.process.Pause(ctx); err != nil {
 		return nil, fmt.Errorf("failed to pause VM: %w", err)
 	}
@@ -0,0 +1,395 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/gcstoken"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/consts"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
+)
+
+const (
+	// minEnvdVersionForVolumeAttach is the first envd version with the /mount endpoint.
+	minEnvdVersionForVolumeAttach = "0.4.8"
+	// minEnvdVersionForVolumeDetach is the first envd version with the /unmount endpoint.
+	minEnvdVersionForVolumeDetach = "0.4.9"
+	// minEnvdVersionForVolumeTokenRefresh is the first envd version with the /volume/token endpoint.
+	minEnvdVersionForVolumeTokenRefresh = "0.4.13"
+	// minEnvdVersionForS3Volumes is the first envd version mounting volumes on S3.
+	minEnvdVersionForS3Volumes = "0.4.14"
+	// minEnvdVersionForS3Endpoint is the first envd version mounting volumes from an S3 compatible endpoint.
+	minEnvdVersionForS3Endpoint = "0.4.15"
+	// minEnvdVersionForRedisMetadata is the first envd version mounting volumes with Redis metadata.
+	minEnvdVersionForRedisMetadata = "0.4.16"
+	// minEnvdVersionForVolumeEvents is the first envd version publishing the volume mount events through hyperloop.
+	minEnvdVersionForVolumeEvents = "0.4.17"
+	// minEnvdVersionForVolumeProxyAuth is the first envd version authenticating JuiceFS to the volume proxies.
+	minEnvdVersionForVolumeProxyAuth = "0.4.18"
+	// minEnvdVersionForVolumeSubpath is the first envd version mounting a directory of the volume.
+	minEnvdVersionForVolumeSubpath = "0.4.19"
+
+	// volumeAttachTimeout bounds waiting for envd to mount the volume, it fits in the request timeout
+	// of the API. Envd keeps mounting when it's exceeded.
+	volumeAttachTimeout = 60 * time.Second
+)
+
+var (
+	ErrVolumesNotConfigured     = errors.New("volumes are not configured on this node")
+	ErrVolumeAlreadyAttached    = errors.New("sandbox already has a volume attached")
+	ErrVolumeAttachNotSupported = errors.New("envd version of the sandbox doesn't support attaching volumes, rebuild the template")
+	ErrVolumeNotAttached        = errors.New("volume is not attached to the sandbox")
+	ErrVolumeDetachNotSupported = errors.New("envd version of the sandbox doesn't support detaching volumes, rebuild the template")
+	ErrVolumeDetachRejected     = errors.New("volume can't be detached from the running sandbox")
+
+	ErrVolumeStorageNotSupported = errors.New("envd version of the sandbox doesn't support the volume storage of this node, rebuild the template")
+	ErrVolumeAttachRedisMetadata = errors.New("volumes with Redis metadata can only be mounted at the sandbox start")
+	ErrVolumeSubpathNotSupported = errors.New("envd version of the sandbox doesn't support mounting a subpath of the volume, rebuild the template")
+
+	ErrVolumeTokensNotMinted          = errors.New("GCS tokens are not minted on this node")
+	ErrVolumeTokenRefreshNotSupported = errors.New("envd version of the sandbox doesn't support refreshing the volume token, rebuild the template")
+)
+
+var volumeAttachHttpClient = http.Client{
+	Timeout:   volumeAttachTimeout,
+	Transport: SandboxHttpTransport,
+}
+
+// AttachVolume mounts the volume in the running sandbox without restarting it.
+// No GCS proxy runs for a volume attached this way, so envd needs a minted GCS token
+// or a custom GCS endpoint to reach the volume data.
+func (f *Factory) AttachVolume(ctx context.Context, sbx *Sandbox, volume *orchestrator.VolumeConfig) error {
+	ctx, span := tracer.Start(ctx, "attach-volume")
+	defer span.End()
+
+	if f.volumes == nil {
+		return ErrVolumesNotConfigured
+	}
+
+	if sbx.Config.Volume != nil {
+		return ErrVolumeAlreadyAttached
+	}
+
+	ok, err := utils.IsGTEVersion(sbx.Config.Envd.Version, minEnvdVersionForVolumeAttach)
+	if err != nil || !ok {
+		return ErrVolumeAttachNotSupported
+	}
+
+	if err := f.checkVolumeStorageSupported(sbx.Config.Envd.Version, volume); err != nil {
+		return err
+	}
+
+	// The Redis proxy of the sandbox is only started with the sandbox
+	if volume.GetMetadataEngine() == string(volumestorage.MetaEngineRedis) {
+		return ErrVolumeAttachRedisMetadata
+	}
+
+	volumeInitConfig := f.newVolumeInitConfig(ctx, volume)
+	if volumeInitConfig.GCSToken == "" && volumeInitConfig.GCSEndpoint == "" {
+		return fmt.Errorf("no token for volume %s, it can only be attached at the sandbox start", volume.GetVolumeId())
+	}
+
+	err = sbx.mountEnvdVolume(ctx, volumeInitConfig)
+	var statusErr *envdMountStatusError
+	if errors.As(err, &statusErr) {
+		return err
+	}
+
+	// Without a response envd may still be mounting the volume, it's flushed at the sandbox stop as well
+	sbx.Config.Volume = volume
+
+	// The list endpoint returns the stored config, it must show the attached volume
+	stored := proto.CloneOf(sbx.APIStoredConfig)
+	stored.Volume = volume
+	sbx.APIStoredConfig = stored
+
+	if err != nil {
+		return err
+	}
+
+	logger.L().Info(ctx, "attached volume to running sandbox",
+		logger.WithSandboxID(sbx.Runtime.SandboxID),
+		zap.String("volume_id", volume.GetVolumeId()),
+		zap.String("mount_path", volume.GetMountPath()),
+	)
+
+	return nil
+}
+
+// checkVolumeStorageSupported returns ErrVolumeStorageNotSupported when envd of the sandbox can't
+// mount the volume from the storage of this node or with its metadata engine, and
+// ErrVolumeSubpathNotSupported when it can't mount only a subpath of the volume.
+func (f *Factory) checkVolumeStorageSupported(envdVersion string, volume *orchestrator.VolumeConfig) error {
+	if volume.GetSubpath() != "" {
+		ok, err := utils.IsGTEVersion(envdVersion, minEnvdVersionForVolumeSubpath)
+		if err != nil || !ok {
+			return ErrVolumeSubpathNotSupported
+		}
+	}
+
+	metaEngine, err := volumestorage.ParseMetaEngine(volume.GetMetadataEngine())
+	if err != nil {
+		return fmt.Errorf("volume %s: %w", volume.GetVolumeId(), err)
+	}
+
+	var minVersion string
+	if f.volumes.StorageProvider == volumestorage.S3 {
+		minVersion = minEnvdVersionForS3Volumes
+		if f.volumes.S3Endpoint != "" {
+			minVersion = minEnvdVersionForS3Endpoint
+		}
+	}
+	if metaEngine == volumestorage.MetaEngineRedis {
+		minVersion = minEnvdVersionForRedisMetadata
+	}
+
+	if minVersion == "" {
+		return nil
+	}
+
+	ok, err := utils.IsGTEVersion(envdVersion, minVersion)
+	if err != nil || !ok {
+		return ErrVolumeStorageNotSupported
+	}
+
+	return nil
+}
+
+// EnvdPublishesVolumeEvents reports whether envd of the sandbox publishes the mount and unmount events
+// of its volume itself.
+func (s *Sandbox) EnvdPublishesVolumeEvents() bool {
+	ok, err := utils.IsGTEVersion(s.Config.Envd.Version, minEnvdVersionForVolumeEvents)
+
+	return err == nil && ok
+}
+
+// envdAuthenticatesToVolumeProxies reports whether envd of the sandbox authenticates JuiceFS to the
+// volume proxies with the proxy token of the volume.
+func envdAuthenticatesToVolumeProxies(envdVersion string) bool {
+	ok, err := utils.IsGTEVersion(envdVersion, minEnvdVersionForVolumeProxyAuth)
+
+	return err == nil && ok
+}
+
+// mountEnvdVolume calls the envd mount endpoint, which mounts the volume before responding.
+func (s *Sandbox) mountEnvdVolume(ctx context.Context, volume *InitVolumeConfig) error {
+	body, err := json.Marshal(volume)
+	if err != nil {
+		return fmt.Errorf("failed to marshal mount request: %w", err)
+	}
+
+	address := fmt.Sprintf("http://%s:%d/mount", s.Slot.HostIPString(), consts.DefaultEnvdServerPort)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create mount request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if authToken := envdAuthToken(s.Config.Envd.AccessToken, s.Config.Envd.Version); authToken != nil {
+		request.Header.Set("X-Access-Token", *authToken)
+	}
+
+	response, err := volumeAttachHttpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to call envd mount: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		return &envdMountStatusError{endpoint: "mount", statusCode: response.StatusCode, err: ErrVolumeAlreadyAttached}
+	default:
+		body, _ := io.ReadAll(response.Body)
+
+		return &envdMountStatusError{endpoint: "mount", statusCode: response.StatusCode, err: errors.New(string(body))}
+	}
+}
+
+// DetachVolume flushes and unmounts the volume in the running sandbox without restarting it.
+// Volumes backing overlay paths or a read-only root stay attached until the sandbox stops.
+func (f *Factory) DetachVolume(ctx context.Context, sbx *Sandbox, volumeID string) error {
+	ctx, span := tracer.Start(ctx, "detach-volume")
+	defer span.End()
+
+	volume := sbx.Config.Volume
+	if volume == nil || volume.GetVolumeId() != volumeID {
+		return ErrVolumeNotAttached
+	}
+
+	ok, err := utils.IsGTEVersion(sbx.Config.Envd.Version, minEnvdVersionForVolumeDetach)
+	if err != nil || !ok {
+		return ErrVolumeDetachNotSupported
+	}
+
+	if err := sbx.unmountEnvdVolume(ctx, volumeID); err != nil {
+		return err
+	}
+
+	// The shutdown has nothing to flush anymore, and the list endpoint mustn't show the volume
+	sbx.Config.Volume = nil
+
+	stored := proto.CloneOf(sbx.APIStoredConfig)
+	stored.Volume = nil
+	sbx.APIStoredConfig = stored
+
+	logger.L().Info(ctx, "detached volume from running sandbox",
+		logger.WithSandboxID(sbx.Runtime.SandboxID),
+		zap.String("volume_id", volumeID),
+		zap.String("mount_path", volume.GetMountPath()),
+	)
+
+	return nil
+}
+
+// unmountEnvdVolume calls the envd unmount endpoint, which flushes the volume data and stops
+// replicating its metadata before responding.
+func (s *Sandbox) unmountEnvdVolume(ctx context.Context, volumeID string) error {
+	body, err := json.Marshal(map[string]string{"volumeId": volumeID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal unmount request: %w", err)
+	}
+
+	address := fmt.Sprintf("http://%s:%d/unmount", s.Slot.HostIPString(), consts.DefaultEnvdServerPort)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create unmount request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if authToken := envdAuthToken(s.Config.Envd.AccessToken, s.Config.Envd.Version); authToken != nil {
+		request.Header.Set("X-Access-Token", *authToken)
+	}
+
+	response, err := volumeAttachHttpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to call envd unmount: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return &envdMountStatusError{endpoint: "unmount", statusCode: response.StatusCode, err: ErrVolumeNotAttached}
+	case http.StatusConflict:
+		body, _ := io.ReadAll(response.Body)
+
+		return &envdMountStatusError{endpoint: "unmount", statusCode: response.StatusCode, err: fmt.Errorf("%w: %s", ErrVolumeDetachRejected, body)}
+	default:
+		body, _ := io.ReadAll(response.Body)
+
+		return &envdMountStatusError{endpoint: "unmount", statusCode: response.StatusCode, err: errors.New(string(body))}
+	}
+}
+
+// RefreshVolumeToken mints a fresh downscoped GCS token for the volume attached to the running sandbox
+// and pushes it to envd, so the volume stays writable after the token it was mounted with expires.
+// Returns the expiry of the new token.
+func (f *Factory) RefreshVolumeToken(ctx context.Context, sbx *Sandbox, volumeID string) (time.Time, error) {
+	ctx, span := tracer.Start(ctx, "refresh-volume-token")
+	defer span.End()
+
+	volume := sbx.Config.Volume
+	if volume == nil || volume.GetVolumeId() != volumeID {
+		return time.Time{}, ErrVolumeNotAttached
+	}
+
+	if f.tokenMinter == nil {
+		return time.Time{}, ErrVolumeTokensNotMinted
+	}
+
+	ok, err := utils.IsGTEVersion(sbx.Config.Envd.Version, minEnvdVersionForVolumeTokenRefresh)
+	if err != nil || !ok {
+		return time.Time{}, ErrVolumeTokenRefreshNotSupported
+	}
+
+	token, err := f.tokenMinter.MintDownscopedToken(ctx, volume.GetGcsBucket(), volumeID, f.volumeTokenOptions(volume))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to mint GCS token: %w", err)
+	}
+
+	if err := sbx.pushEnvdVolumeToken(ctx, volumeID, token); err != nil {
+		return time.Time{}, err
+	}
+
+	logger.L().Info(ctx, "refreshed GCS token of attached volume",
+		logger.WithSandboxID(sbx.Runtime.SandboxID),
+		zap.String("volume_id", volumeID),
+		zap.Time("expires_at", token.ExpiresAt),
+	)
+
+	return token.ExpiresAt, nil
+}
+
+// pushEnvdVolumeToken calls the envd volume token endpoint, which replaces the token the mount
+// processes read before responding.
+func (s *Sandbox) pushEnvdVolumeToken(ctx context.Context, volumeID string, token *gcstoken.Token) error {
+	body, err := json.Marshal(map[string]any{
+		"volumeId":       volumeID,
+		"gcsToken":       token.AccessToken,
+		"gcsTokenExpiry": token.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal volume token request: %w", err)
+	}
+
+	address := fmt.Sprintf("http://%s:%d/volume/token", s.Slot.HostIPString(), consts.DefaultEnvdServerPort)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create volume token request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if authToken := envdAuthToken(s.Config.Envd.AccessToken, s.Config.Envd.Version); authToken != nil {
+		request.Header.Set("X-Access-Token", *authToken)
+	}
+
+	response, err := volumeAttachHttpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to call envd volume token: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return &envdMountStatusError{endpoint: "volume token", statusCode: response.StatusCode, err: ErrVolumeNotAttached}
+	default:
+		body, _ := io.ReadAll(response.Body)
+
+		return &envdMountStatusError{endpoint: "volume token", statusCode: response.StatusCode, err: errors.New(string(body))}
+	}
+}
+
+// envdMountStatusError is envd refusing or failing to mount or unmount the volume, as opposed to no response.
+type envdMountStatusError struct {
+	endpoint   string
+	statusCode int
+	err        error
+}
+
+func (e *envdMountStatusError) Error() string {
+	return fmt.Sprintf("envd %s returned status %d: %s", e.endpoint, e.statusCode, e.err)
+}
+
+func (e *envdMountStatusError) Unwrap() error {
+	return e.err
+}
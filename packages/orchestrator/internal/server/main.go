@@ -16,6 +16,7 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/service"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/warmpool"
 	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
@@ -40,6 +41,7 @@ type Server struct {
 	sbxEventsService  *events.EventsService
 	volEventsService  *events.VolumeEventsService
 	startingSandboxes *semaphore.Weighted
+	warmPool          *warmpool.Pool
 }
 
 type ServiceConfig struct {
@@ -56,6 +58,7 @@ type ServiceConfig struct {
 	FeatureFlags     *featureflags.Client
 	SbxEventsService *events.EventsService
 	VolEventsService *events.VolumeEventsService
+	WarmPool         *warmpool.Pool
 }
 
 func New(ctx context.Context, cfg ServiceConfig) *Server {
@@ -73,6 +76,7 @@ func New(ctx context.Context, cfg ServiceConfig) *Server {
 		sbxEventsService:  cfg.SbxEventsService,
 		volEventsService:  cfg.VolEventsService,
 		startingSandboxes: semaphore.NewWeighted(maxStartingInstancesPerNode),
+		warmPool:          cfg.WarmPool,
 	}
 
 	meter := cfg.Tel.MeterProvider.Meter("orchestrator.sandbox")
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/volumeproxy"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
 	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
@@ -70,6 +71,104 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			Build(),
 	)
 
+	// A pre-booted sandbox doesn't count against the starting limits, it's already running.
+	sbx := s.warmPool.Claim(ctx, req)
+	fromWarmPool := sbx != nil
+	childSpan.SetAttributes(attribute.Bool("warm_pool.claimed", fromWarmPool))
+
+	if !fromWarmPool {
+		var err error
+		sbx, err = s.startSandbox(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	volumeProto := req.GetSandbox().GetVolume()
+
+	// Note: sandbox is already inserted into the map by Factory.ResumeSandbox
+	// before WaitForEnvd is called, so TCP firewall proxy can find it.
+	// Claimed sandboxes are moved to their new ID by the warm pool.
+
+	go func() {
+		ctx, childSpan := tracer.Start(context.WithoutCancel(ctx), "sandbox-create-stop", trace.WithNewRoot())
+		defer childSpan.End()
+
+		waitErr := sbx.Wait(ctx)
+		if waitErr != nil {
+			sbxlogger.I(sbx).Error(ctx, "failed to wait for sandbox, cleaning up", zap.Error(waitErr))
+		}
+
+		cleanupErr := sbx.Close(ctx)
+		if cleanupErr != nil {
+			sbxlogger.I(sbx).Error(ctx, "failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
+		}
+
+		// Remove the sandbox from cache only if the cleanup IDs match.
+		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
+		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
+		s.sandboxes.RemoveByExecutionID(req.GetSandbox().GetSandboxId(), sbx.Runtime.ExecutionID)
+
+		// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
+		closeErr := s.proxy.RemoveFromPool(sbx.Runtime.ExecutionID)
+		if closeErr != nil {
+			// Errors here will be from forcefully closing the connections, so we can ignore them—they will at worst timeout on their own.
+			sbxlogger.I(sbx).Warn(ctx, "errors when manually closing connections to sandbox", zap.Error(closeErr))
+		}
+
+		sbxlogger.E(sbx).Info(ctx, "Sandbox stopped")
+	}()
+
+	eventType := events.SandboxCreatedEventPair
+	if req.GetSandbox().GetSnapshot() {
+		eventType = events.SandboxResumedEventPair
+	}
+
+	teamID, buildId, eventData := s.prepareSandboxEventData(ctx, sbx)
+
+	// Include volume_id in event data for sandbox_runs tracking
+	if volumeProto != nil {
+		eventData["volume_id"] = volumeProto.GetVolumeId()
+	}
+
+	if fromWarmPool {
+		eventData["warm_pool"] = true
+	}
+
+	go s.sbxEventsService.Publish(
+		context.WithoutCancel(ctx),
+		teamID,
+		events.SandboxEvent{
+			Version:   events.StructureVersionV2,
+			ID:        uuid.New(),
+			Type:      eventType.Type,
+			Timestamp: time.Now().UTC(),
+
+			EventData:          eventData,
+			SandboxID:          sbx.Runtime.SandboxID,
+			SandboxExecutionID: sbx.Runtime.ExecutionID,
+			SandboxTemplateID:  sbx.Config.BaseTemplateID,
+			SandboxBuildID:     buildId,
+			SandboxTeamID:      teamID,
+		},
+	)
+
+	// Emit volume.attached event if sandbox has a volume, or volume.mount.failed when envd started it without
+	if volumeProto != nil {
+		if mountErr := sbx.VolumeMountError(); mountErr != "" {
+			s.publishVolumeMountFailedEvent(ctx, teamID, sbx, volumeProto, mountErr)
+		} else {
+			s.publishVolumeEvent(ctx, events.VolumeAttachedEvent, teamID, sbx, volumeProto)
+		}
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId: s.info.ClientId,
+	}, nil
+}
+
+// startSandbox boots a new sandbox for the request.
+func (s *Server) startSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*sandbox.Sandbox, error) {
 	maxRunningSandboxesPerNode := s.featureFlags.IntFlag(ctx, featureflags.MaxSandboxesPerNode)
 
 	runningSandboxes := s.sandboxes.Count()
@@ -137,6 +236,7 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		logger.L().Info(ctx, "Volume config received from API",
 			zap.String("volume_id", volumeProto.GetVolumeId()),
 			zap.String("mount_path", volumeProto.GetMountPath()),
+			zap.String("metadata_engine", volumeProto.GetMetadataEngine()),
 			zap.Int32("redis_db", volumeProto.GetRedisDb()),
 			zap.String("gcs_bucket", volumeProto.GetGcsBucket()),
 		)
@@ -161,6 +261,7 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 				Version:     req.GetSandbox().GetEnvdVersion(),
 				AccessToken: req.GetSandbox().EnvdAccessToken,
 				Vars:        req.GetSandbox().GetEnvVars(),
+				Secrets:     req.GetSandbox().GetSecrets(),
 			},
 
 			Volume: volumeProto,
@@ -183,55 +284,40 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			return nil, status.Errorf(codes.FailedPrecondition, "sandbox files for '%s' not found", req.GetSandbox().GetSandboxId())
 		}
 
+		if errors.Is(err, sandbox.ErrVolumeStorageNotSupported) || errors.Is(err, sandbox.ErrVolumeSubpathNotSupported) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
 		err = errors.Join(err, context.Cause(ctx))
 		telemetry.ReportCriticalError(ctx, "failed to create sandbox", err)
 
 		return nil, status.Errorf(codes.Internal, "failed to create sandbox: %s", err)
 	}
 
-	// Note: sandbox is already inserted into the map by Factory.ResumeSandbox
-	// before WaitForEnvd is called, so TCP firewall proxy can find it.
-
-	go func() {
-		ctx, childSpan := tracer.Start(context.WithoutCancel(ctx), "sandbox-create-stop", trace.WithNewRoot())
-		defer childSpan.End()
-
-		waitErr := sbx.Wait(ctx)
-		if waitErr != nil {
-			sbxlogger.I(sbx).Error(ctx, "failed to wait for sandbox, cleaning up", zap.Error(waitErr))
-		}
-
-		cleanupErr := sbx.Close(ctx)
-		if cleanupErr != nil {
-			sbxlogger.I(sbx).Error(ctx, "failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
-		}
+	return sbx, nil
+}
 
-		// Remove the sandbox from cache only if the cleanup IDs match.
-		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
-		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
-		s.sandboxes.RemoveByExecutionID(req.GetSandbox().GetSandboxId(), sbx.Runtime.ExecutionID)
+func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-update")
+	defer childSpan.End()
 
-		// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
-		closeErr := s.proxy.RemoveFromPool(sbx.Runtime.ExecutionID)
-		if closeErr != nil {
-			// Errors here will be from forcefully closing the connections, so we can ignore them—they will at worst timeout on their own.
-			sbxlogger.I(sbx).Warn(ctx, "errors when manually closing connections to sandbox", zap.Error(closeErr))
-		}
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(req.GetSandboxId()),
+		attribute.String("client.id", s.info.ClientId),
+	)
 
-		sbxlogger.E(sbx).Info(ctx, "Sandbox stopped")
-	}()
+	sbx, ok := s.sandboxes.Get(req.GetSandboxId())
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
 
-	eventType := events.SandboxCreatedEventPair
-	if req.GetSandbox().GetSnapshot() {
-		eventType = events.SandboxResumedEventPair
+		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	teamID, buildId, eventData := s.prepareSandboxEventData(ctx, sbx)
+	sbx.EndAt = req.GetEndTime().AsTime()
 
-	// Include volume_id in event data for sandbox_runs tracking
-	if volumeProto != nil {
-		eventData["volume_id"] = volumeProto.GetVolumeId()
-	}
+	teamID, buildId, eventData := s.prepareSandboxEventData(ctx, sbx)
+	eventData["set_timeout"] = req.GetEndTime().AsTime().Format(time.RFC3339)
+	eventType := events.SandboxUpdatedEventPair
 
 	go s.sbxEventsService.Publish(
 		context.WithoutCancel(ctx),
@@ -251,29 +337,59 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		},
 	)
 
-	// Emit volume.attached event if sandbox has a volume
-	if volumeProto != nil && s.volEventsService != nil {
-		go s.volEventsService.Publish(
-			context.WithoutCancel(ctx),
-			teamID,
-			events.NewVolumeEvent(events.VolumeAttachedEvent, volumeProto.GetVolumeId()).
-				WithSandboxContext(sbx.Runtime.SandboxID, sbx.Runtime.ExecutionID, teamID).
-				WithMountPath(volumeProto.GetMountPath()),
-		)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) AttachVolume(ctx context.Context, req *orchestrator.SandboxAttachVolumeRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-attach-volume")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(req.GetSandboxId()),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.String("volume.id", req.GetVolume().GetVolumeId()),
+	)
+
+	if req.GetVolume() == nil {
+		return nil, status.Error(codes.InvalidArgument, "volume is required")
 	}
 
-	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.info.ClientId,
-	}, nil
+	sbx, ok := s.sandboxes.Get(req.GetSandboxId())
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := s.sandboxFactory.AttachVolume(ctx, sbx, req.GetVolume())
+	switch {
+	case errors.Is(err, sandbox.ErrVolumeAlreadyAttached), errors.Is(err, sandbox.ErrVolumeAttachNotSupported),
+		errors.Is(err, sandbox.ErrVolumeStorageNotSupported), errors.Is(err, sandbox.ErrVolumeAttachRedisMetadata),
+		errors.Is(err, sandbox.ErrVolumeSubpathNotSupported):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sandbox.ErrVolumesNotConfigured):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		telemetry.ReportCriticalError(ctx, "failed to attach volume", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to attach volume: %s", err)
+	}
+
+	teamID, _, _ := s.prepareSandboxEventData(ctx, sbx)
+
+	s.publishVolumeEvent(ctx, events.VolumeAttachedEvent, teamID, sbx, req.GetVolume())
+
+	return &emptypb.Empty{}, nil
 }
 
-func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
-	ctx, childSpan := tracer.Start(ctx, "sandbox-update")
+func (s *Server) DetachVolume(ctx context.Context, req *orchestrator.SandboxDetachVolumeRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-detach-volume")
 	defer childSpan.End()
 
 	childSpan.SetAttributes(
 		telemetry.WithSandboxID(req.GetSandboxId()),
 		attribute.String("client.id", s.info.ClientId),
+		attribute.String("volume.id", req.GetVolumeId()),
 	)
 
 	sbx, ok := s.sandboxes.Get(req.GetSandboxId())
@@ -283,33 +399,60 @@ func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	sbx.EndAt = req.GetEndTime().AsTime()
+	// The factory clears the volume from the sandbox config on detach
+	volume := sbx.Config.Volume
 
-	teamID, buildId, eventData := s.prepareSandboxEventData(ctx, sbx)
-	eventData["set_timeout"] = req.GetEndTime().AsTime().Format(time.RFC3339)
-	eventType := events.SandboxUpdatedEventPair
+	err := s.sandboxFactory.DetachVolume(ctx, sbx, req.GetVolumeId())
+	switch {
+	case errors.Is(err, sandbox.ErrVolumeNotAttached),
+		errors.Is(err, sandbox.ErrVolumeDetachNotSupported),
+		errors.Is(err, sandbox.ErrVolumeDetachRejected):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		telemetry.ReportCriticalError(ctx, "failed to detach volume", err)
 
-	go s.sbxEventsService.Publish(
-		context.WithoutCancel(ctx),
-		teamID,
-		events.SandboxEvent{
-			Version:   events.StructureVersionV2,
-			ID:        uuid.New(),
-			Type:      eventType.Type,
-			Timestamp: time.Now().UTC(),
+		return nil, status.Errorf(codes.Internal, "failed to detach volume: %s", err)
+	}
 
-			EventData:          eventData,
-			SandboxID:          sbx.Runtime.SandboxID,
-			SandboxExecutionID: sbx.Runtime.ExecutionID,
-			SandboxTemplateID:  sbx.Config.BaseTemplateID,
-			SandboxBuildID:     buildId,
-			SandboxTeamID:      teamID,
-		},
-	)
+	teamID, _, _ := s.prepareSandboxEventData(ctx, sbx)
+
+	s.publishVolumeEvent(ctx, events.VolumeDetachedEvent, teamID, sbx, volume)
 
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Server) RefreshVolumeToken(ctx context.Context, req *orchestrator.SandboxRefreshVolumeTokenRequest) (*orchestrator.SandboxRefreshVolumeTokenResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-refresh-volume-token")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(req.GetSandboxId()),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.String("volume.id", req.GetVolumeId()),
+	)
+
+	sbx, ok := s.sandboxes.Get(req.GetSandboxId())
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	expiresAt, err := s.sandboxFactory.RefreshVolumeToken(ctx, sbx, req.GetVolumeId())
+	switch {
+	case errors.Is(err, sandbox.ErrVolumeNotAttached), errors.Is(err, sandbox.ErrVolumeTokenRefreshNotSupported):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sandbox.ErrVolumeTokensNotMinted):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		telemetry.ReportCriticalError(ctx, "failed to refresh volume token", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to refresh volume token: %s", err)
+	}
+
+	return &orchestrator.SandboxRefreshVolumeTokenResponse{ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
 func (s *Server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.SandboxListResponse, error) {
 	_, childSpan := tracer.Start(ctx, "sandbox-list")
 	defer childSpan.End()
@@ -328,10 +471,11 @@ func (s *Server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.Sand
 		}
 
 		sandboxes = append(sandboxes, &orchestrator.RunningSandbox{
-			Config:    sbx.APIStoredConfig,
-			ClientId:  s.info.ClientId,
-			StartTime: timestamppb.New(sbx.StartedAt),
-			EndTime:   timestamppb.New(sbx.EndAt),
+			Config:        sbx.APIStoredConfig,
+			ClientId:      s.info.ClientId,
+			StartTime:     timestamppb.New(sbx.StartedAt),
+			EndTime:       timestamppb.New(sbx.EndAt),
+			VolumeProxies: volumeProxiesStatus(sbx.VolumeProxies()),
 		})
 	}
 
@@ -340,6 +484,21 @@ func (s *Server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.Sand
 	}, nil
 }
 
+// volumeProxiesStatus returns the health of the proxies of the volume of a sandbox.
+func volumeProxiesStatus(statuses []volumeproxy.Status) []*orchestrator.VolumeProxyStatus {
+	proxies := make([]*orchestrator.VolumeProxyStatus, 0, len(statuses))
+	for _, status := range statuses {
+		proxies = append(proxies, &orchestrator.VolumeProxyStatus{
+			Name:      status.Name,
+			Healthy:   status.Healthy,
+			Restarts:  uint32(status.Restarts),
+			LastError: status.LastError,
+		})
+	}
+
+	return proxies
+}
+
 func (s *Server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteRequest) (*emptypb.Empty, error) {
 	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
 	defer cancel()
@@ -407,14 +566,8 @@ func (s *Server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteR
 	)
 
 	// Emit volume.detached event if sandbox had a volume
-	if sbx.Config.Volume != nil && s.volEventsService != nil {
-		go s.volEventsService.Publish(
-			context.WithoutCancel(ctx),
-			teamID,
-			events.NewVolumeEvent(events.VolumeDetachedEvent, sbx.Config.Volume.GetVolumeId()).
-				WithSandboxContext(sbx.Runtime.SandboxID, sbx.Runtime.ExecutionID, teamID).
-				WithMountPath(sbx.Config.Volume.GetMountPath()),
-		)
+	if sbx.Config.Volume != nil {
+		s.publishVolumeEvent(ctx, events.VolumeDetachedEvent, teamID, sbx, sbx.Config.Volume)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -534,9 +687,62 @@ func (s *Server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		},
 	)
 
+	// The volume is mounted again when the sandbox is resumed
+	if sbx.Config.Volume != nil {
+		s.publishVolumeEvent(ctx, events.VolumeDetachedEvent, teamID, sbx, sbx.Config.Volume)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// publishVolumeEvent emits the attach or detach of the volume in the sandbox.
+func (s *Server) publishVolumeEvent(ctx context.Context, eventType string, teamID uuid.UUID, sbx *sandbox.Sandbox, volume *orchestrator.VolumeConfig) {
+	if s.volEventsService == nil {
+		return
+	}
+
+	go s.volEventsService.Publish(
+		context.WithoutCancel(ctx),
+		teamID,
+		events.NewVolumeEvent(eventType, volume.GetVolumeId()).
+			WithSandboxContext(sbx.Runtime.SandboxID, sbx.Runtime.ExecutionID, teamID).
+			WithMountPath(volume.GetMountPath()).
+			WithEventData(volumeEventData(volume)),
+	)
+}
+
+// volumeEventData returns how the volume is mounted in the sandbox, for the events of the volume.
+func volumeEventData(volume *orchestrator.VolumeConfig) map[string]any {
+	data := map[string]any{"read_only": volume.GetReadOnly()}
+	if subpath := volume.GetSubpath(); subpath != "" {
+		data["subpath"] = subpath
+	}
+
+	return data
+}
+
+// publishVolumeMountFailedEvent emits that the sandbox was started without its volume because it
+// couldn't be mounted, for the envd versions that don't publish their mount events.
+func (s *Server) publishVolumeMountFailedEvent(ctx context.Context, teamID uuid.UUID, sbx *sandbox.Sandbox, volume *orchestrator.VolumeConfig, mountErr string) {
+	sbxlogger.I(sbx).Warn(ctx, "sandbox started without its volume",
+		zap.String("volume_id", volume.GetVolumeId()),
+		zap.String("error", mountErr))
+
+	if s.volEventsService == nil || sbx.EnvdPublishesVolumeEvents() {
+		return
+	}
+
+	go s.volEventsService.Publish(
+		context.WithoutCancel(ctx),
+		teamID,
+		events.NewVolumeEvent(events.VolumeMountFailedEvent, volume.GetVolumeId()).
+			WithSandboxContext(sbx.Runtime.SandboxID, sbx.Runtime.ExecutionID, teamID).
+			WithMountPath(volume.GetMountPath()).
+			WithError(mountErr, "mount_failed").
+			WithEventData(volumeEventData(volume)),
+	)
+}
+
 // Extracts common data needed for sandbox events
 func (s *Server) prepareSandboxEventData(ctx context.Context, sbx *sandbox.Sandbox) (uuid.UUID, string, map[string]any) {
 	teamID, err := uuid.Parse(sbx.Runtime.TeamID)
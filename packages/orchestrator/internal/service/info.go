@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/cfg"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/service/machineinfo"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/template/build/core/envd"
 	orchestratorinfo "github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator-info"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
 )
@@ -20,6 +22,9 @@ type ServiceInfo struct {
 	SourceVersion string
 	SourceCommit  string
 
+	// EnvdVersion is the version of the envd binary the template builder puts into templates.
+	EnvdVersion string
+
 	Startup     time.Time
 	Roles       []orchestratorinfo.ServiceInfoRole
 	MachineInfo machineinfo.MachineInfo
@@ -71,6 +76,15 @@ func NewInfoContainer(ctx context.Context, clientId string, version string, comm
 		SourceCommit:  commit,
 	}
 
+	// Lets the API find the templates built with an older envd and update them
+	if slices.Contains(serviceRoles, orchestratorinfo.ServiceInfoRole_TemplateBuilder) {
+		envdVersion, err := envd.GetEnvdVersion(ctx, config.HostEnvdPath)
+		if err != nil {
+			logger.L().Warn(ctx, "Failed to get the envd version", zap.Error(err))
+		}
+		serviceInfo.EnvdVersion = envdVersion
+	}
+
 	serviceInfo.SetStatus(ctx, orchestratorinfo.ServiceInfoStatus_Healthy)
 
 	return serviceInfo
@@ -70,6 +70,7 @@ func (s *Server) ServiceInfo(ctx context.Context, _ *emptypb.Empty) (*orchestrat
 
 		ServiceVersion: info.SourceVersion,
 		ServiceCommit:  info.SourceCommit,
+		EnvdVersion:    info.EnvdVersion,
 
 		ServiceStartup: timestamppb.New(info.Startup),
 		ServiceRoles:   info.Roles,
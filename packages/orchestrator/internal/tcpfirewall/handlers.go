@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -12,6 +11,7 @@ import (
 
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
 )
 
 const (
@@ -100,9 +100,15 @@ func proxy(ctx context.Context, conn net.Conn, upstreamAddr string, metrics *Met
 // isEgressAllowed checks if egress is allowed based on domain and CIDR rules.
 // Returns the allowed status and the match type for metrics.
 // Priority order:
-//  1. Allow domain / Allow CIDR (if either matches → allow)
-//  2. Deny domain / Deny CIDR (if either matches → deny)
-//  3. Default: allow
+//  1. Allow domain (if it matches → allow)
+//  2. Allow CIDR (if it matches → allow)
+//  3. Address resolved for an allowed domain, without a hostname (→ allow)
+//  4. Deny domain (if it matches → deny)
+//  5. Deny CIDR (if it matches → deny)
+//  6. Default: allow
+//
+// Denied domains only match connections with a hostname, a connection straight to an address
+// of a denied domain is only blocked by a denied CIDR.
 func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, MatchType, error) {
 	networkConfig := sbx.Config.Network
 	if networkConfig == nil {
@@ -119,13 +125,13 @@ func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, Ma
 	// Priority 1: Check allowed domains
 	if hostname != noHostnameValue {
 		for _, domain := range egress.GetAllowedDomains() {
-			if matchDomain(hostname, domain) {
+			if sandbox_network.MatchDomain(hostname, domain) {
 				return true, MatchTypeDomain, nil // Explicitly allowed by domain
 			}
 		}
 	}
 
-	// Priority 1: Check allowed CIDRs
+	// Priority 2: Check allowed CIDRs
 	for _, cidr := range egress.GetAllowedCidrs() {
 		_, ipNet, err := net.ParseCIDR(cidr)
 		if err != nil {
@@ -137,7 +143,22 @@ func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, Ma
 		}
 	}
 
-	// Priority 2: Check denied CIDRs
+	// Priority 3: Check addresses resolved for allowed domains by the DNS firewall.
+	// Only used without a hostname, a connection naming another host must not reuse the address (e.g. a shared CDN).
+	if hostname == noHostnameValue && sbx.Resources != nil && sbx.Slot.IsResolvedIP(ip) {
+		return true, MatchTypeDomain, nil // Allowed by a resolved domain
+	}
+
+	// Priority 4: Check denied domains
+	if hostname != noHostnameValue {
+		for _, domain := range egress.GetDeniedDomains() {
+			if sandbox_network.MatchDomain(hostname, domain) {
+				return false, MatchTypeDomain, nil // Blocked by domain
+			}
+		}
+	}
+
+	// Priority 5: Check denied CIDRs
 	for _, cidr := range egress.GetDeniedCidrs() {
 		_, ipNet, err := net.ParseCIDR(cidr)
 		if err != nil {
@@ -152,24 +173,3 @@ func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, Ma
 	// Default: allow all traffic.
 	return true, MatchTypeNone, nil
 }
-
-// matchDomain checks if a hostname matches a domain pattern.
-// Patterns can be exact matches, wildcards (*), or suffix wildcards (*.example.com).
-func matchDomain(hostname, pattern string) bool {
-	switch {
-	case pattern == "":
-		// Empty pattern should never match
-		return false
-	case strings.EqualFold(pattern, hostname):
-		return true
-	case strings.EqualFold(pattern, "*"):
-		return true
-	case strings.HasPrefix(pattern, "*."):
-		suffix := pattern[1:]
-		if strings.HasSuffix(strings.ToLower(hostname), strings.ToLower(suffix)) {
-			return true
-		}
-	}
-
-	return false
-}
@@ -9,122 +9,6 @@ import (
 	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
 )
 
-func TestMatchDomain(t *testing.T) {
-	tests := []struct {
-		name     string
-		hostname string
-		pattern  string
-		want     bool
-	}{
-		// Exact matches
-		{
-			name:     "exact match",
-			hostname: "example.com",
-			pattern:  "example.com",
-			want:     true,
-		},
-		{
-			name:     "exact match case insensitive",
-			hostname: "Example.COM",
-			pattern:  "example.com",
-			want:     true,
-		},
-		{
-			name:     "exact match pattern uppercase",
-			hostname: "example.com",
-			pattern:  "EXAMPLE.COM",
-			want:     true,
-		},
-		{
-			name:     "no match different domain",
-			hostname: "example.com",
-			pattern:  "other.com",
-			want:     false,
-		},
-
-		// Wildcard *
-		{
-			name:     "wildcard matches any hostname",
-			hostname: "anything.example.com",
-			pattern:  "*",
-			want:     true,
-		},
-		{
-			name:     "wildcard matches simple hostname",
-			hostname: "localhost",
-			pattern:  "*",
-			want:     true,
-		},
-
-		// Suffix wildcards *.domain
-		{
-			name:     "suffix wildcard matches subdomain",
-			hostname: "api.example.com",
-			pattern:  "*.example.com",
-			want:     true,
-		},
-		{
-			name:     "suffix wildcard matches nested subdomain",
-			hostname: "deep.nested.example.com",
-			pattern:  "*.example.com",
-			want:     true,
-		},
-		{
-			name:     "suffix wildcard case insensitive",
-			hostname: "API.EXAMPLE.COM",
-			pattern:  "*.example.com",
-			want:     true,
-		},
-		{
-			name:     "suffix wildcard does not match exact domain",
-			hostname: "example.com",
-			pattern:  "*.example.com",
-			want:     false,
-		},
-		{
-			name:     "suffix wildcard does not match different domain",
-			hostname: "api.other.com",
-			pattern:  "*.example.com",
-			want:     false,
-		},
-		{
-			name:     "suffix wildcard does not match partial suffix",
-			hostname: "notexample.com",
-			pattern:  "*.example.com",
-			want:     false,
-		},
-
-		// Edge cases
-		{
-			name:     "empty hostname",
-			hostname: "",
-			pattern:  "example.com",
-			want:     false,
-		},
-		{
-			name:     "empty pattern",
-			hostname: "example.com",
-			pattern:  "",
-			want:     false,
-		},
-		{
-			name:     "both empty - empty pattern never matches",
-			hostname: "",
-			pattern:  "",
-			want:     false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := matchDomain(tt.hostname, tt.pattern)
-			if got != tt.want {
-				t.Errorf("matchDomain(%q, %q) = %v, want %v", tt.hostname, tt.pattern, got, tt.want)
-			}
-		})
-	}
-}
-
 func TestIsEgressAllowed(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -295,6 +179,91 @@ func TestIsEgressAllowed(t *testing.T) {
 			want:     false,
 		},
 
+		// ---------------------------------------------------------------------
+		// Denied Domains
+		// Matched against the hostname, after the allow rules.
+		// ---------------------------------------------------------------------
+		{
+			name: "denied domain blocks traffic",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					DeniedDomains: []string{"uploads.example.com"},
+				},
+			},
+			hostname: "uploads.example.com",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     false,
+		},
+		{
+			name: "denied wildcard domain blocks subdomain",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					DeniedDomains: []string{"*.example.com"},
+				},
+			},
+			hostname: "api.example.com",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     false,
+		},
+		{
+			name: "hostname not in denied domains allows",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					DeniedDomains: []string{"*.example.com"},
+				},
+			},
+			hostname: "example.org",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     true,
+		},
+		{
+			name: "denied domain without hostname allows",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					DeniedDomains: []string{"*"},
+				},
+			},
+			hostname: "",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     true,
+		},
+		{
+			name: "denied CIDR of a denied domain blocks its address without hostname",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					DeniedDomains: []string{"example.com"},
+					DeniedCidrs:   []string{"1.2.3.0/24"},
+				},
+			},
+			hostname: "",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     false,
+		},
+		{
+			name: "bypass: allowed domain takes precedence over denied domain",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					AllowedDomains: []string{"api.example.com"},
+					DeniedDomains:  []string{"*.example.com"},
+				},
+			},
+			hostname: "api.example.com",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     true,
+		},
+		{
+			name: "bypass: allowed CIDR takes precedence over denied domain",
+			network: &orchestrator.SandboxNetworkConfig{
+				Egress: &orchestrator.SandboxNetworkEgressConfig{
+					AllowedCidrs:  []string{"1.2.3.0/24"},
+					DeniedDomains: []string{"example.com"},
+				},
+			},
+			hostname: "example.com",
+			ip:       net.ParseIP("1.2.3.4"),
+			want:     true,
+		},
+
 		// ---------------------------------------------------------------------
 		// Multiple Rules
 		// ---------------------------------------------------------------------
@@ -204,7 +204,7 @@ func additionalOCILayers(
 	if buildContext.BuilderConfig.HostJuiceFSPath != "" {
 		juicefsFileData, err := os.ReadFile(buildContext.BuilderConfig.HostJuiceFSPath)
 		if err == nil {
-			filesMap["usr/local/bin/juicefs"] = oci.File{Bytes: juicefsFileData, Mode: 0o755}
+			filesMap[storage.GuestJuiceFSPath] = oci.File{Bytes: juicefsFileData, Mode: 0o755}
 		}
 	}
 
@@ -212,7 +212,7 @@ func additionalOCILayers(
 	if buildContext.BuilderConfig.HostLitestreamPath != "" {
 		litestreamFileData, err := os.ReadFile(buildContext.BuilderConfig.HostLitestreamPath)
 		if err == nil {
-			filesMap["usr/local/bin/litestream"] = oci.File{Bytes: litestreamFileData, Mode: 0o755}
+			filesMap[storage.GuestLitestreamPath] = oci.File{Bytes: litestreamFileData, Mode: 0o755}
 		}
 	}
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -150,7 +152,8 @@ func (lb *LayerExecutor) BuildLayer(
 	return meta, nil
 }
 
-// updateEnvdInSandbox updates the envd binary in the sandbox to the latest version.
+// updateEnvdInSandbox updates the envd binary and the volume mount helpers in the sandbox to the versions on the host,
+// so templates built from cached layers or other templates pick up their fixes.
 func (lb *LayerExecutor) updateEnvdInSandbox(
 	ctx context.Context,
 	userLogger logger.Logger,
@@ -165,42 +168,33 @@ func (lb *LayerExecutor) updateEnvdInSandbox(
 	}
 	userLogger.Debug(ctx, fmt.Sprintf("Updating envd to version v%s", envdVersion))
 
-	// Step 1: Copy the updated envd binary from host to /tmp in sandbox
-	tmpEnvdPath := "/tmp/envd_updated"
-	err = sandboxtools.CopyFile(
-		ctx,
-		lb.proxy,
-		sbx.Runtime.SandboxID,
-		"root",
-		lb.BuilderConfig.HostEnvdPath,
-		tmpEnvdPath,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to copy envd binary to sandbox: %w", err)
+	binaries := []guestBinary{
+		{hostPath: lb.BuilderConfig.HostEnvdPath, guestPath: storage.GuestEnvdPath},
 	}
 
-	// Step 2: Replace the binary
-	replaceEnvdCmd := fmt.Sprintf(`
-		# Replace the binary and set permissions
-		chmod +x %s
-		mv -f %s %s
-	`, tmpEnvdPath, tmpEnvdPath, storage.GuestEnvdPath)
+	// The helpers are optional on the host, the same as when building the rootfs
+	for _, helper := range []guestBinary{
+		{hostPath: lb.BuilderConfig.HostJuiceFSPath, guestPath: storage.GuestJuiceFSPath},
+		{hostPath: lb.BuilderConfig.HostLitestreamPath, guestPath: storage.GuestLitestreamPath},
+	} {
+		if helper.hostPath == "" {
+			continue
+		}
+		if _, err := os.Stat(helper.hostPath); err != nil {
+			continue
+		}
 
-	err = sandboxtools.RunCommandWithLogger(
-		ctx,
-		lb.proxy,
-		userLogger,
-		zap.DebugLevel,
-		"update-envd-replace",
-		sbx.Runtime.SandboxID,
-		replaceEnvdCmd,
-		metadata.Context{User: "root"},
-	)
-	if err != nil {
-		return fmt.Errorf("failed to replace envd binary: %w", err)
+		binaries = append(binaries, helper)
+	}
+
+	for _, binary := range binaries {
+		err = lb.replaceBinaryInSandbox(ctx, userLogger, sbx, binary)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Step 3: Restart the systemd envd service
+	// Restart the systemd envd service
 	// Error is ignored because it's expected the envd connection will be lost
 	_ = sandboxtools.RunCommand(
 		ctx,
@@ -224,7 +218,7 @@ func (lb *LayerExecutor) updateEnvdInSandbox(
 		logger.WithExecutionID(sbx.Runtime.ExecutionID),
 	)
 
-	// Step 4: Wait for envd to initialize
+	// Wait for envd to initialize
 	err = sbx.WaitForEnvd(
 		ctx,
 		waitEnvdTimeout,
@@ -236,6 +230,57 @@ func (lb *LayerExecutor) updateEnvdInSandbox(
 	return nil
 }
 
+// guestBinary is a binary copied from the host into the sandbox.
+type guestBinary struct {
+	hostPath  string
+	guestPath string
+}
+
+// replaceBinaryInSandbox copies the binary from the host to the sandbox and swaps it in place of the old one.
+func (lb *LayerExecutor) replaceBinaryInSandbox(
+	ctx context.Context,
+	userLogger logger.Logger,
+	sbx *sandbox.Sandbox,
+	binary guestBinary,
+) error {
+	// Copy the updated binary from host to /tmp in sandbox first, so the old one is replaced at once
+	tmpPath := "/tmp/" + filepath.Base(binary.guestPath) + "_updated"
+	err := sandboxtools.CopyFile(
+		ctx,
+		lb.proxy,
+		sbx.Runtime.SandboxID,
+		"root",
+		binary.hostPath,
+		tmpPath,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to copy %s binary to sandbox: %w", filepath.Base(binary.guestPath), err)
+	}
+
+	replaceCmd := fmt.Sprintf(`
+		# Replace the binary and set permissions
+		chmod +x %s
+		mkdir -p %s
+		mv -f %s %s
+	`, tmpPath, filepath.Dir(binary.guestPath), tmpPath, binary.guestPath)
+
+	err = sandboxtools.RunCommandWithLogger(
+		ctx,
+		lb.proxy,
+		userLogger,
+		zap.DebugLevel,
+		"update-envd-replace",
+		sbx.Runtime.SandboxID,
+		replaceCmd,
+		metadata.Context{User: "root"},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to replace %s binary: %w", filepath.Base(binary.guestPath), err)
+	}
+
+	return nil
+}
+
 func (lb *LayerExecutor) PauseAndUpload(
 	ctx context.Context,
 	userLogger logger.Logger,
@@ -0,0 +1,283 @@
+// Package volumeproxy supervises the proxies the volume of a sandbox reaches its storage through.
+package volumeproxy
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+const (
+	// startTimeout bounds the time a proxy takes to listen.
+	startTimeout = 5 * time.Second
+	// stopTimeout bounds the time the proxies take to stop and close their connections.
+	stopTimeout = 10 * time.Second
+
+	// The proxies are restarted after a delay doubling on each crash, reset once a proxy ran for
+	// stableRunTime.
+	minRestartDelay = 100 * time.Millisecond
+	maxRestartDelay = 10 * time.Second
+	stableRunTime   = time.Minute
+)
+
+// Proxy is a proxy of the volume, like the GCS proxy or the Redis proxy.
+type Proxy interface {
+	// Start serves until the context is done, or the proxy crashes.
+	Start(ctx context.Context) error
+	// Ready is closed once the proxy listens.
+	Ready() <-chan struct{}
+	Close() error
+}
+
+// Status is the health of a proxy of the sandbox.
+type Status struct {
+	Name    string
+	Healthy bool
+	// Restarts is the number of times the proxy was restarted, the failed restarts included.
+	Restarts int
+	// LastError is why the proxy last crashed, empty when it never did.
+	LastError string
+}
+
+// Manager starts the proxies of a sandbox, restarts them when they crash, and stops them with
+// the sandbox.
+type Manager struct {
+	sandboxID string
+	logger    logger.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	proxies []*supervised
+	closed  bool
+}
+
+// supervised is a proxy of the manager, newProxy creates it again when it crashed.
+type supervised struct {
+	name     string
+	newProxy func() (Proxy, error)
+
+	// The status is guarded by the mutex of the manager.
+	status Status
+}
+
+// instance is a started proxy, done is closed once its Start returned err.
+type instance struct {
+	proxy  Proxy
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// stop stops the proxy and waits for Start to return.
+func (i *instance) stop() {
+	i.cancel()
+	<-i.done
+	i.proxy.Close()
+}
+
+// NewManager creates the manager of the proxies of the sandbox, they're stopped when the context
+// is done or the manager is closed.
+func NewManager(ctx context.Context, sandboxID string, logger logger.Logger) *Manager {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &Manager{
+		sandboxID: sandboxID,
+		logger:    logger,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Start starts the proxy created by newProxy and waits for it to listen, it's supervised from then
+// on. newProxy is called again to restart the proxy when it crashes.
+func (m *Manager) Start(ctx context.Context, name string, newProxy func() (Proxy, error)) error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+
+		return fmt.Errorf("start %s proxy: proxies closed", name)
+	}
+	// The proxies started before the manager is closed are waited for by Close
+	m.wg.Add(1)
+	m.mu.Unlock()
+
+	s := &supervised{name: name, newProxy: newProxy, status: Status{Name: name, Healthy: true}}
+
+	running, err := m.launch(ctx, s)
+	if err != nil {
+		m.wg.Done()
+
+		return fmt.Errorf("start %s proxy: %w", name, err)
+	}
+
+	m.mu.Lock()
+	m.proxies = append(m.proxies, s)
+	m.mu.Unlock()
+
+	go m.supervise(s, running)
+
+	return nil
+}
+
+// launch creates the proxy and starts it, it returns once the proxy listens.
+func (m *Manager) launch(ctx context.Context, s *supervised) (*instance, error) {
+	proxy, err := s.newProxy()
+	if err != nil {
+		return nil, err
+	}
+
+	proxyCtx, cancel := context.WithCancel(m.ctx)
+	running := &instance{proxy: proxy, cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(running.done)
+
+		running.err = run(proxyCtx, proxy)
+	}()
+
+	timer := time.NewTimer(startTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-proxy.Ready():
+		return running, nil
+	case <-running.done:
+		running.stop()
+
+		return nil, cmp.Or(running.err, errors.New("stopped before listening"))
+	case <-timer.C:
+		err = errors.New("timed out waiting for the proxy to listen")
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-m.ctx.Done():
+		err = m.ctx.Err()
+	}
+
+	running.stop()
+
+	return nil, err
+}
+
+// run starts the proxy, its panics are returned as errors so it's restarted like on the other
+// crashes.
+func run(ctx context.Context, proxy Proxy) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return proxy.Start(ctx)
+}
+
+// supervise restarts the proxy each time it crashes until the manager is closed.
+func (m *Manager) supervise(s *supervised, running *instance) {
+	defer m.wg.Done()
+
+	delay := minRestartDelay
+	for {
+		startedAt := time.Now()
+
+		select {
+		case <-m.ctx.Done():
+			running.stop()
+
+			return
+		case <-running.done:
+			running.stop()
+		}
+
+		if time.Since(startedAt) >= stableRunTime {
+			delay = minRestartDelay
+		}
+
+		err := cmp.Or(running.err, errors.New("stopped"))
+		for {
+			m.crashed(s, err, delay)
+
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(delay*2, maxRestartDelay)
+
+			if running, err = m.launch(m.ctx, s); err == nil {
+				break
+			}
+		}
+
+		m.mu.Lock()
+		s.status.Healthy = true
+		m.mu.Unlock()
+
+		m.logger.Info(m.ctx, "volume proxy restarted", zap.String("sandboxId", m.sandboxID), zap.String("proxy", s.name))
+	}
+}
+
+// crashed records the crash of the proxy, it's restarted after the delay.
+func (m *Manager) crashed(s *supervised, err error, delay time.Duration) {
+	m.mu.Lock()
+	s.status.Healthy = false
+	s.status.Restarts++
+	s.status.LastError = err.Error()
+	m.mu.Unlock()
+
+	m.logger.Error(m.ctx, "volume proxy crashed, restarting it",
+		zap.String("sandboxId", m.sandboxID),
+		zap.String("proxy", s.name),
+		zap.Duration("delay", delay),
+		zap.Error(err),
+	)
+}
+
+// Status returns the health of the proxies, in the order they were started.
+func (m *Manager) Status() []Status {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make([]Status, 0, len(m.proxies))
+	for _, s := range m.proxies {
+		statuses = append(statuses, s.status)
+	}
+
+	return statuses
+}
+
+// Close stops the proxies and waits for them to close their connections.
+func (m *Manager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+
+	m.cancel()
+
+	ctx, cancel := context.WithTimeout(ctx, stopTimeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for the proxies of sandbox %s to stop: %w", m.sandboxID, ctx.Err())
+	}
+}
@@ -0,0 +1,136 @@
+package volumeproxy
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+)
+
+// fakeProxy serves until its context is done or it's crashed with an error.
+type fakeProxy struct {
+	ready   chan struct{}
+	crash   chan error
+	stopped atomic.Bool
+	closed  atomic.Bool
+}
+
+func newFakeProxy() *fakeProxy {
+	return &fakeProxy{ready: make(chan struct{}), crash: make(chan error, 1)}
+}
+
+func (p *fakeProxy) Start(ctx context.Context) error {
+	close(p.ready)
+
+	select {
+	case <-ctx.Done():
+		p.stopped.Store(true)
+
+		return nil
+	case err := <-p.crash:
+		if err == nil {
+			panic("crashed")
+		}
+
+		return err
+	}
+}
+
+func (p *fakeProxy) Ready() <-chan struct{} {
+	return p.ready
+}
+
+func (p *fakeProxy) Close() error {
+	p.closed.Store(true)
+
+	return nil
+}
+
+// fakeProxies creates the proxies of a manager and keeps them in order.
+type fakeProxies struct {
+	created chan *fakeProxy
+}
+
+func (f *fakeProxies) newProxy() (Proxy, error) {
+	proxy := newFakeProxy()
+	f.created <- proxy
+
+	return proxy, nil
+}
+
+func (f *fakeProxies) next(t *testing.T) *fakeProxy {
+	t.Helper()
+
+	select {
+	case proxy := <-f.created:
+		return proxy
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "proxy not restarted")
+
+		return nil
+	}
+}
+
+func TestManagerRestartsProxies(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager(t.Context(), "sbx_1", logger.NewNopLogger())
+	proxies := &fakeProxies{created: make(chan *fakeProxy, 10)}
+
+	require.NoError(t, m.Start(t.Context(), "redis", proxies.newProxy))
+	first := proxies.next(t)
+	assert.Equal(t, []Status{{Name: "redis", Healthy: true}}, m.Status())
+
+	first.crash <- errors.New("listener closed")
+	second := proxies.next(t)
+	<-second.ready
+	assert.True(t, first.closed.Load())
+
+	// The panics are crashes too
+	second.crash <- nil
+	third := proxies.next(t)
+	<-third.ready
+
+	require.Eventually(t, func() bool {
+		return m.Status()[0].Healthy
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []Status{{Name: "redis", Healthy: true, Restarts: 2, LastError: "panic: crashed"}}, m.Status())
+
+	require.NoError(t, m.Close(t.Context()))
+	assert.True(t, third.stopped.Load())
+	assert.True(t, third.closed.Load())
+
+	// The proxies can't be started once the manager is closed
+	assert.Error(t, m.Start(t.Context(), "gcs", proxies.newProxy))
+}
+
+func TestManagerStartFails(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager(t.Context(), "sbx_1", logger.NewNopLogger())
+
+	err := m.Start(t.Context(), "gcs", func() (Proxy, error) {
+		return &failingProxy{fakeProxy: newFakeProxy(), err: errors.New("address already in use")}, nil
+	})
+	require.ErrorContains(t, err, "address already in use")
+	assert.Empty(t, m.Status())
+
+	require.NoError(t, m.Close(t.Context()))
+}
+
+// failingProxy fails to listen.
+type failingProxy struct {
+	*fakeProxy
+
+	err error
+}
+
+func (p *failingProxy) Start(context.Context) error {
+	return p.err
+}
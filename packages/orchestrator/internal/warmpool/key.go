@@ -0,0 +1,113 @@
+package warmpool
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// Key identifies the sandboxes that are interchangeable before they are handed over.
+// Everything that is fixed at boot must be part of the key, the owner specific
+// configuration (env vars, access token, timeout) is applied on claim.
+type Key struct {
+	BuildID            string
+	KernelVersion      string
+	FirecrackerVersion string
+	EnvdVersion        string
+
+	Vcpu            int64
+	RamMB           int64
+	TotalDiskSizeMB int64
+	HugePages       bool
+
+	// Volume fields are empty for pools without a volume.
+	VolumeID        string
+	VolumeMountPath string
+	// VolumeSubpath is the directory of the volume mounted instead of the whole volume.
+	VolumeSubpath   string
+	VolumeRedisDB   int32
+	VolumeGCSBucket string
+	VolumeMemoryMB  int64
+	VolumeCPUWeight int64
+	// VolumeMountOptions are the mount options of the volume in a stable order.
+	VolumeMountOptions string
+	// The rate limits of the GCS proxy of the volume, from the tier of the team.
+	VolumeBandwidthBytesPerSecond int64
+	VolumeRequestsPerSecond       int64
+}
+
+// keyFor returns the pool key for the sandbox config and whether the sandbox
+// can be served from a warm pool at all.
+//
+// Snapshots are resumed from their own memory, custom network rules are applied at boot
+// and writable volumes can be mounted by a single sandbox only, so none of them are pooled.
+// Pooled volumes are mounted before the request, they can't be prewarmed for it either.
+func keyFor(config *orchestrator.SandboxConfig, allowInternetDefault bool) (Key, bool) {
+	if config == nil || config.GetSnapshot() {
+		return Key{}, false
+	}
+
+	allowInternet := allowInternetDefault
+	if config.AllowInternetAccess != nil {
+		allowInternet = config.GetAllowInternetAccess()
+	}
+
+	if allowInternet != allowInternetDefault || hasNetworkRules(config.GetNetwork()) {
+		return Key{}, false
+	}
+
+	key := Key{
+		BuildID:            config.GetBuildId(),
+		KernelVersion:      config.GetKernelVersion(),
+		FirecrackerVersion: config.GetFirecrackerVersion(),
+		EnvdVersion:        config.GetEnvdVersion(),
+
+		Vcpu:            config.GetVcpu(),
+		RamMB:           config.GetRamMb(),
+		TotalDiskSizeMB: config.GetTotalDiskSizeMb(),
+		HugePages:       config.GetHugePages(),
+	}
+
+	if volume := config.GetVolume(); volume != nil {
+		if !volume.GetReadOnly() || volume.GetReadOnlyRoot() || len(volume.GetOverlayPaths()) > 0 || volume.GetPersistHome() || len(volume.GetPrewarm()) > 0 {
+			return Key{}, false
+		}
+
+		key.VolumeID = volume.GetVolumeId()
+		key.VolumeMountPath = volume.GetMountPath()
+		key.VolumeSubpath = volume.GetSubpath()
+		key.VolumeRedisDB = volume.GetRedisDb()
+		key.VolumeGCSBucket = volume.GetGcsBucket()
+		key.VolumeMemoryMB = volume.GetMountMemoryMb()
+		key.VolumeCPUWeight = volume.GetMountCpuWeight()
+		key.VolumeMountOptions = mountOptionsKey(volume.GetMountOptions())
+		key.VolumeBandwidthBytesPerSecond = volume.GetBandwidthBytesPerSecond()
+		key.VolumeRequestsPerSecond = volume.GetRequestsPerSecond()
+	}
+
+	return key, true
+}
+
+// mountOptionsKey encodes the mount options so that the same options give the same key.
+func mountOptionsKey(options map[string]string) string {
+	pairs := make([]string, 0, len(options))
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		pairs = append(pairs, name+"="+options[name])
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func hasNetworkRules(network *orchestrator.SandboxNetworkConfig) bool {
+	egress := network.GetEgress()
+	if len(egress.GetAllowedCidrs()) > 0 || len(egress.GetDeniedCidrs()) > 0 ||
+		len(egress.GetAllowedDomains()) > 0 || len(egress.GetDeniedDomains()) > 0 {
+		return true
+	}
+
+	ingress := network.GetIngress()
+
+	return ingress.GetTrafficAccessToken() != "" || ingress.GetMaskRequestHost() != ""
+}
@@ -0,0 +1,197 @@
+package warmpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
+)
+
+func TestKeyFor(t *testing.T) {
+	t.Parallel()
+
+	base := func() *orchestrator.SandboxConfig {
+		return &orchestrator.SandboxConfig{
+			TemplateId:    "template-id",
+			BuildId:       "build-id",
+			SandboxId:     "sandbox-id",
+			EnvdVersion:   "0.4.4",
+			Vcpu:          2,
+			RamMb:         512,
+			EnvVars:       map[string]string{"FOO": "bar"},
+			Secrets:       map[string]string{"TOKEN": "secret"},
+			ExecutionId:   "execution-id",
+			TeamId:        "team-id",
+			KernelVersion: "vmlinux-6.1.158",
+			Network: &orchestrator.SandboxNetworkConfig{
+				Egress:  &orchestrator.SandboxNetworkEgressConfig{},
+				Ingress: &orchestrator.SandboxNetworkIngressConfig{},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*orchestrator.SandboxConfig)
+		want   bool
+	}{
+		{
+			name:   "plain template",
+			modify: func(*orchestrator.SandboxConfig) {},
+			want:   true,
+		},
+		{
+			name:   "snapshot",
+			modify: func(c *orchestrator.SandboxConfig) { c.Snapshot = true },
+			want:   false,
+		},
+		{
+			name: "internet access differs from the default",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.AllowInternetAccess = new(bool)
+				c.Network.Egress.DeniedCidrs = []string{sandbox_network.AllInternetTrafficCIDR}
+			},
+			want: false,
+		},
+		{
+			name:   "egress rules",
+			modify: func(c *orchestrator.SandboxConfig) { c.Network.Egress.AllowedDomains = []string{"example.com"} },
+			want:   false,
+		},
+		{
+			name: "traffic access token",
+			modify: func(c *orchestrator.SandboxConfig) {
+				token := "token"
+				c.Network.Ingress.TrafficAccessToken = &token
+			},
+			want: false,
+		},
+		{
+			name: "read-only volume",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true}
+			},
+			want: true,
+		},
+		{
+			name: "writable volume",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data"}
+			},
+			want: false,
+		},
+		{
+			name: "read-only volume with overlays",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true, OverlayPaths: []string{"/home"}}
+			},
+			want: false,
+		},
+		{
+			name: "persisted home",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/mnt/home", ReadOnly: true, PersistHome: true}
+			},
+			want: false,
+		},
+		{
+			name: "prewarmed volume",
+			modify: func(c *orchestrator.SandboxConfig) {
+				c.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true, Prewarm: []string{"models/*.bin"}}
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := base()
+			tt.modify(config)
+
+			_, ok := keyFor(config, true)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+
+	t.Run("owner specific fields don't change the key", func(t *testing.T) {
+		t.Parallel()
+
+		a, _ := keyFor(base(), true)
+
+		other := base()
+		other.SandboxId = "other-sandbox-id"
+		other.TeamId = "other-team-id"
+		other.EnvVars = nil
+		other.Secrets = nil
+		b, _ := keyFor(other, true)
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("volume changes the key", func(t *testing.T) {
+		t.Parallel()
+
+		a, _ := keyFor(base(), true)
+
+		withVolume := base()
+		withVolume.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true}
+		b, _ := keyFor(withVolume, true)
+
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("volume subpath changes the key", func(t *testing.T) {
+		t.Parallel()
+
+		withSubpath := func(subpath string) Key {
+			config := base()
+			config.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true, Subpath: subpath}
+			key, _ := keyFor(config, true)
+
+			return key
+		}
+
+		a := withSubpath("/datasets/imagenet")
+		assert.Equal(t, a, withSubpath("/datasets/imagenet"))
+		assert.NotEqual(t, a, withSubpath("/datasets/coco"))
+		assert.NotEqual(t, a, withSubpath(""))
+	})
+
+	t.Run("volume mount options change the key", func(t *testing.T) {
+		t.Parallel()
+
+		withOptions := func(options map[string]string) Key {
+			config := base()
+			config.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true, MountOptions: options}
+			key, _ := keyFor(config, true)
+
+			return key
+		}
+
+		a := withOptions(map[string]string{"cacheSizeMB": "2048", "bufferSizeMB": "512"})
+		assert.Equal(t, a, withOptions(map[string]string{"bufferSizeMB": "512", "cacheSizeMB": "2048"}))
+		assert.NotEqual(t, a, withOptions(map[string]string{"cacheSizeMB": "4096", "bufferSizeMB": "512"}))
+		assert.NotEqual(t, a, withOptions(nil))
+	})
+
+	t.Run("volume rate limits change the key", func(t *testing.T) {
+		t.Parallel()
+
+		withLimits := func(bandwidth, requests int64) Key {
+			config := base()
+			config.Volume = &orchestrator.VolumeConfig{VolumeId: "vol_1", MountPath: "/data", ReadOnly: true, BandwidthBytesPerSecond: bandwidth, RequestsPerSecond: requests}
+			key, _ := keyFor(config, true)
+
+			return key
+		}
+
+		a := withLimits(50<<20, 100)
+		assert.Equal(t, a, withLimits(50<<20, 100))
+		assert.NotEqual(t, a, withLimits(100<<20, 100))
+		assert.NotEqual(t, a, withLimits(50<<20, 0))
+	})
+}
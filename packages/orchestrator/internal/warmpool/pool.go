@@ -0,0 +1,560 @@
+package warmpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/cfg"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/sandbox/template"
+	featureflags "github.com/moru-ai/sandbox-infra/packages/shared/pkg/feature-flags"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/logger"
+	sandbox_network "github.com/moru-ai/sandbox-infra/packages/shared/pkg/sandbox-network"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+)
+
+const (
+	// sandboxIDPrefix marks sandboxes that haven't been claimed yet.
+	sandboxIDPrefix = "warm-"
+
+	refreshInterval = 5 * time.Second
+	bootTimeout     = 60 * time.Second
+	// maxConcurrentBoots keeps the pool from competing with regular sandbox starts.
+	maxConcurrentBoots = 1
+)
+
+var (
+	tracer = otel.Tracer("github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/warmpool")
+	meter  = otel.GetMeterProvider().Meter("orchestrator.internal.warmpool")
+
+	claimsCounter    = utils.Must(telemetry.GetCounter(meter, telemetry.WarmPoolClaimsCounterName))
+	reclaimedCounter = utils.Must(telemetry.GetCounter(meter, telemetry.WarmPoolReclaimedCounterName))
+)
+
+// Claim results reported in the claims metric.
+const (
+	claimHit    = "hit"
+	claimMiss   = "miss"
+	claimFailed = "failed"
+)
+
+// Reclaim reasons reported in the reclaimed metric.
+const (
+	reclaimExpired = "expired"
+	reclaimIdle    = "idle"
+	reclaimExited  = "exited"
+	reclaimClosed  = "closed"
+)
+
+type member struct {
+	sbx     *sandbox.Sandbox
+	readyAt time.Time
+
+	// claimed is set under the pool lock once the sandbox is handed over,
+	// the new owner is then responsible for its cleanup.
+	claimed    bool
+	cancelWait context.CancelFunc
+}
+
+type pool struct {
+	// spec is the sandbox config the pool members are booted from, without the owner specific fields.
+	spec       *orchestrator.SandboxConfig
+	ready      []*member
+	booting    int
+	lastDemand time.Time
+}
+
+// Pool keeps pre-booted sandboxes per template and optional read-only volume,
+// so sandbox creation can skip the cold start.
+//
+// Pools are created on demand from the create requests the orchestrator receives
+// and are dropped again after they haven't been used for the configured idle timeout.
+type Pool struct {
+	config        cfg.WarmPoolConfig
+	allowInternet bool
+
+	factory       *sandbox.Factory
+	templateCache *template.Cache
+	sandboxes     *sandbox.Map
+	featureFlags  *featureflags.Client
+
+	mu    sync.Mutex
+	pools map[Key]*pool
+
+	boots  *semaphore.Weighted
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(
+	ctx context.Context,
+	config cfg.Config,
+	factory *sandbox.Factory,
+	templateCache *template.Cache,
+	sandboxes *sandbox.Map,
+	featureFlags *featureflags.Client,
+) *Pool {
+	p := &Pool{
+		config:        config.WarmPool,
+		allowInternet: config.AllowSandboxInternet,
+		factory:       factory,
+		templateCache: templateCache,
+		sandboxes:     sandboxes,
+		featureFlags:  featureFlags,
+		pools:         make(map[Key]*pool),
+		boots:         semaphore.NewWeighted(maxConcurrentBoots),
+		done:          make(chan struct{}),
+	}
+
+	_, err := telemetry.GetObservableUpDownCounter(meter, telemetry.WarmPoolSizeMeterName, func(_ context.Context, observer metric.Int64Observer) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		for key, pl := range p.pools {
+			observer.Observe(int64(len(pl.ready)), metric.WithAttributes(
+				telemetry.WithTemplateID(pl.spec.GetTemplateId()),
+				telemetry.WithBuildID(key.BuildID),
+				attribute.Bool("volume", key.VolumeID != ""),
+			))
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.L().Error(ctx, "Error registering warm pool size metric", zap.String("metric_name", string(telemetry.WarmPoolSizeMeterName)), zap.Error(err))
+	}
+
+	return p
+}
+
+func (p *Pool) enabled() bool {
+	return p != nil && p.config.Size > 0
+}
+
+// Start runs the loop that refills the pools and reclaims stale members until Close is called.
+func (p *Pool) Start(ctx context.Context) {
+	if !p.enabled() {
+		close(p.done)
+
+		return
+	}
+
+	ctx, p.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	go func() {
+		defer close(p.done)
+
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.refresh(ctx)
+			}
+		}
+	}()
+}
+
+// Close stops the refresh loop and all sandboxes that weren't claimed.
+func (p *Pool) Close(ctx context.Context) error {
+	if !p.enabled() {
+		return nil
+	}
+
+	if p.cancel != nil {
+		p.cancel()
+		<-p.done
+	}
+
+	p.mu.Lock()
+	var members []*member
+	for key, pl := range p.pools {
+		members = append(members, pl.ready...)
+		delete(p.pools, key)
+	}
+	p.mu.Unlock()
+
+	var errs []error
+	for _, m := range members {
+		reclaimedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reclaimClosed)))
+		if err := m.sbx.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Claim hands over a ready sandbox matching the request, or returns nil if there is none.
+// Every eligible request counts as demand for its pool, so the pool for it is created
+// (or kept alive) and refilled in the background.
+func (p *Pool) Claim(ctx context.Context, req *orchestrator.SandboxCreateRequest) *sandbox.Sandbox {
+	if !p.enabled() || !req.GetWarmPool() {
+		return nil
+	}
+
+	key, ok := keyFor(req.GetSandbox(), p.allowInternet)
+	if !ok {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "warm-pool-claim")
+	defer span.End()
+
+	m := p.take(key, req.GetSandbox())
+	if m == nil {
+		claimsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("result", claimMiss)))
+		span.SetAttributes(attribute.String("warm_pool.result", claimMiss))
+
+		return nil
+	}
+
+	warmSandboxID := m.sbx.Runtime.SandboxID
+	config := req.GetSandbox()
+
+	err := m.sbx.Claim(
+		ctx,
+		config.GetBaseTemplateId(),
+		sandbox.EnvdMetadata{
+			Version:     config.GetEnvdVersion(),
+			AccessToken: config.EnvdAccessToken,
+			Vars:        config.GetEnvVars(),
+			Secrets:     config.GetSecrets(),
+		},
+		sandbox.RuntimeMetadata{
+			TemplateID:  config.GetTemplateId(),
+			SandboxID:   config.GetSandboxId(),
+			ExecutionID: config.GetExecutionId(),
+			TeamID:      config.GetTeamId(),
+		},
+		req.GetEndTime().AsTime(),
+		config,
+	)
+	if err != nil {
+		claimsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("result", claimFailed)))
+		span.SetAttributes(attribute.String("warm_pool.result", claimFailed))
+		logger.L().Warn(ctx, "failed to claim warm pool sandbox, starting a new one",
+			logger.WithSandboxID(config.GetSandboxId()),
+			zap.String("warm_sandbox_id", warmSandboxID),
+			zap.Error(err),
+		)
+
+		// The sandbox is half configured for the new owner, it can't go back to the pool.
+		p.sandboxes.Remove(warmSandboxID)
+		go p.release(context.WithoutCancel(ctx), m.sbx)
+
+		return nil
+	}
+
+	p.sandboxes.Rename(warmSandboxID, m.sbx)
+
+	claimsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("result", claimHit)))
+	span.SetAttributes(
+		attribute.String("warm_pool.result", claimHit),
+		attribute.Int64("warm_pool.member_age_ms", time.Since(m.readyAt).Milliseconds()),
+	)
+
+	return m.sbx
+}
+
+// take records demand for the pool and pops its oldest ready member, if any.
+func (p *Pool) take(key Key, config *orchestrator.SandboxConfig) *member {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pl, ok := p.pools[key]
+	if !ok {
+		if len(p.pools) >= p.config.MaxPools {
+			return nil
+		}
+
+		pl = &pool{spec: poolSpec(config)}
+		p.pools[key] = pl
+	}
+
+	pl.lastDemand = time.Now()
+
+	if len(pl.ready) == 0 {
+		return nil
+	}
+
+	m := pl.ready[0]
+	pl.ready = pl.ready[1:]
+
+	m.claimed = true
+	m.cancelWait()
+
+	return m
+}
+
+// refresh reclaims expired members, drops idle pools and boots a missing member.
+func (p *Pool) refresh(ctx context.Context) {
+	now := time.Now()
+
+	var stale []*member
+	var reasons []string
+	var bootKey Key
+	var bootSpec *orchestrator.SandboxConfig
+
+	p.mu.Lock()
+	for key, pl := range p.pools {
+		idle := now.Sub(pl.lastDemand) > p.config.IdleTimeout
+
+		kept := pl.ready[:0]
+		for _, m := range pl.ready {
+			switch {
+			case idle:
+				stale, reasons = append(stale, m), append(reasons, reclaimIdle)
+			case now.Sub(m.readyAt) > p.config.MaxAge:
+				stale, reasons = append(stale, m), append(reasons, reclaimExpired)
+			default:
+				kept = append(kept, m)
+			}
+		}
+		pl.ready = kept
+
+		if idle {
+			if pl.booting == 0 {
+				delete(p.pools, key)
+			}
+
+			continue
+		}
+
+		if bootSpec == nil && len(pl.ready)+pl.booting < p.config.Size {
+			bootKey, bootSpec = key, pl.spec
+		}
+	}
+	p.mu.Unlock()
+
+	for i, m := range stale {
+		reclaimedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reasons[i])))
+
+		// Cleanup is done by the member watcher once the sandbox exits.
+		if err := m.sbx.Stop(ctx); err != nil {
+			logger.L().Warn(ctx, "failed to stop stale warm pool sandbox", logger.WithSandboxID(m.sbx.Runtime.SandboxID), zap.Error(err))
+		}
+	}
+
+	if bootSpec == nil || !p.hasCapacity(ctx) || !p.boots.TryAcquire(1) {
+		return
+	}
+
+	p.mu.Lock()
+	pl, ok := p.pools[bootKey]
+	if ok {
+		pl.booting++
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		p.boots.Release(1)
+
+		return
+	}
+
+	go func() {
+		defer p.boots.Release(1)
+
+		p.boot(ctx, bootKey, bootSpec)
+	}()
+}
+
+// hasCapacity reports whether another sandbox fits on the node without blocking regular sandboxes.
+func (p *Pool) hasCapacity(ctx context.Context) bool {
+	maxSandboxes := p.featureFlags.IntFlag(ctx, featureflags.MaxSandboxesPerNode)
+
+	return p.sandboxes.Count() < maxSandboxes-1
+}
+
+func (p *Pool) boot(ctx context.Context, key Key, spec *orchestrator.SandboxConfig) {
+	ctx, span := tracer.Start(ctx, "warm-pool-boot")
+	defer span.End()
+
+	m, err := p.startMember(ctx, spec)
+
+	p.mu.Lock()
+	pl, ok := p.pools[key]
+	if ok {
+		pl.booting--
+	}
+	if err == nil && ok {
+		pl.ready = append(pl.ready, m)
+	}
+	p.mu.Unlock()
+
+	if err != nil {
+		telemetry.ReportError(ctx, "failed to boot warm pool sandbox", err, telemetry.WithTemplateID(spec.GetTemplateId()))
+
+		return
+	}
+
+	if !ok {
+		// The pool was dropped while the sandbox was booting.
+		reclaimedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reclaimIdle)))
+		m.cancelWait()
+		p.sandboxes.RemoveByExecutionID(m.sbx.Runtime.SandboxID, m.sbx.Runtime.ExecutionID)
+		go p.release(context.WithoutCancel(ctx), m.sbx)
+
+		return
+	}
+
+	logger.L().Info(ctx, "Warm pool sandbox ready",
+		logger.WithSandboxID(m.sbx.Runtime.SandboxID),
+		logger.WithTemplateID(spec.GetTemplateId()),
+		logger.WithBuildID(key.BuildID),
+		zap.String("volume_id", key.VolumeID),
+	)
+}
+
+func (p *Pool) startMember(ctx context.Context, spec *orchestrator.SandboxConfig) (*member, error) {
+	bootCtx, cancel := context.WithTimeout(ctx, bootTimeout)
+	defer cancel()
+
+	tmpl, err := p.templateCache.GetTemplate(
+		bootCtx,
+		spec.GetBuildId(),
+		spec.GetKernelVersion(),
+		spec.GetFirecrackerVersion(),
+		false,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var network *orchestrator.SandboxNetworkConfig
+	if !p.allowInternet {
+		network = &orchestrator.SandboxNetworkConfig{
+			Egress: &orchestrator.SandboxNetworkEgressConfig{
+				DeniedCidrs: []string{sandbox_network.AllInternetTrafficCIDR},
+			},
+		}
+	}
+
+	now := time.Now()
+	sbx, err := p.factory.ResumeSandbox(
+		bootCtx,
+		tmpl,
+		sandbox.Config{
+			BaseTemplateID: spec.GetBaseTemplateId(),
+
+			Vcpu:            spec.GetVcpu(),
+			RamMB:           spec.GetRamMb(),
+			TotalDiskSizeMB: spec.GetTotalDiskSizeMb(),
+			HugePages:       spec.GetHugePages(),
+
+			Network: network,
+
+			Envd: sandbox.EnvdMetadata{
+				Version: spec.GetEnvdVersion(),
+			},
+
+			Volume: spec.GetVolume(),
+		},
+		sandbox.RuntimeMetadata{
+			TemplateID:  spec.GetTemplateId(),
+			SandboxID:   sandboxIDPrefix + id.Generate(),
+			ExecutionID: uuid.NewString(),
+		},
+		now,
+		now.Add(p.config.MaxAge),
+		// Unclaimed sandboxes have no API config, so they are not listed to the API.
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancelWait := context.WithCancel(context.WithoutCancel(ctx))
+	m := &member{
+		sbx:        sbx,
+		readyAt:    time.Now(),
+		cancelWait: cancelWait,
+	}
+
+	go p.watch(waitCtx, m)
+
+	return m, nil
+}
+
+// watch cleans up a member that exits before it's claimed.
+func (p *Pool) watch(ctx context.Context, m *member) {
+	_ = m.sbx.Wait(ctx)
+
+	p.mu.Lock()
+	claimed := m.claimed
+	if !claimed {
+		for _, pl := range p.pools {
+			if i := indexOf(pl.ready, m); i >= 0 {
+				pl.ready = append(pl.ready[:i], pl.ready[i+1:]...)
+				reclaimedCounter.Add(context.WithoutCancel(ctx), 1, metric.WithAttributes(attribute.String("reason", reclaimExited)))
+
+				break
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	if claimed || ctx.Err() != nil {
+		return
+	}
+
+	p.sandboxes.RemoveByExecutionID(m.sbx.Runtime.SandboxID, m.sbx.Runtime.ExecutionID)
+	p.release(context.WithoutCancel(ctx), m.sbx)
+}
+
+// release stops the sandbox and frees its resources.
+// The caller is responsible for removing it from the sandbox map first.
+func (p *Pool) release(ctx context.Context, sbx *sandbox.Sandbox) {
+	if err := sbx.Stop(ctx); err != nil {
+		logger.L().Warn(ctx, "failed to stop warm pool sandbox", logger.WithSandboxID(sbx.Runtime.SandboxID), zap.Error(err))
+	}
+
+	if err := sbx.Close(ctx); err != nil {
+		logger.L().Warn(ctx, "failed to cleanup warm pool sandbox", logger.WithSandboxID(sbx.Runtime.SandboxID), zap.Error(err))
+	}
+}
+
+// poolSpec strips the owner specific fields from the config the pool was created for.
+func poolSpec(config *orchestrator.SandboxConfig) *orchestrator.SandboxConfig {
+	spec := proto.CloneOf(config)
+
+	spec.SandboxId = ""
+	spec.ExecutionId = ""
+	spec.TeamId = ""
+	spec.Alias = nil
+	spec.EnvVars = nil
+	spec.Secrets = nil
+	spec.Metadata = nil
+	spec.EnvdAccessToken = nil
+	spec.Network = nil
+
+	return spec
+}
+
+func indexOf(members []*member, m *member) int {
+	for i, candidate := range members {
+		if candidate == m {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -23,7 +23,9 @@ import (
 
 	clickhouse "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg"
 	clickhouseevents "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/events"
+	clickhousemetrics "github.com/moru-ai/sandbox-infra/packages/clickhouse/pkg/metrics"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/cfg"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/dnsfirewall"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/events"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/factories"
 	moruhealthcheck "github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/healthcheck"
@@ -41,6 +43,7 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/tcpfirewall"
 	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/template/constants"
 	tmplserver "github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/template/server"
+	"github.com/moru-ai/sandbox-infra/packages/orchestrator/internal/warmpool"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/env"
 	event "github.com/moru-ai/sandbox-infra/packages/shared/pkg/events"
 	sharedFactories "github.com/moru-ai/sandbox-infra/packages/shared/pkg/factories"
@@ -54,6 +57,7 @@ import (
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/storage"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/telemetry"
 	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	volumestorage "github.com/moru-ai/sandbox-infra/packages/shared/pkg/volume-storage"
 )
 
 type closer struct {
@@ -306,6 +310,9 @@ func run(config cfg.Config) (success bool) {
 
 	sbxEventsDeliveryTargets := make([]event.Delivery[event.SandboxEvent], 0)
 
+	// Resource samples of the sandboxes are exported through OpenTelemetry without ClickHouse
+	var sbxMetricsSink metrics.SampleSink
+
 	// Clickhouse sandbox events delivery target
 	if config.ClickhouseConnectionString != "" {
 		clickhouseConn, err := clickhouse.NewDriver(config.ClickhouseConnectionString)
@@ -320,6 +327,20 @@ func run(config cfg.Config) (success bool) {
 
 		sbxEventsDeliveryTargets = append(sbxEventsDeliveryTargets, sbxEventsDeliveryClickhouse)
 		closers = append(closers, closer{"sandbox events delivery for clickhouse", sbxEventsDeliveryClickhouse.Close})
+
+		// Each delivery closes its own connection
+		metricsConn, err := clickhouse.NewDriver(config.ClickhouseConnectionString)
+		if err != nil {
+			logger.L().Fatal(ctx, "failed to create clickhouse driver", zap.Error(err))
+		}
+
+		sbxMetricsDeliveryClickhouse, err := clickhousemetrics.NewDefaultClickhouseSandboxMetricsDelivery(ctx, metricsConn, featureFlags)
+		if err != nil {
+			logger.L().Fatal(ctx, "failed to create clickhouse metrics delivery", zap.Error(err))
+		}
+
+		sbxMetricsSink = sbxMetricsDeliveryClickhouse
+		closers = append(closers, closer{"sandbox metrics delivery for clickhouse", sbxMetricsDeliveryClickhouse.Close})
 	}
 
 	// redis
@@ -352,7 +373,7 @@ func run(config cfg.Config) (success bool) {
 	}
 
 	// sandbox observer
-	sandboxObserver, err := metrics.NewSandboxObserver(ctx, nodeID, serviceName, commitSHA, version, serviceInstanceID, sandboxes)
+	sandboxObserver, err := metrics.NewSandboxObserver(ctx, nodeID, serviceName, commitSHA, version, serviceInstanceID, sandboxes, sbxMetricsSink)
 	if err != nil {
 		logger.L().Fatal(ctx, "failed to create sandbox observer", zap.Error(err))
 	}
@@ -385,6 +406,17 @@ func run(config cfg.Config) (success bool) {
 	})
 	closers = append(closers, closer{"tcp egress firewall", tcpFirewall.Close})
 
+	// DNS firewall for sandboxes with domain egress rules
+	dnsFirewall := dnsfirewall.New(
+		globalLogger,
+		config.NetworkConfig,
+		sandboxes,
+	)
+	startService("dns egress firewall", func() error {
+		return dnsFirewall.Start(ctx)
+	})
+	closers = append(closers, closer{"dns egress firewall", dnsFirewall.Close})
+
 	// device pool
 	devicePool, err := nbd.NewDevicePool()
 	if err != nil {
@@ -415,14 +447,49 @@ func run(config cfg.Config) (success bool) {
 
 	// Configure volumes support if enabled
 	if config.VolumesRedisURL != "" {
-		sandboxFactory.SetVolumesConfig(&sandbox.VolumesConfig{
+		storageProvider, err := volumestorage.Parse(config.VolumesStorageProvider)
+		if err != nil {
+			logger.L().Fatal(ctx, "invalid volumes storage provider", zap.Error(err))
+		}
+
+		sandboxFactory.SetVolumesConfig(ctx, &sandbox.VolumesConfig{
 			RedisURL:      config.VolumesRedisURL,
 			RedisTLSCA:    config.VolumesRedisTLSCA,
 			RedisPassword: config.VolumesRedisPassword,
+			RedisPoolSize: config.VolumesRedisProxyPoolSize,
 			GCSBucket:     config.VolumesGCSBucket,
 			TokenMinterSA: config.VolumesTokenMinterSA,
+			TokenLifetime: config.VolumesTokenLifetime,
+			GCSEndpoint:   storage.GCSEndpoint(config.VolumesGCSEndpoint),
+
+			CheckpointInterval: config.VolumesCheckpointInterval,
+			MountAttempts:      config.VolumesMountAttempts,
+			AllowMountFailure:  config.VolumesAllowMountFailure,
+
+			GCSProxyBytesPerSecond:    config.VolumesGCSProxyBytesPerSecond,
+			GCSProxyRequestsPerSecond: config.VolumesGCSProxyRequestsPerSecond,
+			GCSProxyDownscopedTokens:  config.VolumesGCSProxyDownscopedTokens,
+
+			TokenMinterCredentialsFile: config.VolumesTokenMinterCredentialsFile,
+
+			StorageProvider: storageProvider,
+			S3Region:        config.VolumesS3Region,
+			S3RoleARN:       config.VolumesS3RoleARN,
+			S3Endpoint:      config.VolumesS3Endpoint,
+
+			S3AccessKeyID:     config.VolumesS3AccessKeyID,
+			S3SecretAccessKey: config.VolumesS3SecretAccessKey,
 		})
 	}
+	closers = append(closers, closer{"sandbox factory", sandboxFactory.Close})
+
+	// warm pool of pre-booted sandboxes
+	warmPool := warmpool.New(ctx, config, sandboxFactory, templateCache, sandboxes, featureFlags)
+	warmPool.Start(ctx)
+	closers = append(closers, closer{"warm pool", warmPool.Close})
+
+	volEventsService := events.NewVolumeEventsService(volEventsDeliveryTargets)
+	sandboxFactory.SetVolumeEvents(volEventsService)
 
 	orchestratorService := server.New(ctx, server.ServiceConfig{
 		Config:           config,
@@ -437,7 +504,8 @@ func run(config cfg.Config) (success bool) {
 		Persistence:      persistence,
 		FeatureFlags:     featureFlags,
 		SbxEventsService: events.NewEventsService(sbxEventsDeliveryTargets),
-		VolEventsService: events.NewVolumeEventsService(volEventsDeliveryTargets),
+		VolEventsService: volEventsService,
+		WarmPool:         warmPool,
 	})
 
 	// template manager sandbox logger
@@ -462,7 +530,7 @@ func run(config cfg.Config) (success bool) {
 	})
 
 	// hyperloop server
-	hyperloopSrv, err := hyperloopserver.NewHyperloopServer(ctx, config.NetworkConfig.HyperloopProxyPort, globalLogger, sandboxes)
+	hyperloopSrv, err := hyperloopserver.NewHyperloopServer(ctx, config.NetworkConfig.HyperloopProxyPort, globalLogger, sandboxes, volEventsService)
 	if err != nil {
 		logger.L().Fatal(ctx, "failed to create hyperloop server", zap.Error(err))
 	}
@@ -476,6 +544,9 @@ func run(config cfg.Config) (success bool) {
 	})
 	closers = append(closers, closer{"hyperloop server", hyperloopSrv.Shutdown})
 
+	// The volumes are flushed while hyperloop still receives the unmount events of envd
+	closers = append(closers, closer{"sandbox volumes", sandboxFactory.UnmountVolumes})
+
 	grpcServer := factories.NewGRPCServer(tel)
 	orchestrator.RegisterSandboxServiceServer(grpcServer, orchestratorService)
 
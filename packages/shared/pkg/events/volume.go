@@ -21,6 +21,12 @@ const (
 	VolumeMountFailedEvent    = "volume.mount.failed"
 )
 
+// Volume file events, emitted when files are changed through the API
+const (
+	VolumeFileUploadedEvent = "volume.file.uploaded"
+	VolumeFileDeletedEvent  = "volume.file.deleted"
+)
+
 // Sandbox shutdown volume unmount events
 const (
 	SandboxShutdownVolumeUnmountStartedEvent   = "sandbox.shutdown.volume_unmount.started"
@@ -37,6 +43,8 @@ var ValidVolumeEventTypes = []string{
 	VolumeMountStartedEvent,
 	VolumeMountCompletedEvent,
 	VolumeMountFailedEvent,
+	VolumeFileUploadedEvent,
+	VolumeFileDeletedEvent,
 	SandboxShutdownVolumeUnmountStartedEvent,
 	SandboxShutdownVolumeUnmountCompletedEvent,
 	SandboxShutdownVolumeUnmountFailedEvent,
@@ -61,6 +69,9 @@ type VolumeEvent struct {
 	// Mount details (for mount events)
 	MountPath string `json:"mount_path,omitempty"`
 
+	// File details (for file events)
+	FilePath string `json:"file_path,omitempty"`
+
 	// Error information (for failed events)
 	ErrorMessage string `json:"error_message,omitempty"`
 	ErrorCode    string `json:"error_code,omitempty"`
@@ -94,6 +105,12 @@ func (e VolumeEvent) WithMountPath(mountPath string) VolumeEvent {
 	return e
 }
 
+// WithFilePath adds the path of the changed file to the event
+func (e VolumeEvent) WithFilePath(filePath string) VolumeEvent {
+	e.FilePath = filePath
+	return e
+}
+
 // WithError adds error information to the event
 func (e VolumeEvent) WithError(message string, code string) VolumeEvent {
 	e.ErrorMessage = message
@@ -28,6 +28,10 @@ type SandboxServiceClient interface {
 	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error)
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AttachVolume(ctx context.Context, in *SandboxAttachVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DetachVolume(ctx context.Context, in *SandboxDetachVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Mints a fresh GCS token for the attached volume and pushes it to envd, before the previous one expires.
+	RefreshVolumeToken(ctx context.Context, in *SandboxRefreshVolumeTokenRequest, opts ...grpc.CallOption) (*SandboxRefreshVolumeTokenResponse, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
 }
 
@@ -84,6 +88,33 @@ func (c *sandboxServiceClient) Pause(ctx context.Context, in *SandboxPauseReques
 	return out, nil
 }
 
+func (c *sandboxServiceClient) AttachVolume(ctx context.Context, in *SandboxAttachVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/AttachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) DetachVolume(ctx context.Context, in *SandboxDetachVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/DetachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) RefreshVolumeToken(ctx context.Context, in *SandboxRefreshVolumeTokenRequest, opts ...grpc.CallOption) (*SandboxRefreshVolumeTokenResponse, error) {
+	out := new(SandboxRefreshVolumeTokenResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/RefreshVolumeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	out := new(SandboxListCachedBuildsResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/ListCachedBuilds", in, out, opts...)
@@ -102,6 +133,10 @@ type SandboxServiceServer interface {
 	List(context.Context, *emptypb.Empty) (*SandboxListResponse, error)
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
+	AttachVolume(context.Context, *SandboxAttachVolumeRequest) (*emptypb.Empty, error)
+	DetachVolume(context.Context, *SandboxDetachVolumeRequest) (*emptypb.Empty, error)
+	// Mints a fresh GCS token for the attached volume and pushes it to envd, before the previous one expires.
+	RefreshVolumeToken(context.Context, *SandboxRefreshVolumeTokenRequest) (*SandboxRefreshVolumeTokenResponse, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
@@ -125,6 +160,15 @@ func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteR
 func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
+func (UnimplementedSandboxServiceServer) AttachVolume(context.Context, *SandboxAttachVolumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVolume not implemented")
+}
+func (UnimplementedSandboxServiceServer) DetachVolume(context.Context, *SandboxDetachVolumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
+}
+func (UnimplementedSandboxServiceServer) RefreshVolumeToken(context.Context, *SandboxRefreshVolumeTokenRequest) (*SandboxRefreshVolumeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshVolumeToken not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
@@ -231,6 +275,60 @@ func _SandboxService_Pause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_AttachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxAttachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).AttachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/AttachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).AttachVolume(ctx, req.(*SandboxAttachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_DetachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxDetachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).DetachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/DetachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).DetachVolume(ctx, req.(*SandboxDetachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_RefreshVolumeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxRefreshVolumeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).RefreshVolumeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/RefreshVolumeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).RefreshVolumeToken(ctx, req.(*SandboxRefreshVolumeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -276,6 +374,18 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _SandboxService_Pause_Handler,
 		},
+		{
+			MethodName: "AttachVolume",
+			Handler:    _SandboxService_AttachVolume_Handler,
+		},
+		{
+			MethodName: "DetachVolume",
+			Handler:    _SandboxService_DetachVolume_Handler,
+		},
+		{
+			MethodName: "RefreshVolumeToken",
+			Handler:    _SandboxService_RefreshVolumeToken_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
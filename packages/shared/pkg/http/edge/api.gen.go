@@ -31,6 +31,9 @@ type ServerInterface interface {
 	// List structured sandbox logs
 	// (GET /v1/sandboxes/{sandboxID}/logs)
 	V1SandboxLogs(c *gin.Context, sandboxID string, params V1SandboxLogsParams)
+	// Count sandbox logs per event type and level
+	// (GET /v1/sandboxes/{sandboxID}/logs/summary)
+	V1SandboxLogsSummary(c *gin.Context, sandboxID string, params V1SandboxLogsSummaryParams)
 	// Get time-series metrics for a sandbox
 	// (GET /v1/sandboxes/{sandboxID}/metrics)
 	V1SandboxMetrics(c *gin.Context, sandboxID string, params V1SandboxMetricsParams)
@@ -238,6 +241,58 @@ func (siw *ServerInterfaceWrapper) V1SandboxLogs(c *gin.Context) {
 	siw.Handler.V1SandboxLogs(c, sandboxID, params)
 }
 
+// V1SandboxLogsSummary operation middleware
+func (siw *ServerInterfaceWrapper) V1SandboxLogsSummary(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1SandboxLogsSummaryParams
+
+	// ------------- Required query parameter "teamID" -------------
+
+	if paramValue := c.Query("teamID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument teamID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "teamID", c.Request.URL.Query(), &params.TeamID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.V1SandboxLogsSummary(c, sandboxID, params)
+}
+
 // V1SandboxMetrics operation middleware
 func (siw *ServerInterfaceWrapper) V1SandboxMetrics(c *gin.Context) {
 
@@ -491,6 +546,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/v1/info", wrapper.V1Info)
 	router.GET(options.BaseURL+"/v1/sandboxes/metrics", wrapper.V1SandboxesMetrics)
 	router.GET(options.BaseURL+"/v1/sandboxes/:sandboxID/logs", wrapper.V1SandboxLogs)
+	router.GET(options.BaseURL+"/v1/sandboxes/:sandboxID/logs/summary", wrapper.V1SandboxLogsSummary)
 	router.GET(options.BaseURL+"/v1/sandboxes/:sandboxID/metrics", wrapper.V1SandboxMetrics)
 	router.GET(options.BaseURL+"/v1/service-discovery/nodes", wrapper.V1ServiceDiscoveryNodes)
 	router.POST(options.BaseURL+"/v1/service-discovery/nodes/drain", wrapper.V1ServiceDiscoveryNodeDrain)
@@ -107,6 +107,9 @@ type ClientInterface interface {
 	// V1SandboxLogs request
 	V1SandboxLogs(ctx context.Context, sandboxID string, params *V1SandboxLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V1SandboxLogsSummary request
+	V1SandboxLogsSummary(ctx context.Context, sandboxID string, params *V1SandboxLogsSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V1SandboxMetrics request
 	V1SandboxMetrics(ctx context.Context, sandboxID string, params *V1SandboxMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -202,6 +205,18 @@ func (c *Client) V1SandboxLogs(ctx context.Context, sandboxID string, params *V1
 	return c.Client.Do(req)
 }
 
+func (c *Client) V1SandboxLogsSummary(ctx context.Context, sandboxID string, params *V1SandboxLogsSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1SandboxLogsSummaryRequest(c.Server, sandboxID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) V1SandboxMetrics(ctx context.Context, sandboxID string, params *V1SandboxMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewV1SandboxMetricsRequest(c.Server, sandboxID, params)
 	if err != nil {
@@ -579,6 +594,74 @@ func NewV1SandboxLogsRequest(server string, sandboxID string, params *V1SandboxL
 	return req, nil
 }
 
+// NewV1SandboxLogsSummaryRequest generates requests for V1SandboxLogsSummary
+func NewV1SandboxLogsSummaryRequest(server string, sandboxID string, params *V1SandboxLogsSummaryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxID", runtime.ParamLocationPath, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sandboxes/%s/logs/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "teamID", runtime.ParamLocationQuery, params.TeamID); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewV1SandboxMetricsRequest generates requests for V1SandboxMetrics
 func NewV1SandboxMetricsRequest(server string, sandboxID string, params *V1SandboxMetricsParams) (*http.Request, error) {
 	var err error
@@ -1022,6 +1105,9 @@ type ClientWithResponsesInterface interface {
 	// V1SandboxLogsWithResponse request
 	V1SandboxLogsWithResponse(ctx context.Context, sandboxID string, params *V1SandboxLogsParams, reqEditors ...RequestEditorFn) (*V1SandboxLogsResponse, error)
 
+	// V1SandboxLogsSummaryWithResponse request
+	V1SandboxLogsSummaryWithResponse(ctx context.Context, sandboxID string, params *V1SandboxLogsSummaryParams, reqEditors ...RequestEditorFn) (*V1SandboxLogsSummaryResponse, error)
+
 	// V1SandboxMetricsWithResponse request
 	V1SandboxMetricsWithResponse(ctx context.Context, sandboxID string, params *V1SandboxMetricsParams, reqEditors ...RequestEditorFn) (*V1SandboxMetricsResponse, error)
 
@@ -1182,6 +1268,31 @@ func (r V1SandboxLogsResponse) StatusCode() int {
 	return 0
 }
 
+type V1SandboxLogsSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SandboxLogsSummaryResponse
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r V1SandboxLogsSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1SandboxLogsSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type V1SandboxMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1384,6 +1495,15 @@ func (c *ClientWithResponses) V1SandboxLogsWithResponse(ctx context.Context, san
 	return ParseV1SandboxLogsResponse(rsp)
 }
 
+// V1SandboxLogsSummaryWithResponse request returning *V1SandboxLogsSummaryResponse
+func (c *ClientWithResponses) V1SandboxLogsSummaryWithResponse(ctx context.Context, sandboxID string, params *V1SandboxLogsSummaryParams, reqEditors ...RequestEditorFn) (*V1SandboxLogsSummaryResponse, error) {
+	rsp, err := c.V1SandboxLogsSummary(ctx, sandboxID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1SandboxLogsSummaryResponse(rsp)
+}
+
 // V1SandboxMetricsWithResponse request returning *V1SandboxMetricsResponse
 func (c *ClientWithResponses) V1SandboxMetricsWithResponse(ctx context.Context, sandboxID string, params *V1SandboxMetricsParams, reqEditors ...RequestEditorFn) (*V1SandboxMetricsResponse, error) {
 	rsp, err := c.V1SandboxMetrics(ctx, sandboxID, params, reqEditors...)
@@ -1636,6 +1756,53 @@ func ParseV1SandboxLogsResponse(rsp *http.Response) (*V1SandboxLogsResponse, err
 	return response, nil
 }
 
+// ParseV1SandboxLogsSummaryResponse parses an HTTP response from a V1SandboxLogsSummaryWithResponse call
+func ParseV1SandboxLogsSummaryResponse(rsp *http.Response) (*V1SandboxLogsSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1SandboxLogsSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxLogsSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseV1SandboxMetricsResponse parses an HTTP response from a V1SandboxMetricsWithResponse call
 func ParseV1SandboxMetricsResponse(rsp *http.Response) (*V1SandboxMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
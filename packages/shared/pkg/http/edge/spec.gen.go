@@ -18,47 +18,50 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbXW8bt9L+KwTf96IF1pbc5pwL3Tl22gqxGyN2cg4QGAa1HEmsd8ktyZUtGPrvB/zY",
-	"b660ciw3OadXUXbJ4XD4zMwzs/QTjkWaCQ5cKzx5whJUJrgC+58347H5JxZcA9fmJ8myhMVEM8FHfyjB",
-	"zTMVLyEl5tf/S5jjCf6/USVz5N6q0TsphcSbzSbCFFQsWWaE4Al+SyiS8GcOSuNNhN+MTw6/5mmul8C1",
-	"l4rAjTOLvzn84r8LjeYi59Ss+I/XMPE1yBXIYpubyMuzZ/w2Zwm9EIt3XMu1eZBJkYHUzEFgziCh9heh",
-	"lBl5JLlqjGhZthyF3FQ0FxLpJaBELBDYRSKs1xngCVZaMr4wZvAPxOwPiC0MElhBsmvDF2JxYcdtIpyC",
-	"UmQBXY0uxAL5l6gwc0gDloLSJM26Em6KV0jMO1uZC5kSjSeYEg1HRkpX+ibCBuFMAsWTL7WlKrWLHUeF",
-	"xW83ET5LcqVB/i4odE+GCwrT8662ZjSanof2qECuWAy/CaV75mWSrYgGtBRKI0KpBKUQ4RT5qSgTUm8R",
-	"PeVKEx4HFbv2Ipgfs13Ja02kBnqqw+eBHpbA7WEYO6AHopCEBTPmAjrwWOpr6VztQlvtNPyESsKNlTx4",
-	"vh1ezf4MUjHn6c2d+hcF7vzwLTvxE85EmrKA5a5FLmPjBhTQaqDsFng97EIn3tlPj1qBM24asn0wTeS2",
-	"PGPK5+IlvWMIhHcjN88G4VY5C7w+YF8Ncp+/EZjlWQdWLSBVRm0dujYR0esfu/H29HCEgeepUXcJJNFL",
-	"kw+oJIybHUU458Xj24D52uGgs+5ZbSmknV8U6wkZL0FpSbSQ26R/qI17sTQiReLmMg3pUBTWNfkoEqhl",
-	"fSIlWf+dn/4Sdy82XfnSc1PLLkGvm0cshrp5xEH3NuwgFpZdNyQZmbGE6XURAxrO1+OTEdaQZok53Jlh",
-	"2BB2U0fZO04Ze1dtamIH2+RdBxDj+uefKnMzrmEBcisldqvuOiG/UCHFGK0k3EGWbRms5ft2y4Ymq5p9",
-	"KMxyExaZSdgRfiDSnKwrSkK2uRALdc4kxDqI3PJVjZUrpJdEI7UUeULRDJAEnUtuPa5QYy7kA5HmyYzE",
-	"9/ZnZ/UIPx6Z8UcrIjlJzaF8aerzSyml8fhtKXIT4WvC6Uw8XohF2F62hEAPTC9RWRLYYJYwbizfxIR9",
-	"GLY7499iaWMVbtqhp8iEFXA9hELXJJVTNtGAInV3wfmd1o+V6eq1ZK2GDJmsq+E6swxHucFOwZXbaeE3",
-	"SlOR25iqKUjp8BmDUneWxNb+D5wGHbpSRX30baYuFhKHkmBz4VrLPNa5BBdbSk7p5JrQMoSQtOEYICI2",
-	"ctnl5yRPNJ58ue30VCpTqf1X7i7aOmMvtmaN2mFegpYsDqSNLL+LRc5D7ClPZyCNxc6uPqlh+cOIyxXQ",
-	"uywOSDy7+oRy6xUZyBi49tAr5M4TQWqewq0CRixl6v5OC00CaeTGPEZmBFIZsWQMzdYa2hr/801QYyva",
-	"qNyV/EkBfb7gFNLtKqeQCrl+htQt2j5L5j5xKHU4GspGS9F3OWePAaU5e6xlMsaRglhwOkjzLTGutW4L",
-	"mFEN9vWDqpm3gbk6Sm4DicD7GKh/Mb10nqa6rqaKUduyzoBY4F2507K9JFk9IE/PFdLCHBqTyLBKpf3p",
-	"KdzZQ8uUlarB7TqGfM5ULFYg11X58NE35bt7f1Yt1aZmS8JpAhTN1haLxSeA/gLkWT22ti1CXabaRkIW",
-	"uvFEvuiU75G8erPH25IgI1UmtaFppNmyH5BJqhRirRnnkun1tZHmFD3N2HtYn+Z6aat6o+ASiKlZImzo",
-	"L57gfx+dXk2P3kOte0/sLPe9gfkmnGba1FD4UsgcvaMLQKdXUxzhVVF/4vHxyfHYKC0y4CRjeIJ/Ph4f",
-	"jw1/IHpp9Rm5von5uYBA6vnNvkbxEuJ7bCVJ+71kSsuXZ/5d43vWT+5jS1OYh7kt0VUeGwYzzxOzrU1U",
-	"aDJKSbz0/HunRrYC8hOQKirPXiUvvegX01VLMp87ejBMVz8BZVI8rrepeuMlf72qq5NRAZmgjhY6qgwi",
-	"Lnu4VkBTu88nU1dOhjR6ka9q7VZz6Ptaub9kXZacrvFT1736uhlar9zAyAyqPg1uH2sGVTYtQ/0orRKX",
-	"N3DbbmWeuyzzSEYkSUGDNPVuhz0AScswLh64qnNvy05s4PgzB1vX+LihgaS2pVOFJC1ziGrG7xQ7nQqM",
-	"Kd1Ohj+k5BGdjMc/9qzrx94xyz76Fy8Dbl+FWATV2wNCLEg5BuOsPALXSihO3qJtPARt4wMis8o3FlL1",
-	"TPPl1hhV5WlK5BpP8K+gW9TGRdI80SxLmlDTZKFa3KbrA0/+5/R8Myoquu3OcOGqrpYfWHyZ5NSB1/7I",
-	"PpSP2BYo44sa/x7QFjMcPWVJwiqiHlIwzqWyDcNKoQ6fTxlnaZ7iyTjE7Z869PbRjEa8LEt3dO9CaiXM",
-	"d4FLrUrGdTIeB0rc1K3qX+/SOLQkLfuR0UDfbnYxA6b4hSUapKHBtuNiv/SgH1y3BQmJXLvlx2M0nSMu",
-	"NFIZxGzOgEbePgqRJLH2O+4xVL1JtFdEajbaXiMGNvj14BBYy0SuIfPdBT+b46pSoLmbZ8S7wfl/YPa/",
-	"LnNvgbEXCoh7kIwDUgwbPm3sNCHRNjK2h8Si57klIu6Ogu843WdN4HTPFb/WYffpaladjBZz2o/GfN/8",
-	"xZzmkQJTbTdIDKlheJsvu4rniBbdmJEpIlRvmWSXrG5VoHLejpop1PRR+KXAQpLkw9yaa2BdhTe3z4aN",
-	"yX31SxnqrwRDebTtc9x5xCN7b8R2lIK3Hy6JvK8uOSQkBoUEdzUmUah262TIWZ/bxVzEBKXfCrp+uUS+",
-	"s6G4abaoTLDeDGkpNABgA7AhjtYE5f4PGDX85eBdY998Q6C6Z0nSj6n3LEnKKyoDkWPm/DcBx1rob9DU",
-	"QVO/x7KVQLbO61fQHxpTX4N89N1z+6p80rTAt0MuWnePGoVB/zkXF5HUyF7LUaMn+++Arkjny8eg3oiX",
-	"vj8hzyTERFfDQxy4vn93VWz/bovb1NeWDFNO4bG8glC0X8qbT70tF3ffZwmoUKSH7Yv5XEFPeyPY3Nir",
-	"AXPQftGA4mg/bd9xeihddxZV/8uNrde7cderWPFnMYO7bf4vgg7arOr/JLxXy6qIAPX7kt9d2XsT2ESV",
-	"l8rkY/LRpnz+VIaKTt4yQGg2lUxNd7v5TwAAAP//SEWWgKo4AAA=",
+	"H4sIAAAAAAAC/+xbW2/bOPb/KgT//4cZQImdme4+5K1NOjNGk2nRpN0FiiBgxGObE4nUkJQTI9B3X5DU",
+	"hZIoW3Yu0+zOUx2JOufw3M+P7AOORZoJDlwrfPyAJahMcAX2jzfTqfknFlwD1+YnybKExUQzwSd/KMHN",
+	"MxUvISXm1/9LmONj/H+ThubEvVWT91IKiYuiiDAFFUuWGSL4GL8jFEn4MwelcRHhN9Oj5+f5NtdL4Lqk",
+	"isCtM8zfPD/z34VGc5Fzajj+4yVUfAFyBbLaZhGV9KyN3+UsoWdi8Z5ruTYPMikykJo5F5gzSKj9RShl",
+	"hh5JPrVWdDRbr0LuUzQXEukloEQsEFgmEdbrDPAxVloyvsBF/UDc/AGxdYMEVpBs2/CZWJzZdUWEU1CK",
+	"LKAv0ZlYoPIlqtQckoCloDRJsz6Fy+oVEvPeVuZCpkTjY0yJhgNDpU+9iLDxcCaB4uNvHqtG7GrHUaXx",
+	"qyLCJ0muNMjfBYW+ZbigMDvtS2tWo9lpaI8K5IrF8JtQeuC7TLIV0YCWQmlEKJWgFCKcovJTlAmpN5Ce",
+	"caUJj4OCXZQkWLlms5AXmkgN9K0O2wPdLYFbYxg9oDuikIQFM+oCOtIsPi+dq23e5lmj/KChcGkpj/7e",
+	"Lm++/gpSMcH7Oy1fVH5XLt+wk/KDE5GmLKC5C5HL2IQBBbQaSbvjvKXbhSze28+AWAEbtxXZNUzbczuR",
+	"MeNz8ZTRMcaFt3tuno3yW+U08PIO+2Iu9/U7cbM867lVx5EapXaMrk1GLOWP3XprPRxh4HlqxF0CSfTS",
+	"1AMqCeNmRxHOefX4KqC+bjro8T3xWCG9znx+QsZLUFoSLeQm6h+9dU9WRqRI3LdMQzrWC31JPosEvKpP",
+	"pCTrv+vTXxLu1aabWNq3tGwj9LJ1xPpQv444170KB4h1y34YkozcsITpdZUDWsE3EJMR1pBmiTHujemw",
+	"IRymrmXvBWUsaEASu9gWb9+BGNc//9Som3ENC5AbW2LHdZuFSkYVFaO0uuEOdtm2g7X9vt2yaZOVpx8K",
+	"N7lJi8wU7AjfEWks64aSkG7OxEKdMgmxDnpu/crryhXSS6KRWoo8oegGkASdSw7UE2Mu5B2R5skNiW/t",
+	"zx73CN8fmPUHKyI5SY1RvrXl+aWm0nr8riZZRPiCcHoj7s/EIqwvO0KgO6aXqB4JbDJLGDeab/uEfRjW",
+	"O+Pf42hjBW7rYWDIhBVwPaaF9ijVnxTRiCF1+8D5SufHRnX+LOnNkCGV9SVcZ7bDUW6xE3DldlrFjdJU",
+	"5OaB0hSkdP4Zg1LXton1/gZOgwHdiKJORM5DNT5Pb0D2JOFaMlAuUgh3ktmOyEVLOTh3U+gWDh7lTjr9",
+	"55tgOn2sl+4IaXSM7hu62rHbY9vK6nOJ4PXDLHEBGMRtLrTMY51LcGm7btcdXRyN6/W6kR7o8Qx1x35O",
+	"8kTj429XPbiqsb3anXOfaUeTJVlPGx0NXuRpSuR6WJFW72qkc6EM5JDL7ri1MmwCWtVCk2SsQD3bbnX+",
+	"bgKy3KJKD576zkFLFgc0luXXWyPy5NMXNa6zMeRyBfQ6iwMUTz59QbnN1xnIGLguk2JFd54I4uVwbgUw",
+	"ZClTt9cDqrw0j5FZgVRG7JiAbtZ6bPKwpI3IfcpfFND9CaeQbhY5hVTI9R5UN0i7F81dKmTq/GjsnFST",
+	"vs45uw8Izdm912MxjhTEglO1j/N71bfDt+OYkef2vqE89bZ8zveSq0CLUsYYqH8xvXSRpvqhpqpVm/qh",
+	"EfnGMcC9w4RzkvkFenaqkBbGaEwiM+8oXVpP4d4eOqpsRA1u181up0zFYgVy3Qy2n8vjov7e95ryu0PD",
+	"knCaAEU3a+uL1eHU8Gi8F/rb1UUI//Q2EtLQZTliVmc4O9T+weL7rh7dkKp7grGlqn2YNKIQNxXYajPO",
+	"JdPrC0PNCfo2Yx9gbY7rzF/MCLgEQkHiCJvBDB/jfx+8/TQ7+ADeuRKxX7mTMFbCw5ppM93jcyFz9J4u",
+	"AL39NMMRXlXICJ4eHh1OjdAiA04yho/xz4fTwymOcEb00sozcYie+bmAQOn5zb5G8RLiW2wpSXuSN6P1",
+	"y5PyXeuk9afptE+sdHMLHqk8jkGpeW46Q7OvUpJJSuJlORlulcjO5uUHSFWYyKCQ5yXpJ5NVSzKfs3i8",
+	"rOUHKJPifr1J1MuS8uNFXR1NKpcJymhdR9VJxFUPJnhPuq9HMwd0hCR6kvPe7iFI6OS33l+yrsEQB0n6",
+	"sjfn7iF+9QYmZlFzaL15rVnU6LRO9ZO0KVylgrt6q+vceV1HMiJJChqkQWJ63QOQtE7j4o4rv7213YlN",
+	"HH/mINdN3tBAUgs2NilJyxwiT/m9MbyHDTClu8Xwh5Tco6Pp9McBvuXaa0bVRuZ1wh3CLqqkevWMLhZs",
+	"OUb7WW0CN7pXlrfeNh3jbdNn9Mym3liX8ivNtyujVOXGQHyMfwXdaW1cJs0TzbKk7WqaLFSnt+nHwEP5",
+	"c3ZaTKqBeHMwnIlFIA6sf5ni1HOv3T37uWLEgvOML5DuNvmbAFvTo6csSVjTqIcEjHOpLJTdCNTr51PG",
+	"WWowrGmot3/otbf3ZjXi/ui8CVcOiZWw8nyilqruuI6m08CImzqu5ettEodY0hopj0bGdhtfD6jiF5Zo",
+	"kKYN9uCLHxwOiIREDgj88RDN5ogLjVQGMZszoFGpH4VIklj9HQ4oyke1dspIbXDtJXJgq78enQK9SmQV",
+	"8fqSn61xzSjQ3s2e+W5Skx+T90o87nWmv0sfdfAzGppLkaK7JYuXTTYkEpAFDEwQlTmjmq+RSKgpQRI0",
+	"YRzq87WQ5BUkv29afKF46iKte4cVqhzq1YWXhXTbe9kAGO8cbaO77ZG99kXd6eLoSeNvh5YeR8/brNhO",
+	"xYSrhQ03NyAjAm17z/Ge0114Aqc7cnxsOO9yTtHghp05Zbeh4XVPC8aaBwrscYs/MhDPhzfFssMXDmiF",
+	"fU64oKAGQQnLsrldh+rvtiAUIYhV4adyFpIkH+dWXSNRDFxc7e02ptP0L+epv9IZatN27bjVxBN7f9AI",
+	"kgVvwZ0TeVubOUtIDAoJbneMiELe7cMxtj61zFzGBKXfCbp+ujK/Fb4viqKbrIsxAF7LAWwCNmOaVUG9",
+	"/2fMGuV/Etm29s135FS3LEmGfeoDS5L6quJIzzHf/Dc5jtXQ307jO41/n3FjA9mx16+gP7Y+fYnmY+i+",
+	"86PqSVsD309z0bmD2hrDh+1cXUhVE3s9U00e7L8jMMjeOeOoUbykvntDnkmIiW6Wh3pgf/+Wxx7DvdvU",
+	"Y0eGGadwX9+pqcDO+gbsIMDp7n0uAVWCDHT7Yj5XMAAmBqHEneDOZ0VnH4tChAal55J161D1vwwjv9zN",
+	"60HBKgRkNLZdXaN8Tihr+ALGTkhWlQH8e/Ovbuy9DGyiqUt18TH1qKifP9Spole3iqh5WU/IxVXxnwEA",
+	"YpzdTrI+AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -193,6 +193,18 @@ type SandboxLogEntry struct {
 // SandboxLogEventType Type of sandbox log event
 type SandboxLogEventType string
 
+// SandboxLogsCount Number of sandbox log entries with an event type and level
+type SandboxLogsCount struct {
+	// Count Number of log entries
+	Count int64 `json:"count"`
+
+	// EventType Type of sandbox log event
+	EventType SandboxLogEventType `json:"eventType"`
+
+	// Level Log level for build logs
+	Level LogLevel `json:"level"`
+}
+
 // SandboxLogsResponse defines model for SandboxLogsResponse.
 type SandboxLogsResponse struct {
 	// LogEntries Structured logs of the sandbox
@@ -202,6 +214,15 @@ type SandboxLogsResponse struct {
 	Logs []SandboxLog `json:"logs"`
 }
 
+// SandboxLogsSummaryResponse defines model for SandboxLogsSummaryResponse.
+type SandboxLogsSummaryResponse struct {
+	// Counts Number of log entries per event type and level
+	Counts []SandboxLogsCount `json:"counts"`
+
+	// Total Number of log entries of the sandbox
+	Total int64 `json:"total"`
+}
+
 // SandboxMetric defines model for SandboxMetric.
 type SandboxMetric struct {
 	// CpuCount Number of CPUs
@@ -286,6 +307,14 @@ type V1SandboxLogsParams struct {
 	EventType *SandboxLogEventType `form:"eventType,omitempty" json:"eventType,omitempty"`
 }
 
+// V1SandboxLogsSummaryParams defines parameters for V1SandboxLogsSummary.
+type V1SandboxLogsSummaryParams struct {
+	TeamID string `form:"teamID" json:"teamID"`
+
+	// Start Timestamp in milliseconds from which the logs are counted, defaults to the oldest retained logs
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+}
+
 // V1SandboxMetricsParams defines parameters for V1SandboxMetrics.
 type V1SandboxMetricsParams struct {
 	// TeamID Team ID that owns the sandbox
@@ -10,6 +10,9 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /events/volumes)
+	VolumeEvents(c *gin.Context)
+
 	// (POST /logs)
 	Logs(c *gin.Context)
 
@@ -26,6 +29,19 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// VolumeEvents operation middleware
+func (siw *ServerInterfaceWrapper) VolumeEvents(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VolumeEvents(c)
+}
+
 // Logs operation middleware
 func (siw *ServerInterfaceWrapper) Logs(c *gin.Context) {
 
@@ -79,6 +95,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/events/volumes", wrapper.VolumeEvents)
 	router.POST(options.BaseURL+"/logs", wrapper.Logs)
 	router.GET(options.BaseURL+"/me", wrapper.Me)
 }
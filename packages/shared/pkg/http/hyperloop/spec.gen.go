@@ -18,14 +18,18 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xTwY7TMBD9FWvgaDVhFy45okWiiIJEj2gPrjPNGiUeM+MUVlX+HY0TqJa2cEHkYjsz",
-	"82be8/MRPA2JIsYs0ByBURJFwXJ4Wde6eIoZY9atS6kP3uVAsfoiFPWf+AccnO6eM+6hgWfVCbOao1K9",
-	"YSaGaZostCieQ1IQaOC1aw3j1xElw2Th1f/ouUU+IBtc4nbBK6TnouYIiSkh5zBr4alFXZ8ClWRTYhb2",
-	"xIPL0ECI+fYGLOTHhPMRO2RlN6CI664BnUokc4hdGU21CYwtNJ9hafQT5X6ysMHzWcXFdkff13fnfbZz",
-	"yKzv/trshHJfJAxxTwqYQ+61bEM8mrePCbknSmDhgCxzl3r1YlUrX0oYXQrQwO2qXtVgIbn8UIaseurK",
-	"JpHk80E/ocdwQDHvth8/mJ0TbI1WmIW8QEHn4ot1Cw28Vzz71ME3s5t+hy5uM9+cGBm9R5H92Ou4i+Ev",
-	"eeoXbKVJJ6P+OVeTpqJeNZSL6vAi1zxyFKMKq4UCReN2NGaz3IEJWbDfn1He4BXC/+T5bPDS27mmXvl+",
-	"BAAA//8tJV09TwQAAA==",
+	"H4sIAAAAAAAC/7xVwW7bOBD9FWJ2j4KkTbJ70DFwgHVRt0VTtIciKBhpZLOQSJZDOTUC/XsxpBTbkVz3",
+	"kNYXS5zhe/MeR8NHKE1rjUbtCYpHcEjWaMLwcpXn/Fca7VF7fpTWNqqUXhmdfSWjeY3KDbaSn/52WEMB",
+	"f2V7zCxGKbtxzjjo+z6BCql0yjIIFHAtK+HwW4fkoU/g3z/BeYtui07gEE8GvCA6bioewTpj0XkVvShN",
+	"hfx/DBSSRYglUBvXSg8FKO0vLyABv7MYX3GNjtW1SCTXp4D2W8g7pdehNPZGOayg+AwD0Yhy1yewwmmt",
+	"JHV1b74vF1Oe2xgSy8VZsj0K83w0TdfizXY4lGPCqnPhfFY0ZVwMMWFq4TcoWtNpL4wLL52Or0qLVjWN",
+	"IiyNrigR9ZDAp9qgx0pIXYlaqgYrgdvQrseO/3c163g449Up2z9tdqeLimxTnxII8XfSb6aIvBpQtsEw",
+	"oSiCs4Kgk2ODtXPQceE56oedxdHAoB4SQN21fE6RKA0sKXnpfCj6aPnJxueBJ41DSSltOl+ZB53GtC+d",
+	"fg58LvOQ61zuQH83Y0TMnOvh5WK0Iuac7eQQPUC8CyNB6dowuFe+4c0r4zrx/86ia4yxnI+OImOe/pPm",
+	"XJSxqKVVUMBlmqc5JGCl34Suz2JXZpElLFlDflr+eyxRbZEOuyQ2HLf42HwRjYWi3rKV/L2FD2lZQXH4",
+	"ORJEtUj+2lS7F5ueBxRxhu4t9a7DPjm+LC7yi5m+HftVPEgSsizRcmP0yXi3zBXwBJtx0v5O+HkuJ/Wh",
+	"zqwx61/x/9Xt2zfiXhJWgneIYazSxOzXjDeRm89Bh2MIYqkrSySqu+Z3y23DwFjjrFbfOU1C6TgqeQ7L",
+	"e9P5cQQJ5QmbeiJ5hScEv0hrrXDuVj7lXvj9GAD6a1ZEqQgAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,6 +3,16 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package api
 
+// Defines values for VolumeEventType.
+const (
+	SandboxShutdownVolumeUnmountCompleted VolumeEventType = "sandbox.shutdown.volume_unmount.completed"
+	SandboxShutdownVolumeUnmountFailed    VolumeEventType = "sandbox.shutdown.volume_unmount.failed"
+	SandboxShutdownVolumeUnmountStarted   VolumeEventType = "sandbox.shutdown.volume_unmount.started"
+	VolumeMountCompleted                  VolumeEventType = "volume.mount.completed"
+	VolumeMountFailed                     VolumeEventType = "volume.mount.failed"
+	VolumeMountStarted                    VolumeEventType = "volume.mount.started"
+)
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -18,8 +28,32 @@ type Me struct {
 	SandboxID string `json:"sandboxID"`
 }
 
+// VolumeEvent defines model for VolumeEvent.
+type VolumeEvent struct {
+	// DurationMs Duration of the mount or the unmount in milliseconds, for the completed and failed events
+	DurationMs *int64 `json:"durationMs,omitempty"`
+
+	// ErrorMessage Why the mount or the unmount failed
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// MountPath Path the volume is mounted at in the sandbox
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// Type Type of the event
+	Type VolumeEventType `json:"type"`
+
+	// VolumeID ID of the volume
+	VolumeID string `json:"volumeID"`
+}
+
+// VolumeEventType Type of the event
+type VolumeEventType string
+
 // N400 defines model for 400.
 type N400 = Error
 
 // N500 defines model for 500.
 type N500 = Error
+
+// VolumeEventsJSONRequestBody defines body for VolumeEvents for application/json ContentType.
+type VolumeEventsJSONRequestBody = VolumeEvent
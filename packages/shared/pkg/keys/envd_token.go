@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvdAccessTokenWindow is the granularity of envd access token expiries. A token expires at the end
+// of the window after the one it was issued in, so it's valid for one to two windows. As the expiries
+// are aligned, envd knows the few tokens that are valid at any time and can check URL signatures made
+// with any of them.
+const EnvdAccessTokenWindow = 12 * time.Hour
+
+const envdAccessTokenPrefix = "ev2_"
+
+var (
+	ErrInvalidEnvdAccessToken = errors.New("invalid envd access token")
+	ErrEnvdAccessTokenExpired = errors.New("envd access token expired")
+)
+
+// NewEnvdAccessToken returns an expiring access token signed with the envd key of a sandbox.
+func NewEnvdAccessToken(key string, now time.Time) string {
+	return envdAccessToken(key, envdAccessTokenExpiry(now))
+}
+
+// VerifyEnvdAccessToken returns an error if the token wasn't signed with the key or expired.
+func VerifyEnvdAccessToken(key, token string, now time.Time) error {
+	rest, ok := strings.CutPrefix(token, envdAccessTokenPrefix)
+	if !ok {
+		return ErrInvalidEnvdAccessToken
+	}
+
+	expiryRaw, _, ok := strings.Cut(rest, "_")
+	if !ok {
+		return ErrInvalidEnvdAccessToken
+	}
+
+	expiry, err := strconv.ParseInt(expiryRaw, 10, 64)
+	if err != nil {
+		return ErrInvalidEnvdAccessToken
+	}
+
+	if !hmac.Equal([]byte(envdAccessToken(key, expiry)), []byte(token)) {
+		return ErrInvalidEnvdAccessToken
+	}
+
+	if expiry <= now.Unix() {
+		return ErrEnvdAccessTokenExpired
+	}
+
+	return nil
+}
+
+// ValidEnvdAccessTokens returns the access tokens of the key that haven't expired yet: the tokens
+// issued in the previous window and the ones issued in the current window.
+func ValidEnvdAccessTokens(key string, now time.Time) []string {
+	expiry := envdAccessTokenExpiry(now)
+
+	return []string{
+		envdAccessToken(key, expiry-int64(EnvdAccessTokenWindow/time.Second)),
+		envdAccessToken(key, expiry),
+	}
+}
+
+// envdAccessTokenExpiry returns the expiry of the tokens issued at now, the end of the next window.
+func envdAccessTokenExpiry(now time.Time) int64 {
+	window := int64(EnvdAccessTokenWindow / time.Second)
+
+	return (now.Unix()/window + 2) * window
+}
+
+func envdAccessToken(key string, expiry int64) string {
+	expiryRaw := strconv.FormatInt(expiry, 10)
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte("envd-access-token:" + expiryRaw))
+
+	return envdAccessTokenPrefix + expiryRaw + "_" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,42 @@
+package keys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvdAccessToken_Valid(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+	token := NewEnvdAccessToken("sandbox-key", now)
+
+	require.NoError(t, VerifyEnvdAccessToken("sandbox-key", token, now))
+	require.NoError(t, VerifyEnvdAccessToken("sandbox-key", token, now.Add(EnvdAccessTokenWindow)))
+	assert.Contains(t, ValidEnvdAccessTokens("sandbox-key", now), token)
+	assert.Contains(t, ValidEnvdAccessTokens("sandbox-key", now.Add(EnvdAccessTokenWindow)), token)
+}
+
+func TestEnvdAccessToken_Expired(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+	token := NewEnvdAccessToken("sandbox-key", now)
+
+	later := now.Add(2 * EnvdAccessTokenWindow)
+	require.ErrorIs(t, VerifyEnvdAccessToken("sandbox-key", token, later), ErrEnvdAccessTokenExpired)
+	assert.NotContains(t, ValidEnvdAccessTokens("sandbox-key", later), token)
+}
+
+func TestEnvdAccessToken_Invalid(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+	token := NewEnvdAccessToken("sandbox-key", now)
+
+	require.ErrorIs(t, VerifyEnvdAccessToken("other-key", token, now), ErrInvalidEnvdAccessToken)
+	require.ErrorIs(t, VerifyEnvdAccessToken("sandbox-key", "sandbox-key", now), ErrInvalidEnvdAccessToken)
+	require.ErrorIs(t, VerifyEnvdAccessToken("sandbox-key", "ev2_notanumber_abc", now), ErrInvalidEnvdAccessToken)
+
+	// Extending the expiry invalidates the signature
+	forged := NewEnvdAccessToken("sandbox-key", now.Add(10*EnvdAccessTokenWindow))
+	forged = token[:len("ev2_")] + forged[len("ev2_"):len("ev2_")+10] + token[len("ev2_")+10:]
+	require.ErrorIs(t, VerifyEnvdAccessToken("sandbox-key", forged, now), ErrInvalidEnvdAccessToken)
+}
@@ -41,6 +41,27 @@ func (sl *SandboxLogger) Metrics(ctx context.Context, metrics SandboxMetricsFiel
 	)
 }
 
+type SandboxDNSQueryFields struct {
+	Name    string
+	Type    string
+	Allowed bool
+	Rcode   string
+	Answers []string
+}
+
+func (sl *SandboxLogger) DNSQuery(ctx context.Context, query SandboxDNSQueryFields) {
+	sl.Info(
+		ctx,
+		"DNS query",
+		zap.String("category", "dns"),
+		zap.String("name", query.Name),
+		zap.String("type", query.Type),
+		zap.Bool("allowed", query.Allowed),
+		zap.String("rcode", query.Rcode),
+		zap.Strings("answers", query.Answers),
+	)
+}
+
 func (sl *SandboxLogger) Healthcheck(ctx context.Context, action HealthCheckAction) {
 	switch action {
 	case Success:
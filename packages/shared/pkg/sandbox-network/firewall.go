@@ -71,3 +71,51 @@ func ParseAddressesAndDomains(entries []string) (addresses []string, domains []s
 
 	return addresses, domains
 }
+
+// IsDomainPattern checks if a string is a domain name the egress firewall can match:
+// an exact name (example.com), a suffix wildcard (*.example.com) or a wildcard for all names (*).
+func IsDomainPattern(s string) bool {
+	if s == "*" {
+		return true
+	}
+
+	name := strings.TrimPrefix(s, "*.")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for label := range strings.SplitSeq(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// MatchDomain checks if a hostname matches a domain pattern.
+// Patterns can be exact matches, wildcards (*), or suffix wildcards (*.example.com).
+func MatchDomain(hostname, pattern string) bool {
+	switch {
+	case pattern == "":
+		// Empty pattern should never match
+		return false
+	case strings.EqualFold(pattern, hostname):
+		return true
+	case strings.EqualFold(pattern, "*"):
+		return true
+	case strings.HasPrefix(pattern, "*."):
+		suffix := pattern[1:]
+		if strings.HasSuffix(strings.ToLower(hostname), strings.ToLower(suffix)) {
+			return true
+		}
+	}
+
+	return false
+}
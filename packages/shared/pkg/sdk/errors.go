@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// APIError is returned when the API responds with an error status.
+type APIError struct {
+	StatusCode int
+	Message    string
+	// Reason is the machine-readable cause of a failed file operation, empty for other errors
+	Reason api.FileErrorReason
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("moru api: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("moru api: %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an API error for a conflicting state,
+// e.g. writing to a volume attached to a running sandbox.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// newAPIError builds the error of a response, using the message of the API error body when present.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+
+	var payload api.Error
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Message = payload.Message
+		if payload.Reason != nil {
+			apiErr.Reason = *payload.Reason
+		}
+	}
+
+	return apiErr
+}
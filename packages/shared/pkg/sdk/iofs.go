@@ -0,0 +1,373 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// FS returns a read-only io/fs view of the volume, for tools built on the standard
+// file system interfaces (fs.WalkDir, fs.Glob, http.FileServerFS, template.ParseFS).
+// Every call uses ctx, as the io/fs interfaces take no context.
+//
+// The returned file system implements fs.ReadDirFS, fs.ReadFileFS, fs.StatFS and fs.ReadLinkFS.
+// Files are streamed, a file is downloaded when it's first read. Symlinks are followed
+// within the volume, absolute link targets are resolved from the volume root.
+func (v *VolumeFS) FS(ctx context.Context) fs.FS {
+	return &volumeIOFS{volume: v, ctx: ctx}
+}
+
+type volumeIOFS struct {
+	volume *VolumeFS
+	ctx    context.Context
+}
+
+// maxLinkHops bounds the symlinks followed to resolve a path, like ELOOP of the kernel.
+const maxLinkHops = 40
+
+var (
+	_ fs.ReadDirFS  = (*volumeIOFS)(nil)
+	_ fs.ReadFileFS = (*volumeIOFS)(nil)
+	_ fs.StatFS     = (*volumeIOFS)(nil)
+	_ fs.ReadLinkFS = (*volumeIOFS)(nil)
+)
+
+// volumePath maps an io/fs path, unrooted with "." for the root, to the absolute path in the volume.
+func volumePath(name string) string {
+	if name == "." {
+		return "/"
+	}
+
+	return "/" + name
+}
+
+// pathError wraps an error of the API in the error type of io/fs.
+// Missing files are reported as fs.ErrNotExist, so errors.Is works like for local files.
+func pathError(op, name string, err error) error {
+	if IsNotFound(err) {
+		err = fs.ErrNotExist
+	}
+
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+func (f *volumeIOFS) Open(name string) (fs.File, error) {
+	info, err := f.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &volumeDir{fsys: f, name: name, info: info}, nil
+	}
+
+	return &volumeFile{fsys: f, name: name, info: info}, nil
+}
+
+func (f *volumeIOFS) Stat(name string) (fs.FileInfo, error) {
+	return f.stat("stat", name)
+}
+
+func (f *volumeIOFS) Lstat(name string) (fs.FileInfo, error) {
+	return f.lstat("lstat", name)
+}
+
+func (f *volumeIOFS) ReadLink(name string) (string, error) {
+	info, err := f.lstat("readlink", name)
+	if err != nil {
+		return "", err
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return info.target, nil
+}
+
+// stat returns the metadata of the entry, following symlinks. The info keeps the requested name.
+func (f *volumeIOFS) stat(op, name string) (*fileInfo, error) {
+	resolved := name
+	for range maxLinkHops {
+		info, err := f.lstat(op, resolved)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Mode()&fs.ModeSymlink == 0 {
+			info.name = path.Base(name)
+
+			return info, nil
+		}
+
+		resolved = resolveLink(resolved, info.target)
+	}
+
+	return nil, &fs.PathError{Op: op, Path: name, Err: errors.New("too many levels of symbolic links")}
+}
+
+// lstat returns the metadata of the entry without following a final symlink.
+func (f *volumeIOFS) lstat(op, name string) (*fileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	stat, err := f.volume.Stat(f.ctx, volumePath(name), false)
+	if err != nil {
+		return nil, pathError(op, name, err)
+	}
+
+	return newFileInfo(path.Base(name), stat), nil
+}
+
+// resolveLink returns the io/fs path a symlink at name points to.
+// Targets outside the volume are clamped to the volume root, like chroot.
+func resolveLink(name, target string) string {
+	if !path.IsAbs(target) {
+		target = path.Join("/", path.Dir(name), target)
+	}
+
+	resolved := strings.TrimPrefix(path.Clean(target), "/")
+	if resolved == "" {
+		return "."
+	}
+
+	return resolved
+}
+
+func (f *volumeIOFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	files, err := f.volume.ReadDir(f.ctx, volumePath(name))
+	if err != nil {
+		return nil, pathError("readdir", name, err)
+	}
+
+	entries := make([]fs.DirEntry, 0, len(files))
+	for _, file := range files {
+		entries = append(entries, &dirEntry{fsys: f, name: path.Join(name, file.Name), file: file})
+	}
+
+	// The API sorts by name too, io/fs requires it
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return entries, nil
+}
+
+func (f *volumeIOFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: fs.ErrInvalid}
+	}
+
+	content, err := f.volume.ReadFile(f.ctx, volumePath(name))
+	if err != nil {
+		return nil, pathError("readfile", name, err)
+	}
+
+	return content, nil
+}
+
+// fileInfo describes a volume entry.
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	mode    fs.FileMode
+	target  string
+}
+
+func newFileInfo(name string, stat *api.FileStat) *fileInfo {
+	info := &fileInfo{
+		name:    name,
+		size:    stat.Size,
+		modTime: stat.ModifiedAt,
+		mode:    parseFileMode(stat.Mode),
+	}
+
+	switch stat.Type {
+	case api.FileStatTypeDirectory:
+		info.mode |= fs.ModeDir
+		// Directory sizes depend on the metadata engine, io/fs leaves them system dependent
+		info.size = 0
+	case api.FileStatTypeSymlink:
+		info.mode |= fs.ModeSymlink
+		if stat.LinkTarget != nil {
+			info.target = *stat.LinkTarget
+		}
+	}
+
+	return info
+}
+
+// parseFileMode converts octal Unix permission bits to a file mode.
+func parseFileMode(mode string) fs.FileMode {
+	bits, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil {
+		return 0
+	}
+
+	result := fs.FileMode(bits) & fs.ModePerm
+	if bits&0o4000 != 0 {
+		result |= fs.ModeSetuid
+	}
+	if bits&0o2000 != 0 {
+		result |= fs.ModeSetgid
+	}
+	if bits&0o1000 != 0 {
+		result |= fs.ModeSticky
+	}
+
+	return result
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.size }
+func (i *fileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *fileInfo) ModTime() time.Time { return i.modTime }
+func (i *fileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *fileInfo) Sys() any           { return nil }
+
+// dirEntry is an entry of a directory listing, its full metadata is fetched by Info.
+type dirEntry struct {
+	fsys *volumeIOFS
+	name string
+	file api.FileInfo
+}
+
+func (e *dirEntry) Name() string { return e.file.Name }
+func (e *dirEntry) IsDir() bool  { return e.file.Type == api.FileInfoTypeDirectory }
+
+func (e *dirEntry) Type() fs.FileMode {
+	switch e.file.Type {
+	case api.FileInfoTypeDirectory:
+		return fs.ModeDir
+	case api.FileInfoTypeSymlink:
+		return fs.ModeSymlink
+	}
+
+	return 0
+}
+
+func (e *dirEntry) Info() (fs.FileInfo, error) {
+	return e.fsys.lstat("stat", e.name)
+}
+
+func (e *dirEntry) String() string {
+	return fs.FormatDirEntry(e)
+}
+
+// volumeFile is an open file, its content is downloaded on the first read.
+type volumeFile struct {
+	fsys *volumeIOFS
+	name string
+	info *fileInfo
+
+	body   io.ReadCloser
+	closed bool
+}
+
+func (f *volumeFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *volumeFile) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrClosed}
+	}
+
+	if f.body == nil {
+		body, err := f.fsys.volume.Open(f.fsys.ctx, volumePath(f.name))
+		if err != nil {
+			return 0, pathError("read", f.name, err)
+		}
+		f.body = body
+	}
+
+	return f.body.Read(p)
+}
+
+func (f *volumeFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.name, Err: fs.ErrClosed}
+	}
+	f.closed = true
+
+	if f.body != nil {
+		return f.body.Close()
+	}
+
+	return nil
+}
+
+// volumeDir is an open directory, its entries are listed on the first ReadDir.
+type volumeDir struct {
+	fsys *volumeIOFS
+	name string
+	info *fileInfo
+
+	entries []fs.DirEntry
+	listed  bool
+	offset  int
+	closed  bool
+}
+
+var _ fs.ReadDirFile = (*volumeDir)(nil)
+
+func (d *volumeDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *volumeDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *volumeDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: fs.ErrClosed}
+	}
+
+	if !d.listed {
+		entries, err := d.fsys.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.listed = true
+	}
+
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+
+		return remaining, nil
+	}
+
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+
+	n = min(n, len(remaining))
+	d.offset += n
+
+	return remaining[:n], nil
+}
+
+func (d *volumeDir) Close() error {
+	if d.closed {
+		return &fs.PathError{Op: "close", Path: d.name, Err: fs.ErrClosed}
+	}
+	d.closed = true
+
+	return nil
+}
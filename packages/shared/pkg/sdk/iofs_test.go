@@ -0,0 +1,222 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// volumeServer serves the file listing and download endpoints of a volume from an in-memory file system.
+func volumeServer(t *testing.T, files fstest.MapFS) http.HandlerFunc {
+	t.Helper()
+
+	sendError := func(w http.ResponseWriter, code int, message string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(api.Error{Code: int32(code), Message: message})
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Query().Get("path"), "/")
+		if name == "" {
+			name = "."
+		}
+
+		switch r.URL.Path {
+		case "/volumes/vol-1/files":
+			entries, err := files.ReadDir(name)
+			if err != nil {
+				sendError(w, http.StatusNotFound, "Path not found")
+				return
+			}
+
+			list := api.FileListResponse{Files: []api.FileInfo{}}
+			for _, entry := range entries {
+				info, err := entry.Info()
+				require.NoError(t, err)
+
+				file := api.FileInfo{
+					Name:       entry.Name(),
+					Path:       "/" + strings.TrimPrefix(name+"/"+entry.Name(), "./"),
+					Type:       api.FileInfoTypeFile,
+					Size:       ptr(info.Size()),
+					ModifiedAt: ptr(info.ModTime()),
+				}
+				if entry.IsDir() {
+					file.Type = api.FileInfoTypeDirectory
+				}
+				list.Files = append(list.Files, file)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(list)
+		case "/volumes/vol-1/files/stat":
+			info, err := files.Lstat(name)
+			if err != nil {
+				sendError(w, http.StatusNotFound, "Path not found")
+				return
+			}
+
+			stat := api.FileStat{
+				Name:       info.Name(),
+				Path:       "/" + strings.TrimPrefix(name, "."),
+				Type:       api.FileStatTypeFile,
+				Size:       info.Size(),
+				ModifiedAt: info.ModTime(),
+				Mode:       fmt.Sprintf("%04o", info.Mode().Perm()),
+			}
+			switch {
+			case info.IsDir():
+				stat.Type = api.FileStatTypeDirectory
+			case info.Mode()&fs.ModeSymlink != 0:
+				target, err := files.ReadLink(name)
+				require.NoError(t, err)
+				stat.Type = api.FileStatTypeSymlink
+				stat.LinkTarget = &target
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(stat)
+		case "/volumes/vol-1/files/download":
+			// The API follows symlinks when reading
+			content, err := fs.ReadFile(files, name)
+			if err != nil {
+				sendError(w, http.StatusNotFound, "File not found")
+				return
+			}
+
+			_, _ = w.Write(content)
+		default:
+			sendError(w, http.StatusNotFound, "Not found")
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+var testModTime = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+var testFiles = fstest.MapFS{
+	"README.md":           {Data: []byte("# project"), Mode: 0o644, ModTime: testModTime},
+	"src/main.go":         {Data: []byte("package main"), Mode: 0o644, ModTime: testModTime},
+	"src/internal/lib.go": {Data: []byte("package internal"), Mode: 0o644, ModTime: testModTime},
+	"bin/run.sh":          {Data: []byte("#!/bin/sh"), Mode: 0o755, ModTime: testModTime},
+	"empty.txt":           {Mode: 0o600, ModTime: testModTime},
+}
+
+func TestVolumeFS_FS(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, volumeServer(t, testFiles))
+	fsys := client.VolumeFS("vol-1").FS(t.Context())
+
+	require.NoError(t, fstest.TestFS(fsys, "README.md", "src/main.go", "src/internal/lib.go", "bin/run.sh", "empty.txt"))
+
+	info, err := fs.Stat(fsys, "bin/run.sh")
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o755), info.Mode())
+}
+
+func TestVolumeFS_FSNotExist(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, volumeServer(t, testFiles))
+	fsys := client.VolumeFS("vol-1").FS(t.Context())
+
+	_, err := fsys.Open("missing.txt")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	_, err = fs.ReadFile(fsys, "src/missing.go")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	_, err = fs.ReadDir(fsys, "missing")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	_, err = fsys.Open("../escape")
+	assert.True(t, errors.Is(err, fs.ErrInvalid))
+}
+
+func TestVolumeFS_FSWalk(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, volumeServer(t, testFiles))
+	fsys := client.VolumeFS("vol-1").FS(t.Context())
+
+	var walked []string
+	err := fs.WalkDir(fsys, ".", func(name string, _ fs.DirEntry, err error) error {
+		walked = append(walked, name)
+
+		return err
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{".", "README.md", "bin", "bin/run.sh", "empty.txt", "src", "src/internal", "src/internal/lib.go", "src/main.go"}, walked)
+}
+
+func TestVolumeFS_FSSymlinks(t *testing.T) {
+	t.Parallel()
+
+	files := fstest.MapFS{
+		"app/main.go":   {Data: []byte("package main"), Mode: 0o644, ModTime: testModTime},
+		"current":       {Data: []byte("app"), Mode: fs.ModeSymlink | 0o777},
+		"app/link.go":   {Data: []byte("main.go"), Mode: fs.ModeSymlink | 0o777},
+		"app/absolute":  {Data: []byte("/app/main.go"), Mode: fs.ModeSymlink | 0o777},
+		"loop":          {Data: []byte("loop"), Mode: fs.ModeSymlink | 0o777},
+		"escape/parent": {Data: []byte("../../.."), Mode: fs.ModeSymlink | 0o777},
+	}
+
+	client := newTestClient(t, volumeServer(t, files))
+	fsys := client.VolumeFS("vol-1").FS(t.Context())
+
+	target, err := fs.ReadLink(fsys, "app/link.go")
+	require.NoError(t, err)
+	assert.Equal(t, "main.go", target)
+
+	info, err := fs.Lstat(fsys, "current")
+	require.NoError(t, err)
+	assert.Equal(t, fs.ModeSymlink, info.Mode().Type())
+
+	// Stat follows the links and keeps the requested name
+	info, err = fs.Stat(fsys, "current")
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+	assert.Equal(t, "current", info.Name())
+
+	for _, name := range []string{"app/link.go", "app/absolute"} {
+		info, err = fs.Stat(fsys, name)
+		require.NoError(t, err, name)
+		assert.Equal(t, int64(len("package main")), info.Size(), name)
+	}
+
+	// Links pointing above the volume root resolve to the root
+	info, err = fs.Stat(fsys, "escape/parent")
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+
+	_, err = fs.Stat(fsys, "loop")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many levels of symbolic links")
+}
+
+func TestResolveLink(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "app/main.go", resolveLink("app/link.go", "main.go"))
+	assert.Equal(t, "lib/util.go", resolveLink("app/link.go", "../lib/util.go"))
+	assert.Equal(t, "etc/config", resolveLink("app/link", "/etc/config"))
+	assert.Equal(t, ".", resolveLink("app/link", "../.."))
+	assert.Equal(t, ".", resolveLink("link", "/"))
+}
@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// RetryPolicy controls how requests failing with transient errors are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, 1 disables retries
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubled for every further retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts, including waits requested with Retry-After
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times, waiting between 0.5 and 10 seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+}
+
+// NoRetry disables retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// retryDoer retries requests on rate limiting, unavailable upstreams and network errors.
+type retryDoer struct {
+	doer   api.HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	// Bodies that can't be rewound (streamed uploads) are sent once
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 1; ; attempt++ {
+		resp, err := d.doer.Do(req)
+
+		if attempt >= d.policy.MaxAttempts || !replayable || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := d.backoff(attempt, resp)
+		if resp != nil {
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// retryable reports whether a failed attempt should be retried.
+// Rate limited and unavailable requests were not processed, so every method is retried.
+// Gateway errors and network failures may happen after the API acted on the request,
+// only idempotent methods are retried then.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		return idempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the next attempt, honoring Retry-After when the API sent it.
+func (d *retryDoer) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, d.policy.MaxBackoff)
+		}
+	}
+
+	wait := d.policy.InitialBackoff << (attempt - 1)
+	if wait <= 0 || wait > d.policy.MaxBackoff {
+		wait = d.policy.MaxBackoff
+	}
+
+	// Full jitter spreads the retries of clients failing at the same time
+	return time.Duration(rand.Int64N(int64(wait) + 1))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
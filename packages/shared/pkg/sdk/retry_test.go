@@ -0,0 +1,166 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+}
+
+// failingServer responds with the status for the first failures requests, then with 200.
+func failingServer(t *testing.T, status, failures int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if int(attempts.Add(1)) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func TestRetryDoer_RetriesUnavailable(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := failingServer(t, http.StatusServiceUnavailable, 2)
+	doer := &retryDoer{doer: server.Client(), policy: testRetryPolicy}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+
+	// The body is sent again on every attempt
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "payload", string(body))
+}
+
+func TestRetryDoer_GivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := failingServer(t, http.StatusTooManyRequests, 10)
+	doer := &retryDoer{doer: server.Client(), policy: testRetryPolicy}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryDoer_GatewayErrorOnlyRetriesIdempotent(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := failingServer(t, http.StatusBadGateway, 1)
+	doer := &retryDoer{doer: server.Client(), policy: testRetryPolicy}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryDoer_StreamedBodyNotRetried(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := failingServer(t, http.StatusServiceUnavailable, 1)
+	doer := &retryDoer{doer: server.Client(), policy: testRetryPolicy}
+
+	// A pipe can't be rewound, the request has no GetBody
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write([]byte("payload"))
+		pw.Close()
+	}()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, server.URL, pr)
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryDoer_StopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := failingServer(t, http.StatusServiceUnavailable, 10)
+	doer := &retryDoer{doer: server.Client(), policy: RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	}}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = doer.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryDoer_Backoff(t *testing.T) {
+	t.Parallel()
+
+	doer := &retryDoer{policy: RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}}
+
+	for attempt := 1; attempt <= 8; attempt++ {
+		wait := doer.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, wait, time.Duration(0))
+		assert.LessOrEqual(t, wait, time.Second)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	assert.Equal(t, time.Duration(0), doer.backoff(1, resp))
+
+	// Waits requested by the API are capped too
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, time.Second, doer.backoff(1, resp))
+}
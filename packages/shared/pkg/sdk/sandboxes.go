@@ -0,0 +1,154 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// Sandbox is a handle to a sandbox of the team.
+type Sandbox struct {
+	ID string
+
+	client *Client
+}
+
+// CreateSandbox starts a sandbox from a template.
+func (c *Client) CreateSandbox(ctx context.Context, params api.NewSandbox) (*Sandbox, *api.Sandbox, error) {
+	resp, err := c.api.PostSandboxesWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return c.Sandbox(resp.JSON201.SandboxID), resp.JSON201, nil
+}
+
+// Sandbox returns a handle to an existing sandbox, without checking it exists.
+func (c *Client) Sandbox(sandboxID string) *Sandbox {
+	return &Sandbox{ID: sandboxID, client: c}
+}
+
+// Info returns the state of the sandbox.
+func (s *Sandbox) Info(ctx context.Context) (*api.SandboxDetail, error) {
+	resp, err := s.client.api.GetSandboxesSandboxIDWithResponse(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Kill stops the sandbox and discards its state.
+func (s *Sandbox) Kill(ctx context.Context) error {
+	resp, err := s.client.api.DeleteSandboxesSandboxIDWithResponse(ctx, s.ID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// Pause snapshots the sandbox and stops it, Resume starts it again from the snapshot.
+func (s *Sandbox) Pause(ctx context.Context) error {
+	resp, err := s.client.api.PostSandboxesSandboxIDPauseWithResponse(ctx, s.ID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// Resume starts a paused sandbox. A zero timeout keeps the default timeout of the API.
+func (s *Sandbox) Resume(ctx context.Context, timeout time.Duration) (*api.Sandbox, error) {
+	body := api.ResumedSandbox{}
+	if timeout > 0 {
+		seconds := int32(timeout.Seconds())
+		body.Timeout = &seconds
+	}
+
+	resp, err := s.client.api.PostSandboxesSandboxIDResumeWithResponse(ctx, s.ID, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// SetTimeout sets the sandbox to expire after the timeout from now.
+func (s *Sandbox) SetTimeout(ctx context.Context, timeout time.Duration) error {
+	resp, err := s.client.api.PostSandboxesSandboxIDTimeoutWithResponse(ctx, s.ID, api.PostSandboxesSandboxIDTimeoutJSONRequestBody{
+		Timeout: int32(timeout.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// KeepAlive extends the time to live of the sandbox by the duration.
+func (s *Sandbox) KeepAlive(ctx context.Context, duration time.Duration) error {
+	seconds := int(duration.Seconds())
+
+	resp, err := s.client.api.PostSandboxesSandboxIDRefreshesWithResponse(ctx, s.ID, api.PostSandboxesSandboxIDRefreshesJSONRequestBody{
+		Duration: &seconds,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// AttachVolume mounts the volume at mountPath in the running sandbox. A sandbox has at most one volume.
+func (s *Sandbox) AttachVolume(ctx context.Context, volumeID, mountPath string, readOnly bool) error {
+	resp, err := s.client.api.PostSandboxesSandboxIDVolumesWithResponse(ctx, s.ID, api.SandboxVolumeAttach{
+		VolumeId:  volumeID,
+		MountPath: mountPath,
+		ReadOnly:  &readOnly,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// DetachVolume flushes and unmounts the volume from the running sandbox, so another volume can be attached.
+func (s *Sandbox) DetachVolume(ctx context.Context, volumeID string) error {
+	resp, err := s.client.api.DeleteSandboxesSandboxIDVolumesVolumeIDWithResponse(ctx, s.ID, volumeID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Package sdk is the Go client of the Moru API.
+//
+// It wraps the client generated from the OpenAPI spec (package api) with helpers
+// for the sandbox lifecycle and volume files, and retries transient failures.
+// The generated client stays available through Client.API for endpoints without a helper.
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// DefaultBaseURL is the URL of the hosted Moru API.
+const DefaultBaseURL = "https://api.moru.io"
+
+const defaultTimeout = 60 * time.Second
+
+// Client is a client of the Moru API authenticated with a team API key.
+type Client struct {
+	api *api.ClientWithResponses
+}
+
+type config struct {
+	baseURL    string
+	httpClient *http.Client
+	retry      RetryPolicy
+}
+
+// Option configures a Client.
+type Option func(*config)
+
+// WithBaseURL sets the URL of the API, for self-hosted deployments.
+func WithBaseURL(baseURL string) Option {
+	return func(c *config) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+// The client timeout bounds downloads too, use a client without a timeout for large files.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetry sets the retry policy for transient failures.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *config) {
+		c.retry = policy
+	}
+}
+
+// New creates a client authenticated with the team API key.
+func New(apiKey string, opts ...Option) (*Client, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("api key is required")
+	}
+
+	cfg := &config{
+		baseURL:    DefaultBaseURL,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	client, err := api.NewClientWithResponses(cfg.baseURL,
+		api.WithHTTPClient(&retryDoer{doer: cfg.httpClient, policy: cfg.retry}),
+		api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("X-API-Key", apiKey)
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create api client: %w", err)
+	}
+
+	return &Client{api: client}, nil
+}
+
+// API returns the generated client, for endpoints without a helper.
+// Requests made with it are authenticated and retried like the helpers.
+func (c *Client) API() *api.ClientWithResponses {
+	return c.api
+}
+
+// Limits returns the rate limits of the API with the consumption of the team, to see why requests
+// are throttled. The consumption is tracked per API instance.
+func (c *Client) Limits(ctx context.Context) ([]api.RateLimit, error) {
+	resp, err := c.api.GetLimitsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// Capabilities returns the features and limits of the platform, like the maximum size of an upload
+// request, larger files are uploaded in parts.
+func (c *Client) Capabilities(ctx context.Context) (*api.Capabilities, error) {
+	resp, err := c.api.GetCapabilitiesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Status returns the health of the platform components, to tell platform issues from issues of the
+// team's own code. The error rates are tracked per API instance.
+func (c *Client) Status(ctx context.Context) (*api.PlatformStatus, error) {
+	resp, err := c.api.GetStatusWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// SetSecret stores a team secret, replacing the value of an existing secret with the same name.
+// Secrets are injected into sandboxes created with their name in api.NewSandbox.Secrets.
+func (c *Client) SetSecret(ctx context.Context, name, value string) (*api.TeamSecret, error) {
+	resp, err := c.api.PostSecretsWithResponse(ctx, api.NewTeamSecret{Name: name, Value: value})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// ListSecrets returns the secrets of the team, without their values.
+func (c *Client) ListSecrets(ctx context.Context) ([]api.TeamSecret, error) {
+	resp, err := c.api.GetSecretsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// DeleteSecret deletes a team secret. Running sandboxes keep the value they were started with.
+func (c *Client) DeleteSecret(ctx context.Context, name string) error {
+	resp, err := c.api.DeleteSecretsSecretNameWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
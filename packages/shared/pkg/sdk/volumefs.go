@@ -0,0 +1,354 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// maxErrorBodySize bounds how much of an error response of a streaming endpoint is read.
+const maxErrorBodySize = 64 << 10
+
+// VolumeFS accesses the files of a volume through the API, without attaching it to a sandbox.
+// Paths are absolute within the volume. Writes fail with a conflict error while
+// the volume is attached to a running sandbox.
+type VolumeFS struct {
+	VolumeID string
+
+	client *Client
+}
+
+// VolumeFS returns the file system of a volume.
+func (c *Client) VolumeFS(volumeID string) *VolumeFS {
+	return &VolumeFS{VolumeID: volumeID, client: c}
+}
+
+// ReadDir returns the entries of a directory, following the pagination.
+func (v *VolumeFS) ReadDir(ctx context.Context, dir string) ([]api.FileInfo, error) {
+	var files []api.FileInfo
+
+	params := &api.GetVolumesVolumeIDFilesParams{Path: &dir}
+	for {
+		resp, err := v.client.api.GetVolumesVolumeIDFilesWithResponse(ctx, v.VolumeID, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, newAPIError(resp.StatusCode(), resp.Body)
+		}
+
+		files = append(files, resp.JSON200.Files...)
+
+		if resp.JSON200.NextToken == nil || *resp.JSON200.NextToken == "" {
+			return files, nil
+		}
+		params.NextToken = resp.JSON200.NextToken
+	}
+}
+
+// Search returns the entries below params.Path matching all the filters of params, following the pagination.
+// The tree is walked by the API, which is much faster than walking it with ReadDir.
+func (v *VolumeFS) Search(ctx context.Context, params api.GetVolumesVolumeIDFilesSearchParams) ([]api.FileInfo, error) {
+	var files []api.FileInfo
+
+	for {
+		resp, err := v.client.api.GetVolumesVolumeIDFilesSearchWithResponse(ctx, v.VolumeID, &params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, newAPIError(resp.StatusCode(), resp.Body)
+		}
+
+		files = append(files, resp.JSON200.Files...)
+
+		if resp.JSON200.NextToken == nil || *resp.JSON200.NextToken == "" {
+			return files, nil
+		}
+		params.NextToken = resp.JSON200.NextToken
+	}
+}
+
+// Grep searches the file contents below params.Path for the lines matching params.Pattern.
+// The search stops at the limits of the API, the response is truncated then.
+func (v *VolumeFS) Grep(ctx context.Context, params api.GetVolumesVolumeIDFilesGrepParams) (*api.FileGrepResponse, error) {
+	resp, err := v.client.api.GetVolumesVolumeIDFilesGrepWithResponse(ctx, v.VolumeID, &params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Stat returns the metadata of a file, directory or symlink, symlinks are not followed.
+// With checksum, the API computes the SHA-256 of a file, which reads the whole file.
+func (v *VolumeFS) Stat(ctx context.Context, name string, checksum bool) (*api.FileStat, error) {
+	resp, err := v.client.api.GetVolumesVolumeIDFilesStatWithResponse(ctx, v.VolumeID, &api.GetVolumesVolumeIDFilesStatParams{
+		Path:     name,
+		Checksum: &checksum,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Open streams the content of a file. The caller must close the returned reader.
+func (v *VolumeFS) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := v.client.api.ClientInterface.GetVolumesVolumeIDFilesDownload(ctx, v.VolumeID, &api.GetVolumesVolumeIDFilesDownloadParams{
+		Path: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return streamResponse(resp)
+}
+
+// ReadFile returns the content of a file.
+func (v *VolumeFS) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	r, err := v.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// WriteFile streams content to a file, replacing it if it exists. Parent directories are created as needed.
+// The content is sent once, a failed write must be retried by the caller.
+// Use the multipart upload endpoints of the API for large files that should survive interruptions.
+func (v *VolumeFS) WriteFile(ctx context.Context, name string, content io.Reader) (*api.UploadResponse, error) {
+	resp, err := v.client.api.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(ctx, v.VolumeID, &api.PutVolumesVolumeIDFilesUploadParams{
+		Path: name,
+	}, "application/octet-stream", content)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// Extract unpacks an archive into the directory dir on the server, preserving the directory
+// structure and permissions. Existing files are overwritten.
+func (v *VolumeFS) Extract(ctx context.Context, dir string, format api.GetVolumesVolumeIDFilesArchiveParamsFormat, content io.Reader) (*api.UploadResponse, error) {
+	contentType := "application/gzip"
+	switch format {
+	case api.Tar:
+		contentType = "application/x-tar"
+	case api.Zip:
+		contentType = "application/zip"
+	}
+
+	extract := true
+	resp, err := v.client.api.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(ctx, v.VolumeID, &api.PutVolumesVolumeIDFilesUploadParams{
+		Path:    dir,
+		Extract: &extract,
+	}, contentType, content)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// Mkdir creates a directory, its parent must exist.
+func (v *VolumeFS) Mkdir(ctx context.Context, name string) (*api.FileInfo, error) {
+	return v.mkdir(ctx, name, false)
+}
+
+// MkdirAll creates a directory with any missing parents, an existing directory is not an error.
+func (v *VolumeFS) MkdirAll(ctx context.Context, name string) (*api.FileInfo, error) {
+	return v.mkdir(ctx, name, true)
+}
+
+func (v *VolumeFS) mkdir(ctx context.Context, name string, recursive bool) (*api.FileInfo, error) {
+	resp, err := v.client.api.PostVolumesVolumeIDFilesMkdirWithResponse(ctx, v.VolumeID, api.FileMkdirRequest{
+		Path:      name,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// Symlink creates name as a symlink to target, like os.Symlink. Missing parents of name are created.
+func (v *VolumeFS) Symlink(ctx context.Context, target, name string) (*api.FileInfo, error) {
+	resp, err := v.client.api.PostVolumesVolumeIDFilesSymlinkWithResponse(ctx, v.VolumeID, api.FileSymlinkRequest{
+		Path:   name,
+		Target: target,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// Chmod changes the permission bits of a file or directory, e.g. 0o775.
+func (v *VolumeFS) Chmod(ctx context.Context, name string, mode fs.FileMode) (*api.FileStat, error) {
+	octal := fmt.Sprintf("%04o", uint32(mode.Perm())|setModeBits(mode))
+
+	return v.setAttributes(ctx, api.FileAttributesRequest{Path: name, Mode: &octal})
+}
+
+// Chown changes the owner of a file or directory, with recursive of all the entries below a directory too.
+// Sandboxes access the volume as their user, files uploaded through the API are owned by root.
+func (v *VolumeFS) Chown(ctx context.Context, name string, uid, gid int64, recursive bool) (*api.FileStat, error) {
+	return v.setAttributes(ctx, api.FileAttributesRequest{Path: name, Uid: &uid, Gid: &gid, Recursive: &recursive})
+}
+
+func (v *VolumeFS) setAttributes(ctx context.Context, body api.FileAttributesRequest) (*api.FileStat, error) {
+	resp, err := v.client.api.PatchVolumesVolumeIDFilesAttributesWithResponse(ctx, v.VolumeID, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return &resp.JSON200.File, nil
+}
+
+// setModeBits returns the setuid, setgid and sticky bits of a mode in their octal notation.
+func setModeBits(mode fs.FileMode) uint32 {
+	var bits uint32
+	if mode&fs.ModeSetuid != 0 {
+		bits |= 0o4000
+	}
+	if mode&fs.ModeSetgid != 0 {
+		bits |= 0o2000
+	}
+	if mode&fs.ModeSticky != 0 {
+		bits |= 0o1000
+	}
+
+	return bits
+}
+
+// Remove deletes a file or an empty directory.
+func (v *VolumeFS) Remove(ctx context.Context, name string) error {
+	return v.remove(ctx, name, false)
+}
+
+// RemoveAll deletes a file or a directory with its content.
+func (v *VolumeFS) RemoveAll(ctx context.Context, name string) error {
+	return v.remove(ctx, name, true)
+}
+
+func (v *VolumeFS) remove(ctx context.Context, name string, recursive bool) error {
+	resp, err := v.client.api.DeleteVolumesVolumeIDFilesWithResponse(ctx, v.VolumeID, &api.DeleteVolumesVolumeIDFilesParams{
+		Path:      name,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// Copy copies a file or a directory tree within the volume on the server.
+func (v *VolumeFS) Copy(ctx context.Context, src, dst string, overwrite bool) (*api.FileCopyResponse, error) {
+	return v.copy(ctx, api.FileCopyRequest{
+		Source:      src,
+		Destination: dst,
+		Overwrite:   &overwrite,
+	})
+}
+
+// CopyToVolume copies a file or a directory tree to another volume of the team on the server.
+func (v *VolumeFS) CopyToVolume(ctx context.Context, src, dstVolumeID, dst string, overwrite bool) (*api.FileCopyResponse, error) {
+	return v.copy(ctx, api.FileCopyRequest{
+		Source:              src,
+		Destination:         dst,
+		DestinationVolumeId: &dstVolumeID,
+		Overwrite:           &overwrite,
+	})
+}
+
+func (v *VolumeFS) copy(ctx context.Context, body api.FileCopyRequest) (*api.FileCopyResponse, error) {
+	resp, err := v.client.api.PostVolumesVolumeIDFilesCopyWithResponse(ctx, v.VolumeID, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// PresignDownload returns a signed URL to download a file from storage directly, valid for expiresIn.
+// With materialize, a file that isn't stored as a single object is staged as one first.
+func (v *VolumeFS) PresignDownload(ctx context.Context, name string, expiresIn time.Duration, materialize bool) (*api.FilePresignResponse, error) {
+	seconds := int32(expiresIn / time.Second)
+	resp, err := v.client.api.PostVolumesVolumeIDFilesPresignWithResponse(ctx, v.VolumeID, api.FilePresignRequest{
+		Path:        name,
+		ExpiresIn:   &seconds,
+		Materialize: &materialize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Archive streams an archive of a directory tree. The caller must close the returned reader.
+func (v *VolumeFS) Archive(ctx context.Context, dir string, format api.GetVolumesVolumeIDFilesArchiveParamsFormat) (io.ReadCloser, error) {
+	resp, err := v.client.api.ClientInterface.GetVolumesVolumeIDFilesArchive(ctx, v.VolumeID, &api.GetVolumesVolumeIDFilesArchiveParams{
+		Path:   dir,
+		Format: &format,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return streamResponse(resp)
+}
+
+// streamResponse returns the body of a successful streaming response, or the API error.
+func streamResponse(resp *http.Response) (io.ReadCloser, error) {
+	if resp.StatusCode == http.StatusOK {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	return nil, newAPIError(resp.StatusCode, body)
+}
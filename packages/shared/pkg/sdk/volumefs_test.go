@@ -0,0 +1,240 @@
+package sdk
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New("moru_test", WithBaseURL(server.URL), WithHTTPClient(server.Client()), WithRetry(NoRetry))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestVolumeFS_ReadDirFollowsPagination(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "moru_test", r.Header.Get("X-API-Key"))
+		assert.Equal(t, "/volumes/vol-1/files", r.URL.Path)
+		assert.Equal(t, "/data", r.URL.Query().Get("path"))
+
+		page := api.FileListResponse{}
+		switch r.URL.Query().Get("nextToken") {
+		case "":
+			next := "page-2"
+			page.Files = []api.FileInfo{{Name: "a.txt", Path: "/data/a.txt", Type: api.FileInfoTypeFile}}
+			page.NextToken = &next
+		case "page-2":
+			page.Files = []api.FileInfo{{Name: "b", Path: "/data/b", Type: api.FileInfoTypeDirectory}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	files, err := client.VolumeFS("vol-1").ReadDir(t.Context(), "/data")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, "/data/a.txt", files[0].Path)
+	assert.Equal(t, "/data/b", files[1].Path)
+}
+
+func TestVolumeFS_SearchFollowsPagination(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/volumes/vol-1/files/search", r.URL.Path)
+		assert.Equal(t, "/logs", r.URL.Query().Get("path"))
+		assert.Equal(t, "*.log", r.URL.Query().Get("glob"))
+
+		page := api.FileListResponse{}
+		switch r.URL.Query().Get("nextToken") {
+		case "":
+			next := "page-2"
+			page.Files = []api.FileInfo{{Name: "a.log", Path: "/logs/a.log", Type: api.FileInfoTypeFile}}
+			page.NextToken = &next
+		case "page-2":
+			page.Files = []api.FileInfo{{Name: "b.log", Path: "/logs/2026/b.log", Type: api.FileInfoTypeFile}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	path, glob := "/logs", "*.log"
+	files, err := client.VolumeFS("vol-1").Search(t.Context(), api.GetVolumesVolumeIDFilesSearchParams{Path: &path, Glob: &glob})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, "/logs/a.log", files[0].Path)
+	assert.Equal(t, "/logs/2026/b.log", files[1].Path)
+}
+
+func TestVolumeFS_ReadFile(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("path") != "/hello.txt" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"File not found","reason":"not_found"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	fs := client.VolumeFS("vol-1")
+
+	content, err := fs.ReadFile(t.Context(), "/hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	_, err = fs.ReadFile(t.Context(), "/missing.txt")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Contains(t, err.Error(), "File not found")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, api.NotFound, apiErr.Reason)
+}
+
+func TestVolumeFS_WriteConflict(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code":409,"message":"Volume is attached to a running sandbox"}`))
+	})
+
+	_, err := client.VolumeFS("vol-1").WriteFile(t.Context(), "/a.txt", strings.NewReader("a"))
+	require.Error(t, err)
+	assert.True(t, IsConflict(err))
+}
+
+func TestVolumeFS_MkdirAll(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/volumes/vol-1/files/mkdir", r.URL.Path)
+
+		var req api.FileMkdirRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "/a/b", req.Path)
+		require.NotNil(t, req.Recursive)
+		assert.True(t, *req.Recursive)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(api.FileInfo{Name: "b", Path: "/a/b", Type: api.FileInfoTypeDirectory})
+	})
+
+	dir, err := client.VolumeFS("vol-1").MkdirAll(t.Context(), "/a/b")
+	require.NoError(t, err)
+	assert.Equal(t, api.FileInfoTypeDirectory, dir.Type)
+}
+
+func TestVolumeFS_SyncUploadsChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	src := fstest.MapFS{
+		"same.txt":       {Data: []byte("same"), Mode: 0o644},
+		"src/changed.go": {Data: []byte("package src"), Mode: 0o755},
+		"link":           {Data: []byte("same.txt"), Mode: fs.ModeSymlink},
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/volumes/vol-1/files/sync":
+			var req api.FileSyncRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "/app", req.Path)
+			require.NotNil(t, req.Delete)
+			assert.True(t, *req.Delete)
+
+			// Symlinks aren't synced
+			require.Len(t, req.Files, 2)
+			assert.Equal(t, "same.txt", req.Files[0].Path)
+			assert.Equal(t, int64(4), req.Files[0].Size)
+			assert.Equal(t, "src/changed.go", req.Files[1].Path)
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(api.FileSyncResponse{
+				Upload:    []string{"src/changed.go"},
+				Extra:     []string{"old.txt"},
+				Unchanged: 1,
+			})
+		case "/volumes/vol-1/files/upload":
+			assert.Equal(t, "/app", r.URL.Query().Get("path"))
+			assert.Equal(t, "true", r.URL.Query().Get("extract"))
+			assert.Equal(t, "application/gzip", r.Header.Get("Content-Type"))
+
+			gz, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			tr := tar.NewReader(gz)
+
+			header, err := tr.Next()
+			require.NoError(t, err)
+			assert.Equal(t, "src/changed.go", header.Name)
+			assert.Equal(t, int64(0o755), header.Mode&0o777)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			assert.Equal(t, "package src", string(content))
+
+			_, err = tr.Next()
+			assert.Equal(t, io.EOF, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(api.UploadResponse{Path: "/app", Size: 11})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	result, err := client.VolumeFS("vol-1").Sync(t.Context(), src, "/app", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src/changed.go"}, result.Uploaded)
+	assert.Equal(t, []string{"old.txt"}, result.Deleted)
+	assert.Equal(t, int64(1), result.Unchanged)
+}
+
+func TestVolumeFS_SyncUpToDate(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Nothing is uploaded when the volume is up to date
+		assert.Equal(t, "/volumes/vol-1/files/sync", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.FileSyncResponse{Upload: []string{}, Extra: []string{"old.txt"}, Unchanged: 1})
+	})
+
+	result, err := client.VolumeFS("vol-1").Sync(t.Context(), fstest.MapFS{"a.txt": {Data: []byte("a")}}, "/app", false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Uploaded)
+	assert.Empty(t, result.Deleted)
+	assert.Equal(t, int64(1), result.Unchanged)
+}
@@ -0,0 +1,250 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// nextTokenHeader carries the pagination token of list endpoints returning arrays.
+const nextTokenHeader = "X-Next-Token"
+
+// volumePollInterval is how often WaitForVolume gets the volume being created.
+const volumePollInterval = 500 * time.Millisecond
+
+// CreateVolume creates a volume, or returns the existing volume of the team with the same name.
+// New volumes are prepared in the background, WaitForVolume waits for them to be available.
+func (c *Client) CreateVolume(ctx context.Context, name string) (*api.Volume, error) {
+	resp, err := c.api.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.JSON201 != nil:
+		return resp.JSON201, nil
+	case resp.JSON200 != nil:
+		return resp.JSON200, nil
+	default:
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+}
+
+// GetVolume returns a volume by its ID or name.
+func (c *Client) GetVolume(ctx context.Context, idOrName string) (*api.Volume, error) {
+	resp, err := c.api.GetVolumesIdOrNameWithResponse(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// WaitForVolume waits for the volume being created to be available, until the context is done.
+func (c *Client) WaitForVolume(ctx context.Context, idOrName string) (*api.Volume, error) {
+	for {
+		volume, err := c.GetVolume(ctx, idOrName)
+		if err != nil {
+			return nil, err
+		}
+
+		if volume.Status != nil {
+			switch *volume.Status {
+			case api.Available:
+				return volume, nil
+			case api.Failed:
+				return nil, fmt.Errorf("volume %s creation failed", volume.VolumeID)
+			}
+		}
+
+		if err := sleep(ctx, volumePollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ListVolumes returns all volumes of the team, following the pagination.
+func (c *Client) ListVolumes(ctx context.Context) ([]api.Volume, error) {
+	var volumes []api.Volume
+
+	params := &api.GetVolumesParams{}
+	for {
+		resp, err := c.api.GetVolumesWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, newAPIError(resp.StatusCode(), resp.Body)
+		}
+
+		volumes = append(volumes, *resp.JSON200...)
+
+		next := resp.HTTPResponse.Header.Get(nextTokenHeader)
+		if next == "" {
+			return volumes, nil
+		}
+		params.NextToken = &next
+	}
+}
+
+// SetVolumeDeletionProtection sets whether the volume is refused to be deleted until the protection is cleared.
+func (c *Client) SetVolumeDeletionProtection(ctx context.Context, idOrName string, enabled bool) (*api.Volume, error) {
+	resp, err := c.api.PatchVolumesIdOrNameWithResponse(ctx, idOrName, api.UpdateVolumeRequest{DeletionProtection: &enabled})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// DeleteVolume deletes a volume. Its files are destroyed once the deletion grace period ends,
+// until then RestoreVolume restores it. With force, the files are destroyed immediately.
+func (c *Client) DeleteVolume(ctx context.Context, idOrName string, force bool) error {
+	resp, err := c.api.DeleteVolumesIdOrNameWithResponse(ctx, idOrName, &api.DeleteVolumesIdOrNameParams{Force: &force})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// RestoreVolume restores a volume pending deletion.
+func (c *Client) RestoreVolume(ctx context.Context, idOrName string) (*api.Volume, error) {
+	resp, err := c.api.PostVolumesIdOrNameUndeleteWithResponse(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// ListVolumeOperations returns all operations of a volume, the most recent first, following the pagination.
+func (c *Client) ListVolumeOperations(ctx context.Context, idOrName string) ([]api.VolumeOperation, error) {
+	var operations []api.VolumeOperation
+
+	params := &api.GetVolumesIdOrNameOperationsParams{}
+	for {
+		resp, err := c.api.GetVolumesIdOrNameOperationsWithResponse(ctx, idOrName, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, newAPIError(resp.StatusCode(), resp.Body)
+		}
+
+		operations = append(operations, *resp.JSON200...)
+
+		next := resp.HTTPResponse.Header.Get(nextTokenHeader)
+		if next == "" {
+			return operations, nil
+		}
+		params.NextToken = &next
+	}
+}
+
+// ListVolumeAttachments returns the sandboxes the volume is currently mounted in.
+// Attachments are recorded from orchestrator events, so a just attached or detached volume can lag behind.
+func (c *Client) ListVolumeAttachments(ctx context.Context, idOrName string) ([]api.VolumeAttachment, error) {
+	resp, err := c.api.GetVolumesIdOrNameAttachmentsWithResponse(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// GetVolumeOperation returns a volume operation, also once its volume is deleted.
+func (c *Client) GetVolumeOperation(ctx context.Context, operationID string) (*api.VolumeOperation, error) {
+	resp, err := c.api.GetOperationsOperationIDWithResponse(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// FsckVolume starts a consistency check of a volume, with repair the inconsistencies found are repaired.
+// The findings are reported by the returned operation once it succeeded, see GetVolumeOperation.
+func (c *Client) FsckVolume(ctx context.Context, idOrName string, repair bool) (*api.VolumeOperation, error) {
+	resp, err := c.api.PostVolumesVolumeIDFsckWithResponse(ctx, idOrName, &api.PostVolumesVolumeIDFsckParams{Repair: &repair})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON202 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON202, nil
+}
+
+// VolumeUsage returns the storage usage of a volume.
+// Computing it lists the stored objects of the volume, avoid calling it frequently.
+func (c *Client) VolumeUsage(ctx context.Context, volumeID string) (*api.VolumeUsage, error) {
+	resp, err := c.api.GetVolumesVolumeIDUsageWithResponse(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// VolumeMetrics returns the usage and throughput of a volume over time, reported by the sandboxes it's
+// mounted in. A zero start or end defaults to the first or last metrics of the volume.
+func (c *Client) VolumeMetrics(ctx context.Context, volumeID string, start, end time.Time) ([]api.VolumeMetric, error) {
+	params := &api.GetVolumesVolumeIDMetricsParams{}
+	if !start.IsZero() {
+		startUnix := start.Unix()
+		params.Start = &startUnix
+	}
+	if !end.IsZero() {
+		endUnix := end.Unix()
+		params.End = &endUnix
+	}
+
+	resp, err := c.api.GetVolumesVolumeIDMetricsWithResponse(ctx, volumeID, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// TeamStorageUsage returns the storage used by the volumes of the team and the team storage limit.
+func (c *Client) TeamStorageUsage(ctx context.Context) (*api.TeamStorageUsage, error) {
+	resp, err := c.api.GetTeamsStorageUsageWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
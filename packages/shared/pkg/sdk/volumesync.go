@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// SyncResult describes the changes of a sync.
+type SyncResult struct {
+	// Uploaded lists the files sent to the volume, relative to the synced directory
+	Uploaded []string
+	// Deleted lists the files and symlinks deleted from the volume, set when deleting extra files
+	Deleted []string
+	// Unchanged counts the files already up to date on the volume
+	Unchanged int64
+}
+
+// Sync makes the directory dir of the volume match the regular files of src, e.g. os.DirFS of a local
+// directory. The API compares a manifest of the SHA-256 of the files with the volume, then only the missing
+// and changed files are sent, in a single archive. With deleteExtra, the files and symlinks of dir that
+// aren't in src are deleted. Symlinks and empty directories of src are skipped.
+func (v *VolumeFS) Sync(ctx context.Context, src fs.FS, dir string, deleteExtra bool) (*SyncResult, error) {
+	manifest, err := syncManifest(src)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v.client.api.PostVolumesVolumeIDFilesSyncWithResponse(ctx, v.VolumeID, api.FileSyncRequest{
+		Path:   dir,
+		Files:  manifest,
+		Delete: &deleteExtra,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	result := &SyncResult{
+		Uploaded:  resp.JSON200.Upload,
+		Unchanged: resp.JSON200.Unchanged,
+	}
+	if deleteExtra {
+		result.Deleted = resp.JSON200.Extra
+	}
+
+	if len(resp.JSON200.Upload) == 0 {
+		return result, nil
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeSyncArchive(pw, src, resp.JSON200.Upload))
+	}()
+	defer pr.Close()
+
+	if _, err := v.Extract(ctx, dir, api.TarGz, pr); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// syncManifest lists the regular files of src with their size and SHA-256.
+func syncManifest(src fs.FS) ([]api.FileSyncEntry, error) {
+	manifest := []api.FileSyncEntry{}
+
+	err := fs.WalkDir(src, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		f, err := src.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		hash := sha256.New()
+		size, err := io.Copy(hash, f)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", name, err)
+		}
+
+		manifest = append(manifest, api.FileSyncEntry{
+			Path:     name,
+			Size:     size,
+			Checksum: hex.EncodeToString(hash.Sum(nil)),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// writeSyncArchive writes a gzip-compressed tar of the named files of src, keeping their permission bits.
+func writeSyncArchive(w io.Writer, src fs.FS, names []string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	for _, name := range names {
+		if err := addSyncFile(tw, src, name); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func addSyncFile(tw *tar.Writer, src fs.FS, name string) error {
+	f, err := src.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("read %s: %w", name, err)
+	}
+
+	return nil
+}
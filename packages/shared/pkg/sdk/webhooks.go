@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/sdk/api"
+)
+
+// ErrInvalidWebhookSignature is returned by VerifyWebhook for a delivery that wasn't signed with the secret
+// of the webhook, or that was signed too long ago.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// CreateWebhook creates a team webhook receiving the volume events of the given types.
+// The returned secret signs the deliveries, it can't be read back.
+func (c *Client) CreateWebhook(ctx context.Context, url string, eventTypes ...api.WebhookEventType) (*api.CreatedTeamWebhook, error) {
+	resp, err := c.api.PostWebhooksWithResponse(ctx, api.NewTeamWebhook{Url: url, EventTypes: eventTypes})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// ListWebhooks returns the webhooks of the team, without their secrets.
+func (c *Client) ListWebhooks(ctx context.Context) ([]api.TeamWebhook, error) {
+	resp, err := c.api.GetWebhooksWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// DeleteWebhook deletes a team webhook.
+func (c *Client) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	resp, err := c.api.DeleteWebhooksWebhookIDWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// VerifyWebhook checks the signature of a webhook delivery with the secret of the webhook, for receivers
+// written in Go. Deliveries signed more than tolerance ago are rejected, so they can't be replayed.
+func VerifyWebhook(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	timestamp := header.Get("X-Moru-Timestamp")
+	signature, err := hex.DecodeString(header.Get("X-Moru-Signature"))
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(signedAt, 0)).Abs() > tolerance {
+		return ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"type":"volume.file.uploaded","file_path":"/out/report.csv"}`)
+
+	signed := func(secret string, at time.Time) http.Header {
+		timestamp := strconv.FormatInt(at.Unix(), 10)
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+
+		return http.Header{
+			"X-Moru-Timestamp": {timestamp},
+			"X-Moru-Signature": {hex.EncodeToString(mac.Sum(nil))},
+		}
+	}
+
+	require.NoError(t, VerifyWebhook("whsec_test", signed("whsec_test", time.Now()), body, 5*time.Minute))
+
+	assert.ErrorIs(t, VerifyWebhook("whsec_other", signed("whsec_test", time.Now()), body, 5*time.Minute), ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifyWebhook("whsec_test", signed("whsec_test", time.Now()), []byte(`{}`), 5*time.Minute), ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifyWebhook("whsec_test", signed("whsec_test", time.Now().Add(-time.Hour)), body, 5*time.Minute), ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifyWebhook("whsec_test", http.Header{}, body, 5*time.Minute), ErrInvalidWebhookSignature)
+}
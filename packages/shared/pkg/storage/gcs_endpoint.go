@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	// GCSEmulatorHostEnv is the environment variable the Google Cloud Storage clients, JuiceFS and Litestream
+	// read to send requests to a GCS emulator or another GCS compatible endpoint instead of the public API.
+	// Requests to it aren't authenticated with Google credentials.
+	GCSEmulatorHostEnv = "STORAGE_EMULATOR_HOST"
+
+	// DefaultGCSEndpoint is the public GCS API.
+	DefaultGCSEndpoint = "https://storage.googleapis.com"
+)
+
+// GCSEndpoint returns the URL of the GCS API: endpoint when set, otherwise the emulator host
+// in STORAGE_EMULATOR_HOST, otherwise DefaultGCSEndpoint. Hosts without a scheme use HTTP,
+// like the GCS clients do with STORAGE_EMULATOR_HOST.
+func GCSEndpoint(endpoint string) string {
+	if endpoint == "" {
+		endpoint = os.Getenv(GCSEmulatorHostEnv)
+	}
+	if endpoint == "" {
+		return DefaultGCSEndpoint
+	}
+
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+// IsCustomGCSEndpoint reports whether the endpoint is an emulator or another GCS compatible endpoint
+// instead of the public API, so no Google credentials or downscoped tokens are used. An empty endpoint
+// is the public API.
+func IsCustomGCSEndpoint(endpoint string) bool {
+	return endpoint != "" && endpoint != DefaultGCSEndpoint
+}
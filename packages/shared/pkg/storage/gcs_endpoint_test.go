@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCSEndpoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpoint     string
+		emulatorHost string
+		expected     string
+	}{
+		{name: "public API", expected: DefaultGCSEndpoint},
+		{name: "emulator host", emulatorHost: "localhost:4443", expected: "http://localhost:4443"},
+		{name: "emulator URL", emulatorHost: "https://gcs.internal:4443/", expected: "https://gcs.internal:4443"},
+		{name: "explicit endpoint", endpoint: "https://gcs.internal", expected: "https://gcs.internal"},
+		{name: "explicit endpoint overrides the emulator", endpoint: "10.0.0.2:4443", emulatorHost: "localhost:4443", expected: "http://10.0.0.2:4443"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(GCSEmulatorHostEnv, tt.emulatorHost)
+
+			endpoint := GCSEndpoint(tt.endpoint)
+			assert.Equal(t, tt.expected, endpoint)
+			assert.Equal(t, tt.expected != DefaultGCSEndpoint, IsCustomGCSEndpoint(endpoint))
+		})
+	}
+
+	assert.False(t, IsCustomGCSEndpoint(""))
+}
@@ -7,6 +7,10 @@ import (
 const (
 	GuestEnvdPath = "/usr/bin/envd"
 
+	// Volume mount helpers shipped with envd
+	GuestJuiceFSPath    = "/usr/local/bin/juicefs"
+	GuestLitestreamPath = "/usr/local/bin/litestream"
+
 	MemfileName  = "memfile"
 	RootfsName   = "rootfs.ext4"
 	SnapfileName = "snapfile"
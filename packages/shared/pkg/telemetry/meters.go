@@ -26,6 +26,23 @@ const (
 	TeamSandboxCreated CounterType = "moru.team.sandbox.created"
 
 	EnvdInitCalls CounterType = "orchestrator.sandbox.envd.init.calls"
+
+	// Warm pool counters
+	WarmPoolClaimsCounterName    CounterType = "orchestrator.warm_pool.claims"
+	WarmPoolReclaimedCounterName CounterType = "orchestrator.warm_pool.reclaimed"
+
+	// Background jobs counters
+	ApiJobsFinishedCounterName CounterType = "api.jobs.finished"
+
+	// Rate limit counters
+	ApiRateLimitRequestsCounterName CounterType = "api.ratelimit.requests"
+
+	// GCS proxy counters, per volume
+	GCSProxyRequestsCounterName      CounterType = "orchestrator.gcs_proxy.requests"
+	GCSProxyUploadBytesCounterName   CounterType = "orchestrator.gcs_proxy.upload.bytes"
+	GCSProxyDownloadBytesCounterName CounterType = "orchestrator.gcs_proxy.download.bytes"
+	GCSProxyRejectedCounterName      CounterType = "orchestrator.gcs_proxy.rejected"
+	RedisProxyRejectedCounterName    CounterType = "orchestrator.redis_proxy.rejected"
 )
 
 const (
@@ -35,6 +52,8 @@ const (
 
 const (
 	SandboxCountMeterName UpDownCounterType = "api.env.instance.running"
+
+	ApiJobsRunningMeterName UpDownCounterType = "api.jobs.running"
 )
 
 const (
@@ -51,10 +70,18 @@ const (
 	BuildCounterMeterName ObservableUpDownCounterType = "api.env.build.running"
 
 	TCPFirewallActiveConnections ObservableUpDownCounterType = "orchestrator.tcpfirewall.connections.active"
+
+	WarmPoolSizeMeterName ObservableUpDownCounterType = "orchestrator.warm_pool.size"
+
+	ApiVolumeClientPoolSizeMeterName ObservableUpDownCounterType = "api.volumes.client_pool.size"
 )
 
 const (
 	SandboxCpuUsedGaugeName GaugeFloatType = "moru.sandbox.cpu.used"
+
+	// Volume metrics, reported by the sandboxes the volume is mounted in
+	VolumeReadThroughputGaugeName  GaugeFloatType = "moru.volume.read.throughput"
+	VolumeWriteThroughputGaugeName GaugeFloatType = "moru.volume.write.throughput"
 )
 
 const (
@@ -68,6 +95,9 @@ const (
 
 	// TCP Firewall histograms
 	TCPFirewallConnectionDurationHistogramName HistogramType = "orchestrator.tcpfirewall.connection.duration"
+
+	// Background jobs histograms
+	ApiJobsDurationHistogramName HistogramType = "api.jobs.duration"
 )
 
 const (
@@ -91,6 +121,11 @@ const (
 	SandboxDiskUsedGaugeName  GaugeIntType = "moru.sandbox.disk.used"
 	SandboxDiskTotalGaugeName GaugeIntType = "moru.sandbox.disk.total"
 
+	// Volume metrics, reported by the sandboxes the volume is mounted in
+	VolumeUsedGaugeName     GaugeIntType = "moru.volume.used"
+	VolumeFilesGaugeName    GaugeIntType = "moru.volume.files"
+	VolumeLastSyncGaugeName GaugeIntType = "moru.volume.last_sync"
+
 	// Team metrics
 	TeamSandboxRunningGaugeName GaugeIntType = "moru.team.sandbox.running"
 
@@ -106,6 +141,19 @@ var counterDesc = map[CounterType]string{
 	TeamSandboxCreated:              "Counter of started sandboxes for the team in the interval",
 	EnvdInitCalls:                   "Number of envd initialization calls",
 
+	WarmPoolClaimsCounterName:    "Number of sandbox creations that tried to claim a warm pool sandbox",
+	WarmPoolReclaimedCounterName: "Number of warm pool sandboxes stopped without being claimed",
+
+	ApiJobsFinishedCounterName: "Number of finished background job attempts",
+
+	ApiRateLimitRequestsCounterName: "Number of requests checked against a rate limit, allowed or throttled",
+
+	GCSProxyRequestsCounterName:      "Number of requests of the volumes forwarded by the GCS proxy",
+	GCSProxyUploadBytesCounterName:   "Bytes sent to the bucket by the volumes through the GCS proxy",
+	GCSProxyDownloadBytesCounterName: "Bytes received from the bucket by the volumes through the GCS proxy",
+	GCSProxyRejectedCounterName:      "Number of requests the GCS proxy rejected for a path outside of the volume",
+	RedisProxyRejectedCounterName:    "Number of commands the Redis proxy rejected as not needed by the volumes",
+
 	TCPFirewallConnectionsTotal: "Total number of TCP firewall connections processed",
 	TCPFirewallErrorsTotal:      "Total number of TCP firewall errors",
 	TCPFirewallDecisionsTotal:   "Total number of TCP firewall allow/block decisions",
@@ -119,6 +167,19 @@ var counterUnits = map[CounterType]string{
 	TeamSandboxCreated:              "{sandbox}",
 	EnvdInitCalls:                   "1",
 
+	WarmPoolClaimsCounterName:    "{sandbox}",
+	WarmPoolReclaimedCounterName: "{sandbox}",
+
+	ApiJobsFinishedCounterName: "{job}",
+
+	ApiRateLimitRequestsCounterName: "{request}",
+
+	GCSProxyRequestsCounterName:      "{request}",
+	GCSProxyUploadBytesCounterName:   "{By}",
+	GCSProxyDownloadBytesCounterName: "{By}",
+	GCSProxyRejectedCounterName:      "{request}",
+	RedisProxyRejectedCounterName:    "{command}",
+
 	TCPFirewallConnectionsTotal: "{connection}",
 	TCPFirewallErrorsTotal:      "{error}",
 	TCPFirewallDecisionsTotal:   "{decision}",
@@ -135,11 +196,13 @@ var observableCounterUnits = map[ObservableCounterType]string{
 }
 
 var upDownCounterDesc = map[UpDownCounterType]string{
-	SandboxCountMeterName: "Counter of started instances.",
+	SandboxCountMeterName:   "Counter of started instances.",
+	ApiJobsRunningMeterName: "Number of background jobs running on the API instance.",
 }
 
 var upDownCounterUnits = map[UpDownCounterType]string{
-	SandboxCountMeterName: "{sandbox}",
+	SandboxCountMeterName:   "{sandbox}",
+	ApiJobsRunningMeterName: "{job}",
 }
 
 var observableUpDownCounterDesc = map[ObservableUpDownCounterType]string{
@@ -153,6 +216,10 @@ var observableUpDownCounterDesc = map[ObservableUpDownCounterType]string{
 	BuildCounterMeterName:                              "Counter of running builds.",
 
 	TCPFirewallActiveConnections: "Number of currently active TCP firewall connections.",
+
+	WarmPoolSizeMeterName: "Number of pre-booted sandboxes ready in the warm pool.",
+
+	ApiVolumeClientPoolSizeMeterName: "Number of volume clients cached by the API.",
 }
 
 var observableUpDownCounterUnits = map[ObservableUpDownCounterType]string{
@@ -166,14 +233,22 @@ var observableUpDownCounterUnits = map[ObservableUpDownCounterType]string{
 	BuildCounterMeterName:                              "{build}",
 
 	TCPFirewallActiveConnections: "{connection}",
+
+	WarmPoolSizeMeterName: "{sandbox}",
+
+	ApiVolumeClientPoolSizeMeterName: "{client}",
 }
 
 var gaugeFloatDesc = map[GaugeFloatType]string{
-	SandboxCpuUsedGaugeName: "Amount of CPU used by the sandbox.",
+	SandboxCpuUsedGaugeName:        "Amount of CPU used by the sandbox.",
+	VolumeReadThroughputGaugeName:  "Bytes read from the volume per second by the sandbox.",
+	VolumeWriteThroughputGaugeName: "Bytes written to the volume per second by the sandbox.",
 }
 
 var gaugeFloatUnits = map[GaugeFloatType]string{
-	SandboxCpuUsedGaugeName: "{percent}",
+	SandboxCpuUsedGaugeName:        "{percent}",
+	VolumeReadThroughputGaugeName:  "{By}/s",
+	VolumeWriteThroughputGaugeName: "{By}/s",
 }
 
 var gaugeIntDesc = map[GaugeIntType]string{
@@ -184,6 +259,9 @@ var gaugeIntDesc = map[GaugeIntType]string{
 	SandboxDiskUsedGaugeName:      "Amount of disk space used by the sandbox.",
 	SandboxDiskTotalGaugeName:     "Amount of disk space available to the sandbox.",
 	TeamSandboxRunningGaugeName:   "The number of sandboxes running for the team in the interval.",
+	VolumeUsedGaugeName:           "Amount of space used by the volume.",
+	VolumeFilesGaugeName:          "Number of files and directories of the volume.",
+	VolumeLastSyncGaugeName:       "Last time no data written to the volume waited for upload.",
 }
 
 var gaugeIntUnits = map[GaugeIntType]string{
@@ -194,6 +272,9 @@ var gaugeIntUnits = map[GaugeIntType]string{
 	SandboxDiskUsedGaugeName:      "{By}",
 	SandboxDiskTotalGaugeName:     "{By}",
 	TeamSandboxRunningGaugeName:   "{sandbox}",
+	VolumeUsedGaugeName:           "{By}",
+	VolumeFilesGaugeName:          "{file}",
+	VolumeLastSyncGaugeName:       "s",
 }
 
 func GetCounter(meter metric.Meter, name CounterType) (metric.Int64Counter, error) {
@@ -266,6 +347,8 @@ var histogramDesc = map[HistogramType]string{
 	WaitForEnvdDurationHistogramName: "Time taken for Envd to initialize successfully",
 
 	TCPFirewallConnectionDurationHistogramName: "Duration of TCP firewall proxied connections",
+
+	ApiJobsDurationHistogramName: "Time taken to run a background job attempt",
 }
 
 var histogramUnits = map[HistogramType]string{
@@ -275,6 +358,7 @@ var histogramUnits = map[HistogramType]string{
 	BuildRootfsSizeHistogramName:               "{By}",
 	WaitForEnvdDurationHistogramName:           "ms",
 	TCPFirewallConnectionDurationHistogramName: "ms",
+	ApiJobsDurationHistogramName:               "ms",
 }
 
 func GetHistogram(meter metric.Meter, name HistogramType) (metric.Int64Histogram, error) {
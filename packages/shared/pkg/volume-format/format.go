@@ -0,0 +1,61 @@
+// Package volumeformat versions the layout of the volume metadata, so that a release refuses to open
+// a volume written by a newer release with an incompatible format instead of silently corrupting it.
+//
+// The version is stamped in a table of the SQLite metadata of the volume, replicated with the rest of it.
+package volumeformat
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Legacy is the version of the volumes formatted before the metadata was stamped.
+	Legacy = 1
+	// Current is the version new volumes are formatted with and old volumes are migrated to.
+	// Raise it with every change of the metadata layout older releases can't handle.
+	Current = 2
+
+	// stampTable holds the format version in the volume metadata.
+	stampTable = "moru_volume_format"
+)
+
+// ErrUnsupported is returned for volumes written by a newer release with an incompatible format.
+var ErrUnsupported = errors.New("volume was written by a newer release with an incompatible format")
+
+// ReadSQL returns the SQLite statements printing the format version of the volume metadata, Legacy without a stamp.
+func ReadSQL() string {
+	return fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %[1]s (version INTEGER NOT NULL); SELECT COALESCE(MAX(version), %[2]d) FROM %[1]s;",
+		stampTable, Legacy,
+	)
+}
+
+// WriteSQL returns the SQLite statements stamping the volume metadata with the format version.
+func WriteSQL(version int) string {
+	return fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %[1]s (version INTEGER NOT NULL); DELETE FROM %[1]s; INSERT INTO %[1]s (version) VALUES (%[2]d);",
+		stampTable, version,
+	)
+}
+
+// Parse parses the output of the ReadSQL statements.
+func Parse(output string) (int, error) {
+	version, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, fmt.Errorf("invalid volume format version %q: %w", strings.TrimSpace(output), err)
+	}
+
+	return version, nil
+}
+
+// Check returns ErrUnsupported when the volume format version is newer than this release supports.
+func Check(version int) error {
+	if version > Current {
+		return fmt.Errorf("%w: the volume has format version %d, this release supports up to %d", ErrUnsupported, version, Current)
+	}
+
+	return nil
+}
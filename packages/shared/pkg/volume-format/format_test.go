@@ -0,0 +1,27 @@
+package volumeformat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	version, err := Parse("2\n")
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	_, err = Parse("Error: no such table")
+	assert.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Check(Legacy))
+	assert.NoError(t, Check(Current))
+	assert.ErrorIs(t, Check(Current+1), ErrUnsupported)
+}
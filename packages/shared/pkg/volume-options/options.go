@@ -0,0 +1,199 @@
+// Package volumeoptions defines the JuiceFS options a volume can be created or attached with. Only the
+// options of the allowlist are accepted, they are passed to juicefs format and juicefs mount by envd.
+//
+// Format options are applied when the volume is formatted on its first mount and can't change afterwards,
+// so they can only be set when the volume is created. Mount options can also be set per attach.
+package volumeoptions
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BlockSizeKB is the size of the blocks the files are split into in the bucket, in KiB.
+	BlockSizeKB = "blockSizeKB"
+	// Compression is the algorithm the blocks are compressed with: none, lz4 or zstd.
+	Compression = "compression"
+	// CacheDir is the sandbox directory the local disk cache of the mount is kept in, e.g. on a
+	// larger disk. Each volume has its own directory under it.
+	CacheDir = "cacheDir"
+	// CacheSizeMB is the size of the local disk cache of the mount in MiB, 0 disables it.
+	CacheSizeMB = "cacheSizeMB"
+	// BufferSizeMB is the read/write buffer of the mount in MiB, capped by the mount memory.
+	BufferSizeMB = "bufferSizeMB"
+	// Writeback uploads the written blocks in the background, writable mounts default to true.
+	Writeback = "writeback"
+)
+
+const (
+	// MinBufferSizeMB keeps the mount usable under tight memory limits.
+	MinBufferSizeMB = 32
+	// HelperOverheadMB is the memory JuiceFS and Litestream need on top of the buffer.
+	HelperOverheadMB = 96
+	// MinMountMemoryMB is the smallest memory the volume processes run with, lower limits are raised to it.
+	MinMountMemoryMB = MinBufferSizeMB + HelperOverheadMB
+)
+
+type option struct {
+	// flag is the juicefs format or mount flag of the option.
+	flag string
+	// format options are applied by juicefs format, the others by juicefs mount.
+	format   bool
+	validate func(value string) error
+}
+
+// allowlist are the options that can be set, by name.
+var allowlist = map[string]option{
+	BlockSizeKB:  {flag: "--block-size", format: true, validate: intBetween(64, 16*1024)},
+	Compression:  {flag: "--compress", format: true, validate: oneOf("none", "lz4", "zstd")},
+	CacheDir:     {flag: "--cache-dir", validate: cacheDir},
+	CacheSizeMB:  {flag: "--cache-size", validate: intBetween(0, 100*1024)},
+	BufferSizeMB: {flag: "--buffer-size", validate: intBetween(MinBufferSizeMB, 4*1024)},
+	Writeback:    {flag: "--writeback", validate: boolean},
+}
+
+// Validate checks that the options are in the allowlist and have valid values.
+func Validate(options map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		opt, ok := allowlist[name]
+		if !ok {
+			return fmt.Errorf("unsupported volume mount option %q, supported options are %v", name, slices.Sorted(maps.Keys(allowlist)))
+		}
+
+		if err := opt.validate(options[name]); err != nil {
+			return fmt.Errorf("invalid volume mount option %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMount checks the options like Validate and rejects the format options, for the options of
+// a volume that was already created.
+func ValidateMount(options map[string]string) error {
+	if err := Validate(options); err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		if allowlist[name].format {
+			return fmt.Errorf("volume mount option %s can only be set when the volume is created", name)
+		}
+	}
+
+	return nil
+}
+
+// Merge returns the options of the volume with the options of the attach applied over them.
+func Merge(volume, attach map[string]string) map[string]string {
+	if len(volume) == 0 && len(attach) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]string, len(volume)+len(attach))
+	maps.Copy(merged, volume)
+	maps.Copy(merged, attach)
+
+	return merged
+}
+
+// FormatArgs returns the juicefs format flags of the format options, in a stable order.
+func FormatArgs(options map[string]string) []string {
+	var args []string
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		if opt, ok := allowlist[name]; ok && opt.format {
+			args = append(args, opt.flag, options[name])
+		}
+	}
+
+	return args
+}
+
+// Int returns the value of an integer option, def when it isn't set or invalid.
+func Int(options map[string]string, name string, def int64) int64 {
+	value, err := strconv.ParseInt(options[name], 10, 64)
+	if err != nil {
+		return def
+	}
+
+	return value
+}
+
+// String returns the value of an option, def when it isn't set.
+func String(options map[string]string, name, def string) string {
+	if value, ok := options[name]; ok {
+		return value
+	}
+
+	return def
+}
+
+// Bool returns the value of a boolean option, def when it isn't set or invalid.
+func Bool(options map[string]string, name string, def bool) bool {
+	value, err := strconv.ParseBool(options[name])
+	if err != nil {
+		return def
+	}
+
+	return value
+}
+
+func intBetween(minValue, maxValue int64) func(string) error {
+	return func(value string) error {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+
+		if n < minValue || n > maxValue {
+			return fmt.Errorf("%d is not between %d and %d", n, minValue, maxValue)
+		}
+
+		return nil
+	}
+}
+
+func oneOf(values ...string) func(string) error {
+	return func(value string) error {
+		if !slices.Contains(values, value) {
+			return fmt.Errorf("%q is not one of %v", value, values)
+		}
+
+		return nil
+	}
+}
+
+// reservedCacheDirs are the virtual file systems of the sandbox, the cache can't be kept in them.
+var reservedCacheDirs = []string{"/proc", "/sys", "/dev"}
+
+func cacheDir(value string) error {
+	if !filepath.IsAbs(value) || filepath.Clean(value) != value {
+		return fmt.Errorf("%q is not a clean absolute path", value)
+	}
+
+	if value == "/" {
+		return errors.New("the cache can't be kept in the root directory")
+	}
+
+	for _, dir := range reservedCacheDirs {
+		if value == dir || strings.HasPrefix(value, dir+"/") {
+			return fmt.Errorf("the cache can't be kept in %s", dir)
+		}
+	}
+
+	return nil
+}
+
+func boolean(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("%q is not a boolean", value)
+	}
+
+	return nil
+}
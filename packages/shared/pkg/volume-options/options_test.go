@@ -0,0 +1,55 @@
+package volumeoptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Validate(nil))
+	require.NoError(t, Validate(map[string]string{
+		BlockSizeKB:  "1024",
+		Compression:  "zstd",
+		CacheSizeMB:  "0",
+		BufferSizeMB: "512",
+		Writeback:    "false",
+	}))
+
+	assert.ErrorContains(t, Validate(map[string]string{"max-uploads": "100"}), "unsupported")
+	assert.ErrorContains(t, Validate(map[string]string{BlockSizeKB: "32"}), "not between")
+	assert.ErrorContains(t, Validate(map[string]string{CacheSizeMB: "1GiB"}), "not an integer")
+	assert.ErrorContains(t, Validate(map[string]string{Compression: "gzip"}), "not one of")
+	assert.ErrorContains(t, Validate(map[string]string{Writeback: "sometimes"}), "not a boolean")
+	require.NoError(t, Validate(map[string]string{CacheDir: "/mnt/cache"}))
+	assert.ErrorContains(t, Validate(map[string]string{CacheDir: "cache"}), "absolute")
+	assert.ErrorContains(t, Validate(map[string]string{CacheDir: "/mnt/../proc"}), "absolute")
+	assert.ErrorContains(t, Validate(map[string]string{CacheDir: "/"}), "root directory")
+	assert.ErrorContains(t, Validate(map[string]string{CacheDir: "/proc/cache"}), "/proc")
+}
+
+func TestValidateMount(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateMount(map[string]string{CacheSizeMB: "2048", Writeback: "false"}))
+	assert.ErrorContains(t, ValidateMount(map[string]string{Compression: "lz4"}), "when the volume is created")
+}
+
+func TestArgs(t *testing.T) {
+	t.Parallel()
+
+	options := Merge(
+		map[string]string{BlockSizeKB: "1024", Compression: "lz4", CacheSizeMB: "2048"},
+		map[string]string{CacheSizeMB: "512", Writeback: "false"},
+	)
+
+	assert.Equal(t, []string{"--block-size", "1024", "--compress", "lz4"}, FormatArgs(options))
+	assert.Equal(t, int64(512), Int(options, CacheSizeMB, 1024))
+	assert.Equal(t, int64(300), Int(options, BufferSizeMB, 300))
+	assert.Equal(t, "/tmp/cache", String(options, CacheDir, "/tmp/cache"))
+	assert.False(t, Bool(options, Writeback, true))
+	assert.Nil(t, Merge(nil, map[string]string{}))
+}
@@ -0,0 +1,48 @@
+package volumestorage
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MetaEngine is where the JuiceFS metadata of a volume lives, chosen when the volume is created.
+type MetaEngine string
+
+const (
+	// MetaEngineSQLite keeps the metadata in a SQLite database next to each mount, replicated to the
+	// bucket by Litestream under volumeID-meta/.
+	MetaEngineSQLite MetaEngine = "sqlite"
+	// MetaEngineRedis keeps the metadata in a database of the volumes Redis, shared live by all the
+	// mounts and the API. Nothing is replicated to the bucket.
+	MetaEngineRedis MetaEngine = "redis"
+)
+
+// ParseMetaEngine parses a metadata engine name, an empty name is SQLite for the volumes from
+// before the engine was recorded.
+func ParseMetaEngine(name string) (MetaEngine, error) {
+	switch MetaEngine(name) {
+	case "", MetaEngineSQLite:
+		return MetaEngineSQLite, nil
+	case MetaEngineRedis:
+		return MetaEngineRedis, nil
+	default:
+		return "", fmt.Errorf("unsupported volume metadata engine %q, expected %q or %q", name, MetaEngineSQLite, MetaEngineRedis)
+	}
+}
+
+// RedisMetaURL returns the JuiceFS metadata URL of the Redis database db on the Redis at baseURL,
+// which keeps its scheme, credentials and options.
+func RedisMetaURL(baseURL string, db int32) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse redis URL: %w", err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("empty host in redis URL %q", baseURL)
+	}
+
+	u.Path = "/" + strconv.Itoa(int(db))
+
+	return u.String(), nil
+}
@@ -0,0 +1,92 @@
+// Package volumestorage names the object storage holding the volume data, so that the API,
+// the orchestrator and envd agree on where the data and the metadata replica of a volume live.
+//
+// Volumes keep their data under the volumeID/ prefix of the bucket and the Litestream replica of
+// their metadata under volumeID-meta/, on GCS and on S3 alike.
+package volumestorage
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Provider is the object storage of the volume buckets.
+type Provider string
+
+const (
+	// GCS stores the volumes in Google Cloud Storage, downscoped with credential access boundaries.
+	GCS Provider = "gcs"
+	// S3 stores the volumes in AWS S3, downscoped with STS session policies.
+	S3 Provider = "s3"
+
+	// ProviderEnv selects the provider of the API and the orchestrator, GCS when unset.
+	ProviderEnv = "VOLUMES_STORAGE_PROVIDER"
+	// S3RegionEnv is the region of the S3 volume buckets.
+	S3RegionEnv = "VOLUMES_S3_REGION"
+	// S3EndpointEnv is the URL of an S3 compatible storage, like MinIO for local development,
+	// used instead of AWS S3.
+	S3EndpointEnv = "VOLUMES_S3_ENDPOINT"
+)
+
+// Parse parses a provider name, an empty name is GCS for the configs from before providers.
+func Parse(name string) (Provider, error) {
+	switch Provider(name) {
+	case "", GCS:
+		return GCS, nil
+	case S3:
+		return S3, nil
+	default:
+		return "", fmt.Errorf("unsupported volume storage provider %q, expected %q or %q", name, GCS, S3)
+	}
+}
+
+// FromEnv returns the provider configured in ProviderEnv.
+func FromEnv() (Provider, error) {
+	return Parse(os.Getenv(ProviderEnv))
+}
+
+// JuiceFSStorage returns the object storage name JuiceFS formats the volume with.
+func (p Provider) JuiceFSStorage() string {
+	if p == S3 {
+		return "s3"
+	}
+
+	return "gs"
+}
+
+// DataURL returns the JuiceFS bucket URL of the volume data. S3 buckets are addressed by their
+// regional endpoint, or by their path on an S3 compatible endpoint. JuiceFS keeps the data under
+// the volume name either way.
+func (p Provider) DataURL(bucket, volumeID, region, endpoint string) string {
+	if p == S3 {
+		if endpoint != "" {
+			return strings.TrimSuffix(endpoint, "/") + "/" + bucket
+		}
+
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, region)
+	}
+
+	return fmt.Sprintf("gs://%s/%s", bucket, volumeID)
+}
+
+// MetaReplicaURL returns the URL of the Litestream replica of the volume metadata.
+func (p Provider) MetaReplicaURL(bucket, volumeID string) string {
+	scheme := "gs"
+	if p == S3 {
+		scheme = "s3"
+	}
+
+	return fmt.Sprintf("%s://%s/%s-meta", scheme, bucket, volumeID)
+}
+
+// LitestreamReplicaOptions returns the settings of the Litestream replica for an S3 compatible
+// endpoint, as lines of a replica in the Litestream config. Litestream only reads the endpoint
+// from its config, not from the replica URL. Empty without an endpoint.
+func (p Provider) LitestreamReplicaOptions(endpoint string) string {
+	if p != S3 || endpoint == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("        endpoint: %s\n        force-path-style: true\n", strings.TrimSuffix(endpoint, "/"))
+}
@@ -15,6 +15,7 @@ tool (
 
 require (
 	connectrpc.com/connect v1.18.1
+	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/google/uuid v1.6.0
 	github.com/moru-ai/sandbox-infra/packages/clickhouse v0.0.0
 	github.com/moru-ai/sandbox-infra/packages/db v0.0.0
@@ -34,6 +35,7 @@ require (
 	github.com/Microsoft/go-winio v0.6.2 // indirect
 	github.com/andybalholm/brotli v1.1.1 // indirect
 	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
+	github.com/aws/smithy-go v1.22.3 // indirect
 	github.com/bitfield/gotestdox v0.2.2 // indirect
 	github.com/cenkalti/backoff/v5 v5.0.3 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
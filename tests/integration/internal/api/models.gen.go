@@ -13,11 +13,12 @@ import (
 )
 
 const (
-	AccessTokenAuthScopes    = "AccessTokenAuth.Scopes"
-	AdminTokenAuthScopes     = "AdminTokenAuth.Scopes"
-	ApiKeyAuthScopes         = "ApiKeyAuth.Scopes"
-	Supabase1TokenAuthScopes = "Supabase1TokenAuth.Scopes"
-	Supabase2TeamAuthScopes  = "Supabase2TeamAuth.Scopes"
+	AccessTokenAuthScopes       = "AccessTokenAuth.Scopes"
+	AdminTokenAuthScopes        = "AdminTokenAuth.Scopes"
+	ApiKeyAuthScopes            = "ApiKeyAuth.Scopes"
+	Supabase1TokenAuthScopes    = "Supabase1TokenAuth.Scopes"
+	Supabase2TeamAuthScopes     = "Supabase2TeamAuth.Scopes"
+	VolumeCredentialsAuthScopes = "VolumeCredentialsAuth.Scopes"
 )
 
 // Defines values for AWSRegistryType.
@@ -25,10 +26,49 @@ const (
 	Aws AWSRegistryType = "aws"
 )
 
+// Defines values for AuditAction.
+const (
+	AuditActionFileDelete   AuditAction = "file.delete"
+	AuditActionFileDownload AuditAction = "file.download"
+	AuditActionFileUpload   AuditAction = "file.upload"
+	AuditActionVolumeAttach AuditAction = "volume.attach"
+	AuditActionVolumeCreate AuditAction = "volume.create"
+	AuditActionVolumeDelete AuditAction = "volume.delete"
+	AuditActionVolumeDetach AuditAction = "volume.detach"
+)
+
+// Defines values for AuditActorType.
+const (
+	AuditActorAPIKey AuditActorType = "api_key"
+	AuditActorUser   AuditActorType = "user"
+)
+
+// Defines values for FileErrorReason.
+const (
+	ChecksumMismatch FileErrorReason = "checksum_mismatch"
+	DanglingSymlink  FileErrorReason = "dangling_symlink"
+	Exists           FileErrorReason = "exists"
+	InvalidArchive   FileErrorReason = "invalid_archive"
+	IsDirectory      FileErrorReason = "is_directory"
+	NotDirectory     FileErrorReason = "not_directory"
+	NotEmpty         FileErrorReason = "not_empty"
+	NotFound         FileErrorReason = "not_found"
+	QuotaExceeded    FileErrorReason = "quota_exceeded"
+	UploadTooLarge   FileErrorReason = "upload_too_large"
+)
+
 // Defines values for FileInfoType.
 const (
-	Directory FileInfoType = "directory"
-	File      FileInfoType = "file"
+	FileInfoTypeDirectory FileInfoType = "directory"
+	FileInfoTypeFile      FileInfoType = "file"
+	FileInfoTypeSymlink   FileInfoType = "symlink"
+)
+
+// Defines values for FileStatType.
+const (
+	FileStatTypeDirectory FileStatType = "directory"
+	FileStatTypeFile      FileStatType = "file"
+	FileStatTypeSymlink   FileStatType = "symlink"
 )
 
 // Defines values for GCPRegistryType.
@@ -69,6 +109,21 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for PlatformComponent.
+const (
+	PlatformComponentAPI               PlatformComponent = "api"
+	PlatformComponentLogs              PlatformComponent = "logs"
+	PlatformComponentSandboxScheduling PlatformComponent = "sandbox_scheduling"
+	PlatformComponentVolumes           PlatformComponent = "volumes"
+)
+
+// Defines values for PlatformStatusState.
+const (
+	PlatformStatusDegraded    PlatformStatusState = "degraded"
+	PlatformStatusOperational PlatformStatusState = "operational"
+	PlatformStatusOutage      PlatformStatusState = "outage"
+)
+
 // Defines values for SandboxLogEventType.
 const (
 	ProcessEnd   SandboxLogEventType = "process_end"
@@ -106,12 +161,87 @@ const (
 	TemplateBuildStatusWaiting  TemplateBuildStatus = "waiting"
 )
 
+// Defines values for VolumeCreateCheckCheck.
+const (
+	Bucket       VolumeCreateCheckCheck = "bucket"
+	MountOptions VolumeCreateCheckCheck = "mountOptions"
+	Name         VolumeCreateCheckCheck = "name"
+	Quota        VolumeCreateCheckCheck = "quota"
+	RedisDb      VolumeCreateCheckCheck = "redisDb"
+	SizeLimit    VolumeCreateCheckCheck = "sizeLimit"
+)
+
+// Defines values for VolumeCreateCheckStatus.
+const (
+	VolumeCreateCheckStatusFailed  VolumeCreateCheckStatus = "failed"
+	VolumeCreateCheckStatusPassed  VolumeCreateCheckStatus = "passed"
+	VolumeCreateCheckStatusSkipped VolumeCreateCheckStatus = "skipped"
+)
+
+// Defines values for VolumeMetadataEngine.
+const (
+	Redis  VolumeMetadataEngine = "redis"
+	Sqlite VolumeMetadataEngine = "sqlite"
+)
+
+// Defines values for VolumeOperationState.
+const (
+	VolumeOperationStateCanceled  VolumeOperationState = "canceled"
+	VolumeOperationStateFailed    VolumeOperationState = "failed"
+	VolumeOperationStatePending   VolumeOperationState = "pending"
+	VolumeOperationStateRunning   VolumeOperationState = "running"
+	VolumeOperationStateSucceeded VolumeOperationState = "succeeded"
+)
+
+// Defines values for VolumeOperationType.
+const (
+	Delete VolumeOperationType = "delete"
+	Fsck   VolumeOperationType = "fsck"
+)
+
+// Defines values for VolumeStatus.
+const (
+	Available     VolumeStatus = "available"
+	Creating      VolumeStatus = "creating"
+	Deleting      VolumeStatus = "deleting"
+	Failed        VolumeStatus = "failed"
+	PendingDelete VolumeStatus = "pending_delete"
+)
+
+// Defines values for VolumeUploadStatus.
+const (
+	Aborted   VolumeUploadStatus = "aborted"
+	Completed VolumeUploadStatus = "completed"
+	Uploading VolumeUploadStatus = "uploading"
+)
+
+// Defines values for WebhookEventType.
+const (
+	VolumeCreated      WebhookEventType = "volume.created"
+	VolumeDeleted      WebhookEventType = "volume.deleted"
+	VolumeFileDeleted  WebhookEventType = "volume.file.deleted"
+	VolumeFileUploaded WebhookEventType = "volume.file.uploaded"
+)
+
 // Defines values for GetTeamsTeamIDMetricsMaxParamsMetric.
 const (
 	ConcurrentSandboxes GetTeamsTeamIDMetricsMaxParamsMetric = "concurrent_sandboxes"
 	SandboxStartRate    GetTeamsTeamIDMetricsMaxParamsMetric = "sandbox_start_rate"
 )
 
+// Defines values for GetVolumesParamsOrder.
+const (
+	Asc  GetVolumesParamsOrder = "asc"
+	Desc GetVolumesParamsOrder = "desc"
+)
+
+// Defines values for GetVolumesVolumeIDFilesArchiveParamsFormat.
+const (
+	Tar   GetVolumesVolumeIDFilesArchiveParamsFormat = "tar"
+	TarGz GetVolumesVolumeIDFilesArchiveParamsFormat = "tar.gz"
+	Zip   GetVolumesVolumeIDFilesArchiveParamsFormat = "zip"
+)
+
 // AWSRegistry defines model for AWSRegistry.
 type AWSRegistry struct {
 	// AwsAccessKeyId AWS Access Key ID for ECR authentication
@@ -130,6 +260,24 @@ type AWSRegistry struct {
 // AWSRegistryType Type of registry authentication
 type AWSRegistryType string
 
+// AdminRedisDatabaseUsage defines model for AdminRedisDatabaseUsage.
+type AdminRedisDatabaseUsage struct {
+	// Allocated Highest database given to a volume, databases are given out from 1
+	Allocated int32 `json:"allocated"`
+
+	// Capacity Number of databases of the volumes Redis, database 0 included. Missing when the volumes Redis doesn't allow reading its configuration.
+	Capacity *int32 `json:"capacity,omitempty"`
+
+	// Free Databases freed by destroyed volumes, given to new volumes first
+	Free int32 `json:"free"`
+
+	// Lost Allocated databases neither used nor free, freed before the databases were reused or held by metadata migrations in progress
+	Lost int32 `json:"lost"`
+
+	// Used Databases used by volumes
+	Used int32 `json:"used"`
+}
+
 // AdminSandboxKillResult defines model for AdminSandboxKillResult.
 type AdminSandboxKillResult struct {
 	// FailedCount Number of sandboxes that failed to kill
@@ -139,6 +287,98 @@ type AdminSandboxKillResult struct {
 	KilledCount int `json:"killedCount"`
 }
 
+// AdminTemplateEnvdUpdate defines model for AdminTemplateEnvdUpdate.
+type AdminTemplateEnvdUpdate struct {
+	// BuildID Identifier of the build updating the template
+	BuildID string `json:"buildID"`
+
+	// EnvdVersion Version of envd the build puts into the template
+	EnvdVersion string `json:"envdVersion"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+}
+
+// AdminVolumeCleanup defines model for AdminVolumeCleanup.
+type AdminVolumeCleanup struct {
+	// NamePrefix Only volumes whose name starts with the prefix are deleted
+	NamePrefix string `json:"namePrefix"`
+
+	// OlderThanHours Only volumes created at least this many hours ago are deleted
+	OlderThanHours int32 `json:"olderThanHours"`
+}
+
+// AdminVolumeCleanupResult defines model for AdminVolumeCleanupResult.
+type AdminVolumeCleanupResult struct {
+	// DeletedCount Number of volumes deleted
+	DeletedCount int `json:"deletedCount"`
+
+	// FailedCount Number of volumes that failed to delete
+	FailedCount int `json:"failedCount"`
+
+	// SkippedCount Number of volumes kept because they are attached to a running sandbox or have deletion protection
+	SkippedCount int `json:"skippedCount"`
+}
+
+// AdminVolumeMetadataMigration defines model for AdminVolumeMetadataMigration.
+type AdminVolumeMetadataMigration struct {
+	// MetadataEngine Where the file system metadata of the volume lives, chosen when the volume is created. sqlite replicates the metadata to the bucket of the volume, redis keeps it in Redis and requires the deployment to have volumes Redis configured.
+	MetadataEngine VolumeMetadataEngine `json:"metadataEngine"`
+}
+
+// AdminVolumeOrphanPrefix defines model for AdminVolumeOrphanPrefix.
+type AdminVolumeOrphanPrefix struct {
+	// Bucket Bucket the prefix is in
+	Bucket string `json:"bucket"`
+
+	// Prefix Prefix with the data or the metadata replica of a volume that doesn't exist
+	Prefix string `json:"prefix"`
+}
+
+// AdminVolumeOrphans defines model for AdminVolumeOrphans.
+type AdminVolumeOrphans struct {
+	Prefixes []AdminVolumeOrphanPrefix `json:"prefixes"`
+
+	// RedisDatabases Databases of the volumes Redis with metadata of a volume that doesn't exist
+	RedisDatabases []int32 `json:"redisDatabases"`
+}
+
+// AuditAction Operation recorded in the audit log
+type AuditAction string
+
+// AuditActorType Credential the operation was made with
+type AuditActorType string
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	// Action Operation recorded in the audit log
+	Action AuditAction `json:"action"`
+
+	// ActorId ID of the API key or of the user that made the operation
+	ActorId *openapi_types.UUID `json:"actorId,omitempty"`
+
+	// ActorType Credential the operation was made with
+	ActorType AuditActorType `json:"actorType"`
+
+	// CreatedAt Time of the operation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Identifier of the entry
+	Id openapi_types.UUID `json:"id"`
+
+	// Path File of the file operations
+	Path *string `json:"path,omitempty"`
+
+	// SandboxId Sandbox of the attach and detach operations
+	SandboxId *string `json:"sandboxId,omitempty"`
+
+	// SourceIp IP address the request came from
+	SourceIp *string `json:"sourceIp,omitempty"`
+
+	// VolumeId Volume of the operation
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
 // BuildLogEntry defines model for BuildLogEntry.
 type BuildLogEntry struct {
 	// Level Log level for build logs
@@ -169,16 +409,39 @@ type BuildStatusReason struct {
 // CPUCount CPU cores for the sandbox
 type CPUCount = int32
 
+// Capabilities defines model for Capabilities.
+type Capabilities struct {
+	Volumes VolumeCapabilities `json:"volumes"`
+}
+
 // ConnectSandbox defines model for ConnectSandbox.
 type ConnectSandbox struct {
 	// Timeout Timeout in seconds from the current time after which the sandbox should expire
 	Timeout int32 `json:"timeout"`
 }
 
+// CreateUploadRequest defines model for CreateUploadRequest.
+type CreateUploadRequest struct {
+	// Path Destination path of the uploaded file, parent directories are created as needed
+	Path string `json:"path"`
+}
+
 // CreateVolumeRequest defines model for CreateVolumeRequest.
 type CreateVolumeRequest struct {
+	// DeletionProtection Refuse to delete the volume until the protection is cleared
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// MetadataEngine Where the file system metadata of the volume lives, chosen when the volume is created. sqlite replicates the metadata to the bucket of the volume, redis keeps it in Redis and requires the deployment to have volumes Redis configured.
+	MetadataEngine *VolumeMetadataEngine `json:"metadataEngine,omitempty"`
+
+	// MountOptions JuiceFS options of the volume by name. Only these options are accepted: blockSizeKB (64-16384, size of the blocks files are stored in), compression (none, lz4 or zstd), cacheDir (absolute path of the local disk cache in the sandbox, a directory per volume is created under it), cacheSizeMB (0-102400, size of the local disk cache, 0 disables it, defaults to 1024), bufferSizeMB (32-4096, read/write buffer, capped by the mount memory, defaults to 300) and writeback (true or false, upload writes in the background, defaults to true). blockSizeKB and compression are fixed when the volume is formatted and can only be set when it's created, the other options can be overridden when the volume is attached and default per template.
+	MountOptions *VolumeMountOptions `json:"mountOptions,omitempty"`
+
 	// Name Volume name (unique per team, slug format)
 	Name string `json:"name"`
+
+	// SizeLimitBytes Size quota of the volume in bytes. Uploads through the API that would exceed it are rejected. Unlimited if not set.
+	SizeLimitBytes *int64 `json:"sizeLimitBytes,omitempty"`
 }
 
 // CreatedAccessToken defines model for CreatedAccessToken.
@@ -197,6 +460,27 @@ type CreatedAccessToken struct {
 	Token string `json:"token"`
 }
 
+// CreatedS3Credentials defines model for CreatedS3Credentials.
+type CreatedS3Credentials struct {
+	// AccessKeyId Access key ID of the credentials
+	AccessKeyId string `json:"accessKeyId"`
+
+	// CreatedAt Timestamp of the creation of the credentials
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ReadOnly Whether the credentials only read the volume
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// SecretAccessKey Secret access key signing the requests with AWS Signature Version 4, it can't be read back
+	SecretAccessKey string `json:"secretAccessKey"`
+
+	// Subpath Directory of the volume the credentials are restricted to, absent for the whole volume
+	Subpath *string `json:"subpath,omitempty"`
+
+	// VolumeId Volume the credentials are restricted to, they reach all the volumes of the team when absent
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
 // CreatedTeamAPIKey defines model for CreatedTeamAPIKey.
 type CreatedTeamAPIKey struct {
 	// CreatedAt Timestamp of API key creation
@@ -217,6 +501,24 @@ type CreatedTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// CreatedTeamWebhook defines model for CreatedTeamWebhook.
+type CreatedTeamWebhook struct {
+	// CreatedAt Timestamp of webhook creation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes Types of the events delivered to the webhook
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Secret Secret signing the deliveries, it can't be read back. The X-Moru-Signature header of a delivery is the hex encoded HMAC-SHA256 of the X-Moru-Timestamp header, a dot and the body.
+	Secret string `json:"secret"`
+
+	// Url HTTPS URL the events are posted to
+	Url string `json:"url"`
+}
+
 // DiskMetrics defines model for DiskMetrics.
 type DiskMetrics struct {
 	// Device Device name
@@ -244,6 +546,15 @@ type EnvVars map[string]string
 // EnvdVersion Version of the envd running in the sandbox
 type EnvdVersion = string
 
+// EphemeralVolume Throwaway volume created for the sandbox, for scratch space beyond the rootfs. The volume is attached read-write and deleted with its files when the sandbox is killed.
+type EphemeralVolume struct {
+	// MountPath Absolute path to mount the volume at inside the sandbox (e.g., /mnt/scratch)
+	MountPath string `json:"mountPath"`
+
+	// SizeLimitBytes Size quota of the volume in bytes, unlimited if not set
+	SizeLimitBytes *int64 `json:"sizeLimitBytes,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -251,10 +562,102 @@ type Error struct {
 
 	// Message Error
 	Message string `json:"message"`
+
+	// Reason Machine-readable cause of a failed volume file operation, set by the file API
+	Reason *FileErrorReason `json:"reason,omitempty"`
+}
+
+// FileAttributesRequest defines model for FileAttributesRequest.
+type FileAttributesRequest struct {
+	// Gid Owner group ID
+	Gid *int64 `json:"gid,omitempty"`
+
+	// Mode Permission bits in octal notation, including setuid, setgid and sticky
+	Mode *string `json:"mode,omitempty"`
+
+	// Path Absolute path of the file or directory, a final symlink is followed
+	Path string `json:"path"`
+
+	// Recursive Change the attributes of all the entries below a directory too
+	Recursive *bool `json:"recursive,omitempty"`
+
+	// Uid Owner user ID
+	Uid *int64 `json:"uid,omitempty"`
+}
+
+// FileAttributesResponse defines model for FileAttributesResponse.
+type FileAttributesResponse struct {
+	// Entries Number of entries whose attributes were changed
+	Entries int64    `json:"entries"`
+	File    FileStat `json:"file"`
+}
+
+// FileCopyRequest defines model for FileCopyRequest.
+type FileCopyRequest struct {
+	// Destination Destination path, the parent directories are created as needed
+	Destination string `json:"destination"`
+
+	// DestinationVolumeId Volume to copy to, owned by the same team. Defaults to the source volume.
+	DestinationVolumeId *string `json:"destinationVolumeId,omitempty"`
+
+	// Overwrite Replace existing files at the destination
+	Overwrite *bool `json:"overwrite,omitempty"`
+
+	// Source Path of the file or directory to copy
+	Source string `json:"source"`
+}
+
+// FileCopyResponse defines model for FileCopyResponse.
+type FileCopyResponse struct {
+	// Directories Number of copied directories
+	Directories int64 `json:"directories"`
+
+	// Files Number of copied files
+	Files int64 `json:"files"`
+
+	// Path Destination path
+	Path string `json:"path"`
+
+	// Size Total size of the copied files in bytes
+	Size int64 `json:"size"`
+
+	// VolumeId Volume the content was copied to
+	VolumeId string `json:"volumeId"`
+}
+
+// FileErrorReason Machine-readable cause of a failed volume file operation, set by the file API
+type FileErrorReason string
+
+// FileGrepMatch defines model for FileGrepMatch.
+type FileGrepMatch struct {
+	// Line Line number, starting at 1
+	Line int64 `json:"line"`
+
+	// Path Path of the file
+	Path string `json:"path"`
+
+	// Text The matching line without the line break, cut after 512 bytes
+	Text string `json:"text"`
+}
+
+// FileGrepResponse defines model for FileGrepResponse.
+type FileGrepResponse struct {
+	// FilesSearched Number of files read
+	FilesSearched int64 `json:"filesSearched"`
+
+	// FilesSkipped Number of files not searched for being binary or larger than the file size limit
+	FilesSkipped int64           `json:"filesSkipped"`
+	Matches      []FileGrepMatch `json:"matches"`
+
+	// Truncated The search stopped at the match or file count limit before all files were searched
+	Truncated bool `json:"truncated"`
 }
 
 // FileInfo defines model for FileInfo.
 type FileInfo struct {
+	// LinkTarget Target of a symlink
+	LinkTarget *string `json:"linkTarget,omitempty"`
+
 	// ModifiedAt Last modification time
 	ModifiedAt *time.Time `json:"modifiedAt,omitempty"`
 
@@ -267,11 +670,11 @@ type FileInfo struct {
 	// Size File size in bytes (only for files)
 	Size *int64 `json:"size,omitempty"`
 
-	// Type Entry type
+	// Type Entry type, symlinks are not followed
 	Type FileInfoType `json:"type"`
 }
 
-// FileInfoType Entry type
+// FileInfoType Entry type, symlinks are not followed
 type FileInfoType string
 
 // FileListResponse defines model for FileListResponse.
@@ -282,6 +685,131 @@ type FileListResponse struct {
 	NextToken *string `json:"nextToken,omitempty"`
 }
 
+// FileMkdirRequest defines model for FileMkdirRequest.
+type FileMkdirRequest struct {
+	// Path Absolute path of the directory to create
+	Path string `json:"path"`
+
+	// Recursive Create missing parent directories, and succeed if the directory already exists
+	Recursive *bool `json:"recursive,omitempty"`
+}
+
+// FilePresignRequest defines model for FilePresignRequest.
+type FilePresignRequest struct {
+	// ExpiresIn Seconds the URL is valid for
+	ExpiresIn *int32 `json:"expiresIn,omitempty"`
+
+	// Materialize Copy the file to a single staging object first when its content isn't stored as a single object, which takes time proportional to the file size
+	Materialize *bool `json:"materialize,omitempty"`
+
+	// Path File path in volume
+	Path string `json:"path"`
+}
+
+// FilePresignResponse defines model for FilePresignResponse.
+type FilePresignResponse struct {
+	// ExpiresAt Time the URL stops working
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Materialized Whether the URL points to a staged copy of the file, which is deleted once the URL expires
+	Materialized bool `json:"materialized"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+
+	// Url Signed URL to download the file from storage with a GET request, without credentials
+	Url string `json:"url"`
+}
+
+// FileStat defines model for FileStat.
+type FileStat struct {
+	// Checksum Hex encoded SHA-256 of the file content, set for files when requested
+	Checksum *string `json:"checksum,omitempty"`
+
+	// ContentType Content type of a file, the type stored on upload or the type of its extension
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Gid Owner group ID
+	Gid int64 `json:"gid"`
+
+	// LinkTarget Target of a symlink
+	LinkTarget *string `json:"linkTarget,omitempty"`
+
+	// Mode Permission bits in octal notation, e.g. 0644
+	Mode string `json:"mode"`
+
+	// ModifiedAt Last modification time
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	// Name File or directory name
+	Name string `json:"name"`
+
+	// Path Full path within volume
+	Path string `json:"path"`
+
+	// Size Size in bytes
+	Size int64 `json:"size"`
+
+	// Type Entry type, symlinks are not followed
+	Type FileStatType `json:"type"`
+
+	// Uid Owner user ID
+	Uid int64 `json:"uid"`
+}
+
+// FileStatType Entry type, symlinks are not followed
+type FileStatType string
+
+// FileSymlinkRequest defines model for FileSymlinkRequest.
+type FileSymlinkRequest struct {
+	// Overwrite Replace an existing file or symlink at path
+	Overwrite *bool `json:"overwrite,omitempty"`
+
+	// Path Absolute path of the symlink to create, missing parent directories are created
+	Path string `json:"path"`
+
+	// Target Target of the symlink, relative to the directory of the symlink or absolute.
+	// Absolute targets are resolved from the volume root by the files API and from the root of the
+	// sandbox filesystem inside sandboxes, so relative targets work in both.
+	Target string `json:"target"`
+}
+
+// FileSyncEntry defines model for FileSyncEntry.
+type FileSyncEntry struct {
+	// Checksum Hex encoded SHA-256 of the file content
+	Checksum string `json:"checksum"`
+
+	// Path Path of the file relative to the synced directory, e.g. src/main.go
+	Path string `json:"path"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+}
+
+// FileSyncRequest defines model for FileSyncRequest.
+type FileSyncRequest struct {
+	// Delete Delete the files and symlinks of the volume directory that aren't in the manifest
+	Delete *bool `json:"delete,omitempty"`
+
+	// Files Files of the local directory, symlinks and empty directories aren't synced
+	Files []FileSyncEntry `json:"files"`
+
+	// Path Directory in volume to compare, it doesn't need to exist
+	Path string `json:"path"`
+}
+
+// FileSyncResponse defines model for FileSyncResponse.
+type FileSyncResponse struct {
+	// Extra Relative paths of the files and symlinks of the volume directory that aren't in the manifest, deleted with delete
+	Extra []string `json:"extra"`
+
+	// Unchanged Number of manifest files with the same content on the volume
+	Unchanged int64 `json:"unchanged"`
+
+	// Upload Manifest paths missing on the volume or with a different content, in manifest order
+	Upload []string `json:"upload"`
+}
+
 // FromImageRegistry defines model for FromImageRegistry.
 type FromImageRegistry struct {
 	union json.RawMessage
@@ -416,20 +944,41 @@ type NewAccessToken struct {
 	Name string `json:"name"`
 }
 
+// NewS3Credentials defines model for NewS3Credentials.
+type NewS3Credentials struct {
+	// ReadOnly Only allow reads of the volume. Requires volumeId.
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// Subpath Restrict the credentials to this directory of the volume, like a volume attached with a subpath. Requires volumeId.
+	Subpath *string `json:"subpath,omitempty"`
+
+	// VolumeId Restrict the credentials to this volume, they reach all the volumes of the team otherwise
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
 // NewSandbox defines model for NewSandbox.
 type NewSandbox struct {
 	// AllowInternetAccess Allow sandbox to access the internet. When set to false, it behaves the same as specifying denyOut to 0.0.0.0/0 in the network config.
 	AllowInternetAccess *bool `json:"allow_internet_access,omitempty"`
 
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool    `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars `json:"envVars,omitempty"`
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// CreateEphemeralVolume Throwaway volume created for the sandbox, for scratch space beyond the rootfs. The volume is attached read-write and deleted with its files when the sandbox is killed.
+	CreateEphemeralVolume *EphemeralVolume `json:"createEphemeralVolume,omitempty"`
+	EnvVars               *EnvVars         `json:"envVars,omitempty"`
 
 	// Mcp MCP configuration for the sandbox
 	Mcp      *Mcp                  `json:"mcp"`
 	Metadata *SandboxMetadata      `json:"metadata,omitempty"`
 	Network  *SandboxNetworkConfig `json:"network,omitempty"`
 
+	// PersistHome Volume ID (e.g., vol_abc123) or name to persist the home directory of the template default user on. A volume with the given name is created if it doesn't exist. The volume is mounted at /mnt/home and the home directory is stored on it owned by the default user, so dotfiles and workspaces survive sandbox recreations. Can be combined with volumeReadOnlyRoot and volumeMountResources, but not with volumeId.
+	PersistHome *string `json:"persistHome,omitempty"`
+
+	// Secrets Names of team secrets to inject into the sandbox. Secrets are set as environment variables of the processes started in the sandbox, but unlike envVars they are not logged, stored with the sandbox or returned by the sandbox environment endpoint.
+	Secrets *[]string `json:"secrets,omitempty"`
+
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
 
@@ -439,11 +988,35 @@ type NewSandbox struct {
 	// Timeout Time to live for the sandbox in seconds.
 	Timeout *int32 `json:"timeout,omitempty"`
 
+	// VolumeAllowMountFailure Start the sandbox without its volume when the volume can't be mounted, instead of failing the sandbox creation. The failure is published as a volume.mount.failed event. Applies to the volume of volumeId, persistHome or createEphemeralVolume and to the default volume of the template.
+	VolumeAllowMountFailure *bool `json:"volumeAllowMountFailure,omitempty"`
+
 	// VolumeId Volume ID to attach (e.g., vol_abc123). Requires volumeMountPath.
 	VolumeId *string `json:"volumeId,omitempty"`
 
+	// VolumeMountOptions JuiceFS options of the volume by name. Only these options are accepted: blockSizeKB (64-16384, size of the blocks files are stored in), compression (none, lz4 or zstd), cacheDir (absolute path of the local disk cache in the sandbox, a directory per volume is created under it), cacheSizeMB (0-102400, size of the local disk cache, 0 disables it, defaults to 1024), bufferSizeMB (32-4096, read/write buffer, capped by the mount memory, defaults to 300) and writeback (true or false, upload writes in the background, defaults to true). blockSizeKB and compression are fixed when the volume is formatted and can only be set when it's created, the other options can be overridden when the volume is attached and default per template.
+	VolumeMountOptions *VolumeMountOptions `json:"volumeMountOptions,omitempty"`
+
 	// VolumeMountPath Mount path inside sandbox (e.g., /workspace/data). Required if volumeId is provided. Must start with /workspace/, /data/, /mnt/, or /volumes/.
 	VolumeMountPath *string `json:"volumeMountPath,omitempty"`
+
+	// VolumeMountResources Resource limits for the processes serving the volume inside the sandbox. The JuiceFS read/write buffer is sized to fit the memory limit, so the mount is slowed down under memory pressure instead of being killed.
+	VolumeMountResources *VolumeMountResources `json:"volumeMountResources,omitempty"`
+
+	// VolumeOverlayPaths Paths inside the sandbox (e.g., /home) whose contents are stored on the attached volume and persist across sandbox recreations. Empty paths on the volume are seeded from the template on first use. Requires volumeId.
+	VolumeOverlayPaths *[]string `json:"volumeOverlayPaths,omitempty"`
+
+	// VolumePrewarm Glob patterns, relative to the volume mount path (e.g., models/*.bin or data), of the files pulled into the local cache of the volume after it's mounted and before the sandbox starts, so the first reads don't wait for the object storage. A matching directory is pulled with everything under it. A failed prewarm doesn't fail the sandbox start. Requires volumeId or persistHome.
+	VolumePrewarm *[]string `json:"volumePrewarm,omitempty"`
+
+	// VolumeReadOnly Mount the volume read-only. Read-only mounts can be shared by multiple sandboxes and allow the sandbox to start from a pre-booted warm pool. A volume can be mounted read-write by a single sandbox at a time. Can't be combined with volumeOverlayPaths or volumeReadOnlyRoot. Requires volumeId.
+	VolumeReadOnly *bool `json:"volumeReadOnly,omitempty"`
+
+	// VolumeReadOnlyRoot Make the template root filesystem read-only. Only volumeOverlayPaths, the volume mount path and scratch paths (/tmp, /run, /dev) stay writable. Requires volumeId.
+	VolumeReadOnlyRoot *bool `json:"volumeReadOnlyRoot,omitempty"`
+
+	// VolumeSubpath Absolute path of a directory of the volume (e.g., /datasets/imagenet) to mount at volumeMountPath instead of the whole volume, so one volume can serve isolated workloads. The sandbox can't reach the rest of the volume. Read-write mounts create the directory when it doesn't exist. volumeOverlayPaths are stored under it. Requires volumeId.
+	VolumeSubpath *string `json:"volumeSubpath,omitempty"`
 }
 
 // NewTeamAPIKey defines model for NewTeamAPIKey.
@@ -452,6 +1025,24 @@ type NewTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// NewTeamSecret defines model for NewTeamSecret.
+type NewTeamSecret struct {
+	// Name Name of the secret, a valid environment variable name
+	Name string `json:"name"`
+
+	// Value Value of the secret, it can't be read back
+	Value string `json:"value"`
+}
+
+// NewTeamWebhook defines model for NewTeamWebhook.
+type NewTeamWebhook struct {
+	// EventTypes Types of the events delivered to the webhook
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Url HTTPS URL the events are posted to
+	Url string `json:"url"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// ClusterID Identifier of the cluster
@@ -570,6 +1161,68 @@ type NodeStatusChange struct {
 	Status NodeStatus `json:"status"`
 }
 
+// PlatformComponent Component of the platform
+type PlatformComponent string
+
+// PlatformComponentStatus defines model for PlatformComponentStatus.
+type PlatformComponentStatus struct {
+	// ErrorRate Share of the requests that failed with a server error in the last 5 minutes, from 0 to 1
+	ErrorRate float64 `json:"errorRate"`
+
+	// LatencyP95Ms 95th percentile of the recent request durations in milliseconds, only reported for sandbox scheduling
+	LatencyP95Ms *int64 `json:"latencyP95Ms,omitempty"`
+
+	// Message Reason of the status when the component is not operational
+	Message *string `json:"message,omitempty"`
+
+	// Name Component of the platform
+	Name PlatformComponent `json:"name"`
+
+	// Requests Number of requests served by the component in the last 5 minutes on this API instance
+	Requests int64 `json:"requests"`
+
+	// Status State of the platform or one of its components.
+	// `operational` works normally, `degraded` fails or is slow for part of the requests,
+	// `outage` fails for most of the requests.
+	Status PlatformStatusState `json:"status"`
+}
+
+// PlatformStatus defines model for PlatformStatus.
+type PlatformStatus struct {
+	Components []PlatformComponentStatus `json:"components"`
+
+	// Status State of the platform or one of its components.
+	// `operational` works normally, `degraded` fails or is slow for part of the requests,
+	// `outage` fails for most of the requests.
+	Status PlatformStatusState `json:"status"`
+
+	// UpdatedAt Time the status was computed
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PlatformStatusState State of the platform or one of its components.
+// `operational` works normally, `degraded` fails or is slow for part of the requests,
+// `outage` fails for most of the requests.
+type PlatformStatusState string
+
+// RateLimit defines model for RateLimit.
+type RateLimit struct {
+	// BurstSize Maximum number of requests allowed at once
+	BurstSize int32 `json:"burstSize"`
+
+	// Name Name of the rate limit, e.g. files.upload
+	Name string `json:"name"`
+
+	// Remaining Number of requests the team can make right now before being throttled
+	Remaining int32 `json:"remaining"`
+
+	// RequestsPerMinute Sustained number of requests allowed per minute
+	RequestsPerMinute int32 `json:"requestsPerMinute"`
+
+	// ThrottledRecently Number of requests of the team rejected with 429 in the last 10 minutes on this API instance
+	ThrottledRecently int64 `json:"throttledRecently"`
+}
+
 // ResumedSandbox defines model for ResumedSandbox.
 type ResumedSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
@@ -580,6 +1233,27 @@ type ResumedSandbox struct {
 	Timeout *int32 `json:"timeout,omitempty"`
 }
 
+// S3Credentials defines model for S3Credentials.
+type S3Credentials struct {
+	// AccessKeyId Access key ID of the credentials
+	AccessKeyId string `json:"accessKeyId"`
+
+	// CreatedAt Timestamp of the creation of the credentials
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LastUsed Last time the credentials signed a request
+	LastUsed *time.Time `json:"lastUsed"`
+
+	// ReadOnly Whether the credentials only read the volume
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// Subpath Directory of the volume the credentials are restricted to, absent for the whole volume
+	Subpath *string `json:"subpath,omitempty"`
+
+	// VolumeId Volume the credentials are restricted to, they reach all the volumes of the team when absent
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
 // Sandbox defines model for Sandbox.
 type Sandbox struct {
 	// Alias Alias of the template
@@ -686,6 +1360,27 @@ type SandboxLogs struct {
 	Logs []SandboxLog `json:"logs"`
 }
 
+// SandboxLogsCount Number of sandbox log entries with an event type and level
+type SandboxLogsCount struct {
+	// Count Number of log entries
+	Count int64 `json:"count"`
+
+	// EventType Type of sandbox log event
+	EventType SandboxLogEventType `json:"eventType"`
+
+	// Level Log level for build logs
+	Level LogLevel `json:"level"`
+}
+
+// SandboxLogsSummary defines model for SandboxLogsSummary.
+type SandboxLogsSummary struct {
+	// Counts Number of log entries per event type and level
+	Counts []SandboxLogsCount `json:"counts"`
+
+	// Total Number of log entries of the sandbox
+	Total int64 `json:"total"`
+}
+
 // SandboxMetadata defines model for SandboxMetadata.
 type SandboxMetadata map[string]string
 
@@ -719,13 +1414,13 @@ type SandboxMetric struct {
 
 // SandboxNetworkConfig defines model for SandboxNetworkConfig.
 type SandboxNetworkConfig struct {
-	// AllowOut List of allowed CIDR blocks or IP addresses for egress traffic. Allowed addresses always take precedence over blocked addresses.
+	// AllowOut List of allowed CIDR blocks, IP addresses or domains for egress traffic. Allowed addresses always take precedence over blocked addresses. Domains require denyOut to contain 0.0.0.0/0, the DNS queries of the sandbox are then answered by a filtering resolver that only resolves allowed domains.
 	AllowOut *[]string `json:"allowOut,omitempty"`
 
 	// AllowPublicTraffic Specify if the sandbox URLs should be accessible only with authentication.
 	AllowPublicTraffic *bool `json:"allowPublicTraffic,omitempty"`
 
-	// DenyOut List of denied CIDR blocks or IP addresses for egress traffic
+	// DenyOut List of denied CIDR blocks, IP addresses or domains for egress traffic. Domains can be exact names (example.com) or wildcards (*.example.com) and are matched against the HTTP Host header or the TLS SNI of outbound TCP connections, denied domains are not resolved. Connections without a hostname (e.g. to a hardcoded IP address, or TLS without SNI) are only matched by address, so a denied domain doesn't block its own addresses reached directly. Add the CIDR blocks of the domain to denyOut to block them as well.
 	DenyOut *[]string `json:"denyOut,omitempty"`
 
 	// MaskRequestHost Specify host mask which will be used for all sandbox requests
@@ -765,6 +1460,27 @@ type SandboxRunStatus string
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxVolumeAttach Volume to mount in a running sandbox
+type SandboxVolumeAttach struct {
+	// MountOptions JuiceFS options of the volume by name. Only these options are accepted: blockSizeKB (64-16384, size of the blocks files are stored in), compression (none, lz4 or zstd), cacheDir (absolute path of the local disk cache in the sandbox, a directory per volume is created under it), cacheSizeMB (0-102400, size of the local disk cache, 0 disables it, defaults to 1024), bufferSizeMB (32-4096, read/write buffer, capped by the mount memory, defaults to 300) and writeback (true or false, upload writes in the background, defaults to true). blockSizeKB and compression are fixed when the volume is formatted and can only be set when it's created, the other options can be overridden when the volume is attached and default per template.
+	MountOptions *VolumeMountOptions `json:"mountOptions,omitempty"`
+
+	// MountPath Absolute path to mount the volume at, under /workspace/, /data/, /mnt/ or /volumes/
+	MountPath string `json:"mountPath"`
+
+	// MountResources Resource limits for the processes serving the volume inside the sandbox. The JuiceFS read/write buffer is sized to fit the memory limit, so the mount is slowed down under memory pressure instead of being killed.
+	MountResources *VolumeMountResources `json:"mountResources,omitempty"`
+
+	// ReadOnly Mount the volume read-only, read-only mounts can be shared by multiple sandboxes while a volume can be mounted read-write by a single sandbox at a time
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// Subpath Absolute path of a directory of the volume (e.g., /datasets/imagenet) to mount instead of the whole volume. The sandbox can't reach the rest of the volume. Read-write mounts create the directory when it doesn't exist.
+	Subpath *string `json:"subpath,omitempty"`
+
+	// VolumeId ID of the volume to mount
+	VolumeId string `json:"volumeId"`
+}
+
 // SandboxesWithMetrics defines model for SandboxesWithMetrics.
 type SandboxesWithMetrics struct {
 	Sandboxes map[string]SandboxMetric `json:"sandboxes"`
@@ -798,24 +1514,75 @@ type TeamAPIKey struct {
 	LastUsed *time.Time               `json:"lastUsed"`
 	Mask     IdentifierMaskingDetails `json:"mask"`
 
-	// Name Name of the API key
+	// Name Name of the API key
+	Name string `json:"name"`
+}
+
+// TeamMetric Team metric with timestamp
+type TeamMetric struct {
+	// ConcurrentSandboxes The number of concurrent sandboxes for the team
+	ConcurrentSandboxes int32 `json:"concurrentSandboxes"`
+
+	// SandboxStartRate Number of sandboxes started per second
+	SandboxStartRate float32 `json:"sandboxStartRate"`
+
+	// Timestamp Timestamp of the metric entry
+	// Deprecated:
+	Timestamp time.Time `json:"timestamp"`
+
+	// TimestampUnix Timestamp of the metric entry in Unix time (seconds since epoch)
+	TimestampUnix int64 `json:"timestampUnix"`
+}
+
+// TeamResourceMetric Resources of all the sandboxes of the team in an interval
+type TeamResourceMetric struct {
+	// CpuCount Number of CPU cores of the sandboxes
+	CpuCount int32 `json:"cpuCount"`
+
+	// CpuUsed Number of CPU cores used by the sandboxes
+	CpuUsed float32 `json:"cpuUsed"`
+
+	// DiskTotal Total disk space of the sandboxes in bytes
+	DiskTotal int64 `json:"diskTotal"`
+
+	// DiskUsed Disk used by the sandboxes in bytes
+	DiskUsed int64 `json:"diskUsed"`
+
+	// MemTotal Total memory of the sandboxes in bytes
+	MemTotal int64 `json:"memTotal"`
+
+	// MemUsed Memory used by the sandboxes in bytes
+	MemUsed int64 `json:"memUsed"`
+
+	// SandboxCount Number of sandboxes that reported their resources in the interval
+	SandboxCount int32 `json:"sandboxCount"`
+
+	// TimestampUnix Start of the interval in Unix time (seconds since epoch)
+	TimestampUnix int64 `json:"timestampUnix"`
+}
+
+// TeamSecret defines model for TeamSecret.
+type TeamSecret struct {
+	// CreatedAt Timestamp of secret creation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the secret, used as the environment variable name in the sandbox
 	Name string `json:"name"`
-}
 
-// TeamMetric Team metric with timestamp
-type TeamMetric struct {
-	// ConcurrentSandboxes The number of concurrent sandboxes for the team
-	ConcurrentSandboxes int32 `json:"concurrentSandboxes"`
+	// UpdatedAt Timestamp of the last change of the secret value
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// SandboxStartRate Number of sandboxes started per second
-	SandboxStartRate float32 `json:"sandboxStartRate"`
+// TeamStorageUsage defines model for TeamStorageUsage.
+type TeamStorageUsage struct {
+	// LimitBytes Storage limit of the team in bytes, unlimited if not set
+	LimitBytes *int64 `json:"limitBytes,omitempty"`
 
-	// Timestamp Timestamp of the metric entry
-	// Deprecated:
-	Timestamp time.Time `json:"timestamp"`
+	// UsedBytes Total size of the files in the team volumes (bytes), refreshed periodically
+	UsedBytes int64 `json:"usedBytes"`
 
-	// TimestampUnix Timestamp of the metric entry in Unix time (seconds since epoch)
-	TimestampUnix int64 `json:"timestampUnix"`
+	// VolumeCount Number of volumes of the team
+	VolumeCount int64 `json:"volumeCount"`
 }
 
 // TeamUser defines model for TeamUser.
@@ -827,6 +1594,21 @@ type TeamUser struct {
 	Id openapi_types.UUID `json:"id"`
 }
 
+// TeamWebhook defines model for TeamWebhook.
+type TeamWebhook struct {
+	// CreatedAt Timestamp of webhook creation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes Types of the events delivered to the webhook
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Url HTTPS URL the events are posted to
+	Url string `json:"url"`
+}
+
 // Template defines model for Template.
 type Template struct {
 	// Aliases Aliases of the template
@@ -1016,6 +1798,18 @@ type TemplateBuildStartV2 struct {
 // TemplateBuildStatus Status of the template build
 type TemplateBuildStatus string
 
+// TemplateDefaultVolume Volume attached to the sandboxes of the template when the request doesn't attach one, e.g. a shared model cache. The volume is looked up by name in the team of the sandbox, the sandbox starts without it when the team has no such volume available. The sandbox isn't created, with a 409, when another sandbox has it attached read-write.
+type TemplateDefaultVolume struct {
+	// MountPath Absolute path to mount the volume at, under /workspace/, /data/, /mnt/ or /volumes/. Required with volumeName.
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// ReadOnly Mount the volume read-only, so any number of sandboxes of the template can share it. A read-write volume is attached to one sandbox of the template at a time, creating another one fails with a 409.
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// VolumeName Name of the volume, or a name prefix ending with * (e.g., model-cache-*) to attach the most recently created volume whose name starts with it. An empty name removes the default volume.
+	VolumeName string `json:"volumeName"`
+}
+
 // TemplateLegacy defines model for TemplateLegacy.
 type TemplateLegacy struct {
 	// Aliases Aliases of the template
@@ -1091,10 +1885,19 @@ type TemplateStep struct {
 
 // TemplateUpdateRequest defines model for TemplateUpdateRequest.
 type TemplateUpdateRequest struct {
+	// DefaultVolume Volume attached to the sandboxes of the template when the request doesn't attach one, e.g. a shared model cache. The volume is looked up by name in the team of the sandbox, the sandbox starts without it when the team has no such volume available. The sandbox isn't created, with a 409, when another sandbox has it attached read-write.
+	DefaultVolume *TemplateDefaultVolume `json:"defaultVolume,omitempty"`
+
 	// Public Whether the template is public or only accessible by the team
 	Public *bool `json:"public,omitempty"`
+
+	// VolumeMountOptions Default mount options of the volumes attached to the sandboxes of the template, e.g. the local disk cache (cacheDir, cacheSizeMB) sized for the template. The options of the volume and of the attach are applied over them. Only the options that can be set on attach are accepted, empty options remove the defaults.
+	VolumeMountOptions *TemplateVolumeMountOptions `json:"volumeMountOptions,omitempty"`
 }
 
+// TemplateVolumeMountOptions Default mount options of the volumes attached to the sandboxes of the template, e.g. the local disk cache (cacheDir, cacheSizeMB) sized for the template. The options of the volume and of the attach are applied over them. Only the options that can be set on attach are accepted, empty options remove the defaults.
+type TemplateVolumeMountOptions map[string]string
+
 // TemplateWithBuilds defines model for TemplateWithBuilds.
 type TemplateWithBuilds struct {
 	// Aliases Aliases of the template
@@ -1106,6 +1909,9 @@ type TemplateWithBuilds struct {
 	// CreatedAt Time when the template was created
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DefaultVolume Volume attached to the sandboxes of the template when the request doesn't attach one, e.g. a shared model cache. The volume is looked up by name in the team of the sandbox, the sandbox starts without it when the team has no such volume available. The sandbox isn't created, with a 409, when another sandbox has it attached read-write.
+	DefaultVolume *TemplateDefaultVolume `json:"defaultVolume,omitempty"`
+
 	// LastSpawnedAt Time when the template was last used
 	LastSpawnedAt *time.Time `json:"lastSpawnedAt"`
 
@@ -1120,6 +1926,9 @@ type TemplateWithBuilds struct {
 
 	// UpdatedAt Time when the template was last updated
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// VolumeMountOptions Default mount options of the volumes attached to the sandboxes of the template, e.g. the local disk cache (cacheDir, cacheSizeMB) sized for the template. The options of the volume and of the attach are applied over them. Only the options that can be set on attach are accepted, empty options remove the defaults.
+	VolumeMountOptions *TemplateVolumeMountOptions `json:"volumeMountOptions,omitempty"`
 }
 
 // UpdateTeamAPIKey defines model for UpdateTeamAPIKey.
@@ -1128,12 +1937,39 @@ type UpdateTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// UpdateVolumeRequest defines model for UpdateVolumeRequest.
+type UpdateVolumeRequest struct {
+	// DeletionProtection Refuse to delete the volume until the protection is cleared
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+// UploadPart defines model for UploadPart.
+type UploadPart struct {
+	// Checksum Hex encoded SHA-256 checksum of the part content
+	Checksum string `json:"checksum"`
+
+	// PartNumber Position of the part in the file, starting at 1
+	PartNumber int32 `json:"partNumber"`
+
+	// Size Size of the part in bytes
+	Size int64 `json:"size"`
+}
+
 // UploadResponse defines model for UploadResponse.
 type UploadResponse struct {
+	// Checksum Hex encoded SHA-256 checksum of the uploaded file, not set when extracting
+	Checksum *string `json:"checksum,omitempty"`
+
+	// Directories Number of directories extracted, set when extracting an archive
+	Directories *int64 `json:"directories,omitempty"`
+
+	// Files Number of files and symlinks extracted, set when extracting an archive
+	Files *int64 `json:"files,omitempty"`
+
 	// Path Path of uploaded file
 	Path string `json:"path"`
 
-	// Size Size of uploaded file in bytes
+	// Size Size of uploaded file in bytes, the total size of the extracted files when extracting
 	Size int64 `json:"size"`
 }
 
@@ -1142,9 +1978,30 @@ type Volume struct {
 	// CreatedAt When the volume was created
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DeleteAfter When the data of a volume pending deletion is destroyed, it can be restored until then
+	DeleteAfter *time.Time `json:"deleteAfter,omitempty"`
+
+	// DeletionProtection Whether the volume can't be deleted until the protection is cleared
+	DeletionProtection bool `json:"deletionProtection"`
+
+	// EphemeralSandboxID Sandbox the volume was created for as scratch space, the volume is deleted when the sandbox is killed
+	EphemeralSandboxID *string `json:"ephemeralSandboxID,omitempty"`
+
+	// MetadataEngine Where the file system metadata of the volume lives, chosen when the volume is created. sqlite replicates the metadata to the bucket of the volume, redis keeps it in Redis and requires the deployment to have volumes Redis configured.
+	MetadataEngine VolumeMetadataEngine `json:"metadataEngine"`
+
+	// MountOptions JuiceFS options of the volume by name. Only these options are accepted: blockSizeKB (64-16384, size of the blocks files are stored in), compression (none, lz4 or zstd), cacheDir (absolute path of the local disk cache in the sandbox, a directory per volume is created under it), cacheSizeMB (0-102400, size of the local disk cache, 0 disables it, defaults to 1024), bufferSizeMB (32-4096, read/write buffer, capped by the mount memory, defaults to 300) and writeback (true or false, upload writes in the background, defaults to true). blockSizeKB and compression are fixed when the volume is formatted and can only be set when it's created, the other options can be overridden when the volume is attached and default per template.
+	MountOptions *VolumeMountOptions `json:"mountOptions,omitempty"`
+
 	// Name Volume name
 	Name string `json:"name"`
 
+	// SizeLimitBytes Size quota of the volume in bytes, unlimited if not set
+	SizeLimitBytes *int64 `json:"sizeLimitBytes,omitempty"`
+
+	// Status Status of a volume. New volumes are creating until they're ready to use and available, or failed when they couldn't be prepared.
+	Status *VolumeStatus `json:"status,omitempty"`
+
 	// TotalFileCount Total number of files in volume
 	TotalFileCount *int64 `json:"totalFileCount,omitempty"`
 
@@ -1158,6 +2015,264 @@ type Volume struct {
 	VolumeID string `json:"volumeID"`
 }
 
+// VolumeAttachment Sandbox the volume is currently mounted in
+type VolumeAttachment struct {
+	// MountPath Path the volume is mounted at inside the sandbox
+	MountPath string `json:"mountPath"`
+
+	// MountedAt When the volume was mounted in the sandbox
+	MountedAt time.Time `json:"mountedAt"`
+
+	// ReadOnly Whether the sandbox mounts the volume read-only
+	ReadOnly bool `json:"readOnly"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// Subpath Directory of the volume mounted in the sandbox, the whole volume when not set
+	Subpath *string `json:"subpath,omitempty"`
+}
+
+// VolumeCapabilities defines model for VolumeCapabilities.
+type VolumeCapabilities struct {
+	// FileOperations Whether the files of the volumes can be read and written through the API
+	FileOperations bool `json:"fileOperations"`
+
+	// MaxUploadBytes Maximum size of a file or archive uploaded in a single request and of a part of a multipart upload.
+	// Larger requests are rejected with 413. Not set when only the request size limit of the API applies.
+	MaxUploadBytes *int64 `json:"maxUploadBytes,omitempty"`
+
+	// MaxUploadParts Maximum number of parts of a multipart upload
+	MaxUploadParts int32 `json:"maxUploadParts"`
+
+	// MaxVolumeSizeBytes Maximum size of a volume, applies to the volumes without a lower size limit. Not set when volumes are unlimited.
+	MaxVolumeSizeBytes *int64 `json:"maxVolumeSizeBytes,omitempty"`
+}
+
+// VolumeCreateCheck defines model for VolumeCreateCheck.
+type VolumeCreateCheck struct {
+	// Check Checked precondition of creating the volume
+	Check VolumeCreateCheckCheck `json:"check"`
+
+	// Message Details of the result
+	Message string `json:"message"`
+
+	// Status Result of the check
+	Status VolumeCreateCheckStatus `json:"status"`
+}
+
+// VolumeCreateCheckCheck Checked precondition of creating the volume
+type VolumeCreateCheckCheck string
+
+// VolumeCreateCheckStatus Result of the check
+type VolumeCreateCheckStatus string
+
+// VolumeCreateDryRun defines model for VolumeCreateDryRun.
+type VolumeCreateDryRun struct {
+	Checks         []VolumeCreateCheck `json:"checks"`
+	ExistingVolume *Volume             `json:"existingVolume,omitempty"`
+
+	// Name Volume name from the request
+	Name string `json:"name"`
+
+	// Ok Whether creating the volume would succeed
+	Ok bool `json:"ok"`
+}
+
+// VolumeFsckReport Findings of a fsck operation, set once it succeeded
+type VolumeFsckReport struct {
+	// BrokenFiles Paths of the files with missing data blocks, at most 100
+	BrokenFiles []string `json:"brokenFiles"`
+
+	// LeakedBytes Size of the leaked data blocks in bytes
+	LeakedBytes int64 `json:"leakedBytes"`
+
+	// LeakedObjects Number of data blocks no file references anymore, e.g. after a crash mid-write
+	LeakedObjects int64 `json:"leakedObjects"`
+
+	// MetadataIssues Inconsistencies found in the metadata, e.g. dangling inodes or wrong directory statistics. Absent when the metadata is consistent.
+	MetadataIssues *string `json:"metadataIssues,omitempty"`
+
+	// MissingObjects Number of data blocks referenced by files but missing from storage, their data is lost
+	MissingObjects int64 `json:"missingObjects"`
+
+	// Repaired Whether the metadata was repaired and the leaked data blocks deleted
+	Repaired bool `json:"repaired"`
+}
+
+// VolumeMetadataEngine Where the file system metadata of the volume lives, chosen when the volume is created. sqlite replicates the metadata to the bucket of the volume, redis keeps it in Redis and requires the deployment to have volumes Redis configured.
+type VolumeMetadataEngine string
+
+// VolumeMetric Usage and throughput of a volume in an interval
+type VolumeMetric struct {
+	// FileCount Number of files and directories of the volume
+	FileCount int64 `json:"fileCount"`
+
+	// LastSyncAt Last time no data written to the volume waited for upload, unset if the data wasn't uploaded yet
+	LastSyncAt *time.Time `json:"lastSyncAt,omitempty"`
+
+	// ReadBytesPerSecond Bytes read from the volume per second by the sandboxes
+	ReadBytesPerSecond float64 `json:"readBytesPerSecond"`
+
+	// Timestamp Start of the interval
+	Timestamp time.Time `json:"timestamp"`
+
+	// TimestampUnix Start of the interval in Unix time (seconds since epoch)
+	TimestampUnix int64 `json:"timestampUnix"`
+
+	// UsedBytes Space used by the volume in bytes
+	UsedBytes int64 `json:"usedBytes"`
+
+	// WriteBytesPerSecond Bytes written to the volume per second by the sandboxes
+	WriteBytesPerSecond float64 `json:"writeBytesPerSecond"`
+}
+
+// VolumeMountOptions JuiceFS options of the volume by name. Only these options are accepted: blockSizeKB (64-16384, size of the blocks files are stored in), compression (none, lz4 or zstd), cacheDir (absolute path of the local disk cache in the sandbox, a directory per volume is created under it), cacheSizeMB (0-102400, size of the local disk cache, 0 disables it, defaults to 1024), bufferSizeMB (32-4096, read/write buffer, capped by the mount memory, defaults to 300) and writeback (true or false, upload writes in the background, defaults to true). blockSizeKB and compression are fixed when the volume is formatted and can only be set when it's created, the other options can be overridden when the volume is attached and default per template.
+type VolumeMountOptions map[string]string
+
+// VolumeMountResources Resource limits for the processes serving the volume inside the sandbox. The JuiceFS read/write buffer is sized to fit the memory limit, so the mount is slowed down under memory pressure instead of being killed.
+type VolumeMountResources struct {
+	// CpuWeight Relative CPU weight of the volume processes, user processes run with 50. Defaults to 100.
+	CpuWeight *int32 `json:"cpuWeight,omitempty"`
+
+	// MemoryMB Memory available to the volume processes in MiB. Defaults to a quarter of the sandbox memory and can't exceed half of it.
+	MemoryMB *int32 `json:"memoryMB,omitempty"`
+}
+
+// VolumeOperation Asynchronous job running on a volume
+type VolumeOperation struct {
+	// CreatedAt When the operation was requested
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Why the operation failed
+	Error *string `json:"error,omitempty"`
+
+	// FinishedAt When the operation succeeded, failed or was canceled
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Fsck Findings of a fsck operation, set once it succeeded
+	Fsck *VolumeFsckReport `json:"fsck,omitempty"`
+
+	// OperationID Unique operation identifier
+	OperationID string `json:"operationID"`
+
+	// Progress Completion of the operation in percent
+	Progress int32 `json:"progress"`
+
+	// StartedAt When the operation started running
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// State State of a volume operation
+	State VolumeOperationState `json:"state"`
+
+	// Type Kind of a volume operation. A delete operation waits in pending during the deletion grace period,
+	// a fsck operation checks the consistency of the volume.
+	Type VolumeOperationType `json:"type"`
+
+	// UpdatedAt When the operation was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// VolumeID Volume the operation runs on, the volume may no longer exist
+	VolumeID string `json:"volumeID"`
+}
+
+// VolumeOperationState State of a volume operation
+type VolumeOperationState string
+
+// VolumeOperationType Kind of a volume operation. A delete operation waits in pending during the deletion grace period,
+// a fsck operation checks the consistency of the volume.
+type VolumeOperationType string
+
+// VolumeRun Mount of the volume in a sandbox, kept once the volume is detached
+type VolumeRun struct {
+	// DetachedAt When the volume was detached from the sandbox, not set while it's mounted
+	DetachedAt *time.Time `json:"detachedAt,omitempty"`
+
+	// MountPath Path the volume was mounted at inside the sandbox
+	MountPath string `json:"mountPath"`
+
+	// MountedAt When the volume was mounted in the sandbox
+	MountedAt time.Time `json:"mountedAt"`
+
+	// ReadOnly Whether the sandbox mounted the volume read-only
+	ReadOnly bool `json:"readOnly"`
+
+	// SandboxID Identifier of the sandbox the volume was mounted in
+	SandboxID string `json:"sandboxID"`
+
+	// Subpath Directory of the volume mounted in the sandbox, the whole volume when not set
+	Subpath *string `json:"subpath,omitempty"`
+}
+
+// VolumeStatus Status of a volume. New volumes are creating until they're ready to use and available, or failed when they couldn't be prepared.
+type VolumeStatus string
+
+// VolumeUpload defines model for VolumeUpload.
+type VolumeUpload struct {
+	// CreatedAt When the upload was started
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When an unfinished upload is discarded, extended by every received part
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Parts Parts received so far, ordered by part number. Resume an interrupted upload by sending the missing parts.
+	Parts []UploadPart `json:"parts"`
+
+	// Path Destination path of the uploaded file
+	Path string `json:"path"`
+
+	// Status State of the upload
+	Status VolumeUploadStatus `json:"status"`
+
+	// UploadID Unique upload identifier
+	UploadID string `json:"uploadID"`
+
+	// VolumeID Volume the file is uploaded to
+	VolumeID string `json:"volumeID"`
+}
+
+// VolumeUploadStatus State of the upload
+type VolumeUploadStatus string
+
+// VolumeUsage Storage usage of a volume. Files sharing chunks (e.g., server-side copies) and compression make the stored size differ from the size reported by `du`.
+type VolumeUsage struct {
+	// Compression Compression algorithm of the volume chunks (e.g., lz4, none)
+	Compression string `json:"compression"`
+
+	// CompressionRatio uniqueBytes divided by storedBytes, 1 when the data doesn't compress
+	CompressionRatio float64 `json:"compressionRatio"`
+
+	// DedupRatio referencedBytes divided by uniqueBytes, 1 when no chunks are shared
+	DedupRatio float64 `json:"dedupRatio"`
+
+	// DirectoryCount Number of directories
+	DirectoryCount int64 `json:"directoryCount"`
+
+	// FileCount Number of files
+	FileCount int64 `json:"fileCount"`
+
+	// LogicalBytes Sum of the file sizes, as reported by `du --apparent-size`
+	LogicalBytes int64 `json:"logicalBytes"`
+
+	// ReferencedBytes Data referenced by the files, excluding sparse holes
+	ReferencedBytes int64 `json:"referencedBytes"`
+
+	// StoredBytes Bytes stored in object storage after compression, counted against the quota
+	StoredBytes int64 `json:"storedBytes"`
+
+	// UniqueBytes Data stored once after deduplicating shared chunks, before compression
+	UniqueBytes int64 `json:"uniqueBytes"`
+
+	// VolumeID Volume identifier
+	VolumeID string `json:"volumeID"`
+}
+
+// WebhookEventType Type of a volume event delivered to webhooks
+type WebhookEventType string
+
+// AccessKeyID defines model for accessKeyID.
+type AccessKeyID = string
+
 // AccessTokenID defines model for accessTokenID.
 type AccessTokenID = string
 
@@ -1170,6 +2285,9 @@ type BuildID = string
 // NodeID defines model for nodeID.
 type NodeID = string
 
+// OperationID defines model for operationID.
+type OperationID = string
+
 // PaginationLimit defines model for paginationLimit.
 type PaginationLimit = int32
 
@@ -1179,15 +2297,24 @@ type PaginationNextToken = string
 // SandboxID defines model for sandboxID.
 type SandboxID = string
 
+// SecretName defines model for secretName.
+type SecretName = string
+
 // TeamID defines model for teamID.
 type TeamID = string
 
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// UploadID defines model for uploadID.
+type UploadID = string
+
 // VolumeIdOrName defines model for volumeIdOrName.
 type VolumeIdOrName = string
 
+// WebhookID defines model for webhookID.
+type WebhookID = openapi_types.UUID
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -1206,6 +2333,30 @@ type N409 = Error
 // N500 defines model for 500.
 type N500 = Error
 
+// GetAuditLogsParams defines parameters for GetAuditLogs.
+type GetAuditLogsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+
+	// Action Filter the entries of the action
+	Action *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// VolumeID Filter the entries of the volume
+	VolumeID *string `form:"volumeID,omitempty" json:"volumeID,omitempty"`
+
+	// ActorID Filter the entries of the API key or user
+	ActorID *openapi_types.UUID `form:"actorID,omitempty" json:"actorID,omitempty"`
+
+	// Since Filter the entries recorded at or after the time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Filter the entries recorded before the time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // GetNodesNodeIDParams defines parameters for GetNodesNodeID.
 type GetNodesNodeIDParams struct {
 	// ClusterID Identifier of the cluster
@@ -1276,6 +2427,15 @@ type GetTeamsTeamIDMetricsMaxParams struct {
 // GetTeamsTeamIDMetricsMaxParamsMetric defines parameters for GetTeamsTeamIDMetricsMax.
 type GetTeamsTeamIDMetricsMaxParamsMetric string
 
+// GetTeamsTeamIDMetricsResourcesParams defines parameters for GetTeamsTeamIDMetricsResources.
+type GetTeamsTeamIDMetricsResourcesParams struct {
+	// Start Unix timestamp for the start of the interval, in seconds, for which the metrics
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Unix timestamp for the end of the interval, in seconds, for which the metrics
+	End *int64 `form:"end,omitempty" json:"end,omitempty"`
+}
+
 // GetTemplatesParams defines parameters for GetTemplates.
 type GetTemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
@@ -1348,6 +2508,30 @@ type GetVolumesParams struct {
 
 	// NextToken Cursor to start the list from
 	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+
+	// Status Filter volumes by one or more statuses, volumes pending deletion are only listed when filtered for
+	Status *[]VolumeStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// NamePrefix Filter volumes whose name starts with the prefix
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
+
+	// Order Order of the volumes by creation time, newest first by default
+	Order *GetVolumesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetVolumesParamsOrder defines parameters for GetVolumes.
+type GetVolumesParamsOrder string
+
+// PostVolumesParams defines parameters for PostVolumes.
+type PostVolumesParams struct {
+	// DryRun Run the checks of creating the volume and return a report instead of creating it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// DeleteVolumesIdOrNameParams defines parameters for DeleteVolumesIdOrName.
+type DeleteVolumesIdOrNameParams struct {
+	// Force Destroy the volume data immediately, without the grace period
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
 }
 
 // DeleteVolumesVolumeIDFilesParams defines parameters for DeleteVolumesVolumeIDFiles.
@@ -1371,21 +2555,156 @@ type GetVolumesVolumeIDFilesParams struct {
 	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
 
+// GetVolumesVolumeIDFilesArchiveParams defines parameters for GetVolumesVolumeIDFilesArchive.
+type GetVolumesVolumeIDFilesArchiveParams struct {
+	// Path Directory path in volume
+	Path string `form:"path" json:"path"`
+
+	// Format Archive format
+	Format *GetVolumesVolumeIDFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetVolumesVolumeIDFilesArchiveParamsFormat defines parameters for GetVolumesVolumeIDFilesArchive.
+type GetVolumesVolumeIDFilesArchiveParamsFormat string
+
 // GetVolumesVolumeIDFilesDownloadParams defines parameters for GetVolumesVolumeIDFilesDownload.
 type GetVolumesVolumeIDFilesDownloadParams struct {
 	// Path File path in volume
 	Path string `form:"path" json:"path"`
 }
 
+// HeadVolumesVolumeIDFilesDownloadParams defines parameters for HeadVolumesVolumeIDFilesDownload.
+type HeadVolumesVolumeIDFilesDownloadParams struct {
+	// Path File path in volume
+	Path string `form:"path" json:"path"`
+}
+
+// GetVolumesVolumeIDFilesGrepParams defines parameters for GetVolumesVolumeIDFilesGrep.
+type GetVolumesVolumeIDFilesGrepParams struct {
+	// Pattern Regular expression matched against every line
+	Pattern string `form:"pattern" json:"pattern"`
+
+	// Path Directory or file to search
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// Glob Only searches the files whose name matches the pattern, e.g. `*.go`
+	Glob *string `form:"glob,omitempty" json:"glob,omitempty"`
+
+	// IgnoreCase Match the pattern case-insensitively
+	IgnoreCase *bool `form:"ignoreCase,omitempty" json:"ignoreCase,omitempty"`
+
+	// MaxMatches Maximum number of matching lines to return
+	MaxMatches *int32 `form:"maxMatches,omitempty" json:"maxMatches,omitempty"`
+}
+
+// GetVolumesVolumeIDFilesSearchParams defines parameters for GetVolumesVolumeIDFilesSearch.
+type GetVolumesVolumeIDFilesSearchParams struct {
+	// Path Directory to search below
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// Name Matches the entries whose name contains the string
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Glob Matches the entries whose name matches the pattern, e.g. `*.log`
+	Glob *string `form:"glob,omitempty" json:"glob,omitempty"`
+
+	// MinSize Matches the files of at least the size in bytes, excludes directories and symlinks
+	MinSize *int64 `form:"minSize,omitempty" json:"minSize,omitempty"`
+
+	// MaxSize Matches the files of at most the size in bytes, excludes directories and symlinks
+	MaxSize *int64 `form:"maxSize,omitempty" json:"maxSize,omitempty"`
+
+	// ModifiedAfter Matches the entries modified at or after the time
+	ModifiedAfter *time.Time `form:"modifiedAfter,omitempty" json:"modifiedAfter,omitempty"`
+
+	// ModifiedBefore Matches the entries modified before the time
+	ModifiedBefore *time.Time `form:"modifiedBefore,omitempty" json:"modifiedBefore,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetVolumesVolumeIDFilesStatParams defines parameters for GetVolumesVolumeIDFilesStat.
+type GetVolumesVolumeIDFilesStatParams struct {
+	// Path Path in volume
+	Path string `form:"path" json:"path"`
+
+	// Checksum Compute the SHA-256 of a file, reads the whole file
+	Checksum *bool `form:"checksum,omitempty" json:"checksum,omitempty"`
+}
+
 // PutVolumesVolumeIDFilesUploadParams defines parameters for PutVolumesVolumeIDFilesUpload.
 type PutVolumesVolumeIDFilesUploadParams struct {
-	// Path Destination path in volume
+	// Path Destination path in volume, the target directory when extracting
 	Path string `form:"path" json:"path"`
+
+	// Extract Unpack the uploaded archive into the directory at path
+	Extract *bool `form:"extract,omitempty" json:"extract,omitempty"`
+
+	// ContentType Content type of the file, e.g. image/png, served when the file is downloaded
+	ContentType *string `form:"contentType,omitempty" json:"contentType,omitempty"`
+
+	// Mode Permission bits of the file in octal notation, 0644 when not set. Not supported when extracting.
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Uid Owner user ID of the file, root when not set. Not supported when extracting.
+	Uid *int64 `form:"uid,omitempty" json:"uid,omitempty"`
+
+	// Gid Owner group ID of the file, root when not set. Not supported when extracting.
+	Gid *int64 `form:"gid,omitempty" json:"gid,omitempty"`
+
+	// ContentMD5 Base64 encoded MD5 digest of the content (RFC 1864), the upload is rejected if the content doesn't match
+	ContentMD5 *string `json:"Content-MD5,omitempty"`
+
+	// XChecksumSha256 Hex or base64 encoded SHA-256 digest of the content, the upload is rejected if the content doesn't match
+	XChecksumSha256 *string `json:"x-checksum-sha256,omitempty"`
+}
+
+// PostVolumesVolumeIDFsckParams defines parameters for PostVolumesVolumeIDFsck.
+type PostVolumesVolumeIDFsckParams struct {
+	// Repair Repair the inconsistencies found
+	Repair *bool `form:"repair,omitempty" json:"repair,omitempty"`
+}
+
+// GetVolumesVolumeIDMetricsParams defines parameters for GetVolumesVolumeIDMetrics.
+type GetVolumesVolumeIDMetricsParams struct {
+	// Start Unix timestamp for the start of the interval, in seconds, defaults to the first metrics of the volume
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Unix timestamp for the end of the interval, in seconds, defaults to the last metrics of the volume
+	End *int64 `form:"end,omitempty" json:"end,omitempty"`
+}
+
+// GetVolumesIdOrNameOperationsParams defines parameters for GetVolumesIdOrNameOperations.
+type GetVolumesIdOrNameOperationsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetVolumesIdOrNameRunsParams defines parameters for GetVolumesIdOrNameRuns.
+type GetVolumesIdOrNameRunsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Cursor to start the list from
+	NextToken *PaginationNextToken `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
 
 // PostAccessTokensJSONRequestBody defines body for PostAccessTokens for application/json ContentType.
 type PostAccessTokensJSONRequestBody = NewAccessToken
 
+// PostAdminTeamsTeamIDVolumesCleanupJSONRequestBody defines body for PostAdminTeamsTeamIDVolumesCleanup for application/json ContentType.
+type PostAdminTeamsTeamIDVolumesCleanupJSONRequestBody = AdminVolumeCleanup
+
+// PostAdminVolumesVolumeIDMetadataEngineJSONRequestBody defines body for PostAdminVolumesVolumeIDMetadataEngine for application/json ContentType.
+type PostAdminVolumesVolumeIDMetadataEngineJSONRequestBody = AdminVolumeMetadataMigration
+
 // PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
 type PostApiKeysJSONRequestBody = NewTeamAPIKey
 
@@ -1395,6 +2714,9 @@ type PatchApiKeysApiKeyIDJSONRequestBody = UpdateTeamAPIKey
 // PostNodesNodeIDJSONRequestBody defines body for PostNodesNodeID for application/json ContentType.
 type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 
+// PostS3CredentialsJSONRequestBody defines body for PostS3Credentials for application/json ContentType.
+type PostS3CredentialsJSONRequestBody = NewS3Credentials
+
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
@@ -1410,6 +2732,12 @@ type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
 
+// PostSandboxesSandboxIDVolumesJSONRequestBody defines body for PostSandboxesSandboxIDVolumes for application/json ContentType.
+type PostSandboxesSandboxIDVolumesJSONRequestBody = SandboxVolumeAttach
+
+// PostSecretsJSONRequestBody defines body for PostSecrets for application/json ContentType.
+type PostSecretsJSONRequestBody = NewTeamSecret
+
 // PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
 type PostTemplatesJSONRequestBody = TemplateBuildRequest
 
@@ -1431,6 +2759,33 @@ type PostV3TemplatesJSONRequestBody = TemplateBuildRequestV3
 // PostVolumesJSONRequestBody defines body for PostVolumes for application/json ContentType.
 type PostVolumesJSONRequestBody = CreateVolumeRequest
 
+// PatchVolumesIdOrNameJSONRequestBody defines body for PatchVolumesIdOrName for application/json ContentType.
+type PatchVolumesIdOrNameJSONRequestBody = UpdateVolumeRequest
+
+// PatchVolumesVolumeIDFilesAttributesJSONRequestBody defines body for PatchVolumesVolumeIDFilesAttributes for application/json ContentType.
+type PatchVolumesVolumeIDFilesAttributesJSONRequestBody = FileAttributesRequest
+
+// PostVolumesVolumeIDFilesCopyJSONRequestBody defines body for PostVolumesVolumeIDFilesCopy for application/json ContentType.
+type PostVolumesVolumeIDFilesCopyJSONRequestBody = FileCopyRequest
+
+// PostVolumesVolumeIDFilesMkdirJSONRequestBody defines body for PostVolumesVolumeIDFilesMkdir for application/json ContentType.
+type PostVolumesVolumeIDFilesMkdirJSONRequestBody = FileMkdirRequest
+
+// PostVolumesVolumeIDFilesPresignJSONRequestBody defines body for PostVolumesVolumeIDFilesPresign for application/json ContentType.
+type PostVolumesVolumeIDFilesPresignJSONRequestBody = FilePresignRequest
+
+// PostVolumesVolumeIDFilesSymlinkJSONRequestBody defines body for PostVolumesVolumeIDFilesSymlink for application/json ContentType.
+type PostVolumesVolumeIDFilesSymlinkJSONRequestBody = FileSymlinkRequest
+
+// PostVolumesVolumeIDFilesSyncJSONRequestBody defines body for PostVolumesVolumeIDFilesSync for application/json ContentType.
+type PostVolumesVolumeIDFilesSyncJSONRequestBody = FileSyncRequest
+
+// PostVolumesVolumeIDUploadsJSONRequestBody defines body for PostVolumesVolumeIDUploads for application/json ContentType.
+type PostVolumesVolumeIDUploadsJSONRequestBody = CreateUploadRequest
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = NewTeamWebhook
+
 // AsAWSRegistry returns the union data inside the FromImageRegistry as a AWSRegistry
 func (t FromImageRegistry) AsAWSRegistry() (AWSRegistry, error) {
 	var body AWSRegistry
@@ -101,3 +101,11 @@ func WithSupabaseTeam(t *testing.T, teamID ...string) func(ctx context.Context,
 		return nil
 	}
 }
+
+func WithAdminToken() func(ctx context.Context, req *http.Request) error {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Admin-Token", AdminToken)
+
+		return nil
+	}
+}
@@ -25,4 +25,13 @@ var (
 
 	OrchestratorHost = os.Getenv("TESTS_ORCHESTRATOR_HOST")
 	EnvdProxy        = os.Getenv("TESTS_ENVD_PROXY")
+
+	// Namespace prefixes the names of the resources the tests create, so runs against a shared
+	// deployment don't touch resources they didn't create. Unset, each run gets its own namespace.
+	Namespace = namespace()
+
+	// AdminToken enables removing the resources leaked by earlier runs through the admin API.
+	AdminToken = os.Getenv("TESTS_ADMIN_TOKEN")
+	// LeftoverMaxAge is the age after which resources in the namespace of the tests count as leaked.
+	LeftoverMaxAge = leftoverMaxAge()
 )
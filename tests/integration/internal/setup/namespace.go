@@ -0,0 +1,51 @@
+package setup
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GeneratedNamespacePrefix starts the namespaces generated for the runs without TESTS_NAMESPACE.
+const GeneratedNamespacePrefix = "itest-"
+
+const (
+	namespaceSuffixLength = 8
+	defaultLeftoverMaxAge = 6 * time.Hour
+)
+
+func namespace() string {
+	if namespace := os.Getenv("TESTS_NAMESPACE"); namespace != "" {
+		return namespace
+	}
+
+	// The random text is base32, lowercased it's made of valid volume name characters
+	return GeneratedNamespacePrefix + strings.ToLower(rand.Text()[:namespaceSuffixLength])
+}
+
+// LeftoverPrefix is the name prefix of the resources runs like the current one create, the leaked ones
+// of earlier runs included.
+func LeftoverPrefix() string {
+	if os.Getenv("TESTS_NAMESPACE") == "" {
+		return GeneratedNamespacePrefix
+	}
+
+	return Namespace + "-"
+}
+
+func leftoverMaxAge() time.Duration {
+	value := os.Getenv("TESTS_LEFTOVER_MAX_AGE_HOURS")
+	if value == "" {
+		return defaultLeftoverMaxAge
+	}
+
+	hours, err := strconv.Atoi(value)
+	if err != nil || hours < 1 {
+		panic(fmt.Sprintf("TESTS_LEFTOVER_MAX_AGE_HOURS must be a positive number of hours, got %q", value))
+	}
+
+	return time.Duration(hours) * time.Hour
+}
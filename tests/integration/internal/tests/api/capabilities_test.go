@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestCapabilities(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.GetCapabilitiesWithResponse(ctx, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+
+	volumes := resp.JSON200.Volumes
+	assert.Positive(t, volumes.MaxUploadParts)
+	if volumes.MaxUploadBytes != nil {
+		assert.Positive(t, *volumes.MaxUploadBytes)
+	}
+	if volumes.MaxVolumeSizeBytes != nil {
+		assert.Positive(t, *volumes.MaxVolumeSizeBytes)
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		resp, err := c.GetCapabilitiesWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
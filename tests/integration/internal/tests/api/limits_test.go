@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestLimits(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.GetLimitsWithResponse(ctx, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	require.NotEmpty(t, *resp.JSON200)
+
+	for _, limit := range *resp.JSON200 {
+		assert.NotEmpty(t, limit.Name)
+		assert.Positive(t, limit.RequestsPerMinute)
+		assert.LessOrEqual(t, limit.Remaining, limit.BurstSize)
+		assert.GreaterOrEqual(t, limit.ThrottledRecently, int64(0))
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		resp, err := c.GetLimitsWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
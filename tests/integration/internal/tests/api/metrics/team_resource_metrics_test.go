@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/utils"
+)
+
+func TestTeamResourceMetrics(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	// Create sandboxes reporting their resources
+	utils.SetupSandboxWithCleanup(t, c)
+	utils.SetupSandboxWithCleanup(t, c)
+
+	now := time.Now()
+	start := now.Add(-1 * time.Hour).Unix()
+	end := now.Add(time.Minute).Unix()
+	var metrics []api.TeamResourceMetric
+
+	maxDuration := 30 * time.Second
+	tick := 500 * time.Millisecond
+
+	require.Eventually(t, func() bool {
+		resp, err := c.GetTeamsTeamIDMetricsResourcesWithResponse(
+			t.Context(), setup.TeamID,
+			&api.GetTeamsTeamIDMetricsResourcesParams{Start: &start, End: &end},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		if len(*resp.JSON200) == 0 {
+			return false
+		}
+
+		metrics = *resp.JSON200
+
+		return true
+	}, maxDuration, tick, "team resource metrics not available in time")
+
+	for _, metric := range metrics {
+		require.GreaterOrEqual(t, metric.TimestampUnix, start, "Metric timestamp should be >= start time")
+		require.LessOrEqual(t, metric.TimestampUnix, end, "Metric timestamp should be <= end time")
+		require.Positive(t, metric.SandboxCount)
+		require.Positive(t, metric.CpuCount)
+		require.Positive(t, metric.MemTotal)
+		require.LessOrEqual(t, metric.MemUsed, metric.MemTotal)
+	}
+}
+
+func TestTeamResourceMetricsOtherTeam(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	db := setup.GetTestDBClient(t)
+	teamID := utils.CreateTeamWithUser(t, db, "test-team-resource-metrics", setup.UserID)
+
+	response, err := c.GetTeamsTeamIDMetricsResourcesWithResponse(t.Context(), teamID.String(), nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, response.StatusCode())
+}
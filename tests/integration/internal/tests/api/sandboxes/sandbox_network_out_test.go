@@ -88,6 +88,20 @@ func assertBlockedDNSQuery(t *testing.T, ctx context.Context, sbx *api.Sandbox,
 	require.Error(t, err, msg)
 }
 
+// assertResolvedDomain asserts that the resolver configured in the sandbox resolves the domain
+func assertResolvedDomain(t *testing.T, ctx context.Context, sbx *api.Sandbox, envdClient *setup.EnvdClient, domain string, msg string) {
+	t.Helper()
+	err := utils.ExecCommand(t, ctx, sbx, envdClient, "getent", "hosts", domain)
+	require.NoError(t, err, msg)
+}
+
+// assertUnresolvedDomain asserts that the resolver configured in the sandbox doesn't resolve the domain
+func assertUnresolvedDomain(t *testing.T, ctx context.Context, sbx *api.Sandbox, envdClient *setup.EnvdClient, domain string, msg string) {
+	t.Helper()
+	err := utils.ExecCommand(t, ctx, sbx, envdClient, "getent", "hosts", domain)
+	require.Error(t, err, msg)
+}
+
 // =============================================================================
 // IP and CIDR-based filtering tests
 // =============================================================================
@@ -793,3 +807,58 @@ func TestEgressFirewallUDPAllowedCIDR(t *testing.T) {
 	assertSuccessfulDNSQuery(t, ctx, sbx, envdClient, "8.8.8.8", "google.com", "Expected DNS query (UDP) to IP within allowed CIDR (8.8.8.0/24) to succeed")
 	assertBlockedDNSQuery(t, ctx, sbx, envdClient, "1.1.1.1", "google.com", "Expected DNS query (UDP) to IP outside allowed CIDR to fail")
 }
+
+// =============================================================================
+// DNS firewall tests
+// =============================================================================
+
+// TestEgressFirewallDNSOnlyResolvesAllowedDomains tests that with all internet traffic blocked,
+// only allowed domains are resolved, whichever resolver the sandbox queries
+func TestEgressFirewallDNSOnlyResolvesAllowedDomains(t *testing.T) {
+	templateID := ensureNetworkTestTemplate(t)
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	allowList := []string{"google.com"}
+	blockAll := []string{sandbox_network.AllInternetTrafficCIDR}
+
+	sbx := utils.SetupSandboxWithCleanup(t, client,
+		utils.WithTemplateID(templateID),
+		utils.WithTimeout(60),
+		utils.WithNetwork(&api.SandboxNetworkConfig{
+			AllowOut: &allowList,
+			DenyOut:  &blockAll,
+		}),
+	)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	assertResolvedDomain(t, ctx, sbx, envdClient, "google.com", "Expected allowed domain (google.com) to resolve")
+	assertUnresolvedDomain(t, ctx, sbx, envdClient, "cloudflare.com", "Expected non-allowed domain (cloudflare.com) not to resolve")
+	// Queries to other resolvers are answered by the DNS firewall too
+	assertSuccessfulDNSQuery(t, ctx, sbx, envdClient, "1.1.1.1", "google.com", "Expected DNS query for allowed domain to another resolver (1.1.1.1) to succeed")
+}
+
+// TestEgressFirewallDNSDeniedDomain tests that denied domains are not resolved while other domains are
+func TestEgressFirewallDNSDeniedDomain(t *testing.T) {
+	templateID := ensureNetworkTestTemplate(t)
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	denyList := []string{"cloudflare.com", "*.cloudflare.com"}
+
+	sbx := utils.SetupSandboxWithCleanup(t, client,
+		utils.WithTemplateID(templateID),
+		utils.WithTimeout(60),
+		utils.WithNetwork(&api.SandboxNetworkConfig{
+			DenyOut: &denyList,
+		}),
+	)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	assertResolvedDomain(t, ctx, sbx, envdClient, "google.com", "Expected domain (google.com) to resolve")
+	assertUnresolvedDomain(t, ctx, sbx, envdClient, "www.cloudflare.com", "Expected denied domain (www.cloudflare.com) not to resolve")
+	assertSuccessfulHTTPRequest(t, ctx, sbx, envdClient, "https://google.com", "Expected curl to domain (google.com) to succeed")
+	assertBlockedHTTPRequest(t, ctx, sbx, envdClient, "https://cloudflare.com", "Expected curl to denied domain (cloudflare.com) to fail")
+}
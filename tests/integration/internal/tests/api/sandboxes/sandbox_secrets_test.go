@@ -0,0 +1,83 @@
+package sandboxes
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/packages/shared/pkg/id"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/utils"
+)
+
+// createTestSecret stores a team secret and deletes it when the test ends
+func createTestSecret(t *testing.T, c *api.ClientWithResponses, name, value string) {
+	t.Helper()
+
+	resp, err := c.PostSecretsWithResponse(t.Context(), api.NewTeamSecret{Name: name, Value: value}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+	assert.Equal(t, name, resp.JSON201.Name)
+
+	t.Cleanup(func() {
+		_, _ = c.DeleteSecretsSecretNameWithResponse(context.WithoutCancel(t.Context()), name, setup.WithAPIKey())
+	})
+}
+
+func TestSandboxSecrets(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	name := "TEST_SECRET_" + strings.ToUpper(id.Generate())
+	value := "secret-value"
+	createTestSecret(t, c, name, value)
+
+	listResp, err := c.GetSecretsWithResponse(t.Context(), setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	assert.NotContains(t, string(listResp.Body), value, "secret values must not be listed")
+
+	sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithSecrets(name))
+	envdClient := setup.GetEnvdClient(t, t.Context())
+
+	output, err := utils.ExecCommandWithOutput(t, t.Context(), sbx, envdClient, nil, "user", "printenv", name)
+	require.NoError(t, err)
+	assert.Equal(t, value, strings.TrimSpace(output))
+
+	envsResp, err := envdClient.HTTPClient.GetEnvsWithResponse(t.Context(), setup.WithSandbox(sbx.SandboxID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, envsResp.StatusCode())
+	require.NotNil(t, envsResp.JSON200)
+	assert.NotContains(t, *envsResp.JSON200, name, "secrets must not be returned with the env vars")
+}
+
+func TestSandboxSecretNotFound(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	secrets := []string{"MISSING_TEST_SECRET"}
+	resp, err := c.PostSandboxesWithResponse(t.Context(), api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Secrets:    &secrets,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+
+	if resp.JSON201 != nil {
+		utils.TeardownSandbox(t, c, resp.JSON201.SandboxID)
+	}
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
+
+func TestPostSecretInvalidName(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	for _, name := range []string{"INVALID-NAME", "1_SECRET", "MORU_SECRET"} {
+		resp, err := c.PostSecretsWithResponse(t.Context(), api.NewTeamSecret{Name: name, Value: "value"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), name)
+	}
+}
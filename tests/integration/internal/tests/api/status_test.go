@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.GetStatusWithResponse(ctx, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	require.NotEmpty(t, resp.JSON200.Components)
+
+	names := make([]api.PlatformComponent, 0, len(resp.JSON200.Components))
+	for _, component := range resp.JSON200.Components {
+		names = append(names, component.Name)
+		assert.NotEmpty(t, component.Status)
+		assert.GreaterOrEqual(t, component.Requests, int64(0))
+		assert.GreaterOrEqual(t, component.ErrorRate, float64(0))
+		assert.LessOrEqual(t, component.ErrorRate, float64(1))
+	}
+	assert.Contains(t, names, api.PlatformComponentAPI)
+	assert.Contains(t, names, api.PlatformComponentSandboxScheduling)
+	assert.NotZero(t, resp.JSON200.UpdatedAt)
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		resp, err := c.GetStatusWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
@@ -0,0 +1,124 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+// volumeCleanupTimeout bounds deleting a volume after a test, the test context is already canceled then.
+const volumeCleanupTimeout = 30 * time.Second
+
+// trackedVolumes holds the IDs of the volumes created by the tests and not deleted yet.
+var trackedVolumes sync.Map
+
+// trackVolume force deletes the volume when the test ends. Volumes the deletion fails for are
+// retried once all the tests have run.
+func trackVolume(t *testing.T, c *api.ClientWithResponses, volumeID string) {
+	t.Helper()
+
+	trackedVolumes.Store(volumeID, struct{}{})
+
+	t.Cleanup(func() {
+		if err := deleteTrackedVolume(context.Background(), c, volumeID); err != nil {
+			t.Logf("Failed to delete volume %s: %v", volumeID, err)
+		}
+	})
+}
+
+func deleteTrackedVolume(ctx context.Context, c *api.ClientWithResponses, volumeID string) error {
+	ctx, cancel := context.WithTimeout(ctx, volumeCleanupTimeout)
+	defer cancel()
+
+	resp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volumeID, forceDelete, setup.WithAPIKey())
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusNoContent, http.StatusNotFound:
+		trackedVolumes.Delete(volumeID)
+
+		return nil
+	default:
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+}
+
+// waitForVolumeAvailable waits for the volume to be prepared, new volumes are created in the background.
+func waitForVolumeAvailable(t *testing.T, ctx context.Context, c *api.ClientWithResponses, volumeID string) *api.Volume {
+	t.Helper()
+
+	var volume *api.Volume
+	require.Eventually(t, func() bool {
+		resp, err := c.GetVolumesIdOrNameWithResponse(ctx, volumeID, setup.WithAPIKey())
+		if err != nil || resp.JSON200 == nil || resp.JSON200.Status == nil {
+			return false
+		}
+
+		volume = resp.JSON200
+
+		return *volume.Status == api.Available || *volume.Status == api.Failed
+	}, time.Minute, 500*time.Millisecond, "volume %s isn't prepared", volumeID)
+	require.Equal(t, api.Available, *volume.Status, "volume %s creation failed", volumeID)
+
+	return volume
+}
+
+// cleanupTrackedVolumes deletes the volumes the tests failed to delete.
+func cleanupTrackedVolumes(c *api.ClientWithResponses) {
+	trackedVolumes.Range(func(key, _ any) bool {
+		volumeID := key.(string)
+		if err := deleteTrackedVolume(context.Background(), c, volumeID); err != nil {
+			fmt.Fprintf(os.Stderr, "Leaked volume %s: %v\n", volumeID, err)
+		}
+
+		return true
+	})
+}
+
+// cleanupLeftoverVolumes deletes the volumes leaked by earlier runs, e.g. killed ones, through the admin API.
+// It's skipped without an admin token and the team ID.
+func cleanupLeftoverVolumes(c *api.ClientWithResponses) {
+	if setup.AdminToken == "" || setup.TeamID == "" {
+		return
+	}
+
+	teamID, err := uuid.Parse(setup.TeamID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Skipping the cleanup of leftover volumes, invalid team ID %q: %v\n", setup.TeamID, err)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	resp, err := c.PostAdminTeamsTeamIDVolumesCleanupWithResponse(ctx, teamID, api.AdminVolumeCleanup{
+		NamePrefix:     setup.LeftoverPrefix(),
+		OlderThanHours: int32(setup.LeftoverMaxAge / time.Hour),
+	}, setup.WithAdminToken())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to clean up leftover volumes: %v\n", err)
+
+		return
+	}
+	if resp.JSON200 == nil {
+		fmt.Fprintf(os.Stderr, "Failed to clean up leftover volumes, status %d: %s\n", resp.StatusCode(), string(resp.Body))
+
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Cleaned up leftover volumes: %d deleted, %d attached skipped, %d failed\n",
+		resp.JSON200.DeletedCount, resp.JSON200.SkippedCount, resp.JSON200.FailedCount)
+}
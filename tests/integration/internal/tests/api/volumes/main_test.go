@@ -0,0 +1,36 @@
+package volumes
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+// namespacePattern keeps the namespaced names valid volume names, the longest test name included.
+var namespacePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,19}$`)
+
+func TestMain(m *testing.M) {
+	if !namespacePattern.MatchString(setup.Namespace) {
+		fmt.Fprintf(os.Stderr, "TESTS_NAMESPACE %q must start with a lowercase letter and contain at most 20 lowercase letters, digits and hyphens\n", setup.Namespace)
+		os.Exit(2)
+	}
+
+	c := setup.GetAPIClient()
+	cleanupLeftoverVolumes(c)
+
+	code := m.Run()
+
+	cleanupTrackedVolumes(c)
+
+	os.Exit(code)
+}
+
+// testVolumeName prefixes the name with the namespace of the run. The tests delete any volume
+// with the names they use first, so the namespace keeps them away from the volumes of a deployment
+// and of the runs in parallel.
+func testVolumeName(name string) string {
+	return setup.Namespace + "-" + name
+}
@@ -3,7 +3,10 @@ package volumes
 import (
 	"context"
 	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,14 +23,10 @@ func TestSandboxWithVolume(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume using helper that handles idempotent creates
-	volumeName := "test-sandbox-volume"
+	volumeName := testVolumeName("test-sandbox-volume")
 	volume := createTestVolume(t, ctx, c, volumeName)
 	volumeID := volume.VolumeID
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeID, setup.WithAPIKey())
-	})
-
 	// Create sandbox with volume attached
 	sbxTimeout := int32(60)
 	mountPath := "/workspace/data"
@@ -60,14 +59,10 @@ func TestSandboxVolumeInvalidMountPath(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume using helper that handles idempotent creates
-	volumeName := "test-sandbox-invalid-mount"
+	volumeName := testVolumeName("test-sandbox-invalid-mount")
 	volume := createTestVolume(t, ctx, c, volumeName)
 	volumeID := volume.VolumeID
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeID, setup.WithAPIKey())
-	})
-
 	// Try to create sandbox with invalid mount path
 	sbxTimeout := int32(60)
 	invalidMountPath := "/etc/passwd" // Not an allowed prefix
@@ -90,14 +85,10 @@ func TestSandboxVolumeMissingMountPath(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume using helper that handles idempotent creates
-	volumeName := "test-sandbox-missing-mount"
+	volumeName := testVolumeName("test-sandbox-missing-mount")
 	volume := createTestVolume(t, ctx, c, volumeName)
 	volumeID := volume.VolumeID
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeID, setup.WithAPIKey())
-	})
-
 	// Try to create sandbox with volume but no mount path
 	sbxTimeout := int32(60)
 	sbxResp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
@@ -133,3 +124,408 @@ func TestSandboxVolumeNotFound(t *testing.T) {
 	// Should be rejected with not found
 	assert.Equal(t, http.StatusNotFound, sbxResp.StatusCode())
 }
+
+func TestSandboxVolumeHotAttach(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-sandbox-hot-attach")
+	volume := createTestVolume(t, ctx, c, volumeName)
+	volumeID := volume.VolumeID
+
+	// Start the sandbox without a volume
+	sbxTimeout := int32(60)
+	sbxResp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, sbxResp.StatusCode())
+	require.NotNil(t, sbxResp.JSON201)
+
+	sandboxID := sbxResp.JSON201.SandboxID
+	t.Cleanup(func() {
+		utils.TeardownSandbox(t, c, sandboxID)
+	})
+
+	t.Run("invalid mount path", func(t *testing.T) {
+		resp, err := c.PostSandboxesSandboxIDVolumesWithResponse(ctx, sandboxID, api.SandboxVolumeAttach{
+			VolumeId:  volumeID,
+			MountPath: "/etc",
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	attachResp, err := c.PostSandboxesSandboxIDVolumesWithResponse(ctx, sandboxID, api.SandboxVolumeAttach{
+		VolumeId:  volumeID,
+		MountPath: "/workspace/data",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	if attachResp.StatusCode() == http.StatusBadRequest {
+		t.Skipf("Template can't attach volumes to running sandboxes: %s", string(attachResp.Body))
+	}
+	require.Equal(t, http.StatusNoContent, attachResp.StatusCode(), string(attachResp.Body))
+
+	// A sandbox has at most one volume
+	againResp, err := c.PostSandboxesSandboxIDVolumesWithResponse(ctx, sandboxID, api.SandboxVolumeAttach{
+		VolumeId:  volumeID,
+		MountPath: "/workspace/other",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, againResp.StatusCode())
+}
+
+func TestSandboxVolumeHotDetach(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	first := createTestVolume(t, ctx, c, testVolumeName("test-sandbox-detach-a"))
+	second := createTestVolume(t, ctx, c, testVolumeName("test-sandbox-detach-b"))
+
+	sbxTimeout := int32(60)
+	sbxResp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, sbxResp.StatusCode())
+	require.NotNil(t, sbxResp.JSON201)
+
+	sandboxID := sbxResp.JSON201.SandboxID
+	t.Cleanup(func() {
+		utils.TeardownSandbox(t, c, sandboxID)
+	})
+
+	// Nothing is attached yet
+	detachResp, err := c.DeleteSandboxesSandboxIDVolumesVolumeIDWithResponse(ctx, sandboxID, first.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	if detachResp.StatusCode() == http.StatusBadRequest {
+		t.Skipf("Template can't detach volumes from running sandboxes: %s", string(detachResp.Body))
+	}
+	assert.Equal(t, http.StatusConflict, detachResp.StatusCode())
+
+	attachResp, err := c.PostSandboxesSandboxIDVolumesWithResponse(ctx, sandboxID, api.SandboxVolumeAttach{
+		VolumeId:  first.VolumeID,
+		MountPath: "/workspace/data",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, attachResp.StatusCode(), string(attachResp.Body))
+	requireAttachedSandboxes(t, ctx, c, first.VolumeID, sandboxID)
+
+	detachResp, err = c.DeleteSandboxesSandboxIDVolumesVolumeIDWithResponse(ctx, sandboxID, first.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, detachResp.StatusCode(), string(detachResp.Body))
+	requireAttachedSandboxes(t, ctx, c, first.VolumeID)
+
+	// The mount is kept in the runs of the volume once detached
+	require.Eventually(t, func() bool {
+		resp, err := c.GetVolumesIdOrNameRunsWithResponse(ctx, first.VolumeID, nil, setup.WithAPIKey())
+		if err != nil || resp.JSON200 == nil || len(*resp.JSON200) != 1 {
+			return false
+		}
+
+		run := (*resp.JSON200)[0]
+
+		return run.SandboxID == sandboxID && run.MountPath == "/workspace/data" && run.DetachedAt != nil
+	}, 30*time.Second, 500*time.Millisecond, "volume %s has no detached run", first.VolumeID)
+
+	// The sandbox can swap to another volume
+	attachResp, err = c.PostSandboxesSandboxIDVolumesWithResponse(ctx, sandboxID, api.SandboxVolumeAttach{
+		VolumeId:  second.VolumeID,
+		MountPath: "/workspace/data",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, attachResp.StatusCode(), string(attachResp.Body))
+}
+
+func TestSandboxVolumeExclusiveWriter(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-sandbox-exclusive-writer"))
+	volumeID := volume.VolumeID
+
+	createSandbox := func(readOnly bool) *api.PostSandboxesResponse {
+		sbxTimeout := int32(60)
+		mountPath := "/workspace/data"
+		sbxResp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID:      setup.SandboxTemplateID,
+			Timeout:         &sbxTimeout,
+			VolumeId:        &volumeID,
+			VolumeMountPath: &mountPath,
+			VolumeReadOnly:  &readOnly,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+
+		if sbxResp.JSON201 != nil {
+			t.Cleanup(func() {
+				utils.TeardownSandbox(t, c, sbxResp.JSON201.SandboxID)
+			})
+		}
+
+		return sbxResp
+	}
+
+	writer := createSandbox(false)
+	require.Equal(t, http.StatusCreated, writer.StatusCode(), string(writer.Body))
+
+	// A second writer is rejected
+	secondWriter := createSandbox(false)
+	assert.Equal(t, http.StatusConflict, secondWriter.StatusCode(), string(secondWriter.Body))
+
+	// Readers can be added next to the writer
+	reader := createSandbox(true)
+	assert.Equal(t, http.StatusCreated, reader.StatusCode(), string(reader.Body))
+
+	// The volume can be attached read-write again once the writer is gone
+	utils.TeardownSandbox(t, c, writer.JSON201.SandboxID)
+
+	secondWriter = createSandbox(false)
+	assert.Equal(t, http.StatusCreated, secondWriter.StatusCode(), string(secondWriter.Body))
+}
+
+func TestSandboxVolumeSubpath(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-sandbox-volume-subpath"))
+	volumeID := volume.VolumeID
+
+	createSandbox := func(sandbox api.NewSandbox) *api.PostSandboxesResponse {
+		sbxTimeout := int32(60)
+		sandbox.TemplateID = setup.SandboxTemplateID
+		sandbox.Timeout = &sbxTimeout
+		sbxResp, err := c.PostSandboxesWithResponse(ctx, sandbox, setup.WithAPIKey())
+		require.NoError(t, err)
+
+		if sbxResp.JSON201 != nil {
+			t.Cleanup(func() {
+				utils.TeardownSandbox(t, c, sbxResp.JSON201.SandboxID)
+			})
+		}
+
+		return sbxResp
+	}
+
+	mountPath := "/workspace/data"
+
+	t.Run("invalid subpath", func(t *testing.T) {
+		for _, subpath := range []string{"projects/foo", "/projects/../foo", "/", "/.moru-overlays/home"} {
+			resp := createSandbox(api.NewSandbox{VolumeId: &volumeID, VolumeMountPath: &mountPath, VolumeSubpath: ptr(subpath)})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), "subpath %q", subpath)
+		}
+	})
+
+	t.Run("subpath without volume", func(t *testing.T) {
+		resp := createSandbox(api.NewSandbox{VolumeSubpath: ptr("/projects/foo")})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	// The sandbox only sees the files of the subpath, written before the volume is attached
+	mkdirResp, err := c.PostVolumesVolumeIDFilesMkdirWithResponse(ctx, volumeID,
+		api.FileMkdirRequest{Path: "/projects/foo", Recursive: ptr(true)}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, mkdirResp.StatusCode(), string(mkdirResp.Body))
+
+	for path, content := range map[string]string{"/projects/foo/hello.txt": "hello", "/secret.txt": "secret"} {
+		_, _ = c.DeleteVolumesVolumeIDFilesWithResponse(ctx, volumeID,
+			&api.DeleteVolumesVolumeIDFilesParams{Path: path}, setup.WithAPIKey())
+
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(ctx, volumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: path}, "application/octet-stream",
+			strings.NewReader(content), setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, uploadResp.StatusCode(), string(uploadResp.Body))
+	}
+
+	sbxResp := createSandbox(api.NewSandbox{VolumeId: &volumeID, VolumeMountPath: &mountPath, VolumeSubpath: ptr("/projects/foo")})
+	// Only the templates built before subpaths were supported refuse them, other errors fail the test
+	if sbxResp.StatusCode() == http.StatusBadRequest && strings.Contains(string(sbxResp.Body), "doesn't support mounting a subpath") {
+		t.Skipf("Template can't mount a subpath of a volume: %s", string(sbxResp.Body))
+	}
+	require.Equal(t, http.StatusCreated, sbxResp.StatusCode(), string(sbxResp.Body))
+	sbx := sbxResp.JSON201
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+	output, err := utils.ExecCommandWithOutput(t, ctx, sbx, envdClient, nil, "user", "cat", mountPath+"/hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", output)
+
+	// The rest of the volume is mounted in the state directory of envd, out of reach of the user
+	err = utils.ExecCommand(t, ctx, sbx, envdClient, "cat", "/tmp/volumes/"+volumeID+"/root/secret.txt")
+	require.Error(t, err)
+
+	require.Eventually(t, func() bool {
+		resp, err := c.GetVolumesIdOrNameAttachmentsWithResponse(ctx, volumeID, setup.WithAPIKey())
+		if err != nil || resp.JSON200 == nil || len(*resp.JSON200) != 1 {
+			return false
+		}
+
+		attachment := (*resp.JSON200)[0]
+
+		return attachment.SandboxID == sbx.SandboxID && attachment.Subpath != nil && *attachment.Subpath == "/projects/foo"
+	}, 30*time.Second, 500*time.Millisecond, "volume %s isn't attached with its subpath", volumeID)
+}
+
+func TestSandboxEphemeralVolume(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	sbxTimeout := int32(60)
+	mountPath := "/mnt/scratch"
+
+	t.Run("combined with a volume", func(t *testing.T) {
+		volumeID := "vol_nonexistent"
+		resp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID:            setup.SandboxTemplateID,
+			Timeout:               &sbxTimeout,
+			VolumeId:              &volumeID,
+			VolumeMountPath:       &mountPath,
+			CreateEphemeralVolume: &api.EphemeralVolume{MountPath: "/mnt/other"},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("invalid mount path", func(t *testing.T) {
+		resp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID:            setup.SandboxTemplateID,
+			Timeout:               &sbxTimeout,
+			CreateEphemeralVolume: &api.EphemeralVolume{MountPath: "/etc"},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	sbxResp, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID:            setup.SandboxTemplateID,
+		Timeout:               &sbxTimeout,
+		CreateEphemeralVolume: &api.EphemeralVolume{MountPath: mountPath},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, sbxResp.StatusCode(), string(sbxResp.Body))
+	sbx := sbxResp.JSON201
+
+	killed := false
+	t.Cleanup(func() {
+		if !killed {
+			utils.TeardownSandbox(t, c, sbx.SandboxID)
+		}
+	})
+
+	volumeName := "ephemeral-" + sbx.SandboxID
+	volumeResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volumeName, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, volumeResp.StatusCode(), string(volumeResp.Body))
+	require.NotNil(t, volumeResp.JSON200.EphemeralSandboxID)
+	assert.Equal(t, sbx.SandboxID, *volumeResp.JSON200.EphemeralSandboxID)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+	err = utils.ExecCommand(t, ctx, sbx, envdClient, "sh", "-c", "echo scratch > "+mountPath+"/scratch.txt")
+	require.NoError(t, err)
+
+	killResp, err := c.DeleteSandboxesSandboxIDWithResponse(ctx, sbx.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, killResp.StatusCode())
+	killed = true
+
+	// The volume is deleted once the sandbox had the time to unmount it
+	require.Eventually(t, func() bool {
+		resp, err := c.GetVolumesIdOrNameWithResponse(ctx, volumeName, setup.WithAPIKey())
+
+		return err == nil && resp.StatusCode() == http.StatusNotFound
+	}, 5*time.Minute, 5*time.Second, "ephemeral volume %s isn't deleted", volumeName)
+}
+
+// requireAttachedSandboxes waits for the attachments of the volume to list exactly the sandboxes,
+// attachments are recorded asynchronously from the orchestrator events.
+func requireAttachedSandboxes(t *testing.T, ctx context.Context, c *api.ClientWithResponses, volumeID string, sandboxIDs ...string) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		resp, err := c.GetVolumesIdOrNameAttachmentsWithResponse(ctx, volumeID, setup.WithAPIKey())
+		if err != nil || resp.JSON200 == nil {
+			return false
+		}
+
+		attached := make([]string, 0, len(*resp.JSON200))
+		for _, attachment := range *resp.JSON200 {
+			attached = append(attached, attachment.SandboxID)
+		}
+
+		return slices.Equal(sandboxIDs, attached)
+	}, 30*time.Second, 500*time.Millisecond, "volume %s isn't attached to exactly %v", volumeID, sandboxIDs)
+}
+
+func TestTemplateDefaultVolumeSharedBySandboxes(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-template-default-volume")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	template := utils.BuildSimpleTemplate(t, "test-template-default-volume", setup.WithAPIKey())
+
+	setDefaultVolume := func(t *testing.T, readOnly bool) {
+		t.Helper()
+
+		resp, err := c.PatchTemplatesTemplateIDWithResponse(ctx, template.TemplateID, api.TemplateUpdateRequest{
+			DefaultVolume: &api.TemplateDefaultVolume{VolumeName: volumeName, MountPath: ptr("/workspace/data"), ReadOnly: &readOnly},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	}
+
+	attachments := func(t *testing.T) map[string]bool {
+		t.Helper()
+
+		resp, err := c.GetVolumesIdOrNameAttachmentsWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+		readOnly := make(map[string]bool)
+		for _, attachment := range *resp.JSON200 {
+			readOnly[attachment.SandboxID] = attachment.ReadOnly
+		}
+
+		return readOnly
+	}
+
+	// A read-write default volume is attached to the first sandbox, the second isn't created without it
+	setDefaultVolume(t, false)
+	first := utils.SetupSandboxWithCleanup(t, c, utils.WithTemplateID(template.TemplateID))
+
+	sbxTimeout := int32(60)
+	second, err := c.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: template.TemplateID,
+		Timeout:    &sbxTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	if second.JSON201 != nil {
+		t.Cleanup(func() {
+			utils.TeardownSandbox(t, c, second.JSON201.SandboxID)
+		})
+	}
+	assert.Equal(t, http.StatusConflict, second.StatusCode(), string(second.Body))
+	assert.Equal(t, map[string]bool{first.SandboxID: false}, attachments(t))
+
+	// A read-only default volume is shared by any number of sandboxes
+	setDefaultVolume(t, true)
+	third := utils.SetupSandboxWithCleanup(t, c, utils.WithTemplateID(template.TemplateID))
+	fourth := utils.SetupSandboxWithCleanup(t, c, utils.WithTemplateID(template.TemplateID))
+
+	assert.Equal(t, map[string]bool{first.SandboxID: false, third.SandboxID: true, fourth.SandboxID: true}, attachments(t))
+}
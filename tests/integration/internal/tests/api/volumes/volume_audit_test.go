@@ -0,0 +1,53 @@
+package volumes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestVolumeAuditLog(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-audit-log"))
+
+	action := api.AuditActionVolumeCreate
+	params := &api.GetAuditLogsParams{Action: &action, VolumeID: &volume.VolumeID}
+
+	// The entries are recorded in the background
+	var entries []api.AuditLogEntry
+	require.Eventually(t, func() bool {
+		resp, err := c.GetAuditLogsWithResponse(ctx, params, setup.WithAPIKey())
+		if err != nil || resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+			return false
+		}
+		entries = *resp.JSON200
+
+		return len(entries) > 0
+	}, 10*time.Second, 200*time.Millisecond)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, api.AuditActionVolumeCreate, entries[0].Action)
+	assert.Equal(t, api.AuditActorAPIKey, entries[0].ActorType)
+	assert.NotNil(t, entries[0].ActorId)
+	assert.NotNil(t, entries[0].SourceIp)
+	require.NotNil(t, entries[0].VolumeId)
+	assert.Equal(t, volume.VolumeID, *entries[0].VolumeId)
+
+	// The filters are combined, the volume wasn't deleted yet
+	deleteAction := api.AuditActionVolumeDelete
+	resp, err := c.GetAuditLogsWithResponse(ctx, &api.GetAuditLogsParams{Action: &deleteAction, VolumeID: &volume.VolumeID}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Empty(t, *resp.JSON200)
+}
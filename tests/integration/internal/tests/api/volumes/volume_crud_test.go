@@ -12,15 +12,18 @@ import (
 	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
 )
 
+// forceDelete deletes volumes without the grace period, so the tests can reuse their names.
+var forceDelete = &api.DeleteVolumesIdOrNameParams{Force: func() *bool { force := true; return &force }()}
+
 // createTestVolume creates a volume for testing, handling idempotent creates.
 // Returns the created/existing volume.
 func createTestVolume(t *testing.T, ctx context.Context, c *api.ClientWithResponses, name string) *api.Volume {
 	t.Helper()
 
 	// First try to delete any existing volume with this name
-	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, name, setup.WithAPIKey())
+	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, name, forceDelete, setup.WithAPIKey())
 
-	resp, err := c.PostVolumesWithResponse(ctx, api.CreateVolumeRequest{
+	resp, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
 		Name: name,
 	}, setup.WithAPIKey())
 	require.NoError(t, err)
@@ -38,7 +41,9 @@ func createTestVolume(t *testing.T, ctx context.Context, c *api.ClientWithRespon
 	}
 	require.NotNil(t, volume, "Expected volume in response, got status %d", resp.StatusCode())
 
-	return volume
+	trackVolume(t, c, volume.VolumeID)
+
+	return waitForVolumeAvailable(t, ctx, c, volume.VolumeID)
 }
 
 func TestVolumeCreate(t *testing.T) {
@@ -47,32 +52,49 @@ func TestVolumeCreate(t *testing.T) {
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-create"
+	volumeName := testVolumeName("test-volume-create")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	assert.Equal(t, volumeName, volume.Name)
 	assert.Contains(t, volume.VolumeID, "vol_")
 }
 
+func TestVolumeCreateAsync(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-create-async")
+	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
+
+	resp, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
+		Name: volumeName,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON201.Status)
+
+	trackVolume(t, c, resp.JSON201.VolumeID)
+
+	// The volume can be prepared before the response is sent
+	assert.Contains(t, []api.VolumeStatus{api.Creating, api.Available}, *resp.JSON201.Status)
+
+	volume := waitForVolumeAvailable(t, ctx, c, resp.JSON201.VolumeID)
+	assert.Equal(t, volumeName, volume.Name)
+}
+
 func TestVolumeCreateIdempotent(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-idempotent"
+	volumeName := testVolumeName("test-volume-idempotent")
 	volume1 := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume1.VolumeID, setup.WithAPIKey())
-	})
-
 	// Second create with same name should return existing (200 OK)
-	resp2, err := c.PostVolumesWithResponse(ctx, api.CreateVolumeRequest{
+	resp2, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
 		Name: volumeName,
 	}, setup.WithAPIKey())
 	require.NoError(t, err)
@@ -89,13 +111,9 @@ func TestVolumeGetByID(t *testing.T) {
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-get-by-id"
+	volumeName := testVolumeName("test-volume-get-by-id")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Get by ID
 	getResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
 	require.NoError(t, err)
@@ -112,13 +130,9 @@ func TestVolumeGetByName(t *testing.T) {
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-get-by-name"
+	volumeName := testVolumeName("test-volume-get-by-name")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Get by name
 	getResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volumeName, setup.WithAPIKey())
 	require.NoError(t, err)
@@ -134,13 +148,9 @@ func TestVolumeList(t *testing.T) {
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-list"
+	volumeName := testVolumeName("test-volume-list")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// List volumes
 	listResp, err := c.GetVolumesWithResponse(ctx, &api.GetVolumesParams{}, setup.WithAPIKey())
 	require.NoError(t, err)
@@ -159,17 +169,102 @@ func TestVolumeList(t *testing.T) {
 	assert.True(t, found, "Created volume should be in list")
 }
 
+func TestVolumeListPagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	var created []string
+	for _, name := range []string{testVolumeName("test-volume-page-a"), testVolumeName("test-volume-page-b"), testVolumeName("test-volume-page-c")} {
+		volume := createTestVolume(t, ctx, c, name)
+		created = append(created, volume.VolumeID)
+	}
+
+	listAll := func(order api.GetVolumesParamsOrder) []string {
+		limit := int32(2)
+		prefix := testVolumeName("test-volume-page-")
+		params := &api.GetVolumesParams{Limit: &limit, NamePrefix: &prefix, Order: &order}
+
+		var ids []string
+		for {
+			resp, err := c.GetVolumesWithResponse(ctx, params, setup.WithAPIKey())
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode())
+			require.NotNil(t, resp.JSON200)
+			assert.LessOrEqual(t, len(*resp.JSON200), 2)
+
+			for _, v := range *resp.JSON200 {
+				ids = append(ids, v.VolumeID)
+			}
+
+			next := resp.HTTPResponse.Header.Get("X-Next-Token")
+			if next == "" {
+				return ids
+			}
+			params.NextToken = &next
+		}
+	}
+
+	t.Run("ascending", func(t *testing.T) {
+		assert.Equal(t, created, listAll(api.Asc))
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		assert.Equal(t, []string{created[2], created[1], created[0]}, listAll(api.Desc))
+	})
+
+	t.Run("status filter", func(t *testing.T) {
+		prefix := testVolumeName("test-volume-page-")
+		status := []api.VolumeStatus{api.Deleting}
+		resp, err := c.GetVolumesWithResponse(ctx, &api.GetVolumesParams{NamePrefix: &prefix, Status: &status}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Empty(t, *resp.JSON200)
+	})
+
+	t.Run("invalid next token", func(t *testing.T) {
+		token := "invalid-token"
+		resp, err := c.GetVolumesWithResponse(ctx, &api.GetVolumesParams{NextToken: &token}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+}
+
+func TestTeamStorageUsage(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	createTestVolume(t, ctx, c, testVolumeName("test-volume-storage-usage"))
+
+	resp, err := c.GetTeamsStorageUsageWithResponse(ctx, setup.WithAPIKey())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+
+	// The team has at least the created volume
+	assert.GreaterOrEqual(t, resp.JSON200.VolumeCount, int64(1))
+	assert.GreaterOrEqual(t, resp.JSON200.UsedBytes, int64(0))
+	if resp.JSON200.LimitBytes != nil {
+		assert.Positive(t, *resp.JSON200.LimitBytes)
+	}
+}
+
 func TestVolumeDelete(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-delete"
+	volumeName := testVolumeName("test-volume-delete")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
 	// Delete the volume
-	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, forceDelete, setup.WithAPIKey())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
 
@@ -185,11 +280,11 @@ func TestVolumeDeleteByName(t *testing.T) {
 
 	c := setup.GetAPIClient()
 
-	volumeName := "test-volume-delete-name"
+	volumeName := testVolumeName("test-volume-delete-name")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
 	// Delete by name
-	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, setup.WithAPIKey())
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
 
@@ -199,6 +294,140 @@ func TestVolumeDeleteByName(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, getResp.StatusCode())
 }
 
+func TestVolumeDeletionProtection(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-protected")
+	volume := createTestVolume(t, ctx, c, volumeName)
+	assert.False(t, volume.DeletionProtection)
+
+	// Cleanups run last in first, the protection is cleared before the tracked volume is deleted
+	t.Cleanup(func() {
+		unprotect := false
+		_, _ = c.PatchVolumesIdOrNameWithResponse(context.Background(), volume.VolumeID, api.UpdateVolumeRequest{
+			DeletionProtection: &unprotect,
+		}, setup.WithAPIKey())
+	})
+
+	protect := true
+	patchResp, err := c.PatchVolumesIdOrNameWithResponse(ctx, volume.VolumeID, api.UpdateVolumeRequest{
+		DeletionProtection: &protect,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, patchResp.StatusCode())
+	assert.True(t, patchResp.JSON200.DeletionProtection)
+
+	// Deleting is refused, forced or not
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, forceDelete, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, deleteResp.StatusCode())
+
+	deleteResp, err = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, deleteResp.StatusCode())
+
+	getResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	assert.Equal(t, api.Available, *getResp.JSON200.Status)
+
+	// Clearing the protection allows the delete
+	protect = false
+	patchResp, err = c.PatchVolumesIdOrNameWithResponse(ctx, volumeName, api.UpdateVolumeRequest{
+		DeletionProtection: &protect,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, patchResp.StatusCode())
+	assert.False(t, patchResp.JSON200.DeletionProtection)
+
+	deleteResp, err = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, forceDelete, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+}
+
+func TestVolumeCreateWithDeletionProtection(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-create-protected")
+	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
+
+	protect := true
+	resp, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
+		Name:               volumeName,
+		DeletionProtection: &protect,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.True(t, resp.JSON201.DeletionProtection)
+
+	// Cleanups run last in first, the protection is cleared before the tracked volume is deleted
+	trackVolume(t, c, resp.JSON201.VolumeID)
+	t.Cleanup(func() {
+		unprotect := false
+		_, _ = c.PatchVolumesIdOrNameWithResponse(context.Background(), resp.JSON201.VolumeID, api.UpdateVolumeRequest{
+			DeletionProtection: &unprotect,
+		}, setup.WithAPIKey())
+	})
+
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, deleteResp.StatusCode())
+}
+
+func TestVolumeDeleteAndUndelete(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-undelete")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	getResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	if getResp.StatusCode() == http.StatusNotFound {
+		t.Skip("volumes are destroyed immediately, the deletion grace period is disabled")
+	}
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	require.NotNil(t, getResp.JSON200)
+	require.NotNil(t, getResp.JSON200.Status)
+	assert.Equal(t, api.PendingDelete, *getResp.JSON200.Status)
+	assert.NotNil(t, getResp.JSON200.DeleteAfter)
+
+	// The name stays taken while the volume is pending deletion
+	createResp, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{Name: volumeName}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, createResp.StatusCode())
+
+	// File operations don't see the volume
+	statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesStatParams{Path: "/"}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, statResp.StatusCode())
+
+	undeleteResp, err := c.PostVolumesIdOrNameUndeleteWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, undeleteResp.StatusCode())
+	require.NotNil(t, undeleteResp.JSON200)
+	require.NotNil(t, undeleteResp.JSON200.Status)
+	assert.Equal(t, api.Available, *undeleteResp.JSON200.Status)
+	assert.Nil(t, undeleteResp.JSON200.DeleteAfter)
+
+	// Only volumes pending deletion can be restored
+	undeleteResp, err = c.PostVolumesIdOrNameUndeleteWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, undeleteResp.StatusCode())
+}
+
 func TestVolumeNotFound(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
@@ -223,23 +452,76 @@ func TestVolumeNameValidation(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Invalid name: starts with number
-	resp1, err := c.PostVolumesWithResponse(ctx, api.CreateVolumeRequest{
+	resp1, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
 		Name: "123-invalid",
 	}, setup.WithAPIKey())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, resp1.StatusCode())
 
 	// Invalid name: contains uppercase
-	resp2, err := c.PostVolumesWithResponse(ctx, api.CreateVolumeRequest{
+	resp2, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
 		Name: "Invalid-Name",
 	}, setup.WithAPIKey())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, resp2.StatusCode())
 
 	// Invalid name: empty
-	resp3, err := c.PostVolumesWithResponse(ctx, api.CreateVolumeRequest{
+	resp3, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
 		Name: "",
 	}, setup.WithAPIKey())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, resp3.StatusCode())
 }
+
+func TestVolumeCreateDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-dry-run")
+	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
+
+	dryRun := true
+	resp, err := c.PostVolumesWithResponse(ctx, &api.PostVolumesParams{DryRun: &dryRun}, api.CreateVolumeRequest{
+		Name: volumeName,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode())
+	require.NotNil(t, resp.JSON202)
+	assert.Equal(t, volumeName, resp.JSON202.Name)
+	assert.Nil(t, resp.JSON202.ExistingVolume)
+	assert.NotEmpty(t, resp.JSON202.Checks)
+
+	// Nothing is created
+	getResp, err := c.GetVolumesIdOrNameWithResponse(ctx, volumeName, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResp.StatusCode())
+}
+
+func TestVolumeCreateDryRunInvalidName(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	dryRun := true
+	resp, err := c.PostVolumesWithResponse(ctx, &api.PostVolumesParams{DryRun: &dryRun}, api.CreateVolumeRequest{
+		Name: "Invalid-Name",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode())
+	require.NotNil(t, resp.JSON202)
+	assert.False(t, resp.JSON202.Ok)
+
+	var nameCheck *api.VolumeCreateCheck
+	for i, check := range resp.JSON202.Checks {
+		if check.Check == api.Name {
+			nameCheck = &resp.JSON202.Checks[i]
+		}
+	}
+	require.NotNil(t, nameCheck)
+	assert.Equal(t, api.VolumeCreateCheckStatusFailed, nameCheck.Status)
+}
@@ -1,12 +1,17 @@
 package volumes
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,13 +31,9 @@ func TestVolumeFileUpload(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-upload"
+	volumeName := testVolumeName("test-volume-file-upload")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file
 	fileContent := "Hello, JuiceFS!"
 	filePath := "/test.txt"
@@ -60,6 +61,55 @@ func TestVolumeFileUpload(t *testing.T) {
 	assert.Equal(t, int64(len(fileContent)), uploadResp.JSON201.Size)
 }
 
+func TestVolumeFileUploadSizeLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-size-limit")
+	_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volumeName, forceDelete, setup.WithAPIKey())
+
+	resp, err := c.PostVolumesWithResponse(ctx, nil, api.CreateVolumeRequest{
+		Name:           volumeName,
+		SizeLimitBytes: ptr(int64(64 << 10)),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+	volume := resp.JSON201
+	require.NotNil(t, volume.SizeLimitBytes)
+	assert.Equal(t, int64(64<<10), *volume.SizeLimitBytes)
+
+	trackVolume(t, c, volume.VolumeID)
+	waitForVolumeAvailable(t, ctx, c, volume.VolumeID)
+
+	upload := func(path string, size int) *api.PutVolumesVolumeIDFilesUploadResponse {
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: path},
+			"application/octet-stream",
+			bytes.NewReader(bytes.Repeat([]byte("a"), size)),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+
+		return uploadResp
+	}
+
+	small := upload("/small.txt", 1024)
+	require.Equal(t, http.StatusCreated, small.StatusCode(), string(small.Body))
+
+	large := upload("/large.txt", 128<<10)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, large.StatusCode(), string(large.Body))
+
+	// The rejected upload leaves nothing behind
+	statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(ctx, volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesStatParams{Path: "/large.txt"}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, statResp.StatusCode())
+}
+
 func TestVolumeFileList(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
@@ -67,13 +117,9 @@ func TestVolumeFileList(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-list"
+	volumeName := testVolumeName("test-volume-file-list")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file first
 	fileContent := "List test content"
 	filePath := "/list-test.txt"
@@ -112,7 +158,7 @@ func TestVolumeFileList(t *testing.T) {
 	for _, f := range listResp.JSON200.Files {
 		if f.Name == "list-test.txt" {
 			found = true
-			assert.Equal(t, api.File, f.Type)
+			assert.Equal(t, api.FileInfoTypeFile, f.Type)
 			require.NotNil(t, f.Size)
 			assert.Equal(t, int64(len(fileContent)), *f.Size)
 			break
@@ -128,13 +174,9 @@ func TestVolumeFileDownload(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-download"
+	volumeName := testVolumeName("test-volume-file-download")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file first
 	fileContent := "Download test content with special chars: \n\t日本語"
 	filePath := "/download-test.txt"
@@ -178,13 +220,9 @@ func TestVolumeFileDelete(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-delete"
+	volumeName := testVolumeName("test-volume-file-delete")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file first
 	filePath := "/delete-test.txt"
 
@@ -230,13 +268,9 @@ func TestVolumeFileUploadDirectory(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-upload-dir"
+	volumeName := testVolumeName("test-volume-file-upload-dir")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file in a nested directory (should auto-create directories)
 	fileContent := "Nested file content"
 	filePath := "/subdir/nested/file.txt"
@@ -285,13 +319,9 @@ func TestVolumeFileDeleteRecursive(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-delete-recursive"
+	volumeName := testVolumeName("test-volume-file-delete-recursive")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload files in a directory structure
 	for _, path := range []string{"/rmdir/file1.txt", "/rmdir/sub/file2.txt"} {
 		_, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
@@ -329,20 +359,92 @@ func TestVolumeFileDeleteRecursive(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, listResp.StatusCode())
 }
 
-func TestVolumeFileLargeFile(t *testing.T) {
+func TestVolumeFileErrorReasons(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
 
 	c := setup.GetAPIClient()
 
-	// Create a volume
-	volumeName := "test-volume-file-large"
+	volumeName := testVolumeName("test-volume-file-errors")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/dir/file.txt"},
+		"application/octet-stream",
+		strings.NewReader("content"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+
+	t.Run("download missing file", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesDownloadParams{Path: "/missing.txt"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+		require.NotNil(t, resp.JSON404)
+		assert.Equal(t, ptr(api.NotFound), resp.JSON404.Reason)
+	})
+
+	t.Run("download directory", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesDownloadParams{Path: "/dir"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		require.NotNil(t, resp.JSON400)
+		assert.Equal(t, ptr(api.IsDirectory), resp.JSON400.Reason)
+	})
+
+	t.Run("list file", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesParams{Path: ptr("/dir/file.txt")}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		require.NotNil(t, resp.JSON400)
+		assert.Equal(t, ptr(api.NotDirectory), resp.JSON400.Reason)
+	})
+
+	t.Run("upload over directory", func(t *testing.T) {
+		resp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: "/dir"},
+			"application/octet-stream",
+			strings.NewReader("content"),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode())
+		require.NotNil(t, resp.JSON409)
+		assert.Equal(t, ptr(api.IsDirectory), resp.JSON409.Reason)
+	})
+
+	t.Run("delete non-empty directory", func(t *testing.T) {
+		resp, err := c.DeleteVolumesVolumeIDFilesWithResponse(ctx, volume.VolumeID, &api.DeleteVolumesVolumeIDFilesParams{Path: "/dir"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode())
+		require.NotNil(t, resp.JSON409)
+		assert.Equal(t, ptr(api.NotEmpty), resp.JSON409.Reason)
 	})
 
+	t.Run("errors of the volume have no reason", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesWithResponse(ctx, "vol_missing", &api.GetVolumesVolumeIDFilesParams{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+		require.NotNil(t, resp.JSON404)
+		assert.Nil(t, resp.JSON404.Reason)
+	})
+}
+
+func TestVolumeFileLargeFile(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	// Create a volume
+	volumeName := testVolumeName("test-volume-file-large")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
 	// Upload a 1MB file
 	size := 1024 * 1024 // 1 MB
 	content := bytes.Repeat([]byte("A"), size)
@@ -391,13 +493,9 @@ func TestVolumeFileNotFound(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-notfound"
+	volumeName := testVolumeName("test-volume-file-notfound")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Try to download non-existent file
 	downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
 		ctx,
@@ -478,13 +576,9 @@ func TestVolumeFileOverwrite(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-overwrite"
+	volumeName := testVolumeName("test-volume-file-overwrite")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	filePath := "/overwrite.txt"
 
 	// Upload initial content
@@ -539,13 +633,9 @@ func TestVolumeFileMinimalContent(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-minimal"
+	volumeName := testVolumeName("test-volume-file-minimal")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload single-byte file
 	filePath := "/minimal.txt"
 
@@ -585,13 +675,9 @@ func TestVolumeFileBinaryContent(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-binary"
+	volumeName := testVolumeName("test-volume-file-binary")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload binary content with null bytes and high bytes
 	binaryContent := []byte{0x00, 0x01, 0xFF, 0xFE, 0x7F, 0x80, 0x00, 0xFF}
 	filePath := "/binary.bin"
@@ -631,13 +717,9 @@ func TestVolumeFileStreamingDownload(t *testing.T) {
 	c := setup.GetAPIClient()
 
 	// Create a volume
-	volumeName := "test-volume-file-streaming"
+	volumeName := testVolumeName("test-volume-file-streaming")
 	volume := createTestVolume(t, ctx, c, volumeName)
 
-	t.Cleanup(func() {
-		_, _ = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
-	})
-
 	// Upload a file
 	size := 100 * 1024 // 100 KB
 	content := bytes.Repeat([]byte("X"), size)
@@ -682,3 +764,849 @@ func TestVolumeFileStreamingDownload(t *testing.T) {
 	}
 	assert.Equal(t, int64(size), totalRead)
 }
+
+func TestVolumeFileUploadExtract(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	// Create a volume
+	volumeName := testVolumeName("test-volume-file-extract")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	// Build a project template archive
+	fileContent := "package main\n"
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "src/", Mode: 0o755}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "src/main.go", Mode: 0o644, Size: int64(len(fileContent))}))
+	_, err := tw.Write([]byte(fileContent))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/project", Extract: ptr(true)},
+		"application/gzip",
+		&buf,
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+
+	if uploadResp.StatusCode() != http.StatusCreated {
+		t.Logf("Extract response: %s", string(uploadResp.Body))
+	}
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+	require.NotNil(t, uploadResp.JSON201)
+	assert.Equal(t, int64(len(fileContent)), uploadResp.JSON201.Size)
+	assert.Equal(t, ptr(int64(1)), uploadResp.JSON201.Files)
+	assert.Equal(t, ptr(int64(1)), uploadResp.JSON201.Directories)
+
+	// The extracted file is at its path below the target directory
+	downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesDownloadParams{Path: "/project/src/main.go"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, downloadResp.StatusCode())
+	assert.Equal(t, fileContent, string(downloadResp.Body))
+
+	// Extracting requires an archive content type
+	invalidResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/project", Extract: ptr(true)},
+		"application/octet-stream",
+		strings.NewReader("not an archive"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, invalidResp.StatusCode())
+}
+
+func TestVolumeFileStat(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	// Create a volume
+	volumeName := testVolumeName("test-volume-file-stat")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	fileContent := "stat me"
+	filePath := "/stat/test.txt"
+
+	_, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: filePath},
+		"application/octet-stream",
+		strings.NewReader(fileContent),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+
+	statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesStatParams{Path: filePath, Checksum: ptr(true)},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statResp.StatusCode())
+	require.NotNil(t, statResp.JSON200)
+	assert.Equal(t, api.FileStatTypeFile, statResp.JSON200.Type)
+	assert.Equal(t, int64(len(fileContent)), statResp.JSON200.Size)
+	assert.Equal(t, "0644", statResp.JSON200.Mode)
+	require.NotNil(t, statResp.JSON200.Checksum)
+	assert.Equal(t, "efb0afa8aec211cc17eaa2b6cfb83b57f469e2f762f9e1f58c49da59a1331537", *statResp.JSON200.Checksum)
+
+	dirResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesStatParams{Path: "/stat"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, dirResp.StatusCode())
+	assert.Equal(t, api.FileStatTypeDirectory, dirResp.JSON200.Type)
+
+	missingResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesStatParams{Path: "/stat/missing.txt"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, missingResp.StatusCode())
+
+	// HEAD returns the download headers without the content
+	headResp, err := c.HeadVolumesVolumeIDFilesDownloadWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.HeadVolumesVolumeIDFilesDownloadParams{Path: filePath},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, headResp.StatusCode())
+	assert.Equal(t, int64(len(fileContent)), headResp.HTTPResponse.ContentLength)
+	assert.NotEmpty(t, headResp.HTTPResponse.Header.Get("Last-Modified"))
+	assert.Empty(t, headResp.Body)
+}
+
+func TestVolumeFileContentType(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-content-type")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 16)
+
+	tests := []struct {
+		name        string
+		path        string
+		content     string
+		contentType *string
+		expected    string
+	}{
+		{name: "by extension", path: "/assets/logo.png", content: "not really a png", expected: "image/png"},
+		{name: "sniffed", path: "/assets/image", content: png, expected: "image/png"},
+		{name: "set by the caller", path: "/assets/notes", content: "# Notes", contentType: ptr("text/markdown; charset=utf-8"), expected: "text/markdown; charset=utf-8"},
+		{name: "unknown", path: "/assets/blob", content: "\x00\x01\x02\x03", expected: "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+				ctx,
+				volume.VolumeID,
+				&api.PutVolumesVolumeIDFilesUploadParams{Path: tt.path, ContentType: tt.contentType},
+				"application/octet-stream",
+				strings.NewReader(tt.content),
+				setup.WithAPIKey(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+
+			downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
+				ctx,
+				volume.VolumeID,
+				&api.GetVolumesVolumeIDFilesDownloadParams{Path: tt.path},
+				setup.WithAPIKey(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, downloadResp.StatusCode())
+			assert.Equal(t, tt.expected, downloadResp.HTTPResponse.Header.Get("Content-Type"))
+			assert.Equal(t, "nosniff", downloadResp.HTTPResponse.Header.Get("X-Content-Type-Options"))
+			assert.Equal(t, tt.content, string(downloadResp.Body))
+
+			statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+				ctx,
+				volume.VolumeID,
+				&api.GetVolumesVolumeIDFilesStatParams{Path: tt.path},
+				setup.WithAPIKey(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, statResp.StatusCode())
+			require.NotNil(t, statResp.JSON200.ContentType)
+			assert.Equal(t, tt.expected, *statResp.JSON200.ContentType)
+		})
+	}
+
+	t.Run("overwrite replaces the content type", func(t *testing.T) {
+		for _, contentType := range []string{"text/csv", "application/json"} {
+			uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+				ctx,
+				volume.VolumeID,
+				&api.PutVolumesVolumeIDFilesUploadParams{Path: "/assets/data", ContentType: ptr(contentType)},
+				"application/octet-stream",
+				strings.NewReader("{}"),
+				setup.WithAPIKey(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+		}
+
+		headResp, err := c.HeadVolumesVolumeIDFilesDownloadWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.HeadVolumesVolumeIDFilesDownloadParams{Path: "/assets/data"},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, headResp.StatusCode())
+		assert.Equal(t, "application/json", headResp.HTTPResponse.Header.Get("Content-Type"))
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: "/assets/invalid", ContentType: ptr("text/")},
+			"application/octet-stream",
+			strings.NewReader("content"),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, uploadResp.StatusCode())
+	})
+}
+
+func TestVolumeFileAttributes(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-attributes")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	// Upload with the mode and owner of the sandbox user
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/app/run.sh", Mode: ptr("0755"), Uid: ptr(int64(1000)), Gid: ptr(int64(1000))},
+		"application/octet-stream",
+		strings.NewReader("#!/bin/sh\necho hello\n"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+
+	statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesStatParams{Path: "/app/run.sh"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statResp.StatusCode())
+	assert.Equal(t, "0755", statResp.JSON200.Mode)
+	assert.Equal(t, int64(1000), statResp.JSON200.Uid)
+	assert.Equal(t, int64(1000), statResp.JSON200.Gid)
+
+	_, err = c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/app/data/config.json"},
+		"application/octet-stream",
+		strings.NewReader("{}"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+
+	t.Run("chmod", func(t *testing.T) {
+		resp, err := c.PatchVolumesVolumeIDFilesAttributesWithResponse(ctx, volume.VolumeID, api.FileAttributesRequest{
+			Path: "/app/data/config.json",
+			Mode: ptr("0664"),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, int64(1), resp.JSON200.Entries)
+		assert.Equal(t, "0664", resp.JSON200.File.Mode)
+		assert.Equal(t, int64(0), resp.JSON200.File.Uid)
+	})
+
+	t.Run("recursive chown", func(t *testing.T) {
+		resp, err := c.PatchVolumesVolumeIDFilesAttributesWithResponse(ctx, volume.VolumeID, api.FileAttributesRequest{
+			Path:      "/app",
+			Uid:       ptr(int64(1001)),
+			Gid:       ptr(int64(1002)),
+			Recursive: ptr(true),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		// The directory, run.sh, data and config.json
+		assert.Equal(t, int64(4), resp.JSON200.Entries)
+		assert.Equal(t, int64(1001), resp.JSON200.File.Uid)
+
+		statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.GetVolumesVolumeIDFilesStatParams{Path: "/app/data/config.json"},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statResp.StatusCode())
+		assert.Equal(t, int64(1001), statResp.JSON200.Uid)
+		assert.Equal(t, int64(1002), statResp.JSON200.Gid)
+		// The mode is left unchanged
+		assert.Equal(t, "0664", statResp.JSON200.Mode)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]api.FileAttributesRequest{
+			"nothing to set": {Path: "/app"},
+			"invalid mode":   {Path: "/app", Mode: ptr("rwxr-xr-x")},
+			"relative path":  {Path: "app", Mode: ptr("0755")},
+		} {
+			resp, err := c.PatchVolumesVolumeIDFilesAttributesWithResponse(ctx, volume.VolumeID, req, setup.WithAPIKey())
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), name)
+		}
+
+		resp, err := c.PatchVolumesVolumeIDFilesAttributesWithResponse(ctx, volume.VolumeID, api.FileAttributesRequest{
+			Path: "/missing",
+			Mode: ptr("0755"),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
+
+func TestVolumeFileSymlink(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-symlink")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/releases/v2/app.txt"},
+		"application/octet-stream",
+		strings.NewReader("v2"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+
+	// The symlink is created in a missing parent directory
+	resp, err := c.PostVolumesVolumeIDFilesSymlinkWithResponse(ctx, volume.VolumeID, api.FileSymlinkRequest{
+		Path:   "/links/current",
+		Target: "../releases/v2",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.Equal(t, "current", resp.JSON201.Name)
+	assert.Equal(t, api.FileInfoTypeSymlink, resp.JSON201.Type)
+	require.NotNil(t, resp.JSON201.LinkTarget)
+	assert.Equal(t, "../releases/v2", *resp.JSON201.LinkTarget)
+
+	t.Run("listed with the target", func(t *testing.T) {
+		listResp, err := c.GetVolumesVolumeIDFilesWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.GetVolumesVolumeIDFilesParams{Path: ptr("/links")},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, listResp.StatusCode())
+		require.Len(t, listResp.JSON200.Files, 1)
+
+		file := listResp.JSON200.Files[0]
+		assert.Equal(t, api.FileInfoTypeSymlink, file.Type)
+		require.NotNil(t, file.LinkTarget)
+		assert.Equal(t, "../releases/v2", *file.LinkTarget)
+	})
+
+	t.Run("download follows the symlink", func(t *testing.T) {
+		downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.GetVolumesVolumeIDFilesDownloadParams{Path: "/links/current/app.txt"},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, downloadResp.StatusCode())
+		assert.Equal(t, "v2", string(downloadResp.Body))
+	})
+
+	t.Run("existing path", func(t *testing.T) {
+		resp, err := c.PostVolumesVolumeIDFilesSymlinkWithResponse(ctx, volume.VolumeID, api.FileSymlinkRequest{
+			Path:   "/links/current",
+			Target: "../releases/v3",
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode())
+	})
+
+	t.Run("dangling symlink", func(t *testing.T) {
+		resp, err := c.PostVolumesVolumeIDFilesSymlinkWithResponse(ctx, volume.VolumeID, api.FileSymlinkRequest{
+			Path:      "/links/current",
+			Target:    "../releases/v3",
+			Overwrite: ptr(true),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.GetVolumesVolumeIDFilesDownloadParams{Path: "/links/current"},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, downloadResp.StatusCode())
+		assert.Contains(t, string(downloadResp.Body), "../releases/v3")
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]api.FileSymlinkRequest{
+			"relative path": {Path: "links/other", Target: "/releases"},
+			"volume root":   {Path: "/", Target: "/releases"},
+			"empty target":  {Path: "/links/other", Target: ""},
+		} {
+			resp, err := c.PostVolumesVolumeIDFilesSymlinkWithResponse(ctx, volume.VolumeID, req, setup.WithAPIKey())
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), name)
+		}
+	})
+}
+
+func TestVolumeFileSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-search")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	for filePath, content := range map[string]string{
+		"/logs/app.log":          "started",
+		"/logs/2026/01/app.log":  "started and stopped",
+		"/logs/2026/01/db.log":   "ready",
+		"/logs/2026/01/app.json": "{}",
+		"/data/app.log.gz":       "compressed",
+	} {
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: filePath},
+			"application/octet-stream",
+			strings.NewReader(content),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+	}
+
+	search := func(t *testing.T, params api.GetVolumesVolumeIDFilesSearchParams) []string {
+		t.Helper()
+
+		resp, err := c.GetVolumesVolumeIDFilesSearchWithResponse(ctx, volume.VolumeID, &params, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+		paths := make([]string, 0, len(resp.JSON200.Files))
+		for _, file := range resp.JSON200.Files {
+			paths = append(paths, file.Path)
+		}
+
+		return paths
+	}
+
+	t.Run("glob", func(t *testing.T) {
+		paths := search(t, api.GetVolumesVolumeIDFilesSearchParams{Glob: ptr("*.log")})
+		assert.Equal(t, []string{"/logs/2026/01/app.log", "/logs/2026/01/db.log", "/logs/app.log"}, paths)
+	})
+
+	t.Run("name below a directory", func(t *testing.T) {
+		paths := search(t, api.GetVolumesVolumeIDFilesSearchParams{Path: ptr("/logs/2026"), Name: ptr("app")})
+		assert.Equal(t, []string{"/logs/2026/01/app.json", "/logs/2026/01/app.log"}, paths)
+	})
+
+	t.Run("size", func(t *testing.T) {
+		paths := search(t, api.GetVolumesVolumeIDFilesSearchParams{MinSize: ptr(int64(10))})
+		assert.Equal(t, []string{"/data/app.log.gz", "/logs/2026/01/app.log"}, paths)
+	})
+
+	t.Run("modified time", func(t *testing.T) {
+		paths := search(t, api.GetVolumesVolumeIDFilesSearchParams{Glob: ptr("*.log"), ModifiedBefore: ptr(time.Now().Add(-time.Hour))})
+		assert.Empty(t, paths)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesSearchWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesSearchParams{
+			Glob:  ptr("*.log"),
+			Limit: ptr(int32(2)),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Files, 2)
+		require.NotNil(t, resp.JSON200.NextToken)
+
+		paths := search(t, api.GetVolumesVolumeIDFilesSearchParams{Glob: ptr("*.log"), Limit: ptr(int32(2)), NextToken: resp.JSON200.NextToken})
+		assert.Equal(t, []string{"/logs/app.log"}, paths)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, params := range map[string]api.GetVolumesVolumeIDFilesSearchParams{
+			"invalid glob":     {Glob: ptr("[a-")},
+			"empty size range": {MinSize: ptr(int64(2)), MaxSize: ptr(int64(1))},
+			"not a directory":  {Path: ptr("/logs/app.log")},
+		} {
+			resp, err := c.GetVolumesVolumeIDFilesSearchWithResponse(ctx, volume.VolumeID, &params, setup.WithAPIKey())
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), name)
+		}
+
+		resp, err := c.GetVolumesVolumeIDFilesSearchWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesSearchParams{Path: ptr("/missing")}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
+
+func TestVolumeFileGrep(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-grep")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	for filePath, content := range map[string]string{
+		"/repo/main.go":       "package main\n\nfunc main() {\n\t// TODO: flags\n}\n",
+		"/repo/pkg/util.go":   "package pkg\n\n// todo: tests\nfunc Util() {}\n",
+		"/repo/README.md":     "# repo\nTODO: docs\n",
+		"/repo/bin/tool":      "TODO\x00binary",
+		"/repo/pkg/other.txt": "nothing to do",
+	} {
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: filePath},
+			"application/octet-stream",
+			strings.NewReader(content),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+	}
+
+	grep := func(t *testing.T, params api.GetVolumesVolumeIDFilesGrepParams) *api.FileGrepResponse {
+		t.Helper()
+
+		resp, err := c.GetVolumesVolumeIDFilesGrepWithResponse(ctx, volume.VolumeID, &params, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+		return resp.JSON200
+	}
+
+	t.Run("pattern", func(t *testing.T) {
+		result := grep(t, api.GetVolumesVolumeIDFilesGrepParams{Pattern: "TODO"})
+		assert.Equal(t, []api.FileGrepMatch{
+			{Path: "/repo/README.md", Line: 2, Text: "TODO: docs"},
+			{Path: "/repo/main.go", Line: 4, Text: "\t// TODO: flags"},
+		}, result.Matches)
+		// The binary file is skipped
+		assert.Equal(t, int64(4), result.FilesSearched)
+		assert.Equal(t, int64(1), result.FilesSkipped)
+		assert.False(t, result.Truncated)
+	})
+
+	t.Run("ignore case and glob", func(t *testing.T) {
+		result := grep(t, api.GetVolumesVolumeIDFilesGrepParams{Pattern: "todo", IgnoreCase: ptr(true), Glob: ptr("*.go")})
+		require.Len(t, result.Matches, 2)
+		assert.Equal(t, "/repo/main.go", result.Matches[0].Path)
+		assert.Equal(t, "/repo/pkg/util.go", result.Matches[1].Path)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		result := grep(t, api.GetVolumesVolumeIDFilesGrepParams{Pattern: `^func \w+`, Path: ptr("/repo/pkg/util.go")})
+		require.Len(t, result.Matches, 1)
+		assert.Equal(t, int64(4), result.Matches[0].Line)
+	})
+
+	t.Run("max matches", func(t *testing.T) {
+		result := grep(t, api.GetVolumesVolumeIDFilesGrepParams{Pattern: "package", MaxMatches: ptr(int32(1))})
+		assert.Len(t, result.Matches, 1)
+		assert.True(t, result.Truncated)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		resp, err := c.GetVolumesVolumeIDFilesGrepWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesGrepParams{Pattern: "func ("}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+
+		resp, err = c.GetVolumesVolumeIDFilesGrepWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesGrepParams{Pattern: "func", Path: ptr("/missing")}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
+
+func TestVolumeFileSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-sync")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	for filePath, content := range map[string]string{
+		"/app/same.txt":    "same",
+		"/app/src/main.go": "package old",
+		"/app/stale.txt":   "stale",
+	} {
+		uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.PutVolumesVolumeIDFilesUploadParams{Path: filePath},
+			"application/octet-stream",
+			strings.NewReader(content),
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, uploadResp.StatusCode())
+	}
+
+	entry := func(filePath, content string) api.FileSyncEntry {
+		checksum := sha256.Sum256([]byte(content))
+
+		return api.FileSyncEntry{Path: filePath, Size: int64(len(content)), Checksum: hex.EncodeToString(checksum[:])}
+	}
+
+	manifest := []api.FileSyncEntry{
+		entry("same.txt", "same"),
+		// Same size, different content
+		entry("src/main.go", "package new"),
+		entry("docs/new.md", "# new"),
+	}
+
+	t.Run("diff", func(t *testing.T) {
+		resp, err := c.PostVolumesVolumeIDFilesSyncWithResponse(ctx, volume.VolumeID, api.FileSyncRequest{
+			Path:  "/app",
+			Files: manifest,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+		assert.Equal(t, []string{"src/main.go", "docs/new.md"}, resp.JSON200.Upload)
+		assert.Equal(t, []string{"stale.txt"}, resp.JSON200.Extra)
+		assert.Equal(t, int64(1), resp.JSON200.Unchanged)
+
+		// Without delete, the extra file is kept
+		statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesStatParams{Path: "/app/stale.txt"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, statResp.StatusCode())
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		resp, err := c.PostVolumesVolumeIDFilesSyncWithResponse(ctx, volume.VolumeID, api.FileSyncRequest{
+			Path:  "/missing",
+			Files: manifest,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+		assert.Len(t, resp.JSON200.Upload, len(manifest))
+		assert.Empty(t, resp.JSON200.Extra)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := c.PostVolumesVolumeIDFilesSyncWithResponse(ctx, volume.VolumeID, api.FileSyncRequest{
+			Path:   "/app",
+			Files:  manifest,
+			Delete: ptr(true),
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+		assert.Equal(t, []string{"stale.txt"}, resp.JSON200.Extra)
+
+		statResp, err := c.GetVolumesVolumeIDFilesStatWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDFilesStatParams{Path: "/app/stale.txt"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, statResp.StatusCode())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, files := range map[string][]api.FileSyncEntry{
+			"absolute path":    {entry("/same.txt", "same")},
+			"parent path":      {entry("../same.txt", "same")},
+			"invalid checksum": {{Path: "same.txt", Size: 4, Checksum: "same"}},
+		} {
+			resp, err := c.PostVolumesVolumeIDFilesSyncWithResponse(ctx, volume.VolumeID, api.FileSyncRequest{
+				Path:  "/app",
+				Files: files,
+			}, setup.WithAPIKey())
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), name)
+		}
+
+		resp, err := c.PostVolumesVolumeIDFilesSyncWithResponse(ctx, volume.VolumeID, api.FileSyncRequest{
+			Path:  "/app/same.txt",
+			Files: manifest,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode())
+	})
+}
+
+func TestVolumeFileMkdir(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	// Create a volume
+	volumeName := testVolumeName("test-volume-file-mkdir")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	mkdir := func(path string, recursive bool) *api.PostVolumesVolumeIDFilesMkdirResponse {
+		t.Helper()
+
+		resp, err := c.PostVolumesVolumeIDFilesMkdirWithResponse(
+			ctx,
+			volume.VolumeID,
+			api.FileMkdirRequest{Path: path, Recursive: ptr(recursive)},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	created := mkdir("/empty", false)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.NotNil(t, created.JSON201)
+	assert.Equal(t, "empty", created.JSON201.Name)
+	assert.Equal(t, api.FileInfoTypeDirectory, created.JSON201.Type)
+
+	listResp, err := c.GetVolumesVolumeIDFilesWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.GetVolumesVolumeIDFilesParams{Path: ptr("/empty")},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	assert.Empty(t, listResp.JSON200.Files)
+
+	// An existing directory is a conflict, unless recursive
+	assert.Equal(t, http.StatusConflict, mkdir("/empty", false).StatusCode())
+	assert.Equal(t, http.StatusCreated, mkdir("/empty", true).StatusCode())
+
+	// Missing parents are only created with recursive
+	assert.Equal(t, http.StatusNotFound, mkdir("/a/b/c", false).StatusCode())
+	nested := mkdir("/a/b/c", true)
+	require.Equal(t, http.StatusCreated, nested.StatusCode())
+	assert.Equal(t, "/a/b/c", nested.JSON201.Path)
+
+	// A file at the path is a conflict
+	_, err = c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/file.txt"},
+		"application/octet-stream",
+		strings.NewReader("content"),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, mkdir("/file.txt", true).StatusCode())
+
+	assert.Equal(t, http.StatusBadRequest, mkdir("relative", true).StatusCode())
+}
+
+func TestVolumeFilePresign(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-file-presign")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	fileContent := "Presigned download content"
+	filePath := "/presign-test.txt"
+
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: filePath},
+		"application/octet-stream",
+		strings.NewReader(fileContent),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode(), string(uploadResp.Body))
+
+	presignResp, err := c.PostVolumesVolumeIDFilesPresignWithResponse(ctx, volume.VolumeID, api.FilePresignRequest{
+		Path:        filePath,
+		ExpiresIn:   ptr(int32(60)),
+		Materialize: ptr(true),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+
+	if presignResp.StatusCode() == http.StatusInternalServerError && strings.Contains(string(presignResp.Body), "sign the download URL") {
+		t.Skip("The API credentials can't sign URLs")
+	}
+	require.Equal(t, http.StatusOK, presignResp.StatusCode(), string(presignResp.Body))
+	require.NotNil(t, presignResp.JSON200)
+	assert.Equal(t, int64(len(fileContent)), presignResp.JSON200.Size)
+
+	// The URL works without the API key
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, presignResp.JSON200.Url, nil)
+	require.NoError(t, err)
+	downloadResp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer downloadResp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, downloadResp.StatusCode)
+	content, err := io.ReadAll(downloadResp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, fileContent, string(content))
+
+	// Missing files can't be signed
+	notFoundResp, err := c.PostVolumesVolumeIDFilesPresignWithResponse(ctx, volume.VolumeID, api.FilePresignRequest{
+		Path:        "/nonexistent.txt",
+		Materialize: ptr(true),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, notFoundResp.StatusCode())
+}
@@ -0,0 +1,33 @@
+package volumes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestVolumeMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-volume-metrics"))
+
+	// A volume that was never mounted has no metrics
+	resp, err := c.GetVolumesVolumeIDMetricsWithResponse(ctx, volume.VolumeID, &api.GetVolumesVolumeIDMetricsParams{}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON200)
+	assert.Empty(t, *resp.JSON200)
+
+	resp, err = c.GetVolumesVolumeIDMetricsWithResponse(ctx, "vol_nonexistent123", &api.GetVolumesVolumeIDMetricsParams{}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
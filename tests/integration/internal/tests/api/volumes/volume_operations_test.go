@@ -0,0 +1,124 @@
+package volumes
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestVolumeOperations(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-volume-operations"))
+
+	listResp, err := c.GetVolumesIdOrNameOperationsWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	assert.Empty(t, *listResp.JSON200)
+
+	deleteResp, err := c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	listResp, err = c.GetVolumesIdOrNameOperationsWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	if listResp.StatusCode() == http.StatusNotFound {
+		t.Skip("volumes are destroyed immediately, the deletion grace period is disabled")
+	}
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	require.Len(t, *listResp.JSON200, 1)
+
+	op := (*listResp.JSON200)[0]
+	assert.Equal(t, volume.VolumeID, op.VolumeID)
+	assert.Equal(t, api.Delete, op.Type)
+	assert.Equal(t, api.VolumeOperationStatePending, op.State)
+	assert.Nil(t, op.FinishedAt)
+
+	// Restoring the volume cancels its deletion
+	undeleteResp, err := c.PostVolumesIdOrNameUndeleteWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, undeleteResp.StatusCode())
+
+	getResp, err := c.GetOperationsOperationIDWithResponse(ctx, op.OperationID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	require.NotNil(t, getResp.JSON200)
+	assert.Equal(t, api.VolumeOperationStateCanceled, getResp.JSON200.State)
+	assert.NotNil(t, getResp.JSON200.FinishedAt)
+
+	// Operations stay readable once the volume is gone
+	deleteResp, err = c.DeleteVolumesIdOrNameWithResponse(ctx, volume.VolumeID, forceDelete, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	getResp, err = c.GetOperationsOperationIDWithResponse(ctx, op.OperationID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResp.StatusCode())
+}
+
+func TestVolumeFsck(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-volume-fsck"))
+
+	uploadResp, err := c.PutVolumesVolumeIDFilesUploadWithBodyWithResponse(
+		ctx,
+		volume.VolumeID,
+		&api.PutVolumesVolumeIDFilesUploadParams{Path: "/fsck.txt"},
+		"application/octet-stream",
+		bytes.NewReader([]byte("consistent")),
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode(), string(uploadResp.Body))
+
+	fsckResp, err := c.PostVolumesVolumeIDFsckWithResponse(ctx, volume.VolumeID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, fsckResp.StatusCode(), string(fsckResp.Body))
+	require.NotNil(t, fsckResp.JSON202)
+	assert.Equal(t, api.Fsck, fsckResp.JSON202.Type)
+
+	var op *api.VolumeOperation
+	require.Eventually(t, func() bool {
+		resp, err := c.GetOperationsOperationIDWithResponse(ctx, fsckResp.JSON202.OperationID, setup.WithAPIKey())
+		if err != nil || resp.JSON200 == nil {
+			return false
+		}
+		op = resp.JSON200
+
+		return op.FinishedAt != nil
+	}, 2*time.Minute, time.Second, "fsck operation didn't finish")
+
+	require.Equal(t, api.VolumeOperationStateSucceeded, op.State, "fsck failed: %v", op.Error)
+	require.NotNil(t, op.Fsck)
+	assert.Zero(t, op.Fsck.MissingObjects)
+	assert.Empty(t, op.Fsck.BrokenFiles)
+	assert.False(t, op.Fsck.Repaired)
+}
+
+func TestVolumeOperationNotFound(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.GetOperationsOperationIDWithResponse(ctx, "op-nonexistent", setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
@@ -0,0 +1,466 @@
+package volumes
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sharedUtils "github.com/moru-ai/sandbox-infra/packages/shared/pkg/utils"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+const (
+	s3Region                 = "us-east-1"
+	s3StreamingSignedPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+)
+
+// s3Signer signs like the S3 clients of the SDK, which send the path encoded once.
+var s3Signer = v4.NewSigner(func(o *v4.SignerOptions) {
+	o.DisableURIPathEscaping = true
+})
+
+// createS3Credentials creates S3 credentials for the team, deleted at the end of the test.
+func createS3Credentials(t *testing.T, ctx context.Context, c *api.ClientWithResponses, scope api.NewS3Credentials) aws.Credentials {
+	t.Helper()
+
+	resp, err := c.PostS3CredentialsWithResponse(ctx, scope, setup.WithAPIKey())
+	require.NoError(t, err)
+	if resp.StatusCode() == http.StatusServiceUnavailable {
+		t.Skip("S3 credentials are not available without a secrets encryption key")
+	}
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON201)
+
+	t.Cleanup(func() {
+		_, _ = c.DeleteS3CredentialsAccessKeyIDWithResponse(context.WithoutCancel(ctx), resp.JSON201.AccessKeyId, setup.WithAPIKey())
+	})
+
+	return aws.Credentials{AccessKeyID: resp.JSON201.AccessKeyId, SecretAccessKey: resp.JSON201.SecretAccessKey}
+}
+
+// signS3Request signs the request with the SHA-256 of its content like the S3 clients.
+func signS3Request(t *testing.T, req *http.Request, credentials aws.Credentials, content []byte) {
+	t.Helper()
+
+	digest := sha256.Sum256(content)
+	payloadHash := hex.EncodeToString(digest[:])
+
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	require.NoError(t, s3Signer.SignHTTP(req.Context(), credentials, req, payloadHash, "s3", s3Region, time.Now()))
+}
+
+// signS3StreamingUpload signs an upload of the chunks in the aws-chunked encoding like the S3 clients
+// and returns its content.
+func signS3StreamingUpload(t *testing.T, req *http.Request, credentials aws.Credentials, chunks []string) []byte {
+	t.Helper()
+
+	now := time.Now()
+
+	var length int
+	for _, chunk := range chunks {
+		length += len(chunk)
+	}
+
+	req.Header.Set("Content-Encoding", "aws-chunked")
+	req.Header.Set("X-Amz-Content-Sha256", s3StreamingSignedPayload)
+	req.Header.Set("X-Amz-Decoded-Content-Length", strconv.Itoa(length))
+	require.NoError(t, s3Signer.SignHTTP(req.Context(), credentials, req, s3StreamingSignedPayload, "s3", s3Region, now))
+
+	// Each chunk is signed with the signature of the previous one, the first with the signature of the request
+	_, seed, ok := strings.Cut(req.Header.Get("Authorization"), "Signature=")
+	require.True(t, ok)
+	seedSignature, err := hex.DecodeString(seed)
+	require.NoError(t, err)
+
+	chunkSigner := v4.NewStreamSigner(credentials, "s3", s3Region, seedSignature)
+
+	var content bytes.Buffer
+	for _, chunk := range append(chunks, "") {
+		signature, err := chunkSigner.GetSignature(req.Context(), nil, []byte(chunk), now)
+		require.NoError(t, err)
+		fmt.Fprintf(&content, "%x;chunk-signature=%x\r\n%s\r\n", len(chunk), signature, chunk)
+	}
+
+	return content.Bytes()
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key  string `xml:"Key"`
+		Size int64  `xml:"Size"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func TestVolumeS3(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-s3")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	bucketURL := setup.APIServerURL + "/s3/" + volume.VolumeID
+
+	credentials := createS3Credentials(t, ctx, c, api.NewS3Credentials{})
+
+	send := func(t *testing.T, req *http.Request) (*http.Response, string) {
+		t.Helper()
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(respBody)
+	}
+
+	do := func(t *testing.T, method, path string, body io.Reader, header http.Header) (*http.Response, string) {
+		t.Helper()
+
+		var content []byte
+		if body != nil {
+			var err error
+			content, err = io.ReadAll(body)
+			require.NoError(t, err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, bucketURL+path, bytes.NewReader(content))
+		require.NoError(t, err)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		signS3Request(t, req, credentials, content)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(respBody)
+	}
+
+	list := func(t *testing.T, query string) s3ListBucketResult {
+		t.Helper()
+
+		resp, body := do(t, http.MethodGet, "?list-type=2&"+query, nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+		var result s3ListBucketResult
+		require.NoError(t, xml.Unmarshal([]byte(body), &result))
+
+		return result
+	}
+
+	t.Run("requires credentials", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, bucketURL+"?list-type=2", nil)
+		require.NoError(t, err)
+
+		resp, _ := send(t, req)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("verifies signature", func(t *testing.T) {
+		// The API key isn't a secret access key
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, bucketURL+"?list-type=2", nil)
+		require.NoError(t, err)
+		signS3Request(t, req, aws.Credentials{AccessKeyID: credentials.AccessKeyID, SecretAccessKey: setup.APIKey}, nil)
+
+		resp, body := send(t, req)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Contains(t, body, "SignatureDoesNotMatch")
+
+		// The signed content can't be replaced
+		req, err = http.NewRequestWithContext(ctx, http.MethodPut, bucketURL+"/tampered.txt", strings.NewReader("tampered"))
+		require.NoError(t, err)
+		signS3Request(t, req, credentials, []byte("original"))
+
+		resp, body = send(t, req)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, body)
+
+		resp, _ = do(t, http.MethodGet, "/tampered.txt", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		// Unknown credentials
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, bucketURL+"?list-type=2", nil)
+		require.NoError(t, err)
+		signS3Request(t, req, aws.Credentials{AccessKeyID: "MORUUNKNOWNACCESSKEY", SecretAccessKey: credentials.SecretAccessKey}, nil)
+
+		resp, body = send(t, req)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Contains(t, body, "InvalidAccessKeyId")
+	})
+
+	t.Run("objects", func(t *testing.T) {
+		for key, content := range map[string]string{
+			"logs/a.log":       "first",
+			"logs/2026/b.log":  "second",
+			"logs-archive.tar": "archive",
+			"readme.md":        "# volume",
+		} {
+			resp, body := do(t, http.MethodPut, "/"+key, strings.NewReader(content), nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode, body)
+			assert.NotEmpty(t, resp.Header.Get("ETag"))
+		}
+
+		resp, body := do(t, http.MethodGet, "/logs/a.log", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "first", body)
+
+		resp, body = do(t, http.MethodGet, "/logs/a.log", nil, http.Header{"Range": {"bytes=1-3"}})
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		assert.Equal(t, "irs", body)
+
+		resp, _ = do(t, http.MethodHead, "/readme.md", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "8", resp.Header.Get("Content-Length"))
+
+		resp, body = do(t, http.MethodGet, "/missing.txt", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Contains(t, body, "NoSuchKey")
+
+		// Keys are listed in lexicographic order
+		result := list(t, "")
+		keys := make([]string, 0, len(result.Contents))
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+		assert.Equal(t, []string{"logs-archive.tar", "logs/2026/b.log", "logs/a.log", "readme.md"}, keys)
+
+		result = list(t, "prefix=logs/&delimiter=/")
+		require.Len(t, result.Contents, 1)
+		assert.Equal(t, "logs/a.log", result.Contents[0].Key)
+		require.Len(t, result.CommonPrefixes, 1)
+		assert.Equal(t, "logs/2026/", result.CommonPrefixes[0].Prefix)
+
+		// Pages continue after the last key
+		result = list(t, "max-keys=2")
+		require.Len(t, result.Contents, 2)
+		require.True(t, result.IsTruncated)
+		result = list(t, "max-keys=2&continuation-token="+result.NextContinuationToken)
+		require.Len(t, result.Contents, 2)
+		assert.Equal(t, "logs/a.log", result.Contents[0].Key)
+		assert.False(t, result.IsTruncated)
+
+		resp, _ = do(t, http.MethodDelete, "/logs/a.log", nil, nil)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+		resp, _ = do(t, http.MethodDelete, "/logs/a.log", nil, nil)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+		resp, _ = do(t, http.MethodGet, "/logs/a.log", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("multipart upload", func(t *testing.T) {
+		resp, body := do(t, http.MethodPost, "/big/file.bin?uploads", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+		var initiated struct {
+			UploadID string `xml:"UploadId"`
+		}
+		require.NoError(t, xml.Unmarshal([]byte(body), &initiated))
+		require.NotEmpty(t, initiated.UploadID)
+
+		etags := make([]string, 0, 2)
+		for i, content := range []string{"part one, ", "part two"} {
+			resp, body := do(t, http.MethodPut, "/big/file.bin?partNumber="+strconv.Itoa(i+1)+"&uploadId="+initiated.UploadID, strings.NewReader(content), nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode, body)
+			etags = append(etags, resp.Header.Get("ETag"))
+		}
+
+		complete := "<CompleteMultipartUpload>" +
+			"<Part><PartNumber>1</PartNumber><ETag>" + etags[0] + "</ETag></Part>" +
+			"<Part><PartNumber>2</PartNumber><ETag>" + etags[1] + "</ETag></Part>" +
+			"</CompleteMultipartUpload>"
+		resp, body = do(t, http.MethodPost, "/big/file.bin?uploadId="+initiated.UploadID, strings.NewReader(complete), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+		resp, body = do(t, http.MethodGet, "/big/file.bin", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "part one, part two", body)
+
+		// A completed upload is gone
+		resp, body = do(t, http.MethodDelete, "/big/file.bin?uploadId="+initiated.UploadID, nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Contains(t, body, "NoSuchUpload")
+	})
+
+	t.Run("streaming upload", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, bucketURL+"/streamed.txt", nil)
+		require.NoError(t, err)
+		content := signS3StreamingUpload(t, req, credentials, []string{"hello", " world"})
+		req.Body = io.NopCloser(bytes.NewReader(content))
+		req.ContentLength = int64(len(content))
+
+		resp, body := send(t, req)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+		resp, body = do(t, http.MethodGet, "/streamed.txt", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "hello world", body)
+
+		// A chunk that doesn't match its signature fails the upload
+		req, err = http.NewRequestWithContext(ctx, http.MethodPut, bucketURL+"/streamed.txt", nil)
+		require.NoError(t, err)
+		content = signS3StreamingUpload(t, req, credentials, []string{"hello", " there"})
+		content = bytes.Replace(content, []byte("hello"), []byte("jello"), 1)
+		req.Body = io.NopCloser(bytes.NewReader(content))
+		req.ContentLength = int64(len(content))
+
+		resp, body = send(t, req)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+		assert.Contains(t, body, "SignatureDoesNotMatch")
+
+		resp, body = do(t, http.MethodGet, "/streamed.txt", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "hello world", body)
+	})
+
+	t.Run("presigned url", func(t *testing.T) {
+		resp, body := do(t, http.MethodPut, "/shared.txt", strings.NewReader("shared"), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, bucketURL+"/shared.txt?X-Amz-Expires=300", nil)
+		require.NoError(t, err)
+		signedURL, _, err := s3Signer.PresignHTTP(ctx, credentials, req, "UNSIGNED-PAYLOAD", "s3", s3Region, time.Now())
+		require.NoError(t, err)
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, signedURL, nil)
+		require.NoError(t, err)
+		resp, body = send(t, req)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+		assert.Equal(t, "shared", body)
+
+		// The presigned URL is only valid for its object
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, strings.Replace(signedURL, "/shared.txt", "/streamed.txt", 1), nil)
+		require.NoError(t, err)
+		resp, body = send(t, req)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Contains(t, body, "SignatureDoesNotMatch")
+	})
+
+	t.Run("unknown bucket", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, setup.APIServerURL+"/s3/vol_missing?list-type=2", nil)
+		require.NoError(t, err)
+		signS3Request(t, req, credentials, nil)
+
+		resp, _ := send(t, req)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestVolumeS3ScopedCredentials(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volume := createTestVolume(t, ctx, c, testVolumeName("test-volume-s3-scoped"))
+	other := createTestVolume(t, ctx, c, testVolumeName("test-volume-s3-scoped-other"))
+
+	bucketURL := setup.APIServerURL + "/s3/" + volume.VolumeID
+
+	do := func(t *testing.T, credentials aws.Credentials, method, url, content string) (*http.Response, string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(content))
+		require.NoError(t, err)
+		signS3Request(t, req, credentials, []byte(content))
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(respBody)
+	}
+
+	full := createS3Credentials(t, ctx, c, api.NewS3Credentials{})
+	for _, key := range []string{"datasets/train.csv", "secret.txt"} {
+		resp, body := do(t, full, http.MethodPut, bucketURL+"/"+key, key)
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	}
+
+	t.Run("requires volume", func(t *testing.T) {
+		resp, err := c.PostS3CredentialsWithResponse(ctx, api.NewS3Credentials{Subpath: sharedUtils.ToPtr("/datasets")}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), string(resp.Body))
+	})
+
+	scoped := createS3Credentials(t, ctx, c, api.NewS3Credentials{
+		VolumeId: &volume.VolumeID,
+		Subpath:  sharedUtils.ToPtr("/datasets"),
+		ReadOnly: sharedUtils.ToPtr(true),
+	})
+
+	t.Run("reads the subpath", func(t *testing.T) {
+		resp, body := do(t, scoped, http.MethodGet, bucketURL+"/datasets/train.csv", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+		assert.Equal(t, "datasets/train.csv", body)
+
+		resp, body = do(t, scoped, http.MethodGet, bucketURL+"?list-type=2&prefix=datasets/", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode, body)
+		assert.Contains(t, body, "datasets/train.csv")
+	})
+
+	t.Run("refuses the rest of the volume", func(t *testing.T) {
+		resp, body := do(t, scoped, http.MethodGet, bucketURL+"/secret.txt", "")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+
+		resp, body = do(t, scoped, http.MethodGet, bucketURL+"?list-type=2", "")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+
+		resp, body = do(t, scoped, http.MethodGet, setup.APIServerURL+"/s3/"+other.VolumeID+"?list-type=2", "")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+	})
+
+	t.Run("refuses writes", func(t *testing.T) {
+		resp, body := do(t, scoped, http.MethodPut, bucketURL+"/datasets/new.csv", "new")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+	})
+
+	t.Run("webdav", func(t *testing.T) {
+		davDo := func(t *testing.T, method, path string) int {
+			t.Helper()
+
+			req, err := http.NewRequestWithContext(ctx, method, setup.APIServerURL+"/volumes/"+volume.VolumeID+"/webdav"+path, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(scoped.AccessKeyID, scoped.SecretAccessKey)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			return resp.StatusCode
+		}
+
+		assert.Equal(t, http.StatusOK, davDo(t, http.MethodGet, "/datasets/train.csv"))
+		assert.Equal(t, http.StatusForbidden, davDo(t, http.MethodGet, "/secret.txt"))
+		assert.Equal(t, http.StatusForbidden, davDo(t, http.MethodDelete, "/datasets/train.csv"))
+	})
+}
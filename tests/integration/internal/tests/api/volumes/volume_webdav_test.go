@@ -0,0 +1,109 @@
+package volumes
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestVolumeWebDAV(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	c := setup.GetAPIClient()
+
+	volumeName := testVolumeName("test-volume-webdav")
+	volume := createTestVolume(t, ctx, c, volumeName)
+
+	webdavURL := setup.APIServerURL + "/volumes/" + volume.VolumeID + "/webdav"
+
+	do := func(t *testing.T, method, path string, body io.Reader, header http.Header) (*http.Response, string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, method, webdavURL+path, body)
+		require.NoError(t, err)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		req.SetBasicAuth("moru", setup.APIKey)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(respBody)
+	}
+
+	t.Run("requires credentials", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, "PROPFIND", webdavURL+"/", nil)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("WWW-Authenticate"), "Basic")
+	})
+
+	t.Run("files", func(t *testing.T) {
+		resp, _ := do(t, "MKCOL", "/docs", nil, nil)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, _ = do(t, http.MethodPut, "/docs/notes.txt", strings.NewReader("hello webdav"), nil)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, body := do(t, http.MethodGet, "/docs/notes.txt", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "hello webdav", body)
+
+		resp, body = do(t, "PROPFIND", "/docs", nil, http.Header{"Depth": {"1"}})
+		require.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+		assert.Contains(t, body, "/webdav/docs/notes.txt")
+
+		// Files written over WebDAV are served by the files API
+		downloadResp, err := c.GetVolumesVolumeIDFilesDownloadWithResponse(
+			ctx,
+			volume.VolumeID,
+			&api.GetVolumesVolumeIDFilesDownloadParams{Path: "/docs/notes.txt"},
+			setup.WithAPIKey(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, downloadResp.StatusCode())
+		assert.Equal(t, "hello webdav", string(downloadResp.Body))
+
+		resp, _ = do(t, "MOVE", "/docs/notes.txt", nil, http.Header{
+			"Destination": {webdavURL + "/docs/renamed.txt"},
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, _ = do(t, http.MethodGet, "/docs/notes.txt", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, body = do(t, http.MethodGet, "/docs/renamed.txt", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "hello webdav", body)
+
+		resp, _ = do(t, http.MethodDelete, "/docs", nil, nil)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, _ = do(t, "PROPFIND", "/docs", nil, http.Header{"Depth": {"0"}})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("missing parent", func(t *testing.T) {
+		resp, _ := do(t, http.MethodPut, "/missing/file.txt", strings.NewReader("content"), nil)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/api"
+	"github.com/moru-ai/sandbox-infra/tests/integration/internal/setup"
+)
+
+func TestWebhooks(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	createResp, err := c.PostWebhooksWithResponse(t.Context(), api.NewTeamWebhook{
+		Url:        "https://example.com/hooks/moru",
+		EventTypes: []api.WebhookEventType{api.VolumeFileUploaded, api.VolumeFileDeleted},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	if createResp.StatusCode() == http.StatusServiceUnavailable {
+		t.Skip("webhooks are not available without an events stream and a secrets encryption key")
+	}
+	require.Equal(t, http.StatusCreated, createResp.StatusCode(), string(createResp.Body))
+	require.NotNil(t, createResp.JSON201)
+	assert.NotEmpty(t, createResp.JSON201.Secret)
+
+	webhookID := createResp.JSON201.Id
+	t.Cleanup(func() {
+		_, _ = c.DeleteWebhooksWebhookIDWithResponse(context.WithoutCancel(t.Context()), webhookID, setup.WithAPIKey())
+	})
+
+	listResp, err := c.GetWebhooksWithResponse(t.Context(), setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	assert.NotContains(t, string(listResp.Body), createResp.JSON201.Secret, "webhook secrets must not be listed")
+
+	var listed *api.TeamWebhook
+	for _, hook := range *listResp.JSON200 {
+		if hook.Id == webhookID {
+			listed = &hook
+		}
+	}
+	require.NotNil(t, listed)
+	assert.Equal(t, "https://example.com/hooks/moru", listed.Url)
+	assert.ElementsMatch(t, []api.WebhookEventType{api.VolumeFileUploaded, api.VolumeFileDeleted}, listed.EventTypes)
+
+	deleteResp, err := c.DeleteWebhooksWebhookIDWithResponse(t.Context(), webhookID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	deleteResp, err = c.DeleteWebhooksWebhookIDWithResponse(t.Context(), webhookID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode())
+}
+
+func TestWebhookValidation(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	for name, req := range map[string]api.NewTeamWebhook{
+		"http url":           {Url: "http://example.com/hooks", EventTypes: []api.WebhookEventType{api.VolumeFileUploaded}},
+		"no event types":     {Url: "https://example.com/hooks", EventTypes: []api.WebhookEventType{}},
+		"unknown event type": {Url: "https://example.com/hooks", EventTypes: []api.WebhookEventType{"volume.mount.started"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := c.PostWebhooksWithResponse(t.Context(), req, setup.WithAPIKey())
+			require.NoError(t, err)
+			if resp.StatusCode() == http.StatusServiceUnavailable {
+				t.Skip("webhooks are not available")
+			}
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		})
+	}
+
+	resp, err := c.DeleteWebhooksWebhookIDWithResponse(t.Context(), uuid.New(), setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
@@ -21,6 +21,7 @@ type SandboxConfig struct {
 	network             *api.SandboxNetworkConfig
 	allowInternetAccess *bool
 	secure              *bool
+	secrets             *[]string
 }
 
 type SandboxOption func(config *SandboxConfig)
@@ -55,6 +56,12 @@ func WithSecure(secure bool) SandboxOption {
 	}
 }
 
+func WithSecrets(names ...string) SandboxOption {
+	return func(config *SandboxConfig) {
+		config.secrets = &names
+	}
+}
+
 func WithNetwork(network *api.SandboxNetworkConfig) SandboxOption {
 	return func(config *SandboxConfig) {
 		config.network = network
@@ -105,6 +112,7 @@ func SetupSandboxWithCleanup(t *testing.T, c *api.ClientWithResponses, options .
 		Network:             config.network,
 		AllowInternetAccess: config.allowInternetAccess,
 		Secure:              config.secure,
+		Secrets:             config.secrets,
 	}, setup.WithAPIKey())
 
 	require.NoError(t, err)